@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb "api_gateway/proto/proto_gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// blockingUserServer GetUserInfo在收到release前一直阻塞，用于模拟在途请求
+type blockingUserServer struct {
+	pb.UnimplementedUserServiceServer
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingUserServer) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.UserResponse, error) {
+	s.entered <- struct{}{}
+	<-s.release
+	return &pb.UserResponse{StatusMsg: "ok"}, nil
+}
+
+func startBlockingUserServer(t *testing.T) (string, *blockingUserServer) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := &blockingUserServer{entered: make(chan struct{}, 8), release: make(chan struct{})}
+	server := grpc.NewServer()
+	pb.RegisterUserServiceServer(server, srv)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String(), srv
+}
+
+func waitForState(t *testing.T, conn *grpc.ClientConn, want connectivity.State) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	for state := conn.GetState(); state != want; state = conn.GetState() {
+		if !conn.WaitForStateChange(ctx, state) {
+			t.Fatalf("connection state = %s, want %s", conn.GetState(), want)
+		}
+	}
+}
+
+func TestDrainWaitsForInFlightCallsOnOldClient(t *testing.T) {
+	addr, srv := startBlockingUserServer(t)
+	oldClient, err := NewUserServiceClient(addr, insecure.NewCredentials())
+	if err != nil {
+		t.Fatalf("NewUserServiceClient: %v", err)
+	}
+
+	type result struct {
+		resp *pb.UserResponse
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := oldClient.GetUserInfo(context.Background(), &pb.GetUserInfoRequest{UserId: 1})
+		done <- result{resp, err}
+	}()
+	<-srv.entered
+
+	// 地址变更：旧客户端进入摘除状态，新请求改用新客户端
+	oldClient.Drain(5 * time.Second)
+	if oldClient.IsConnected() {
+		t.Fatal("draining client still reports connected")
+	}
+	newClient, err := NewUserServiceClient(addr, insecure.NewCredentials())
+	if err != nil {
+		t.Fatalf("NewUserServiceClient: %v", err)
+	}
+	defer newClient.Close()
+	if !newClient.IsConnected() {
+		t.Fatal("replacement client is not connected")
+	}
+
+	// 在途请求完成前旧连接不能被关闭
+	time.Sleep(50 * time.Millisecond)
+	if state := oldClient.GetConnection().GetState(); state == connectivity.Shutdown {
+		t.Fatal("old connection closed while a call was in flight")
+	}
+
+	close(srv.release)
+	select {
+	case res := <-done:
+		if res.err != nil || res.resp.GetStatusMsg() != "ok" {
+			t.Fatalf("in-flight call = %v, %v; want ok response", res.resp, res.err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("in-flight call did not complete")
+	}
+	waitForState(t, oldClient.GetConnection(), connectivity.Shutdown)
+
+	if _, err := newClient.GetUserInfo(context.Background(), &pb.GetUserInfoRequest{UserId: 2}); err != nil {
+		t.Fatalf("call on replacement client: %v", err)
+	}
+}
+
+func TestDrainClosesIdleClientImmediately(t *testing.T) {
+	addr, _ := startBlockingUserServer(t)
+	c, err := NewUserServiceClient(addr, insecure.NewCredentials())
+	if err != nil {
+		t.Fatalf("NewUserServiceClient: %v", err)
+	}
+
+	c.Drain(5 * time.Second)
+	waitForState(t, c.GetConnection(), connectivity.Shutdown)
+}
+
+func TestDrainTimeoutClosesStuckClient(t *testing.T) {
+	addr, srv := startBlockingUserServer(t)
+	defer close(srv.release)
+	c, err := NewUserServiceClient(addr, insecure.NewCredentials())
+	if err != nil {
+		t.Fatalf("NewUserServiceClient: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetUserInfo(context.Background(), &pb.GetUserInfoRequest{UserId: 1})
+		done <- err
+	}()
+	<-srv.entered
+
+	c.Drain(50 * time.Millisecond)
+	waitForState(t, c.GetConnection(), connectivity.Shutdown)
+	if err := <-done; err == nil {
+		t.Fatal("call succeeded although the connection was closed after the drain timeout")
+	}
+}
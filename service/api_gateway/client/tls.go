@@ -0,0 +1,51 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TLSOptions 下游gRPC连接的TLS参数，Enabled为false时使用明文连接，仅适用于本地开发
+type TLSOptions struct {
+	Enabled    bool
+	CAFile     string // 校验服务端证书的CA，为空时使用系统根证书
+	CertFile   string // 客户端证书，下游开启mTLS时填写
+	KeyFile    string // 客户端私钥
+	ServerName string // 校验服务端证书时使用的名称，为空时取拨号地址的主机名
+}
+
+// TransportCredentials 构造下游gRPC连接凭证
+func TransportCredentials(opts TLSOptions) (credentials.TransportCredentials, error) {
+	if !opts.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{
+		ServerName: opts.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if opts.CAFile != "" {
+		data, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no valid certificates found in ca file %s", opts.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "api_gateway/proto/proto_gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type okUserServer struct {
+	pb.UnimplementedUserServiceServer
+}
+
+func (okUserServer) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.UserResponse, error) {
+	return &pb.UserResponse{StatusMsg: "ok"}, nil
+}
+
+// startTLSUserServer 启动使用自签CA签发证书的用户服务，证书名称为serverName，返回地址和CA文件
+func startTLSUserServer(t *testing.T, serverName string) (string, string) {
+	t.Helper()
+	newKey := func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("generate key: %v", err)
+		}
+		return key
+	}
+	caKey, serverKey := newKey(), newKey()
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create ca: %v", err)
+	}
+	serverDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: serverName},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, caTmpl, &serverKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create server certificate: %v", err)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0o600); err != nil {
+		t.Fatalf("write ca: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverDER}, PrivateKey: serverKey}},
+	})))
+	pb.RegisterUserServiceServer(server, okUserServer{})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String(), caFile
+}
+
+func TestTransportCredentialsDisabled(t *testing.T) {
+	creds, err := TransportCredentials(TLSOptions{CAFile: "missing.pem"})
+	if err != nil {
+		t.Fatalf("TransportCredentials: %v", err)
+	}
+	if got := creds.Info().SecurityProtocol; got != "insecure" {
+		t.Errorf("protocol = %s, want insecure", got)
+	}
+}
+
+func TestTransportCredentialsErrors(t *testing.T) {
+	invalidCA := filepath.Join(t.TempDir(), "invalid.pem")
+	if err := os.WriteFile(invalidCA, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	tests := []struct {
+		name string
+		opts TLSOptions
+	}{
+		{"missing ca file", TLSOptions{Enabled: true, CAFile: "missing.pem"}},
+		{"invalid ca", TLSOptions{Enabled: true, CAFile: invalidCA}},
+		{"certificate without key", TLSOptions{Enabled: true, CertFile: invalidCA}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := TransportCredentials(tt.opts); err == nil {
+				t.Error("TransportCredentials succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestUserServiceClientOverTLS(t *testing.T) {
+	addr, caFile := startTLSUserServer(t, "user-service")
+
+	creds, err := TransportCredentials(TLSOptions{Enabled: true, CAFile: caFile, ServerName: "user-service"})
+	if err != nil {
+		t.Fatalf("TransportCredentials: %v", err)
+	}
+	if info := creds.Info(); info.SecurityProtocol != "tls" || info.ServerName != "user-service" {
+		t.Errorf("credentials info = %+v, want tls for user-service", info)
+	}
+	c, err := NewUserServiceClient(addr, creds)
+	if err != nil {
+		t.Fatalf("NewUserServiceClient: %v", err)
+	}
+	defer c.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if resp, err := c.GetUserInfo(ctx, &pb.GetUserInfoRequest{UserId: 1}); err != nil || resp.StatusMsg != "ok" {
+		t.Errorf("GetUserInfo = (%v, %v), want ok over tls", resp, err)
+	}
+}
+
+func TestUserServiceClientRejectsUntrustedServer(t *testing.T) {
+	addr, caFile := startTLSUserServer(t, "user-service")
+
+	tests := []struct {
+		name string
+		opts TLSOptions
+	}{
+		// 自签CA不在系统根证书中
+		{"system roots", TLSOptions{Enabled: true, ServerName: "user-service"}},
+		// 证书不包含拨号地址
+		{"dial address", TLSOptions{Enabled: true, CAFile: caFile}},
+		{"server name mismatch", TLSOptions{Enabled: true, CAFile: caFile, ServerName: "live-service"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := TransportCredentials(tt.opts)
+			if err != nil {
+				t.Fatalf("TransportCredentials: %v", err)
+			}
+			conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer conn.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			if _, err := pb.NewUserServiceClient(conn).GetUserInfo(ctx, &pb.GetUserInfoRequest{UserId: 1}); err == nil {
+				t.Error("GetUserInfo succeeded against an untrusted server")
+			}
+		})
+	}
+}
@@ -4,26 +4,43 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	pb "api_gateway/proto/proto_gen/proto"
+	"common/ctxkeys"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 )
 
+// requestIDMetadataKey 向下游服务透传请求ID的gRPC元数据键
+const requestIDMetadataKey = "x-request-id"
+
 // UserServiceClient 用户服务客户端封装
 type UserServiceClient struct {
 	conn   *grpc.ClientConn
 	client pb.UserServiceClient
+
+	// 在途请求计数，用于摘除实例时等待请求完成后再关闭连接
+	mu       sync.Mutex
+	inFlight int
+	draining bool
+	drained  chan struct{}
 }
 
-// NewUserServiceClient 创建用户服务客户端
-func NewUserServiceClient(serviceAddr string) (*UserServiceClient, error) {
+// NewUserServiceClient 创建用户服务客户端，creds为连接使用的传输层凭证
+func NewUserServiceClient(serviceAddr string, creds credentials.TransportCredentials) (*UserServiceClient, error) {
+	c := &UserServiceClient{
+		drained: make(chan struct{}),
+	}
+
 	// gRPC连接配置
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(c.trackInFlight, propagateRequestID),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                10 * time.Second, // 每10秒发送一次keepalive ping
 			Timeout:             time.Second,      // ping超时时间
@@ -60,10 +77,73 @@ func NewUserServiceClient(serviceAddr string) (*UserServiceClient, error) {
 
 	log.Printf("Successfully connected to user service at %s", serviceAddr)
 
-	return &UserServiceClient{
-		conn:   conn,
-		client: pb.NewUserServiceClient(conn),
-	}, nil
+	c.conn = conn
+	c.client = pb.NewUserServiceClient(conn)
+	return c, nil
+}
+
+// trackInFlight 统计在途请求数的拦截器
+func (c *UserServiceClient) trackInFlight(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	c.mu.Lock()
+	c.inFlight++
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.inFlight--
+		if c.draining && c.inFlight == 0 {
+			c.markDrained()
+		}
+	}()
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// propagateRequestID 将网关请求ID写入下游调用的gRPC元数据，便于跨服务关联日志
+func propagateRequestID(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if requestID, ok := ctxkeys.RequestID(ctx); ok && requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, requestIDMetadataKey, requestID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// Drain 标记连接进入摘除状态，在途请求全部完成或超时后异步关闭连接
+// 摘除状态下IsConnected返回false，新请求应使用新的客户端
+func (c *UserServiceClient) Drain(timeout time.Duration) {
+	c.mu.Lock()
+	if c.draining {
+		c.mu.Unlock()
+		return
+	}
+	c.draining = true
+	if c.inFlight == 0 {
+		c.markDrained()
+	}
+	c.mu.Unlock()
+
+	go func() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-c.drained:
+		case <-timer.C:
+			log.Printf("User service connection drain timed out after %v, closing with in-flight requests", timeout)
+		}
+		if err := c.Close(); err != nil {
+			log.Printf("Failed to close drained user service connection: %v", err)
+		}
+	}()
+}
+
+// markDrained 通知连接已无在途请求，调用方需持有锁
+func (c *UserServiceClient) markDrained() {
+	select {
+	case <-c.drained:
+	default:
+		close(c.drained)
+	}
 }
 
 // Close 关闭连接
@@ -79,8 +159,16 @@ func (c *UserServiceClient) GetConnection() *grpc.ClientConn {
 	return c.conn
 }
 
-// IsConnected 检查连接状态
+// IsConnected 检查连接是否可用于新请求，摘除中的连接视为不可用
 func (c *UserServiceClient) IsConnected() bool {
+	c.mu.Lock()
+	draining := c.draining
+	c.mu.Unlock()
+	return !draining && c.isReady()
+}
+
+// isReady 检查底层连接状态，摘除中的连接仍可完成已获取客户端的请求
+func (c *UserServiceClient) isReady() bool {
 	if c.conn == nil {
 		return false
 	}
@@ -90,7 +178,7 @@ func (c *UserServiceClient) IsConnected() bool {
 
 // PhoneLogin 手机号登录
 func (c *UserServiceClient) PhoneLogin(ctx context.Context, req *pb.PhoneLoginRequest) (*pb.LoginResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.PhoneLogin(ctx, req)
@@ -98,7 +186,7 @@ func (c *UserServiceClient) PhoneLogin(ctx context.Context, req *pb.PhoneLoginRe
 
 // CodeLogin 验证码登录
 func (c *UserServiceClient) CodeLogin(ctx context.Context, req *pb.CodeLoginRequest) (*pb.LoginResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.CodeLogin(ctx, req)
@@ -106,7 +194,7 @@ func (c *UserServiceClient) CodeLogin(ctx context.Context, req *pb.CodeLoginRequ
 
 // SendSmsCode 发送短信验证码
 func (c *UserServiceClient) SendSmsCode(ctx context.Context, req *pb.SendSmsRequest) (*pb.SendSmsResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.SendSmsCode(ctx, req)
@@ -114,7 +202,7 @@ func (c *UserServiceClient) SendSmsCode(ctx context.Context, req *pb.SendSmsRequ
 
 // GetUserInfo 获取用户信息
 func (c *UserServiceClient) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.UserResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.GetUserInfo(ctx, req)
@@ -122,7 +210,7 @@ func (c *UserServiceClient) GetUserInfo(ctx context.Context, req *pb.GetUserInfo
 
 // VerifyToken 验证Token
 func (c *UserServiceClient) VerifyToken(ctx context.Context, req *pb.VerifyTokenRequest) (*pb.VerifyTokenResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.VerifyToken(ctx, req)
@@ -130,7 +218,7 @@ func (c *UserServiceClient) VerifyToken(ctx context.Context, req *pb.VerifyToken
 
 // RefreshToken 刷新Token
 func (c *UserServiceClient) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.RefreshToken(ctx, req)
@@ -138,7 +226,7 @@ func (c *UserServiceClient) RefreshToken(ctx context.Context, req *pb.RefreshTok
 
 // 退出登录
 func (c *UserServiceClient) LogOut(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
-	if !c.IsConnected() {
+	if !c.isReady() {
 		return nil, fmt.Errorf("connection not ready")
 	}
 	return c.client.Logout(ctx, req)
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"common/ctxkeys"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPropagateRequestID(t *testing.T) {
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	ctx := ctxkeys.WithRequestID(context.Background(), "req-1")
+	if err := propagateRequestID(ctx, "/UserService/GetUserInfo", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("propagateRequestID: %v", err)
+	}
+	if got := outgoing.Get(requestIDMetadataKey); len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("outgoing %s = %v, want [req-1]", requestIDMetadataKey, got)
+	}
+
+	outgoing = nil
+	if err := propagateRequestID(context.Background(), "/UserService/GetUserInfo", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("propagateRequestID: %v", err)
+	}
+	if got := outgoing.Get(requestIDMetadataKey); len(got) != 0 {
+		t.Errorf("outgoing %s = %v without request id, want none", requestIDMetadataKey, got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -14,6 +15,16 @@ type Config struct {
 	Server ServerConfig `mapstructure:"server"`
 	Etcd   EtcdConfig   `mapstructure:"etcd"`
 	Logger LoggerConfig `mapstructure:"logger"`
+	// CircuitBreaker 下游服务熔断配置
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	// UserInfoCache 用户服务不可用时返回的用户信息兜底缓存配置
+	UserInfoCache UserInfoCacheConfig `mapstructure:"user_info_cache"`
+	// GRPCTLS 连接下游gRPC服务的TLS配置
+	GRPCTLS GRPCTLSConfig `mapstructure:"grpc_tls"`
+	// Compression 响应压缩配置
+	Compression CompressionConfig `mapstructure:"compression"`
+	// Maintenance 维护模式配置
+	Maintenance MaintenanceConfig `mapstructure:"maintenance"`
 }
 
 // ServerConfig 服务器配置
@@ -32,6 +43,48 @@ type EtcdConfig struct {
 type LoggerConfig struct {
 	Level  string `mapstructure:"level"`
 	Format string `mapstructure:"format"`
+	// AccessLogSampleRate 2xx访问日志采样率(0~1)，4xx/5xx始终记录
+	AccessLogSampleRate float64 `mapstructure:"access_log_sample_rate"`
+}
+
+// CircuitBreakerConfig 熔断器配置
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"` // 连续失败多少次后开启熔断
+	Cooldown         time.Duration `mapstructure:"cooldown"`          // 开启后多久进入半开状态
+}
+
+// UserInfoCacheConfig 用户信息兜底缓存配置
+type UserInfoCacheConfig struct {
+	TTL        time.Duration `mapstructure:"ttl"`         // 缓存数据的最长使用时间，超过后不再兜底返回
+	MaxEntries int           `mapstructure:"max_entries"` // 最多缓存的用户数
+}
+
+// GRPCTLSConfig 下游gRPC连接TLS配置，未开启时使用明文连接，仅适用于本地开发
+type GRPCTLSConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	CAFile     string `mapstructure:"ca_file"`     // 校验服务端证书的CA，为空时使用系统根证书
+	CertFile   string `mapstructure:"cert_file"`   // 客户端证书，下游开启mTLS时填写
+	KeyFile    string `mapstructure:"key_file"`    // 客户端私钥
+	ServerName string `mapstructure:"server_name"` // 校验服务端证书时使用的名称，为空时取拨号地址的主机名
+}
+
+// CompressionConfig 响应压缩配置
+type CompressionConfig struct {
+	Enabled      bool     `mapstructure:"enabled"`
+	MinSize      int      `mapstructure:"min_size"`      // 响应体小于该字节数时不压缩
+	Level        int      `mapstructure:"level"`         // 压缩级别，-1为默认级别，1~9越大压缩率越高
+	ContentTypes []string `mapstructure:"content_types"` // 允许压缩的Content-Type，图片、视频等已压缩的格式不要加入
+}
+
+// MaintenanceConfig 维护模式配置，开启后由etcd中的key控制是否进入维护
+type MaintenanceConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	Key         string        `mapstructure:"key"`          // 维护开关的etcd键，值为on、true或1时进入维护
+	Methods     []string      `mapstructure:"methods"`      // 维护期间拦截的HTTP方法，为空时拦截POST/PUT/PATCH/DELETE
+	Paths       []string      `mapstructure:"paths"`        // 维护期间额外拦截的路由，按gin注册的路径匹配
+	ExemptPaths []string      `mapstructure:"exempt_paths"` // 始终放行的路由，为空时放行健康检查和监控接口
+	RetryAfter  time.Duration `mapstructure:"retry_after"`  // 响应的Retry-After
+	Message     string        `mapstructure:"message"`      // 返回给客户端的提示
 }
 
 // LoadConfig 加载配置
@@ -58,6 +111,18 @@ func LoadConfig(configPath string) (*Config, error) {
 	v.SetDefault("etcd.endpoints", []string{"localhost:2379"})
 	v.SetDefault("logger.level", "info")
 	v.SetDefault("logger.format", "json")
+	v.SetDefault("logger.access_log_sample_rate", 1.0)
+	v.SetDefault("circuit_breaker.failure_threshold", 3)
+	v.SetDefault("circuit_breaker.cooldown", "30s")
+	v.SetDefault("user_info_cache.ttl", "5m")
+	v.SetDefault("user_info_cache.max_entries", 10000)
+	v.SetDefault("compression.enabled", true)
+	v.SetDefault("compression.min_size", 1024)
+	v.SetDefault("compression.level", -1)
+	v.SetDefault("compression.content_types", []string{"application/json", "text/plain", "text/html", "text/css", "application/javascript"})
+	v.SetDefault("maintenance.enabled", false)
+	v.SetDefault("maintenance.key", "/config/gateway/maintenance")
+	v.SetDefault("maintenance.retry_after", "60s")
 
 	// 读取配置文件
 	if err := v.ReadInConfig(); err != nil {
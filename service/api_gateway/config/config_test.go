@@ -0,0 +1,46 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// loadTestConfig 写入临时配置文件并加载
+func loadTestConfig(t *testing.T, yaml string) *Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "gateway.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	return cfg
+}
+
+func TestGRPCTLSConfig(t *testing.T) {
+	cfg := loadTestConfig(t, `grpc_tls:
+  enabled: true
+  ca_file: /etc/tls/ca.pem
+  cert_file: /etc/tls/gateway.pem
+  key_file: /etc/tls/gateway-key.pem
+  server_name: user-service
+`)
+	want := GRPCTLSConfig{
+		Enabled:    true,
+		CAFile:     "/etc/tls/ca.pem",
+		CertFile:   "/etc/tls/gateway.pem",
+		KeyFile:    "/etc/tls/gateway-key.pem",
+		ServerName: "user-service",
+	}
+	if cfg.GRPCTLS != want {
+		t.Errorf("grpc tls = %+v, want %+v", cfg.GRPCTLS, want)
+	}
+
+	// 未配置时使用明文连接
+	if cfg := loadTestConfig(t, "server:\n  port: 8080\n"); cfg.GRPCTLS != (GRPCTLSConfig{}) {
+		t.Errorf("default grpc tls = %+v, want disabled", cfg.GRPCTLS)
+	}
+}
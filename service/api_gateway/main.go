@@ -12,26 +12,16 @@ import (
 	"github.com/gin-gonic/gin"
 	ginprometheus "github.com/zsais/go-gin-prometheus"
 
+	"api_gateway/config"
 	"api_gateway/middleware"
 	"api_gateway/routes"
 )
 
-// EtcdConfig etcd配置
-type EtcdConfig struct {
-	Endpoints []string `mapstructure:"endpoints"`
-}
-
-// Config 应用配置
-type Config struct {
-	Etcd EtcdConfig `mapstructure:"etcd"`
-}
-
 func main() {
 	// 初始化配置
-	cfg := &Config{
-		Etcd: EtcdConfig{
-			Endpoints: []string{"localhost:2379"},
-		},
+	cfg, err := config.LoadConfig(config.GetDefaultConfigPath())
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
 	}
 
 	// 创建Gin引擎
@@ -42,10 +32,22 @@ func main() {
 	p.Use(router)
 
 	// 添加中间件
-	router.Use(middleware.MetricsMiddleware())  // 自定义监控中间件
-	router.Use(middleware.LoggerMiddleware())   // 日志中间件
-	router.Use(middleware.RecoveryMiddleware()) // 恢复中间件
-	router.Use(middleware.CORSMiddleware())     // CORS中间件
+	router.Use(middleware.MetricsMiddleware())                              // 自定义监控中间件
+	router.Use(middleware.RequestIDMiddleware())                            // 请求ID中间件
+	router.Use(middleware.LoggerMiddleware(cfg.Logger.AccessLogSampleRate)) // 日志中间件
+	router.Use(middleware.RecoveryMiddleware())                             // 恢复中间件
+	router.Use(middleware.CORSMiddleware())                                 // CORS中间件
+	router.Use(middleware.CompressionMiddleware(cfg.Compression))           // 响应压缩中间件
+
+	// 维护模式，由etcd中的开关控制
+	if cfg.Maintenance.Enabled {
+		maintenance, err := middleware.NewEtcdMaintenanceSwitch(cfg.Etcd.Endpoints, cfg.Maintenance.Key)
+		if err != nil {
+			log.Fatalf("Failed to watch maintenance flag: %v", err)
+		}
+		defer maintenance.Close()
+		router.Use(middleware.Maintenance(cfg.Maintenance, maintenance)) // 维护模式中间件
+	}
 
 	// 健康检查路由
 	router.GET("/health", middleware.HealthCheck())
@@ -54,7 +56,7 @@ func main() {
 	router.GET("/grafana/health", middleware.GrafanaHealthCheck())
 
 	// 注册用户服务路由
-	userHandler, err := routes.NewUserHandler(cfg.Etcd.Endpoints)
+	userHandler, err := routes.NewUserHandler(cfg.Etcd.Endpoints, cfg.CircuitBreaker, cfg.UserInfoCache, cfg.GRPCTLS)
 	if err != nil {
 		log.Fatalf("Failed to connect to user service: %v", err)
 	}
@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"api_gateway/config"
+)
+
+// 支持的压缩编码，客户端同时接受时优先gzip
+const (
+	encodingGzip    = "gzip"
+	encodingDeflate = "deflate"
+)
+
+// compressor 响应压缩参数
+type compressor struct {
+	minSize      int
+	level        int
+	contentTypes map[string]bool
+}
+
+// CompressionMiddleware 响应压缩中间件，按Accept-Encoding使用gzip或deflate压缩响应
+// 响应体先缓冲到min_size，不足min_size、Content-Type不在允许列表或已设置Content-Encoding的响应原样返回
+func CompressionMiddleware(cfg config.CompressionConfig) gin.HandlerFunc {
+	if !cfg.Enabled {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	level := cfg.Level
+	if level < flate.HuffmanOnly || level > flate.BestCompression {
+		level = flate.DefaultCompression
+	}
+	cp := &compressor{
+		minSize:      cfg.MinSize,
+		level:        level,
+		contentTypes: make(map[string]bool, len(cfg.ContentTypes)),
+	}
+	for _, contentType := range cfg.ContentTypes {
+		cp.contentTypes[strings.ToLower(strings.TrimSpace(contentType))] = true
+	}
+
+	return func(c *gin.Context) {
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		w := &compressWriter{ResponseWriter: c.Writer, compressor: cp, encoding: encoding}
+		c.Writer = w
+		defer w.finish()
+		c.Next()
+	}
+}
+
+// negotiateEncoding 从Accept-Encoding中选择压缩编码，q=0表示客户端拒绝该编码
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool, 2)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok && isZeroQuality(q) {
+			continue
+		}
+		accepted[name] = true
+	}
+	switch {
+	case accepted[encodingGzip]:
+		return encodingGzip
+	case accepted[encodingDeflate]:
+		return encodingDeflate
+	}
+	return ""
+}
+
+// isZeroQuality 判断q值是否为0(如0、0.0、0.000)
+func isZeroQuality(q string) bool {
+	return strings.Trim(strings.TrimSpace(q), "0.") == ""
+}
+
+// compressWriter 缓冲响应体，达到压缩阈值后决定是否压缩
+type compressWriter struct {
+	gin.ResponseWriter
+	*compressor
+	encoding string
+
+	buf     []byte
+	size    int // 处理函数写入的响应体字节数(压缩前)
+	decided bool
+	encoder io.WriteCloser // 为nil时原样输出
+}
+
+// Write 决定是否压缩前先缓冲，缓冲达到min_size时开始输出
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.decided {
+		n, err := w.write(data)
+		w.size += n
+		return n, err
+	}
+	w.buf = append(w.buf, data...)
+	w.size += len(data)
+	if len(w.buf) >= w.minSize {
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// WriteString 与Write相同，gin渲染字符串响应时调用
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Size 返回处理函数写入的响应体字节数(压缩前)，包括仍在缓冲中的数据，未写入时与gin一致返回-1
+func (w *compressWriter) Size() int {
+	if w.size > 0 {
+		return w.size
+	}
+	return w.ResponseWriter.Size()
+}
+
+// Written 响应头已发送或已有响应体写入缓冲时返回true，避免后续中间件重复写响应
+func (w *compressWriter) Written() bool {
+	return w.size > 0 || w.ResponseWriter.Written()
+}
+
+// WriteHeaderNow 立即发送响应头前先按已缓冲的数据决定是否压缩，保证Content-Encoding随响应头发出
+func (w *compressWriter) WriteHeaderNow() {
+	if !w.decided {
+		if err := w.decide(); err != nil {
+			return
+		}
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Flush 流式响应需要立即输出，按已缓冲的数据决定是否压缩
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		if err := w.decide(); err != nil {
+			return
+		}
+	}
+	if f, ok := w.encoder.(interface{ Flush() error }); ok {
+		f.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide 根据响应头和已缓冲的数据决定是否压缩，并输出缓冲的数据
+func (w *compressWriter) decide() error {
+	w.decided = true
+	if w.shouldCompress() {
+		header := w.Header()
+		header.Set("Content-Encoding", w.encoding)
+		header.Add("Vary", "Accept-Encoding")
+		header.Del("Content-Length")
+		if w.encoding == encodingGzip {
+			w.encoder, _ = gzip.NewWriterLevel(w.ResponseWriter, w.level)
+		} else {
+			w.encoder, _ = flate.NewWriter(w.ResponseWriter, w.level)
+		}
+	}
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.write(buf)
+	return err
+}
+
+// shouldCompress 响应是否满足压缩条件
+func (w *compressWriter) shouldCompress() bool {
+	if len(w.buf) < w.minSize || len(w.buf) == 0 {
+		return false
+	}
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	header := w.Header()
+	// 已压缩或由下游指定了编码的响应不再压缩
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return w.contentTypes[mediaType]
+}
+
+// write 输出数据，压缩时写入压缩流
+func (w *compressWriter) write(data []byte) (int, error) {
+	if w.encoder != nil {
+		return w.encoder.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// finish 请求处理完成后输出剩余数据并结束压缩流
+func (w *compressWriter) finish() {
+	if !w.decided {
+		if err := w.decide(); err != nil {
+			return
+		}
+	}
+	if w.encoder != nil {
+		w.encoder.Close()
+	}
+}
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"api_gateway/config"
+)
+
+// testCompressionConfig 测试用压缩配置，响应体不少于64字节的JSON和文本响应会被压缩
+var testCompressionConfig = config.CompressionConfig{
+	Enabled:      true,
+	MinSize:      64,
+	Level:        -1,
+	ContentTypes: []string{"application/json", "text/plain"},
+}
+
+// serveCompressed 经压缩中间件处理请求
+func serveCompressed(acceptEncoding string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CompressionMiddleware(testCompressionConfig))
+	router.GET("/data", handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/data", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestCompressionMiddlewareCompressesEligibleResponses(t *testing.T) {
+	body := strings.Repeat("vision world ", 20)
+	for _, encoding := range []string{encodingGzip, encodingDeflate} {
+		w := serveCompressed(encoding, func(c *gin.Context) { c.String(http.StatusOK, body) })
+
+		if got := w.Header().Get("Content-Encoding"); got != encoding {
+			t.Fatalf("%s: Content-Encoding = %q", encoding, got)
+		}
+		var reader io.Reader
+		if encoding == encodingGzip {
+			gz, err := gzip.NewReader(w.Body)
+			if err != nil {
+				t.Fatalf("gzip.NewReader: %v", err)
+			}
+			reader = gz
+		} else {
+			reader = flate.NewReader(w.Body)
+		}
+		decoded, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("%s: decode: %v", encoding, err)
+		}
+		if string(decoded) != body {
+			t.Errorf("%s: decoded body = %q", encoding, decoded)
+		}
+	}
+}
+
+func TestCompressionMiddlewarePassesThrough(t *testing.T) {
+	large := strings.Repeat("x", 256)
+	cases := map[string]struct {
+		acceptEncoding string
+		handler        gin.HandlerFunc
+		wantBody       string
+	}{
+		"small body":               {"gzip", func(c *gin.Context) { c.String(http.StatusOK, "ok") }, "ok"},
+		"no accept-encoding":       {"", func(c *gin.Context) { c.String(http.StatusOK, large) }, large},
+		"gzip refused":             {"gzip;q=0", func(c *gin.Context) { c.String(http.StatusOK, large) }, large},
+		"content type not allowed": {"gzip", func(c *gin.Context) { c.Data(http.StatusOK, "image/png", []byte(large)) }, large},
+		"already encoded": {"gzip", func(c *gin.Context) {
+			c.Header("Content-Encoding", "br")
+			c.Data(http.StatusOK, "application/json", []byte(large))
+		}, large},
+	}
+	for name, tc := range cases {
+		w := serveCompressed(tc.acceptEncoding, tc.handler)
+		if got := w.Header().Get("Content-Encoding"); got == encodingGzip {
+			t.Errorf("%s: Content-Encoding = %q, want passthrough", name, got)
+		}
+		if w.Body.String() != tc.wantBody {
+			t.Errorf("%s: body = %q", name, w.Body.String())
+		}
+	}
+}
+
+func TestCompressionMiddlewareReportsBufferedWrites(t *testing.T) {
+	var sizeBefore, sizeAfter int
+	var writtenBefore, writtenAfter bool
+	w := serveCompressed("gzip", func(c *gin.Context) {
+		sizeBefore, writtenBefore = c.Writer.Size(), c.Writer.Written()
+		c.String(http.StatusOK, "small")
+		// 响应体仍在缓冲中，尚未写到底层连接
+		sizeAfter, writtenAfter = c.Writer.Size(), c.Writer.Written()
+	})
+
+	if sizeBefore != -1 || writtenBefore {
+		t.Errorf("before write: Size = %d, Written = %v, want -1/false", sizeBefore, writtenBefore)
+	}
+	if sizeAfter != len("small") || !writtenAfter {
+		t.Errorf("after buffered write: Size = %d, Written = %v, want %d/true", sizeAfter, writtenAfter, len("small"))
+	}
+	if w.Body.String() != "small" {
+		t.Errorf("body = %q, want small", w.Body.String())
+	}
+}
+
+func TestCompressionMiddlewareDecidesBeforeHeadersAreSent(t *testing.T) {
+	w := serveCompressed("gzip", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.AbortWithStatus(http.StatusUnauthorized)
+		c.Writer.WriteString(strings.Repeat("x", 256))
+	})
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", w.Code)
+	}
+	// 响应头已在没有缓冲数据时发出，后续写入不能再压缩
+	if got := w.Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Content-Encoding = %q after headers were sent", got)
+	}
+	if w.Body.Len() != 256 {
+		t.Errorf("body length = %d, want 256 uncompressed bytes", w.Body.Len())
+	}
+}
@@ -1,37 +1,85 @@
 package middleware
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"math/rand"
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
 )
 
-// LoggerMiddleware 自定义日志中间件
-func LoggerMiddleware() gin.HandlerFunc {
-	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		return fmt.Sprintf("[%s] %s %s %s %d %s %s %s\n",
-			param.TimeStamp.Format(time.RFC3339),
-			param.Method,
-			param.Path,
-			param.Request.Proto,
-			param.StatusCode,
-			param.Latency,
-			param.ClientIP,
-			param.ErrorMessage,
-		)
+// AccessLog 结构化访问日志
+type AccessLog struct {
+	Time      string  `json:"time"`
+	Method    string  `json:"method"`
+	Path      string  `json:"path"`
+	Proto     string  `json:"proto"`
+	Status    int     `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	ClientIP  string  `json:"client_ip"`
+	RequestID string  `json:"request_id"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// LoggerMiddleware 访问日志中间件，输出JSON格式日志
+// successSampleRate 为2xx/3xx响应的采样率，4xx/5xx响应始终记录
+func LoggerMiddleware(successSampleRate float64) gin.HandlerFunc {
+	return gin.LoggerWithConfig(gin.LoggerConfig{
+		Formatter: func(param gin.LogFormatterParams) string {
+			line, err := json.Marshal(AccessLog{
+				Time:      param.TimeStamp.Format(time.RFC3339),
+				Method:    param.Method,
+				Path:      param.Path,
+				Proto:     param.Request.Proto,
+				Status:    param.StatusCode,
+				LatencyMs: float64(param.Latency.Microseconds()) / 1000,
+				ClientIP:  param.ClientIP,
+				RequestID: accessLogRequestID(param),
+				Error:     param.ErrorMessage,
+			})
+			if err != nil {
+				return fmt.Sprintf("access log marshal failed: %v\n", err)
+			}
+			return string(line) + "\n"
+		},
+		Skip: func(c *gin.Context) bool {
+			return !shouldLogAccess(c.Writer.Status(), successSampleRate)
+		},
 	})
 }
 
+// accessLogRequestID 取RequestIDMiddleware分配的请求ID，未经该中间件时取请求头
+func accessLogRequestID(param gin.LogFormatterParams) string {
+	if requestID, ok := param.Keys[RequestIDKey].(string); ok {
+		return requestID
+	}
+	return param.Request.Header.Get(RequestIDHeader)
+}
+
+// shouldLogAccess 判断是否记录本次访问日志
+func shouldLogAccess(status int, successSampleRate float64) bool {
+	if status >= http.StatusBadRequest {
+		return true
+	}
+	if successSampleRate >= 1 {
+		return true
+	}
+	return rand.Float64() < successSampleRate
+}
+
 // RecoveryMiddleware 恢复中间件
 func RecoveryMiddleware() gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
 		if err, ok := recovered.(string); ok {
 			log.Printf("Panic recovered: %s", err)
-			c.JSON(500, gin.H{
-				"error":   "Internal Server Error",
-				"message": "Something went wrong",
+			// 与网关错误响应格式保持一致
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"code": int(codes.Internal),
+				"msg":  "Internal Server Error",
 			})
 		}
 		c.AbortWithStatus(500)
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// serveAccessLog 以给定采样率处理一次返回status的请求，返回输出的访问日志
+func serveAccessLog(t *testing.T, sampleRate float64, status int) []AccessLog {
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	defaultWriter := gin.DefaultWriter
+	gin.DefaultWriter = &buf
+	defer func() { gin.DefaultWriter = defaultWriter }()
+
+	router := gin.New()
+	router.Use(RequestIDMiddleware())
+	router.Use(LoggerMiddleware(sampleRate))
+	router.GET("/ping", func(c *gin.Context) { c.Status(status) })
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	var logs []AccessLog
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry AccessLog
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("access log is not JSON: %v (%q)", err, buf.String())
+		}
+		logs = append(logs, entry)
+	}
+	return logs
+}
+
+func TestLoggerMiddlewareWritesStructuredFields(t *testing.T) {
+	logs := serveAccessLog(t, 1, http.StatusOK)
+	if len(logs) != 1 {
+		t.Fatalf("got %d access logs, want 1", len(logs))
+	}
+	entry := logs[0]
+	if entry.Method != http.MethodGet || entry.Path != "/ping" || entry.Status != http.StatusOK ||
+		entry.RequestID != "req-1" || entry.Time == "" || entry.Proto == "" {
+		t.Errorf("access log = %+v", entry)
+	}
+}
+
+func TestLoggerMiddlewareNeverSamplesOutErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusInternalServerError} {
+		if logs := serveAccessLog(t, 0, status); len(logs) != 1 || logs[0].Status != status {
+			t.Errorf("status %d with sample rate 0 logged %+v, want one entry", status, logs)
+		}
+	}
+	if logs := serveAccessLog(t, 0, http.StatusOK); len(logs) != 0 {
+		t.Errorf("2xx with sample rate 0 logged %+v, want none", logs)
+	}
+}
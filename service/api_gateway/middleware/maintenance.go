@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+
+	"api_gateway/config"
+)
+
+// 未配置时的默认值
+var (
+	defaultMaintenanceMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	defaultMaintenanceExempt  = []string{"/health", "/grafana/health", "/metrics"}
+)
+
+const (
+	defaultMaintenanceRetryAfter = time.Minute
+	defaultMaintenanceMessage    = "系统维护中，请稍后再试"
+)
+
+// MaintenanceSwitch 维护模式开关，并发安全
+type MaintenanceSwitch struct {
+	on     atomic.Bool
+	client *clientv3.Client
+	cancel context.CancelFunc
+}
+
+// Set 设置是否处于维护模式
+func (s *MaintenanceSwitch) Set(on bool) {
+	s.on.Store(on)
+}
+
+// On 是否处于维护模式
+func (s *MaintenanceSwitch) On() bool {
+	return s.on.Load()
+}
+
+// Close 停止监听并关闭etcd客户端，未连接etcd的开关无需关闭
+func (s *MaintenanceSwitch) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.client != nil {
+		return s.client.Close()
+	}
+	return nil
+}
+
+// NewEtcdMaintenanceSwitch 创建由etcd键控制的维护模式开关
+// 键的值为on、true或1时进入维护模式，其他值或键不存在时退出；读取初始值后持续监听键的变化
+func NewEtcdMaintenanceSwitch(endpoints []string, key string) (*MaintenanceSwitch, error) {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &MaintenanceSwitch{client: client, cancel: cancel}
+
+	getCtx, getCancel := context.WithTimeout(ctx, 3*time.Second)
+	resp, err := client.Get(getCtx, key)
+	getCancel()
+	if err != nil {
+		s.Close()
+		return nil, fmt.Errorf("failed to get maintenance flag: %w", err)
+	}
+	if len(resp.Kvs) > 0 {
+		s.Set(isMaintenanceOn(string(resp.Kvs[0].Value)))
+	}
+
+	// 从读取的版本之后开始监听，避免两者之间的变更丢失
+	watchChan := client.Watch(ctx, key, clientv3.WithRev(resp.Header.Revision+1))
+	go func() {
+		for watchResp := range watchChan {
+			for _, event := range watchResp.Events {
+				on := event.Type == clientv3.EventTypePut && isMaintenanceOn(string(event.Kv.Value))
+				if on != s.On() {
+					log.Printf("Maintenance mode changed: on=%v", on)
+				}
+				s.Set(on)
+			}
+		}
+	}()
+	return s, nil
+}
+
+// isMaintenanceOn 解析维护开关的值
+func isMaintenanceOn(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "on", "true", "1":
+		return true
+	}
+	return false
+}
+
+// Maintenance 维护模式中间件，维护期间拦截写请求和指定路由，返回503和Retry-After
+// 按HTTP方法或路由(gin注册的路径)拦截，exempt_paths中的路由(如健康检查)始终放行
+func Maintenance(cfg config.MaintenanceConfig, sw *MaintenanceSwitch) gin.HandlerFunc {
+	methods := toSet(cfg.Methods, defaultMaintenanceMethods, strings.ToUpper)
+	paths := toSet(cfg.Paths, nil, nil)
+	exempt := toSet(cfg.ExemptPaths, defaultMaintenanceExempt, nil)
+	retryAfter := cfg.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = defaultMaintenanceRetryAfter
+	}
+	retrySeconds := int(retryAfter.Round(time.Second) / time.Second)
+	message := cfg.Message
+	if message == "" {
+		message = defaultMaintenanceMessage
+	}
+
+	return func(c *gin.Context) {
+		if !sw.On() {
+			c.Next()
+			return
+		}
+		path := c.FullPath()
+		if path == "" {
+			path = c.Request.URL.Path
+		}
+		if exempt[path] || (!methods[c.Request.Method] && !paths[path]) {
+			c.Next()
+			return
+		}
+
+		c.Header("Retry-After", strconv.Itoa(retrySeconds))
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+			"code":        int(codes.Unavailable),
+			"msg":         message,
+			"maintenance": true,
+			"retry_after": retrySeconds,
+		})
+	}
+}
+
+// toSet 将配置列表转换为集合，列表为空时使用默认值，normalize不为nil时先规范化每一项
+func toSet(values, defaults []string, normalize func(string) string) map[string]bool {
+	if len(values) == 0 {
+		values = defaults
+	}
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if normalize != nil {
+			value = normalize(value)
+		}
+		set[value] = true
+	}
+	return set
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"api_gateway/config"
+)
+
+// newMaintenanceRouter 注册维护模式中间件和几条测试路由
+func newMaintenanceRouter(cfg config.MaintenanceConfig, sw *MaintenanceSwitch) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Maintenance(cfg, sw))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/health", ok)
+	router.GET("/api/v1/videos", ok)
+	router.POST("/api/v1/videos", ok)
+	router.GET("/api/v1/live/:id/playback", ok)
+	router.POST("/api/v1/internal/reindex", ok)
+	return router
+}
+
+func serveMaintenance(router *gin.Engine, method, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w
+}
+
+func TestMaintenanceBlocksByMethodAndPath(t *testing.T) {
+	sw := &MaintenanceSwitch{}
+	sw.Set(true)
+	router := newMaintenanceRouter(config.MaintenanceConfig{
+		Paths:       []string{"/api/v1/live/:id/playback"},
+		ExemptPaths: []string{"/health", "/api/v1/internal/reindex"},
+	}, sw)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{"read passes", http.MethodGet, "/api/v1/videos", http.StatusOK},
+		{"write blocked", http.MethodPost, "/api/v1/videos", http.StatusServiceUnavailable},
+		// 按gin注册的路径匹配，带参数的路由同样拦截
+		{"configured path blocked", http.MethodGet, "/api/v1/live/42/playback", http.StatusServiceUnavailable},
+		{"exempt write passes", http.MethodPost, "/api/v1/internal/reindex", http.StatusOK},
+		{"health passes", http.MethodGet, "/health", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := serveMaintenance(router, tt.method, tt.path); w.Code != tt.want {
+				t.Errorf("%s %s = %d, want %d", tt.method, tt.path, w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaintenanceResponse(t *testing.T) {
+	sw := &MaintenanceSwitch{}
+	sw.Set(true)
+
+	tests := []struct {
+		name        string
+		cfg         config.MaintenanceConfig
+		wantRetry   string
+		wantMessage string
+	}{
+		{"defaults", config.MaintenanceConfig{}, "60", defaultMaintenanceMessage},
+		{"configured", config.MaintenanceConfig{RetryAfter: 90 * time.Second, Message: "升级中"}, "90", "升级中"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveMaintenance(newMaintenanceRouter(tt.cfg, sw), http.MethodPost, "/api/v1/videos")
+			if w.Code != http.StatusServiceUnavailable || w.Header().Get("Retry-After") != tt.wantRetry {
+				t.Fatalf("status = %d Retry-After = %q, want 503 and %s", w.Code, w.Header().Get("Retry-After"), tt.wantRetry)
+			}
+			var body struct {
+				Msg         string `json:"msg"`
+				Maintenance bool   `json:"maintenance"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("unmarshal %s: %v", w.Body.String(), err)
+			}
+			if body.Msg != tt.wantMessage || !body.Maintenance {
+				t.Errorf("body = %s, want maintenance message %q", w.Body.String(), tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestMaintenanceFollowsSwitch(t *testing.T) {
+	sw := &MaintenanceSwitch{}
+	router := newMaintenanceRouter(config.MaintenanceConfig{}, sw)
+
+	for _, step := range []struct {
+		on   bool
+		want int
+	}{{false, http.StatusOK}, {true, http.StatusServiceUnavailable}, {false, http.StatusOK}} {
+		sw.Set(step.on)
+		if w := serveMaintenance(router, http.MethodPost, "/api/v1/videos"); w.Code != step.want {
+			t.Errorf("maintenance on=%v: POST = %d, want %d", step.on, w.Code, step.want)
+		}
+	}
+}
+
+func TestIsMaintenanceOn(t *testing.T) {
+	for value, want := range map[string]bool{
+		"on": true, "TRUE": true, " 1\n": true,
+		"off": false, "0": false, "": false, "yes": false,
+	} {
+		if got := isMaintenanceOn(value); got != want {
+			t.Errorf("isMaintenanceOn(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+
+	"common/ctxkeys"
+)
+
+const (
+	// RequestIDHeader 请求ID所在的HTTP请求头和响应头
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDKey 请求ID在gin上下文中的键
+	RequestIDKey = "request_id"
+	// maxRequestIDLength 客户端传入请求ID的最大长度，超过时重新生成
+	maxRequestIDLength = 128
+)
+
+// RequestIDMiddleware 请求ID中间件
+// 沿用客户端传入的合法X-Request-ID，缺失或不合法时生成新ID；
+// ID写入gin上下文和请求context，并通过响应头返回，下游gRPC调用经客户端拦截器透传
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = newRequestID()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Request = c.Request.WithContext(ctxkeys.WithRequestID(c.Request.Context(), requestID))
+		c.Header(RequestIDHeader, requestID)
+		c.Next()
+	}
+}
+
+// validRequestID 请求ID只允许可见ASCII字符，避免换行等字符污染日志和响应头
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < '!' || requestID[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID 生成32位十六进制随机请求ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"common/ctxkeys"
+)
+
+// serveRequestID 经RequestIDMiddleware处理请求，返回响应和处理函数看到的请求ID
+func serveRequestID(t *testing.T, header string) (*httptest.ResponseRecorder, string, string) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestIDMiddleware())
+
+	var fromGin, fromContext string
+	router.GET("/ping", func(c *gin.Context) {
+		fromGin = c.GetString(RequestIDKey)
+		fromContext, _ = ctxkeys.RequestID(c.Request.Context())
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	if header != "" {
+		req.Header.Set(RequestIDHeader, header)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w, fromGin, fromContext
+}
+
+func TestRequestIDMiddlewareGeneratesID(t *testing.T) {
+	w, fromGin, fromContext := serveRequestID(t, "")
+
+	requestID := w.Header().Get(RequestIDHeader)
+	if len(requestID) != 32 {
+		t.Fatalf("generated request id = %q, want 32 hex chars", requestID)
+	}
+	if fromGin != requestID || fromContext != requestID {
+		t.Errorf("handler saw gin=%q context=%q, want %q", fromGin, fromContext, requestID)
+	}
+
+	other, _, _ := serveRequestID(t, "")
+	if other.Header().Get(RequestIDHeader) == requestID {
+		t.Error("two requests got the same generated request id")
+	}
+}
+
+func TestRequestIDMiddlewareKeepsClientID(t *testing.T) {
+	w, fromGin, fromContext := serveRequestID(t, "req-abc-123")
+
+	if got := w.Header().Get(RequestIDHeader); got != "req-abc-123" {
+		t.Errorf("response header = %q, want client request id", got)
+	}
+	if fromGin != "req-abc-123" || fromContext != "req-abc-123" {
+		t.Errorf("handler saw gin=%q context=%q, want client request id", fromGin, fromContext)
+	}
+}
+
+func TestRequestIDMiddlewareReplacesInvalidID(t *testing.T) {
+	for _, header := range []string{"bad id", "bad\tid", strings.Repeat("a", maxRequestIDLength+1)} {
+		w, _, _ := serveRequestID(t, header)
+		if got := w.Header().Get(RequestIDHeader); got == header || len(got) != 32 {
+			t.Errorf("request id for %q = %q, want newly generated id", header, got)
+		}
+	}
+}
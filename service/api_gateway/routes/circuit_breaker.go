@@ -0,0 +1,130 @@
+package routes
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// 熔断器默认参数
+const (
+	defaultFailureThreshold = 3
+	defaultCooldown         = 30 * time.Second
+)
+
+// circuitState 熔断器状态
+type circuitState int
+
+const (
+	circuitClosed   circuitState = iota // 关闭：正常放行
+	circuitOpen                         // 开启：拒绝所有请求
+	circuitHalfOpen                     // 半开：只放行一个探测请求
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreaker 熔断器
+// 连续失败达到阈值后开启，冷却时间过后进入半开状态并只放行一个探测请求，
+// 探测成功则关闭，失败则重新开启，避免冷却结束后大量请求同时涌入
+type CircuitBreaker struct {
+	failureThreshold int
+	cooldown         time.Duration
+
+	state     circuitState
+	failCount int
+	openedAt  time.Time
+	probing   bool // 半开状态下是否已有探测请求在执行
+	mutex     sync.Mutex
+}
+
+// NewCircuitBreaker 创建熔断器，参数不合法时使用默认值
+func NewCircuitBreaker(failureThreshold int, cooldown time.Duration) *CircuitBreaker {
+	if failureThreshold <= 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCooldown
+	}
+	return &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		state:            circuitClosed,
+	}
+}
+
+// CanExecute 检查是否可以执行请求
+// 返回true后调用方必须调用RecordSuccess或RecordFailure上报结果
+func (cb *CircuitBreaker) CanExecute() bool {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.cooldown {
+			return false
+		}
+		cb.setState(circuitHalfOpen)
+		cb.probing = true
+		return true
+	case circuitHalfOpen:
+		if cb.probing {
+			return false
+		}
+		cb.probing = true
+		return true
+	}
+	return true
+}
+
+// RecordSuccess 记录成功，半开状态下探测成功则关闭熔断器
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.failCount = 0
+	cb.probing = false
+	cb.setState(circuitClosed)
+}
+
+// RecordFailure 记录失败，半开状态下探测失败立即重新开启
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.failCount++
+	cb.probing = false
+
+	if cb.state == circuitHalfOpen || cb.failCount >= cb.failureThreshold {
+		cb.openedAt = time.Now()
+		if cb.state != circuitOpen {
+			log.Printf("Circuit breaker opened due to %d consecutive failures", cb.failCount)
+		}
+		cb.setState(circuitOpen)
+	}
+}
+
+// State 返回当前状态
+func (cb *CircuitBreaker) State() string {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	return cb.state.String()
+}
+
+// setState 切换状态，调用方需持有锁
+func (cb *CircuitBreaker) setState(state circuitState) {
+	if cb.state == state {
+		return
+	}
+	log.Printf("Circuit breaker state changed from %s to %s", cb.state, state)
+	cb.state = state
+}
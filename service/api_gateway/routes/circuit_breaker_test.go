@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testCooldown = 20 * time.Millisecond
+
+// openBreaker 返回已开启且冷却时间已过的熔断器
+func openBreaker(t *testing.T) *CircuitBreaker {
+	t.Helper()
+	cb := NewCircuitBreaker(2, testCooldown)
+	cb.RecordFailure()
+	cb.RecordFailure()
+	if cb.State() != "open" {
+		t.Fatalf("state = %s after threshold failures, want open", cb.State())
+	}
+	if cb.CanExecute() {
+		t.Fatal("open breaker allowed a request during cooldown")
+	}
+	time.Sleep(testCooldown + 5*time.Millisecond)
+	return cb
+}
+
+func TestCircuitBreakerOpensAfterThreshold(t *testing.T) {
+	cb := NewCircuitBreaker(3, testCooldown)
+	cb.RecordFailure()
+	cb.RecordFailure()
+	if cb.State() != "closed" || !cb.CanExecute() {
+		t.Fatalf("state = %s below threshold, want closed and executable", cb.State())
+	}
+	// 成功会清零连续失败计数
+	cb.RecordSuccess()
+	cb.RecordFailure()
+	cb.RecordFailure()
+	if cb.State() != "closed" {
+		t.Fatalf("state = %s after success reset, want closed", cb.State())
+	}
+	cb.RecordFailure()
+	if cb.State() != "open" {
+		t.Fatalf("state = %s at threshold, want open", cb.State())
+	}
+}
+
+func TestCircuitBreakerHalfOpenAllowsSingleConcurrentProbe(t *testing.T) {
+	cb := openBreaker(t)
+
+	const callers = 50
+	var allowed int32
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if cb.CanExecute() {
+				atomic.AddInt32(&allowed, 1)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if allowed != 1 {
+		t.Fatalf("half-open breaker allowed %d concurrent probes, want 1", allowed)
+	}
+	if cb.State() != "half-open" {
+		t.Fatalf("state = %s while probing, want half-open", cb.State())
+	}
+}
+
+func TestCircuitBreakerProbeSuccessCloses(t *testing.T) {
+	cb := openBreaker(t)
+	if !cb.CanExecute() {
+		t.Fatal("breaker did not allow a probe after cooldown")
+	}
+	cb.RecordSuccess()
+
+	if cb.State() != "closed" {
+		t.Fatalf("state = %s after successful probe, want closed", cb.State())
+	}
+	for i := 0; i < 3; i++ {
+		if !cb.CanExecute() {
+			t.Fatal("closed breaker rejected a request")
+		}
+	}
+}
+
+func TestCircuitBreakerProbeFailureReopens(t *testing.T) {
+	cb := openBreaker(t)
+	if !cb.CanExecute() {
+		t.Fatal("breaker did not allow a probe after cooldown")
+	}
+	cb.RecordFailure()
+
+	// 探测失败立即重新开启并重新计算冷却时间
+	if cb.State() != "open" {
+		t.Fatalf("state = %s after failed probe, want open", cb.State())
+	}
+	if cb.CanExecute() {
+		t.Fatal("reopened breaker allowed a request before the new cooldown")
+	}
+	time.Sleep(testCooldown + 5*time.Millisecond)
+	if !cb.CanExecute() {
+		t.Fatal("breaker did not allow a new probe after the second cooldown")
+	}
+}
@@ -0,0 +1,93 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusClientClosedRequest 客户端取消请求，HTTP标准中没有对应状态码，沿用nginx的499
+const statusClientClosedRequest = 499
+
+// HTTPStatusFromCode 将gRPC状态码映射为HTTP状态码
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return statusClientClosedRequest
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		// Unknown、Internal、DataLoss
+		return http.StatusInternalServerError
+	}
+}
+
+// isServiceUnavailable 判断下游调用是否因服务不可用或超时失败
+func isServiceUnavailable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// respondError 返回统一格式的错误响应，code与成功响应一致使用gRPC状态码，成功时为0
+func respondError(c *gin.Context, httpStatus int, code codes.Code, msg string) {
+	c.JSON(httpStatus, gin.H{
+		"code": int(code),
+		"msg":  msg,
+	})
+}
+
+// respondGRPCError 根据下游gRPC调用的错误返回对应的HTTP状态码和错误信息
+// 服务端内部错误不向客户端暴露细节，使用fallback作为提示
+func respondGRPCError(c *gin.Context, method string, err error, fallback string) {
+	var st *status.Status
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	switch {
+	case errors.As(err, &grpcErr):
+		// 被包装的gRPC错误取原始状态，避免包装前缀和状态码文本出现在响应中
+		st = grpcErr.GRPCStatus()
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+		st = status.FromContextError(err)
+	default:
+		st = status.Convert(err)
+	}
+	log.Printf("%s error: code=%s, message=%s", method, st.Code(), st.Message())
+
+	msg := st.Message()
+	switch st.Code() {
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		msg = fallback
+	}
+	if msg == "" {
+		msg = fallback
+	}
+	respondError(c, HTTPStatusFromCode(st.Code()), st.Code(), msg)
+}
@@ -0,0 +1,113 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Canceled, 499},
+		{codes.Unknown, http.StatusInternalServerError},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Aborted, http.StatusConflict},
+		{codes.OutOfRange, http.StatusBadRequest},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DataLoss, http.StatusInternalServerError},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.Code(99), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		if got := HTTPStatusFromCode(tt.code); got != tt.want {
+			t.Errorf("HTTPStatusFromCode(%s) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestRespondGRPCError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const fallback = "获取用户信息失败"
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   codes.Code
+		wantMsg    string
+	}{
+		{"not found", status.Error(codes.NotFound, "用户不存在"), http.StatusNotFound, codes.NotFound, "用户不存在"},
+		{"invalid argument", status.Error(codes.InvalidArgument, "手机号格式错误"), http.StatusBadRequest, codes.InvalidArgument, "手机号格式错误"},
+		{"unauthenticated", status.Error(codes.Unauthenticated, "token已过期"), http.StatusUnauthorized, codes.Unauthenticated, "token已过期"},
+		{"rate limited", status.Error(codes.ResourceExhausted, "发送过于频繁"), http.StatusTooManyRequests, codes.ResourceExhausted, "发送过于频繁"},
+		{"wrapped status", fmt.Errorf("call user service: %w", status.Error(codes.PermissionDenied, "无权限")), http.StatusForbidden, codes.PermissionDenied, "无权限"},
+		// 内部错误不向客户端暴露细节
+		{"internal", status.Error(codes.Internal, "dial tcp 10.0.0.3:3306: connection refused"), http.StatusInternalServerError, codes.Internal, fallback},
+		{"data loss", status.Error(codes.DataLoss, "corrupt row"), http.StatusInternalServerError, codes.DataLoss, fallback},
+		{"plain error", errors.New("boom"), http.StatusInternalServerError, codes.Unknown, fallback},
+		{"empty message", status.Error(codes.Unavailable, ""), http.StatusServiceUnavailable, codes.Unavailable, fallback},
+		{"deadline", context.DeadlineExceeded, http.StatusGatewayTimeout, codes.DeadlineExceeded, context.DeadlineExceeded.Error()},
+		{"wrapped cancel", fmt.Errorf("call: %w", context.Canceled), 499, codes.Canceled, "call: " + context.Canceled.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			respondGRPCError(c, "GetUserInfo", tt.err, fallback)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("HTTP status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			var body struct {
+				Code int    `json:"code"`
+				Msg  string `json:"msg"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body %s: %v", w.Body.String(), err)
+			}
+			if body.Code != int(tt.wantCode) || body.Msg != tt.wantMsg {
+				t.Errorf("body = %+v, want code %d msg %q", body, tt.wantCode, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestIsServiceUnavailable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{status.Error(codes.Unavailable, "no healthy upstream"), true},
+		{status.Error(codes.DeadlineExceeded, "timeout"), true},
+		{fmt.Errorf("call: %w", context.DeadlineExceeded), true},
+		{status.Error(codes.NotFound, "用户不存在"), false},
+		{status.Error(codes.Internal, "db down"), false},
+		{context.Canceled, false},
+	}
+	for _, tt := range tests {
+		if got := isServiceUnavailable(tt.err); got != tt.want {
+			t.Errorf("isServiceUnavailable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package routes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 用户信息兜底缓存默认参数
+const (
+	defaultUserInfoCacheTTL        = 5 * time.Minute
+	defaultUserInfoCacheMaxEntries = 10000
+)
+
+// userInfoCacheEntry 缓存的用户信息
+type userInfoCacheEntry struct {
+	user     gin.H
+	storedAt time.Time
+}
+
+// userInfoCache 用户信息兜底缓存
+// 只在用户服务不可用时使用，返回的数据可能已过时，超过ttl的数据不再使用
+type userInfoCache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[uint32]userInfoCacheEntry
+}
+
+// newUserInfoCache 创建用户信息兜底缓存，参数不合法时使用默认值
+func newUserInfoCache(ttl time.Duration, maxEntries int) *userInfoCache {
+	if ttl <= 0 {
+		ttl = defaultUserInfoCacheTTL
+	}
+	if maxEntries <= 0 {
+		maxEntries = defaultUserInfoCacheMaxEntries
+	}
+	return &userInfoCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[uint32]userInfoCacheEntry),
+	}
+}
+
+// Set 缓存用户信息，user写入后不应再修改
+func (c *userInfoCache) Set(userID uint32, user gin.H) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := c.entries[userID]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[userID] = userInfoCacheEntry{user: user, storedAt: now}
+}
+
+// Get 获取未超过ttl的用户信息
+func (c *userInfoCache) Get(userID uint32) (gin.H, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil, false
+	}
+	if time.Since(entry.storedAt) > c.ttl {
+		delete(c.entries, userID)
+		return nil, false
+	}
+	return entry.user, true
+}
+
+// evict 缓存已满时腾出空间：先清理过期数据，仍然已满时淘汰最早写入的一条，调用方需持有锁
+func (c *userInfoCache) evict(now time.Time) {
+	var oldestID uint32
+	var oldestAt time.Time
+	for id, entry := range c.entries {
+		if now.Sub(entry.storedAt) > c.ttl {
+			delete(c.entries, id)
+			continue
+		}
+		if oldestAt.IsZero() || entry.storedAt.Before(oldestAt) {
+			oldestID, oldestAt = id, entry.storedAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestID)
+	}
+}
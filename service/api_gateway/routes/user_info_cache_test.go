@@ -0,0 +1,232 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pb "api_gateway/proto/proto_gen/proto"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserInfoCacheGet(t *testing.T) {
+	cache := newUserInfoCache(time.Minute, 10)
+	cache.Set(1, gin.H{"name": "alice"})
+
+	user, ok := cache.Get(1)
+	if !ok || user["name"] != "alice" {
+		t.Fatalf("Get = (%v, %v), want the cached user", user, ok)
+	}
+	if _, ok := cache.Get(2); ok {
+		t.Error("Get returned a user that was never cached")
+	}
+
+	// 超过ttl的数据不再兜底返回并被删除
+	cache.entries[1] = userInfoCacheEntry{user: user, storedAt: time.Now().Add(-2 * time.Minute)}
+	if _, ok := cache.Get(1); ok {
+		t.Error("Get returned an expired user")
+	}
+	if len(cache.entries) != 0 {
+		t.Errorf("entries = %d, want the expired user removed", len(cache.entries))
+	}
+}
+
+func TestUserInfoCacheEviction(t *testing.T) {
+	cache := newUserInfoCache(time.Minute, 2)
+	cache.Set(1, gin.H{"name": "oldest"})
+	cache.Set(2, gin.H{"name": "newer"})
+	cache.entries[1] = userInfoCacheEntry{user: cache.entries[1].user, storedAt: time.Now().Add(-30 * time.Second)}
+
+	// 更新已缓存的用户不淘汰其他数据
+	cache.Set(2, gin.H{"name": "updated"})
+	if len(cache.entries) != 2 {
+		t.Fatalf("entries = %d after updating a cached user, want 2", len(cache.entries))
+	}
+
+	// 已满时淘汰最早写入的一条
+	cache.Set(3, gin.H{"name": "new"})
+	if _, ok := cache.Get(1); ok {
+		t.Error("oldest user was not evicted")
+	}
+	if user, ok := cache.Get(2); !ok || user["name"] != "updated" {
+		t.Errorf("Get(2) = (%v, %v), want the updated user kept", user, ok)
+	}
+	if _, ok := cache.Get(3); !ok {
+		t.Error("new user was not cached")
+	}
+}
+
+func TestUserInfoCacheEvictsExpiredFirst(t *testing.T) {
+	cache := newUserInfoCache(time.Minute, 3)
+	for id := uint32(1); id <= 3; id++ {
+		cache.Set(id, gin.H{"id": id})
+	}
+	cache.entries[1] = userInfoCacheEntry{storedAt: time.Now().Add(-10 * time.Second)}
+	cache.entries[2] = userInfoCacheEntry{storedAt: time.Now().Add(-2 * time.Minute)}
+	cache.entries[3] = userInfoCacheEntry{storedAt: time.Now().Add(-3 * time.Minute)}
+
+	cache.Set(4, gin.H{"id": 4})
+	// 过期数据全部清理后已有空间，未过期的最早数据保留
+	if len(cache.entries) != 2 {
+		t.Fatalf("entries = %d, want the two expired users removed", len(cache.entries))
+	}
+	if _, ok := cache.entries[1]; !ok {
+		t.Error("live user evicted although expired users freed space")
+	}
+}
+
+func TestNewUserInfoCacheDefaults(t *testing.T) {
+	cache := newUserInfoCache(0, -1)
+	if cache.ttl != defaultUserInfoCacheTTL || cache.maxEntries != defaultUserInfoCacheMaxEntries {
+		t.Errorf("cache = ttl %v max %d, want the defaults", cache.ttl, cache.maxEntries)
+	}
+}
+
+// stubUserServer 返回预设结果的用户服务
+type stubUserServer struct {
+	pb.UnimplementedUserServiceServer
+
+	mu  sync.Mutex
+	err error
+}
+
+func (s *stubUserServer) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *stubUserServer) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.UserResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pb.UserResponse{User: &pb.User{Id: req.UserId, Name: "alice"}}, nil
+}
+
+// newUserInfoTestHandler 连接本地用户服务的处理器
+func newUserInfoTestHandler(t *testing.T) (*UserHandler, *stubUserServer) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := &stubUserServer{}
+	server := grpc.NewServer()
+	pb.RegisterUserServiceServer(server, srv)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	h := &UserHandler{
+		serviceAddr:    lis.Addr().String(),
+		circuitBreaker: NewCircuitBreaker(1, time.Hour),
+		userInfoCache:  newUserInfoCache(time.Minute, 10),
+		creds:          insecure.NewCredentials(),
+	}
+	t.Cleanup(func() { h.Close() })
+	return h, srv
+}
+
+// userInfoResponse GetUserInfo的响应体
+type userInfoResponse struct {
+	Code  int                    `json:"code"`
+	Data  map[string]interface{} `json:"data"`
+	Stale bool                   `json:"stale"`
+}
+
+// getUserInfo 请求用户信息，返回HTTP状态码和响应体
+func getUserInfo(t *testing.T, h *UserHandler, id string) (int, userInfoResponse) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/user/:id", h.GetUserInfo)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/user/"+id, nil))
+	var body userInfoResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response %s: %v", w.Body.String(), err)
+	}
+	return w.Code, body
+}
+
+func TestGetUserInfoServesStaleWhenUnavailable(t *testing.T) {
+	h, srv := newUserInfoTestHandler(t)
+
+	code, body := getUserInfo(t, h, "7")
+	if code != http.StatusOK || body.Stale || body.Data["name"] != "alice" {
+		t.Fatalf("fresh response = %d %+v, want the user without stale", code, body)
+	}
+
+	for _, err := range []error{
+		status.Error(codes.Unavailable, "connection refused"),
+		status.Error(codes.DeadlineExceeded, "timeout"),
+	} {
+		srv.setErr(err)
+		code, body = getUserInfo(t, h, "7")
+		if code != http.StatusOK || body.Code != 0 || !body.Stale || body.Data["name"] != "alice" {
+			t.Errorf("response for %v = %d %+v, want the cached user flagged stale", err, code, body)
+		}
+	}
+}
+
+func TestGetUserInfoUnavailableWithoutCache(t *testing.T) {
+	h, srv := newUserInfoTestHandler(t)
+	srv.setErr(status.Error(codes.Unavailable, "connection refused"))
+
+	code, body := getUserInfo(t, h, "7")
+	if code != http.StatusServiceUnavailable || body.Stale {
+		t.Errorf("response = %d %+v, want 503 without stale data", code, body)
+	}
+}
+
+func TestGetUserInfoDoesNotServeStaleForOtherErrors(t *testing.T) {
+	h, srv := newUserInfoTestHandler(t)
+	if code, _ := getUserInfo(t, h, "7"); code != http.StatusOK {
+		t.Fatalf("fresh response = %d, want 200", code)
+	}
+
+	// 业务错误说明用户服务可用，不能用缓存掩盖
+	srv.setErr(status.Error(codes.NotFound, "user not found"))
+	code, body := getUserInfo(t, h, "7")
+	if code != http.StatusNotFound || body.Stale {
+		t.Errorf("response = %d %+v, want 404 without stale data", code, body)
+	}
+}
+
+func TestGetUserInfoServesStaleWhenBreakerOpen(t *testing.T) {
+	h, _ := newUserInfoTestHandler(t)
+	if code, _ := getUserInfo(t, h, "7"); code != http.StatusOK {
+		t.Fatalf("fresh response = %d, want 200", code)
+	}
+
+	// 连接断开后熔断器开启，无法创建新的客户端
+	h.Close()
+	h.userClient = nil
+	h.circuitBreaker.RecordFailure()
+
+	code, body := getUserInfo(t, h, "7")
+	if code != http.StatusOK || !body.Stale || body.Data["name"] != "alice" {
+		t.Errorf("cached user response = %d %+v, want the cached user flagged stale", code, body)
+	}
+	if code, body := getUserInfo(t, h, "8"); code != http.StatusServiceUnavailable || body.Stale {
+		t.Errorf("uncached user response = %d %+v, want 503", code, body)
+	}
+
+	// 超过ttl的数据不再兜底返回
+	h.userInfoCache.entries[7] = userInfoCacheEntry{user: gin.H{"name": "alice"}, storedAt: time.Now().Add(-2 * time.Minute)}
+	if code, _ := getUserInfo(t, h, "7"); code != http.StatusServiceUnavailable {
+		t.Errorf("expired cache response = %d, want 503", code)
+	}
+}
@@ -11,65 +11,21 @@ import (
 	"time"
 
 	"api_gateway/client"
+	"api_gateway/config"
 	"api_gateway/discovery"
 	pb "api_gateway/proto/proto_gen/proto"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
-// CircuitBreaker 熔断器
-type CircuitBreaker struct {
-	failCount    int
-	lastFailTime time.Time
-	isOpen       bool
-	mutex        sync.Mutex
-}
-
-// NewCircuitBreaker 创建熔断器
-func NewCircuitBreaker() *CircuitBreaker {
-	return &CircuitBreaker{
-		lastFailTime: time.Now(),
-	}
-}
-
-// CanExecute 检查是否可以执行请求
-func (cb *CircuitBreaker) CanExecute() bool {
-	cb.mutex.Lock()
-	defer cb.mutex.Unlock()
-
-	if cb.isOpen {
-		// 熔断器开启，检查是否过了冷却时间（30秒）
-		if time.Since(cb.lastFailTime) > 30*time.Second {
-			cb.isOpen = false
-			cb.failCount = 0
-			return true
-		}
-		return false
-	}
-	return true
-}
-
-// RecordSuccess 记录成功
-func (cb *CircuitBreaker) RecordSuccess() {
-	cb.mutex.Lock()
-	defer cb.mutex.Unlock()
-	cb.failCount = 0
-	cb.isOpen = false
-}
+// clientIPMetadataKey 向下游服务透传客户端IP的gRPC元数据键
+const clientIPMetadataKey = "x-client-ip"
 
-// RecordFailure 记录失败
-func (cb *CircuitBreaker) RecordFailure() {
-	cb.mutex.Lock()
-	defer cb.mutex.Unlock()
-	cb.failCount++
-	cb.lastFailTime = time.Now()
-
-	// 连续失败3次开启熔断器
-	if cb.failCount >= 3 {
-		cb.isOpen = true
-		log.Printf("Circuit breaker opened due to %d consecutive failures", cb.failCount)
-	}
-}
+// userClientDrainTimeout 摘除旧连接时等待在途请求的最长时间，与单次请求超时保持一致
+const userClientDrainTimeout = 10 * time.Second
 
 // UserHandler 用户处理器
 type UserHandler struct {
@@ -80,10 +36,24 @@ type UserHandler struct {
 	mu             sync.RWMutex
 	lastFailTime   time.Time
 	circuitBreaker *CircuitBreaker
+	userInfoCache  *userInfoCache
+	creds          credentials.TransportCredentials
 }
 
 // NewUserHandler 创建用户处理器
-func NewUserHandler(etcdEndpoints []string) (*UserHandler, error) {
+func NewUserHandler(etcdEndpoints []string, breakerCfg config.CircuitBreakerConfig, cacheCfg config.UserInfoCacheConfig, tlsCfg config.GRPCTLSConfig) (*UserHandler, error) {
+	// 连接用户服务的凭证，未开启TLS时为明文
+	creds, err := client.TransportCredentials(client.TLSOptions{
+		Enabled:    tlsCfg.Enabled,
+		CAFile:     tlsCfg.CAFile,
+		CertFile:   tlsCfg.CertFile,
+		KeyFile:    tlsCfg.KeyFile,
+		ServerName: tlsCfg.ServerName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// 创建服务发现客户端
 	serviceDiscovery, err := discovery.NewEtcdServiceDiscovery(etcdEndpoints, "user-service")
 	if err != nil {
@@ -93,7 +63,9 @@ func NewUserHandler(etcdEndpoints []string) (*UserHandler, error) {
 	handler := &UserHandler{
 		etcdEndpoints:  etcdEndpoints,
 		discovery:      serviceDiscovery,
-		circuitBreaker: NewCircuitBreaker(),
+		circuitBreaker: NewCircuitBreaker(breakerCfg.FailureThreshold, breakerCfg.Cooldown),
+		userInfoCache:  newUserInfoCache(cacheCfg.TTL, cacheCfg.MaxEntries),
+		creds:          creds,
 	}
 
 	// 监听服务变化
@@ -112,9 +84,9 @@ func (h *UserHandler) onServiceChange(serviceAddr string, isAdded bool) {
 			log.Printf("User service address changed from %s to %s", h.serviceAddr, serviceAddr)
 			h.serviceAddr = serviceAddr
 
-			// 关闭旧连接
+			// 旧连接等待在途请求完成后再关闭，新请求使用新地址
 			if h.userClient != nil {
-				h.userClient.Close()
+				h.userClient.Drain(userClientDrainTimeout)
 				h.userClient = nil
 			}
 
@@ -126,7 +98,7 @@ func (h *UserHandler) onServiceChange(serviceAddr string, isAdded bool) {
 		if serviceAddr == h.serviceAddr {
 			h.serviceAddr = ""
 			if h.userClient != nil {
-				h.userClient.Close()
+				h.userClient.Drain(userClientDrainTimeout)
 				h.userClient = nil
 			}
 		}
@@ -167,7 +139,7 @@ func (h *UserHandler) getUserClient() (*client.UserServiceClient, error) {
 	}
 
 	// 创建客户端
-	userClient, err := client.NewUserServiceClient(h.serviceAddr)
+	userClient, err := client.NewUserServiceClient(h.serviceAddr, h.creds)
 	if err != nil {
 		h.circuitBreaker.RecordFailure()
 		return nil, fmt.Errorf("failed to create user service client: %v", err)
@@ -183,31 +155,31 @@ func (h *UserHandler) getUserClient() (*client.UserServiceClient, error) {
 func (h *UserHandler) PhoneLogin(c *gin.Context) {
 	var req pb.PhoneLoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		respondError(c, http.StatusBadRequest, codes.InvalidArgument, "Invalid request")
 		return
 	}
 
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	resp, err := userClient.PhoneLogin(ctx, &req)
 	if err != nil {
-		log.Printf("PhoneLogin error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Login failed"})
+		respondGRPCError(c, "PhoneLogin", err, "Login failed")
 		return
 	}
 
 	// 转换为前端期望的格式
 	loginResponse := gin.H{
-		"status_msg": resp.StatusMsg,
-		"token":      resp.Token,
+		"status_msg":    resp.StatusMsg,
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
 	}
 
 	// 如果有用户信息，添加到响应中
@@ -242,31 +214,31 @@ func (h *UserHandler) PhoneLogin(c *gin.Context) {
 func (h *UserHandler) CodeLogin(c *gin.Context) {
 	var req pb.CodeLoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		respondError(c, http.StatusBadRequest, codes.InvalidArgument, "Invalid request")
 		return
 	}
 
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	resp, err := userClient.CodeLogin(ctx, &req)
 	if err != nil {
-		log.Printf("CodeLogin error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Login failed"})
+		respondGRPCError(c, "CodeLogin", err, "Login failed")
 		return
 	}
 
 	// 转换为前端期望的格式
 	loginResponse := gin.H{
-		"status_msg": resp.StatusMsg,
-		"token":      resp.Token,
+		"status_msg":    resp.StatusMsg,
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
 	}
 
 	// 如果有用户信息，添加到响应中
@@ -301,7 +273,7 @@ func (h *UserHandler) CodeLogin(c *gin.Context) {
 func (h *UserHandler) SendSmsCode(c *gin.Context) {
 	var req pb.SendSmsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		respondError(c, http.StatusBadRequest, codes.InvalidArgument, "Invalid request")
 		return
 	}
 
@@ -313,17 +285,18 @@ func (h *UserHandler) SendSmsCode(c *gin.Context) {
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
+	// 透传客户端IP，用户服务据此识别同一IP轮换手机号刷短信
+	ctx = metadata.AppendToOutgoingContext(ctx, clientIPMetadataKey, c.ClientIP())
 
 	resp, err := userClient.SendSmsCode(ctx, &req)
 	if err != nil {
-		log.Printf("SendSmsCode error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send SMS"})
+		respondGRPCError(c, "SendSmsCode", err, "Failed to send SMS")
 		return
 	}
 
@@ -345,7 +318,7 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 		// 从路径参数获取ID
 		id, err := strconv.ParseUint(userIdStr, 10, 32)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user id"})
+			respondError(c, http.StatusBadRequest, codes.InvalidArgument, "Invalid user id")
 			return
 		}
 		userId = uint32(id)
@@ -354,7 +327,7 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 		// 这里简化处理，实际应该从认证中间件中获取
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Missing authorization token"})
+			respondError(c, http.StatusUnauthorized, codes.Unauthenticated, "Missing authorization token")
 			return
 		}
 
@@ -376,17 +349,23 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		if h.respondStaleUserInfo(c, userId) {
+			return
+		}
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	resp, err := userClient.GetUserInfo(ctx, req)
 	if err != nil {
-		log.Printf("GetUserInfo error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
+		if isServiceUnavailable(err) && h.respondStaleUserInfo(c, userId) {
+			log.Printf("GetUserInfo error, served stale user info: %v", err)
+			return
+		}
+		respondGRPCError(c, "GetUserInfo", err, "Failed to get user info")
 		return
 	}
 
@@ -407,6 +386,7 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 		"last_login_time":  resp.User.LastLoginTime,
 		"user_type":        resp.User.UserType,
 	}
+	h.userInfoCache.Set(userId, userResponse)
 
 	c.JSON(http.StatusOK, gin.H{
 		"code": 0,
@@ -415,6 +395,21 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 	})
 }
 
+// respondStaleUserInfo 用户服务不可用时返回缓存的用户信息并标记stale，没有可用缓存时返回false
+func (h *UserHandler) respondStaleUserInfo(c *gin.Context, userID uint32) bool {
+	user, ok := h.userInfoCache.Get(userID)
+	if !ok {
+		return false
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"code":  0,
+		"msg":   "success",
+		"data":  user,
+		"stale": true,
+	})
+	return true
+}
+
 // Close 关闭处理器
 func (h *UserHandler) Close() error {
 	h.mu.Lock()
@@ -430,24 +425,23 @@ func (h *UserHandler) Close() error {
 func (h *UserHandler) VerifyToken(c *gin.Context) {
 	var req pb.VerifyTokenRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		respondError(c, http.StatusBadRequest, codes.InvalidArgument, "Invalid request")
 		return
 	}
 
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	resp, err := userClient.VerifyToken(ctx, &req)
 	if err != nil {
-		log.Printf("VerifyToken error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Token verification failed"})
+		respondGRPCError(c, "VerifyToken", err, "Token verification failed")
 		return
 	}
 
@@ -462,24 +456,23 @@ func (h *UserHandler) VerifyToken(c *gin.Context) {
 func (h *UserHandler) RefreshToken(c *gin.Context) {
 	var req pb.RefreshTokenRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		respondError(c, http.StatusBadRequest, codes.InvalidArgument, "Invalid request")
 		return
 	}
 
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	resp, err := userClient.RefreshToken(ctx, &req)
 	if err != nil {
-		log.Printf("RefreshToken error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Token refresh failed"})
+		respondGRPCError(c, "RefreshToken", err, "Token refresh failed")
 		return
 	}
 
@@ -495,7 +488,7 @@ func (h *UserHandler) Logout(c *gin.Context) {
 	// 从请求头获取token
 	token := c.GetHeader("Authorization")
 	if token == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing authorization token"})
+		respondError(c, http.StatusUnauthorized, codes.Unauthenticated, "Missing authorization token")
 		return
 	}
 
@@ -511,17 +504,16 @@ func (h *UserHandler) Logout(c *gin.Context) {
 	userClient, err := h.getUserClient()
 	if err != nil {
 		log.Printf("Failed to get user service client: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User service temporarily unavailable"})
+		respondError(c, http.StatusServiceUnavailable, codes.Unavailable, "User service temporarily unavailable")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	resp, err := userClient.LogOut(ctx, req)
 	if err != nil {
-		log.Printf("Logout error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Logout failed"})
+		respondGRPCError(c, "Logout", err, "Logout failed")
 		return
 	}
 
@@ -16,9 +16,15 @@ type Config struct {
 	Redis    RedisConfig    `mapstructure:"redis"`
 	Logger   LoggerConfig   `mapstructure:"logger"`
 	Etcd     EtcdConfig     `mapstructure:"etcd"`
+	Health   HealthConfig   `mapstructure:"health"`
 	Consul   ConsulConfig   `mapstructure:"consul"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	Audit    AuditConfig    `mapstructure:"audit"`
+
+	FeatureFlags FeatureFlagsConfig `mapstructure:"feature_flags"`
+	ConnectRetry ConnectRetryConfig `mapstructure:"connect_retry"`
+
+	UserNotification UserNotificationConfig `mapstructure:"user_notification"`
 }
 
 // ServerConfig 服务器配置
@@ -28,21 +34,47 @@ type ServerConfig struct {
 	Mode         string        `mapstructure:"mode"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// MaxRequestSize 单个请求最大字节数，0表示不限制
+	MaxRequestSize int `mapstructure:"max_request_size"`
+	// EnableReflection 是否注册gRPC反射服务，未配置时仅debug模式开启
+	EnableReflection *bool `mapstructure:"enable_reflection"`
+	// TLS gRPC服务端TLS配置，未开启时使用明文连接
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// ReflectionEnabled 是否注册gRPC反射服务
+// 反射会暴露完整的接口定义，release模式下需显式配置enable_reflection: true才会开启
+func (c ServerConfig) ReflectionEnabled() bool {
+	if c.EnableReflection != nil {
+		return *c.EnableReflection
+	}
+	return c.Mode == "debug"
+}
+
+// TLSConfig gRPC服务端TLS配置，未开启时使用明文连接，仅适用于本地开发，跨主机部署必须开启
+type TLSConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	CertFile     string `mapstructure:"cert_file"`      // 服务端证书
+	KeyFile      string `mapstructure:"key_file"`       // 服务端私钥
+	ClientAuth   bool   `mapstructure:"client_auth"`    // 要求并校验客户端证书(mTLS)
+	ClientCAFile string `mapstructure:"client_ca_file"` // 校验客户端证书的CA，开启ClientAuth时必填
 }
 
 // DatabaseConfig 数据库配置
 type DatabaseConfig struct {
-	Host            string `mapstructure:"host"`
-	Port            int    `mapstructure:"port"`
-	Username        string `mapstructure:"username"`
-	Password        string `mapstructure:"password"`
-	Database        string `mapstructure:"database"`
-	Charset         string `mapstructure:"charset"`
-	TablePrefix     string `mapstructure:"table_prefix"`
-	LogLevel        string `mapstructure:"log_level"`
-	MaxIdleConns    int    `mapstructure:"max_idle_conns"`
-	MaxOpenConns    int    `mapstructure:"max_open_conns"`
-	ConnMaxLifetime int    `mapstructure:"conn_max_lifetime"`
+	Host             string `mapstructure:"host"`
+	Port             int    `mapstructure:"port"`
+	Username         string `mapstructure:"username"`
+	Password         string `mapstructure:"password"`
+	Database         string `mapstructure:"database"`
+	Charset          string `mapstructure:"charset"`
+	TablePrefix      string `mapstructure:"table_prefix"`
+	LogLevel         string `mapstructure:"log_level"`
+	MaxIdleConns     int    `mapstructure:"max_idle_conns"`
+	MaxOpenConns     int    `mapstructure:"max_open_conns"`
+	ConnMaxLifetime  int    `mapstructure:"conn_max_lifetime"`
+	AutoMigrate      bool   `mapstructure:"auto_migrate"`      // 启动时自动迁移表结构
+	AllowDestructive bool   `mapstructure:"allow_destructive"` // 迁移时允许删除模型中已移除的列
 }
 
 // RedisConfig Redis配置
@@ -55,6 +87,13 @@ type RedisConfig struct {
 	DialTimeout  int    `mapstructure:"dial_timeout"`
 	ReadTimeout  int    `mapstructure:"read_timeout"`
 	WriteTimeout int    `mapstructure:"write_timeout"`
+
+	// 部署模式，single(默认)、sentinel或cluster
+	Mode             string   `mapstructure:"mode"`
+	MasterName       string   `mapstructure:"master_name"`       // sentinel模式的主节点名称
+	SentinelAddrs    []string `mapstructure:"sentinel_addrs"`    // sentinel模式的哨兵地址
+	SentinelPassword string   `mapstructure:"sentinel_password"` // 哨兵的认证密码，为空时不认证
+	ClusterNodes     []string `mapstructure:"cluster_nodes"`     // cluster模式的种子节点地址
 }
 
 // LoggerConfig 日志配置
@@ -62,6 +101,17 @@ type LoggerConfig struct {
 	Level      string `mapstructure:"level"`
 	Format     string `mapstructure:"format"`
 	OutputPath string `mapstructure:"output_path"`
+	MaxSize    int    `mapstructure:"max_size"`    // 单个日志文件最大大小(MB)
+	MaxAge     int    `mapstructure:"max_age"`     // 旧日志保留天数
+	MaxBackups int    `mapstructure:"max_backups"` // 旧日志最多保留个数
+	Compress   bool   `mapstructure:"compress"`    // 是否压缩旧日志
+}
+
+// HealthConfig 健康检查配置
+type HealthConfig struct {
+	HTTPPort      int           `mapstructure:"http_port"`      // HTTP探针端口，0表示不启动
+	CheckInterval time.Duration `mapstructure:"check_interval"` // 依赖检查间隔
+	CheckTimeout  time.Duration `mapstructure:"check_timeout"`  // 单个依赖检查超时
 }
 
 // EtcdConfig etcd配置
@@ -72,6 +122,13 @@ type EtcdConfig struct {
 	Password    string   `mapstructure:"password"`
 }
 
+// ConnectRetryConfig 启动时连接MySQL、Redis、etcd的重试配置
+type ConnectRetryConfig struct {
+	Attempts    int           `mapstructure:"attempts"`     // 最大尝试次数(含首次)，<=1时不重试
+	Interval    time.Duration `mapstructure:"interval"`     // 首次重试间隔，之后按指数翻倍
+	MaxInterval time.Duration `mapstructure:"max_interval"` // 重试间隔上限
+}
+
 // ConsulConfig Consul配置
 type ConsulConfig struct {
 	Host      string `mapstructure:"host"`
@@ -93,6 +150,15 @@ type AuditConfig struct {
 	ThirdParty   ThirdPartyConfig   `mapstructure:"third_party"`
 	Queue        QueueConfig        `mapstructure:"queue"`
 	Notification NotificationConfig `mapstructure:"notification"`
+	Report       ReportConfig       `mapstructure:"report"`
+	Levels       AuditLevelConfig   `mapstructure:"levels"`
+	Retention    RetentionConfig    `mapstructure:"retention"`
+
+	RepeatOffender RepeatOffenderConfig `mapstructure:"repeat_offender"`
+
+	// Keywords 按语言分组的敏感关键词，键为语言代码，所有分组都参与匹配
+	// 内容标题或正文命中时记录到审核结果并进入人工审核
+	Keywords map[string][]string `mapstructure:"keywords"`
 }
 
 // AuditStrategies 审核策略配置
@@ -107,10 +173,177 @@ type AuditStrategy struct {
 	Enabled               bool          `mapstructure:"enabled"`
 	SensitivityLevel      string        `mapstructure:"sensitivity_level"`
 	AutoBlockThreshold    float64       `mapstructure:"auto_block_threshold"`
+	AutoPassThreshold     float64       `mapstructure:"auto_pass_threshold"`
 	AllowAiReview         bool          `mapstructure:"allow_ai_review"`
 	ManualReviewThreshold float64       `mapstructure:"manual_review_threshold"`
 	FrameSampleRate       int           `mapstructure:"frame_sample_rate"`
 	AiReviewTimeout       time.Duration `mapstructure:"ai_review_timeout"`
+
+	// Thresholds 按内容类型(text/image/video/audio/live)覆盖自动拦截和自动通过阈值，未配置的类型使用上面的全局阈值
+	Thresholds map[string]AuditThresholds `mapstructure:"thresholds"`
+}
+
+// AuditThresholds 单个内容类型的自动拦截和自动通过阈值，两个阈值都需要配置
+type AuditThresholds struct {
+	AutoBlockThreshold float64 `mapstructure:"auto_block_threshold"`
+	AutoPassThreshold  float64 `mapstructure:"auto_pass_threshold"`
+}
+
+// thresholdContentTypes 允许单独配置阈值的内容类型
+var thresholdContentTypes = map[string]bool{
+	"text":  true,
+	"image": true,
+	"video": true,
+	"audio": true,
+	"live":  true,
+}
+
+// ThresholdsFor 获取内容类型使用的审核阈值，未单独配置时返回全局阈值
+func (s AuditStrategy) ThresholdsFor(contentType string) AuditThresholds {
+	if t, ok := s.Thresholds[contentType]; ok {
+		return t
+	}
+	return AuditThresholds{AutoBlockThreshold: s.AutoBlockThreshold, AutoPassThreshold: s.AutoPassThreshold}
+}
+
+// ValidateThresholds 校验全局和各内容类型的自动通过和自动拦截阈值
+func (s AuditStrategy) ValidateThresholds() error {
+	global := AuditThresholds{AutoBlockThreshold: s.AutoBlockThreshold, AutoPassThreshold: s.AutoPassThreshold}
+	if err := global.Validate(); err != nil {
+		return err
+	}
+	for contentType, t := range s.Thresholds {
+		if !thresholdContentTypes[contentType] {
+			return fmt.Errorf("thresholds: unsupported content type %q", contentType)
+		}
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("thresholds.%s: %w", contentType, err)
+		}
+	}
+	return nil
+}
+
+// Validate 校验自动通过和自动拦截阈值
+// 阈值取值范围为[0, 1]，AI评分<=自动通过阈值时自动通过，>=自动拦截阈值时自动拦截，两者之间进入人工审核，
+// 因此自动通过阈值必须小于自动拦截阈值
+func (t AuditThresholds) Validate() error {
+	if t.AutoPassThreshold < 0 || t.AutoPassThreshold > 1 {
+		return fmt.Errorf("auto_pass_threshold must be in [0, 1], got %v", t.AutoPassThreshold)
+	}
+	if t.AutoBlockThreshold < 0 || t.AutoBlockThreshold > 1 {
+		return fmt.Errorf("auto_block_threshold must be in [0, 1], got %v", t.AutoBlockThreshold)
+	}
+	if t.AutoPassThreshold >= t.AutoBlockThreshold {
+		return fmt.Errorf("auto_pass_threshold (%v) must be less than auto_block_threshold (%v)",
+			t.AutoPassThreshold, t.AutoBlockThreshold)
+	}
+	return nil
+}
+
+// 审核级别规则的比较方式
+const (
+	AuditLevelOpGTE = "gte" // 元数据字段值 >= Value 时命中
+	AuditLevelOpLT  = "lt"  // 元数据字段值 < Value 时命中
+)
+
+// AuditLevelConfig 审核级别配置
+type AuditLevelConfig struct {
+	Defaults map[string]string `mapstructure:"defaults"` // 内容类型的基础审核级别，覆盖内置默认值
+	Rules    []AuditLevelRule  `mapstructure:"rules"`    // 按元数据调整级别的规则，配置后替换内置规则
+}
+
+// AuditLevelRule 审核级别调整规则，命中的规则按Adjust累加
+type AuditLevelRule struct {
+	ContentTypes []string `mapstructure:"content_types"` // 适用的内容类型，为空时适用全部类型
+	Field        string   `mapstructure:"field"`         // 元数据字段：duration、prior_violations、follower_count
+	Op           string   `mapstructure:"op"`            // 比较方式：gte、lt
+	Value        float64  `mapstructure:"value"`         // 比较阈值
+	Adjust       int      `mapstructure:"adjust"`        // 级别调整，正数升级，负数降级
+}
+
+// Matches 判断规则是否命中，fields中缺少规则字段时不命中
+func (r AuditLevelRule) Matches(contentType string, fields map[string]float64) bool {
+	if len(r.ContentTypes) > 0 {
+		applicable := false
+		for _, t := range r.ContentTypes {
+			if strings.EqualFold(t, contentType) {
+				applicable = true
+				break
+			}
+		}
+		if !applicable {
+			return false
+		}
+	}
+
+	value, ok := fields[strings.ToLower(r.Field)]
+	if !ok {
+		return false
+	}
+	switch r.Op {
+	case AuditLevelOpGTE:
+		return value >= r.Value
+	case AuditLevelOpLT:
+		return value < r.Value
+	}
+	return false
+}
+
+// RepeatOffenderConfig 重复违规上传者的审核加严配置
+// 上传者在统计窗口内已审结的内容达到min_submissions且拒绝率达到rejection_rate时视为重复违规，
+// 其内容的AI评分加上score_boost后再与阈值比较，force_manual为true时不再自动通过
+type RepeatOffenderConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	Window         time.Duration `mapstructure:"window"`          // 统计审核历史的时间窗口
+	MinSubmissions int64         `mapstructure:"min_submissions"` // 窗口内已审结内容数的下限，不足时不判定
+	RejectionRate  float64       `mapstructure:"rejection_rate"`  // 拒绝率阈值，取值(0, 1]
+	ScoreBoost     float64       `mapstructure:"score_boost"`     // AI评分加成，取值[0, 1]，加成后不超过1
+	ForceManual    bool          `mapstructure:"force_manual"`    // 低风险内容也进入人工审核
+}
+
+// Validate 校验重复违规配置，未启用时不校验
+func (c RepeatOffenderConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("window must be positive, got %v", c.Window)
+	}
+	if c.MinSubmissions <= 0 {
+		return fmt.Errorf("min_submissions must be positive, got %d", c.MinSubmissions)
+	}
+	if c.RejectionRate <= 0 || c.RejectionRate > 1 {
+		return fmt.Errorf("rejection_rate must be in (0, 1], got %v", c.RejectionRate)
+	}
+	if c.ScoreBoost < 0 || c.ScoreBoost > 1 {
+		return fmt.Errorf("score_boost must be in [0, 1], got %v", c.ScoreBoost)
+	}
+	return nil
+}
+
+// FeatureFlagsConfig 特性开关配置
+type FeatureFlagsConfig struct {
+	RedisKey        string            `mapstructure:"redis_key"`        // Redis中覆盖值所在的hash键
+	RefreshInterval time.Duration     `mapstructure:"refresh_interval"` // 覆盖值刷新间隔
+	Defaults        map[string]string `mapstructure:"defaults"`         // 开关默认值，Redis中未覆盖时生效
+}
+
+// ReportConfig 用户举报配置
+type ReportConfig struct {
+	// EscalationThreshold 不同举报人数达到该值时，内容自动进入人工审核队列并提升为高级别
+	EscalationThreshold int `mapstructure:"escalation_threshold"`
+}
+
+// RetentionConfig 审核记录保留配置，超过保留时长的记录由后台任务清理
+type RetentionConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	DryRun    bool          `mapstructure:"dry_run"`    // 只记录符合清理条件的记录数，不实际删除
+	Interval  time.Duration `mapstructure:"interval"`   // 清理间隔
+	BatchSize int           `mapstructure:"batch_size"` // 单批删除的记录数
+	// Default 未单独配置的内容类型的保留时长，0表示永久保留
+	Default time.Duration `mapstructure:"default"`
+	// ContentTypes 按内容类型配置的保留时长，覆盖Default，0表示该类型永久保留
+	ContentTypes map[string]time.Duration `mapstructure:"content_types"`
 }
 
 // ThirdPartyConfig 第三方审核服务配置
@@ -127,7 +360,7 @@ type QueueConfig struct {
 	MaxRetryCount int           `mapstructure:"max_retry_count"`
 	RetryInterval time.Duration `mapstructure:"retry_interval"`
 	BatchSize     int           `mapstructure:"batch_size"`
-	WorkerCount   int           `mapstructure:"worker_count"`
+	WorkerCount   int           `mapstructure:"worker_count"` // 批量提交时并发处理的内容数，<=0时使用默认值
 }
 
 // NotificationConfig 审核结果通知配置
@@ -137,6 +370,13 @@ type NotificationConfig struct {
 	EmailRecipients []string `mapstructure:"email_recipients"`
 }
 
+// UserNotificationConfig 用户通知事件配置
+type UserNotificationConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`     // 是否发布用户通知事件
+	QueueKey   string `mapstructure:"queue_key"`   // 通知事件队列，需与消息服务一致
+	BufferSize int    `mapstructure:"buffer_size"` // 内存队列长度，队列满时丢弃新事件
+}
+
 // LoadConfig 加载配置
 func LoadConfig(configPath string) (*Config, error) {
 	v := viper.New()
@@ -215,6 +455,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("jwt token expiration must be positive")
 	}
 
+	if err := c.Audit.Strategies.Content.ValidateThresholds(); err != nil {
+		return fmt.Errorf("invalid content audit strategy: %w", err)
+	}
+
+	if err := c.Audit.RepeatOffender.Validate(); err != nil {
+		return fmt.Errorf("invalid repeat offender config: %w", err)
+	}
+
 	return nil
 }
 
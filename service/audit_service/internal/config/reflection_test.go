@@ -0,0 +1,44 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// loadServerConfig 按LoadConfig的方式用viper解析server配置段
+func loadServerConfig(t *testing.T, yaml string) ServerConfig {
+	t.Helper()
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("unmarshal config: %v", err)
+	}
+	return cfg.Server
+}
+
+func TestReflectionEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want bool
+	}{
+		{"debug default", "server:\n  mode: debug\n", true},
+		{"release default", "server:\n  mode: release\n", false},
+		{"mode missing", "server:\n  port: 50051\n", false},
+		{"release explicitly enabled", "server:\n  mode: release\n  enable_reflection: true\n", true},
+		{"debug explicitly disabled", "server:\n  mode: debug\n  enable_reflection: false\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := loadServerConfig(t, tt.yaml).ReflectionEnabled(); got != tt.want {
+				t.Errorf("ReflectionEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
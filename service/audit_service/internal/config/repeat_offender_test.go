@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRepeatOffenderConfigValidate(t *testing.T) {
+	valid := RepeatOffenderConfig{Enabled: true, Window: 30 * 24 * time.Hour, MinSubmissions: 5, RejectionRate: 0.5, ScoreBoost: 0.2}
+	tests := []struct {
+		name      string
+		modify    func(*RepeatOffenderConfig)
+		wantValid bool
+	}{
+		{"valid", func(c *RepeatOffenderConfig) {}, true},
+		// 未启用时不校验
+		{"disabled", func(c *RepeatOffenderConfig) { *c = RepeatOffenderConfig{} }, true},
+		{"no window", func(c *RepeatOffenderConfig) { c.Window = 0 }, false},
+		{"no min submissions", func(c *RepeatOffenderConfig) { c.MinSubmissions = 0 }, false},
+		{"zero rejection rate", func(c *RepeatOffenderConfig) { c.RejectionRate = 0 }, false},
+		{"rejection rate one", func(c *RepeatOffenderConfig) { c.RejectionRate = 1 }, true},
+		{"rejection rate above one", func(c *RepeatOffenderConfig) { c.RejectionRate = 1.1 }, false},
+		{"zero boost", func(c *RepeatOffenderConfig) { c.ScoreBoost = 0 }, true},
+		{"negative boost", func(c *RepeatOffenderConfig) { c.ScoreBoost = -0.1 }, false},
+		{"boost above one", func(c *RepeatOffenderConfig) { c.ScoreBoost = 1.5 }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+			if err := cfg.Validate(); (err == nil) != tt.wantValid {
+				t.Errorf("Validate(%+v) = %v, want valid %v", cfg, err, tt.wantValid)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestValidateThresholdsBoundaries(t *testing.T) {
+	tests := []struct {
+		name      string
+		pass      float64
+		block     float64
+		wantValid bool
+	}{
+		{"typical", 0.2, 0.8, true},
+		{"full range", 0, 1, true},
+		{"adjacent", 0.49, 0.5, true},
+		{"equal", 0.5, 0.5, false},
+		{"pass above block", 0.9, 0.8, false},
+		{"negative pass", -0.1, 0.8, false},
+		{"block above one", 0.2, 1.1, false},
+		{"both unset", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := AuditStrategy{AutoPassThreshold: tt.pass, AutoBlockThreshold: tt.block}
+			if err := s.ValidateThresholds(); (err == nil) != tt.wantValid {
+				t.Errorf("ValidateThresholds(pass %v, block %v) = %v, want valid %v", tt.pass, tt.block, err, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestThresholdsFor(t *testing.T) {
+	s := AuditStrategy{
+		AutoPassThreshold:  0.3,
+		AutoBlockThreshold: 0.8,
+		Thresholds: map[string]AuditThresholds{
+			"video": {AutoPassThreshold: 0.1, AutoBlockThreshold: 0.6},
+		},
+	}
+	tests := []struct {
+		contentType string
+		want        AuditThresholds
+	}{
+		{"video", AuditThresholds{AutoPassThreshold: 0.1, AutoBlockThreshold: 0.6}},
+		// 未单独配置的类型使用全局阈值
+		{"text", AuditThresholds{AutoPassThreshold: 0.3, AutoBlockThreshold: 0.8}},
+		{"", AuditThresholds{AutoPassThreshold: 0.3, AutoBlockThreshold: 0.8}},
+	}
+	for _, tt := range tests {
+		if got := s.ThresholdsFor(tt.contentType); got != tt.want {
+			t.Errorf("ThresholdsFor(%q) = %+v, want %+v", tt.contentType, got, tt.want)
+		}
+	}
+}
+
+func TestValidateContentTypeThresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		thresholds map[string]AuditThresholds
+		wantErr    string
+	}{
+		{"valid", map[string]AuditThresholds{"video": {AutoBlockThreshold: 0.6, AutoPassThreshold: 0.1}, "live": {AutoBlockThreshold: 0.9, AutoPassThreshold: 0.2}}, ""},
+		{"unsupported type", map[string]AuditThresholds{"podcast": {AutoBlockThreshold: 0.8, AutoPassThreshold: 0.2}}, `unsupported content type "podcast"`},
+		{"pass above block", map[string]AuditThresholds{"image": {AutoBlockThreshold: 0.5, AutoPassThreshold: 0.6}}, "thresholds.image"},
+		// 只配置一个阈值时另一个为0，不能通过校验
+		{"block unset", map[string]AuditThresholds{"audio": {AutoPassThreshold: 0.2}}, "thresholds.audio"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := AuditStrategy{AutoPassThreshold: 0.3, AutoBlockThreshold: 0.8, Thresholds: tt.thresholds}
+			err := s.ValidateThresholds()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateThresholds = %v, want valid", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateThresholds = %v, want an error mentioning %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestContentTypeThresholdsConfig(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	yaml := `audit:
+  strategies:
+    content:
+      auto_pass_threshold: 0.3
+      auto_block_threshold: 0.8
+      thresholds:
+        video:
+          auto_pass_threshold: 0.1
+          auto_block_threshold: 0.6
+`
+	if err := v.ReadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("unmarshal config: %v", err)
+	}
+	content := cfg.Audit.Strategies.Content
+	if got := content.ThresholdsFor("video"); got != (AuditThresholds{AutoBlockThreshold: 0.6, AutoPassThreshold: 0.1}) {
+		t.Errorf("video thresholds = %+v, want block 0.6 pass 0.1", got)
+	}
+	if err := content.ValidateThresholds(); err != nil {
+		t.Errorf("ValidateThresholds = %v, want valid", err)
+	}
+}
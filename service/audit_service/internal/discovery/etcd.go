@@ -130,6 +130,16 @@ func (d *EtcdDiscovery) Watch(serviceName string, callback func([]string)) error
 	return nil
 }
 
+// Ping 检查etcd连通性
+func (d *EtcdDiscovery) Ping(ctx context.Context) error {
+	endpoints := d.client.Endpoints()
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no etcd endpoints configured")
+	}
+	_, err := d.client.Status(ctx, endpoints[0])
+	return err
+}
+
 // Close 关闭连接
 func (d *EtcdDiscovery) Close() error {
 	if d.lease != 0 {
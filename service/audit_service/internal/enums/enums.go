@@ -0,0 +1,94 @@
+// Package enums 统一处理proto枚举与服务层字符串之间的转换
+// 未指定或未知的枚举值转换为空字符串，服务层据此不做过滤；未知的字符串转换为UNSPECIFIED
+package enums
+
+import (
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+// contentTypeNames 内容类型枚举对应的字符串
+var contentTypeNames = map[auditv1.ContentType]string{
+	auditv1.ContentType_CONTENT_TYPE_TEXT:     "text",
+	auditv1.ContentType_CONTENT_TYPE_IMAGE:    "image",
+	auditv1.ContentType_CONTENT_TYPE_VIDEO:    "video",
+	auditv1.ContentType_CONTENT_TYPE_AUDIO:    "audio",
+	auditv1.ContentType_CONTENT_TYPE_DOCUMENT: "document",
+	auditv1.ContentType_CONTENT_TYPE_LIVE:     "live",
+	auditv1.ContentType_CONTENT_TYPE_COMMENT:  "comment",
+	auditv1.ContentType_CONTENT_TYPE_PROFILE:  "profile",
+}
+
+// auditStatusNames 审核状态枚举对应的字符串
+var auditStatusNames = map[auditv1.AuditStatus]string{
+	auditv1.AuditStatus_AUDIT_STATUS_PENDING:        "pending",
+	auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW:   "under_review",
+	auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL: "pending_manual",
+	auditv1.AuditStatus_AUDIT_STATUS_PASSED:         "passed",
+	auditv1.AuditStatus_AUDIT_STATUS_REJECTED:       "rejected",
+	auditv1.AuditStatus_AUDIT_STATUS_EXPIRED:        "expired",
+}
+
+// auditStatusAliases 审核记录中使用的状态字符串，转换为语义相同的proto状态
+var auditStatusAliases = map[string]auditv1.AuditStatus{
+	"approved":     auditv1.AuditStatus_AUDIT_STATUS_PASSED,
+	"auto_passed":  auditv1.AuditStatus_AUDIT_STATUS_PASSED,
+	"auto_blocked": auditv1.AuditStatus_AUDIT_STATUS_REJECTED,
+	"appealing":    auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW,
+}
+
+// auditLevelNames 审核级别枚举对应的字符串
+var auditLevelNames = map[auditv1.AuditLevel]string{
+	auditv1.AuditLevel_AUDIT_LEVEL_LOW:      "low",
+	auditv1.AuditLevel_AUDIT_LEVEL_MEDIUM:   "medium",
+	auditv1.AuditLevel_AUDIT_LEVEL_HIGH:     "high",
+	auditv1.AuditLevel_AUDIT_LEVEL_CRITICAL: "critical",
+}
+
+var (
+	contentTypeValues = invert(contentTypeNames)
+	auditStatusValues = invert(auditStatusNames)
+	auditLevelValues  = invert(auditLevelNames)
+)
+
+// invert 生成字符串到枚举的反向映射
+func invert[E comparable](names map[E]string) map[string]E {
+	values := make(map[string]E, len(names))
+	for e, name := range names {
+		values[name] = e
+	}
+	return values
+}
+
+// ContentTypeToString 内容类型枚举转换为字符串，未指定时返回空字符串
+func ContentTypeToString(contentType auditv1.ContentType) string {
+	return contentTypeNames[contentType]
+}
+
+// ContentTypeFromString 字符串转换为内容类型枚举，未知类型返回CONTENT_TYPE_UNSPECIFIED
+func ContentTypeFromString(contentType string) auditv1.ContentType {
+	return contentTypeValues[contentType]
+}
+
+// AuditStatusToString 审核状态枚举转换为字符串，未指定时返回空字符串
+func AuditStatusToString(status auditv1.AuditStatus) string {
+	return auditStatusNames[status]
+}
+
+// AuditStatusFromString 字符串转换为审核状态枚举，未知状态返回AUDIT_STATUS_UNSPECIFIED
+// 审核记录中的approved、auto_passed视为通过，auto_blocked视为拒绝，appealing视为审核中
+func AuditStatusFromString(status string) auditv1.AuditStatus {
+	if s, ok := auditStatusValues[status]; ok {
+		return s
+	}
+	return auditStatusAliases[status]
+}
+
+// AuditLevelToString 审核级别枚举转换为字符串，未指定时返回空字符串
+func AuditLevelToString(level auditv1.AuditLevel) string {
+	return auditLevelNames[level]
+}
+
+// AuditLevelFromString 字符串转换为审核级别枚举，未知级别返回AUDIT_LEVEL_UNSPECIFIED
+func AuditLevelFromString(level string) auditv1.AuditLevel {
+	return auditLevelValues[level]
+}
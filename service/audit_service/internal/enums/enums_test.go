@@ -0,0 +1,126 @@
+package enums
+
+import (
+	"testing"
+
+	"audit_service/internal/model"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+func TestContentTypeRoundTrip(t *testing.T) {
+	// proto中定义的每个内容类型都有对应的字符串，并能转换回原值
+	seen := make(map[string]auditv1.ContentType)
+	for value := range auditv1.ContentType_name {
+		contentType := auditv1.ContentType(value)
+		if contentType == auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED {
+			continue
+		}
+		name := ContentTypeToString(contentType)
+		if name == "" {
+			t.Errorf("%v has no string", contentType)
+			continue
+		}
+		if prev, ok := seen[name]; ok {
+			t.Errorf("%v and %v both map to %q", prev, contentType, name)
+		}
+		seen[name] = contentType
+		if got := ContentTypeFromString(name); got != contentType {
+			t.Errorf("ContentTypeFromString(%q) = %v, want %v", name, got, contentType)
+		}
+	}
+
+	// 审核记录中保存的内容类型都能转换为proto枚举
+	for _, contentType := range []model.ContentType{model.ContentTypeVideo, model.ContentTypeImage, model.ContentTypeText, model.ContentTypeAudio, model.ContentTypeLive} {
+		if got := ContentTypeFromString(string(contentType)); got == auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED || ContentTypeToString(got) != string(contentType) {
+			t.Errorf("model content type %q converts to %v", contentType, got)
+		}
+	}
+}
+
+func TestAuditStatusRoundTrip(t *testing.T) {
+	for value := range auditv1.AuditStatus_name {
+		status := auditv1.AuditStatus(value)
+		if status == auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED {
+			continue
+		}
+		name := AuditStatusToString(status)
+		if name == "" {
+			t.Errorf("%v has no string", status)
+			continue
+		}
+		if got := AuditStatusFromString(name); got != status {
+			t.Errorf("AuditStatusFromString(%q) = %v, want %v", name, got, status)
+		}
+	}
+
+	// 审核记录中的状态映射到语义相同的proto状态
+	tests := []struct {
+		status model.AuditStatus
+		want   auditv1.AuditStatus
+	}{
+		{model.AuditStatusPending, auditv1.AuditStatus_AUDIT_STATUS_PENDING},
+		{model.AuditStatusApproved, auditv1.AuditStatus_AUDIT_STATUS_PASSED},
+		{model.AuditStatusAutoPassed, auditv1.AuditStatus_AUDIT_STATUS_PASSED},
+		{model.AuditStatusRejected, auditv1.AuditStatus_AUDIT_STATUS_REJECTED},
+		{model.AuditStatusAutoBlocked, auditv1.AuditStatus_AUDIT_STATUS_REJECTED},
+		{model.AuditStatusAppealing, auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW},
+	}
+	for _, tt := range tests {
+		if got := AuditStatusFromString(string(tt.status)); got != tt.want {
+			t.Errorf("AuditStatusFromString(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestAuditLevelRoundTrip(t *testing.T) {
+	for value := range auditv1.AuditLevel_name {
+		level := auditv1.AuditLevel(value)
+		if level == auditv1.AuditLevel_AUDIT_LEVEL_UNSPECIFIED {
+			continue
+		}
+		name := AuditLevelToString(level)
+		if name == "" {
+			t.Errorf("%v has no string", level)
+			continue
+		}
+		if got := AuditLevelFromString(name); got != level {
+			t.Errorf("AuditLevelFromString(%q) = %v, want %v", name, got, level)
+		}
+	}
+	for _, level := range []model.AuditLevel{model.AuditLevelLow, model.AuditLevelMedium, model.AuditLevelHigh} {
+		if got := AuditLevelFromString(string(level)); AuditLevelToString(got) != string(level) {
+			t.Errorf("model level %q converts to %v", level, got)
+		}
+	}
+}
+
+func TestUnspecifiedAndUnknownValues(t *testing.T) {
+	// 未指定的枚举转换为空字符串，服务层据此不做过滤
+	if got := ContentTypeToString(auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED); got != "" {
+		t.Errorf("unspecified content type = %q, want empty", got)
+	}
+	if got := AuditStatusToString(auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED); got != "" {
+		t.Errorf("unspecified status = %q, want empty", got)
+	}
+	if got := AuditLevelToString(auditv1.AuditLevel_AUDIT_LEVEL_UNSPECIFIED); got != "" {
+		t.Errorf("unspecified level = %q, want empty", got)
+	}
+	if got := ContentTypeToString(auditv1.ContentType(99)); got != "" {
+		t.Errorf("unknown content type = %q, want empty", got)
+	}
+
+	// 未知或大小写不同的字符串转换为UNSPECIFIED
+	for _, s := range []string{"", "podcast", "VIDEO", "CONTENT_TYPE_VIDEO"} {
+		if got := ContentTypeFromString(s); got != auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED {
+			t.Errorf("ContentTypeFromString(%q) = %v, want unspecified", s, got)
+		}
+	}
+	for _, s := range []string{"", "deleted", "PASSED"} {
+		if got := AuditStatusFromString(s); got != auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED {
+			t.Errorf("AuditStatusFromString(%q) = %v, want unspecified", s, got)
+		}
+	}
+	if got := AuditLevelFromString("severe"); got != auditv1.AuditLevel_AUDIT_LEVEL_UNSPECIFIED {
+		t.Errorf("AuditLevelFromString(severe) = %v, want unspecified", got)
+	}
+}
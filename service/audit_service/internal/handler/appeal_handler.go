@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"audit_service/internal/service"
+	"audit_service/pkg/timeconv"
+	"context"
+	"errors"
+
+	auditv1 "audit_service/proto_gen/audit/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubmitAppeal 被拒绝内容的上传者提交申诉
+func (h *AuditServiceHandler) SubmitAppeal(ctx context.Context, req *auditv1.SubmitAppealRequest) (*auditv1.SubmitAppealResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.AuditId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "audit_id is required")
+	}
+
+	appeal, err := h.service.SubmitAppeal(ctx, req.AuditId, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrAuditRecordNotFound):
+			return nil, status.Error(codes.NotFound, "audit record not found")
+		case errors.Is(err, service.ErrAppealAlreadyOpen):
+			return nil, status.Error(codes.AlreadyExists, "appeal already submitted")
+		case errors.Is(err, service.ErrAppealNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, "only rejected content can be appealed")
+		case errors.Is(err, service.ErrAppealFinal):
+			return nil, status.Error(codes.FailedPrecondition, "appeal was already upheld and is final")
+		}
+		h.logger.Error("Failed to submit appeal", "error", err, "audit_id", req.AuditId)
+		return nil, status.Error(codes.Internal, "failed to submit appeal")
+	}
+
+	return &auditv1.SubmitAppealResponse{
+		AppealId: appeal.ID,
+		Status:   appeal.Status,
+	}, nil
+}
+
+// ResolveAppeal 审核员处理申诉
+func (h *AuditServiceHandler) ResolveAppeal(ctx context.Context, req *auditv1.ResolveAppealRequest) (*auditv1.ResolveAppealResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.AuditId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "audit_id is required")
+	}
+	if req.ReviewerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id is required")
+	}
+
+	appeal, err := h.service.ResolveAppeal(ctx, req.AuditId, req.Decision, req.ReviewerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidAppealDecision):
+			return nil, status.Error(codes.InvalidArgument, "decision must be uphold or overturn")
+		case errors.Is(err, service.ErrAppealNotFound):
+			return nil, status.Error(codes.NotFound, "no open appeal for audit record")
+		}
+		h.logger.Error("Failed to resolve appeal", "error", err, "audit_id", req.AuditId, "reviewer_id", req.ReviewerId)
+		return nil, status.Error(codes.Internal, "failed to resolve appeal")
+	}
+
+	return &auditv1.ResolveAppealResponse{
+		AppealId: appeal.ID,
+		Status:   appeal.Status,
+	}, nil
+}
+
+// ListPendingAppeals 获取申诉队列中待处理的申诉
+func (h *AuditServiceHandler) ListPendingAppeals(ctx context.Context, req *auditv1.ListPendingAppealsRequest) (*auditv1.ListPendingAppealsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	result, err := h.service.ListPendingAppeals(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list pending appeals", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list pending appeals")
+	}
+
+	appeals := make([]*auditv1.AuditAppeal, len(result.Appeals))
+	for i, appeal := range result.Appeals {
+		appeals[i] = &auditv1.AuditAppeal{
+			AppealId:    appeal.ID,
+			AuditId:     appeal.AuditID,
+			ContentId:   appeal.ContentID,
+			ContentType: appeal.ContentType,
+			UploaderId:  appeal.UploaderID,
+			Reason:      appeal.Reason,
+			Status:      appeal.Status,
+			CreatedAt:   timeconv.ToProto(appeal.CreatedAt),
+		}
+	}
+
+	return &auditv1.ListPendingAppealsResponse{
+		Total:    result.Total,
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+		Appeals:  appeals,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"audit_service/internal/service"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// stubAppealService 返回固定申诉结果的审核服务
+type stubAppealService struct {
+	service.AuditService
+	pending   *service.ListAppealsResponse
+	submitErr error
+}
+
+func (s *stubAppealService) ListPendingAppeals(ctx context.Context, page, pageSize int) (*service.ListAppealsResponse, error) {
+	return s.pending, nil
+}
+
+func (s *stubAppealService) SubmitAppeal(ctx context.Context, auditID uint64, reason string) (*service.Appeal, error) {
+	return nil, s.submitErr
+}
+
+func TestListPendingAppeals(t *testing.T) {
+	createdAt := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	svc := &stubAppealService{pending: &service.ListAppealsResponse{
+		Total: 1, Page: 1, PageSize: 20,
+		Appeals: []*service.Appeal{{ID: 3, AuditID: 9, ContentID: "video-1", ContentType: "video", UploaderID: 42, Reason: "误判", Status: "pending", CreatedAt: createdAt}},
+	}}
+	h := NewAuditServiceHandler(svc, nopLogger{})
+
+	resp, err := h.ListPendingAppeals(context.Background(), &auditv1.ListPendingAppealsRequest{})
+	if err != nil {
+		t.Fatalf("ListPendingAppeals: %v", err)
+	}
+
+	// 经过序列化往返，确认新增的申诉消息可以正常编解码
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded auditv1.ListPendingAppealsResponse
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if decoded.Total != 1 || len(decoded.Appeals) != 1 {
+		t.Fatalf("response = %v, want one appeal", &decoded)
+	}
+	appeal := decoded.Appeals[0]
+	if appeal.AppealId != 3 || appeal.AuditId != 9 || appeal.UploaderId != 42 || appeal.Reason != "误判" || !appeal.CreatedAt.AsTime().Equal(createdAt) {
+		t.Fatalf("appeal = %v", appeal)
+	}
+}
+
+func TestSubmitAppealRejectsFinalAppeal(t *testing.T) {
+	h := NewAuditServiceHandler(&stubAppealService{submitErr: service.ErrAppealFinal}, nopLogger{})
+
+	_, err := h.SubmitAppeal(context.Background(), &auditv1.SubmitAppealRequest{AuditId: 9, Reason: "再申诉"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("SubmitAppeal error = %v, want FailedPrecondition", err)
+	}
+}
@@ -2,18 +2,21 @@ package handler
 
 import (
 	"audit_service/internal/config"
+	"audit_service/internal/enums"
+	"audit_service/internal/model"
 	"audit_service/internal/service"
+	"audit_service/pkg/ids"
 	"audit_service/pkg/logger"
+	"audit_service/pkg/paginate"
+	"audit_service/pkg/timeconv"
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
-	"time"
 
 	auditv1 "audit_service/proto_gen/audit/v1"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AuditServiceHandler implements the auditv1.AuditServiceServer interface
@@ -40,15 +43,7 @@ func (h *AuditServiceHandler) SubmitContent(ctx context.Context, req *auditv1.Su
 	}
 
 	// Convert proto request to service request
-	serviceReq := service.SubmitContentRequest{
-		ContentID:       req.ContentId,
-		ContentType:     string(req.ContentType),
-		ContentTitle:    "",                                // 这个字段在proto中不存在
-		ContentURL:      "",                                // 这个字段在proto中不存在
-		ContentMetadata: "",                                // 这个字段在proto中不存在
-		UploaderID:      fmt.Sprintf("%d", req.UploaderId), // uint64转string
-		UploaderName:    "",                                // 这个字段在proto中不存在
-	}
+	serviceReq := bindSubmitContentRequest(req)
 
 	// Call service layer
 	result, err := h.service.SubmitContent(ctx, &serviceReq)
@@ -58,28 +53,9 @@ func (h *AuditServiceHandler) SubmitContent(ctx context.Context, req *auditv1.Su
 	}
 
 	// Convert service response to proto response
-	// 将字符串状态转换为枚举类型
-	var status auditv1.AuditStatus
-	switch result.Status {
-	case "pending":
-		status = auditv1.AuditStatus_AUDIT_STATUS_PENDING
-	case "under_review":
-		status = auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW
-	case "pending_manual":
-		status = auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL
-	case "passed":
-		status = auditv1.AuditStatus_AUDIT_STATUS_PASSED
-	case "rejected":
-		status = auditv1.AuditStatus_AUDIT_STATUS_REJECTED
-	case "expired":
-		status = auditv1.AuditStatus_AUDIT_STATUS_EXPIRED
-	default:
-		status = auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED
-	}
-
 	resp := &auditv1.SubmitContentResponse{
 		AuditId: result.AuditID,
-		Status:  status,
+		Status:  enums.AuditStatusFromString(result.Status),
 		Reason:  result.Message, // 使用Message字段作为Reason
 		// Level和CreatedAt在service层没有对应字段，暂时留空
 	}
@@ -87,6 +63,42 @@ func (h *AuditServiceHandler) SubmitContent(ctx context.Context, req *auditv1.Su
 	return resp, nil
 }
 
+// BatchSubmitContent submits a batch of content for audit
+func (h *AuditServiceHandler) BatchSubmitContent(ctx context.Context, req *auditv1.BatchSubmitContentRequest) (*auditv1.BatchSubmitContentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if len(req.ContentIds) == 0 && len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content_ids or items is required")
+	}
+	for _, item := range req.Items {
+		if item.GetContentId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "content_id is required for each item")
+		}
+	}
+
+	serviceReq := bindBatchSubmitContentRequest(req)
+	result, err := h.service.BatchSubmitContent(ctx, &serviceReq)
+	if err != nil {
+		h.logger.Error("Failed to batch submit content for audit", "error", err)
+		return nil, status.Error(codes.Internal, "failed to batch submit content for audit")
+	}
+
+	resp := &auditv1.BatchSubmitContentResponse{
+		Results: make([]*auditv1.SubmitContentResponse, 0, len(result.Results)),
+		Message: result.Message,
+	}
+	for _, r := range result.Results {
+		resp.Results = append(resp.Results, &auditv1.SubmitContentResponse{
+			AuditId: r.AuditID,
+			Status:  enums.AuditStatusFromString(r.Status),
+			Reason:  r.Message,
+		})
+	}
+
+	return resp, nil
+}
+
 // GetAuditResult retrieves audit result
 func (h *AuditServiceHandler) GetAuditResult(ctx context.Context, req *auditv1.GetAuditResultRequest) (*auditv1.GetAuditResultResponse, error) {
 	if req == nil {
@@ -94,63 +106,25 @@ func (h *AuditServiceHandler) GetAuditResult(ctx context.Context, req *auditv1.G
 	}
 
 	// Call service layer
-	result, err := h.service.GetAuditResult(ctx, fmt.Sprintf("%d", req.AuditId))
+	result, err := h.service.GetAuditResult(ctx, ids.Format(req.AuditId))
 	if err != nil {
 		h.logger.Error("Failed to get audit result", "error", err, "audit_id", req.AuditId)
 		return nil, status.Error(codes.Internal, "failed to get audit result")
 	}
 
 	// Convert service response to proto response
-	// 将字符串内容类型转换为枚举类型
-	var contentType auditv1.ContentType
-	switch result.ContentType {
-	case "text":
-		contentType = auditv1.ContentType_CONTENT_TYPE_TEXT
-	case "image":
-		contentType = auditv1.ContentType_CONTENT_TYPE_IMAGE
-	case "video":
-		contentType = auditv1.ContentType_CONTENT_TYPE_VIDEO
-	case "audio":
-		contentType = auditv1.ContentType_CONTENT_TYPE_AUDIO
-	case "document":
-		contentType = auditv1.ContentType_CONTENT_TYPE_DOCUMENT
-	case "live":
-		contentType = auditv1.ContentType_CONTENT_TYPE_LIVE
-	case "comment":
-		contentType = auditv1.ContentType_CONTENT_TYPE_COMMENT
-	case "profile":
-		contentType = auditv1.ContentType_CONTENT_TYPE_PROFILE
-	default:
-		contentType = auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED
-	}
-
-	// 将字符串状态转换为枚举类型
-	var status auditv1.AuditStatus
-	switch result.Status {
-	case "pending":
-		status = auditv1.AuditStatus_AUDIT_STATUS_PENDING
-	case "under_review":
-		status = auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW
-	case "pending_manual":
-		status = auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL
-	case "passed":
-		status = auditv1.AuditStatus_AUDIT_STATUS_PASSED
-	case "rejected":
-		status = auditv1.AuditStatus_AUDIT_STATUS_REJECTED
-	case "expired":
-		status = auditv1.AuditStatus_AUDIT_STATUS_EXPIRED
-	default:
-		status = auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED
-	}
-
+	// 未审核的记录没有审核时间，ReviewedAt为nil
 	resp := &auditv1.GetAuditResultResponse{
 		AuditId:     result.AuditID,
 		ContentId:   result.ContentID,
-		ContentType: contentType,
-		Status:      status,
+		ContentType: enums.ContentTypeFromString(result.ContentType),
+		Status:      enums.AuditStatusFromString(result.Status),
 		Reason:      result.Reason,
-		// Level, ReviewerId, ReviewedAt在service层没有对应字段，暂时留空
-		CreatedAt: timestamppb.New(time.Now()), // 使用当前时间，因为service层没有提供
+		Details:     result.Details,
+		Level:       enums.AuditLevelFromString(result.Level),
+		ReviewerId:  result.ReviewerID,
+		ReviewedAt:  timeconv.ToProtoPtr(result.ReviewTime),
+		CreatedAt:   timeconv.ToProto(result.CreatedAt),
 	}
 
 	return resp, nil
@@ -163,36 +137,17 @@ func (h *AuditServiceHandler) UpdateAuditStatus(ctx context.Context, req *auditv
 	}
 
 	// Convert proto request to service request
-	// 将枚举状态转换为字符串
-	var statusStr string
-	switch req.Status {
-	case auditv1.AuditStatus_AUDIT_STATUS_PENDING:
-		statusStr = "pending"
-	case auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW:
-		statusStr = "under_review"
-	case auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL:
-		statusStr = "pending_manual"
-	case auditv1.AuditStatus_AUDIT_STATUS_PASSED:
-		statusStr = "passed"
-	case auditv1.AuditStatus_AUDIT_STATUS_REJECTED:
-		statusStr = "rejected"
-	case auditv1.AuditStatus_AUDIT_STATUS_EXPIRED:
-		statusStr = "expired"
-	default:
-		statusStr = "unspecified"
-	}
-
-	serviceReq := service.UpdateAuditStatusRequest{
-		AuditID:    req.AuditId,
-		Status:     statusStr,
-		ReviewerID: req.ReviewerId,
-		Reason:     req.Reason,
-		// Details和Violations在proto中不存在
-	}
+	serviceReq := bindUpdateAuditStatusRequest(req)
 
 	// Call service layer
 	_, err := h.service.UpdateAuditStatus(ctx, &serviceReq)
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidViolationCategory) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, service.ErrAppealInProgress) {
+			return nil, status.Error(codes.FailedPrecondition, "audit record is under appeal, resolve the appeal instead")
+		}
 		h.logger.Error("Failed to update audit status", "error", err, "audit_id", req.AuditId)
 		return nil, status.Error(codes.Internal, "failed to update audit status")
 	}
@@ -211,76 +166,25 @@ func (h *AuditServiceHandler) ListAuditRecords(ctx context.Context, req *auditv1
 	}
 
 	// Convert proto request to service request
-	// 将枚举类型转换为字符串
-	var contentTypeStr string
-	switch req.ContentType {
-	case auditv1.ContentType_CONTENT_TYPE_TEXT:
-		contentTypeStr = "text"
-	case auditv1.ContentType_CONTENT_TYPE_IMAGE:
-		contentTypeStr = "image"
-	case auditv1.ContentType_CONTENT_TYPE_VIDEO:
-		contentTypeStr = "video"
-	case auditv1.ContentType_CONTENT_TYPE_AUDIO:
-		contentTypeStr = "audio"
-	case auditv1.ContentType_CONTENT_TYPE_DOCUMENT:
-		contentTypeStr = "document"
-	case auditv1.ContentType_CONTENT_TYPE_LIVE:
-		contentTypeStr = "live"
-	case auditv1.ContentType_CONTENT_TYPE_COMMENT:
-		contentTypeStr = "comment"
-	case auditv1.ContentType_CONTENT_TYPE_PROFILE:
-		contentTypeStr = "profile"
-	default:
-		contentTypeStr = "unspecified"
-	}
-
-	var statusStr string
-	switch req.Status {
-	case auditv1.AuditStatus_AUDIT_STATUS_PENDING:
-		statusStr = "pending"
-	case auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW:
-		statusStr = "under_review"
-	case auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL:
-		statusStr = "pending_manual"
-	case auditv1.AuditStatus_AUDIT_STATUS_PASSED:
-		statusStr = "passed"
-	case auditv1.AuditStatus_AUDIT_STATUS_REJECTED:
-		statusStr = "rejected"
-	case auditv1.AuditStatus_AUDIT_STATUS_EXPIRED:
-		statusStr = "expired"
-	default:
-		statusStr = "unspecified"
-	}
-
-	var levelStr string
-	switch req.Level {
-	case auditv1.AuditLevel_AUDIT_LEVEL_LOW:
-		levelStr = "low"
-	case auditv1.AuditLevel_AUDIT_LEVEL_MEDIUM:
-		levelStr = "medium"
-	case auditv1.AuditLevel_AUDIT_LEVEL_HIGH:
-		levelStr = "high"
-	case auditv1.AuditLevel_AUDIT_LEVEL_CRITICAL:
-		levelStr = "critical"
-	default:
-		levelStr = "unspecified"
-	}
-
 	serviceReq := service.ListAuditRecordsRequest{
-		ContentType: contentTypeStr,
-		Status:      statusStr,
-		Level:       levelStr,
-		UploaderID:  fmt.Sprintf("%d", req.UploaderId),
+		ContentType: enums.ContentTypeToString(req.ContentType),
+		Status:      enums.AuditStatusToString(req.Status),
+		Level:       enums.AuditLevelToString(req.Level),
+		UploaderID:  ids.Format(req.UploaderId),
 		// ReviewerID在service层不存在
 		StartDate: req.StartDate,
 		EndDate:   req.EndDate,
 		Page:      int(req.Page),
 		PageSize:  int(req.PageSize),
+		Cursor:    req.Cursor,
 	}
 
 	// Call service layer
 	result, err := h.service.ListAuditRecords(ctx, &serviceReq)
 	if err != nil {
+		if errors.Is(err, paginate.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination cursor")
+		}
 		h.logger.Error("Failed to list audit records", "error", err)
 		return nil, status.Error(codes.Internal, "failed to list audit records")
 	}
@@ -288,91 +192,31 @@ func (h *AuditServiceHandler) ListAuditRecords(ctx context.Context, req *auditv1
 	// Convert service response to proto response
 	records := make([]*auditv1.AuditRecord, len(result.Records))
 	for i, record := range result.Records {
-		// 将字符串转换为枚举类型
-		var contentType auditv1.ContentType
-		switch record.ContentType {
-		case "text":
-			contentType = auditv1.ContentType_CONTENT_TYPE_TEXT
-		case "image":
-			contentType = auditv1.ContentType_CONTENT_TYPE_IMAGE
-		case "video":
-			contentType = auditv1.ContentType_CONTENT_TYPE_VIDEO
-		case "audio":
-			contentType = auditv1.ContentType_CONTENT_TYPE_AUDIO
-		case "document":
-			contentType = auditv1.ContentType_CONTENT_TYPE_DOCUMENT
-		case "live":
-			contentType = auditv1.ContentType_CONTENT_TYPE_LIVE
-		case "comment":
-			contentType = auditv1.ContentType_CONTENT_TYPE_COMMENT
-		case "profile":
-			contentType = auditv1.ContentType_CONTENT_TYPE_PROFILE
-		default:
-			contentType = auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED
-		}
-
-		var status auditv1.AuditStatus
-		switch record.Status {
-		case "pending":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PENDING
-		case "under_review":
-			status = auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW
-		case "pending_manual":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL
-		case "passed":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PASSED
-		case "rejected":
-			status = auditv1.AuditStatus_AUDIT_STATUS_REJECTED
-		case "expired":
-			status = auditv1.AuditStatus_AUDIT_STATUS_EXPIRED
-		default:
-			status = auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED
-		}
-
-		var level auditv1.AuditLevel
-		switch record.Level {
-		case "low":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_LOW
-		case "medium":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_MEDIUM
-		case "high":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_HIGH
-		case "critical":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_CRITICAL
-		default:
-			level = auditv1.AuditLevel_AUDIT_LEVEL_UNSPECIFIED
-		}
-
 		// 转换UploaderID为uint64
-		var uploaderID uint64
-		if id, err := strconv.ParseUint(record.UploaderID, 10, 64); err == nil {
-			uploaderID = id
-		}
-
-		// 处理ReviewTime可能为nil的情况
-		var reviewedAt *timestamppb.Timestamp
-		if record.ReviewTime != nil {
-			reviewedAt = timestamppb.New(*record.ReviewTime)
+		uploaderID, err := ids.ParseOptional(record.UploaderID)
+		if err != nil {
+			h.logger.Warn("Invalid uploader id in audit record", "audit_id", record.ID, "uploader_id", record.UploaderID)
 		}
 
 		records[i] = &auditv1.AuditRecord{
 			AuditId:     record.ID,
 			ContentId:   record.ContentID,
-			ContentType: contentType,
-			Status:      status,
+			ContentType: enums.ContentTypeFromString(record.ContentType),
+			Status:      enums.AuditStatusFromString(record.Status),
 			Reason:      record.Reason,
-			Level:       level,
-			UploaderId:  uploaderID,
-			CreatedAt:   timestamppb.New(record.CreatedAt),
-			ReviewedAt:  reviewedAt,
+			Level:       enums.AuditLevelFromString(record.Level),
+			UploaderId:  uploaderID.Uint64(),
+			CreatedAt:   timeconv.ToProto(record.CreatedAt),
+			ReviewedAt:  timeconv.ToProtoPtr(record.ReviewTime),
 		}
 	}
 
 	return &auditv1.ListAuditRecordsResponse{
-		Total:    result.Total,
-		Page:     int32(result.Page),
-		PageSize: int32(result.PageSize),
-		Records:  records,
+		Total:      result.Total,
+		Page:       int32(result.Page),
+		PageSize:   int32(result.PageSize),
+		Records:    records,
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
@@ -383,12 +227,7 @@ func (h *AuditServiceHandler) AddToWhitelist(ctx context.Context, req *auditv1.A
 	}
 
 	// Convert proto request to service request
-	serviceReq := service.AddToWhitelistRequest{
-		ContentID:   req.ContentId,
-		ContentType: fmt.Sprintf("%d", req.ContentType),
-		Reason:      req.Reason,
-		CreatedBy:   req.CreatedBy,
-	}
+	serviceReq := bindAddToWhitelistRequest(req)
 
 	// Call service layer
 	_, err := h.service.AddToWhitelist(ctx, &serviceReq)
@@ -431,12 +270,7 @@ func (h *AuditServiceHandler) AddToBlacklist(ctx context.Context, req *auditv1.A
 	}
 
 	// Convert proto request to service request
-	serviceReq := service.AddToBlacklistRequest{
-		ContentID:   req.ContentId,
-		ContentType: fmt.Sprintf("%d", req.ContentType),
-		Reason:      req.Reason,
-		CreatedBy:   req.CreatedBy,
-	}
+	serviceReq := bindAddToBlacklistRequest(req)
 
 	// Call service layer
 	_, err := h.service.AddToBlacklist(ctx, &serviceReq)
@@ -479,46 +313,10 @@ func (h *AuditServiceHandler) GetManualReviewQueue(ctx context.Context, req *aud
 	}
 
 	// Convert proto request to service request
-	// 将枚举类型转换为字符串
-	var contentTypeStr string
-	switch req.ContentType {
-	case auditv1.ContentType_CONTENT_TYPE_TEXT:
-		contentTypeStr = "text"
-	case auditv1.ContentType_CONTENT_TYPE_IMAGE:
-		contentTypeStr = "image"
-	case auditv1.ContentType_CONTENT_TYPE_VIDEO:
-		contentTypeStr = "video"
-	case auditv1.ContentType_CONTENT_TYPE_AUDIO:
-		contentTypeStr = "audio"
-	case auditv1.ContentType_CONTENT_TYPE_DOCUMENT:
-		contentTypeStr = "document"
-	case auditv1.ContentType_CONTENT_TYPE_LIVE:
-		contentTypeStr = "live"
-	case auditv1.ContentType_CONTENT_TYPE_COMMENT:
-		contentTypeStr = "comment"
-	case auditv1.ContentType_CONTENT_TYPE_PROFILE:
-		contentTypeStr = "profile"
-	default:
-		contentTypeStr = "unspecified"
-	}
-
-	var levelStr string
-	switch req.Level {
-	case auditv1.AuditLevel_AUDIT_LEVEL_LOW:
-		levelStr = "low"
-	case auditv1.AuditLevel_AUDIT_LEVEL_MEDIUM:
-		levelStr = "medium"
-	case auditv1.AuditLevel_AUDIT_LEVEL_HIGH:
-		levelStr = "high"
-	case auditv1.AuditLevel_AUDIT_LEVEL_CRITICAL:
-		levelStr = "critical"
-	default:
-		levelStr = "unspecified"
-	}
-
 	serviceReq := service.GetManualReviewQueueRequest{
-		ContentType: contentTypeStr,
-		Level:       levelStr,
+		ContentType: enums.ContentTypeToString(req.ContentType),
+		Level:       enums.AuditLevelToString(req.Level),
+		Priority:    int(req.Priority),
 		ReviewerID:  0, // proto中没有ReviewerId字段，使用默认值
 		Page:        int(req.Page),
 		PageSize:    int(req.PageSize),
@@ -534,65 +332,10 @@ func (h *AuditServiceHandler) GetManualReviewQueue(ctx context.Context, req *aud
 	// Convert service response to proto response
 	records := make([]*auditv1.AuditRecord, len(result.Queue))
 	for i, record := range result.Queue {
-		// 将字符串转换为枚举类型
-		var contentType auditv1.ContentType
-		switch record.ContentType {
-		case "text":
-			contentType = auditv1.ContentType_CONTENT_TYPE_TEXT
-		case "image":
-			contentType = auditv1.ContentType_CONTENT_TYPE_IMAGE
-		case "video":
-			contentType = auditv1.ContentType_CONTENT_TYPE_VIDEO
-		case "audio":
-			contentType = auditv1.ContentType_CONTENT_TYPE_AUDIO
-		case "document":
-			contentType = auditv1.ContentType_CONTENT_TYPE_DOCUMENT
-		case "live":
-			contentType = auditv1.ContentType_CONTENT_TYPE_LIVE
-		case "comment":
-			contentType = auditv1.ContentType_CONTENT_TYPE_COMMENT
-		case "profile":
-			contentType = auditv1.ContentType_CONTENT_TYPE_PROFILE
-		default:
-			contentType = auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED
-		}
-
-		var status auditv1.AuditStatus
-		switch record.Status {
-		case "pending":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PENDING
-		case "under_review":
-			status = auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW
-		case "pending_manual":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL
-		case "passed":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PASSED
-		case "rejected":
-			status = auditv1.AuditStatus_AUDIT_STATUS_REJECTED
-		case "expired":
-			status = auditv1.AuditStatus_AUDIT_STATUS_EXPIRED
-		default:
-			status = auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED
-		}
-
-		var level auditv1.AuditLevel
-		switch record.Level {
-		case "low":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_LOW
-		case "medium":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_MEDIUM
-		case "high":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_HIGH
-		case "critical":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_CRITICAL
-		default:
-			level = auditv1.AuditLevel_AUDIT_LEVEL_UNSPECIFIED
-		}
-
 		// 转换UploaderID为uint64
-		var uploaderID uint64
-		if id, err := strconv.ParseUint(record.UploaderID, 10, 64); err == nil {
-			uploaderID = id
+		uploaderID, err := ids.ParseOptional(record.UploaderID)
+		if err != nil {
+			h.logger.Warn("Invalid uploader id in audit record", "audit_id", record.ID, "uploader_id", record.UploaderID)
 		}
 
 		// 转换ReviewerID为uint64
@@ -601,23 +344,17 @@ func (h *AuditServiceHandler) GetManualReviewQueue(ctx context.Context, req *aud
 			reviewerID = *record.ReviewerID
 		}
 
-		// 处理ReviewTime可能为nil的情况
-		var reviewedAt *timestamppb.Timestamp
-		if record.ReviewTime != nil {
-			reviewedAt = timestamppb.New(*record.ReviewTime)
-		}
-
 		records[i] = &auditv1.AuditRecord{
 			AuditId:     record.ID,
 			ContentId:   record.ContentID,
-			ContentType: contentType,
-			Status:      status,
+			ContentType: enums.ContentTypeFromString(record.ContentType),
+			Status:      enums.AuditStatusFromString(record.Status),
 			Reason:      record.Reason,
-			Level:       level,
-			UploaderId:  uploaderID,
+			Level:       enums.AuditLevelFromString(record.Level),
+			UploaderId:  uploaderID.Uint64(),
 			ReviewerId:  reviewerID,
-			CreatedAt:   timestamppb.New(record.CreatedAt),
-			ReviewedAt:  reviewedAt,
+			CreatedAt:   timeconv.ToProto(record.CreatedAt),
+			ReviewedAt:  timeconv.ToProtoPtr(record.ReviewTime),
 		}
 	}
 
@@ -636,14 +373,14 @@ func (h *AuditServiceHandler) AssignManualReview(ctx context.Context, req *audit
 	}
 
 	// Convert proto request to service request
-	serviceReq := service.AssignManualReviewRequest{
-		AuditID:    req.AuditId,
-		ReviewerID: req.ReviewerId,
-	}
+	serviceReq := bindAssignManualReviewRequest(req)
 
 	// Call service layer
 	_, err := h.service.AssignManualReview(ctx, &serviceReq)
 	if err != nil {
+		if errors.Is(err, service.ErrAppealInProgress) {
+			return nil, status.Error(codes.FailedPrecondition, "audit record is under appeal, resolve the appeal instead")
+		}
 		h.logger.Error("Failed to assign manual review", "error", err)
 		return nil, status.Error(codes.Internal, "failed to assign manual review")
 	}
@@ -662,10 +399,7 @@ func (h *AuditServiceHandler) GetAuditStatistics(ctx context.Context, req *audit
 	}
 
 	// Convert proto request to service request
-	serviceReq := service.GetAuditStatisticsRequest{
-		StartDate: req.StartDate,
-		EndDate:   req.EndDate,
-	}
+	serviceReq := bindGetAuditStatisticsRequest(req)
 
 	// Call service layer
 	result, err := h.service.GetAuditStatistics(ctx, &serviceReq)
@@ -682,59 +416,24 @@ func (h *AuditServiceHandler) GetAuditStatistics(ctx context.Context, req *audit
 
 	// 转换状态统计
 	for _, stat := range result.StatusCounts {
-		status := auditv1.AuditStatus_AUDIT_STATUS_UNSPECIFIED
-		switch stat.Status {
-		case "pending":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PENDING
-		case "passed":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PASSED
-		case "rejected":
-			status = auditv1.AuditStatus_AUDIT_STATUS_REJECTED
-		case "under_review":
-			status = auditv1.AuditStatus_AUDIT_STATUS_UNDER_REVIEW
-		case "pending_manual":
-			status = auditv1.AuditStatus_AUDIT_STATUS_PENDING_MANUAL
-		}
 		resp.StatusStats = append(resp.StatusStats, &auditv1.StatusCount{
-			Status: status,
+			Status: enums.AuditStatusFromString(stat.Status),
 			Count:  stat.Count,
 		})
 	}
 
 	// 转换级别统计
 	for _, stat := range result.LevelCounts {
-		level := auditv1.AuditLevel_AUDIT_LEVEL_UNSPECIFIED
-		switch stat.Level {
-		case "low":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_LOW
-		case "medium":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_MEDIUM
-		case "high":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_HIGH
-		case "critical":
-			level = auditv1.AuditLevel_AUDIT_LEVEL_CRITICAL
-		}
 		resp.LevelStats = append(resp.LevelStats, &auditv1.LevelCount{
-			Level: level,
+			Level: enums.AuditLevelFromString(stat.Level),
 			Count: stat.Count,
 		})
 	}
 
 	// 转换类型统计
 	for _, stat := range result.TypeCounts {
-		contentType := auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED
-		switch stat.Type {
-		case "text":
-			contentType = auditv1.ContentType_CONTENT_TYPE_TEXT
-		case "image":
-			contentType = auditv1.ContentType_CONTENT_TYPE_IMAGE
-		case "video":
-			contentType = auditv1.ContentType_CONTENT_TYPE_VIDEO
-		case "audio":
-			contentType = auditv1.ContentType_CONTENT_TYPE_AUDIO
-		}
 		resp.TypeStats = append(resp.TypeStats, &auditv1.TypeCount{
-			ContentType: contentType,
+			ContentType: enums.ContentTypeFromString(stat.Type),
 			Count:       stat.Count,
 		})
 	}
@@ -749,10 +448,7 @@ func (h *AuditServiceHandler) GetViolationTrends(ctx context.Context, req *audit
 	}
 
 	// Convert proto request to service request
-	serviceReq := service.GetViolationTrendsRequest{
-		StartDate: req.StartDate,
-		EndDate:   req.EndDate,
-	}
+	serviceReq := bindGetViolationTrendsRequest(req)
 
 	// Call service layer
 	result, err := h.service.GetViolationTrends(ctx, &serviceReq)
@@ -765,8 +461,9 @@ func (h *AuditServiceHandler) GetViolationTrends(ctx context.Context, req *audit
 	trends := make([]*auditv1.ViolationTrend, len(result.Trends))
 	for i, trend := range result.Trends {
 		trends[i] = &auditv1.ViolationTrend{
-			Date:  trend.Date,
-			Count: trend.Violation,
+			Date:       trend.Date,
+			Count:      trend.Violation,
+			Categories: trend.Categories,
 		}
 	}
 
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"audit_service/internal/service"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+// stubAuditResultService 返回固定审核结果的审核服务
+type stubAuditResultService struct {
+	service.AuditService
+	result *service.AuditResult
+}
+
+func (s *stubAuditResultService) GetAuditResult(ctx context.Context, contentID string) (*service.AuditResult, error) {
+	return s.result, nil
+}
+
+func TestGetAuditResultUsesStoredTimestamps(t *testing.T) {
+	createdAt := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	reviewedAt := time.Date(2026, 9, 2, 10, 30, 0, 0, time.UTC)
+	svc := &stubAuditResultService{result: &service.AuditResult{
+		AuditID: 9, ContentID: "video-1", ContentType: "video", Status: "rejected",
+		Level: "high", ReviewerID: 42, ReviewTime: &reviewedAt, CreatedAt: createdAt, Found: true,
+	}}
+	h := NewAuditServiceHandler(svc, nopLogger{})
+
+	resp, err := h.GetAuditResult(context.Background(), &auditv1.GetAuditResultRequest{AuditId: 9})
+	if err != nil {
+		t.Fatalf("GetAuditResult: %v", err)
+	}
+	if !resp.CreatedAt.AsTime().Equal(createdAt) {
+		t.Errorf("created_at = %v, want stored %v", resp.CreatedAt.AsTime(), createdAt)
+	}
+	if resp.ReviewedAt == nil || !resp.ReviewedAt.AsTime().Equal(reviewedAt) {
+		t.Errorf("reviewed_at = %v, want stored %v", resp.ReviewedAt, reviewedAt)
+	}
+	if resp.Level != auditv1.AuditLevel_AUDIT_LEVEL_HIGH || resp.ReviewerId != 42 {
+		t.Errorf("level = %s reviewer = %d, want high/42", resp.Level, resp.ReviewerId)
+	}
+
+	// 未审核的记录不返回审核时间
+	svc.result.ReviewTime, svc.result.ReviewerID = nil, 0
+	resp, err = h.GetAuditResult(context.Background(), &auditv1.GetAuditResultRequest{AuditId: 9})
+	if err != nil {
+		t.Fatalf("GetAuditResult: %v", err)
+	}
+	if resp.ReviewedAt != nil || !resp.CreatedAt.AsTime().Equal(createdAt) {
+		t.Errorf("pending response reviewed_at = %v created_at = %v, want nil and stored", resp.ReviewedAt, resp.CreatedAt.AsTime())
+	}
+}
+
+func TestGetAuditResultReturnsDecisionDetails(t *testing.T) {
+	details := `{"decision":"auto_blocked","score":0.9,"threshold":{"name":"auto_block","value":0.8}}`
+	svc := &stubAuditResultService{result: &service.AuditResult{
+		AuditID: 9, ContentID: "video-1", Status: "auto_blocked", Details: details, Found: true,
+	}}
+	h := NewAuditServiceHandler(svc, nopLogger{})
+
+	resp, err := h.GetAuditResult(context.Background(), &auditv1.GetAuditResultRequest{AuditId: 9})
+	if err != nil {
+		t.Fatalf("GetAuditResult: %v", err)
+	}
+	if resp.Details != details {
+		t.Errorf("details = %q, want the stored decision details %q", resp.Details, details)
+	}
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"audit_service/internal/model"
+	"audit_service/internal/service"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+// stubBatchSubmitService 记录收到的批量提交请求，每项返回一条审核记录
+type stubBatchSubmitService struct {
+	service.AuditService
+	got *service.BatchSubmitContentRequest
+}
+
+func (s *stubBatchSubmitService) BatchSubmitContent(ctx context.Context, req *service.BatchSubmitContentRequest) (*service.BatchSubmitContentResponse, error) {
+	s.got = req
+	results := make([]*service.SubmitContentResponse, 0, len(req.ContentIDs)+len(req.Items))
+	for i := 0; i < cap(results); i++ {
+		results = append(results, &service.SubmitContentResponse{AuditID: uint64(i + 1), Status: string(model.AuditStatusPending), Message: "queued"})
+	}
+	return &service.BatchSubmitContentResponse{Results: results, Message: "batch submitted"}, nil
+}
+
+func TestBatchSubmitContentPassesItemsToService(t *testing.T) {
+	req := &auditv1.BatchSubmitContentRequest{
+		ContentIds:  []string{"text-1"},
+		ContentType: auditv1.ContentType_CONTENT_TYPE_TEXT,
+		Content:     "共用内容",
+		UploaderId:  42,
+		Metadata:    map[string]string{"source": "shared"},
+		Items: []*auditv1.BatchSubmitItem{
+			{ContentId: "text-2", Content: "第二条", Metadata: map[string]string{"source": "item"}, ContentTitle: "标题", ContentUrl: "https://cdn.example.com/2"},
+			{ContentId: "text-3"},
+		},
+	}
+	// 经过序列化往返，确认新增的批量提交消息可以正常编解码
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded auditv1.BatchSubmitContentRequest
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	svc := &stubBatchSubmitService{}
+	resp, err := NewAuditServiceHandler(svc, nopLogger{}).BatchSubmitContent(context.Background(), &decoded)
+	if err != nil {
+		t.Fatalf("BatchSubmitContent: %v", err)
+	}
+
+	got := svc.got
+	if got.ContentType != "text" || got.UploaderID != "42" || got.Content != "共用内容" || got.Metadata != `{"source":"shared"}` {
+		t.Fatalf("shared fields = %+v", got)
+	}
+	if len(got.ContentIDs) != 1 || got.ContentIDs[0] != "text-1" || len(got.Items) != 2 {
+		t.Fatalf("content ids %v and %d items, want [text-1] and 2 items", got.ContentIDs, len(got.Items))
+	}
+	item := got.Items[0]
+	if item.ContentID != "text-2" || item.Content != "第二条" || item.Metadata != `{"source":"item"}` ||
+		item.ContentTitle != "标题" || item.ContentURL != "https://cdn.example.com/2" {
+		t.Fatalf("item = %+v", item)
+	}
+	if got.Items[1].ContentID != "text-3" || got.Items[1].Content != "" || got.Items[1].Metadata != "" {
+		t.Fatalf("empty item = %+v, want only content_id", got.Items[1])
+	}
+
+	if len(resp.Results) != 3 || resp.Message != "batch submitted" {
+		t.Fatalf("response = %v", resp)
+	}
+	if r := resp.Results[2]; r.AuditId != 3 || r.Status != auditv1.AuditStatus_AUDIT_STATUS_PENDING || r.Reason != "queued" {
+		t.Fatalf("result = %v", r)
+	}
+}
+
+func TestBatchSubmitContentRejectsInvalidBatches(t *testing.T) {
+	h := NewAuditServiceHandler(&stubBatchSubmitService{}, nopLogger{})
+	tests := map[string]*auditv1.BatchSubmitContentRequest{
+		"empty batch":             {ContentType: auditv1.ContentType_CONTENT_TYPE_TEXT, UploaderId: 42},
+		"item without content_id": {ContentType: auditv1.ContentType_CONTENT_TYPE_TEXT, UploaderId: 42, Items: []*auditv1.BatchSubmitItem{{Content: "无ID"}}},
+	}
+	for name, req := range tests {
+		if _, err := h.BatchSubmitContent(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: error = %v, want InvalidArgument", name, err)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"audit_service/internal/enums"
+	"audit_service/internal/service"
+	"audit_service/pkg/ids"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	auditv1 "audit_service/proto_gen/audit/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// requestBinder 将proto请求转换为带binding标签的service请求
+type requestBinder func(req interface{}) interface{}
+
+// requestBinders 需要在拦截器中校验的RPC，key为gRPC完整方法名
+var requestBinders = map[string]requestBinder{
+	auditv1.AuditService_SubmitContent_FullMethodName: func(req interface{}) interface{} {
+		return bindSubmitContentRequest(req.(*auditv1.SubmitContentRequest))
+	},
+	auditv1.AuditService_BatchSubmitContent_FullMethodName: func(req interface{}) interface{} {
+		return bindBatchSubmitContentRequest(req.(*auditv1.BatchSubmitContentRequest))
+	},
+	auditv1.AuditService_UpdateAuditStatus_FullMethodName: func(req interface{}) interface{} {
+		return bindUpdateAuditStatusRequest(req.(*auditv1.UpdateAuditStatusRequest))
+	},
+	auditv1.AuditService_AddToWhitelist_FullMethodName: func(req interface{}) interface{} {
+		return bindAddToWhitelistRequest(req.(*auditv1.AddToWhitelistRequest))
+	},
+	auditv1.AuditService_AddToBlacklist_FullMethodName: func(req interface{}) interface{} {
+		return bindAddToBlacklistRequest(req.(*auditv1.AddToBlacklistRequest))
+	},
+	auditv1.AuditService_AssignManualReview_FullMethodName: func(req interface{}) interface{} {
+		return bindAssignManualReviewRequest(req.(*auditv1.AssignManualReviewRequest))
+	},
+	auditv1.AuditService_GetAuditStatistics_FullMethodName: func(req interface{}) interface{} {
+		return bindGetAuditStatisticsRequest(req.(*auditv1.GetAuditStatisticsRequest))
+	},
+	auditv1.AuditService_GetViolationTrends_FullMethodName: func(req interface{}) interface{} {
+		return bindGetViolationTrendsRequest(req.(*auditv1.GetViolationTrendsRequest))
+	},
+}
+
+// ValidationUnaryInterceptor 请求校验拦截器
+// 在进入业务逻辑前校验请求大小和service请求结构体上的binding标签，不合法时返回InvalidArgument
+func ValidationUnaryInterceptor(maxRequestSize int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok || reflect.ValueOf(req).IsNil() {
+			return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+		}
+
+		if maxRequestSize > 0 {
+			if size := proto.Size(msg); size > maxRequestSize {
+				return nil, status.Errorf(codes.InvalidArgument, "request size %d exceeds limit %d", size, maxRequestSize)
+			}
+		}
+
+		if bind, ok := requestBinders[info.FullMethod]; ok {
+			if err := validateBinding(bind(req)); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// validateBinding 按binding标签校验结构体字段，支持required、min、max
+// min/max 对数字校验取值范围，对字符串和切片校验长度
+func validateBinding(v interface{}) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("binding")
+		if tag == "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = field.Name
+		}
+
+		value := rv.Field(i)
+		for _, rule := range strings.Split(tag, ",") {
+			key, arg, _ := strings.Cut(rule, "=")
+			switch key {
+			case "required":
+				if value.IsZero() || (value.Kind() == reflect.Slice && value.Len() == 0) {
+					return fmt.Errorf("%s is required", name)
+				}
+			case "min", "max":
+				limit, err := strconv.ParseFloat(arg, 64)
+				if err != nil {
+					return fmt.Errorf("invalid binding rule %q on %s", rule, name)
+				}
+				n, ok := bindingMeasure(value)
+				if !ok {
+					continue
+				}
+				if key == "min" && n < limit {
+					return fmt.Errorf("%s must be at least %s", name, arg)
+				}
+				if key == "max" && n > limit {
+					return fmt.Errorf("%s must be at most %s", name, arg)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// bindingMeasure 返回用于min/max比较的数值
+func bindingMeasure(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String, reflect.Slice, reflect.Map:
+		return float64(v.Len()), true
+	}
+	return 0, false
+}
+
+// bindSubmitContentRequest 转换提交内容审核请求
+func bindSubmitContentRequest(req *auditv1.SubmitContentRequest) service.SubmitContentRequest {
+	return service.SubmitContentRequest{
+		ContentID:       req.ContentId,
+		ContentType:     enums.ContentTypeToString(req.ContentType),
+		ContentTitle:    req.ContentTitle,
+		ContentURL:      req.ContentUrl,
+		ContentMetadata: metadataJSON(req.Metadata),
+		UploaderID:      ids.Format(req.UploaderId),
+		UploaderName:    "", // 这个字段在proto中不存在
+		Content:         req.Content,
+	}
+}
+
+// bindBatchSubmitContentRequest 转换批量提交内容审核请求
+func bindBatchSubmitContentRequest(req *auditv1.BatchSubmitContentRequest) service.BatchSubmitContentRequest {
+	items := make([]*service.BatchSubmitItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item == nil {
+			continue
+		}
+		items = append(items, &service.BatchSubmitItem{
+			ContentID:    item.ContentId,
+			ContentTitle: item.ContentTitle,
+			ContentURL:   item.ContentUrl,
+			Content:      item.Content,
+			Metadata:     metadataJSON(item.Metadata),
+		})
+	}
+
+	return service.BatchSubmitContentRequest{
+		ContentIDs:  req.ContentIds,
+		Items:       items,
+		ContentType: enums.ContentTypeToString(req.ContentType),
+		Content:     req.Content,
+		UploaderID:  ids.Format(req.UploaderId),
+		Metadata:    metadataJSON(req.Metadata),
+	}
+}
+
+// metadataJSON 将元数据转换为审核记录保存的JSON，没有元数据时返回空字符串
+func metadataJSON(metadata map[string]string) string {
+	if len(metadata) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// bindUpdateAuditStatusRequest 转换更新审核状态请求
+func bindUpdateAuditStatusRequest(req *auditv1.UpdateAuditStatusRequest) service.UpdateAuditStatusRequest {
+	return service.UpdateAuditStatusRequest{
+		AuditID:    req.AuditId,
+		Status:     enums.AuditStatusToString(req.Status),
+		ReviewerID: req.ReviewerId,
+		Reason:     req.Reason,
+		Violations: strings.Join(req.Violations, ","),
+	}
+}
+
+// bindAddToWhitelistRequest 转换添加到白名单请求
+func bindAddToWhitelistRequest(req *auditv1.AddToWhitelistRequest) service.AddToWhitelistRequest {
+	return service.AddToWhitelistRequest{
+		ContentID:   req.ContentId,
+		ContentType: enums.ContentTypeToString(req.ContentType),
+		Reason:      req.Reason,
+		CreatedBy:   req.CreatedBy,
+	}
+}
+
+// bindAddToBlacklistRequest 转换添加到黑名单请求
+func bindAddToBlacklistRequest(req *auditv1.AddToBlacklistRequest) service.AddToBlacklistRequest {
+	return service.AddToBlacklistRequest{
+		ContentID:   req.ContentId,
+		ContentType: enums.ContentTypeToString(req.ContentType),
+		Reason:      req.Reason,
+		CreatedBy:   req.CreatedBy,
+	}
+}
+
+// bindAssignManualReviewRequest 转换分配人工审核请求
+func bindAssignManualReviewRequest(req *auditv1.AssignManualReviewRequest) service.AssignManualReviewRequest {
+	return service.AssignManualReviewRequest{
+		AuditID:    req.AuditId,
+		ReviewerID: req.ReviewerId,
+	}
+}
+
+// bindGetAuditStatisticsRequest 转换获取审核统计请求
+func bindGetAuditStatisticsRequest(req *auditv1.GetAuditStatisticsRequest) service.GetAuditStatisticsRequest {
+	return service.GetAuditStatisticsRequest{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+}
+
+// bindGetViolationTrendsRequest 转换获取违规趋势请求
+func bindGetViolationTrendsRequest(req *auditv1.GetViolationTrendsRequest) service.GetViolationTrendsRequest {
+	return service.GetViolationTrendsRequest{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+}
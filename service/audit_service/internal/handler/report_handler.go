@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"audit_service/internal/enums"
+	"audit_service/internal/model"
+	"audit_service/internal/service"
+	"context"
+	"errors"
+
+	auditv1 "audit_service/proto_gen/audit/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReportContent 用户举报直播或视频等内容
+func (h *AuditServiceHandler) ReportContent(ctx context.Context, req *auditv1.ReportContentRequest) (*auditv1.ReportContentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.ContentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "content_id is required")
+	}
+	if req.ReporterId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "reporter_id is required")
+	}
+
+	contentType := model.ContentType(enums.ContentTypeToString(req.ContentType))
+	switch contentType {
+	case model.ContentTypeText, model.ContentTypeImage, model.ContentTypeVideo, model.ContentTypeAudio, model.ContentTypeLive:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unsupported content_type")
+	}
+
+	result, err := h.service.ReportContent(ctx, &service.ReportContentRequest{
+		ContentID:   req.ContentId,
+		ContentType: string(contentType),
+		ReporterID:  req.ReporterId,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrDuplicateReport) {
+			return nil, status.Error(codes.AlreadyExists, "content already reported")
+		}
+		h.logger.Error("Failed to report content", "error", err, "content_id", req.ContentId, "reporter_id", req.ReporterId)
+		return nil, status.Error(codes.Internal, "failed to report content")
+	}
+
+	return &auditv1.ReportContentResponse{
+		ReportId:    result.ReportID,
+		ReportCount: result.ReportCount,
+		Escalated:   result.Escalated,
+		AuditId:     result.AuditID,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"audit_service/internal/service"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+// stubReportService 记录举报请求并返回预设结果
+type stubReportService struct {
+	service.AuditService
+	resp *service.ReportContentResponse
+	err  error
+	req  *service.ReportContentRequest
+}
+
+func (s *stubReportService) ReportContent(ctx context.Context, req *service.ReportContentRequest) (*service.ReportContentResponse, error) {
+	s.req = req
+	return s.resp, s.err
+}
+
+func TestReportContent(t *testing.T) {
+	svc := &stubReportService{resp: &service.ReportContentResponse{ReportID: 4, ReportCount: 5, Escalated: true, AuditID: 9}}
+	h := NewAuditServiceHandler(svc, nopLogger{})
+
+	resp, err := h.ReportContent(context.Background(), &auditv1.ReportContentRequest{
+		ContentId: "live-1", ContentType: auditv1.ContentType_CONTENT_TYPE_LIVE, ReporterId: 7, Reason: "低俗",
+	})
+	if err != nil {
+		t.Fatalf("ReportContent: %v", err)
+	}
+	if svc.req.ContentID != "live-1" || svc.req.ContentType != "live" || svc.req.ReporterID != 7 || svc.req.Reason != "低俗" {
+		t.Errorf("service request = %+v", svc.req)
+	}
+	if resp.ReportId != 4 || resp.ReportCount != 5 || !resp.Escalated || resp.AuditId != 9 {
+		t.Errorf("response = %v, want service result", resp)
+	}
+}
+
+func TestReportContentErrors(t *testing.T) {
+	valid := func() *auditv1.ReportContentRequest {
+		return &auditv1.ReportContentRequest{ContentId: "video-1", ContentType: auditv1.ContentType_CONTENT_TYPE_VIDEO, ReporterId: 7}
+	}
+	tests := []struct {
+		name   string
+		req    *auditv1.ReportContentRequest
+		svcErr error
+		want   codes.Code
+	}{
+		{"nil request", nil, nil, codes.InvalidArgument},
+		{"missing content id", func() *auditv1.ReportContentRequest { r := valid(); r.ContentId = ""; return r }(), nil, codes.InvalidArgument},
+		{"missing reporter", func() *auditv1.ReportContentRequest { r := valid(); r.ReporterId = 0; return r }(), nil, codes.InvalidArgument},
+		{"unsupported type", func() *auditv1.ReportContentRequest {
+			r := valid()
+			r.ContentType = auditv1.ContentType_CONTENT_TYPE_UNSPECIFIED
+			return r
+		}(), nil, codes.InvalidArgument},
+		{"duplicate report", valid(), service.ErrDuplicateReport, codes.AlreadyExists},
+		{"service failure", valid(), errors.New("db down"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &stubReportService{err: tt.svcErr}
+			_, err := NewAuditServiceHandler(svc, nopLogger{}).ReportContent(context.Background(), tt.req)
+			if status.Code(err) != tt.want {
+				t.Fatalf("error = %v, want %s", err, tt.want)
+			}
+			if tt.want == codes.InvalidArgument && svc.req != nil {
+				t.Errorf("service called for invalid request: %+v", svc.req)
+			}
+		})
+	}
+}
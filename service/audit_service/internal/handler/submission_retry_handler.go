@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"audit_service/internal/enums"
+	"audit_service/internal/model"
+	"audit_service/internal/service"
+	"audit_service/pkg/timeconv"
+	"context"
+	"errors"
+
+	auditv1 "audit_service/proto_gen/audit/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListFailedSubmissions 获取提交失败的审核内容
+func (h *AuditServiceHandler) ListFailedSubmissions(ctx context.Context, req *auditv1.ListFailedSubmissionsRequest) (*auditv1.ListFailedSubmissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	switch model.SubmissionRetryStatus(req.Status) {
+	case "", model.SubmissionRetryPending, model.SubmissionRetrySucceeded, model.SubmissionRetryExhausted:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid submission retry status")
+	}
+
+	result, err := h.service.ListFailedSubmissions(ctx, &service.ListFailedSubmissionsRequest{
+		Status:   req.Status,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		h.logger.Error("Failed to list failed submissions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list failed submissions")
+	}
+
+	submissions := make([]*auditv1.FailedSubmission, len(result.Submissions))
+	for i, submission := range result.Submissions {
+		submissions[i] = failedSubmissionToProto(submission)
+	}
+
+	return &auditv1.ListFailedSubmissionsResponse{
+		Total:       result.Total,
+		Page:        int32(result.Page),
+		PageSize:    int32(result.PageSize),
+		Submissions: submissions,
+	}, nil
+}
+
+// RetryFailedSubmission 人工重试提交失败的审核内容
+func (h *AuditServiceHandler) RetryFailedSubmission(ctx context.Context, req *auditv1.RetryFailedSubmissionRequest) (*auditv1.RetryFailedSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	submission, err := h.service.RetryFailedSubmission(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrSubmissionRetryNotFound):
+			return nil, status.Error(codes.NotFound, "failed submission not found")
+		case errors.Is(err, service.ErrSubmissionAlreadySucceeded):
+			return nil, status.Error(codes.FailedPrecondition, "submission already succeeded")
+		case errors.Is(err, service.ErrSubmissionRetryInProgress):
+			return nil, status.Error(codes.Aborted, "submission retry is already in progress")
+		}
+		h.logger.Error("Failed to retry failed submission", "error", err, "id", req.Id)
+		return nil, status.Error(codes.Internal, "failed to retry failed submission")
+	}
+
+	success := submission.Status == string(model.SubmissionRetrySucceeded)
+	message := "Submission retried successfully"
+	if !success {
+		message = "Submission retry failed: " + submission.LastError
+	}
+
+	return &auditv1.RetryFailedSubmissionResponse{
+		Success:    success,
+		Message:    message,
+		Submission: failedSubmissionToProto(submission),
+	}, nil
+}
+
+// failedSubmissionToProto 转换为proto失败提交
+func failedSubmissionToProto(submission *service.FailedSubmission) *auditv1.FailedSubmission {
+	return &auditv1.FailedSubmission{
+		Id:          submission.ID,
+		ContentId:   submission.ContentID,
+		ContentType: enums.ContentTypeFromString(submission.ContentType),
+		Status:      submission.Status,
+		Attempts:    int32(submission.Attempts),
+		LastError:   submission.LastError,
+		NextRetryAt: timeconv.ToProto(submission.NextRetryAt),
+		AuditId:     submission.AuditID,
+		CreatedAt:   timeconv.ToProto(submission.CreatedAt),
+		UpdatedAt:   timeconv.ToProto(submission.UpdatedAt),
+	}
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"audit_service/internal/service"
+)
+
+func TestFailedSubmissionToProtoTimestamps(t *testing.T) {
+	createdAt := time.Date(2026, 9, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	submission := &service.FailedSubmission{ID: 3, ContentID: "c-1", ContentType: "text", CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	got := failedSubmissionToProto(submission)
+	if !got.CreatedAt.AsTime().Equal(createdAt) || got.CreatedAt.AsTime().Hour() != 0 {
+		t.Errorf("created_at = %v, want %v as UTC", got.CreatedAt.AsTime(), createdAt)
+	}
+	// 未安排重试的提交不返回公元1年的时间戳
+	if got.NextRetryAt != nil {
+		t.Errorf("next_retry_at = %v, want nil for a zero time", got.NextRetryAt)
+	}
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"audit_service/internal/model"
+	"audit_service/internal/service"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+// stubSubmitService 记录收到的提交请求
+type stubSubmitService struct {
+	service.AuditService
+	got *service.SubmitContentRequest
+}
+
+func (s *stubSubmitService) SubmitContent(ctx context.Context, req *service.SubmitContentRequest) (*service.SubmitContentResponse, error) {
+	s.got = req
+	return &service.SubmitContentResponse{AuditID: 1, Status: string(model.AuditStatusPending)}, nil
+}
+
+func TestSubmitContentPassesTitleURLAndMetadata(t *testing.T) {
+	req := &auditv1.SubmitContentRequest{
+		ContentId:    "video_9",
+		ContentType:  auditv1.ContentType_CONTENT_TYPE_VIDEO,
+		ContentTitle: "我的视频",
+		ContentUrl:   "https://cdn.example.com/v/9.mp4",
+		UploaderId:   42,
+		Content:      "简介",
+		Metadata:     map[string]string{"title": "我的视频", "video_url": "https://cdn.example.com/v/9.mp4"},
+	}
+	// 经过序列化往返，确认新增字段可以正常编解码
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded auditv1.SubmitContentRequest
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	svc := &stubSubmitService{}
+	if _, err := NewAuditServiceHandler(svc, nopLogger{}).SubmitContent(context.Background(), &decoded); err != nil {
+		t.Fatalf("SubmitContent: %v", err)
+	}
+
+	got := svc.got
+	if got.ContentTitle != "我的视频" || got.ContentURL != "https://cdn.example.com/v/9.mp4" || got.Content != "简介" {
+		t.Errorf("request = %+v, want title, url and content passed through", got)
+	}
+	var metadata map[string]string
+	if err := json.Unmarshal([]byte(got.ContentMetadata), &metadata); err != nil {
+		t.Fatalf("metadata %q is not JSON: %v", got.ContentMetadata, err)
+	}
+	if len(metadata) != 2 || metadata["video_url"] != req.ContentUrl {
+		t.Errorf("metadata = %v, want the request metadata", metadata)
+	}
+}
+
+func TestSubmitContentWithoutOptionalFields(t *testing.T) {
+	svc := &stubSubmitService{}
+	req := &auditv1.SubmitContentRequest{ContentId: "text_1", ContentType: auditv1.ContentType_CONTENT_TYPE_TEXT, UploaderId: 42, Content: "评论"}
+	if _, err := NewAuditServiceHandler(svc, nopLogger{}).SubmitContent(context.Background(), req); err != nil {
+		t.Fatalf("SubmitContent: %v", err)
+	}
+	// 未提供的字段保持为空，没有元数据时不写入"{}"或"null"
+	if got := svc.got; got.ContentTitle != "" || got.ContentURL != "" || got.ContentMetadata != "" {
+		t.Errorf("request = %+v, want empty title, url and metadata", got)
+	}
+}
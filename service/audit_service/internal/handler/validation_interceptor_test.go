@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"audit_service/internal/service"
+	auditv1 "audit_service/proto_gen/audit/v1"
+)
+
+func invokeValidation(t *testing.T, maxRequestSize int, method string, req interface{}) (bool, error) {
+	t.Helper()
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+	_, err := ValidationUnaryInterceptor(maxRequestSize)(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return called, err
+}
+
+func validSubmitContentRequest() *auditv1.SubmitContentRequest {
+	return &auditv1.SubmitContentRequest{
+		ContentId:   "text-1",
+		ContentType: auditv1.ContentType_CONTENT_TYPE_TEXT,
+		Content:     "正常内容",
+		UploaderId:  42,
+	}
+}
+
+func TestValidationInterceptorPassesValidRequest(t *testing.T) {
+	called, err := invokeValidation(t, 1024, auditv1.AuditService_SubmitContent_FullMethodName, validSubmitContentRequest())
+	if err != nil || !called {
+		t.Fatalf("valid request: called=%v err=%v, want handler called", called, err)
+	}
+}
+
+func TestValidationInterceptorRejectsOversizeRequest(t *testing.T) {
+	req := validSubmitContentRequest()
+	req.Content = strings.Repeat("a", 2048)
+
+	called, err := invokeValidation(t, 1024, auditv1.AuditService_SubmitContent_FullMethodName, req)
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "exceeds limit 1024") {
+		t.Fatalf("oversize request error = %v, want InvalidArgument size limit", err)
+	}
+	if called {
+		t.Fatal("handler called for oversize request")
+	}
+
+	// 未配置上限时不限制大小，方法也不在校验表中时直接放行
+	if called, err := invokeValidation(t, 0, auditv1.AuditService_SubmitContent_FullMethodName, req); err != nil || !called {
+		t.Fatalf("unlimited size: called=%v err=%v, want handler called", called, err)
+	}
+	if called, err := invokeValidation(t, 1024, auditv1.AuditService_SubmitContent_FullMethodName, (*auditv1.SubmitContentRequest)(nil)); status.Code(err) != codes.InvalidArgument || called {
+		t.Fatalf("nil request: called=%v err=%v, want InvalidArgument", called, err)
+	}
+}
+
+func TestValidationInterceptorRejectsInvalidFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		req     interface{}
+		message string
+	}{
+		{
+			name:    "missing content id",
+			method:  auditv1.AuditService_SubmitContent_FullMethodName,
+			req:     &auditv1.SubmitContentRequest{ContentType: auditv1.ContentType_CONTENT_TYPE_TEXT, UploaderId: 42},
+			message: "content_id is required",
+		},
+		{
+			name:    "unspecified content type",
+			method:  auditv1.AuditService_SubmitContent_FullMethodName,
+			req:     &auditv1.SubmitContentRequest{ContentId: "text-1", UploaderId: 42},
+			message: "content_type is required",
+		},
+		{
+			name:    "missing reviewer",
+			method:  auditv1.AuditService_UpdateAuditStatus_FullMethodName,
+			req:     &auditv1.UpdateAuditStatusRequest{AuditId: 1, Status: auditv1.AuditStatus_AUDIT_STATUS_PASSED},
+			message: "reviewer_id is required",
+		},
+		{
+			name:    "batch without content type",
+			method:  auditv1.AuditService_BatchSubmitContent_FullMethodName,
+			req:     &auditv1.BatchSubmitContentRequest{ContentIds: []string{"text-1"}, UploaderId: 42},
+			message: "content_type is required",
+		},
+	}
+	for _, tt := range tests {
+		called, err := invokeValidation(t, 0, tt.method, tt.req)
+		if status.Code(err) != codes.InvalidArgument || !strings.Contains(status.Convert(err).Message(), tt.message) {
+			t.Errorf("%s: error = %v, want InvalidArgument containing %q", tt.name, err, tt.message)
+		}
+		if called {
+			t.Errorf("%s: handler called for invalid request", tt.name)
+		}
+	}
+}
+
+func TestValidateBindingMinMax(t *testing.T) {
+	tests := []struct {
+		req     service.ListTemplatesRequest
+		message string
+	}{
+		{service.ListTemplatesRequest{Page: 1, PageSize: 20}, ""},
+		{service.ListTemplatesRequest{Page: 0, PageSize: 20}, "page must be at least 1"},
+		{service.ListTemplatesRequest{Page: 1, PageSize: 101}, "page_size must be at most 100"},
+		{service.ListTemplatesRequest{Page: 1, PageSize: 100}, ""},
+	}
+	for _, tt := range tests {
+		err := validateBinding(tt.req)
+		if tt.message == "" {
+			if err != nil {
+				t.Errorf("validateBinding(%+v) = %v, want nil", tt.req, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.message {
+			t.Errorf("validateBinding(%+v) = %v, want %q", tt.req, err, tt.message)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package model
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -13,8 +17,85 @@ const (
 	AuditStatusRejected    AuditStatus = "rejected"     // 已拒绝
 	AuditStatusAutoPassed  AuditStatus = "auto_passed"  // 自动通过
 	AuditStatusAutoBlocked AuditStatus = "auto_blocked" // 自动拦截
+	AuditStatusAppealing   AuditStatus = "appealing"    // 申诉中
 )
 
+// ViolationCategory 违规类型
+type ViolationCategory string
+
+const (
+	ViolationNudity     ViolationCategory = "nudity"      // 色情低俗
+	ViolationViolence   ViolationCategory = "violence"    // 暴力血腥
+	ViolationSpam       ViolationCategory = "spam"        // 垃圾广告
+	ViolationCopyright  ViolationCategory = "copyright"   // 侵犯版权
+	ViolationHateSpeech ViolationCategory = "hate_speech" // 仇恨言论
+	ViolationPolitical  ViolationCategory = "political"   // 政治敏感
+	ViolationFraud      ViolationCategory = "fraud"       // 诈骗
+	ViolationOther      ViolationCategory = "other"       // 其他
+)
+
+// ErrInvalidViolationCategory 未知的违规类型
+var ErrInvalidViolationCategory = errors.New("invalid violation category")
+
+// IsValid 判断违规类型是否在分类体系内
+func (c ViolationCategory) IsValid() bool {
+	switch c {
+	case ViolationNudity, ViolationViolence, ViolationSpam, ViolationCopyright,
+		ViolationHateSpeech, ViolationPolitical, ViolationFraud, ViolationOther:
+		return true
+	}
+	return false
+}
+
+// ParseViolations 解析并校验违规类型列表
+// 支持JSON数组或逗号分隔两种格式，返回去重后的列表
+func ParseViolations(raw string) ([]ViolationCategory, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var items []string
+	if strings.HasPrefix(raw, "[") {
+		if err := json.Unmarshal([]byte(raw), &items); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidViolationCategory, err)
+		}
+	} else {
+		items = strings.Split(raw, ",")
+	}
+
+	seen := make(map[ViolationCategory]bool, len(items))
+	categories := make([]ViolationCategory, 0, len(items))
+	for _, item := range items {
+		category := ViolationCategory(strings.ToLower(strings.TrimSpace(item)))
+		if category == "" || seen[category] {
+			continue
+		}
+		if !category.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidViolationCategory, item)
+		}
+		seen[category] = true
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
+
+// NormalizeViolations 校验违规类型并转换为JSON数组存储
+func NormalizeViolations(raw string) (string, error) {
+	categories, err := ParseViolations(raw)
+	if err != nil {
+		return "", err
+	}
+	if len(categories) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(categories)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // ContentType 内容类型
 type ContentType string
 
@@ -23,6 +104,7 @@ const (
 	ContentTypeImage ContentType = "image"
 	ContentTypeText  ContentType = "text"
 	ContentTypeAudio ContentType = "audio"
+	ContentTypeLive  ContentType = "live"
 )
 
 // AuditLevel 审核级别
@@ -34,6 +116,12 @@ const (
 	AuditLevelHigh   AuditLevel = "high"
 )
 
+// 人工审核优先级，数值越大越先处理
+const (
+	AuditPriorityNormal = 0  // 普通
+	AuditPriorityAppeal = 10 // 申诉，优先于普通待审核内容处理
+)
+
 // AuditRecord 审核记录
 type AuditRecord struct {
 	ID              uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
@@ -41,6 +129,7 @@ type AuditRecord struct {
 	ContentType     ContentType `gorm:"index;not null;type:varchar(20)" json:"content_type"`
 	ContentTitle    string      `gorm:"type:varchar(255)" json:"content_title"`
 	ContentURL      string      `gorm:"type:text" json:"content_url"`
+	Content         string      `gorm:"type:text" json:"content"`
 	ContentMetadata string      `gorm:"type:json" json:"content_metadata"`
 	UploaderID      uint64      `gorm:"index;not null" json:"uploader_id"`
 	UploaderName    string      `gorm:"type:varchar(100)" json:"uploader_name"`
@@ -48,6 +137,7 @@ type AuditRecord struct {
 	// 审核信息
 	Status       AuditStatus `gorm:"index;not null;type:varchar(20)" json:"status"`
 	Level        AuditLevel  `gorm:"index;not null;type:varchar(10)" json:"level"`
+	Priority     int         `gorm:"index;not null;default:0" json:"priority"` // 人工审核优先级
 	Score        float64     `gorm:"type:decimal(5,4)" json:"score"`
 	AIResult     string      `gorm:"type:json" json:"ai_result"`
 	AIConfidence float64     `gorm:"type:decimal(5,4)" json:"ai_confidence"`
@@ -123,8 +213,8 @@ func (AuditTemplate) TableName() string {
 // AuditWhitelist 审核白名单
 type AuditWhitelist struct {
 	ID          uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
-	ContentID   string      `gorm:"uniqueIndex;not null" json:"content_id"`
-	ContentType ContentType `gorm:"index;not null;type:varchar(20)" json:"content_type"`
+	ContentID   string      `gorm:"uniqueIndex:idx_whitelist_content,priority:1;not null;size:100" json:"content_id"`
+	ContentType ContentType `gorm:"uniqueIndex:idx_whitelist_content,priority:2;index;not null;type:varchar(20)" json:"content_type"`
 	UploaderID  uint64      `gorm:"index;not null" json:"uploader_id"`
 
 	// 白名单信息
@@ -145,8 +235,8 @@ func (AuditWhitelist) TableName() string {
 // AuditBlacklist 审核黑名单
 type AuditBlacklist struct {
 	ID          uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
-	ContentID   string      `gorm:"index;not null" json:"content_id"`
-	ContentType ContentType `gorm:"index;not null;type:varchar(20)" json:"content_type"`
+	ContentID   string      `gorm:"uniqueIndex:idx_blacklist_content,priority:1;not null;size:100" json:"content_id"`
+	ContentType ContentType `gorm:"uniqueIndex:idx_blacklist_content,priority:2;index;not null;type:varchar(20)" json:"content_type"`
 	UploaderID  uint64      `gorm:"index;not null" json:"uploader_id"`
 
 	// 黑名单信息
@@ -206,3 +296,104 @@ type AuditStatistics struct {
 func (AuditStatistics) TableName() string {
 	return "audit_statistics"
 }
+
+// SubmissionRetryStatus 失败提交的重试状态
+type SubmissionRetryStatus string
+
+const (
+	SubmissionRetryPending   SubmissionRetryStatus = "pending"   // 等待重试
+	SubmissionRetrySucceeded SubmissionRetryStatus = "succeeded" // 重试成功
+	SubmissionRetryExhausted SubmissionRetryStatus = "exhausted" // 重试次数耗尽，需人工处理
+)
+
+// AuditSubmissionRetry 提交失败的审核内容（死信/重试队列）
+type AuditSubmissionRetry struct {
+	ID          uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	ContentID   string      `gorm:"index;not null;size:100" json:"content_id"`
+	ContentType ContentType `gorm:"not null;type:varchar(20)" json:"content_type"`
+
+	// 原始提交请求（JSON），用于重放
+	Payload string `gorm:"type:text;not null" json:"payload"`
+
+	// 重试信息
+	Status      SubmissionRetryStatus `gorm:"index;not null;type:varchar(20)" json:"status"`
+	Attempts    int                   `gorm:"default:0" json:"attempts"`
+	LastError   string                `gorm:"type:text" json:"last_error"`
+	NextRetryAt time.Time             `gorm:"index" json:"next_retry_at"`
+	AuditID     uint64                `gorm:"default:0" json:"audit_id"` // 重试成功后生成的审核ID
+	// LockedUntil 被后台任务或人工重试领取后的租约截止时间，租约内其他实例不会再次领取
+	// 处理结束后清空；处理中进程退出时，租约到期后记录可被重新领取
+	LockedUntil *time.Time `gorm:"index" json:"locked_until,omitempty"`
+
+	// 时间戳
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 表名
+func (AuditSubmissionRetry) TableName() string {
+	return "audit_submission_retries"
+}
+
+// AuditReport 用户举报记录，同一举报人对同一内容只保留一条
+type AuditReport struct {
+	ID          uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	ContentID   string      `gorm:"uniqueIndex:idx_report_content_reporter,priority:1;not null;size:100" json:"content_id"`
+	ContentType ContentType `gorm:"uniqueIndex:idx_report_content_reporter,priority:2;not null;type:varchar(20)" json:"content_type"`
+	ReporterID  uint64      `gorm:"uniqueIndex:idx_report_content_reporter,priority:3;not null" json:"reporter_id"`
+	Reason      string      `gorm:"type:text" json:"reason"`
+
+	// 时间戳
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 表名
+func (AuditReport) TableName() string {
+	return "audit_reports"
+}
+
+// AppealStatus 申诉状态
+type AppealStatus string
+
+const (
+	AppealStatusPending    AppealStatus = "pending"    // 待处理
+	AppealStatusUpheld     AppealStatus = "upheld"     // 维持原审核结果
+	AppealStatusOverturned AppealStatus = "overturned" // 撤销原审核结果
+)
+
+// AppealDecision 申诉处理结论
+type AppealDecision string
+
+const (
+	AppealDecisionUphold   AppealDecision = "uphold"   // 维持原审核结果
+	AppealDecisionOverturn AppealDecision = "overturn" // 撤销原审核结果，内容改为通过
+)
+
+// AuditAppeal 被拒绝内容的申诉记录
+// 提交申诉时审核记录进入申诉中状态，同一审核记录同时只能有一条待处理的申诉；申诉被驳回(维持原判)后不能再次申诉
+type AuditAppeal struct {
+	ID          uint64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	AuditID     uint64      `gorm:"index;not null" json:"audit_id"`
+	ContentID   string      `gorm:"index;not null;size:100" json:"content_id"`
+	ContentType ContentType `gorm:"not null;type:varchar(20)" json:"content_type"`
+	UploaderID  uint64      `gorm:"index;not null" json:"uploader_id"`
+	Reason      string      `gorm:"type:text" json:"reason"`
+
+	// 申诉前的审核结果，维持原判时恢复
+	PreviousStatus   AuditStatus `gorm:"not null;type:varchar(20)" json:"previous_status"`
+	PreviousPriority int         `gorm:"not null;default:0" json:"previous_priority"`
+
+	// 处理信息
+	Status     AppealStatus `gorm:"index;not null;type:varchar(20)" json:"status"`
+	ReviewerID *uint64      `gorm:"index" json:"reviewer_id"`
+	ResolvedAt *time.Time   `json:"resolved_at"`
+
+	// 时间戳
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 表名
+func (AuditAppeal) TableName() string {
+	return "audit_appeals"
+}
@@ -88,11 +88,19 @@ func AutoMigrate() error {
 	}
 
 	// 自动迁移所有模型
-	return db.AutoMigrate(
+	return db.AutoMigrate(Models()...)
+}
+
+// Models 需要迁移的全部模型
+func Models() []interface{} {
+	return []interface{}{
 		&AuditRecord{},
 		&AuditTemplate{},
 		&AuditWhitelist{},
 		&AuditBlacklist{},
 		&AuditStatistics{},
-	)
+		&AuditSubmissionRetry{},
+		&AuditReport{},
+		&AuditAppeal{},
+	}
 }
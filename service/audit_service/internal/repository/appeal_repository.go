@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"audit_service/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrAuditRecordNotFound 审核记录不存在
+	ErrAuditRecordNotFound = errors.New("audit record not found")
+	// ErrAppealNotAllowed 审核记录不是拒绝状态，不能申诉
+	ErrAppealNotAllowed = errors.New("only rejected audit records can be appealed")
+	// ErrAppealAlreadyOpen 审核记录已有待处理的申诉
+	ErrAppealAlreadyOpen = errors.New("audit record already has an open appeal")
+	// ErrAppealNotFound 审核记录没有待处理的申诉
+	ErrAppealNotFound = errors.New("no open appeal for audit record")
+	// ErrAppealFinal 审核记录的申诉已被驳回，维持原判为最终结果
+	ErrAppealFinal = errors.New("appeal for audit record was upheld and is final")
+	// ErrAppealInProgress 审核记录正在申诉中，只能通过处理申诉变更状态
+	ErrAppealInProgress = errors.New("audit record is under appeal")
+)
+
+// appealableStatuses 允许申诉的审核状态
+var appealableStatuses = []model.AuditStatus{model.AuditStatusRejected, model.AuditStatusAutoBlocked}
+
+// CreateAuditAppeal 提交申诉，审核记录转为申诉中并把人工审核优先级提升到priority，违规级别保持不变
+// 审核记录的状态变更是条件更新，并发提交时只有一个申诉能成功；申诉曾被驳回的审核记录不能再次申诉
+func (r *auditRepository) CreateAuditAppeal(ctx context.Context, auditID uint64, reason string, priority int) (*model.AuditAppeal, error) {
+	var appeal *model.AuditAppeal
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var record model.AuditRecord
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&record, auditID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAuditRecordNotFound
+			}
+			return fmt.Errorf("failed to get audit record: %w", err)
+		}
+		if record.Status == model.AuditStatusAppealing {
+			return ErrAppealAlreadyOpen
+		}
+
+		var upheld int64
+		if err := tx.Model(&model.AuditAppeal{}).
+			Where("audit_id = ? AND status = ?", auditID, model.AppealStatusUpheld).
+			Count(&upheld).Error; err != nil {
+			return fmt.Errorf("failed to check audit appeals: %w", err)
+		}
+		if upheld > 0 {
+			return ErrAppealFinal
+		}
+
+		result := tx.Model(&model.AuditRecord{}).
+			Where("id = ? AND status IN ?", auditID, appealableStatuses).
+			Updates(map[string]interface{}{
+				"status":      model.AuditStatusAppealing,
+				"priority":    priority,
+				"reviewer_id": nil,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update audit record: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrAppealNotAllowed
+		}
+
+		appeal = &model.AuditAppeal{
+			AuditID:          record.ID,
+			ContentID:        record.ContentID,
+			ContentType:      record.ContentType,
+			UploaderID:       record.UploaderID,
+			Reason:           reason,
+			PreviousStatus:   record.Status,
+			PreviousPriority: record.Priority,
+			Status:           model.AppealStatusPending,
+		}
+		if err := tx.Create(appeal).Error; err != nil {
+			return fmt.Errorf("failed to create audit appeal: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appeal, nil
+}
+
+// ResolveAuditAppeal 处理待处理的申诉
+// 维持原判时审核记录恢复申诉前的状态，维持结果为最终结果；撤销原判时审核记录改为通过，并移出黑名单
+// 两种结论都会把人工审核优先级恢复为申诉前的值
+func (r *auditRepository) ResolveAuditAppeal(ctx context.Context, auditID uint64, decision model.AppealDecision, reviewerID uint64, resolvedAt time.Time) (*model.AuditAppeal, error) {
+	var appeal model.AuditAppeal
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("audit_id = ? AND status = ?", auditID, model.AppealStatusPending).
+			Order("id DESC").
+			First(&appeal).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAppealNotFound
+			}
+			return fmt.Errorf("failed to get audit appeal: %w", err)
+		}
+
+		updates := map[string]interface{}{
+			"reviewer_id": reviewerID,
+			"review_time": resolvedAt,
+			"priority":    appeal.PreviousPriority,
+		}
+		if decision == model.AppealDecisionOverturn {
+			appeal.Status = model.AppealStatusOverturned
+			updates["status"] = model.AuditStatusApproved
+		} else {
+			appeal.Status = model.AppealStatusUpheld
+			updates["status"] = appeal.PreviousStatus
+		}
+
+		result := tx.Model(&model.AuditRecord{}).
+			Where("id = ? AND status = ?", auditID, model.AuditStatusAppealing).
+			Updates(updates)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update audit record: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrAppealNotFound
+		}
+
+		if appeal.Status == model.AppealStatusOverturned {
+			if err := tx.Where("content_id = ? AND content_type = ?", appeal.ContentID, appeal.ContentType).
+				Delete(&model.AuditBlacklist{}).Error; err != nil {
+				return fmt.Errorf("failed to remove from blacklist: %w", err)
+			}
+		}
+
+		appeal.ReviewerID = &reviewerID
+		appeal.ResolvedAt = &resolvedAt
+		if err := tx.Save(&appeal).Error; err != nil {
+			return fmt.Errorf("failed to update audit appeal: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// ListPendingAppeals 获取待处理的申诉，按提交时间先后排列
+func (r *auditRepository) ListPendingAppeals(ctx context.Context, page, pageSize int) ([]*model.AuditAppeal, int64, error) {
+	query := r.conn(ctx).Model(&model.AuditAppeal{}).
+		Where("status = ?", model.AppealStatusPending)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count pending appeals: %w", err)
+	}
+
+	var appeals []*model.AuditAppeal
+	if err := query.Order("created_at ASC").Order("id ASC").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&appeals).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list pending appeals: %w", err)
+	}
+	return appeals, total, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+)
+
+// AuditFilter 审核记录列表、人工审核队列和统计共用的过滤条件，零值字段不参与过滤
+// 通过With方法链式构造，例如 AuditFilter{}.WithContentType("video").WithDateRange(start, end)
+type AuditFilter struct {
+	ContentType string `json:"content_type"` // 内容类型
+	Status      string `json:"status"`       // 审核状态
+	Level       string `json:"level"`        // 违规等级
+	UploaderID  uint64 `json:"uploader_id"`  // 上传者ID
+	ReviewerID  uint64 `json:"reviewer_id"`  // 审核员ID
+	StartDate   string `json:"start_date"`   // 开始日期
+	EndDate     string `json:"end_date"`     // 结束日期
+}
+
+// WithContentType 按内容类型过滤
+func (f AuditFilter) WithContentType(contentType string) AuditFilter {
+	f.ContentType = contentType
+	return f
+}
+
+// WithStatus 按审核状态过滤
+func (f AuditFilter) WithStatus(status string) AuditFilter {
+	f.Status = status
+	return f
+}
+
+// WithLevel 按违规等级过滤
+func (f AuditFilter) WithLevel(level string) AuditFilter {
+	f.Level = level
+	return f
+}
+
+// WithUploader 按上传者过滤
+func (f AuditFilter) WithUploader(uploaderID uint64) AuditFilter {
+	f.UploaderID = uploaderID
+	return f
+}
+
+// WithReviewer 按审核员过滤
+func (f AuditFilter) WithReviewer(reviewerID uint64) AuditFilter {
+	f.ReviewerID = reviewerID
+	return f
+}
+
+// WithDateRange 按创建时间过滤，start或end为空时该侧不限
+func (f AuditFilter) WithDateRange(start, end string) AuditFilter {
+	f.StartDate = start
+	f.EndDate = end
+	return f
+}
+
+// Apply 将过滤条件应用到审核记录查询，可直接用于Scopes
+func (f AuditFilter) Apply(query *gorm.DB) *gorm.DB {
+	if f.ContentType != "" {
+		query = query.Where("content_type = ?", f.ContentType)
+	}
+	if f.Status != "" {
+		query = query.Where("status = ?", f.Status)
+	}
+	if f.Level != "" {
+		query = query.Where("level = ?", f.Level)
+	}
+	if f.UploaderID != 0 {
+		query = query.Where("uploader_id = ?", f.UploaderID)
+	}
+	if f.ReviewerID != 0 {
+		query = query.Where("reviewer_id = ?", f.ReviewerID)
+	}
+	if f.StartDate != "" {
+		query = query.Where("created_at >= ?", f.StartDate)
+	}
+	if f.EndDate != "" {
+		query = query.Where("created_at <= ?", f.EndDate)
+	}
+	return query
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"audit_service/internal/model"
+)
+
+// recordQueries 记录仓库执行的查询SQL和参数
+func recordQueries(t *testing.T, repo *auditRepository) *[]*gorm.Statement {
+	t.Helper()
+	var stmts []*gorm.Statement
+	record := func(tx *gorm.DB) {
+		stmts = append(stmts, &gorm.Statement{SQL: tx.Statement.SQL, Vars: tx.Statement.Vars})
+	}
+	if err := repo.db.Callback().Query().After("gorm:query").Register("test:record", record); err != nil {
+		t.Fatalf("register query callback: %v", err)
+	}
+	if err := repo.db.Callback().Row().After("gorm:row").Register("test:record", record); err != nil {
+		t.Fatalf("register row callback: %v", err)
+	}
+	return &stmts
+}
+
+func TestAuditFilterWithReturnsCopy(t *testing.T) {
+	base := AuditFilter{}.WithContentType("video")
+	filtered := base.WithStatus("rejected").WithUploader(42)
+
+	// With方法不修改原过滤条件，可以在不同查询间复用
+	if base != (AuditFilter{ContentType: "video"}) {
+		t.Errorf("base filter changed to %+v", base)
+	}
+	want := AuditFilter{ContentType: "video", Status: "rejected", UploaderID: 42}
+	if filtered != want {
+		t.Errorf("filter = %+v, want %+v", filtered, want)
+	}
+}
+
+func TestAuditFilterApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   AuditFilter
+		wantSQL  []string
+		wantVars []interface{}
+	}{
+		{"empty", AuditFilter{}, nil, nil},
+		{
+			"all fields",
+			AuditFilter{}.
+				WithContentType("video").
+				WithStatus("rejected").
+				WithLevel("high").
+				WithUploader(42).
+				WithReviewer(7).
+				WithDateRange("2024-01-01", "2024-01-31"),
+			[]string{"content_type = ?", "status = ?", "level = ?", "uploader_id = ?", "reviewer_id = ?", "created_at >= ?", "created_at <= ?"},
+			[]interface{}{"video", "rejected", "high", uint64(42), uint64(7), "2024-01-01", "2024-01-31"},
+		},
+		{"open start", AuditFilter{}.WithDateRange("", "2024-01-31"), []string{"created_at <= ?"}, []interface{}{"2024-01-31"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newDryRunRepository(t)
+			var records []model.AuditRecord
+			stmt := repo.db.Model(&model.AuditRecord{}).Scopes(tt.filter.Apply).Find(&records).Statement
+
+			sql := stmt.SQL.String()
+			if len(tt.wantSQL) == 0 && strings.Contains(sql, "WHERE") {
+				t.Errorf("empty filter added conditions: %s", sql)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(sql, want) {
+					t.Errorf("query missing %q: %s", want, sql)
+				}
+			}
+			if len(tt.wantVars) != 0 && !reflect.DeepEqual(stmt.Vars, tt.wantVars) {
+				t.Errorf("vars = %v, want %v", stmt.Vars, tt.wantVars)
+			}
+		})
+	}
+}
+
+func TestManualReviewQueueForcesPendingStatus(t *testing.T) {
+	repo := newDryRunRepository(t)
+	stmts := recordQueries(t, repo)
+
+	// 调用方传入的审核状态被覆盖为待审核
+	req := &GetManualReviewQueueRequest{
+		Filter:   AuditFilter{}.WithContentType("video").WithStatus("approved").WithReviewer(7),
+		Page:     2,
+		PageSize: 10,
+	}
+	if _, err := repo.GetManualReviewQueue(context.Background(), req); err != nil {
+		t.Fatalf("GetManualReviewQueue: %v", err)
+	}
+	if len(*stmts) != 2 {
+		t.Fatalf("queries = %d, want count and page", len(*stmts))
+	}
+	for _, stmt := range *stmts {
+		if !reflect.DeepEqual(stmt.Vars[:3], []interface{}{"video", string(model.AuditStatusPending), uint64(7)}) {
+			t.Errorf("vars = %v, want video, pending and reviewer 7", stmt.Vars)
+		}
+	}
+	if req.Filter.Status != "approved" {
+		t.Errorf("request filter changed to %+v", req.Filter)
+	}
+}
+
+func TestAuditStatisticsQueriesApplyFilter(t *testing.T) {
+	repo := newDryRunRepository(t)
+	stmts := recordQueries(t, repo)
+
+	repo.db = repo.db.Session(&gorm.Session{Logger: logger.Discard})
+
+	// 只生成SQL时分组统计的Scan返回ErrDryRunModeUnsupported，此前的总数和状态统计查询已生成
+	req := &GetAuditStatisticsRequest{Filter: AuditFilter{}.WithDateRange("2024-01-01", "2024-01-31")}
+	if _, err := repo.GetAuditStatistics(context.Background(), req); err != nil && !errors.Is(err, gorm.ErrDryRunModeUnsupported) {
+		t.Fatalf("GetAuditStatistics: %v", err)
+	}
+	if len(*stmts) < 2 {
+		t.Fatalf("queries = %d, want the total count and status statistics", len(*stmts))
+	}
+	// 总数和各项分组统计都限定在相同的日期范围内
+	for _, stmt := range *stmts {
+		sql := stmt.SQL.String()
+		if !strings.Contains(sql, "created_at >= ?") || !strings.Contains(sql, "created_at <= ?") {
+			t.Errorf("query not filtered by date: %s", sql)
+		}
+	}
+}
@@ -4,12 +4,19 @@ import (
 	"audit_service/internal/model"
 	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"common/ctxkeys"
 )
 
 // AuditRepository 审核仓库接口
 type AuditRepository interface {
+	// Transaction 在事务中执行fn，fn内通过传入的context进行的读写都使用此事务
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
 	// 审核记录操作
 	CreateAuditRecord(ctx context.Context, record *model.AuditRecord) (uint64, error)
 	GetAuditRecord(ctx context.Context, auditID uint64) (*model.AuditRecord, error)
@@ -42,6 +49,30 @@ type AuditRepository interface {
 	GetManualReviewQueue(ctx context.Context, req *GetManualReviewQueueRequest) (*GetManualReviewQueueResponse, error)
 	AssignManualReview(ctx context.Context, auditID uint64, reviewerID uint64) error
 
+	// 失败提交重试队列
+	CreateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error
+	GetSubmissionRetry(ctx context.Context, id uint64) (*model.AuditSubmissionRetry, error)
+	UpdateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error
+	ListSubmissionRetries(ctx context.Context, req *ListSubmissionRetriesRequest) (*ListSubmissionRetriesResponse, error)
+	ClaimDueSubmissionRetries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.AuditSubmissionRetry, error)
+	ClaimSubmissionRetry(ctx context.Context, id uint64, now time.Time, lease time.Duration) (*model.AuditSubmissionRetry, error)
+
+	// 数据保留
+	CountExpiredAuditRecords(ctx context.Context, filter *ExpiredAuditRecordsFilter) (int64, error)
+	DeleteExpiredAuditRecords(ctx context.Context, filter *ExpiredAuditRecordsFilter, limit int) (int64, error)
+
+	// 用户举报
+	CreateAuditReport(ctx context.Context, report *model.AuditReport) error
+	CountContentReporters(ctx context.Context, contentID string, contentType model.ContentType) (int64, error)
+
+	// 申诉
+	CreateAuditAppeal(ctx context.Context, auditID uint64, reason string, priority int) (*model.AuditAppeal, error)
+	ResolveAuditAppeal(ctx context.Context, auditID uint64, decision model.AppealDecision, reviewerID uint64, resolvedAt time.Time) (*model.AuditAppeal, error)
+	ListPendingAppeals(ctx context.Context, page, pageSize int) ([]*model.AuditAppeal, int64, error)
+
+	// 上传者审核历史
+	GetUploaderAuditHistory(ctx context.Context, uploaderID uint64, since time.Time) (*UploaderAuditHistory, error)
+
 	// 统计操作
 	GetAuditStatistics(ctx context.Context, req *GetAuditStatisticsRequest) (*GetAuditStatisticsResponse, error)
 	GetViolationTrends(ctx context.Context, req *GetViolationTrendsRequest) (*GetViolationTrendsResponse, error)
@@ -57,9 +88,25 @@ func NewAuditRepository(db *gorm.DB) AuditRepository {
 	return &auditRepository{db: db}
 }
 
+// Transaction 在事务中执行fn，事务绑定到传给fn的context，fn返回错误或panic时回滚，否则提交
+// 已处于事务中时作为嵌套事务(savepoint)执行
+func (r *auditRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctxkeys.WithTx(ctx, tx))
+	})
+}
+
+// conn 返回本次请求使用的数据库连接，context绑定了事务时使用该事务
+func (r *auditRepository) conn(ctx context.Context) *gorm.DB {
+	if tx, ok := ctxkeys.Tx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
 // CreateAuditRecord 创建审核记录
 func (r *auditRepository) CreateAuditRecord(ctx context.Context, record *model.AuditRecord) (uint64, error) {
-	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+	if err := r.conn(ctx).Create(record).Error; err != nil {
 		return 0, fmt.Errorf("failed to create audit record: %w", err)
 	}
 	return record.ID, nil
@@ -68,7 +115,7 @@ func (r *auditRepository) CreateAuditRecord(ctx context.Context, record *model.A
 // GetAuditRecord 获取审核记录
 func (r *auditRepository) GetAuditRecord(ctx context.Context, auditID uint64) (*model.AuditRecord, error) {
 	var record model.AuditRecord
-	if err := r.db.WithContext(ctx).First(&record, auditID).Error; err != nil {
+	if err := r.conn(ctx).First(&record, auditID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("audit record not found: %d", auditID)
 		}
@@ -80,7 +127,7 @@ func (r *auditRepository) GetAuditRecord(ctx context.Context, auditID uint64) (*
 // GetAuditRecordByContentID 根据内容ID获取审核记录
 func (r *auditRepository) GetAuditRecordByContentID(ctx context.Context, contentID string) (*model.AuditRecord, error) {
 	var record model.AuditRecord
-	if err := r.db.WithContext(ctx).Where("content_id = ?", contentID).First(&record).Error; err != nil {
+	if err := r.conn(ctx).Where("content_id = ?", contentID).First(&record).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("audit record not found for content: %s", contentID)
 		}
@@ -91,7 +138,7 @@ func (r *auditRepository) GetAuditRecordByContentID(ctx context.Context, content
 
 // UpdateAuditRecord 更新审核记录
 func (r *auditRepository) UpdateAuditRecord(ctx context.Context, record *model.AuditRecord) error {
-	if err := r.db.WithContext(ctx).Save(record).Error; err != nil {
+	if err := r.conn(ctx).Save(record).Error; err != nil {
 		return fmt.Errorf("failed to update audit record: %w", err)
 	}
 	return nil
@@ -99,30 +146,7 @@ func (r *auditRepository) UpdateAuditRecord(ctx context.Context, record *model.A
 
 // ListAuditRecords 获取审核记录列表
 func (r *auditRepository) ListAuditRecords(ctx context.Context, req *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
-	query := r.db.WithContext(ctx).Model(&model.AuditRecord{})
-
-	// 应用过滤条件
-	if req.ContentType != "" {
-		query = query.Where("content_type = ?", req.ContentType)
-	}
-	if req.Status != "" {
-		query = query.Where("status = ?", req.Status)
-	}
-	if req.Level != "" {
-		query = query.Where("level = ?", req.Level)
-	}
-	if req.UploaderID != 0 {
-		query = query.Where("uploader_id = ?", req.UploaderID)
-	}
-	if req.ReviewerID != 0 {
-		query = query.Where("reviewer_id = ?", req.ReviewerID)
-	}
-	if req.StartDate != "" {
-		query = query.Where("created_at >= ?", req.StartDate)
-	}
-	if req.EndDate != "" {
-		query = query.Where("created_at <= ?", req.EndDate)
-	}
+	query := r.conn(ctx).Model(&model.AuditRecord{}).Scopes(req.Filter.Apply)
 
 	// 获取总数
 	var total int64
@@ -130,10 +154,15 @@ func (r *auditRepository) ListAuditRecords(ctx context.Context, req *ListAuditRe
 		return nil, fmt.Errorf("failed to count audit records: %w", err)
 	}
 
-	// 分页查询
+	// 分页查询，按审核ID倒序(即创建时间倒序)
 	var records []*model.AuditRecord
-	offset := (req.Page - 1) * req.PageSize
-	if err := query.Offset(offset).Limit(req.PageSize).Find(&records).Error; err != nil {
+	page := query.Session(&gorm.Session{}).Order("id DESC")
+	if req.After != nil {
+		page = page.Where("id < ?", req.After.ID)
+	} else {
+		page = page.Offset((req.Page - 1) * req.PageSize)
+	}
+	if err := page.Limit(req.PageSize).Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to list audit records: %w", err)
 	}
 
@@ -147,7 +176,7 @@ func (r *auditRepository) ListAuditRecords(ctx context.Context, req *ListAuditRe
 
 // BatchCreateAuditRecords 批量创建审核记录
 func (r *auditRepository) BatchCreateAuditRecords(ctx context.Context, records []*model.AuditRecord) error {
-	if err := r.db.WithContext(ctx).CreateInBatches(records, 100).Error; err != nil {
+	if err := r.conn(ctx).CreateInBatches(records, 100).Error; err != nil {
 		return fmt.Errorf("failed to batch create audit records: %w", err)
 	}
 	return nil
@@ -156,7 +185,7 @@ func (r *auditRepository) BatchCreateAuditRecords(ctx context.Context, records [
 // GetAuditRecordsByContentIDs 根据内容ID列表获取审核记录
 func (r *auditRepository) GetAuditRecordsByContentIDs(ctx context.Context, contentIDs []string) ([]*model.AuditRecord, error) {
 	var records []*model.AuditRecord
-	if err := r.db.WithContext(ctx).Where("content_id IN ?", contentIDs).Find(&records).Error; err != nil {
+	if err := r.conn(ctx).Where("content_id IN ?", contentIDs).Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get audit records by content IDs: %w", err)
 	}
 	return records, nil
@@ -164,7 +193,7 @@ func (r *auditRepository) GetAuditRecordsByContentIDs(ctx context.Context, conte
 
 // CreateTemplate 创建审核模板
 func (r *auditRepository) CreateTemplate(ctx context.Context, template *model.AuditTemplate) (uint64, error) {
-	if err := r.db.WithContext(ctx).Create(template).Error; err != nil {
+	if err := r.conn(ctx).Create(template).Error; err != nil {
 		return 0, fmt.Errorf("failed to create audit template: %w", err)
 	}
 	return template.ID, nil
@@ -173,7 +202,7 @@ func (r *auditRepository) CreateTemplate(ctx context.Context, template *model.Au
 // GetTemplate 获取审核模板
 func (r *auditRepository) GetTemplate(ctx context.Context, templateID uint64) (*model.AuditTemplate, error) {
 	var template model.AuditTemplate
-	if err := r.db.WithContext(ctx).First(&template, templateID).Error; err != nil {
+	if err := r.conn(ctx).First(&template, templateID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("audit template not found: %d", templateID)
 		}
@@ -184,7 +213,7 @@ func (r *auditRepository) GetTemplate(ctx context.Context, templateID uint64) (*
 
 // UpdateTemplate 更新审核模板
 func (r *auditRepository) UpdateTemplate(ctx context.Context, template *model.AuditTemplate) error {
-	if err := r.db.WithContext(ctx).Save(template).Error; err != nil {
+	if err := r.conn(ctx).Save(template).Error; err != nil {
 		return fmt.Errorf("failed to update audit template: %w", err)
 	}
 	return nil
@@ -192,7 +221,7 @@ func (r *auditRepository) UpdateTemplate(ctx context.Context, template *model.Au
 
 // ListTemplates 获取审核模板列表
 func (r *auditRepository) ListTemplates(ctx context.Context, req *ListTemplatesRequest) (*ListTemplatesResponse, error) {
-	query := r.db.WithContext(ctx).Model(&model.AuditTemplate{})
+	query := r.conn(ctx).Model(&model.AuditTemplate{})
 
 	// 应用过滤条件
 	if req.ContentType != "" {
@@ -228,15 +257,19 @@ func (r *auditRepository) ListTemplates(ctx context.Context, req *ListTemplatesR
 
 // DeleteTemplate 删除审核模板
 func (r *auditRepository) DeleteTemplate(ctx context.Context, templateID uint64) error {
-	if err := r.db.WithContext(ctx).Delete(&model.AuditTemplate{}, templateID).Error; err != nil {
+	if err := r.conn(ctx).Delete(&model.AuditTemplate{}, templateID).Error; err != nil {
 		return fmt.Errorf("failed to delete audit template: %w", err)
 	}
 	return nil
 }
 
-// AddToWhitelist 添加到白名单
+// AddToWhitelist 添加到白名单，同一内容重复添加时更新原因和有效期
 func (r *auditRepository) AddToWhitelist(ctx context.Context, whitelist *model.AuditWhitelist) error {
-	if err := r.db.WithContext(ctx).Create(whitelist).Error; err != nil {
+	upsert := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_id"}, {Name: "content_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"uploader_id", "reason", "expiry_date", "is_permanent", "created_by"}),
+	}
+	if err := r.conn(ctx).Clauses(upsert).Create(whitelist).Error; err != nil {
 		return fmt.Errorf("failed to add to whitelist: %w", err)
 	}
 	return nil
@@ -244,7 +277,7 @@ func (r *auditRepository) AddToWhitelist(ctx context.Context, whitelist *model.A
 
 // RemoveFromWhitelist 从白名单移除
 func (r *auditRepository) RemoveFromWhitelist(ctx context.Context, contentID string) error {
-	if err := r.db.WithContext(ctx).Where("content_id = ?", contentID).Delete(&model.AuditWhitelist{}).Error; err != nil {
+	if err := r.conn(ctx).Where("content_id = ?", contentID).Delete(&model.AuditWhitelist{}).Error; err != nil {
 		return fmt.Errorf("failed to remove from whitelist: %w", err)
 	}
 	return nil
@@ -253,7 +286,7 @@ func (r *auditRepository) RemoveFromWhitelist(ctx context.Context, contentID str
 // IsWhitelisted 检查是否在白名单中
 func (r *auditRepository) IsWhitelisted(ctx context.Context, contentID string, contentType model.ContentType) (bool, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.AuditWhitelist{}).Where("content_id = ?", contentID)
+	query := r.conn(ctx).Model(&model.AuditWhitelist{}).Where("content_id = ?", contentID)
 	if contentType != "" {
 		query = query.Where("content_type = ?", contentType)
 	}
@@ -265,9 +298,13 @@ func (r *auditRepository) IsWhitelisted(ctx context.Context, contentID string, c
 	return count > 0, nil
 }
 
-// AddToBlacklist 添加到黑名单
+// AddToBlacklist 添加到黑名单，同一内容重复添加时更新原因、违规项和有效期
 func (r *auditRepository) AddToBlacklist(ctx context.Context, blacklist *model.AuditBlacklist) error {
-	if err := r.db.WithContext(ctx).Create(blacklist).Error; err != nil {
+	upsert := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_id"}, {Name: "content_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"uploader_id", "reason", "violations", "expiry_date", "is_permanent", "created_by"}),
+	}
+	if err := r.conn(ctx).Clauses(upsert).Create(blacklist).Error; err != nil {
 		return fmt.Errorf("failed to add to blacklist: %w", err)
 	}
 	return nil
@@ -275,7 +312,7 @@ func (r *auditRepository) AddToBlacklist(ctx context.Context, blacklist *model.A
 
 // RemoveFromBlacklist 从黑名单移除
 func (r *auditRepository) RemoveFromBlacklist(ctx context.Context, contentID string) error {
-	if err := r.db.WithContext(ctx).Where("content_id = ?", contentID).Delete(&model.AuditBlacklist{}).Error; err != nil {
+	if err := r.conn(ctx).Where("content_id = ?", contentID).Delete(&model.AuditBlacklist{}).Error; err != nil {
 		return fmt.Errorf("failed to remove from blacklist: %w", err)
 	}
 	return nil
@@ -284,7 +321,7 @@ func (r *auditRepository) RemoveFromBlacklist(ctx context.Context, contentID str
 // IsBlacklisted 检查是否在黑名单中
 func (r *auditRepository) IsBlacklisted(ctx context.Context, contentID string, contentType model.ContentType) (bool, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.AuditBlacklist{}).Where("content_id = ?", contentID)
+	query := r.conn(ctx).Model(&model.AuditBlacklist{}).Where("content_id = ?", contentID)
 	if contentType != "" {
 		query = query.Where("content_type = ?", contentType)
 	}
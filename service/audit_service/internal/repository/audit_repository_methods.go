@@ -4,34 +4,29 @@ import (
 	"audit_service/internal/model"
 	"context"
 	"fmt"
+
+	"gorm.io/gorm"
 )
 
-// AddToManualReviewQueue 添加到人工审核队列
+// AddToManualReviewQueue 添加到人工审核队列，申诉中的记录保持申诉状态不变
 func (r *auditRepository) AddToManualReviewQueue(ctx context.Context, auditID uint64) error {
 	// 这里可以添加更复杂的队列逻辑，比如使用Redis队列
 	// 目前简单地将审核状态更新为待人工审核
-	if err := r.db.WithContext(ctx).
+	if err := r.conn(ctx).
 		Model(&model.AuditRecord{}).
-		Where("id = ?", auditID).
+		Where("id = ? AND status <> ?", auditID, model.AuditStatusAppealing).
 		Update("status", model.AuditStatusPending).Error; err != nil {
 		return fmt.Errorf("failed to add to manual review queue: %w", err)
 	}
 	return nil
 }
 
-// GetManualReviewQueue 获取人工审核队列
+// GetManualReviewQueue 获取人工审核队列，优先级高的先处理，同优先级按送审时间先后排列
 func (r *auditRepository) GetManualReviewQueue(ctx context.Context, req *GetManualReviewQueueRequest) (*GetManualReviewQueueResponse, error) {
-	query := r.db.WithContext(ctx).
+	filter := req.Filter.WithStatus(string(model.AuditStatusPending))
+	query := r.conn(ctx).
 		Model(&model.AuditRecord{}).
-		Where("status = ?", model.AuditStatusPending)
-
-	// 应用过滤条件
-	if req.ContentType != "" {
-		query = query.Where("content_type = ?", req.ContentType)
-	}
-	if req.Level != "" {
-		query = query.Where("level = ?", req.Level)
-	}
+		Scopes(filter.Apply)
 	if req.Priority != 0 {
 		query = query.Where("priority = ?", req.Priority)
 	}
@@ -45,7 +40,8 @@ func (r *auditRepository) GetManualReviewQueue(ctx context.Context, req *GetManu
 	// 分页查询
 	var records []*model.AuditRecord
 	offset := (req.Page - 1) * req.PageSize
-	if err := query.Offset(offset).Limit(req.PageSize).Find(&records).Error; err != nil {
+	if err := query.Order("priority DESC").Order("created_at ASC").
+		Offset(offset).Limit(req.PageSize).Find(&records).Error; err != nil {
 		return nil, fmt.Errorf("failed to get manual review queue: %w", err)
 	}
 
@@ -58,15 +54,23 @@ func (r *auditRepository) GetManualReviewQueue(ctx context.Context, req *GetManu
 }
 
 // AssignManualReview 分配人工审核
+// 申诉中的记录只能通过处理申诉变更状态，返回ErrAppealInProgress，不会被改回待审核
 func (r *auditRepository) AssignManualReview(ctx context.Context, auditID uint64, reviewerID uint64) error {
-	if err := r.db.WithContext(ctx).
+	result := r.conn(ctx).
 		Model(&model.AuditRecord{}).
-		Where("id = ?", auditID).
+		Where("id = ? AND status <> ?", auditID, model.AuditStatusAppealing).
 		Updates(map[string]interface{}{
 			"reviewer_id": reviewerID,
 			"status":      model.AuditStatusPending,
-		}).Error; err != nil {
-		return fmt.Errorf("failed to assign manual review: %w", err)
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to assign manual review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		var record model.AuditRecord
+		if err := r.conn(ctx).Select("id", "status").First(&record, auditID).Error; err == nil && record.Status == model.AuditStatusAppealing {
+			return ErrAppealInProgress
+		}
 	}
 	return nil
 }
@@ -74,18 +78,20 @@ func (r *auditRepository) AssignManualReview(ctx context.Context, auditID uint64
 // GetAuditStatistics 获取审核统计
 func (r *auditRepository) GetAuditStatistics(ctx context.Context, req *GetAuditStatisticsRequest) (*GetAuditStatisticsResponse, error) {
 	var stats GetAuditStatisticsResponse
+	records := func() *gorm.DB {
+		return r.conn(ctx).Model(&model.AuditRecord{}).Scopes(req.Filter.Apply)
+	}
 
 	// 总审核数
 	var totalCount int64
-	if err := r.db.WithContext(ctx).Model(&model.AuditRecord{}).Count(&totalCount).Error; err != nil {
+	if err := records().Count(&totalCount).Error; err != nil {
 		return nil, fmt.Errorf("failed to get total count: %w", err)
 	}
 	stats.TotalCount = totalCount
 
 	// 按状态统计
 	var statusStats []StatusCount
-	if err := r.db.WithContext(ctx).
-		Model(&model.AuditRecord{}).
+	if err := records().
 		Select("status, COUNT(*) as count").
 		Group("status").
 		Scan(&statusStats).Error; err != nil {
@@ -95,8 +101,7 @@ func (r *auditRepository) GetAuditStatistics(ctx context.Context, req *GetAuditS
 
 	// 按违规等级统计
 	var levelStats []LevelCount
-	if err := r.db.WithContext(ctx).
-		Model(&model.AuditRecord{}).
+	if err := records().
 		Select("level, COUNT(*) as count").
 		Group("level").
 		Scan(&levelStats).Error; err != nil {
@@ -106,8 +111,7 @@ func (r *auditRepository) GetAuditStatistics(ctx context.Context, req *GetAuditS
 
 	// 按内容类型统计
 	var typeStats []TypeCount
-	if err := r.db.WithContext(ctx).
-		Model(&model.AuditRecord{}).
+	if err := records().
 		Select("content_type, COUNT(*) as count").
 		Group("content_type").
 		Scan(&typeStats).Error; err != nil {
@@ -118,8 +122,7 @@ func (r *auditRepository) GetAuditStatistics(ctx context.Context, req *GetAuditS
 	// 通过率计算
 	if totalCount > 0 {
 		var passedCount int64
-		r.db.WithContext(ctx).
-			Model(&model.AuditRecord{}).
+		records().
 			Where("status = ?", model.AuditStatusApproved).
 			Count(&passedCount)
 		stats.PassRate = float64(passedCount) / float64(totalCount) * 100
@@ -133,17 +136,11 @@ func (r *auditRepository) GetViolationTrends(ctx context.Context, req *GetViolat
 	var trends []ViolationTrend
 
 	// 按日期分组统计违规数量
-	query := r.db.WithContext(ctx).
+	filter := req.Filter.WithStatus(string(model.AuditStatusRejected))
+	query := r.conn(ctx).
 		Model(&model.AuditRecord{}).
 		Select("DATE(created_at) as date, COUNT(*) as count").
-		Where("status = ?", model.AuditStatusRejected)
-
-	if req.StartDate != "" {
-		query = query.Where("created_at >= ?", req.StartDate)
-	}
-	if req.EndDate != "" {
-		query = query.Where("created_at <= ?", req.EndDate)
-	}
+		Scopes(filter.Apply)
 
 	if err := query.
 		Group("DATE(created_at)").
@@ -152,6 +149,36 @@ func (r *auditRepository) GetViolationTrends(ctx context.Context, req *GetViolat
 		return nil, fmt.Errorf("failed to get violation trends: %w", err)
 	}
 
+	// 按违规类型细分
+	var rows []struct {
+		Date       string
+		Violations string
+	}
+	rowQuery := r.conn(ctx).
+		Model(&model.AuditRecord{}).
+		Select("DATE(created_at) as date, violations").
+		Scopes(filter.Apply)
+	if err := rowQuery.Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get violation categories: %w", err)
+	}
+
+	breakdown := make(map[string]map[string]int64)
+	for _, row := range rows {
+		categories, err := model.ParseViolations(row.Violations)
+		if err != nil || len(categories) == 0 {
+			categories = []model.ViolationCategory{model.ViolationOther}
+		}
+		if breakdown[row.Date] == nil {
+			breakdown[row.Date] = make(map[string]int64)
+		}
+		for _, category := range categories {
+			breakdown[row.Date][string(category)]++
+		}
+	}
+	for i := range trends {
+		trends[i].Categories = breakdown[trends[i].Date]
+	}
+
 	return &GetViolationTrendsResponse{
 		Trends: trends,
 	}, nil
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"common/ctxkeys"
+)
+
+func TestConnUsesTransactionBoundToContext(t *testing.T) {
+	repo := newDryRunRepository(t)
+	tx := repo.db.Set("test:tx", true)
+
+	if _, ok := repo.conn(context.Background()).Get("test:tx"); ok {
+		t.Error("conn without a bound transaction used the transaction")
+	}
+	if _, ok := repo.conn(ctxkeys.WithTx(context.Background(), tx)).Get("test:tx"); !ok {
+		t.Error("conn ignored the transaction bound to the context")
+	}
+}
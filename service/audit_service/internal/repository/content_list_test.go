@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"audit_service/internal/model"
+)
+
+// recordCreates 记录仓库执行的写入SQL
+func recordCreates(t *testing.T, repo *auditRepository) *[]string {
+	t.Helper()
+	var sqls []string
+	err := repo.db.Callback().Create().After("gorm:create").Register("test:record", func(tx *gorm.DB) {
+		sqls = append(sqls, tx.Statement.SQL.String())
+	})
+	if err != nil {
+		t.Fatalf("register create callback: %v", err)
+	}
+	return &sqls
+}
+
+func TestAddToContentListUpserts(t *testing.T) {
+	tests := []struct {
+		name    string
+		add     func(repo *auditRepository) error
+		updates []string
+	}{
+		{
+			"whitelist",
+			func(repo *auditRepository) error {
+				return repo.AddToWhitelist(context.Background(), &model.AuditWhitelist{ContentID: "c-1", ContentType: model.ContentTypeVideo})
+			},
+			[]string{"uploader_id", "reason", "expiry_date", "is_permanent", "created_by"},
+		},
+		{
+			"blacklist",
+			func(repo *auditRepository) error {
+				return repo.AddToBlacklist(context.Background(), &model.AuditBlacklist{ContentID: "c-1", ContentType: model.ContentTypeVideo})
+			},
+			[]string{"uploader_id", "reason", "violations", "expiry_date", "is_permanent", "created_by"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newDryRunRepository(t)
+			sqls := recordCreates(t, repo)
+			// 只生成SQL时不能开启写入的默认事务
+			repo.db = repo.db.Session(&gorm.Session{SkipDefaultTransaction: true})
+
+			if err := tt.add(repo); err != nil {
+				t.Fatalf("add: %v", err)
+			}
+			if len(*sqls) != 1 {
+				t.Fatalf("statements = %d, want 1", len(*sqls))
+			}
+			sql := (*sqls)[0]
+			// 重复添加时更新已有条目，不插入第二条
+			_, updates, ok := strings.Cut(sql, "ON DUPLICATE KEY UPDATE")
+			if !ok {
+				t.Fatalf("insert is not an upsert: %s", sql)
+			}
+			for _, column := range tt.updates {
+				if !strings.Contains(updates, "`"+column+"`=VALUES(`"+column+"`)") {
+					t.Errorf("upsert does not update %s: %s", column, updates)
+				}
+			}
+			// 内容标识和首次添加时间保持不变
+			for _, column := range []string{"id", "content_id", "content_type", "created_at"} {
+				if strings.Contains(updates, "`"+column+"`=") {
+					t.Errorf("upsert overwrites %s: %s", column, updates)
+				}
+			}
+		})
+	}
+}
+
+func TestContentListUniqueIndex(t *testing.T) {
+	tests := []struct {
+		model interface{}
+		index string
+	}{
+		{&model.AuditWhitelist{}, "idx_whitelist_content"},
+		{&model.AuditBlacklist{}, "idx_blacklist_content"},
+	}
+	for _, tt := range tests {
+		s, err := schema.Parse(tt.model, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatalf("parse schema: %v", err)
+		}
+		index := s.LookIndex(tt.index)
+		if index == nil {
+			t.Fatalf("%s not defined on %s", tt.index, s.Table)
+		}
+		// 同一内容ID在不同内容类型下可以分别添加
+		if index.Class != "UNIQUE" || len(index.Fields) != 2 ||
+			index.Fields[0].DBName != "content_id" || index.Fields[1].DBName != "content_type" {
+			t.Errorf("%s = %s on %d fields, want UNIQUE (content_id, content_type)", tt.index, index.Class, len(index.Fields))
+		}
+		for _, idx := range s.ParseIndexes() {
+			if idx.Class == "UNIQUE" && len(idx.Fields) == 1 && idx.Fields[0].DBName == "content_id" {
+				t.Errorf("%s still has a unique index on content_id alone", s.Table)
+			}
+		}
+	}
+}
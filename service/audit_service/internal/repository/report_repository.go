@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"audit_service/internal/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+)
+
+// ErrDuplicateReport 同一举报人已举报过该内容
+var ErrDuplicateReport = errors.New("content already reported by this reporter")
+
+// CreateAuditReport 记录用户举报，依赖唯一索引去重
+func (r *auditRepository) CreateAuditReport(ctx context.Context, report *model.AuditReport) error {
+	result := r.conn(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create audit report: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrDuplicateReport
+	}
+	return nil
+}
+
+// CountContentReporters 统计举报该内容的不同举报人数
+func (r *auditRepository) CountContentReporters(ctx context.Context, contentID string, contentType model.ContentType) (int64, error) {
+	var count int64
+	if err := r.conn(ctx).
+		Model(&model.AuditReport{}).
+		Where("content_id = ? AND content_type = ?", contentID, contentType).
+		Distinct("reporter_id").
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count content reporters: %w", err)
+	}
+	return count, nil
+}
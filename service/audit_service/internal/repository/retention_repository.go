@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"audit_service/internal/model"
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// expiredAuditRecordsQuery 构造过期审核记录的查询条件
+// 待审核(包括举报升级后重新进入人工审核)和申诉中的记录仍在处理中，黑名单中的内容需要保留处置依据，均不清理
+func (r *auditRepository) expiredAuditRecordsQuery(ctx context.Context, filter *ExpiredAuditRecordsFilter) *gorm.DB {
+	query := r.conn(ctx).Model(&model.AuditRecord{}).
+		Where("created_at < ? AND status NOT IN ?", filter.Before, []model.AuditStatus{model.AuditStatusPending, model.AuditStatusAppealing}).
+		Where("NOT EXISTS (?)", r.db.Model(&model.AuditBlacklist{}).
+			Select("1").
+			Where("audit_blacklists.content_id = audit_records.content_id AND audit_blacklists.content_type = audit_records.content_type"))
+	if len(filter.ContentTypes) > 0 {
+		query = query.Where("content_type IN ?", filter.ContentTypes)
+	}
+	if len(filter.ExcludeContentTypes) > 0 {
+		query = query.Where("content_type NOT IN ?", filter.ExcludeContentTypes)
+	}
+	return query
+}
+
+// CountExpiredAuditRecords 统计符合清理条件的审核记录数
+func (r *auditRepository) CountExpiredAuditRecords(ctx context.Context, filter *ExpiredAuditRecordsFilter) (int64, error) {
+	var count int64
+	if err := r.expiredAuditRecordsQuery(ctx, filter).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count expired audit records: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteExpiredAuditRecords 删除一批符合清理条件的审核记录，单次最多limit条，返回实际删除的条数
+// 删除时重新校验清理条件，避免查询后记录被重新送审或内容被加入黑名单
+func (r *auditRepository) DeleteExpiredAuditRecords(ctx context.Context, filter *ExpiredAuditRecordsFilter, limit int) (int64, error) {
+	var ids []uint64
+	if err := r.expiredAuditRecordsQuery(ctx, filter).Order("id ASC").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to list expired audit records: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result := r.expiredAuditRecordsQuery(ctx, filter).Where("id IN ?", ids).Delete(&model.AuditRecord{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired audit records: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"audit_service/internal/model"
+)
+
+// newDryRunRepository 只生成SQL不连接数据库的仓库，用于校验查询条件
+func newDryRunRepository(t *testing.T) *auditRepository {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/audit", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	return &auditRepository{db: db}
+}
+
+func TestExpiredAuditRecordsQueryKeepsInProgressRecords(t *testing.T) {
+	repo := newDryRunRepository(t)
+	var records []model.AuditRecord
+	stmt := repo.expiredAuditRecordsQuery(context.Background(), &ExpiredAuditRecordsFilter{Before: time.Now()}).
+		Find(&records).Statement
+
+	sql := stmt.SQL.String()
+	if !strings.Contains(sql, "status NOT IN") {
+		t.Fatalf("query does not exclude statuses: %s", sql)
+	}
+	excluded := map[model.AuditStatus]bool{}
+	for _, v := range stmt.Vars {
+		if status, ok := v.(model.AuditStatus); ok {
+			excluded[status] = true
+		}
+	}
+	for _, status := range []model.AuditStatus{model.AuditStatusPending, model.AuditStatusAppealing} {
+		if !excluded[status] {
+			t.Errorf("status %s is not excluded from retention (vars %v)", status, stmt.Vars)
+		}
+	}
+}
+
+func TestExpiredAuditRecordsQueryFilters(t *testing.T) {
+	before := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		filter   ExpiredAuditRecordsFilter
+		want     []string
+		excluded []string
+	}{
+		{
+			name:     "all content types",
+			filter:   ExpiredAuditRecordsFilter{Before: before},
+			excluded: []string{"content_type IN", "content_type NOT IN"},
+		},
+		{
+			name:     "single content type",
+			filter:   ExpiredAuditRecordsFilter{ContentTypes: []model.ContentType{model.ContentTypeVideo}, Before: before},
+			want:     []string{"content_type IN"},
+			excluded: []string{"content_type NOT IN"},
+		},
+		{
+			name:   "default policy",
+			filter: ExpiredAuditRecordsFilter{ExcludeContentTypes: []model.ContentType{model.ContentTypeVideo}, Before: before},
+			want:   []string{"content_type NOT IN"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var records []model.AuditRecord
+			stmt := newDryRunRepository(t).expiredAuditRecordsQuery(context.Background(), &tt.filter).Find(&records).Statement
+			sql := stmt.SQL.String()
+
+			// 黑名单中的内容始终保留
+			for _, want := range append([]string{"created_at <", "NOT EXISTS", "audit_blacklists"}, tt.want...) {
+				if !strings.Contains(sql, want) {
+					t.Errorf("query missing %q: %s", want, sql)
+				}
+			}
+			for _, excluded := range tt.excluded {
+				if strings.Contains(sql, excluded) {
+					t.Errorf("query contains %q: %s", excluded, sql)
+				}
+			}
+			if len(stmt.Vars) == 0 || stmt.Vars[0] != before {
+				t.Errorf("vars = %v, want the cutoff first", stmt.Vars)
+			}
+		})
+	}
+}
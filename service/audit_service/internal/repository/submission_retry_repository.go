@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"audit_service/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrSubmissionRetryNotFound 重试记录不存在
+	ErrSubmissionRetryNotFound = errors.New("submission retry not found")
+	// ErrSubmissionRetryLocked 重试记录正在被其他worker或人工重试处理，或已重试成功
+	ErrSubmissionRetryLocked = errors.New("submission retry is being processed")
+)
+
+// CreateSubmissionRetry 记录一次失败的审核提交
+func (r *auditRepository) CreateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error {
+	if err := r.conn(ctx).Create(retry).Error; err != nil {
+		return fmt.Errorf("failed to create submission retry: %w", err)
+	}
+	return nil
+}
+
+// GetSubmissionRetry 获取重试记录
+func (r *auditRepository) GetSubmissionRetry(ctx context.Context, id uint64) (*model.AuditSubmissionRetry, error) {
+	var retry model.AuditSubmissionRetry
+	if err := r.conn(ctx).First(&retry, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSubmissionRetryNotFound
+		}
+		return nil, fmt.Errorf("failed to get submission retry: %w", err)
+	}
+	return &retry, nil
+}
+
+// UpdateSubmissionRetry 更新重试记录
+func (r *auditRepository) UpdateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error {
+	if err := r.conn(ctx).Save(retry).Error; err != nil {
+		return fmt.Errorf("failed to update submission retry: %w", err)
+	}
+	return nil
+}
+
+// ListSubmissionRetries 分页获取重试记录，status为空时返回全部
+func (r *auditRepository) ListSubmissionRetries(ctx context.Context, req *ListSubmissionRetriesRequest) (*ListSubmissionRetriesResponse, error) {
+	query := r.conn(ctx).Model(&model.AuditSubmissionRetry{})
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count submission retries: %w", err)
+	}
+
+	var retries []*model.AuditSubmissionRetry
+	offset := (req.Page - 1) * req.PageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(req.PageSize).Find(&retries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list submission retries: %w", err)
+	}
+
+	return &ListSubmissionRetriesResponse{
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Retries:  retries,
+	}, nil
+}
+
+// ClaimDueSubmissionRetries 领取已到重试时间的待重试记录，领取的记录在lease内不会被再次领取
+// 查询使用FOR UPDATE SKIP LOCKED并在同一事务中写入租约，多个实例同时执行时每条记录只会被一个实例领取
+func (r *auditRepository) ClaimDueSubmissionRetries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.AuditSubmissionRetry, error) {
+	var retries []*model.AuditSubmissionRetry
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := dueSubmissionRetriesQuery(tx, now, limit).Find(&retries).Error; err != nil {
+			return err
+		}
+		if len(retries) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, len(retries))
+		for i, retry := range retries {
+			ids[i] = retry.ID
+		}
+		lockedUntil := now.Add(lease)
+		if err := tx.Model(&model.AuditSubmissionRetry{}).
+			Where("id IN ?", ids).
+			Update("locked_until", lockedUntil).Error; err != nil {
+			return err
+		}
+		for _, retry := range retries {
+			retry.LockedUntil = &lockedUntil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due submission retries: %w", err)
+	}
+	return retries, nil
+}
+
+// dueSubmissionRetriesQuery 已到重试时间且未被领取的待重试记录，跳过其他事务已锁定的行
+func dueSubmissionRetriesQuery(tx *gorm.DB, now time.Time, limit int) *gorm.DB {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_retry_at <= ?", model.SubmissionRetryPending, now).
+		Where("locked_until IS NULL OR locked_until <= ?", now).
+		Order("next_retry_at ASC").
+		Limit(limit)
+}
+
+// ClaimSubmissionRetry 人工重试前领取单条记录，记录正在被处理或已成功时返回ErrSubmissionRetryLocked
+func (r *auditRepository) ClaimSubmissionRetry(ctx context.Context, id uint64, now time.Time, lease time.Duration) (*model.AuditSubmissionRetry, error) {
+	lockedUntil := now.Add(lease)
+	result := r.conn(ctx).Model(&model.AuditSubmissionRetry{}).
+		Where("id = ? AND status <> ?", id, model.SubmissionRetrySucceeded).
+		Where("locked_until IS NULL OR locked_until <= ?", now).
+		Update("locked_until", lockedUntil)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim submission retry: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if _, err := r.GetSubmissionRetry(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, ErrSubmissionRetryLocked
+	}
+	return r.GetSubmissionRetry(ctx, id)
+}
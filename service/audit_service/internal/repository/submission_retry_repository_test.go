@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"audit_service/internal/model"
+)
+
+func TestDueSubmissionRetriesQuerySkipsLockedRows(t *testing.T) {
+	repo := newDryRunRepository(t)
+	var retries []model.AuditSubmissionRetry
+	stmt := dueSubmissionRetriesQuery(repo.db.WithContext(context.Background()), time.Now(), 10).Find(&retries).Statement
+
+	sql := stmt.SQL.String()
+	for _, want := range []string{"FOR UPDATE SKIP LOCKED", "(locked_until IS NULL OR locked_until <=", "status = ?"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("claim query missing %q: %s", want, sql)
+		}
+	}
+}
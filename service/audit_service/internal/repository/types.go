@@ -1,18 +1,18 @@
 package repository
 
-import "audit_service/internal/model"
+import (
+	"audit_service/internal/model"
+	"audit_service/pkg/paginate"
+	"time"
+)
 
 // ListAuditRecordsRequest 获取审核记录列表请求
 type ListAuditRecordsRequest struct {
-	ContentType string `json:"content_type"` // 内容类型
-	Status      string `json:"status"`       // 审核状态
-	Level       string `json:"level"`        // 违规等级
-	UploaderID  uint64 `json:"uploader_id"`  // 上传者ID
-	ReviewerID  uint64 `json:"reviewer_id"`  // 审核员ID
-	StartDate   string `json:"start_date"`   // 开始日期
-	EndDate     string `json:"end_date"`     // 结束日期
-	Page        int    `json:"page"`         // 页码
-	PageSize    int    `json:"page_size"`    // 每页数量
+	Filter   AuditFilter `json:"filter"`    // 过滤条件
+	Page     int         `json:"page"`      // 页码
+	PageSize int         `json:"page_size"` // 每页数量
+
+	After *paginate.Cursor `json:"-"` // 游标位置，不为nil时从该位置之后查询并忽略Page
 }
 
 // ListAuditRecordsResponse 获取审核记录列表响应
@@ -42,11 +42,10 @@ type ListTemplatesResponse struct {
 
 // GetManualReviewQueueRequest 获取人工审核队列请求
 type GetManualReviewQueueRequest struct {
-	ContentType string `json:"content_type"` // 内容类型
-	Level       string `json:"level"`        // 违规等级
-	Priority    int    `json:"priority"`     // 优先级
-	Page        int    `json:"page"`         // 页码
-	PageSize    int    `json:"page_size"`    // 每页数量
+	Filter   AuditFilter `json:"filter"`    // 过滤条件，审核状态固定为待审核
+	Priority int         `json:"priority"`  // 优先级
+	Page     int         `json:"page"`      // 页码
+	PageSize int         `json:"page_size"` // 每页数量
 }
 
 // GetManualReviewQueueResponse 获取人工审核队列响应
@@ -59,8 +58,7 @@ type GetManualReviewQueueResponse struct {
 
 // GetAuditStatisticsRequest 获取审核统计请求
 type GetAuditStatisticsRequest struct {
-	StartDate string `json:"start_date"` // 开始日期
-	EndDate   string `json:"end_date"`   // 结束日期
+	Filter AuditFilter `json:"filter"` // 过滤条件
 }
 
 // GetAuditStatisticsResponse 获取审核统计响应
@@ -92,8 +90,7 @@ type TypeCount struct {
 
 // GetViolationTrendsRequest 获取违规趋势请求
 type GetViolationTrendsRequest struct {
-	StartDate string `json:"start_date"` // 开始日期
-	EndDate   string `json:"end_date"`   // 结束日期
+	Filter AuditFilter `json:"filter"` // 过滤条件，审核状态固定为已拒绝
 }
 
 // GetViolationTrendsResponse 获取违规趋势响应
@@ -103,6 +100,30 @@ type GetViolationTrendsResponse struct {
 
 // ViolationTrend 违规趋势
 type ViolationTrend struct {
-	Date  string `json:"date"`  // 日期
-	Count int64  `json:"count"` // 数量
+	Date       string           `json:"date"`                // 日期
+	Count      int64            `json:"count"`               // 数量
+	Categories map[string]int64 `json:"categories" gorm:"-"` // 按违规类型统计
+}
+
+// ExpiredAuditRecordsFilter 按保留策略筛选过期审核记录的条件
+// ContentTypes和ExcludeContentTypes都为空时匹配全部内容类型
+type ExpiredAuditRecordsFilter struct {
+	ContentTypes        []model.ContentType // 限定的内容类型
+	ExcludeContentTypes []model.ContentType // 排除的内容类型，用于单独配置了保留时长的类型之外的默认策略
+	Before              time.Time           // 创建时间早于该时间的记录视为过期
+}
+
+// ListSubmissionRetriesRequest 获取失败提交列表请求
+type ListSubmissionRetriesRequest struct {
+	Status   string `json:"status"`    // 重试状态
+	Page     int    `json:"page"`      // 页码
+	PageSize int    `json:"page_size"` // 每页数量
+}
+
+// ListSubmissionRetriesResponse 获取失败提交列表响应
+type ListSubmissionRetriesResponse struct {
+	Total    int64                         `json:"total"`     // 总数
+	Page     int                           `json:"page"`      // 当前页
+	PageSize int                           `json:"page_size"` // 每页数量
+	Retries  []*model.AuditSubmissionRetry `json:"retries"`   // 重试记录列表
 }
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"audit_service/internal/model"
+	"context"
+	"fmt"
+	"time"
+)
+
+// 上传者审核历史中计为已审结和被拒绝的审核状态，申诉中的记录结果未定，不计入
+var (
+	uploaderDecidedStatuses = []model.AuditStatus{
+		model.AuditStatusApproved, model.AuditStatusAutoPassed,
+		model.AuditStatusRejected, model.AuditStatusAutoBlocked,
+	}
+	uploaderRejectedStatuses = []model.AuditStatus{model.AuditStatusRejected, model.AuditStatusAutoBlocked}
+)
+
+// UploaderAuditHistory 上传者在统计窗口内的审核结果
+type UploaderAuditHistory struct {
+	Decided  int64 // 已审结的内容数
+	Rejected int64 // 被拒绝或自动拦截的内容数
+}
+
+// RejectionRate 拒绝率，没有已审结的内容时为0
+func (h *UploaderAuditHistory) RejectionRate() float64 {
+	if h.Decided == 0 {
+		return 0
+	}
+	return float64(h.Rejected) / float64(h.Decided)
+}
+
+// GetUploaderAuditHistory 统计上传者since之后提交的内容中已审结和被拒绝的数量
+func (r *auditRepository) GetUploaderAuditHistory(ctx context.Context, uploaderID uint64, since time.Time) (*UploaderAuditHistory, error) {
+	var history UploaderAuditHistory
+	if err := r.conn(ctx).
+		Model(&model.AuditRecord{}).
+		Select("COUNT(*) AS decided, COALESCE(SUM(CASE WHEN status IN ? THEN 1 ELSE 0 END), 0) AS rejected", uploaderRejectedStatuses).
+		Where("uploader_id = ? AND created_at >= ? AND status IN ?", uploaderID, since, uploaderDecidedStatuses).
+		Scan(&history).Error; err != nil {
+		return nil, fmt.Errorf("failed to get uploader audit history: %w", err)
+	}
+	return &history, nil
+}
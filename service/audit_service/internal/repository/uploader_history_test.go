@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestUploaderAuditHistoryRejectionRate(t *testing.T) {
+	tests := []struct {
+		history UploaderAuditHistory
+		want    float64
+	}{
+		{UploaderAuditHistory{}, 0},
+		{UploaderAuditHistory{Decided: 4, Rejected: 1}, 0.25},
+		{UploaderAuditHistory{Decided: 3, Rejected: 3}, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.history.RejectionRate(); got != tt.want {
+			t.Errorf("RejectionRate(%+v) = %v, want %v", tt.history, got, tt.want)
+		}
+	}
+}
+
+func TestUploaderAuditHistoryQuery(t *testing.T) {
+	repo := newDryRunRepository(t)
+	stmts := recordQueries(t, repo)
+	repo.db = repo.db.Session(&gorm.Session{Logger: logger.Discard})
+
+	since := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	// 只生成SQL时Scan返回ErrDryRunModeUnsupported
+	if _, err := repo.GetUploaderAuditHistory(context.Background(), 42, since); err != nil && !errors.Is(err, gorm.ErrDryRunModeUnsupported) {
+		t.Fatalf("GetUploaderAuditHistory: %v", err)
+	}
+	if len(*stmts) != 1 {
+		t.Fatalf("queries = %d, want 1", len(*stmts))
+	}
+	stmt := (*stmts)[0]
+	sql := stmt.SQL.String()
+	for _, want := range []string{
+		"COUNT(*) AS decided",
+		"SUM(CASE WHEN status IN (?,?) THEN 1 ELSE 0 END), 0) AS rejected",
+		// 申诉中和待审核的记录不计入已审结
+		"uploader_id = ? AND created_at >= ? AND status IN (?,?,?,?)",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("query = %s, want it to contain %s", sql, want)
+		}
+	}
+	if len(stmt.Vars) < 4 || stmt.Vars[2] != uint64(42) || stmt.Vars[3] != since {
+		t.Errorf("vars = %v, want the rejected statuses, uploader 42 and since %v", stmt.Vars, since)
+	}
+}
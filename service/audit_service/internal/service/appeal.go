@@ -0,0 +1,105 @@
+package service
+
+import (
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrAuditRecordNotFound 审核记录不存在
+	ErrAuditRecordNotFound = repository.ErrAuditRecordNotFound
+	// ErrAppealNotAllowed 只有被拒绝的内容可以申诉
+	ErrAppealNotAllowed = repository.ErrAppealNotAllowed
+	// ErrAppealAlreadyOpen 已有待处理的申诉
+	ErrAppealAlreadyOpen = repository.ErrAppealAlreadyOpen
+	// ErrAppealNotFound 没有待处理的申诉
+	ErrAppealNotFound = repository.ErrAppealNotFound
+	// ErrAppealFinal 申诉已被驳回，不能再次申诉
+	ErrAppealFinal = repository.ErrAppealFinal
+	// ErrAppealInProgress 审核记录申诉中，只能通过处理申诉变更审核结果
+	ErrAppealInProgress = repository.ErrAppealInProgress
+	// ErrInvalidAppealDecision 申诉处理结论只能是uphold或overturn
+	ErrInvalidAppealDecision = errors.New("invalid appeal decision")
+)
+
+// SubmitAppeal 被拒绝内容的上传者提交申诉
+// 审核记录转为申诉中并提升人工审核优先级，进入申诉队列由审核员优先处理；
+// 已有待处理的申诉时不能重复提交，申诉被驳回后维持原判为最终结果
+func (s *auditService) SubmitAppeal(ctx context.Context, auditID uint64, reason string) (*Appeal, error) {
+	s.logger.Info("Submitting audit appeal", "audit_id", auditID)
+
+	appeal, err := s.repository.CreateAuditAppeal(ctx, auditID, reason, model.AuditPriorityAppeal)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Audit appeal submitted", "audit_id", auditID, "appeal_id", appeal.ID, "previous_status", appeal.PreviousStatus)
+	return toAppeal(appeal), nil
+}
+
+// ResolveAppeal 审核员处理申诉
+// uphold维持原审核结果；overturn撤销原审核结果，内容改为通过并移出黑名单
+func (s *auditService) ResolveAppeal(ctx context.Context, auditID uint64, decision string, reviewerID uint64) (*Appeal, error) {
+	s.logger.Info("Resolving audit appeal", "audit_id", auditID, "decision", decision, "reviewer_id", reviewerID)
+
+	appealDecision := model.AppealDecision(decision)
+	if appealDecision != model.AppealDecisionUphold && appealDecision != model.AppealDecisionOverturn {
+		return nil, ErrInvalidAppealDecision
+	}
+
+	appeal, err := s.repository.ResolveAuditAppeal(ctx, auditID, appealDecision, reviewerID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Audit appeal resolved", "audit_id", auditID, "appeal_id", appeal.ID, "status", appeal.Status)
+	return toAppeal(appeal), nil
+}
+
+// ListPendingAppeals 获取申诉队列中待处理的申诉，先提交的先处理
+func (s *auditService) ListPendingAppeals(ctx context.Context, page, pageSize int) (*ListAppealsResponse, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	appeals, total, err := s.repository.ListPendingAppeals(ctx, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListAppealsResponse{
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, appeal := range appeals {
+		resp.Appeals = append(resp.Appeals, toAppeal(appeal))
+	}
+	return resp, nil
+}
+
+// toAppeal 将申诉记录转换为服务层结构
+func toAppeal(appeal *model.AuditAppeal) *Appeal {
+	var reviewerID uint64
+	if appeal.ReviewerID != nil {
+		reviewerID = *appeal.ReviewerID
+	}
+	return &Appeal{
+		ID:          appeal.ID,
+		AuditID:     appeal.AuditID,
+		ContentID:   appeal.ContentID,
+		ContentType: string(appeal.ContentType),
+		UploaderID:  appeal.UploaderID,
+		Reason:      appeal.Reason,
+		Status:      string(appeal.Status),
+		ReviewerID:  reviewerID,
+		ResolvedAt:  appeal.ResolvedAt,
+		CreatedAt:   appeal.CreatedAt,
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+func newRejectedRecord() *model.AuditRecord {
+	return &model.AuditRecord{
+		ID:          1,
+		ContentID:   "video-1",
+		ContentType: model.ContentTypeVideo,
+		Status:      model.AuditStatusRejected,
+		Level:       model.AuditLevelMedium,
+	}
+}
+
+func TestSubmitAppealRaisesPriorityAndEntersAppealQueue(t *testing.T) {
+	repo := newFakeAuditRepo(newRejectedRecord())
+	s := newTestAuditService(repo)
+
+	if _, err := s.SubmitAppeal(context.Background(), 1, "误判"); err != nil {
+		t.Fatalf("SubmitAppeal: %v", err)
+	}
+	record := repo.records[1]
+	if record.Priority != model.AuditPriorityAppeal {
+		t.Errorf("priority = %d, want %d", record.Priority, model.AuditPriorityAppeal)
+	}
+	if record.Level != model.AuditLevelMedium {
+		t.Errorf("level = %s, want unchanged medium", record.Level)
+	}
+
+	queue, err := s.ListPendingAppeals(context.Background(), 0, 0)
+	if err != nil {
+		t.Fatalf("ListPendingAppeals: %v", err)
+	}
+	if queue.Total != 1 || len(queue.Appeals) != 1 || queue.Appeals[0].AuditID != 1 {
+		t.Fatalf("appeal queue = %+v, want the submitted appeal", queue)
+	}
+	if queue.Page != 1 || queue.PageSize != 20 {
+		t.Errorf("page = %d/%d, want defaults 1/20", queue.Page, queue.PageSize)
+	}
+}
+
+func TestUpheldAppealIsFinal(t *testing.T) {
+	repo := newFakeAuditRepo(newRejectedRecord())
+	s := newTestAuditService(repo)
+	ctx := context.Background()
+
+	if _, err := s.SubmitAppeal(ctx, 1, "误判"); err != nil {
+		t.Fatalf("SubmitAppeal: %v", err)
+	}
+	if _, err := s.ResolveAppeal(ctx, 1, string(model.AppealDecisionUphold), 7); err != nil {
+		t.Fatalf("ResolveAppeal: %v", err)
+	}
+	if record := repo.records[1]; record.Status != model.AuditStatusRejected || record.Priority != model.AuditPriorityNormal {
+		t.Fatalf("record after uphold = %s/%d, want rejected with normal priority", record.Status, record.Priority)
+	}
+	if _, err := s.SubmitAppeal(ctx, 1, "再申诉一次"); !errors.Is(err, ErrAppealFinal) {
+		t.Fatalf("second SubmitAppeal error = %v, want ErrAppealFinal", err)
+	}
+}
+
+func TestAppealingRecordCannotBeReassignedOrReescalated(t *testing.T) {
+	record := newRejectedRecord()
+	record.Status = model.AuditStatusAppealing
+	repo := newFakeAuditRepo(record)
+	s := newTestAuditService(repo)
+	ctx := context.Background()
+
+	if _, err := s.AssignManualReview(ctx, &AssignManualReviewRequest{AuditID: 1, ReviewerID: 3}); !errors.Is(err, ErrAppealInProgress) {
+		t.Errorf("AssignManualReview error = %v, want ErrAppealInProgress", err)
+	}
+	if _, err := s.UpdateAuditStatus(ctx, &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusApproved), ReviewerID: 3}); !errors.Is(err, ErrAppealInProgress) {
+		t.Errorf("UpdateAuditStatus error = %v, want ErrAppealInProgress", err)
+	}
+
+	auditID, escalated, err := s.escalateReportedContent(ctx, "video-1", model.ContentTypeVideo, 10)
+	if err != nil || escalated || auditID != 1 {
+		t.Errorf("escalateReportedContent = (%d, %v, %v), want existing record without escalation", auditID, escalated, err)
+	}
+
+	if len(repo.updated) != 0 || len(repo.queued) != 0 {
+		t.Fatalf("appealing record was written (updated %v, queued %v)", repo.updated, repo.queued)
+	}
+	if repo.records[1].Status != model.AuditStatusAppealing {
+		t.Fatalf("status = %s, want still appealing", repo.records[1].Status)
+	}
+}
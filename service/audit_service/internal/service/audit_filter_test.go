@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+)
+
+// filterRepo 记录列表、审核队列和统计查询收到的仓库请求
+type filterRepo struct {
+	*fakeAuditRepo
+	listReq  *repository.ListAuditRecordsRequest
+	queueReq *repository.GetManualReviewQueueRequest
+	statsReq *repository.GetAuditStatisticsRequest
+	records  []*model.AuditRecord
+}
+
+func (r *filterRepo) ListAuditRecords(ctx context.Context, req *repository.ListAuditRecordsRequest) (*repository.ListAuditRecordsResponse, error) {
+	r.listReq = req
+	return &repository.ListAuditRecordsResponse{Total: int64(len(r.records)), Records: r.records}, nil
+}
+
+func (r *filterRepo) GetManualReviewQueue(ctx context.Context, req *repository.GetManualReviewQueueRequest) (*repository.GetManualReviewQueueResponse, error) {
+	r.queueReq = req
+	return &repository.GetManualReviewQueueResponse{Page: req.Page, PageSize: req.PageSize}, nil
+}
+
+func (r *filterRepo) GetAuditStatistics(ctx context.Context, req *repository.GetAuditStatisticsRequest) (*repository.GetAuditStatisticsResponse, error) {
+	r.statsReq = req
+	return &repository.GetAuditStatisticsResponse{}, nil
+}
+
+func newFilterTestService() (*auditService, *filterRepo) {
+	repo := &filterRepo{fakeAuditRepo: newFakeAuditRepo()}
+	s := newTestAuditService(repo.fakeAuditRepo)
+	s.repository = repo
+	return s, repo
+}
+
+func TestListAuditRecordsBuildsFilter(t *testing.T) {
+	s, repo := newFilterTestService()
+
+	_, err := s.ListAuditRecords(context.Background(), &ListAuditRecordsRequest{
+		ContentType: "video",
+		Status:      "rejected",
+		Level:       "high",
+		UploaderID:  "42",
+		StartDate:   "2024-01-01",
+		EndDate:     "2024-01-31",
+		Page:        2,
+		PageSize:    10,
+	})
+	if err != nil {
+		t.Fatalf("ListAuditRecords: %v", err)
+	}
+	want := repository.AuditFilter{
+		ContentType: "video",
+		Status:      "rejected",
+		Level:       "high",
+		UploaderID:  42,
+		StartDate:   "2024-01-01",
+		EndDate:     "2024-01-31",
+	}
+	if repo.listReq.Filter != want {
+		t.Errorf("filter = %+v, want %+v", repo.listReq.Filter, want)
+	}
+	// 多取一条用于判断是否还有下一页
+	if repo.listReq.Page != 2 || repo.listReq.PageSize != 11 || repo.listReq.After != nil {
+		t.Errorf("page %d size %d after %v, want page 2 size 11 without cursor", repo.listReq.Page, repo.listReq.PageSize, repo.listReq.After)
+	}
+}
+
+func TestListAuditRecordsPagination(t *testing.T) {
+	s, repo := newFilterTestService()
+	for id := uint64(5); id >= 1; id-- {
+		repo.records = append(repo.records, &model.AuditRecord{ID: id})
+	}
+
+	// 未指定分页时使用默认值，空过滤条件不限制查询
+	resp, err := s.ListAuditRecords(context.Background(), &ListAuditRecordsRequest{})
+	if err != nil {
+		t.Fatalf("ListAuditRecords: %v", err)
+	}
+	if repo.listReq.Filter != (repository.AuditFilter{}) || repo.listReq.Page != 1 || repo.listReq.PageSize != 21 {
+		t.Errorf("request = %+v, want an empty filter on page 1 with size 21", repo.listReq)
+	}
+	if resp.Page != 1 || resp.PageSize != 20 || len(resp.Records) != 5 || resp.NextCursor != "" {
+		t.Errorf("response page %d size %d with %d records and cursor %q, want the only page", resp.Page, resp.PageSize, len(resp.Records), resp.NextCursor)
+	}
+
+	// 多取到的一条不返回，生成下一页游标
+	resp, err = s.ListAuditRecords(context.Background(), &ListAuditRecordsRequest{PageSize: 4})
+	if err != nil {
+		t.Fatalf("ListAuditRecords: %v", err)
+	}
+	if len(resp.Records) != 4 || resp.NextCursor == "" {
+		t.Fatalf("got %d records with cursor %q, want 4 and a next cursor", len(resp.Records), resp.NextCursor)
+	}
+	if _, err := s.ListAuditRecords(context.Background(), &ListAuditRecordsRequest{PageSize: 4, Cursor: resp.NextCursor}); err != nil {
+		t.Fatalf("ListAuditRecords with cursor: %v", err)
+	}
+	if after := repo.listReq.After; after == nil || after.ID != 2 {
+		t.Errorf("cursor position = %+v, want after record 2", after)
+	}
+}
+
+func TestManualReviewQueueBuildsFilter(t *testing.T) {
+	s, repo := newFilterTestService()
+
+	_, err := s.GetManualReviewQueue(context.Background(), &GetManualReviewQueueRequest{
+		ContentType: "image",
+		Level:       "medium",
+		Priority:    2,
+		ReviewerID:  7,
+		Page:        1,
+		PageSize:    20,
+	})
+	if err != nil {
+		t.Fatalf("GetManualReviewQueue: %v", err)
+	}
+	// 审核状态由仓库固定为待审核，服务层不设置
+	want := repository.AuditFilter{ContentType: "image", Level: "medium", ReviewerID: 7}
+	if got := repo.queueReq; got.Filter != want || got.Priority != 2 || got.Page != 1 || got.PageSize != 20 {
+		t.Errorf("request = %+v, want filter %+v with priority 2", got, want)
+	}
+}
+
+func TestAuditStatisticsFiltersByDateRange(t *testing.T) {
+	s, repo := newFilterTestService()
+
+	if _, err := s.GetAuditStatistics(context.Background(), &GetAuditStatisticsRequest{StartDate: "2024-01-01", EndDate: "2024-01-31"}); err != nil {
+		t.Fatalf("GetAuditStatistics: %v", err)
+	}
+	want := repository.AuditFilter{StartDate: "2024-01-01", EndDate: "2024-01-31"}
+	if repo.statsReq.Filter != want {
+		t.Errorf("filter = %+v, want %+v", repo.statsReq.Filter, want)
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"audit_service/internal/config"
+	"audit_service/internal/model"
+	"audit_service/pkg/logger"
+)
+
+// 元数据中参与审核级别计算的字段
+const (
+	MetadataFieldDuration        = "duration"         // 内容时长(秒)
+	MetadataFieldPriorViolations = "prior_violations" // 发布者历史违规次数
+	MetadataFieldFollowerCount   = "follower_count"   // 发布者粉丝数
+)
+
+// auditLevelOrder 审核级别由低到高排列，规则调整时在此序列上移动
+var auditLevelOrder = []model.AuditLevel{
+	model.AuditLevelLow,
+	model.AuditLevelMedium,
+	model.AuditLevelHigh,
+}
+
+// defaultAuditLevels 未配置时各内容类型的基础审核级别，未列出的类型使用中级
+var defaultAuditLevels = map[model.ContentType]model.AuditLevel{
+	model.ContentTypeVideo: model.AuditLevelHigh,
+	model.ContentTypeImage: model.AuditLevelMedium,
+	model.ContentTypeText:  model.AuditLevelLow,
+	model.ContentTypeAudio: model.AuditLevelMedium,
+	model.ContentTypeLive:  model.AuditLevelMedium,
+}
+
+// defaultAuditLevelRules 未配置时使用的调整规则
+var defaultAuditLevelRules = []config.AuditLevelRule{
+	// 长时间直播曝光面大，升一级
+	{ContentTypes: []string{string(model.ContentTypeLive)}, Field: MetadataFieldDuration, Op: config.AuditLevelOpGTE, Value: 3600, Adjust: 1},
+	// 一分钟以内的短视频降一级
+	{ContentTypes: []string{string(model.ContentTypeVideo)}, Field: MetadataFieldDuration, Op: config.AuditLevelOpLT, Value: 60, Adjust: -1},
+	// 有违规记录的发布者升一级，多次违规再升一级
+	{Field: MetadataFieldPriorViolations, Op: config.AuditLevelOpGTE, Value: 1, Adjust: 1},
+	{Field: MetadataFieldPriorViolations, Op: config.AuditLevelOpGTE, Value: 3, Adjust: 1},
+	// 大粉丝量发布者传播范围广，升一级
+	{Field: MetadataFieldFollowerCount, Op: config.AuditLevelOpGTE, Value: 100000, Adjust: 1},
+}
+
+// auditLevelPolicy 审核级别策略
+// 先按内容类型取基础级别，再依次应用命中的元数据规则累加调整，结果截断在[low, high]之间
+type auditLevelPolicy struct {
+	defaults map[model.ContentType]model.AuditLevel
+	rules    []config.AuditLevelRule
+}
+
+// newAuditLevelPolicy 根据配置创建审核级别策略
+// 配置的基础级别覆盖内置默认值，配置了规则时完全替换内置规则；非法的级别或规则记录日志后忽略
+func newAuditLevelPolicy(cfg config.AuditLevelConfig, log logger.Logger) *auditLevelPolicy {
+	policy := &auditLevelPolicy{
+		defaults: make(map[model.ContentType]model.AuditLevel, len(defaultAuditLevels)),
+		rules:    defaultAuditLevelRules,
+	}
+	for contentType, level := range defaultAuditLevels {
+		policy.defaults[contentType] = level
+	}
+
+	for contentType, value := range cfg.Defaults {
+		level := model.AuditLevel(strings.ToLower(strings.TrimSpace(value)))
+		if levelIndex(level) < 0 {
+			log.Warn("Ignoring invalid default audit level", "content_type", contentType, "level", value)
+			continue
+		}
+		policy.defaults[model.ContentType(strings.ToLower(contentType))] = level
+	}
+
+	if len(cfg.Rules) > 0 {
+		rules := make([]config.AuditLevelRule, 0, len(cfg.Rules))
+		for _, rule := range cfg.Rules {
+			if rule.Field == "" || (rule.Op != config.AuditLevelOpGTE && rule.Op != config.AuditLevelOpLT) {
+				log.Warn("Ignoring invalid audit level rule", "field", rule.Field, "op", rule.Op)
+				continue
+			}
+			rules = append(rules, rule)
+		}
+		policy.rules = rules
+	}
+
+	return policy
+}
+
+// Determine 计算内容的审核级别，元数据缺失或无法解析时只使用基础级别
+func (p *auditLevelPolicy) Determine(contentType model.ContentType, metadata string) model.AuditLevel {
+	base, ok := p.defaults[contentType]
+	if !ok {
+		base = model.AuditLevelMedium
+	}
+
+	fields := parseAuditMetadata(metadata)
+	if len(fields) == 0 {
+		return base
+	}
+
+	index := levelIndex(base)
+	for _, rule := range p.rules {
+		if rule.Matches(string(contentType), fields) {
+			index += rule.Adjust
+		}
+	}
+
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(auditLevelOrder) {
+		index = len(auditLevelOrder) - 1
+	}
+	return auditLevelOrder[index]
+}
+
+// levelIndex 级别在auditLevelOrder中的位置，未知级别返回-1
+func levelIndex(level model.AuditLevel) int {
+	for i, l := range auditLevelOrder {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseAuditMetadata 从JSON元数据中提取数值字段，数值可以是数字或数字字符串，其余字段忽略
+func parseAuditMetadata(metadata string) map[string]float64 {
+	metadata = strings.TrimSpace(metadata)
+	if metadata == "" {
+		return nil
+	}
+
+	var raw map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(metadata)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil
+	}
+
+	fields := make(map[string]float64, len(raw))
+	for key, value := range raw {
+		var text string
+		switch v := value.(type) {
+		case json.Number:
+			text = v.String()
+		case string:
+			text = strings.TrimSpace(v)
+		default:
+			continue
+		}
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			continue
+		}
+		fields[strings.ToLower(key)] = n
+	}
+	return fields
+}
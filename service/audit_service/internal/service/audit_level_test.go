@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+
+	"audit_service/internal/config"
+	"audit_service/internal/model"
+)
+
+func TestDetermineAuditLevelDefaultRules(t *testing.T) {
+	s := newTestAuditService(newFakeAuditRepo())
+
+	tests := []struct {
+		name        string
+		contentType model.ContentType
+		metadata    string
+		want        model.AuditLevel
+	}{
+		{"video without metadata", model.ContentTypeVideo, "", model.AuditLevelHigh},
+		{"short video", model.ContentTypeVideo, `{"duration": 30}`, model.AuditLevelMedium},
+		{"one minute video", model.ContentTypeVideo, `{"duration": 60}`, model.AuditLevelHigh},
+		{"short video from offender", model.ContentTypeVideo, `{"duration": 30, "prior_violations": 1}`, model.AuditLevelHigh},
+		{"text", model.ContentTypeText, `{}`, model.AuditLevelLow},
+		{"text from offender", model.ContentTypeText, `{"prior_violations": 1}`, model.AuditLevelMedium},
+		{"text from repeat offender", model.ContentTypeText, `{"prior_violations": 3}`, model.AuditLevelHigh},
+		{"text from big account", model.ContentTypeText, `{"follower_count": 100000}`, model.AuditLevelMedium},
+		{"image capped at high", model.ContentTypeImage, `{"prior_violations": 5, "follower_count": 200000}`, model.AuditLevelHigh},
+		{"short live", model.ContentTypeLive, `{"duration": 1800}`, model.AuditLevelMedium},
+		{"long live", model.ContentTypeLive, `{"duration": 3600}`, model.AuditLevelHigh},
+		// 时长规则只对指定类型生效
+		{"long audio", model.ContentTypeAudio, `{"duration": 7200}`, model.AuditLevelMedium},
+		{"numeric strings", model.ContentTypeText, `{"prior_violations": " 3 "}`, model.AuditLevelHigh},
+		{"case-insensitive keys", model.ContentTypeText, `{"Prior_Violations": 1}`, model.AuditLevelMedium},
+		{"non-numeric field ignored", model.ContentTypeText, `{"prior_violations": "many", "tags": ["a"]}`, model.AuditLevelLow},
+		{"malformed metadata", model.ContentTypeVideo, `{"duration": 30`, model.AuditLevelHigh},
+		{"unknown type", model.ContentType("document"), "", model.AuditLevelMedium},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.determineAuditLevel(tt.contentType, tt.metadata); got != tt.want {
+				t.Errorf("determineAuditLevel(%s, %s) = %s, want %s", tt.contentType, tt.metadata, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetermineAuditLevelConfiguredPolicy(t *testing.T) {
+	policy := newAuditLevelPolicy(config.AuditLevelConfig{
+		Defaults: map[string]string{"Text": "MEDIUM", "video": "extreme"},
+		Rules: []config.AuditLevelRule{
+			{Field: "duration", Op: config.AuditLevelOpLT, Value: 10, Adjust: -2},
+			{Field: "follower_count", Op: "eq", Value: 1, Adjust: 1}, // 非法比较方式被忽略
+			{Op: config.AuditLevelOpGTE, Value: 1, Adjust: 1},        // 缺少字段被忽略
+		},
+	}, nopLogger{})
+
+	tests := []struct {
+		contentType model.ContentType
+		metadata    string
+		want        model.AuditLevel
+	}{
+		{model.ContentTypeText, "", model.AuditLevelMedium},
+		// 非法的基础级别保留内置默认值
+		{model.ContentTypeVideo, "", model.AuditLevelHigh},
+		// 配置规则替换内置规则，降级截断在最低级
+		{model.ContentTypeText, `{"duration": 5}`, model.AuditLevelLow},
+		{model.ContentTypeText, `{"prior_violations": 5, "follower_count": 1}`, model.AuditLevelMedium},
+	}
+	for _, tt := range tests {
+		if got := policy.Determine(tt.contentType, tt.metadata); got != tt.want {
+			t.Errorf("Determine(%s, %s) = %s, want %s", tt.contentType, tt.metadata, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"audit_service/internal/model"
+	"audit_service/pkg/notify"
+)
+
+func TestUpdateAuditStatusRejectNotifiesUploader(t *testing.T) {
+	tests := []struct {
+		name        string
+		reason      string
+		wantContent string
+	}{
+		{"with reason", "画面违规", "你提交的内容未通过审核，原因：画面违规"},
+		{"without reason", "", "你提交的内容未通过审核"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := &recordingNotifier{}
+			s := newTestAuditService(newFakeAuditRepo(newPendingRecord()))
+			s.notifier = notifier
+
+			req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusRejected), Reason: tt.reason, ReviewerID: 3}
+			if _, err := s.UpdateAuditStatus(context.Background(), req); err != nil {
+				t.Fatalf("UpdateAuditStatus: %v", err)
+			}
+			if len(notifier.sent) != 1 {
+				t.Fatalf("notifications = %+v, want 1", notifier.sent)
+			}
+			n := notifier.sent[0]
+			// 系统通知没有触发者
+			if n.Type != notify.TypeAuditRejected || n.UserID != 9 || n.ActorID != 0 || n.TargetID != "video-1" {
+				t.Errorf("notification = %+v, want audit rejection of video-1 to uploader 9", n)
+			}
+			if n.Title == "" || n.Content != tt.wantContent {
+				t.Errorf("notification text = %q %q, want content %q", n.Title, n.Content, tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestUpdateAuditStatusApproveDoesNotNotify(t *testing.T) {
+	notifier := &recordingNotifier{}
+	s := newTestAuditService(newFakeAuditRepo(newPendingRecord()))
+	s.notifier = notifier
+
+	req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusApproved), ReviewerID: 3}
+	if _, err := s.UpdateAuditStatus(context.Background(), req); err != nil {
+		t.Fatalf("UpdateAuditStatus: %v", err)
+	}
+	if len(notifier.sent) != 0 {
+		t.Errorf("notifications = %+v, want none for an approval", notifier.sent)
+	}
+}
+
+func TestSubmitContentNotifiesOnlyAutoBlock(t *testing.T) {
+	tests := []struct {
+		name       string
+		score      float64
+		wantStatus model.AuditStatus
+		wantNotify bool
+	}{
+		{"auto blocked", 0.9, model.AuditStatusAutoBlocked, true},
+		{"pending", 0.5, model.AuditStatusPending, false},
+		{"auto passed", 0.1, model.AuditStatusAutoPassed, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := &recordingNotifier{}
+			s := newTestAuditService(newFakeAuditRepo())
+			s.notifier = notifier
+			s.reviewer = scoreReviewer{score: tt.score}
+			s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+			s.config.Audit.Strategies.Content.AutoPassThreshold = 0.3
+
+			resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: "42"})
+			if err != nil {
+				t.Fatalf("SubmitContent: %v", err)
+			}
+			if resp.Status != string(tt.wantStatus) {
+				t.Fatalf("status = %s, want %s", resp.Status, tt.wantStatus)
+			}
+			if !tt.wantNotify {
+				if len(notifier.sent) != 0 {
+					t.Errorf("notifications = %+v, want none", notifier.sent)
+				}
+				return
+			}
+			if len(notifier.sent) != 1 {
+				t.Fatalf("notifications = %+v, want 1", notifier.sent)
+			}
+			if n := notifier.sent[0]; n.Type != notify.TypeAuditRejected || n.UserID != 42 || n.TargetID != "c-1" {
+				t.Errorf("notification = %+v, want audit rejection of c-1 to uploader 42", n)
+			}
+		})
+	}
+}
+
+func TestRejectWithoutNotifier(t *testing.T) {
+	// 未开启用户通知时notifier为nil
+	s := newTestAuditService(newFakeAuditRepo(newPendingRecord()))
+
+	req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusRejected), Reason: "违规", ReviewerID: 3}
+	if _, err := s.UpdateAuditStatus(context.Background(), req); err != nil {
+		t.Fatalf("UpdateAuditStatus: %v", err)
+	}
+}
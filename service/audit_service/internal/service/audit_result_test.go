@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"audit_service/internal/model"
+)
+
+func TestGetAuditResultReturnsStoredFields(t *testing.T) {
+	createdAt := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	reviewedAt := time.Date(2026, 9, 2, 10, 30, 0, 0, time.UTC)
+	reviewerID := uint64(42)
+	repo := newFakeAuditRepo(
+		&model.AuditRecord{
+			ID: 1, ContentID: "video-1", ContentType: model.ContentTypeVideo,
+			Status: model.AuditStatusRejected, Level: model.AuditLevelHigh,
+			ReviewerID: &reviewerID, ReviewTime: &reviewedAt, CreatedAt: createdAt,
+		},
+		&model.AuditRecord{
+			ID: 2, ContentID: "video-2", ContentType: model.ContentTypeVideo,
+			Status: model.AuditStatusPending, Level: model.AuditLevelLow, CreatedAt: createdAt,
+		},
+	)
+	s := newTestAuditService(repo)
+
+	result, err := s.GetAuditResult(context.Background(), "video-1")
+	if err != nil {
+		t.Fatalf("GetAuditResult: %v", err)
+	}
+	if !result.CreatedAt.Equal(createdAt) {
+		t.Errorf("created_at = %v, want stored %v", result.CreatedAt, createdAt)
+	}
+	if result.ReviewTime == nil || !result.ReviewTime.Equal(reviewedAt) {
+		t.Errorf("review_time = %v, want stored %v", result.ReviewTime, reviewedAt)
+	}
+	if result.Level != string(model.AuditLevelHigh) || result.ReviewerID != 42 || !result.Found {
+		t.Errorf("result = %+v, want high level reviewed by 42", result)
+	}
+
+	// 未审核的记录没有审核人和审核时间
+	pending, err := s.GetAuditResult(context.Background(), "video-2")
+	if err != nil {
+		t.Fatalf("GetAuditResult: %v", err)
+	}
+	if pending.ReviewTime != nil || pending.ReviewerID != 0 || !pending.CreatedAt.Equal(createdAt) {
+		t.Errorf("pending result = %+v, want no review and stored created_at", pending)
+	}
+}
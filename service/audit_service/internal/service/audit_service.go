@@ -4,10 +4,20 @@ import (
 	"audit_service/internal/config"
 	"audit_service/internal/model"
 	"audit_service/internal/repository"
+	"audit_service/pkg/featureflags"
+	"audit_service/pkg/ids"
 	"audit_service/pkg/logger"
+	"audit_service/pkg/notify"
+	"audit_service/pkg/paginate"
+	"audit_service/pkg/timeconv"
 	"context"
 	"fmt"
+	"strings"
 	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"common/keywordfilter"
 )
 
 // AuditService 审核服务接口
@@ -21,6 +31,24 @@ type AuditService interface {
 	BatchSubmitContent(ctx context.Context, req *BatchSubmitContentRequest) (*BatchSubmitContentResponse, error)
 	GetBatchAuditResults(ctx context.Context, contentIDs []string) ([]*AuditResult, error)
 
+	// 失败提交重试
+	ListFailedSubmissions(ctx context.Context, req *ListFailedSubmissionsRequest) (*ListFailedSubmissionsResponse, error)
+	RetryFailedSubmission(ctx context.Context, id uint64) (*FailedSubmission, error)
+	ProcessSubmissionRetries(ctx context.Context) (int, error)
+	RunSubmissionRetryWorker(ctx context.Context)
+
+	// 数据保留
+	PurgeExpiredAuditRecords(ctx context.Context) (int64, error)
+	RunRetentionSweeper(ctx context.Context)
+
+	// 用户举报
+	ReportContent(ctx context.Context, req *ReportContentRequest) (*ReportContentResponse, error)
+
+	// 申诉
+	SubmitAppeal(ctx context.Context, auditID uint64, reason string) (*Appeal, error)
+	ResolveAppeal(ctx context.Context, auditID uint64, decision string, reviewerID uint64) (*Appeal, error)
+	ListPendingAppeals(ctx context.Context, page, pageSize int) (*ListAppealsResponse, error)
+
 	// 人工审核
 	AssignManualReview(ctx context.Context, req *AssignManualReviewRequest) (*AssignManualReviewResponse, error)
 	CompleteManualReview(ctx context.Context, req *CompleteManualReviewRequest) (*CompleteManualReviewResponse, error)
@@ -46,19 +74,45 @@ type AuditService interface {
 	GetViolationTrends(ctx context.Context, req *GetViolationTrendsRequest) (*GetViolationTrendsResponse, error)
 }
 
+// 特性开关
+const (
+	// flagStrictAudit 严格审核：AI判定低风险的内容不再自动通过，一律进入人工审核，支持按上传者灰度
+	flagStrictAudit = "audit_strict"
+	// flagAutoBlockThreshold 覆盖自动拦截分数阈值，对所有内容类型生效，未设置时使用配置文件中该内容类型的阈值
+	flagAutoBlockThreshold = "audit_auto_block_threshold"
+)
+
+// auditRecordsSort 审核记录列表游标分页的排序方式
+const auditRecordsSort = "id_desc"
+
+// defaultBatchSubmitWorkers 未配置worker_count时批量提交的并发数
+const defaultBatchSubmitWorkers = 5
+
 // auditService 审核服务实现
 type auditService struct {
 	config     *config.Config
 	logger     logger.Logger
 	repository repository.AuditRepository
+	flags      *featureflags.Flags
+	notifier   notify.Notifier
+	reviewer   contentReviewer
+
+	levelPolicy *auditLevelPolicy
+	keywords    *keywordfilter.Filter
 }
 
-// NewAuditService 创建审核服务
-func NewAuditService(cfg *config.Config, log logger.Logger, repo repository.AuditRepository) AuditService {
+// NewAuditService 创建审核服务，flags为nil时所有特性开关取默认值，notifier为nil时不发送用户通知
+func NewAuditService(cfg *config.Config, log logger.Logger, repo repository.AuditRepository, flags *featureflags.Flags, notifier notify.Notifier) AuditService {
 	return &auditService{
 		config:     cfg,
 		logger:     log,
 		repository: repo,
+		flags:      flags,
+		notifier:   notifier,
+		reviewer:   mockReviewer{},
+
+		levelPolicy: newAuditLevelPolicy(cfg.Audit.Levels, log),
+		keywords:    keywordfilter.New(cfg.Audit.Keywords),
 	}
 }
 
@@ -66,6 +120,11 @@ func NewAuditService(cfg *config.Config, log logger.Logger, repo repository.Audi
 func (s *auditService) SubmitContent(ctx context.Context, req *SubmitContentRequest) (*SubmitContentResponse, error) {
 	s.logger.Info("Submitting content for audit", "content_id", req.ContentID, "content_type", req.ContentType)
 
+	uploaderID, err := ids.Parse(req.UploaderID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid uploader id: %v", ErrInvalidSubmission, err)
+	}
+
 	// 检查黑白名单
 	if whitelisted, err := s.repository.IsWhitelisted(ctx, req.ContentID, model.ContentType(req.ContentType)); err != nil {
 		return nil, fmt.Errorf("failed to check whitelist: %w", err)
@@ -88,17 +147,14 @@ func (s *auditService) SubmitContent(ctx context.Context, req *SubmitContentRequ
 	}
 
 	// 创建审核记录
-	// Convert string UploaderID to uint64 (assuming it's a numeric string)
-	var uploaderID uint64
-	fmt.Sscanf(req.UploaderID, "%d", &uploaderID)
-
 	auditRecord := &model.AuditRecord{
 		ContentID:       req.ContentID,
 		ContentType:     model.ContentType(req.ContentType),
 		ContentTitle:    req.ContentTitle,
 		ContentURL:      req.ContentURL,
+		Content:         req.Content,
 		ContentMetadata: req.ContentMetadata,
-		UploaderID:      uploaderID,
+		UploaderID:      uploaderID.Uint64(),
 		UploaderName:    req.UploaderName,
 		Status:          model.AuditStatusPending,
 		Level:           s.determineAuditLevel(model.ContentType(req.ContentType), req.ContentMetadata),
@@ -115,12 +171,35 @@ func (s *auditService) SubmitContent(ctx context.Context, req *SubmitContentRequ
 		auditRecord.AIConfidence = aiResult.Confidence
 		auditRecord.Score = aiResult.Score
 
-		// 根据AI结果决定审核状态
-		if aiResult.Score >= s.config.Audit.Strategies.Content.AutoBlockThreshold {
+		// 根据AI结果决定审核状态，阈值按内容类型选取
+		thresholds := s.config.Audit.Strategies.Content.ThresholdsFor(req.ContentType)
+		autoBlockThreshold := s.flags.Float(flagAutoBlockThreshold, thresholds.AutoBlockThreshold)
+		autoPassThreshold := thresholds.AutoPassThreshold
+		strict := s.flags.EnabledFor(flagStrictAudit, uploaderID.Uint64())
+
+		// 重复违规的上传者按加成后的评分判定，并可强制进入人工审核
+		score := aiResult.Score
+		manualOnly := strict
+		// 标题或正文命中关键词的内容不自动通过
+		if matched := s.checkKeywords(auditRecord); len(matched) > 0 {
+			aiResult.MatchedKeywords = mergeKeywords(aiResult.MatchedKeywords, matched)
+			manualOnly = true
+		}
+		escalation := s.checkRepeatOffender(ctx, auditRecord.UploaderID, aiResult.Score)
+		if escalation != nil {
+			score = escalation.EffectiveScore
+			manualOnly = manualOnly || escalation.ForceManual
+		}
+
+		if score >= autoBlockThreshold {
 			auditRecord.Status = model.AuditStatusAutoBlocked
-		} else if aiResult.Score <= 0.2 {
+		} else if score <= autoPassThreshold && !manualOnly {
 			auditRecord.Status = model.AuditStatusAutoPassed
 		}
+		auditRecord.Keywords = strings.Join(aiResult.MatchedKeywords, ",")
+		details := newAuditDecisionDetails(aiResult, auditRecord.Status, score, autoBlockThreshold, autoPassThreshold, strict)
+		details.RepeatOffender = escalation
+		auditRecord.Details = details.String()
 	}
 
 	// 保存审核记录
@@ -135,6 +214,9 @@ func (s *auditService) SubmitContent(ctx context.Context, req *SubmitContentRequ
 			s.logger.Error("Failed to add to manual review queue", "error", err, "audit_id", auditID)
 		}
 	}
+	if auditRecord.Status == model.AuditStatusAutoBlocked {
+		s.notifyAuditRejected(auditRecord)
+	}
 
 	return &SubmitContentResponse{
 		AuditID: auditID,
@@ -151,55 +233,90 @@ func (s *auditService) GetAuditResult(ctx context.Context, contentID string) (*A
 		return nil, fmt.Errorf("failed to get audit record: %w", err)
 	}
 
+	return toAuditResult(auditRecord), nil
+}
+
+// toAuditResult 将审核记录转换为审核结果
+func toAuditResult(record *model.AuditRecord) *AuditResult {
+	var reviewerID uint64
+	if record.ReviewerID != nil {
+		reviewerID = *record.ReviewerID
+	}
 	return &AuditResult{
-		AuditID:     auditRecord.ID,
-		ContentID:   auditRecord.ContentID,
-		ContentType: string(auditRecord.ContentType),
-		Status:      string(auditRecord.Status),
-		Score:       auditRecord.Score,
-		Reason:      auditRecord.Reason,
-		Details:     auditRecord.Details,
-		ReviewTime:  auditRecord.ReviewTime,
-	}, nil
+		AuditID:     record.ID,
+		ContentID:   record.ContentID,
+		ContentType: string(record.ContentType),
+		Status:      string(record.Status),
+		Score:       record.Score,
+		Reason:      record.Reason,
+		Details:     record.Details,
+		Level:       string(record.Level),
+		ReviewerID:  reviewerID,
+		ReviewTime:  record.ReviewTime,
+		CreatedAt:   record.CreatedAt,
+		Found:       true,
+	}
 }
 
 // UpdateAuditStatus 更新审核状态
 func (s *auditService) UpdateAuditStatus(ctx context.Context, req *UpdateAuditStatusRequest) (*UpdateAuditStatusResponse, error) {
+	// 违规类型必须在分类体系内，统一以JSON数组存储
+	violations, err := model.NormalizeViolations(req.Violations)
+	if err != nil {
+		return nil, err
+	}
+
 	auditRecord, err := s.repository.GetAuditRecord(ctx, req.AuditID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get audit record: %w", err)
 	}
+	// 申诉中的记录只能通过处理申诉变更审核结果
+	if auditRecord.Status == model.AuditStatusAppealing {
+		return nil, ErrAppealInProgress
+	}
 
 	// 更新审核状态
 	auditRecord.Status = model.AuditStatus(req.Status)
 	auditRecord.Reason = req.Reason
 	auditRecord.Details = req.Details
-	auditRecord.Violations = req.Violations
+	auditRecord.Violations = violations
 	auditRecord.ReviewerID = &req.ReviewerID
 	// ReviewerName is not available in the request, so we'll leave it empty
 	now := time.Now()
 	auditRecord.ReviewTime = &now
 	auditRecord.UpdatedAt = time.Now()
 
-	if err := s.repository.UpdateAuditRecord(ctx, auditRecord); err != nil {
-		return nil, fmt.Errorf("failed to update audit record: %w", err)
-	}
+	// 审核状态和黑名单在同一事务中写入，拉黑失败时审核状态一并回滚
+	rejected := req.Status == string(model.AuditStatusRejected)
+	err = s.repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.repository.UpdateAuditRecord(ctx, auditRecord); err != nil {
+			return fmt.Errorf("failed to update audit record: %w", err)
+		}
+		if !rejected {
+			return nil
+		}
 
-	// 更新黑名单（如果是拒绝状态）
-	if req.Status == string(model.AuditStatusRejected) {
 		blacklistRecord := &model.AuditBlacklist{
 			ContentID:   auditRecord.ContentID,
 			ContentType: auditRecord.ContentType,
 			UploaderID:  auditRecord.UploaderID,
 			Reason:      req.Reason,
-			Violations:  req.Violations,
+			Violations:  violations,
 			CreatedAt:   time.Now(),
 			CreatedBy:   req.ReviewerID,
 		}
-
 		if err := s.repository.AddToBlacklist(ctx, blacklistRecord); err != nil {
-			s.logger.Error("Failed to add to blacklist", "error", err, "content_id", auditRecord.ContentID)
+			return fmt.Errorf("failed to add to blacklist: %w", err)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 事务提交后再通知，回滚的拒绝不会发出通知
+	if rejected {
+		s.notifyAuditRejected(auditRecord)
 	}
 
 	return &UpdateAuditStatusResponse{
@@ -208,62 +325,155 @@ func (s *auditService) UpdateAuditStatus(ctx context.Context, req *UpdateAuditSt
 	}, nil
 }
 
-// BatchSubmitContent 批量提交内容审核
+// BatchSubmitContent 批量提交内容审核，按worker_count并发处理，结果顺序与请求展开后的顺序一致
 func (s *auditService) BatchSubmitContent(ctx context.Context, req *BatchSubmitContentRequest) (*BatchSubmitContentResponse, error) {
-	s.logger.Info("Batch submitting content for audit", "count", len(req.ContentIDs))
+	contentReqs := s.buildBatchSubmitRequests(req)
+	s.logger.Info("Batch submitting content for audit", "count", len(contentReqs))
+
+	results := make([]*SubmitContentResponse, len(contentReqs))
+
+	// 单项失败写入该项结果，不影响其他内容，因此各项处理均不返回错误
+	var g errgroup.Group
+	g.SetLimit(s.batchSubmitWorkers())
+	for i, contentReq := range contentReqs {
+		g.Go(func() error {
+			results[i] = s.submitBatchItem(ctx, contentReq)
+			return nil
+		})
+	}
+	g.Wait()
 
-	results := make([]*SubmitContentResponse, len(req.ContentIDs))
+	return &BatchSubmitContentResponse{
+		Results: results,
+		Message: fmt.Sprintf("Batch submitted %d contents for audit", len(contentReqs)),
+	}, nil
+}
 
-	for i, contentID := range req.ContentIDs {
-		contentReq := &SubmitContentRequest{
-			ContentID:   contentID,
-			ContentType: req.ContentType,
-			// Content and Metadata are not available in BatchSubmitContentRequest
-			// Content:     req.Content,
-			// Metadata:    req.Metadata,
-			UploaderID: req.UploaderID,
+// submitBatchItem 提交批量中的单项内容，失败时写入重试队列并在结果中说明
+func (s *auditService) submitBatchItem(ctx context.Context, contentReq *SubmitContentRequest) *SubmitContentResponse {
+	result, err := s.SubmitContent(ctx, contentReq)
+	if err == nil {
+		return result
+	}
+
+	s.logger.Error("Failed to submit content in batch", "error", err, "content_id", contentReq.ContentID)
+	if !isTransientSubmitError(err) {
+		// 内容本身无效，重试不会成功
+		return &SubmitContentResponse{
+			AuditID: 0,
+			Status:  string(model.AuditStatusRejected),
+			Message: fmt.Sprintf("Failed to submit content: %v", err),
+		}
+	}
+	// 写入重试队列，由后台任务按退避策略重试
+	retry, qerr := s.enqueueSubmissionRetry(ctx, contentReq, err)
+	if qerr != nil {
+		s.logger.Error("Failed to enqueue submission retry", "error", qerr, "content_id", contentReq.ContentID)
+		return &SubmitContentResponse{
+			AuditID: 0,
+			Status:  string(model.AuditStatusRejected),
+			Message: fmt.Sprintf("Failed to submit content: %v", err),
 		}
+	}
+	return &SubmitContentResponse{
+		AuditID: 0,
+		Status:  string(model.AuditStatusPending),
+		Message: fmt.Sprintf("Failed to submit content, queued for retry (retry_id=%d): %v", retry.ID, err),
+	}
+}
 
-		result, err := s.SubmitContent(ctx, contentReq)
-		if err != nil {
-			s.logger.Error("Failed to submit content in batch", "error", err, "content_id", contentID)
-			results[i] = &SubmitContentResponse{
-				AuditID: 0,
-				Status:  string(model.AuditStatusRejected),
-				Message: fmt.Sprintf("Failed to submit content: %v", err),
-			}
-		} else {
-			results[i] = result
+// batchSubmitWorkers 批量提交时并发处理的内容数
+func (s *auditService) batchSubmitWorkers() int {
+	if workers := s.config.Audit.Queue.WorkerCount; workers > 0 {
+		return workers
+	}
+	return defaultBatchSubmitWorkers
+}
+
+// buildBatchSubmitRequests 将批量请求展开为单条提交请求
+// 先处理共用内容的ContentIDs，再处理逐项内容的Items，结果顺序与此一致
+func (s *auditService) buildBatchSubmitRequests(req *BatchSubmitContentRequest) []*SubmitContentRequest {
+	contentReqs := make([]*SubmitContentRequest, 0, len(req.ContentIDs)+len(req.Items))
+
+	for _, contentID := range req.ContentIDs {
+		contentReqs = append(contentReqs, &SubmitContentRequest{
+			ContentID:       contentID,
+			ContentType:     req.ContentType,
+			Content:         req.Content,
+			ContentMetadata: req.Metadata,
+			UploaderID:      req.UploaderID,
+		})
+	}
+
+	for _, item := range req.Items {
+		if item == nil {
+			continue
 		}
+
+		contentReq := &SubmitContentRequest{
+			ContentID:       item.ContentID,
+			ContentType:     req.ContentType,
+			ContentTitle:    item.ContentTitle,
+			ContentURL:      item.ContentURL,
+			Content:         item.Content,
+			ContentMetadata: item.Metadata,
+			UploaderID:      req.UploaderID,
+		}
+		if contentReq.Content == "" {
+			contentReq.Content = req.Content
+		}
+		if contentReq.ContentMetadata == "" {
+			contentReq.ContentMetadata = req.Metadata
+		}
+		contentReqs = append(contentReqs, contentReq)
 	}
 
-	return &BatchSubmitContentResponse{
-		Results: results,
-		Message: fmt.Sprintf("Batch submitted %d contents for audit", len(req.ContentIDs)),
-	}, nil
+	return contentReqs
 }
 
 // GetBatchAuditResults 批量获取审核结果
+// 返回结果与contentIDs按位置一一对应，重复ID各自返回一份结果；
+// 不存在审核记录的内容返回Found为false的结果，不影响其他内容，也不会被当作拒绝处理
 func (s *auditService) GetBatchAuditResults(ctx context.Context, contentIDs []string) ([]*AuditResult, error) {
 	s.logger.Info("Getting batch audit results", "count", len(contentIDs))
 
-	results := make([]*AuditResult, len(contentIDs))
+	// 去重并过滤空ID后一次查询
+	seen := make(map[string]struct{}, len(contentIDs))
+	queryIDs := make([]string, 0, len(contentIDs))
+	for _, contentID := range contentIDs {
+		if contentID == "" {
+			continue
+		}
+		if _, ok := seen[contentID]; ok {
+			continue
+		}
+		seen[contentID] = struct{}{}
+		queryIDs = append(queryIDs, contentID)
+	}
 
-	for i, contentID := range contentIDs {
-		result, err := s.GetAuditResult(ctx, contentID)
+	recordsByContentID := make(map[string]*model.AuditRecord, len(queryIDs))
+	if len(queryIDs) > 0 {
+		records, err := s.repository.GetAuditRecordsByContentIDs(ctx, queryIDs)
 		if err != nil {
-			s.logger.Error("Failed to get audit result in batch", "error", err, "content_id", contentID)
-			results[i] = &AuditResult{
-				AuditID:   0,
-				ContentID: contentID,
-				Status:    string(model.AuditStatusRejected),
-				Reason:    fmt.Sprintf("Failed to get audit result: %v", err),
+			return nil, fmt.Errorf("failed to get audit records: %w", err)
+		}
+		// 同一内容存在多条记录时取ID最小的一条，与GetAuditResult保持一致
+		for _, record := range records {
+			if existing, ok := recordsByContentID[record.ContentID]; !ok || record.ID < existing.ID {
+				recordsByContentID[record.ContentID] = record
 			}
-		} else {
-			results[i] = result
 		}
 	}
 
+	results := make([]*AuditResult, len(contentIDs))
+	for i, contentID := range contentIDs {
+		if record, ok := recordsByContentID[contentID]; ok {
+			results[i] = toAuditResult(record)
+			continue
+		}
+		results[i] = &AuditResult{ContentID: contentID}
+	}
+
 	return results, nil
 }
 
@@ -276,6 +486,10 @@ func (s *auditService) AssignManualReview(ctx context.Context, req *AssignManual
 	if err != nil {
 		return nil, fmt.Errorf("failed to get audit record: %w", err)
 	}
+	// 申诉由处理申诉的审核员直接处理，不能重新分配到人工审核
+	if auditRecord.Status == model.AuditStatusAppealing {
+		return nil, ErrAppealInProgress
+	}
 
 	// 更新审核记录
 	auditRecord.ReviewerID = &req.ReviewerID
@@ -461,16 +675,15 @@ func (s *auditService) ListTemplates(ctx context.Context, req *ListTemplatesRequ
 func (s *auditService) AddToWhitelist(ctx context.Context, req *AddToWhitelistRequest) (*AddToWhitelistResponse, error) {
 	s.logger.Info("Adding to whitelist", "content_id", req.ContentID, "content_type", req.ContentType)
 
-	// Convert string UploaderID to uint64
-	var uploaderID uint64
-	if req.UploaderID != "" {
-		fmt.Sscanf(req.UploaderID, "%d", &uploaderID)
+	uploaderID, err := ids.ParseOptional(req.UploaderID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uploader id: %w", err)
 	}
 
 	whitelist := &model.AuditWhitelist{
 		ContentID:   req.ContentID,
 		ContentType: model.ContentType(req.ContentType),
-		UploaderID:  uploaderID,
+		UploaderID:  uploaderID.Uint64(),
 		Reason:      req.Reason,
 		IsPermanent: req.IsPermanent,
 		CreatedAt:   time.Now(),
@@ -478,7 +691,7 @@ func (s *auditService) AddToWhitelist(ctx context.Context, req *AddToWhitelistRe
 	}
 
 	if req.ExpiryDate != "" {
-		expiryTime, err := time.Parse("2006-01-02 15:04:05", req.ExpiryDate)
+		expiryTime, err := timeconv.Parse(req.ExpiryDate)
 		if err != nil {
 			return nil, fmt.Errorf("invalid expiry date format: %w", err)
 		}
@@ -510,16 +723,15 @@ func (s *auditService) RemoveFromWhitelist(ctx context.Context, contentID string
 func (s *auditService) AddToBlacklist(ctx context.Context, req *AddToBlacklistRequest) (*AddToBlacklistResponse, error) {
 	s.logger.Info("Adding to blacklist", "content_id", req.ContentID, "content_type", req.ContentType)
 
-	// Convert string UploaderID to uint64
-	var uploaderID uint64
-	if req.UploaderID != "" {
-		fmt.Sscanf(req.UploaderID, "%d", &uploaderID)
+	uploaderID, err := ids.ParseOptional(req.UploaderID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uploader id: %w", err)
 	}
 
 	blacklist := &model.AuditBlacklist{
 		ContentID:   req.ContentID,
 		ContentType: model.ContentType(req.ContentType),
-		UploaderID:  uploaderID,
+		UploaderID:  uploaderID.Uint64(),
 		Reason:      req.Reason,
 		Violations:  req.Violations,
 		IsPermanent: req.IsPermanent,
@@ -528,7 +740,7 @@ func (s *auditService) AddToBlacklist(ctx context.Context, req *AddToBlacklistRe
 	}
 
 	if req.ExpiryDate != "" {
-		expiryTime, err := time.Parse("2006-01-02 15:04:05", req.ExpiryDate)
+		expiryTime, err := timeconv.Parse(req.ExpiryDate)
 		if err != nil {
 			return nil, fmt.Errorf("invalid expiry date format: %w", err)
 		}
@@ -562,8 +774,7 @@ func (s *auditService) GetAuditStatistics(ctx context.Context, req *GetAuditStat
 
 	// 转换为repository层的请求类型
 	repoReq := &repository.GetAuditStatisticsRequest{
-		StartDate: req.StartDate,
-		EndDate:   req.EndDate,
+		Filter: repository.AuditFilter{}.WithDateRange(req.StartDate, req.EndDate),
 	}
 
 	// 调用repository获取统计数据
@@ -622,8 +833,7 @@ func (s *auditService) GetViolationTrends(ctx context.Context, req *GetViolation
 
 	// 转换为repository层的请求类型
 	repoReq := &repository.GetViolationTrendsRequest{
-		StartDate: req.StartDate,
-		EndDate:   req.EndDate,
+		Filter: repository.AuditFilter{}.WithDateRange(req.StartDate, req.EndDate),
 	}
 
 	// 调用repository获取趋势数据
@@ -638,27 +848,47 @@ func (s *auditService) GetViolationTrends(ctx context.Context, req *GetViolation
 	// 转换趋势数据点
 	for _, trend := range trends.Trends {
 		result.Trends = append(result.Trends, ViolationTrend{
-			Date:      trend.Date,
-			Violation: trend.Count,
+			Date:       trend.Date,
+			Violation:  trend.Count,
+			Categories: trend.Categories,
 		})
 	}
 
 	return result, nil
 }
 
-// ListAuditRecords 获取审核记录列表
+// ListAuditRecords 获取审核记录列表，按审核ID倒序
+// Cursor不为空时按游标分页，游标无效时返回paginate.ErrInvalidCursor
 func (s *auditService) ListAuditRecords(ctx context.Context, req *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
 	s.logger.Info("Listing audit records", "content_type", req.ContentType, "page", req.Page)
 
-	// 转换为repository层的请求类型
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	after, err := paginate.Decode(req.Cursor, auditRecordsSort)
+	if err != nil {
+		return nil, err
+	}
+	uploaderID, err := ids.ParseOptional(req.UploaderID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uploader id: %w", err)
+	}
+
+	// 转换为repository层的请求类型，多取一条判断是否还有下一页
 	repoReq := &repository.ListAuditRecordsRequest{
-		ContentType: req.ContentType,
-		Status:      req.Status,
-		Level:       req.Level,
-		StartDate:   req.StartDate,
-		EndDate:     req.EndDate,
-		Page:        req.Page,
-		PageSize:    req.PageSize,
+		Filter: repository.AuditFilter{}.
+			WithContentType(req.ContentType).
+			WithStatus(req.Status).
+			WithLevel(req.Level).
+			WithUploader(uploaderID.Uint64()).
+			WithDateRange(req.StartDate, req.EndDate),
+		Page:     page,
+		PageSize: pageSize + 1,
+		After:    after,
 	}
 
 	// 调用repository获取审核记录列表
@@ -670,8 +900,13 @@ func (s *auditService) ListAuditRecords(ctx context.Context, req *ListAuditRecor
 	// 转换为service层的响应类型
 	result := &ListAuditRecordsResponse{
 		Total:    records.Total,
-		Page:     records.Page,
-		PageSize: records.PageSize,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if len(records.Records) > pageSize {
+		records.Records = records.Records[:pageSize]
+		last := records.Records[pageSize-1]
+		result.NextCursor = paginate.Encode(paginate.Cursor{Sort: auditRecordsSort, ID: last.ID})
 	}
 
 	// 转换审核记录
@@ -683,7 +918,7 @@ func (s *auditService) ListAuditRecords(ctx context.Context, req *ListAuditRecor
 			ContentTitle:    record.ContentTitle,
 			ContentURL:      record.ContentURL,
 			ContentMetadata: record.ContentMetadata,
-			UploaderID:      fmt.Sprintf("%d", record.UploaderID), // 转换为字符串
+			UploaderID:      ids.Format(record.UploaderID),
 			UploaderName:    record.UploaderName,
 			Status:          string(record.Status),
 			Level:           string(record.Level),
@@ -710,10 +945,13 @@ func (s *auditService) GetManualReviewQueue(ctx context.Context, req *GetManualR
 
 	// 转换为repository层的请求类型
 	repoReq := &repository.GetManualReviewQueueRequest{
-		ContentType: req.ContentType,
-		Level:       req.Level,
-		Page:        req.Page,
-		PageSize:    req.PageSize,
+		Filter: repository.AuditFilter{}.
+			WithContentType(req.ContentType).
+			WithLevel(req.Level).
+			WithReviewer(req.ReviewerID),
+		Priority: req.Priority,
+		Page:     req.Page,
+		PageSize: req.PageSize,
 	}
 
 	// 调用repository获取人工审核队列
@@ -738,7 +976,7 @@ func (s *auditService) GetManualReviewQueue(ctx context.Context, req *GetManualR
 			ContentTitle:    record.ContentTitle,
 			ContentURL:      record.ContentURL,
 			ContentMetadata: record.ContentMetadata,
-			UploaderID:      fmt.Sprintf("%d", record.UploaderID), // 转换为字符串
+			UploaderID:      ids.Format(record.UploaderID),
 			UploaderName:    record.UploaderName,
 			Status:          string(record.Status),
 			Level:           string(record.Level),
@@ -760,29 +998,39 @@ func (s *auditService) GetManualReviewQueue(ctx context.Context, req *GetManualR
 }
 
 // determineAuditLevel 确定审核级别
+// 基础级别由内容类型决定，再根据元数据中的时长、历史违规次数、粉丝数等按规则升降级
 func (s *auditService) determineAuditLevel(contentType model.ContentType, metadata string) model.AuditLevel {
-	// 根据内容类型和元数据确定审核级别
-	switch contentType {
-	case model.ContentTypeVideo:
-		return model.AuditLevelHigh
-	case model.ContentTypeImage:
-		return model.AuditLevelMedium
-	case model.ContentTypeText:
-		return model.AuditLevelLow
-	case model.ContentTypeAudio:
-		return model.AuditLevelMedium
-	default:
-		return model.AuditLevelMedium
-	}
+	return s.levelPolicy.Determine(contentType, metadata)
 }
 
-// performAIReview 执行AI审核
-func (s *auditService) performAIReview(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
+// contentReviewer AI审核，根据审核记录中的标题、正文和元数据给出评分
+type contentReviewer interface {
+	Review(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error)
+}
+
+// mockReviewer 模拟的AI审核，所有内容均返回低风险
+type mockReviewer struct{}
+
+func (mockReviewer) Review(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
 	// 这里应该调用实际的AI审核服务
 	// 现在返回模拟结果
 	return &AIReviewResult{
-		Result:     `{"violations": [], "keywords": [], "risk_level": "low"}`,
-		Confidence: 0.95,
-		Score:      0.1, // 低风险分数
+		Result:          `{"violations": [], "keywords": [], "risk_level": "low"}`,
+		Confidence:      0.95,
+		Score:           0.1, // 低风险分数
+		MatchedKeywords: []string{},
+		CategoryScores: map[model.ViolationCategory]float64{
+			model.ViolationNudity:     0.1,
+			model.ViolationViolence:   0.1,
+			model.ViolationSpam:       0.1,
+			model.ViolationHateSpeech: 0.1,
+			model.ViolationPolitical:  0.1,
+			model.ViolationFraud:      0.1,
+		},
 	}, nil
 }
+
+// performAIReview 执行AI审核，返回综合评分以及命中的关键词和各违规类型的评分
+func (s *auditService) performAIReview(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
+	return s.reviewer.Review(ctx, record)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audit_service/internal/model"
+	"audit_service/pkg/notify"
+)
+
+// recordingNotifier 记录发送的用户通知
+type recordingNotifier struct {
+	sent []notify.Notification
+}
+
+func (n *recordingNotifier) Notify(notification notify.Notification) {
+	n.sent = append(n.sent, notification)
+}
+
+func newPendingRecord() *model.AuditRecord {
+	return &model.AuditRecord{
+		ID:          1,
+		ContentID:   "video-1",
+		ContentType: model.ContentTypeVideo,
+		UploaderID:  9,
+		Status:      model.AuditStatusPending,
+	}
+}
+
+func TestUpdateAuditStatusRejectAddsToBlacklist(t *testing.T) {
+	repo := newFakeAuditRepo(newPendingRecord())
+	notifier := &recordingNotifier{}
+	s := newTestAuditService(repo)
+	s.notifier = notifier
+
+	req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusRejected), Reason: "违规", ReviewerID: 3}
+	if _, err := s.UpdateAuditStatus(context.Background(), req); err != nil {
+		t.Fatalf("UpdateAuditStatus: %v", err)
+	}
+	if got := repo.records[1].Status; got != model.AuditStatusRejected {
+		t.Errorf("status = %s, want rejected", got)
+	}
+	if entry := repo.blacklist["video-1"]; entry == nil || entry.CreatedBy != 3 || entry.UploaderID != 9 {
+		t.Errorf("blacklist entry = %+v, want created by reviewer 3 for uploader 9", entry)
+	}
+	if len(notifier.sent) != 1 {
+		t.Errorf("notifications = %d, want 1", len(notifier.sent))
+	}
+}
+
+func TestUpdateAuditStatusRollsBackWhenBlacklistFails(t *testing.T) {
+	repo := newFakeAuditRepo(newPendingRecord())
+	repo.blacklistErr = errors.New("duplicate key")
+	notifier := &recordingNotifier{}
+	s := newTestAuditService(repo)
+	s.notifier = notifier
+
+	req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusRejected), Reason: "违规", ReviewerID: 3}
+	if _, err := s.UpdateAuditStatus(context.Background(), req); err == nil {
+		t.Fatal("UpdateAuditStatus succeeded although the blacklist write failed")
+	}
+	// 审核状态随黑名单一起回滚，也不发送拒绝通知
+	if got := repo.records[1].Status; got != model.AuditStatusPending {
+		t.Errorf("status = %s after rollback, want pending", got)
+	}
+	if len(repo.blacklist) != 0 {
+		t.Errorf("blacklist = %v, want empty", repo.blacklist)
+	}
+	if len(notifier.sent) != 0 {
+		t.Errorf("notifications = %d after rollback, want 0", len(notifier.sent))
+	}
+}
+
+func TestUpdateAuditStatusApproveSkipsBlacklist(t *testing.T) {
+	repo := newFakeAuditRepo(newPendingRecord())
+	repo.blacklistErr = errors.New("must not be called")
+	s := newTestAuditService(repo)
+
+	req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusApproved), ReviewerID: 3}
+	if _, err := s.UpdateAuditStatus(context.Background(), req); err != nil {
+		t.Fatalf("UpdateAuditStatus: %v", err)
+	}
+	if got := repo.records[1].Status; got != model.AuditStatusApproved {
+		t.Errorf("status = %s, want approved", got)
+	}
+}
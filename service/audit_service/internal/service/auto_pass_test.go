@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+// scoreReviewer 返回固定评分的AI审核结果
+type scoreReviewer struct {
+	score float64
+}
+
+func (r scoreReviewer) Review(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
+	result, _ := mockReviewer{}.Review(ctx, record)
+	result.Score = r.score
+	return result, nil
+}
+
+func TestSubmitContentAutoPassCutoff(t *testing.T) {
+	tests := []struct {
+		name  string
+		score float64
+		want  model.AuditStatus
+	}{
+		{"zero", 0, model.AuditStatusAutoPassed},
+		// 旧的固定阈值0.2不再生效
+		{"above old cutoff", 0.25, model.AuditStatusAutoPassed},
+		{"below cutoff", 0.29, model.AuditStatusAutoPassed},
+		{"at cutoff", 0.3, model.AuditStatusAutoPassed},
+		{"just above cutoff", 0.31, model.AuditStatusPending},
+		{"just below block", 0.79, model.AuditStatusPending},
+		{"at block", 0.8, model.AuditStatusAutoBlocked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeAuditRepo()
+			s := newTestAuditService(repo)
+			s.reviewer = scoreReviewer{score: tt.score}
+			s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+			s.config.Audit.Strategies.Content.AutoPassThreshold = 0.3
+
+			resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: "42"})
+			if err != nil {
+				t.Fatalf("SubmitContent: %v", err)
+			}
+			if resp.Status != string(tt.want) {
+				t.Errorf("score %v: status = %s, want %s", tt.score, resp.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubmitContentZeroAutoPassThresholdPassesOnlyZeroScore(t *testing.T) {
+	for score, want := range map[float64]model.AuditStatus{0: model.AuditStatusAutoPassed, 0.01: model.AuditStatusPending} {
+		s := newTestAuditService(newFakeAuditRepo())
+		s.reviewer = scoreReviewer{score: score}
+		s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+
+		resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: "42"})
+		if err != nil {
+			t.Fatalf("SubmitContent: %v", err)
+		}
+		if resp.Status != string(want) {
+			t.Errorf("score %v: status = %s, want %s", score, resp.Status, want)
+		}
+	}
+}
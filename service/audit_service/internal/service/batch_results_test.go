@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+func TestGetBatchAuditResultsKeepsRequestOrder(t *testing.T) {
+	repo := newFakeAuditRepo(
+		&model.AuditRecord{ID: 1, ContentID: "video-1", ContentType: model.ContentTypeVideo, Status: model.AuditStatusApproved},
+		&model.AuditRecord{ID: 2, ContentID: "video-2", ContentType: model.ContentTypeVideo, Status: model.AuditStatusRejected},
+		// 同一内容的后续记录不覆盖最早的一条
+		&model.AuditRecord{ID: 3, ContentID: "video-1", ContentType: model.ContentTypeVideo, Status: model.AuditStatusPending},
+	)
+	s := newTestAuditService(repo)
+
+	ids := []string{"video-2", "missing", "video-1", "", "video-2"}
+	results, err := s.GetBatchAuditResults(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetBatchAuditResults: %v", err)
+	}
+	if len(results) != len(ids) {
+		t.Fatalf("results = %d, want one per requested id", len(results))
+	}
+
+	want := []struct {
+		found   bool
+		auditID uint64
+		status  string
+	}{
+		{true, 2, string(model.AuditStatusRejected)},
+		{false, 0, ""},
+		{true, 1, string(model.AuditStatusApproved)},
+		{false, 0, ""},
+		{true, 2, string(model.AuditStatusRejected)},
+	}
+	for i, w := range want {
+		got := results[i]
+		if got.ContentID != ids[i] || got.Found != w.found || got.AuditID != w.auditID || got.Status != w.status {
+			t.Errorf("result %d = %+v, want content %q found=%v audit=%d status=%q", i, got, ids[i], w.found, w.auditID, w.status)
+		}
+	}
+
+	// 去重并过滤空ID后只查询一次
+	if len(repo.contentIDQueries) != 1 {
+		t.Fatalf("queries = %v, want a single batch query", repo.contentIDQueries)
+	}
+	if q := repo.contentIDQueries[0]; len(q) != 3 || q[0] != "video-2" || q[1] != "missing" || q[2] != "video-1" {
+		t.Errorf("query ids = %v, want deduplicated [video-2 missing video-1]", q)
+	}
+}
+
+func TestGetBatchAuditResultsMissingContentIsNotRejected(t *testing.T) {
+	s := newTestAuditService(newFakeAuditRepo())
+
+	results, err := s.GetBatchAuditResults(context.Background(), []string{"video-9"})
+	if err != nil {
+		t.Fatalf("GetBatchAuditResults: %v", err)
+	}
+	if got := results[0]; got.Found || got.Status == string(model.AuditStatusRejected) || got.ContentID != "video-9" {
+		t.Errorf("missing result = %+v, want not found without a status", got)
+	}
+}
+
+func TestGetBatchAuditResultsEmptyInputSkipsQuery(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+
+	results, err := s.GetBatchAuditResults(context.Background(), []string{"", ""})
+	if err != nil || len(results) != 2 || results[0].Found || results[1].Found {
+		t.Fatalf("results = %v, %v, want two not-found results", results, err)
+	}
+	if len(repo.contentIDQueries) != 0 {
+		t.Errorf("queries = %v, want none for empty ids", repo.contentIDQueries)
+	}
+}
+
+func TestGetBatchAuditResultsQueryFailure(t *testing.T) {
+	repo := newFakeAuditRepo()
+	queryErr := errors.New("db down")
+	repo.contentIDsErr = queryErr
+	s := newTestAuditService(repo)
+
+	results, err := s.GetBatchAuditResults(context.Background(), []string{"video-1"})
+	if !errors.Is(err, queryErr) || results != nil {
+		t.Fatalf("GetBatchAuditResults = (%v, %v), want the query error and no partial results", results, err)
+	}
+}
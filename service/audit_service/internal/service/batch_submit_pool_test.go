@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"audit_service/internal/model"
+)
+
+// gatedReviewer 统计同时在审核的内容数，同时在审核的内容首次达到limit前阻塞，用于验证并发上限
+type gatedReviewer struct {
+	limit int
+
+	mu        sync.Mutex
+	active    int
+	maxActive int
+	opened    bool
+	gate      chan struct{}
+}
+
+func newGatedReviewer(limit int) *gatedReviewer {
+	return &gatedReviewer{limit: limit, gate: make(chan struct{})}
+}
+
+func (r *gatedReviewer) Review(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
+	r.mu.Lock()
+	r.active++
+	if r.active > r.maxActive {
+		r.maxActive = r.active
+	}
+	if r.active == r.limit && !r.opened {
+		r.opened = true
+		close(r.gate)
+	}
+	r.mu.Unlock()
+
+	// 并发不足时超时放行，避免测试卡死
+	select {
+	case <-r.gate:
+	case <-time.After(time.Second):
+	}
+
+	r.mu.Lock()
+	r.active--
+	r.mu.Unlock()
+	return mockReviewer{}.Review(ctx, record)
+}
+
+// failingCreateRepo 指定内容ID的审核记录写入失败，retryErr不为nil时写入重试队列也失败
+type failingCreateRepo struct {
+	*fakeAuditRepo
+	fail     map[string]bool
+	retryErr error
+}
+
+func (r *failingCreateRepo) CreateAuditRecord(ctx context.Context, record *model.AuditRecord) (uint64, error) {
+	if r.fail[record.ContentID] {
+		return 0, errors.New("db down")
+	}
+	return r.fakeAuditRepo.CreateAuditRecord(ctx, record)
+}
+
+func (r *failingCreateRepo) CreateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error {
+	if r.retryErr != nil {
+		return r.retryErr
+	}
+	return r.fakeAuditRepo.CreateSubmissionRetry(ctx, retry)
+}
+
+func newBatchPoolTestService(repo *fakeAuditRepo, workers int) *auditService {
+	s := newTestAuditService(repo)
+	s.config.Audit.Queue.WorkerCount = workers
+	s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+	s.config.Audit.Strategies.Content.AutoPassThreshold = 0.3
+	return s
+}
+
+func batchContentIDs(n int) []string {
+	contentIDs := make([]string, n)
+	for i := range contentIDs {
+		contentIDs[i] = fmt.Sprintf("c-%d", i+1)
+	}
+	return contentIDs
+}
+
+func TestBatchSubmitContentBoundedConcurrency(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newBatchPoolTestService(repo, 3)
+	reviewer := newGatedReviewer(3)
+	s.reviewer = reviewer
+
+	resp, err := s.BatchSubmitContent(context.Background(), &BatchSubmitContentRequest{
+		ContentIDs: batchContentIDs(9), ContentType: "text", UploaderID: "42",
+	})
+	if err != nil {
+		t.Fatalf("BatchSubmitContent: %v", err)
+	}
+	if len(resp.Results) != 9 || len(repo.records) != 9 {
+		t.Fatalf("got %d results and %d records, want 9", len(resp.Results), len(repo.records))
+	}
+	if reviewer.maxActive != 3 {
+		t.Errorf("max concurrent reviews = %d, want worker_count 3", reviewer.maxActive)
+	}
+}
+
+func TestBatchSubmitContentPreservesOrder(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newBatchPoolTestService(repo, 4)
+	s.reviewer = newGatedReviewer(4)
+	contentIDs := batchContentIDs(10)
+
+	resp, err := s.BatchSubmitContent(context.Background(), &BatchSubmitContentRequest{
+		ContentIDs:  contentIDs[:6],
+		ContentType: "text",
+		UploaderID:  "42",
+		Items: []*BatchSubmitItem{
+			{ContentID: contentIDs[6]}, {ContentID: contentIDs[7]}, {ContentID: contentIDs[8]}, {ContentID: contentIDs[9]},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchSubmitContent: %v", err)
+	}
+	if len(resp.Results) != len(contentIDs) {
+		t.Fatalf("got %d results, want %d", len(resp.Results), len(contentIDs))
+	}
+	// 并发完成的顺序不定，结果仍按请求展开后的顺序排列
+	for i, result := range resp.Results {
+		record, ok := repo.records[result.AuditID]
+		if !ok || record.ContentID != contentIDs[i] {
+			t.Errorf("result %d = %+v, want the audit record of %s", i, result, contentIDs[i])
+		}
+	}
+}
+
+func TestBatchSubmitContentItemErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryErr   error
+		wantStatus model.AuditStatus
+		wantMsg    string
+		wantRetry  int
+	}{
+		{"queued for retry", nil, model.AuditStatusPending, "queued for retry", 2},
+		{"retry queue failed", errors.New("queue down"), model.AuditStatusRejected, "Failed to submit content: failed to create audit record: db down", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeAuditRepo()
+			s := newBatchPoolTestService(fake, 2)
+			s.repository = &failingCreateRepo{fakeAuditRepo: fake, fail: map[string]bool{"c-2": true, "c-4": true}, retryErr: tt.retryErr}
+
+			resp, err := s.BatchSubmitContent(context.Background(), &BatchSubmitContentRequest{
+				ContentIDs: batchContentIDs(5), ContentType: "text", UploaderID: "42",
+			})
+			if err != nil {
+				t.Fatalf("BatchSubmitContent: %v", err)
+			}
+			if len(resp.Results) != 5 {
+				t.Fatalf("got %d results, want 5", len(resp.Results))
+			}
+			// 单项失败不影响其他内容
+			for i, result := range resp.Results {
+				contentID := fmt.Sprintf("c-%d", i+1)
+				if contentID == "c-2" || contentID == "c-4" {
+					if result.AuditID != 0 || result.Status != string(tt.wantStatus) || !strings.Contains(result.Message, tt.wantMsg) {
+						t.Errorf("%s result = %+v, want %s with %q", contentID, result, tt.wantStatus, tt.wantMsg)
+					}
+					continue
+				}
+				if record, ok := fake.records[result.AuditID]; !ok || record.ContentID != contentID {
+					t.Errorf("%s result = %+v, want its created audit record", contentID, result)
+				}
+			}
+			if len(fake.retries) != tt.wantRetry {
+				t.Errorf("retries = %d, want %d", len(fake.retries), tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestBatchSubmitWorkers(t *testing.T) {
+	for _, tt := range []struct{ configured, want int }{{0, defaultBatchSubmitWorkers}, {-1, defaultBatchSubmitWorkers}, {8, 8}} {
+		s := newBatchPoolTestService(newFakeAuditRepo(), tt.configured)
+		if got := s.batchSubmitWorkers(); got != tt.want {
+			t.Errorf("worker_count %d: workers = %d, want %d", tt.configured, got, tt.want)
+		}
+	}
+}
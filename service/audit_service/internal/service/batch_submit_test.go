@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+// recordingReviewer 记录送到AI审核的内容，返回低风险结果
+type recordingReviewer struct {
+	mu       sync.Mutex
+	reviewed map[string]model.AuditRecord
+}
+
+func (r *recordingReviewer) Review(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reviewed[record.ContentID] = *record
+	return mockReviewer{}.Review(ctx, record)
+}
+
+func TestBatchSubmitContentSendsEachItemContentToReviewer(t *testing.T) {
+	repo := newFakeAuditRepo()
+	reviewer := &recordingReviewer{reviewed: make(map[string]model.AuditRecord)}
+	s := newTestAuditService(repo)
+	s.reviewer = reviewer
+	s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+	s.config.Audit.Strategies.Content.AutoPassThreshold = 0.3
+
+	resp, err := s.BatchSubmitContent(context.Background(), &BatchSubmitContentRequest{
+		ContentIDs:  []string{"comment-1", "comment-2"},
+		ContentType: "text",
+		Content:     "共用评论",
+		Metadata:    `{"source":"shared"}`,
+		UploaderID:  "42",
+		Items: []*BatchSubmitItem{
+			{ContentID: "comment-3", ContentTitle: "标题三", Content: "第三条评论", Metadata: `{"source":"item-3"}`},
+			{ContentID: "comment-4", Content: "第四条评论"},
+			{ContentID: "comment-5"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchSubmitContent: %v", err)
+	}
+	if len(resp.Results) != 5 {
+		t.Fatalf("got %d results, want 5", len(resp.Results))
+	}
+	for i, result := range resp.Results {
+		if result.AuditID == 0 {
+			t.Errorf("result %d = %+v, want a created audit record", i, result)
+		}
+	}
+
+	tests := []struct {
+		contentID string
+		title     string
+		content   string
+		metadata  string
+	}{
+		{"comment-1", "", "共用评论", `{"source":"shared"}`},
+		{"comment-2", "", "共用评论", `{"source":"shared"}`},
+		{"comment-3", "标题三", "第三条评论", `{"source":"item-3"}`},
+		// 未填写的元数据和内容回退到批次共用值
+		{"comment-4", "", "第四条评论", `{"source":"shared"}`},
+		{"comment-5", "", "共用评论", `{"source":"shared"}`},
+	}
+	for _, tt := range tests {
+		record, ok := reviewer.reviewed[tt.contentID]
+		if !ok {
+			t.Errorf("%s was not sent to the reviewer", tt.contentID)
+			continue
+		}
+		if record.ContentTitle != tt.title || record.Content != tt.content || record.ContentMetadata != tt.metadata {
+			t.Errorf("%s reviewed with title %q content %q metadata %s, want %q %q %s",
+				tt.contentID, record.ContentTitle, record.Content, record.ContentMetadata, tt.title, tt.content, tt.metadata)
+		}
+		if record.ContentType != model.ContentTypeText || record.UploaderID != 42 {
+			t.Errorf("%s reviewed as %s from uploader %d, want text from 42", tt.contentID, record.ContentType, record.UploaderID)
+		}
+	}
+}
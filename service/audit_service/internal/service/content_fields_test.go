@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSubmitContentStoresTitleURLAndMetadata(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+
+	resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{
+		ContentID:       "video_9",
+		ContentType:     "video",
+		ContentTitle:    "我的视频",
+		ContentURL:      "https://cdn.example.com/v/9.mp4",
+		ContentMetadata: `{"title":"我的视频"}`,
+		UploaderID:      "42",
+		Content:         "简介",
+	})
+	if err != nil {
+		t.Fatalf("SubmitContent: %v", err)
+	}
+
+	record := repo.records[resp.AuditID]
+	if record == nil {
+		t.Fatalf("no record for audit %d", resp.AuditID)
+	}
+	if record.ContentTitle != "我的视频" || record.ContentURL != "https://cdn.example.com/v/9.mp4" ||
+		record.ContentMetadata != `{"title":"我的视频"}` || record.Content != "简介" {
+		t.Errorf("record = %+v, want title, url, metadata and content stored", record)
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"audit_service/internal/config"
+	"audit_service/internal/model"
+	"audit_service/pkg/featureflags"
+)
+
+func TestSubmitContentUsesContentTypeThresholds(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		score       float64
+		want        model.AuditStatus
+	}{
+		// 视频的拦截阈值更低，通过阈值更严格
+		{"video blocked below global block", "video", 0.65, model.AuditStatusAutoBlocked},
+		{"video pending above its pass", "video", 0.2, model.AuditStatusPending},
+		{"video passed", "video", 0.1, model.AuditStatusAutoPassed},
+		// 未单独配置的类型使用全局阈值
+		{"text pending", "text", 0.65, model.AuditStatusPending},
+		{"text passed", "text", 0.2, model.AuditStatusAutoPassed},
+		{"text blocked", "text", 0.8, model.AuditStatusAutoBlocked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestAuditService(newFakeAuditRepo())
+			s.reviewer = scoreReviewer{score: tt.score}
+			s.config.Audit.Strategies.Content = config.AuditStrategy{
+				AutoBlockThreshold: 0.8,
+				AutoPassThreshold:  0.3,
+				Thresholds: map[string]config.AuditThresholds{
+					"video": {AutoBlockThreshold: 0.6, AutoPassThreshold: 0.1},
+				},
+			}
+
+			resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: tt.contentType, UploaderID: "42"})
+			if err != nil {
+				t.Fatalf("SubmitContent: %v", err)
+			}
+			if resp.Status != string(tt.want) {
+				t.Errorf("%s score %v: status = %s, want %s", tt.contentType, tt.score, resp.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoBlockThresholdFlagOverridesContentType(t *testing.T) {
+	for _, contentType := range []string{"video", "text"} {
+		s := newTestAuditService(newFakeAuditRepo())
+		s.reviewer = scoreReviewer{score: 0.5}
+		s.flags = featureflags.New(nil, map[string]string{flagAutoBlockThreshold: "0.5"}, 0, nopLogger{})
+		s.config.Audit.Strategies.Content = config.AuditStrategy{
+			AutoBlockThreshold: 0.8,
+			AutoPassThreshold:  0.3,
+			Thresholds: map[string]config.AuditThresholds{
+				"video": {AutoBlockThreshold: 0.6, AutoPassThreshold: 0.1},
+			},
+		}
+
+		resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: contentType, UploaderID: "42"})
+		if err != nil {
+			t.Fatalf("SubmitContent: %v", err)
+		}
+		if resp.Status != string(model.AuditStatusAutoBlocked) {
+			t.Errorf("%s: status = %s, want auto blocked by the flag threshold", contentType, resp.Status)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"audit_service/internal/model"
+	"encoding/json"
+)
+
+// 自动审核触发的阈值
+const (
+	thresholdAutoBlock = "auto_block"
+	thresholdAutoPass  = "auto_pass"
+)
+
+// AuditDecisionDetails 自动审核的决策说明，以JSON保存在审核记录的Details中，供网关展示给创作者
+type AuditDecisionDetails struct {
+	Decision        string             `json:"decision"`         // 自动审核后的审核状态
+	Score           float64            `json:"score"`            // AI综合评分
+	Confidence      float64            `json:"confidence"`       // AI置信度
+	MatchedKeywords []string           `json:"matched_keywords"` // 命中的敏感关键词
+	CategoryScores  map[string]float64 `json:"category_scores"`  // 各违规类型的模型评分
+	// Threshold 触发的阈值，评分介于自动通过和自动拦截之间时为空
+	Threshold *ThresholdCrossed `json:"threshold,omitempty"`
+	// Strict 是否因严格审核未自动通过而进入人工审核
+	Strict bool `json:"strict,omitempty"`
+	// RepeatOffender 上传者为重复违规者时的加严说明，此时按其中的effective_score与阈值比较
+	RepeatOffender *RepeatOffenderEscalation `json:"repeat_offender,omitempty"`
+}
+
+// ThresholdCrossed 触发的审核阈值
+type ThresholdCrossed struct {
+	Name  string  `json:"name"`  // auto_block或auto_pass
+	Value float64 `json:"value"` // 阈值
+}
+
+// newAuditDecisionDetails 根据AI审核结果和决策时使用的评分、阈值生成决策说明
+// score为与阈值比较的评分，重复违规的上传者为加成后的评分，其他情况与AI评分相同
+func newAuditDecisionDetails(aiResult *AIReviewResult, status model.AuditStatus, score, autoBlockThreshold, autoPassThreshold float64, strict bool) *AuditDecisionDetails {
+	details := &AuditDecisionDetails{
+		Decision:        string(status),
+		Score:           aiResult.Score,
+		Confidence:      aiResult.Confidence,
+		MatchedKeywords: aiResult.MatchedKeywords,
+		CategoryScores:  make(map[string]float64, len(aiResult.CategoryScores)),
+	}
+	if details.MatchedKeywords == nil {
+		details.MatchedKeywords = []string{}
+	}
+	for category, score := range aiResult.CategoryScores {
+		details.CategoryScores[string(category)] = score
+	}
+
+	switch {
+	case score >= autoBlockThreshold:
+		details.Threshold = &ThresholdCrossed{Name: thresholdAutoBlock, Value: autoBlockThreshold}
+	case score <= autoPassThreshold:
+		details.Threshold = &ThresholdCrossed{Name: thresholdAutoPass, Value: autoPassThreshold}
+		details.Strict = strict
+	}
+	return details
+}
+
+// String 序列化为JSON，失败时返回空字符串
+func (d *AuditDecisionDetails) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+// keywordReviewer 返回指定评分和关键词的AI审核结果
+type keywordReviewer struct {
+	score    float64
+	keywords []string
+}
+
+func (r keywordReviewer) Review(ctx context.Context, record *model.AuditRecord) (*AIReviewResult, error) {
+	result, _ := mockReviewer{}.Review(ctx, record)
+	result.Score = r.score
+	result.MatchedKeywords = r.keywords
+	return result, nil
+}
+
+func TestNewAuditDecisionDetailsThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     model.AuditStatus
+		score      float64
+		strict     bool
+		wantName   string
+		wantValue  float64
+		wantStrict bool
+	}{
+		{"at block", model.AuditStatusAutoBlocked, 0.8, false, thresholdAutoBlock, 0.8, false},
+		{"above block", model.AuditStatusAutoBlocked, 0.95, true, thresholdAutoBlock, 0.8, false},
+		{"at pass", model.AuditStatusAutoPassed, 0.3, false, thresholdAutoPass, 0.3, false},
+		// 严格审核时评分低于自动通过阈值仍进入人工审核
+		{"strict pass", model.AuditStatusPending, 0.1, true, thresholdAutoPass, 0.3, true},
+		{"between", model.AuditStatusPending, 0.5, true, "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai := &AIReviewResult{Score: 0.4, Confidence: 0.9}
+			details := newAuditDecisionDetails(ai, tt.status, tt.score, 0.8, 0.3, tt.strict)
+			if details.Decision != string(tt.status) || details.Score != 0.4 || details.Confidence != 0.9 {
+				t.Errorf("details = %+v, want decision %s with the AI score and confidence", details, tt.status)
+			}
+			if tt.wantName == "" {
+				if details.Threshold != nil {
+					t.Errorf("threshold = %+v, want none", details.Threshold)
+				}
+			} else if details.Threshold == nil || details.Threshold.Name != tt.wantName || details.Threshold.Value != tt.wantValue {
+				t.Errorf("threshold = %+v, want %s at %v", details.Threshold, tt.wantName, tt.wantValue)
+			}
+			if details.Strict != tt.wantStrict {
+				t.Errorf("strict = %v, want %v", details.Strict, tt.wantStrict)
+			}
+		})
+	}
+}
+
+func TestAuditDecisionDetailsString(t *testing.T) {
+	ai := &AIReviewResult{
+		Score:          0.9,
+		Confidence:     0.7,
+		CategoryScores: map[model.ViolationCategory]float64{model.ViolationSpam: 0.9},
+	}
+	details := newAuditDecisionDetails(ai, model.AuditStatusAutoBlocked, 0.9, 0.8, 0.3, false)
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(details.String()), &got); err != nil {
+		t.Fatalf("unmarshal %q: %v", details.String(), err)
+	}
+	// 没有命中关键词时序列化为空数组而不是null
+	if keywords, ok := got["matched_keywords"].([]interface{}); !ok || len(keywords) != 0 {
+		t.Errorf("matched_keywords = %v, want []", got["matched_keywords"])
+	}
+	if scores, ok := got["category_scores"].(map[string]interface{}); !ok || scores["spam"] != 0.9 {
+		t.Errorf("category_scores = %v, want spam 0.9", got["category_scores"])
+	}
+	if threshold, ok := got["threshold"].(map[string]interface{}); !ok || threshold["name"] != thresholdAutoBlock || threshold["value"] != 0.8 {
+		t.Errorf("threshold = %v, want auto_block 0.8", got["threshold"])
+	}
+	for _, key := range []string{"strict", "repeat_offender"} {
+		if _, ok := got[key]; ok {
+			t.Errorf("%s present in %s, want omitted", key, details.String())
+		}
+	}
+}
+
+func TestSubmitContentStoresDecisionDetails(t *testing.T) {
+	tests := []struct {
+		name          string
+		score         float64
+		keywords      []string
+		wantStatus    model.AuditStatus
+		wantThreshold string
+	}{
+		{"auto blocked", 0.9, []string{"spam"}, model.AuditStatusAutoBlocked, thresholdAutoBlock},
+		{"auto passed", 0.1, nil, model.AuditStatusAutoPassed, thresholdAutoPass},
+		{"manual review", 0.5, nil, model.AuditStatusPending, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeAuditRepo()
+			s := newTestAuditService(repo)
+			s.reviewer = keywordReviewer{score: tt.score, keywords: tt.keywords}
+			s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+			s.config.Audit.Strategies.Content.AutoPassThreshold = 0.3
+
+			resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: "42"})
+			if err != nil {
+				t.Fatalf("SubmitContent: %v", err)
+			}
+			record := repo.records[resp.AuditID]
+			var details AuditDecisionDetails
+			if err := json.Unmarshal([]byte(record.Details), &details); err != nil {
+				t.Fatalf("unmarshal details %q: %v", record.Details, err)
+			}
+			if details.Decision != string(tt.wantStatus) || record.Status != tt.wantStatus || details.Score != tt.score {
+				t.Errorf("details decision = %s score = %v, record status = %s, want %s score %v", details.Decision, details.Score, record.Status, tt.wantStatus, tt.score)
+			}
+			if tt.wantThreshold == "" {
+				if details.Threshold != nil {
+					t.Errorf("threshold = %+v, want none", details.Threshold)
+				}
+			} else if details.Threshold == nil || details.Threshold.Name != tt.wantThreshold {
+				t.Errorf("threshold = %+v, want %s", details.Threshold, tt.wantThreshold)
+			}
+			if len(details.MatchedKeywords) != len(tt.keywords) || len(details.CategoryScores) != 6 {
+				t.Errorf("keywords = %v categories = %v, want %v and the reviewer's six categories", details.MatchedKeywords, details.CategoryScores, tt.keywords)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audit_service/pkg/timeconv"
+)
+
+func TestAddToBlacklistExpiryDate(t *testing.T) {
+	want := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	for _, expiry := range []string{"2026-09-01T08:00:00+08:00", "2026-09-01T00:00:00Z", "2026-09-01 00:00:00"} {
+		repo := newFakeAuditRepo()
+		s := newTestAuditService(repo)
+
+		if _, err := s.AddToBlacklist(context.Background(), &AddToBlacklistRequest{ContentID: "c-1", ContentType: "text", ExpiryDate: expiry, CreatedBy: 1}); err != nil {
+			t.Fatalf("AddToBlacklist(%q): %v", expiry, err)
+		}
+		got := repo.blacklist["c-1"].ExpiryDate
+		if got == nil || !got.Equal(want) {
+			t.Errorf("expiry %q stored as %v, want %v", expiry, got, want)
+		}
+	}
+}
+
+func TestAddToBlacklistRejectsInvalidExpiryDate(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+
+	_, err := s.AddToBlacklist(context.Background(), &AddToBlacklistRequest{ContentID: "c-1", ContentType: "text", ExpiryDate: "2026-09-01", CreatedBy: 1})
+	if !errors.Is(err, timeconv.ErrInvalidTime) {
+		t.Errorf("AddToBlacklist = %v, want ErrInvalidTime", err)
+	}
+	if len(repo.blacklist) != 0 {
+		t.Errorf("blacklist = %v, want nothing stored", repo.blacklist)
+	}
+}
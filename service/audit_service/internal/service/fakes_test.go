@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"audit_service/internal/config"
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// fakeAuditRepo 内存实现的审核仓库
+// 只实现测试用到的方法，调用未实现的方法会因嵌入的nil接口panic
+type fakeAuditRepo struct {
+	repository.AuditRepository
+
+	records map[uint64]*model.AuditRecord
+	appeals []*model.AuditAppeal
+
+	// 记录写入调用，用于断言未被调用
+	updated []uint64
+	queued  []uint64
+
+	// 失败提交重试队列，whitelistErr不为nil时SubmitContent在查询白名单时失败
+	retries      map[uint64]*model.AuditSubmissionRetry
+	whitelistErr error
+
+	// 黑名单，blacklistErr不为nil时AddToBlacklist失败
+	blacklist    map[string]*model.AuditBlacklist
+	blacklistErr error
+
+	// contentIDQueries 按内容ID批量查询的参数，contentIDsErr不为nil时查询失败
+	contentIDQueries [][]string
+	contentIDsErr    error
+
+	// 用户举报记录，同一举报人对同一内容只保留一条
+	reports []*model.AuditReport
+
+	// deleteBatches 每次删除过期审核记录的条数，retentionErr不为nil时清理失败
+	deleteBatches []int64
+	retentionErr  error
+
+	// mu 保护批量提交时并发写入的审核记录和重试队列
+	mu sync.Mutex
+}
+
+func newFakeAuditRepo(records ...*model.AuditRecord) *fakeAuditRepo {
+	repo := &fakeAuditRepo{
+		records:   make(map[uint64]*model.AuditRecord),
+		retries:   make(map[uint64]*model.AuditSubmissionRetry),
+		blacklist: make(map[string]*model.AuditBlacklist),
+	}
+	for _, record := range records {
+		repo.records[record.ID] = record
+	}
+	return repo
+}
+
+func newTestAuditService(repo *fakeAuditRepo) *auditService {
+	return &auditService{
+		config:      &config.Config{},
+		logger:      nopLogger{},
+		repository:  repo,
+		reviewer:    mockReviewer{},
+		levelPolicy: newAuditLevelPolicy(config.AuditLevelConfig{}, nopLogger{}),
+	}
+}
+
+// Transaction fn返回错误时恢复执行前的审核记录和黑名单，模拟事务回滚
+func (r *fakeAuditRepo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	records := make(map[uint64]*model.AuditRecord, len(r.records))
+	for id, record := range r.records {
+		records[id] = record
+	}
+	blacklist := make(map[string]*model.AuditBlacklist, len(r.blacklist))
+	for id, entry := range r.blacklist {
+		blacklist[id] = entry
+	}
+
+	if err := fn(ctx); err != nil {
+		r.records, r.blacklist = records, blacklist
+		return err
+	}
+	return nil
+}
+
+func (r *fakeAuditRepo) AddToBlacklist(ctx context.Context, blacklist *model.AuditBlacklist) error {
+	if r.blacklistErr != nil {
+		return r.blacklistErr
+	}
+	copied := *blacklist
+	r.blacklist[blacklist.ContentID] = &copied
+	return nil
+}
+
+func (r *fakeAuditRepo) GetAuditRecord(ctx context.Context, auditID uint64) (*model.AuditRecord, error) {
+	record, ok := r.records[auditID]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+	copied := *record
+	return &copied, nil
+}
+
+// GetAuditRecordByContentID 与数据库实现一致，返回该内容ID最早的一条记录
+func (r *fakeAuditRepo) GetAuditRecordByContentID(ctx context.Context, contentID string) (*model.AuditRecord, error) {
+	var found *model.AuditRecord
+	for _, record := range r.records {
+		if record.ContentID == contentID && (found == nil || record.ID < found.ID) {
+			found = record
+		}
+	}
+	if found == nil {
+		return nil, errors.New("record not found")
+	}
+	copied := *found
+	return &copied, nil
+}
+
+func (r *fakeAuditRepo) GetAuditRecordsByContentIDs(ctx context.Context, contentIDs []string) ([]*model.AuditRecord, error) {
+	r.contentIDQueries = append(r.contentIDQueries, append([]string(nil), contentIDs...))
+	if r.contentIDsErr != nil {
+		return nil, r.contentIDsErr
+	}
+	var records []*model.AuditRecord
+	for _, record := range r.records {
+		for _, id := range contentIDs {
+			if record.ContentID == id {
+				copied := *record
+				records = append(records, &copied)
+			}
+		}
+	}
+	return records, nil
+}
+
+func (r *fakeAuditRepo) UpdateAuditRecord(ctx context.Context, record *model.AuditRecord) error {
+	r.updated = append(r.updated, record.ID)
+	copied := *record
+	r.records[record.ID] = &copied
+	return nil
+}
+
+func (r *fakeAuditRepo) AddToManualReviewQueue(ctx context.Context, auditID uint64) error {
+	r.queued = append(r.queued, auditID)
+	return nil
+}
+
+// CreateAuditAppeal 与数据库实现一致：申诉中重复提交、申诉已被驳回、非拒绝状态均不能申诉
+func (r *fakeAuditRepo) CreateAuditAppeal(ctx context.Context, auditID uint64, reason string, priority int) (*model.AuditAppeal, error) {
+	record, ok := r.records[auditID]
+	if !ok {
+		return nil, repository.ErrAuditRecordNotFound
+	}
+	if record.Status == model.AuditStatusAppealing {
+		return nil, repository.ErrAppealAlreadyOpen
+	}
+	for _, appeal := range r.appeals {
+		if appeal.AuditID == auditID && appeal.Status == model.AppealStatusUpheld {
+			return nil, repository.ErrAppealFinal
+		}
+	}
+	if record.Status != model.AuditStatusRejected && record.Status != model.AuditStatusAutoBlocked {
+		return nil, repository.ErrAppealNotAllowed
+	}
+
+	appeal := &model.AuditAppeal{
+		ID:               uint64(len(r.appeals) + 1),
+		AuditID:          auditID,
+		ContentID:        record.ContentID,
+		Reason:           reason,
+		PreviousStatus:   record.Status,
+		PreviousPriority: record.Priority,
+		Status:           model.AppealStatusPending,
+		CreatedAt:        time.Now(),
+	}
+	r.appeals = append(r.appeals, appeal)
+	record.Status = model.AuditStatusAppealing
+	record.Priority = priority
+	return appeal, nil
+}
+
+func (r *fakeAuditRepo) ResolveAuditAppeal(ctx context.Context, auditID uint64, decision model.AppealDecision, reviewerID uint64, resolvedAt time.Time) (*model.AuditAppeal, error) {
+	for _, appeal := range r.appeals {
+		if appeal.AuditID != auditID || appeal.Status != model.AppealStatusPending {
+			continue
+		}
+		record := r.records[auditID]
+		record.Priority = appeal.PreviousPriority
+		if decision == model.AppealDecisionOverturn {
+			appeal.Status = model.AppealStatusOverturned
+			record.Status = model.AuditStatusApproved
+		} else {
+			appeal.Status = model.AppealStatusUpheld
+			record.Status = appeal.PreviousStatus
+		}
+		appeal.ReviewerID = &reviewerID
+		appeal.ResolvedAt = &resolvedAt
+		return appeal, nil
+	}
+	return nil, repository.ErrAppealNotFound
+}
+
+func (r *fakeAuditRepo) ListPendingAppeals(ctx context.Context, page, pageSize int) ([]*model.AuditAppeal, int64, error) {
+	var pending []*model.AuditAppeal
+	for _, appeal := range r.appeals {
+		if appeal.Status == model.AppealStatusPending {
+			pending = append(pending, appeal)
+		}
+	}
+	return pending, int64(len(pending)), nil
+}
+
+func (r *fakeAuditRepo) IsWhitelisted(ctx context.Context, contentID string, contentType model.ContentType) (bool, error) {
+	return false, r.whitelistErr
+}
+
+func (r *fakeAuditRepo) IsBlacklisted(ctx context.Context, contentID string, contentType model.ContentType) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.blacklist[contentID]
+	return ok, nil
+}
+
+func (r *fakeAuditRepo) CreateAuditRecord(ctx context.Context, record *model.AuditRecord) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record.ID = uint64(len(r.records) + 1)
+	r.records[record.ID] = record
+	return record.ID, nil
+}
+
+func (r *fakeAuditRepo) CreateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	retry.ID = uint64(len(r.retries) + 1)
+	copied := *retry
+	r.retries[retry.ID] = &copied
+	return nil
+}
+
+func (r *fakeAuditRepo) GetSubmissionRetry(ctx context.Context, id uint64) (*model.AuditSubmissionRetry, error) {
+	retry, ok := r.retries[id]
+	if !ok {
+		return nil, repository.ErrSubmissionRetryNotFound
+	}
+	copied := *retry
+	return &copied, nil
+}
+
+func (r *fakeAuditRepo) UpdateSubmissionRetry(ctx context.Context, retry *model.AuditSubmissionRetry) error {
+	copied := *retry
+	r.retries[retry.ID] = &copied
+	return nil
+}
+
+// leaseFree 与数据库实现的领取条件一致：未被领取或租约已过期
+func leaseFree(retry *model.AuditSubmissionRetry, now time.Time) bool {
+	return retry.LockedUntil == nil || !retry.LockedUntil.After(now)
+}
+
+func (r *fakeAuditRepo) ClaimDueSubmissionRetries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.AuditSubmissionRetry, error) {
+	var claimed []*model.AuditSubmissionRetry
+	for id := uint64(1); id <= uint64(len(r.retries)) && len(claimed) < limit; id++ {
+		retry, ok := r.retries[id]
+		if !ok || retry.Status != model.SubmissionRetryPending || retry.NextRetryAt.After(now) || !leaseFree(retry, now) {
+			continue
+		}
+		lockedUntil := now.Add(lease)
+		retry.LockedUntil = &lockedUntil
+		copied := *retry
+		claimed = append(claimed, &copied)
+	}
+	return claimed, nil
+}
+
+func (r *fakeAuditRepo) ClaimSubmissionRetry(ctx context.Context, id uint64, now time.Time, lease time.Duration) (*model.AuditSubmissionRetry, error) {
+	retry, ok := r.retries[id]
+	if !ok {
+		return nil, repository.ErrSubmissionRetryNotFound
+	}
+	if retry.Status == model.SubmissionRetrySucceeded || !leaseFree(retry, now) {
+		return nil, repository.ErrSubmissionRetryLocked
+	}
+	lockedUntil := now.Add(lease)
+	retry.LockedUntil = &lockedUntil
+	copied := *retry
+	return &copied, nil
+}
+
+// CreateAuditReport 与数据库唯一索引一致：同一举报人重复举报同一内容返回ErrDuplicateReport
+func (r *fakeAuditRepo) CreateAuditReport(ctx context.Context, report *model.AuditReport) error {
+	for _, existing := range r.reports {
+		if existing.ContentID == report.ContentID && existing.ContentType == report.ContentType && existing.ReporterID == report.ReporterID {
+			return repository.ErrDuplicateReport
+		}
+	}
+	report.ID = uint64(len(r.reports) + 1)
+	copied := *report
+	r.reports = append(r.reports, &copied)
+	return nil
+}
+
+func (r *fakeAuditRepo) CountContentReporters(ctx context.Context, contentID string, contentType model.ContentType) (int64, error) {
+	var count int64
+	for _, report := range r.reports {
+		if report.ContentID == contentID && report.ContentType == contentType {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// expiredRecordIDs 与数据库查询条件一致：待审核、申诉中和黑名单内容不清理，按ID升序返回
+func (r *fakeAuditRepo) expiredRecordIDs(filter *repository.ExpiredAuditRecordsFilter) []uint64 {
+	matchType := func(types []model.ContentType, contentType model.ContentType) bool {
+		for _, t := range types {
+			if t == contentType {
+				return true
+			}
+		}
+		return false
+	}
+	var ids []uint64
+	for id, record := range r.records {
+		if !record.CreatedAt.Before(filter.Before) ||
+			record.Status == model.AuditStatusPending || record.Status == model.AuditStatusAppealing {
+			continue
+		}
+		if blacklisted, ok := r.blacklist[record.ContentID]; ok && blacklisted.ContentType == record.ContentType {
+			continue
+		}
+		if len(filter.ContentTypes) > 0 && !matchType(filter.ContentTypes, record.ContentType) {
+			continue
+		}
+		if matchType(filter.ExcludeContentTypes, record.ContentType) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (r *fakeAuditRepo) CountExpiredAuditRecords(ctx context.Context, filter *repository.ExpiredAuditRecordsFilter) (int64, error) {
+	if r.retentionErr != nil {
+		return 0, r.retentionErr
+	}
+	return int64(len(r.expiredRecordIDs(filter))), nil
+}
+
+func (r *fakeAuditRepo) DeleteExpiredAuditRecords(ctx context.Context, filter *repository.ExpiredAuditRecordsFilter, limit int) (int64, error) {
+	if r.retentionErr != nil {
+		return 0, r.retentionErr
+	}
+	ids := r.expiredRecordIDs(filter)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	for _, id := range ids {
+		delete(r.records, id)
+	}
+	r.deleteBatches = append(r.deleteBatches, int64(len(ids)))
+	return int64(len(ids)), nil
+}
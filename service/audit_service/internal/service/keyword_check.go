@@ -0,0 +1,35 @@
+package service
+
+import "audit_service/internal/model"
+
+// checkKeywords 检查内容标题和正文是否命中配置的关键词，返回命中关键词的原始写法
+// 标题和正文分别匹配，避免两者拼接处误命中
+func (s *auditService) checkKeywords(record *model.AuditRecord) []string {
+	var keywords []string
+	seen := make(map[string]bool)
+	for _, text := range []string{record.ContentTitle, record.Content} {
+		for _, m := range s.keywords.Match(text) {
+			if seen[m.Keyword] {
+				continue
+			}
+			seen[m.Keyword] = true
+			keywords = append(keywords, m.Keyword)
+			s.logger.Info("Content matched keyword", "content_id", record.ContentID, "language", m.Language, "keyword", m.Keyword)
+		}
+	}
+	return keywords
+}
+
+// mergeKeywords 合并AI返回的关键词和关键词检查命中的关键词，去掉重复项
+func mergeKeywords(aiKeywords, matched []string) []string {
+	seen := make(map[string]bool, len(aiKeywords))
+	merged := make([]string, 0, len(aiKeywords)+len(matched))
+	for _, k := range append(append([]string{}, aiKeywords...), matched...) {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		merged = append(merged, k)
+	}
+	return merged
+}
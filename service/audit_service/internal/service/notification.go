@@ -0,0 +1,25 @@
+package service
+
+import (
+	"audit_service/internal/model"
+	"audit_service/pkg/notify"
+)
+
+// notifyAuditRejected 通知上传者内容审核未通过，包括AI自动拦截和人工拒绝
+func (s *auditService) notifyAuditRejected(record *model.AuditRecord) {
+	if s.notifier == nil {
+		return
+	}
+
+	content := "你提交的内容未通过审核"
+	if record.Reason != "" {
+		content += "，原因：" + record.Reason
+	}
+	s.notifier.Notify(notify.Notification{
+		Type:     notify.TypeAuditRejected,
+		UserID:   record.UploaderID,
+		TargetID: record.ContentID,
+		Title:    "内容审核未通过",
+		Content:  content,
+	})
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// RepeatOffenderEscalation 重复违规上传者的加严说明，写入审核决策说明
+type RepeatOffenderEscalation struct {
+	Decided        int64   `json:"decided"`         // 统计窗口内已审结的内容数
+	RejectionRate  float64 `json:"rejection_rate"`  // 统计窗口内的拒绝率
+	ScoreBoost     float64 `json:"score_boost"`     // AI评分加成
+	EffectiveScore float64 `json:"effective_score"` // 加成后与阈值比较的评分
+	ForceManual    bool    `json:"force_manual"`    // 是否不再自动通过
+}
+
+// checkRepeatOffender 按上传者近期的拒绝率判断是否为重复违规者，是则返回加严说明
+// 未启用、审核历史不足或查询失败时返回nil，查询失败不影响提交审核
+func (s *auditService) checkRepeatOffender(ctx context.Context, uploaderID uint64, score float64) *RepeatOffenderEscalation {
+	cfg := s.config.Audit.RepeatOffender
+	if !cfg.Enabled {
+		return nil
+	}
+
+	history, err := s.repository.GetUploaderAuditHistory(ctx, uploaderID, time.Now().Add(-cfg.Window))
+	if err != nil {
+		s.logger.Error("Failed to get uploader audit history", "error", err, "uploader_id", uploaderID)
+		return nil
+	}
+	if history.Decided < cfg.MinSubmissions || history.RejectionRate() < cfg.RejectionRate {
+		return nil
+	}
+
+	escalation := &RepeatOffenderEscalation{
+		Decided:        history.Decided,
+		RejectionRate:  history.RejectionRate(),
+		ScoreBoost:     cfg.ScoreBoost,
+		EffectiveScore: math.Min(1, score+cfg.ScoreBoost),
+		ForceManual:    cfg.ForceManual,
+	}
+	s.logger.Info("Escalating audit for repeat offender",
+		"uploader_id", uploaderID,
+		"decided", escalation.Decided,
+		"rejection_rate", escalation.RejectionRate,
+		"effective_score", escalation.EffectiveScore)
+	return escalation
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"audit_service/internal/config"
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+)
+
+// historyRepo 返回预设的上传者审核历史，并记录查询的上传者和起始时间
+type historyRepo struct {
+	*fakeAuditRepo
+	history *repository.UploaderAuditHistory
+	err     error
+
+	calls      int
+	uploaderID uint64
+	since      time.Time
+}
+
+func (r *historyRepo) GetUploaderAuditHistory(ctx context.Context, uploaderID uint64, since time.Time) (*repository.UploaderAuditHistory, error) {
+	r.calls++
+	r.uploaderID, r.since = uploaderID, since
+	return r.history, r.err
+}
+
+var repeatOffenderTestConfig = config.RepeatOffenderConfig{
+	Enabled:        true,
+	Window:         7 * 24 * time.Hour,
+	MinSubmissions: 5,
+	RejectionRate:  0.5,
+	ScoreBoost:     0.4,
+}
+
+// newRepeatOffenderTestService AI评分为score，上传者审核历史为history
+func newRepeatOffenderTestService(score float64, history *repository.UploaderAuditHistory, cfg config.RepeatOffenderConfig) (*auditService, *historyRepo) {
+	fake := newFakeAuditRepo()
+	repo := &historyRepo{fakeAuditRepo: fake, history: history}
+	s := newTestAuditService(fake)
+	s.repository = repo
+	s.reviewer = scoreReviewer{score: score}
+	s.config.Audit.Strategies.Content.AutoBlockThreshold = 0.8
+	s.config.Audit.Strategies.Content.AutoPassThreshold = 0.3
+	s.config.Audit.RepeatOffender = cfg
+	return s, repo
+}
+
+// submitDetails 提交内容并返回审核状态和保存的决策说明
+func submitDetails(t *testing.T, s *auditService, repo *historyRepo) (model.AuditStatus, AuditDecisionDetails) {
+	t.Helper()
+	resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: "42"})
+	if err != nil {
+		t.Fatalf("SubmitContent: %v", err)
+	}
+	var details AuditDecisionDetails
+	if err := json.Unmarshal([]byte(repo.records[resp.AuditID].Details), &details); err != nil {
+		t.Fatalf("unmarshal details: %v", err)
+	}
+	return model.AuditStatus(resp.Status), details
+}
+
+func TestSubmitContentEscalatesRepeatOffender(t *testing.T) {
+	tests := []struct {
+		name        string
+		score       float64
+		history     repository.UploaderAuditHistory
+		forceManual bool
+		want        model.AuditStatus
+		wantBoost   bool
+	}{
+		// 加成后0.5+0.4达到自动拦截阈值
+		{"boosted to block", 0.5, repository.UploaderAuditHistory{Decided: 10, Rejected: 6}, false, model.AuditStatusAutoBlocked, true},
+		{"boosted to pending", 0.2, repository.UploaderAuditHistory{Decided: 10, Rejected: 5}, false, model.AuditStatusPending, true},
+		{"low score boosted above pass", 0.0, repository.UploaderAuditHistory{Decided: 10, Rejected: 8}, false, model.AuditStatusPending, true},
+		{"force manual", 0.0, repository.UploaderAuditHistory{Decided: 5, Rejected: 5}, true, model.AuditStatusPending, true},
+		{"too few submissions", 0.5, repository.UploaderAuditHistory{Decided: 4, Rejected: 4}, false, model.AuditStatusPending, false},
+		{"low rejection rate", 0.5, repository.UploaderAuditHistory{Decided: 10, Rejected: 4}, false, model.AuditStatusPending, false},
+		{"clean uploader passes", 0.2, repository.UploaderAuditHistory{Decided: 10}, false, model.AuditStatusAutoPassed, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := repeatOffenderTestConfig
+			cfg.ForceManual = tt.forceManual
+			history := tt.history
+			s, repo := newRepeatOffenderTestService(tt.score, &history, cfg)
+
+			status, details := submitDetails(t, s, repo)
+			if status != tt.want {
+				t.Errorf("status = %s, want %s", status, tt.want)
+			}
+			if got := details.RepeatOffender != nil; got != tt.wantBoost {
+				t.Fatalf("repeat_offender = %+v, want escalated %v", details.RepeatOffender, tt.wantBoost)
+			}
+			if tt.wantBoost {
+				escalation := details.RepeatOffender
+				if escalation.Decided != tt.history.Decided || escalation.RejectionRate != tt.history.RejectionRate() || escalation.ForceManual != tt.forceManual {
+					t.Errorf("escalation = %+v, want the uploader history %+v", escalation, tt.history)
+				}
+				// 决策说明中的score保留AI原始评分
+				if details.Score != tt.score {
+					t.Errorf("details score = %v, want the AI score %v", details.Score, tt.score)
+				}
+			}
+			if repo.uploaderID != 42 || repo.since.After(time.Now().Add(-cfg.Window+time.Minute)) {
+				t.Errorf("history queried for uploader %d since %v, want uploader 42 over the %v window", repo.uploaderID, repo.since, cfg.Window)
+			}
+		})
+	}
+}
+
+func TestRepeatOffenderEffectiveScoreCapped(t *testing.T) {
+	s, repo := newRepeatOffenderTestService(0.9, &repository.UploaderAuditHistory{Decided: 10, Rejected: 10}, repeatOffenderTestConfig)
+
+	status, details := submitDetails(t, s, repo)
+	if status != model.AuditStatusAutoBlocked || details.RepeatOffender.EffectiveScore != 1 {
+		t.Errorf("status = %s escalation = %+v, want auto blocked with effective score capped at 1", status, details.RepeatOffender)
+	}
+}
+
+func TestRepeatOffenderDisabledOrUnavailable(t *testing.T) {
+	offender := &repository.UploaderAuditHistory{Decided: 10, Rejected: 10}
+
+	s, repo := newRepeatOffenderTestService(0.5, offender, config.RepeatOffenderConfig{})
+	if status, details := submitDetails(t, s, repo); status != model.AuditStatusPending || details.RepeatOffender != nil || repo.calls != 0 {
+		t.Errorf("disabled: status = %s escalation = %+v history queries = %d, want no escalation or query", status, details.RepeatOffender, repo.calls)
+	}
+
+	// 查询审核历史失败不影响提交审核
+	s, repo = newRepeatOffenderTestService(0.5, nil, repeatOffenderTestConfig)
+	repo.err = errors.New("db down")
+	if status, details := submitDetails(t, s, repo); status != model.AuditStatusPending || details.RepeatOffender != nil {
+		t.Errorf("history error: status = %s escalation = %+v, want the normal decision", status, details.RepeatOffender)
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+	"context"
+	"fmt"
+	"time"
+)
+
+const defaultReportEscalationThreshold = 5
+
+// ErrDuplicateReport 同一举报人重复举报同一内容
+var ErrDuplicateReport = repository.ErrDuplicateReport
+
+// ReportContent 记录用户举报，不同举报人数达到阈值后将内容升级为高级别并加入人工审核队列
+func (s *auditService) ReportContent(ctx context.Context, req *ReportContentRequest) (*ReportContentResponse, error) {
+	s.logger.Info("Reporting content", "content_id", req.ContentID, "content_type", req.ContentType, "reporter_id", req.ReporterID)
+
+	contentType := model.ContentType(req.ContentType)
+	report := &model.AuditReport{
+		ContentID:   req.ContentID,
+		ContentType: contentType,
+		ReporterID:  req.ReporterID,
+		Reason:      req.Reason,
+	}
+	if err := s.repository.CreateAuditReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repository.CountContentReporters(ctx, req.ContentID, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ReportContentResponse{
+		ReportID:    report.ID,
+		ReportCount: count,
+	}
+
+	threshold := s.config.Audit.Report.EscalationThreshold
+	if threshold <= 0 {
+		threshold = defaultReportEscalationThreshold
+	}
+	if count < int64(threshold) {
+		return resp, nil
+	}
+
+	auditID, escalated, err := s.escalateReportedContent(ctx, req.ContentID, contentType, count)
+	if err != nil {
+		return nil, err
+	}
+	resp.AuditID = auditID
+	resp.Escalated = escalated
+	return resp, nil
+}
+
+// escalateReportedContent 将被举报内容提升为高级别人工审核
+// 内容已在队列中且为高级别或正在申诉时不重复处理，没有审核记录时(如直播)新建一条
+func (s *auditService) escalateReportedContent(ctx context.Context, contentID string, contentType model.ContentType, reportCount int64) (uint64, bool, error) {
+	records, err := s.repository.GetAuditRecordsByContentIDs(ctx, []string{contentID})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get audit record: %w", err)
+	}
+
+	var record *model.AuditRecord
+	for _, r := range records {
+		if r.ContentType == contentType {
+			record = r
+			break
+		}
+	}
+
+	reason := fmt.Sprintf("用户举报人数达到%d人，升级人工审核", reportCount)
+
+	if record == nil {
+		record = &model.AuditRecord{
+			ContentID:   contentID,
+			ContentType: contentType,
+			Status:      model.AuditStatusPending,
+			Level:       model.AuditLevelHigh,
+			Reason:      reason,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		auditID, err := s.repository.CreateAuditRecord(ctx, record)
+		if err != nil {
+			return 0, false, err
+		}
+		if err := s.repository.AddToManualReviewQueue(ctx, auditID); err != nil {
+			return 0, false, err
+		}
+		s.logger.Info("Reported content escalated", "content_id", contentID, "audit_id", auditID, "report_count", reportCount)
+		return auditID, true, nil
+	}
+
+	if record.Status == model.AuditStatusPending && record.Level == model.AuditLevelHigh {
+		return record.ID, false, nil
+	}
+	// 申诉中的内容已在申诉队列中由审核员优先处理，不改回待审核
+	if record.Status == model.AuditStatusAppealing {
+		return record.ID, false, nil
+	}
+
+	record.Level = model.AuditLevelHigh
+	record.Reason = reason
+	if err := s.repository.UpdateAuditRecord(ctx, record); err != nil {
+		return 0, false, err
+	}
+	if err := s.repository.AddToManualReviewQueue(ctx, record.ID); err != nil {
+		return 0, false, err
+	}
+
+	s.logger.Info("Reported content escalated", "content_id", contentID, "audit_id", record.ID, "report_count", reportCount)
+	return record.ID, true, nil
+}
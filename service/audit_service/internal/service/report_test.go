@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+// reportContent 以不同举报人举报同一内容n次，返回最后一次的结果
+func reportContent(t *testing.T, s *auditService, contentID string, contentType model.ContentType, n int) *ReportContentResponse {
+	t.Helper()
+	var resp *ReportContentResponse
+	for i := 1; i <= n; i++ {
+		var err error
+		resp, err = s.ReportContent(context.Background(), &ReportContentRequest{
+			ContentID: contentID, ContentType: string(contentType), ReporterID: uint64(i), Reason: "违规",
+		})
+		if err != nil {
+			t.Fatalf("ReportContent reporter %d: %v", i, err)
+		}
+	}
+	return resp
+}
+
+func TestReportContentBelowThresholdDoesNotEscalate(t *testing.T) {
+	repo := newFakeAuditRepo(newRejectedRecord())
+	s := newTestAuditService(repo)
+	s.config.Audit.Report.EscalationThreshold = 3
+
+	resp := reportContent(t, s, "video-1", model.ContentTypeVideo, 2)
+	if resp.ReportCount != 2 || resp.Escalated || resp.AuditID != 0 {
+		t.Fatalf("response = %+v, want 2 reports without escalation", resp)
+	}
+	if len(repo.queued) != 0 || len(repo.updated) != 0 {
+		t.Errorf("queued %v updated %v, want untouched below threshold", repo.queued, repo.updated)
+	}
+}
+
+func TestReportContentDuplicateReporterIsNotCounted(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+	s.config.Audit.Report.EscalationThreshold = 2
+	req := &ReportContentRequest{ContentID: "live-1", ContentType: string(model.ContentTypeLive), ReporterID: 7}
+
+	if _, err := s.ReportContent(context.Background(), req); err != nil {
+		t.Fatalf("first report: %v", err)
+	}
+	if _, err := s.ReportContent(context.Background(), req); !errors.Is(err, ErrDuplicateReport) {
+		t.Fatalf("duplicate report error = %v, want ErrDuplicateReport", err)
+	}
+	if count, _ := repo.CountContentReporters(context.Background(), "live-1", model.ContentTypeLive); count != 1 {
+		t.Errorf("reporters = %d, want 1", count)
+	}
+	if len(repo.queued) != 0 {
+		t.Errorf("queued = %v, duplicate reports must not reach the threshold", repo.queued)
+	}
+}
+
+func TestReportContentEscalatesExistingRecord(t *testing.T) {
+	record := newRejectedRecord()
+	record.Status = model.AuditStatusApproved
+	repo := newFakeAuditRepo(record)
+	s := newTestAuditService(repo)
+	s.config.Audit.Report.EscalationThreshold = 3
+
+	resp := reportContent(t, s, "video-1", model.ContentTypeVideo, 3)
+	if !resp.Escalated || resp.AuditID != 1 || resp.ReportCount != 3 {
+		t.Fatalf("response = %+v, want escalation of audit 1", resp)
+	}
+	if got := repo.records[1]; got.Level != model.AuditLevelHigh || got.Reason == "" {
+		t.Errorf("record = %s/%q, want high level with report reason", got.Level, got.Reason)
+	}
+	if len(repo.queued) != 1 || repo.queued[0] != 1 {
+		t.Errorf("queued = %v, want [1]", repo.queued)
+	}
+}
+
+func TestReportContentCreatesRecordForUnauditedContent(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+	s.config.Audit.Report.EscalationThreshold = 2
+
+	resp := reportContent(t, s, "live-1", model.ContentTypeLive, 2)
+	if !resp.Escalated || resp.AuditID == 0 {
+		t.Fatalf("response = %+v, want a new escalated audit record", resp)
+	}
+	record := repo.records[resp.AuditID]
+	if record.ContentID != "live-1" || record.ContentType != model.ContentTypeLive || record.Status != model.AuditStatusPending || record.Level != model.AuditLevelHigh {
+		t.Errorf("record = %+v, want pending high-level live record", record)
+	}
+	if len(repo.queued) != 1 || repo.queued[0] != resp.AuditID {
+		t.Errorf("queued = %v, want [%d]", repo.queued, resp.AuditID)
+	}
+}
+
+func TestReportContentDoesNotRequeueEscalatedContent(t *testing.T) {
+	tests := []struct {
+		name   string
+		status model.AuditStatus
+		level  model.AuditLevel
+	}{
+		{"already queued as high", model.AuditStatusPending, model.AuditLevelHigh},
+		{"appealing", model.AuditStatusAppealing, model.AuditLevelMedium},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := newRejectedRecord()
+			record.Status, record.Level = tt.status, tt.level
+			repo := newFakeAuditRepo(record)
+			s := newTestAuditService(repo)
+			s.config.Audit.Report.EscalationThreshold = 1
+
+			resp := reportContent(t, s, "video-1", model.ContentTypeVideo, 2)
+			if resp.Escalated || resp.AuditID != 1 {
+				t.Errorf("response = %+v, want audit 1 without escalation", resp)
+			}
+			if len(repo.queued) != 0 || len(repo.updated) != 0 {
+				t.Errorf("queued %v updated %v, want untouched", repo.queued, repo.updated)
+			}
+			if got := repo.records[1]; got.Status != tt.status || got.Level != tt.level {
+				t.Errorf("record = %s/%s, want unchanged", got.Status, got.Level)
+			}
+		})
+	}
+}
+
+func TestReportContentDefaultThreshold(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+
+	if resp := reportContent(t, s, "video-2", model.ContentTypeVideo, defaultReportEscalationThreshold-1); resp.Escalated {
+		t.Fatalf("escalated below default threshold: %+v", resp)
+	}
+	resp, err := s.ReportContent(context.Background(), &ReportContentRequest{
+		ContentID: "video-2", ContentType: string(model.ContentTypeVideo), ReporterID: 100,
+	})
+	if err != nil || !resp.Escalated {
+		t.Fatalf("report at default threshold = (%+v, %v), want escalation", resp, err)
+	}
+}
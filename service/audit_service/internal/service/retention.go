@@ -0,0 +1,122 @@
+package service
+
+import (
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetentionInterval  = time.Hour
+	defaultRetentionBatchSize = 500
+)
+
+// retentionPolicy 单条保留策略，对应一个单独配置的内容类型或默认策略
+type retentionPolicy struct {
+	name   string // 日志中展示的策略名称
+	filter *repository.ExpiredAuditRecordsFilter
+}
+
+// retentionPolicies 根据配置生成本轮清理的保留策略，保留时长<=0的策略不清理
+func (s *auditService) retentionPolicies(now time.Time) []retentionPolicy {
+	cfg := s.config.Audit.Retention
+
+	contentTypes := make([]string, 0, len(cfg.ContentTypes))
+	for contentType := range cfg.ContentTypes {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+
+	var policies []retentionPolicy
+	configured := make([]model.ContentType, 0, len(contentTypes))
+	for _, contentType := range contentTypes {
+		ct := model.ContentType(strings.ToLower(contentType))
+		configured = append(configured, ct)
+		if retention := cfg.ContentTypes[contentType]; retention > 0 {
+			policies = append(policies, retentionPolicy{
+				name: string(ct),
+				filter: &repository.ExpiredAuditRecordsFilter{
+					ContentTypes: []model.ContentType{ct},
+					Before:       now.Add(-retention),
+				},
+			})
+		}
+	}
+	if cfg.Default > 0 {
+		policies = append(policies, retentionPolicy{
+			name: "default",
+			filter: &repository.ExpiredAuditRecordsFilter{
+				ExcludeContentTypes: configured,
+				Before:              now.Add(-cfg.Default),
+			},
+		})
+	}
+	return policies
+}
+
+// PurgeExpiredAuditRecords 按保留策略清理过期的审核记录，返回清理的记录数
+// dry_run模式下只统计并记录符合条件的记录数，返回值为将被清理的记录数
+func (s *auditService) PurgeExpiredAuditRecords(ctx context.Context) (int64, error) {
+	cfg := s.config.Audit.Retention
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRetentionBatchSize
+	}
+
+	var total int64
+	for _, policy := range s.retentionPolicies(time.Now()) {
+		if cfg.DryRun {
+			count, err := s.repository.CountExpiredAuditRecords(ctx, policy.filter)
+			if err != nil {
+				return total, err
+			}
+			s.logger.Info("Audit retention dry run", "policy", policy.name, "before", policy.filter.Before, "eligible", count)
+			total += count
+			continue
+		}
+
+		var purged int64
+		for {
+			deleted, err := s.repository.DeleteExpiredAuditRecords(ctx, policy.filter, batchSize)
+			if err != nil {
+				return total + purged, err
+			}
+			purged += deleted
+			if deleted < int64(batchSize) || ctx.Err() != nil {
+				break
+			}
+		}
+		if purged > 0 {
+			s.logger.Info("Expired audit records purged", "policy", policy.name, "before", policy.filter.Before, "purged", purged)
+		}
+		total += purged
+	}
+	return total, nil
+}
+
+// RunRetentionSweeper 后台定时清理过期审核记录，直到ctx取消
+func (s *auditService) RunRetentionSweeper(ctx context.Context) {
+	interval := s.config.Audit.Retention.Interval
+	if interval <= 0 {
+		interval = defaultRetentionInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.logger.Info("Audit retention sweeper started", "interval", interval, "dry_run", s.config.Audit.Retention.DryRun)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Audit retention sweeper stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.PurgeExpiredAuditRecords(ctx); err != nil {
+				s.logger.Error("Failed to purge expired audit records", "error", err)
+			}
+		}
+	}
+}
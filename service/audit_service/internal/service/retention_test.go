@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"audit_service/internal/config"
+	"audit_service/internal/model"
+)
+
+const day = 24 * time.Hour
+
+// agedRecord 创建时间在age之前的审核记录
+func agedRecord(id uint64, contentType model.ContentType, status model.AuditStatus, age time.Duration) *model.AuditRecord {
+	record := &model.AuditRecord{ID: id, ContentID: fmt.Sprintf("content-%d", id), ContentType: contentType, Status: status}
+	record.CreatedAt = time.Now().Add(-age)
+	return record
+}
+
+// remainingIDs 清理后剩余的审核记录ID
+func remainingIDs(repo *fakeAuditRepo) []uint64 {
+	ids := make([]uint64, 0, len(repo.records))
+	for id := range repo.records {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// newRetentionTestService 视频保留7天，文本永久保留，其他类型默认保留30天
+func newRetentionTestService(repo *fakeAuditRepo, dryRun bool) *auditService {
+	s := newTestAuditService(repo)
+	s.config.Audit.Retention = config.RetentionConfig{
+		DryRun:  dryRun,
+		Default: 30 * day,
+		// 配置中的内容类型不区分大小写
+		ContentTypes: map[string]time.Duration{"Video": 7 * day, "text": 0},
+	}
+	return s
+}
+
+func newRetentionTestRepo() *fakeAuditRepo {
+	repo := newFakeAuditRepo(
+		agedRecord(1, model.ContentTypeVideo, model.AuditStatusApproved, 10*day),
+		agedRecord(2, model.ContentTypeVideo, model.AuditStatusApproved, 3*day),
+		agedRecord(3, model.ContentTypeImage, model.AuditStatusRejected, 40*day),
+		agedRecord(4, model.ContentTypeImage, model.AuditStatusApproved, 10*day),
+		agedRecord(5, model.ContentTypeText, model.AuditStatusApproved, 400*day),
+		agedRecord(6, model.ContentTypeVideo, model.AuditStatusPending, 10*day),
+		agedRecord(7, model.ContentTypeVideo, model.AuditStatusAppealing, 10*day),
+		agedRecord(8, model.ContentTypeImage, model.AuditStatusAutoBlocked, 40*day),
+	)
+	repo.blacklist[repo.records[8].ContentID] = &model.AuditBlacklist{ContentID: repo.records[8].ContentID, ContentType: model.ContentTypeImage}
+	return repo
+}
+
+func TestPurgeExpiredAuditRecordsByContentType(t *testing.T) {
+	repo := newRetentionTestRepo()
+	s := newRetentionTestService(repo, false)
+
+	purged, err := s.PurgeExpiredAuditRecords(context.Background())
+	if err != nil {
+		t.Fatalf("PurgeExpiredAuditRecords: %v", err)
+	}
+	if purged != 2 {
+		t.Errorf("purged = %d, want 2", purged)
+	}
+	// 过期的视频和超过默认保留时长的图片被清理；处理中、黑名单和永久保留的记录保留
+	if got, want := remainingIDs(repo), []uint64{2, 4, 5, 6, 7, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("remaining records = %v, want %v", got, want)
+	}
+}
+
+func TestPurgeExpiredAuditRecordsDryRun(t *testing.T) {
+	repo := newRetentionTestRepo()
+	s := newRetentionTestService(repo, true)
+
+	eligible, err := s.PurgeExpiredAuditRecords(context.Background())
+	if err != nil {
+		t.Fatalf("PurgeExpiredAuditRecords: %v", err)
+	}
+	if eligible != 2 {
+		t.Errorf("eligible = %d, want 2", eligible)
+	}
+	if len(repo.records) != 8 || len(repo.deleteBatches) != 0 {
+		t.Errorf("dry run deleted records: %d remaining, batches %v", len(repo.records), repo.deleteBatches)
+	}
+}
+
+func TestPurgeExpiredAuditRecordsInBatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		records     int
+		wantBatches []int64
+	}{
+		{"partial last batch", 5, []int64{2, 2, 1}},
+		{"exact multiple", 4, []int64{2, 2, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeAuditRepo()
+			for id := 1; id <= tt.records; id++ {
+				repo.records[uint64(id)] = agedRecord(uint64(id), model.ContentTypeImage, model.AuditStatusApproved, 2*day)
+			}
+			s := newTestAuditService(repo)
+			s.config.Audit.Retention = config.RetentionConfig{Default: day, BatchSize: 2}
+
+			purged, err := s.PurgeExpiredAuditRecords(context.Background())
+			if err != nil {
+				t.Fatalf("PurgeExpiredAuditRecords: %v", err)
+			}
+			if purged != int64(tt.records) || len(repo.records) != 0 {
+				t.Errorf("purged = %d with %d remaining, want all %d", purged, len(repo.records), tt.records)
+			}
+			if !reflect.DeepEqual(repo.deleteBatches, tt.wantBatches) {
+				t.Errorf("batches = %v, want %v", repo.deleteBatches, tt.wantBatches)
+			}
+		})
+	}
+}
+
+func TestPurgeExpiredAuditRecordsWithoutRetention(t *testing.T) {
+	repo := newRetentionTestRepo()
+	// 未配置保留时长时不访问仓库
+	repo.retentionErr = errors.New("unexpected retention query")
+	s := newTestAuditService(repo)
+	s.config.Audit.Retention = config.RetentionConfig{ContentTypes: map[string]time.Duration{"video": 0}}
+
+	purged, err := s.PurgeExpiredAuditRecords(context.Background())
+	if err != nil || purged != 0 {
+		t.Errorf("PurgeExpiredAuditRecords = (%d, %v), want nothing purged", purged, err)
+	}
+}
+
+func TestPurgeExpiredAuditRecordsError(t *testing.T) {
+	for _, dryRun := range []bool{false, true} {
+		repo := newRetentionTestRepo()
+		repo.retentionErr = errors.New("db down")
+		s := newRetentionTestService(repo, dryRun)
+
+		if _, err := s.PurgeExpiredAuditRecords(context.Background()); !errors.Is(err, repo.retentionErr) {
+			t.Errorf("dry run %v error = %v, want the repository error", dryRun, err)
+		}
+	}
+}
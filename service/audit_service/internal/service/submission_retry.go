@@ -0,0 +1,244 @@
+package service
+
+import (
+	"audit_service/internal/model"
+	"audit_service/internal/repository"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSubmissionRetryInterval = time.Minute
+	defaultSubmissionMaxRetry      = 3
+	defaultSubmissionRetryBatch    = 100
+	maxSubmissionRetryBackoff      = time.Hour
+	// submissionRetryLease 领取重试记录后的租约，需覆盖一批记录的处理时间，进程中途退出时租约到期后记录可被重新领取
+	submissionRetryLease = 10 * time.Minute
+)
+
+var (
+	// ErrSubmissionRetryNotFound 重试记录不存在
+	ErrSubmissionRetryNotFound = repository.ErrSubmissionRetryNotFound
+	// ErrSubmissionAlreadySucceeded 重试记录已成功，无需再次重试
+	ErrSubmissionAlreadySucceeded = errors.New("submission already succeeded")
+	// ErrSubmissionRetryInProgress 重试记录正在被后台任务或其他人工重试处理
+	ErrSubmissionRetryInProgress = repository.ErrSubmissionRetryLocked
+	// ErrInvalidSubmission 提交内容本身无效，重试不会成功，不进入重试队列
+	ErrInvalidSubmission = errors.New("invalid submission")
+)
+
+// isTransientSubmitError 判断提交失败是否为可重试的临时错误
+// 内容无效的错误不重试；gRPC错误只重试服务不可用、超时、限流和并发冲突；其余为数据库等基础设施错误，按临时错误重试
+func isTransientSubmitError(err error) bool {
+	if errors.Is(err, ErrInvalidSubmission) {
+		return false
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// enqueueSubmissionRetry 将提交失败的内容写入重试队列
+func (s *auditService) enqueueSubmissionRetry(ctx context.Context, req *SubmitContentRequest, submitErr error) (*model.AuditSubmissionRetry, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal submission: %w", err)
+	}
+
+	retry := &model.AuditSubmissionRetry{
+		ContentID:   req.ContentID,
+		ContentType: model.ContentType(req.ContentType),
+		Payload:     string(payload),
+		Status:      model.SubmissionRetryPending,
+		Attempts:    1,
+		LastError:   submitErr.Error(),
+		NextRetryAt: time.Now().Add(s.submissionRetryBackoff(1)),
+	}
+	if err := s.repository.CreateSubmissionRetry(ctx, retry); err != nil {
+		return nil, err
+	}
+	return retry, nil
+}
+
+// ListFailedSubmissions 获取失败提交列表
+func (s *auditService) ListFailedSubmissions(ctx context.Context, req *ListFailedSubmissionsRequest) (*ListFailedSubmissionsResponse, error) {
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	result, err := s.repository.ListSubmissionRetries(ctx, &repository.ListSubmissionRetriesRequest{
+		Status:   req.Status,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed submissions: %w", err)
+	}
+
+	resp := &ListFailedSubmissionsResponse{
+		Total:    result.Total,
+		Page:     result.Page,
+		PageSize: result.PageSize,
+	}
+	for _, retry := range result.Retries {
+		resp.Submissions = append(resp.Submissions, toFailedSubmission(retry))
+	}
+	return resp, nil
+}
+
+// RetryFailedSubmission 人工触发重试，重试次数耗尽的记录同样可以重试
+func (s *auditService) RetryFailedSubmission(ctx context.Context, id uint64) (*FailedSubmission, error) {
+	retry, err := s.repository.GetSubmissionRetry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if retry.Status == model.SubmissionRetrySucceeded {
+		return nil, ErrSubmissionAlreadySucceeded
+	}
+
+	// 领取后再重放，避免与后台任务或重复点击同时提交同一内容
+	retry, err = s.repository.ClaimSubmissionRetry(ctx, id, time.Now(), submissionRetryLease)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.retrySubmission(ctx, retry); err != nil {
+		return nil, err
+	}
+	return toFailedSubmission(retry), nil
+}
+
+// ProcessSubmissionRetries 处理已到期的重试记录，返回本轮处理的数量
+func (s *auditService) ProcessSubmissionRetries(ctx context.Context) (int, error) {
+	batchSize := s.config.Audit.Queue.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSubmissionRetryBatch
+	}
+
+	// 多实例部署时每条记录只会被一个实例领取
+	retries, err := s.repository.ClaimDueSubmissionRetries(ctx, time.Now(), submissionRetryLease, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, retry := range retries {
+		if err := s.retrySubmission(ctx, retry); err != nil {
+			s.logger.Error("Failed to process submission retry", "error", err, "retry_id", retry.ID)
+		}
+	}
+	return len(retries), nil
+}
+
+// RunSubmissionRetryWorker 后台定时重试失败提交，直到ctx取消
+func (s *auditService) RunSubmissionRetryWorker(ctx context.Context) {
+	interval := s.config.Audit.Queue.RetryInterval
+	if interval <= 0 {
+		interval = defaultSubmissionRetryInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.logger.Info("Submission retry worker started", "interval", interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Submission retry worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.ProcessSubmissionRetries(ctx); err != nil {
+				s.logger.Error("Failed to process submission retries", "error", err)
+			}
+		}
+	}
+}
+
+// retrySubmission 重放一次已领取的提交并更新重试记录，更新时释放领取租约
+// 提交本身失败不作为错误返回，只记录到重试记录中；仅持久化失败时返回错误
+func (s *auditService) retrySubmission(ctx context.Context, retry *model.AuditSubmissionRetry) error {
+	retry.LockedUntil = nil
+
+	var req SubmitContentRequest
+	if err := json.Unmarshal([]byte(retry.Payload), &req); err != nil {
+		// 载荷无法解析，重试没有意义
+		retry.Status = model.SubmissionRetryExhausted
+		retry.LastError = fmt.Sprintf("invalid payload: %v", err)
+		return s.repository.UpdateSubmissionRetry(ctx, retry)
+	}
+
+	retry.Attempts++
+	result, err := s.SubmitContent(ctx, &req)
+	if err != nil {
+		s.logger.Warn("Submission retry failed", "error", err, "retry_id", retry.ID, "content_id", retry.ContentID, "attempts", retry.Attempts)
+		retry.LastError = err.Error()
+		// 非临时错误重试不会成功，直接转人工处理
+		if !isTransientSubmitError(err) || retry.Attempts >= s.maxSubmissionRetries() {
+			retry.Status = model.SubmissionRetryExhausted
+		} else {
+			retry.Status = model.SubmissionRetryPending
+			retry.NextRetryAt = time.Now().Add(s.submissionRetryBackoff(retry.Attempts))
+		}
+	} else {
+		s.logger.Info("Submission retry succeeded", "retry_id", retry.ID, "content_id", retry.ContentID, "audit_id", result.AuditID)
+		retry.Status = model.SubmissionRetrySucceeded
+		retry.AuditID = result.AuditID
+		retry.LastError = ""
+	}
+
+	return s.repository.UpdateSubmissionRetry(ctx, retry)
+}
+
+// submissionRetryBackoff 指数退避：RetryInterval * 2^(attempts-1)，上限1小时
+func (s *auditService) submissionRetryBackoff(attempts int) time.Duration {
+	interval := s.config.Audit.Queue.RetryInterval
+	if interval <= 0 {
+		interval = defaultSubmissionRetryInterval
+	}
+
+	backoff := interval
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxSubmissionRetryBackoff {
+			return maxSubmissionRetryBackoff
+		}
+	}
+	return backoff
+}
+
+func (s *auditService) maxSubmissionRetries() int {
+	if s.config.Audit.Queue.MaxRetryCount > 0 {
+		return s.config.Audit.Queue.MaxRetryCount
+	}
+	return defaultSubmissionMaxRetry
+}
+
+// toFailedSubmission 转换为service层的失败提交
+func toFailedSubmission(retry *model.AuditSubmissionRetry) *FailedSubmission {
+	return &FailedSubmission{
+		ID:          retry.ID,
+		ContentID:   retry.ContentID,
+		ContentType: string(retry.ContentType),
+		Status:      string(retry.Status),
+		Attempts:    retry.Attempts,
+		LastError:   retry.LastError,
+		NextRetryAt: retry.NextRetryAt,
+		AuditID:     retry.AuditID,
+		CreatedAt:   retry.CreatedAt,
+		UpdatedAt:   retry.UpdatedAt,
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"audit_service/internal/model"
+)
+
+// addRetry 写入一条已到期的待重试记录
+func addRetry(t *testing.T, repo *fakeAuditRepo, uploaderID string) *model.AuditSubmissionRetry {
+	t.Helper()
+	payload, err := json.Marshal(&SubmitContentRequest{ContentID: "video_1", ContentType: "video", UploaderID: uploaderID})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	retry := &model.AuditSubmissionRetry{
+		ContentID:   "video_1",
+		ContentType: model.ContentType("video"),
+		Payload:     string(payload),
+		Status:      model.SubmissionRetryPending,
+		Attempts:    1,
+		NextRetryAt: time.Now().Add(-time.Minute),
+	}
+	if err := repo.CreateSubmissionRetry(context.Background(), retry); err != nil {
+		t.Fatalf("create retry: %v", err)
+	}
+	return repo.retries[retry.ID]
+}
+
+func TestIsTransientSubmitError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{status.Error(codes.Unavailable, "audit ai down"), true},
+		{status.Error(codes.DeadlineExceeded, "timeout"), true},
+		{fmt.Errorf("ai review: %w", status.Error(codes.ResourceExhausted, "rate limited")), true},
+		{status.Error(codes.InvalidArgument, "bad content"), false},
+		{status.Error(codes.PermissionDenied, "denied"), false},
+		{fmt.Errorf("%w: invalid uploader id", ErrInvalidSubmission), false},
+		{errors.New("failed to create audit record: connection refused"), true},
+	}
+	for _, tc := range cases {
+		if got := isTransientSubmitError(tc.err); got != tc.want {
+			t.Errorf("isTransientSubmitError(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestProcessSubmissionRetriesSkipsClaimedRecords(t *testing.T) {
+	repo := newFakeAuditRepo()
+	repo.whitelistErr = status.Error(codes.Unavailable, "mysql unavailable")
+	free := addRetry(t, repo, "42")
+	claimed := addRetry(t, repo, "42")
+	// 另一个实例已领取且租约未过期
+	lockedUntil := time.Now().Add(time.Minute)
+	claimed.LockedUntil = &lockedUntil
+
+	svc := newTestAuditService(repo)
+	n, err := svc.ProcessSubmissionRetries(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("ProcessSubmissionRetries = %d, %v; want 1 record", n, err)
+	}
+
+	got := repo.retries[free.ID]
+	if got.Attempts != 2 || got.Status != model.SubmissionRetryPending || got.LockedUntil != nil {
+		t.Errorf("free retry = attempts %d status %s locked %v; want 2, pending, lease released", got.Attempts, got.Status, got.LockedUntil)
+	}
+	if other := repo.retries[claimed.ID]; other.Attempts != 1 {
+		t.Errorf("record claimed by another instance was retried again (attempts %d)", other.Attempts)
+	}
+
+	// 租约释放后下一轮不会重复领取未到期的记录
+	if n, _ := svc.ProcessSubmissionRetries(context.Background()); n != 0 {
+		t.Errorf("second round processed %d records, want 0 before next_retry_at", n)
+	}
+}
+
+func TestRetrySubmissionExhaustsOnPermanentError(t *testing.T) {
+	repo := newFakeAuditRepo()
+	invalid := addRetry(t, repo, "not-a-number")
+	svc := newTestAuditService(repo)
+
+	if _, err := svc.ProcessSubmissionRetries(context.Background()); err != nil {
+		t.Fatalf("ProcessSubmissionRetries: %v", err)
+	}
+	if got := repo.retries[invalid.ID]; got.Status != model.SubmissionRetryExhausted || got.Attempts != 2 {
+		t.Fatalf("invalid submission = status %s attempts %d, want exhausted without further retries", got.Status, got.Attempts)
+	}
+
+	repo.whitelistErr = status.Error(codes.InvalidArgument, "content type not supported")
+	rejected := addRetry(t, repo, "42")
+	if _, err := svc.ProcessSubmissionRetries(context.Background()); err != nil {
+		t.Fatalf("ProcessSubmissionRetries: %v", err)
+	}
+	if got := repo.retries[rejected.ID]; got.Status != model.SubmissionRetryExhausted {
+		t.Fatalf("InvalidArgument failure status = %s, want exhausted", got.Status)
+	}
+}
+
+func TestBatchSubmitQueuesOnlyTransientFailures(t *testing.T) {
+	repo := newFakeAuditRepo()
+	svc := newTestAuditService(repo)
+
+	resp, err := svc.BatchSubmitContent(context.Background(), &BatchSubmitContentRequest{
+		ContentIDs:  []string{"video_1"},
+		ContentType: "video",
+		UploaderID:  "not-a-number",
+	})
+	if err != nil {
+		t.Fatalf("BatchSubmitContent: %v", err)
+	}
+	if len(repo.retries) != 0 || resp.Results[0].Status != string(model.AuditStatusRejected) {
+		t.Fatalf("invalid content queued for retry: %d retries, status %s", len(repo.retries), resp.Results[0].Status)
+	}
+
+	repo.whitelistErr = status.Error(codes.Unavailable, "mysql unavailable")
+	if _, err := svc.BatchSubmitContent(context.Background(), &BatchSubmitContentRequest{
+		ContentIDs:  []string{"video_2"},
+		ContentType: "video",
+		UploaderID:  "42",
+	}); err != nil {
+		t.Fatalf("BatchSubmitContent: %v", err)
+	}
+	if len(repo.retries) != 1 {
+		t.Fatalf("transient failure queued %d retries, want 1", len(repo.retries))
+	}
+}
+
+func TestRetryFailedSubmissionRejectsClaimedRecord(t *testing.T) {
+	repo := newFakeAuditRepo()
+	repo.whitelistErr = status.Error(codes.Unavailable, "mysql unavailable")
+	retry := addRetry(t, repo, "42")
+	lockedUntil := time.Now().Add(time.Minute)
+	retry.LockedUntil = &lockedUntil
+
+	svc := newTestAuditService(repo)
+	if _, err := svc.RetryFailedSubmission(context.Background(), retry.ID); !errors.Is(err, ErrSubmissionRetryInProgress) {
+		t.Fatalf("RetryFailedSubmission error = %v, want ErrSubmissionRetryInProgress", err)
+	}
+	if repo.retries[retry.ID].Attempts != 1 {
+		t.Fatal("claimed record was replayed by manual retry")
+	}
+
+	retry.LockedUntil = nil
+	got, err := svc.RetryFailedSubmission(context.Background(), retry.ID)
+	if err != nil || got.Attempts != 2 {
+		t.Fatalf("RetryFailedSubmission = %+v, %v; want one more attempt", got, err)
+	}
+	if repo.retries[retry.ID].LockedUntil != nil {
+		t.Fatal("manual retry did not release its lease")
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"audit_service/internal/model"
 	"time"
 )
 
@@ -10,6 +11,7 @@ type SubmitContentRequest struct {
 	ContentType     string `json:"content_type" binding:"required"`
 	ContentTitle    string `json:"content_title"`
 	ContentURL      string `json:"content_url"`
+	Content         string `json:"content"`
 	ContentMetadata string `json:"content_metadata"`
 	UploaderID      string `json:"uploader_id" binding:"required"`
 	UploaderName    string `json:"uploader_name"`
@@ -32,7 +34,12 @@ type AuditResult struct {
 	Score       float64    `json:"score"`
 	Reason      string     `json:"reason"`
 	Details     string     `json:"details"`
+	Level       string     `json:"level"`
+	ReviewerID  uint64     `json:"reviewer_id"`
 	ReviewTime  *time.Time `json:"review_time"`
+	CreatedAt   time.Time  `json:"created_at"`
+	// Found 是否存在审核记录，批量查询时未找到的内容Found为false，其余字段为空
+	Found bool `json:"found"`
 }
 
 // UpdateAuditStatusRequest 更新审核状态请求
@@ -42,7 +49,7 @@ type UpdateAuditStatusRequest struct {
 	ReviewerID uint64 `json:"reviewer_id" binding:"required"`
 	Reason     string `json:"reason"`
 	Details    string `json:"details"`
-	Violations string `json:"violations"`
+	Violations string `json:"violations"` // 违规类型，JSON数组或逗号分隔
 }
 
 // UpdateAuditStatusResponse 更新审核状态响应
@@ -52,12 +59,23 @@ type UpdateAuditStatusResponse struct {
 }
 
 // BatchSubmitContentRequest 批量提交内容审核请求
+// ContentIDs 中的内容共用 Content/Metadata；Items 用于每项内容不同的批次，未填写的字段回退到共用值
 type BatchSubmitContentRequest struct {
-	ContentIDs  []string `json:"content_ids" binding:"required"`
-	ContentType string   `json:"content_type" binding:"required"`
-	Content     string   `json:"content"`
-	UploaderID  string   `json:"uploader_id" binding:"required"`
-	Metadata    string   `json:"metadata"`
+	ContentIDs  []string           `json:"content_ids"`
+	Items       []*BatchSubmitItem `json:"items"`
+	ContentType string             `json:"content_type" binding:"required"`
+	Content     string             `json:"content"`
+	UploaderID  string             `json:"uploader_id" binding:"required"`
+	Metadata    string             `json:"metadata"`
+}
+
+// BatchSubmitItem 批量提交中的单项内容
+type BatchSubmitItem struct {
+	ContentID    string `json:"content_id" binding:"required"`
+	ContentTitle string `json:"content_title"`
+	ContentURL   string `json:"content_url"`
+	Content      string `json:"content"`
+	Metadata     string `json:"metadata"`
 }
 
 // BatchSubmitContentResponse 批量提交内容审核响应
@@ -85,7 +103,7 @@ type CompleteManualReviewRequest struct {
 	ReviewerID uint64 `json:"reviewer_id" binding:"required"`
 	Reason     string `json:"reason"`
 	Details    string `json:"details"`
-	Violations string `json:"violations"`
+	Violations string `json:"violations"` // 违规类型，JSON数组或逗号分隔
 }
 
 // CompleteManualReviewResponse 完成人工审核响应
@@ -179,7 +197,7 @@ type AddToWhitelistRequest struct {
 	UploaderID  string `json:"uploader_id"`
 	Reason      string `json:"reason"`
 	IsPermanent bool   `json:"is permanent"`
-	ExpiryDate  string `json:"expiry_date"`
+	ExpiryDate  string `json:"expiry_date"` // RFC3339格式，兼容不带时区的"2006-01-02 15:04:05"(按UTC解析)
 	CreatedBy   uint64 `json:"created_by" binding:"required"`
 }
 
@@ -197,7 +215,7 @@ type AddToBlacklistRequest struct {
 	Reason      string `json:"reason"`
 	Violations  string `json:"violations"`
 	IsPermanent bool   `json:"is permanent"`
-	ExpiryDate  string `json:"expiry_date"`
+	ExpiryDate  string `json:"expiry_date"` // RFC3339格式，兼容不带时区的"2006-01-02 15:04:05"(按UTC解析)
 	CreatedBy   uint64 `json:"created_by" binding:"required"`
 }
 
@@ -249,20 +267,23 @@ type ListAuditRecordsRequest struct {
 	EndDate     string `json:"end_date"`
 	Page        int    `json:"page" binding:"min=1"`
 	PageSize    int    `json:"page_size" binding:"min=1,max=100"`
+	Cursor      string `json:"cursor"` // 上一页返回的游标，不为空时忽略Page
 }
 
 // ListAuditRecordsResponse 获取审核记录列表响应
 type ListAuditRecordsResponse struct {
-	Records  []*AuditRecord `json:"records"`
-	Total    int64          `json:"total"`
-	Page     int            `json:"page"`
-	PageSize int            `json:"page_size"`
+	Records    []*AuditRecord `json:"records"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	NextCursor string         `json:"next_cursor"` // 下一页游标，没有更多时为空
 }
 
 // GetManualReviewQueueRequest 获取人工审核队列请求
 type GetManualReviewQueueRequest struct {
 	ContentType string `json:"content_type"`
 	Level       string `json:"level"`
+	Priority    int    `json:"priority"`
 	ReviewerID  uint64 `json:"reviewer_id"`
 	Page        int    `json:"page" binding:"min=1"`
 	PageSize    int    `json:"page_size" binding:"min=1,max=100"`
@@ -321,8 +342,9 @@ type TypeCount struct {
 
 // ViolationTrend 违规趋势
 type ViolationTrend struct {
-	Date      string `json:"date"`
-	Violation int64  `json:"violation"`
+	Date       string           `json:"date"`
+	Violation  int64            `json:"violation"`
+	Categories map[string]int64 `json:"categories"` // 按违规类型统计
 }
 
 // AIReviewResult AI审核结果
@@ -330,4 +352,75 @@ type AIReviewResult struct {
 	Result     string  `json:"result"`
 	Confidence float64 `json:"confidence"`
 	Score      float64 `json:"score"`
+	// MatchedKeywords 命中的敏感关键词
+	MatchedKeywords []string `json:"matched_keywords"`
+	// CategoryScores 各违规类型的模型评分
+	CategoryScores map[model.ViolationCategory]float64 `json:"category_scores"`
+}
+
+// ListFailedSubmissionsRequest 获取失败提交列表请求
+type ListFailedSubmissionsRequest struct {
+	Status   string `json:"status"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+}
+
+// ListFailedSubmissionsResponse 获取失败提交列表响应
+type ListFailedSubmissionsResponse struct {
+	Submissions []*FailedSubmission `json:"submissions"`
+	Total       int64               `json:"total"`
+	Page        int                 `json:"page"`
+	PageSize    int                 `json:"page_size"`
+}
+
+// FailedSubmission 提交失败的审核内容
+type FailedSubmission struct {
+	ID          uint64    `json:"id"`
+	ContentID   string    `json:"content_id"`
+	ContentType string    `json:"content_type"`
+	Status      string    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"last_error"`
+	NextRetryAt time.Time `json:"next_retry_at"`
+	AuditID     uint64    `json:"audit_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ReportContentRequest 举报内容请求
+type ReportContentRequest struct {
+	ContentID   string `json:"content_id" binding:"required"`
+	ContentType string `json:"content_type" binding:"required"`
+	ReporterID  uint64 `json:"reporter_id" binding:"required"`
+	Reason      string `json:"reason"`
+}
+
+// ReportContentResponse 举报内容响应
+type ReportContentResponse struct {
+	ReportID    uint64 `json:"report_id"`
+	ReportCount int64  `json:"report_count"` // 举报该内容的不同用户数
+	Escalated   bool   `json:"escalated"`    // 本次举报是否触发升级人工审核
+	AuditID     uint64 `json:"audit_id"`     // 升级后对应的审核记录ID
+}
+
+// Appeal 申诉记录
+type Appeal struct {
+	ID          uint64     `json:"id"`
+	AuditID     uint64     `json:"audit_id"`
+	ContentID   string     `json:"content_id"`
+	ContentType string     `json:"content_type"`
+	UploaderID  uint64     `json:"uploader_id"`
+	Reason      string     `json:"reason"`
+	Status      string     `json:"status"`      // pending/upheld/overturned
+	ReviewerID  uint64     `json:"reviewer_id"` // 处理申诉的审核员，未处理时为0
+	ResolvedAt  *time.Time `json:"resolved_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListAppealsResponse 申诉列表响应
+type ListAppealsResponse struct {
+	Total    int64     `json:"total"`
+	Page     int       `json:"page"`
+	PageSize int       `json:"page_size"`
+	Appeals  []*Appeal `json:"appeals"`
 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"audit_service/pkg/ids"
+)
+
+func TestSubmitContentRejectsInvalidUploaderID(t *testing.T) {
+	for _, uploaderID := range []string{"", "abc", "12abc", "-1", "18446744073709551616"} {
+		repo := newFakeAuditRepo()
+		s := newTestAuditService(repo)
+
+		_, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: uploaderID})
+		if !errors.Is(err, ErrInvalidSubmission) {
+			t.Errorf("uploader %q: error = %v, want ErrInvalidSubmission", uploaderID, err)
+		}
+		// 非法ID不能以0写入审核记录
+		if len(repo.records) != 0 {
+			t.Errorf("uploader %q: created %d records", uploaderID, len(repo.records))
+		}
+	}
+}
+
+func TestSubmitContentKeepsLargeUploaderID(t *testing.T) {
+	repo := newFakeAuditRepo()
+	s := newTestAuditService(repo)
+
+	resp, err := s.SubmitContent(context.Background(), &SubmitContentRequest{ContentID: "c-1", ContentType: "text", UploaderID: "18446744073709551615"})
+	if err != nil {
+		t.Fatalf("SubmitContent: %v", err)
+	}
+	if record := repo.records[resp.AuditID]; record == nil || record.UploaderID != math.MaxUint64 {
+		t.Errorf("record = %+v, want the full 64-bit uploader id", record)
+	}
+}
+
+func TestOptionalUploaderIDRejectsInvalidInput(t *testing.T) {
+	s := newTestAuditService(newFakeAuditRepo())
+	ctx := context.Background()
+
+	if _, err := s.AddToWhitelist(ctx, &AddToWhitelistRequest{ContentID: "c-1", ContentType: "text", UploaderID: "12abc"}); !errors.Is(err, ids.ErrInvalidID) {
+		t.Errorf("AddToWhitelist error = %v, want ErrInvalidID", err)
+	}
+	if _, err := s.AddToBlacklist(ctx, &AddToBlacklistRequest{ContentID: "c-1", ContentType: "text", UploaderID: "-1"}); !errors.Is(err, ids.ErrInvalidID) {
+		t.Errorf("AddToBlacklist error = %v, want ErrInvalidID", err)
+	}
+	if _, err := s.ListAuditRecords(ctx, &ListAuditRecordsRequest{UploaderID: "18446744073709551616"}); !errors.Is(err, ids.ErrInvalidID) {
+		t.Errorf("ListAuditRecords error = %v, want ErrInvalidID", err)
+	}
+}
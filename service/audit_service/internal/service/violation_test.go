@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audit_service/internal/model"
+)
+
+func TestUpdateAuditStatusNormalizesViolations(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"spam", `["spam"]`},
+		{" Spam , fraud,spam ", `["spam","fraud"]`},
+		{`["nudity","hate_speech"]`, `["nudity","hate_speech"]`},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		repo := newFakeAuditRepo(newPendingRecord())
+		req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusApproved), ReviewerID: 3, Violations: tt.raw}
+		if _, err := newTestAuditService(repo).UpdateAuditStatus(context.Background(), req); err != nil {
+			t.Fatalf("UpdateAuditStatus(%q): %v", tt.raw, err)
+		}
+		if got := repo.records[1].Violations; got != tt.want {
+			t.Errorf("violations for %q stored as %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestUpdateAuditStatusRejectsUnknownViolation(t *testing.T) {
+	for _, raw := range []string{"spam,gambling", `["drugs"]`, `["spam"`} {
+		repo := newFakeAuditRepo(newPendingRecord())
+		req := &UpdateAuditStatusRequest{AuditID: 1, Status: string(model.AuditStatusRejected), ReviewerID: 3, Violations: raw}
+		if _, err := newTestAuditService(repo).UpdateAuditStatus(context.Background(), req); !errors.Is(err, model.ErrInvalidViolationCategory) {
+			t.Fatalf("UpdateAuditStatus(%q) error = %v, want ErrInvalidViolationCategory", raw, err)
+		}
+		// 校验失败时不写审核记录也不拉黑
+		if len(repo.updated) != 0 || len(repo.blacklist) != 0 {
+			t.Errorf("invalid violations %q updated %v and blacklisted %v", raw, repo.updated, repo.blacklist)
+		}
+		if got := repo.records[1].Status; got != model.AuditStatusPending {
+			t.Errorf("status = %s, want pending", got)
+		}
+	}
+}
+
+func TestCompleteManualReviewValidatesViolations(t *testing.T) {
+	repo := newFakeAuditRepo(newPendingRecord())
+	s := newTestAuditService(repo)
+
+	bad := &CompleteManualReviewRequest{AuditID: 1, Status: string(model.AuditStatusRejected), ReviewerID: 3, Violations: "unknown"}
+	if _, err := s.CompleteManualReview(context.Background(), bad); !errors.Is(err, model.ErrInvalidViolationCategory) {
+		t.Fatalf("CompleteManualReview error = %v, want ErrInvalidViolationCategory", err)
+	}
+	if len(repo.updated) != 0 {
+		t.Fatalf("invalid violations updated records %v", repo.updated)
+	}
+
+	good := &CompleteManualReviewRequest{AuditID: 1, Status: string(model.AuditStatusRejected), ReviewerID: 3, Violations: "violence,copyright"}
+	resp, err := s.CompleteManualReview(context.Background(), good)
+	if err != nil {
+		t.Fatalf("CompleteManualReview: %v", err)
+	}
+	if !resp.Success {
+		t.Errorf("CompleteManualReview success = false, want true")
+	}
+	if got := repo.records[1].Violations; got != `["violence","copyright"]` {
+		t.Errorf("violations = %q, want normalized JSON array", got)
+	}
+}
@@ -0,0 +1,275 @@
+package featureflags
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"audit_service/pkg/logger"
+)
+
+// DefaultRefreshInterval 覆盖值默认刷新间隔
+const DefaultRefreshInterval = 30 * time.Second
+
+// Source 开关覆盖值数据源，返回开关名到原始值的映射
+type Source interface {
+	Load(ctx context.Context) (map[string]string, error)
+}
+
+// Flags 特性开关
+// 取值优先级：数据源覆盖值 > 配置文件默认值 > 调用方传入的默认值。
+// 覆盖值在后台定时刷新，调用方每次求值只读内存快照，数据源故障时沿用上一次的快照。
+// 零值和nil均可安全使用，此时所有开关返回调用方传入的默认值。
+type Flags struct {
+	source   Source
+	logger   logger.Logger
+	interval time.Duration
+	defaults map[string]string
+
+	mu        sync.RWMutex
+	overrides map[string]string
+
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	started   bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// New 创建特性开关，source为nil时只使用配置默认值，interval<=0时使用默认刷新间隔
+func New(source Source, defaults map[string]string, interval time.Duration, log logger.Logger) *Flags {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	normalized := make(map[string]string, len(defaults))
+	for name, value := range defaults {
+		normalized[normalizeName(name)] = value
+	}
+	return &Flags{
+		source:   source,
+		logger:   log,
+		interval: interval,
+		defaults: normalized,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Refresh 立即从数据源加载覆盖值
+func (f *Flags) Refresh(ctx context.Context) error {
+	if f == nil || f.source == nil {
+		return nil
+	}
+	values, err := f.source.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load feature flags: %w", err)
+	}
+
+	overrides := make(map[string]string, len(values))
+	for name, value := range values {
+		overrides[normalizeName(name)] = value
+	}
+
+	f.mu.Lock()
+	f.overrides = overrides
+	f.mu.Unlock()
+	return nil
+}
+
+// Start 同步加载一次覆盖值后启动后台定时刷新
+func (f *Flags) Start() {
+	if f == nil || f.source == nil {
+		return
+	}
+	f.startOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), f.interval)
+		if err := f.Refresh(ctx); err != nil {
+			f.logger.Warn("Initial feature flags load failed, using defaults", "error", err)
+		}
+		cancel()
+
+		f.mu.Lock()
+		f.started = true
+		f.mu.Unlock()
+		go f.run()
+	})
+}
+
+func (f *Flags) run() {
+	defer close(f.doneCh)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), f.interval)
+			if err := f.Refresh(ctx); err != nil {
+				f.logger.Warn("Failed to refresh feature flags", "error", err)
+			}
+			cancel()
+		case <-f.stopCh:
+			return
+		}
+	}
+}
+
+// Stop 停止后台刷新并等待刷新协程退出
+func (f *Flags) Stop() {
+	if f == nil || f.source == nil {
+		return
+	}
+	f.stopOnce.Do(func() {
+		close(f.stopCh)
+	})
+
+	f.mu.RLock()
+	started := f.started
+	f.mu.RUnlock()
+	if started {
+		<-f.doneCh
+	}
+}
+
+// lookup 获取开关原始值
+func (f *Flags) lookup(name string) (string, bool) {
+	if f == nil {
+		return "", false
+	}
+	name = normalizeName(name)
+
+	f.mu.RLock()
+	value, ok := f.overrides[name]
+	f.mu.RUnlock()
+	if ok {
+		return value, true
+	}
+
+	value, ok = f.defaults[name]
+	return value, ok
+}
+
+// Bool 获取布尔开关，未配置或无法解析时返回def
+func (f *Flags) Bool(name string, def bool) bool {
+	value, ok := f.lookup(name)
+	if !ok {
+		return def
+	}
+	enabled, ok := parseBool(value)
+	if !ok {
+		return def
+	}
+	return enabled
+}
+
+// Int 获取整数开关，未配置或无法解析时返回def
+func (f *Flags) Int(name string, def int) int {
+	value, ok := f.lookup(name)
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// Float 获取浮点开关，未配置或无法解析时返回def
+func (f *Flags) Float(name string, def float64) float64 {
+	value, ok := f.lookup(name)
+	if !ok {
+		return def
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// String 获取字符串开关，未配置时返回def
+func (f *Flags) String(name string, def string) string {
+	value, ok := f.lookup(name)
+	if !ok {
+		return def
+	}
+	return value
+}
+
+// Duration 获取时长开关，值格式同time.ParseDuration，未配置或无法解析时返回def
+func (f *Flags) Duration(name string, def time.Duration) time.Duration {
+	value, ok := f.lookup(name)
+	if !ok {
+		return def
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// EnabledFor 按用户灰度判断开关是否开启
+// 值为true/false时对所有用户开启/关闭，值为"30%"或"30"时按用户ID哈希分桶，
+// 同一用户在比例不变时结果稳定，比例调大时已命中的用户保持命中。未配置或无法解析时返回false
+func (f *Flags) EnabledFor(name string, userID uint64) bool {
+	value, ok := f.lookup(name)
+	if !ok {
+		return false
+	}
+	if enabled, ok := parseBool(value); ok {
+		return enabled
+	}
+	percent, ok := parsePercentage(value)
+	if !ok {
+		return false
+	}
+	return Bucket(name, userID) < percent
+}
+
+// Bucket 用户在开关下的灰度分桶，取值[0,100)
+// 分桶键包含开关名，避免不同开关总是命中同一批用户
+func Bucket(name string, userID uint64) float64 {
+	h := fnv.New32a()
+	h.Write([]byte(normalizeName(name)))
+	h.Write([]byte{':'})
+	h.Write([]byte(strconv.FormatUint(userID, 10)))
+	return float64(h.Sum32()%10000) / 100
+}
+
+// normalizeName 统一开关名大小写，配置文件经viper读取后键名均为小写
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func parseBool(value string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "on", "yes", "enabled":
+		return true, true
+	case "false", "off", "no", "disabled", "":
+		return false, true
+	}
+	return false, false
+}
+
+// parsePercentage 解析灰度比例，超出[0,100]的值截断到边界
+func parsePercentage(value string) (float64, bool) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "%")
+	percent, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, false
+	}
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	return percent, true
+}
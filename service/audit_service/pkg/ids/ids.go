@@ -0,0 +1,50 @@
+// Package ids 统一处理ID与字符串之间的转换
+// 解析只接受十进制无符号整数，非法输入返回ErrInvalidID，不会像fmt.Sscanf那样静默得到0
+package ids
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrInvalidID ID格式不合法
+var ErrInvalidID = errors.New("invalid id")
+
+// ID 64位ID，审核ID、上传者ID、审核员ID等均使用该类型的取值范围
+type ID uint64
+
+// Parse 解析必填ID，空字符串、非数字、负数、超出uint64范围均返回ErrInvalidID
+func Parse(s string) (ID, error) {
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty", ErrInvalidID)
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidID, s)
+	}
+	return ID(v), nil
+}
+
+// ParseOptional 解析可选ID，空字符串视为未设置，返回0
+func ParseOptional(s string) (ID, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return Parse(s)
+}
+
+// Format 将64位ID格式化为十进制字符串
+func Format(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
+
+// Uint64 返回ID的数值
+func (id ID) Uint64() uint64 {
+	return uint64(id)
+}
+
+// String 返回ID的十进制字符串
+func (id ID) String() string {
+	return Format(uint64(id))
+}
@@ -0,0 +1,78 @@
+package ids
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ID
+	}{
+		{"0", 0},
+		{"42", 42},
+		// 超出uint32范围的ID不能被截断
+		{"4294967296", math.MaxUint32 + 1},
+		{"18446744073709551615", math.MaxUint64},
+		{"007", 7},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = (%d, %v), want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseRejectsInvalidIDs(t *testing.T) {
+	invalid := []string{
+		"",
+		// uint64溢出
+		"18446744073709551616",
+		"99999999999999999999999",
+		"-1",
+		"+1",
+		"abc",
+		// fmt.Sscanf会解析出前缀数字
+		"12abc",
+		" 12",
+		"12 ",
+		"1.5",
+		"1e3",
+		"0x1f",
+		"1_000",
+	}
+	for _, in := range invalid {
+		got, err := Parse(in)
+		if !errors.Is(err, ErrInvalidID) || got != 0 {
+			t.Errorf("Parse(%q) = (%d, %v), want ErrInvalidID", in, got, err)
+		}
+	}
+}
+
+func TestParseOptional(t *testing.T) {
+	if got, err := ParseOptional(""); err != nil || got != 0 {
+		t.Errorf("ParseOptional(\"\") = (%d, %v), want unset", got, err)
+	}
+	if got, err := ParseOptional("42"); err != nil || got != 42 {
+		t.Errorf("ParseOptional(\"42\") = (%d, %v), want 42", got, err)
+	}
+	// 非空时与Parse一致，非法输入不会变成未设置
+	for _, in := range []string{"18446744073709551616", "-1", "abc", "12abc"} {
+		if got, err := ParseOptional(in); !errors.Is(err, ErrInvalidID) || got != 0 {
+			t.Errorf("ParseOptional(%q) = (%d, %v), want ErrInvalidID", in, got, err)
+		}
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	for _, v := range []uint64{0, 42, math.MaxUint32 + 1, math.MaxUint64} {
+		s := Format(v)
+		got, err := Parse(s)
+		if err != nil || got.Uint64() != v || got.String() != s {
+			t.Errorf("Parse(Format(%d)) = (%d, %v), want round trip via %q", v, got, err, s)
+		}
+	}
+}
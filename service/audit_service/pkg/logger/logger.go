@@ -1,10 +1,11 @@
 package logger
 
 import (
+	"os"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
-	"os"
-	"path/filepath"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // Logger 日志接口
@@ -21,8 +22,21 @@ type Config struct {
 	Level      string
 	Format     string
 	OutputPath string
+
+	// 日志轮转配置，仅在输出到文件时生效，未设置时使用默认值
+	MaxSize    int  // 单个文件最大大小(MB)
+	MaxAge     int  // 旧文件保留天数
+	MaxBackups int  // 旧文件最多保留个数
+	Compress   bool // 是否压缩旧文件
 }
 
+// 日志轮转默认值
+const (
+	defaultMaxSize    = 100
+	defaultMaxAge     = 7
+	defaultMaxBackups = 10
+)
+
 // zapLogger zap日志实现
 type zapLogger struct {
 	sugar *zap.SugaredLogger
@@ -30,51 +44,63 @@ type zapLogger struct {
 
 // NewLogger 创建新的日志器
 func NewLogger(cfg Config) (Logger, error) {
-	// 确保日志目录存在
-	if cfg.OutputPath != "" {
-		dir := filepath.Dir(cfg.OutputPath)
-		if err := os.MkdirAll(dir, 0755); err != nil {
-			return nil, err
-		}
-	}
-
-	// 配置zap
-	zapConfig := zap.NewProductionConfig()
-
 	// 设置日志级别
 	level, err := zapcore.ParseLevel(cfg.Level)
 	if err != nil {
 		level = zapcore.InfoLevel
 	}
-	zapConfig.Level = zap.NewAtomicLevelAt(level)
 
 	// 设置输出格式
+	encoderConfig := zap.NewProductionEncoderConfig()
+	var encoder zapcore.Encoder
 	if cfg.Format == "json" {
-		zapConfig.Encoding = "json"
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
 	} else {
-		zapConfig.Encoding = "console"
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
 	}
 
-	// 设置输出位置
-	if cfg.OutputPath != "" {
-		zapConfig.OutputPaths = []string{cfg.OutputPath}
-		zapConfig.ErrorOutputPaths = []string{cfg.OutputPath}
+	// 设置输出位置，输出到文件时按大小轮转，容器环境使用stdout
+	var writeSyncer zapcore.WriteSyncer
+	if cfg.OutputPath != "" && cfg.OutputPath != "stdout" {
+		writeSyncer = zapcore.AddSync(newRotateWriter(cfg))
 	} else {
-		zapConfig.OutputPaths = []string{"stdout"}
-		zapConfig.ErrorOutputPaths = []string{"stderr"}
+		writeSyncer = zapcore.AddSync(os.Stdout)
 	}
 
 	// 创建logger
-	logger, err := zapConfig.Build()
-	if err != nil {
-		return nil, err
-	}
+	core := zapcore.NewCore(encoder, writeSyncer, level)
+	logger := zap.New(core, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
 
 	return &zapLogger{
 		sugar: logger.Sugar(),
 	}, nil
 }
 
+// newRotateWriter 创建按大小轮转的文件输出，日志目录不存在时由lumberjack创建
+func newRotateWriter(cfg Config) *lumberjack.Logger {
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	maxAge := cfg.MaxAge
+	if maxAge <= 0 {
+		maxAge = defaultMaxAge
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = defaultMaxBackups
+	}
+
+	return &lumberjack.Logger{
+		Filename:   cfg.OutputPath,
+		MaxSize:    maxSize,
+		MaxAge:     maxAge,
+		MaxBackups: maxBackups,
+		LocalTime:  true,
+		Compress:   cfg.Compress,
+	}
+}
+
 // Debug 调试日志
 func (l *zapLogger) Debug(msg string, fields ...interface{}) {
 	l.sugar.Debugw(msg, fields...)
@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewLoggerWritesThroughRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "audit-service.log")
+	log, err := NewLogger(Config{Level: "info", Format: "json", OutputPath: path})
+	if err != nil {
+		t.Fatalf("NewLogger: %v", err)
+	}
+	log.Info("audit started", "content_id", "video_1")
+	log.Debug("filtered by level")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read log file: %v", err)
+	}
+	if !strings.Contains(string(data), `"content_id":"video_1"`) || strings.Contains(string(data), "filtered by level") {
+		t.Fatalf("log file = %s", data)
+	}
+}
+
+func TestNewRotateWriterDefaults(t *testing.T) {
+	w := newRotateWriter(Config{OutputPath: "audit.log"})
+	if w.MaxSize != defaultMaxSize || w.MaxAge != defaultMaxAge || w.MaxBackups != defaultMaxBackups {
+		t.Fatalf("defaults = %d/%d/%d", w.MaxSize, w.MaxAge, w.MaxBackups)
+	}
+
+	w = newRotateWriter(Config{OutputPath: "audit.log", MaxSize: 5, MaxAge: 1, MaxBackups: 2, Compress: true})
+	if w.Filename != "audit.log" || w.MaxSize != 5 || w.MaxAge != 1 || w.MaxBackups != 2 || !w.Compress {
+		t.Fatalf("configured writer = %+v", w)
+	}
+}
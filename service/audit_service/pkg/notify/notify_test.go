@@ -0,0 +1,148 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// recordingSink 记录投递的通知事件，block不为nil时投递阻塞到其关闭
+type recordingSink struct {
+	mu     sync.Mutex
+	events []Notification
+	block  chan struct{}
+	err    error
+}
+
+func (s *recordingSink) Push(ctx context.Context, n *Notification) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, *n)
+	return s.err
+}
+
+func (s *recordingSink) pushed() []Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Notification(nil), s.events...)
+}
+
+func TestPublisherDeliversNotifications(t *testing.T) {
+	sink := &recordingSink{}
+	p := NewPublisher(sink, 0, nopLogger{})
+	p.Start()
+
+	before := time.Now().UnixMilli()
+	p.Notify(Notification{Type: TypeGift, UserID: 7, ActorID: 20, TargetID: "1", Title: "收到礼物"})
+	p.Notify(Notification{Type: TypeFollow, UserID: 7, ActorID: 21, Timestamp: 1714566600000})
+	// 没有接收者的通知直接忽略
+	p.Notify(Notification{Type: TypeAuditRejected})
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	got := sink.pushed()
+	if len(got) != 2 {
+		t.Fatalf("pushed %d notifications, want 2: %+v", len(got), got)
+	}
+	if got[0].Type != TypeGift || got[0].UserID != 7 || got[0].ActorID != 20 || got[0].Timestamp < before {
+		t.Errorf("first notification = %+v, want the gift stamped with the current time", got[0])
+	}
+	if got[1].Type != TypeFollow || got[1].Timestamp != 1714566600000 {
+		t.Errorf("second notification = %+v, want the follow with its own timestamp", got[1])
+	}
+}
+
+func TestPublisherDropsWhenQueueFull(t *testing.T) {
+	sink := &recordingSink{block: make(chan struct{})}
+	p := NewPublisher(sink, 1, nopLogger{})
+
+	// 未启动时事件只入队，第二条因队列已满被丢弃而不是阻塞调用方
+	done := make(chan struct{})
+	go func() {
+		p.Notify(Notification{Type: TypeGift, UserID: 1})
+		p.Notify(Notification{Type: TypeGift, UserID: 2})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Notify blocked on a full queue")
+	}
+
+	p.Start()
+	close(sink.block)
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if got := sink.pushed(); len(got) != 1 || got[0].UserID != 1 {
+		t.Errorf("pushed = %+v, want only the queued notification", got)
+	}
+}
+
+func TestPublisherIgnoresPushErrors(t *testing.T) {
+	sink := &recordingSink{err: errors.New("redis down")}
+	p := NewPublisher(sink, 0, nopLogger{})
+	p.Start()
+
+	p.Notify(Notification{Type: TypeGift, UserID: 1})
+	p.Notify(Notification{Type: TypeGift, UserID: 2})
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	// 投递失败只记录日志，后续事件继续投递
+	if got := sink.pushed(); len(got) != 2 {
+		t.Errorf("pushed %d notifications, want 2", len(got))
+	}
+}
+
+func TestPublisherStop(t *testing.T) {
+	sink := &recordingSink{block: make(chan struct{})}
+	p := NewPublisher(sink, 0, nopLogger{})
+	p.Start()
+	p.Notify(Notification{Type: TypeGift, UserID: 1})
+
+	// 投递未完成时按ctx超时返回
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := p.Stop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Stop = %v, want deadline exceeded while a push is blocked", err)
+	}
+	close(sink.block)
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("second Stop: %v", err)
+	}
+
+	// 停止后发布的事件被丢弃
+	p.Notify(Notification{Type: TypeGift, UserID: 2})
+	if got := sink.pushed(); len(got) != 1 {
+		t.Errorf("pushed = %+v, want only the notification sent before stop", got)
+	}
+}
+
+func TestNilPublisher(t *testing.T) {
+	var p *Publisher
+	p.Start()
+	p.Notify(Notification{Type: TypeGift, UserID: 1})
+	if err := p.Stop(context.Background()); err != nil {
+		t.Errorf("Stop = %v, want nil", err)
+	}
+
+	// 未启动的发布器停止时不等待
+	if err := NewPublisher(&recordingSink{}, 0, nopLogger{}).Stop(context.Background()); err != nil {
+		t.Errorf("Stop without Start = %v, want nil", err)
+	}
+}
@@ -0,0 +1,49 @@
+// Package paginate 游标分页令牌编解码
+//
+// 偏移分页在翻页期间有新数据插入时会出现重复或遗漏，游标分页记录上一页最后一条记录的
+// 排序值和主键，下一页从该位置之后继续查询，结果不受插入影响。令牌对客户端不透明，
+// 客户端只需原样回传响应中的next_cursor。
+package paginate
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidCursor 游标令牌无法解析或与当前列表的排序方式不一致
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// Cursor 游标位置，记录上一页最后一条记录的排序值和主键
+type Cursor struct {
+	Sort  string `json:"s"`           // 列表排序方式，解码时校验，防止令牌跨列表使用
+	Value int64  `json:"v,omitempty"` // 最后一条记录的排序字段值，时间字段使用纳秒时间戳
+	ID    uint64 `json:"id"`          // 最后一条记录的主键，排序值相同时用于确定先后
+}
+
+// Encode 将游标编码为URL安全的base64令牌
+func Encode(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode 解析游标令牌，token为空时返回nil表示从第一页开始
+// sort为当前列表的排序方式，与令牌中记录的不一致时返回ErrInvalidCursor
+func Decode(token, sort string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.Sort != sort || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,61 @@
+// Package timeconv 统一处理时间与proto、字符串之间的转换
+// 时间一律按UTC的绝对时刻传递，proto使用timestamppb，字符串使用带时区的RFC3339，避免跨时区部署时出现整点偏差
+package timeconv
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// legacyLayout 历史接口使用的不带时区的时间格式，按UTC解析
+const legacyLayout = "2006-01-02 15:04:05"
+
+// ErrInvalidTime 时间格式不合法
+var ErrInvalidTime = errors.New("invalid time")
+
+// ToProto 转换为proto时间戳，零值返回nil
+func ToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// ToProtoPtr 转换可选时间为proto时间戳，nil或零值返回nil
+func ToProtoPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return ToProto(*t)
+}
+
+// FromProto proto时间戳转换为UTC时间，nil返回零值
+func FromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// Parse 解析字符串时间，返回UTC时间
+// 优先按带时区的RFC3339解析；兼容不带时区的"2006-01-02 15:04:05"，视为UTC时间
+func Parse(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC(), nil
+	}
+	if t, err := time.ParseInLocation(legacyLayout, s, time.UTC); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: %q, expected RFC3339 such as 2006-01-02T15:04:05Z", ErrInvalidTime, s)
+}
+
+// Format 格式化为UTC的RFC3339字符串，零值返回空字符串
+func Format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
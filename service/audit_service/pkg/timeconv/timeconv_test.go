@@ -0,0 +1,89 @@
+package timeconv
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToProto(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	at := time.Date(2026, 9, 1, 8, 0, 0, 0, shanghai)
+
+	// 不同时区的同一时刻转换为相同的时间戳
+	got := ToProto(at)
+	if want := timestamppb.New(at.UTC()); got == nil || !got.AsTime().Equal(want.AsTime()) || got.Seconds != want.Seconds {
+		t.Errorf("ToProto(%v) = %v, want %v", at, got, want)
+	}
+	if got.AsTime().Hour() != 0 {
+		t.Errorf("ToProto(%v) hour = %d in UTC, want 0", at, got.AsTime().Hour())
+	}
+
+	if got := ToProto(time.Time{}); got != nil {
+		t.Errorf("ToProto(zero) = %v, want nil", got)
+	}
+	if got := ToProtoPtr(nil); got != nil {
+		t.Errorf("ToProtoPtr(nil) = %v, want nil", got)
+	}
+	if got := ToProtoPtr(&time.Time{}); got != nil {
+		t.Errorf("ToProtoPtr(&zero) = %v, want nil", got)
+	}
+	if got := ToProtoPtr(&at); got == nil || !got.AsTime().Equal(at) {
+		t.Errorf("ToProtoPtr(%v) = %v, want the same instant", at, got)
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	if got := FromProto(nil); !got.IsZero() {
+		t.Errorf("FromProto(nil) = %v, want zero", got)
+	}
+	at := time.Date(2026, 9, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	got := FromProto(timestamppb.New(at))
+	if !got.Equal(at) || got.Location() != time.UTC {
+		t.Errorf("FromProto = %v, want %v in UTC", got, at)
+	}
+}
+
+func TestParse(t *testing.T) {
+	want := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2026-09-01T00:00:00Z", want},
+		{"2026-09-01T08:00:00+08:00", want},
+		{"2026-08-31T20:00:00-04:00", want},
+		{"2026-09-01T00:00:00.5Z", want.Add(500 * time.Millisecond)},
+		// 不带时区的旧格式按UTC解析，不受服务器时区影响
+		{"2026-09-01 00:00:00", want},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil || !got.Equal(tt.want) || got.Location() != time.UTC {
+			t.Errorf("Parse(%q) = (%v, %v), want %v in UTC", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseRejectsInvalidTimes(t *testing.T) {
+	for _, in := range []string{"", "2026-09-01", "2026-09-01T00:00:00", "09/01/2026 00:00", "2026-13-01 00:00:00"} {
+		if got, err := Parse(in); !errors.Is(err, ErrInvalidTime) || !got.IsZero() {
+			t.Errorf("Parse(%q) = (%v, %v), want ErrInvalidTime", in, got, err)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := Format(time.Time{}); got != "" {
+		t.Errorf("Format(zero) = %q, want empty", got)
+	}
+	at := time.Date(2026, 9, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	if got := Format(at); got != "2026-09-01T00:00:00Z" {
+		t.Errorf("Format(%v) = %q, want UTC RFC3339", at, got)
+	}
+	if got, err := Parse(Format(at)); err != nil || !got.Equal(at) {
+		t.Errorf("Parse(Format(%v)) = (%v, %v), want the same instant", at, got, err)
+	}
+}
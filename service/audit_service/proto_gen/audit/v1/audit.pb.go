@@ -217,6 +217,8 @@ type SubmitContentRequest struct {
 	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                                                             // 内容
 	UploaderId    uint64                 `protobuf:"varint,4,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`                                                    // 上传者ID
 	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 元数据
+	ContentTitle  string                 `protobuf:"bytes,6,opt,name=content_title,json=contentTitle,proto3" json:"content_title,omitempty"`                                               // 内容标题
+	ContentUrl    string                 `protobuf:"bytes,7,opt,name=content_url,json=contentUrl,proto3" json:"content_url,omitempty"`                                                     // 内容地址
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -286,6 +288,20 @@ func (x *SubmitContentRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *SubmitContentRequest) GetContentTitle() string {
+	if x != nil {
+		return x.ContentTitle
+	}
+	return ""
+}
+
+func (x *SubmitContentRequest) GetContentUrl() string {
+	if x != nil {
+		return x.ContentUrl
+	}
+	return ""
+}
+
 // 提交内容审核响应
 type SubmitContentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -420,6 +436,7 @@ type GetAuditResultResponse struct {
 	ReviewerId    uint64                 `protobuf:"varint,7,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`                              // 审核员ID
 	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`                               // 审核时间
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                  // 创建时间
+	Details       string                 `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`                                                      // 审核决策说明(JSON)，包含命中的关键词、各违规类型的模型评分和触发的阈值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -517,6 +534,13 @@ func (x *GetAuditResultResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetAuditResultResponse) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
 // 更新审核状态请求
 type UpdateAuditStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -524,6 +548,7 @@ type UpdateAuditStatusRequest struct {
 	Status        AuditStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=audit.v1.AuditStatus" json:"status,omitempty"` // 审核状态
 	ReviewerId    uint64                 `protobuf:"varint,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"` // 审核员ID
 	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                            // 审核原因
+	Violations    []string               `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`                    // 违规类型(nudity/violence/spam/copyright/hate_speech/political/fraud/other)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -586,6 +611,13 @@ func (x *UpdateAuditStatusRequest) GetReason() string {
 	return ""
 }
 
+func (x *UpdateAuditStatusRequest) GetViolations() []string {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
 // 更新审核状态响应
 type UpdateAuditStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -651,6 +683,7 @@ type ListAuditRecordsRequest struct {
 	EndDate       string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                        // 结束日期
 	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`                                                            // 页码
 	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                    // 每页数量
+	Cursor        string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                        // 分页游标，传上一页返回的next_cursor，不为空时忽略page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -748,6 +781,13 @@ func (x *ListAuditRecordsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListAuditRecordsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // 审核记录
 type AuditRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -868,10 +908,11 @@ func (x *AuditRecord) GetReviewedAt() *timestamppb.Timestamp {
 // 获取审核记录列表响应
 type ListAuditRecordsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
-	Records       []*AuditRecord         `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`                    // 审核记录列表
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                            // 总数
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                              // 当前页
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`      // 每页数量
+	Records       []*AuditRecord         `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`                         // 审核记录列表
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标，没有更多时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -934,6 +975,13 @@ func (x *ListAuditRecordsResponse) GetRecords() []*AuditRecord {
 	return nil
 }
 
+func (x *ListAuditRecordsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // 添加到白名单请求
 type AddToWhitelistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1918,8 +1966,9 @@ func (x *GetAuditStatisticsResponse) GetTypeStats() []*TypeCount {
 // 违规趋势
 type ViolationTrend struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // 日期
-	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // 数量
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                                                                        // 日期
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                                                                                     // 数量
+	Categories    map[string]int64       `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 按违规类型统计
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1968,6 +2017,13 @@ func (x *ViolationTrend) GetCount() int64 {
 	return 0
 }
 
+func (x *ViolationTrend) GetCategories() map[string]int64 {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
 // 获取违规趋势请求
 type GetViolationTrendsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2066,171 +2122,1443 @@ func (x *GetViolationTrendsResponse) GetTrends() []*ViolationTrend {
 	return nil
 }
 
-var File_proto_audit_v1_audit_proto protoreflect.FileDescriptor
+// 提交失败的审核内容
+type FailedSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                // 重试记录ID
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`                                  // 内容ID
+	ContentType   ContentType            `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=audit.v1.ContentType" json:"content_type,omitempty"` // 内容类型
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                                         // 重试状态：pending/succeeded/exhausted
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`                                                    // 已尝试次数
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`                                  // 最近一次失败原因
+	NextRetryAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`                          // 下次重试时间
+	AuditId       uint64                 `protobuf:"varint,8,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`                                       // 重试成功后的审核ID
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                  // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                 // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_audit_v1_audit_proto_rawDesc = "" +
-	"\n" +
-	"\x1aproto/audit/v1/audit.proto\x12\baudit.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb1\x02\n" +
-	"\x14SubmitContentRequest\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
-	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1f\n" +
-	"\vuploader_id\x18\x04 \x01(\x04R\n" +
-	"uploaderId\x12H\n" +
-	"\bmetadata\x18\x05 \x03(\v2,.audit.v1.SubmitContentRequest.MetadataEntryR\bmetadata\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x01\n" +
-	"\x15SubmitContentResponse\x12\x19\n" +
-	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12-\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\x12*\n" +
-	"\x05level\x18\x04 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"2\n" +
-	"\x15GetAuditResultRequest\x12\x19\n" +
-	"\baudit_id\x18\x01 \x01(\x04R\aauditId\"\x98\x03\n" +
-	"\x16GetAuditResultResponse\x12\x19\n" +
-	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x02 \x01(\tR\tcontentId\x128\n" +
-	"\fcontent_type\x18\x03 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12-\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\x12*\n" +
-	"\x05level\x18\x06 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1f\n" +
-	"\vreviewer_id\x18\a \x01(\x04R\n" +
-	"reviewerId\x12;\n" +
-	"\vreviewed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"reviewedAt\x129\n" +
-	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9d\x01\n" +
-	"\x18UpdateAuditStatusRequest\x12\x19\n" +
-	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12-\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x1f\n" +
-	"\vreviewer_id\x18\x03 \x01(\x04R\n" +
-	"reviewerId\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"O\n" +
-	"\x19UpdateAuditStatusResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xdb\x02\n" +
-	"\x17ListAuditRecordsRequest\x128\n" +
-	"\fcontent_type\x18\x01 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12-\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12*\n" +
-	"\x05level\x18\x03 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1f\n" +
-	"\vuploader_id\x18\x04 \x01(\x04R\n" +
-	"uploaderId\x12\x1f\n" +
-	"\vreviewer_id\x18\x05 \x01(\x04R\n" +
-	"reviewerId\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\a \x01(\tR\aendDate\x12\x12\n" +
-	"\x04page\x18\b \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\t \x01(\x05R\bpageSize\"\xae\x03\n" +
-	"\vAuditRecord\x12\x19\n" +
-	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x02 \x01(\tR\tcontentId\x128\n" +
-	"\fcontent_type\x18\x03 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12-\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\x12*\n" +
-	"\x05level\x18\x06 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1f\n" +
-	"\vuploader_id\x18\a \x01(\x04R\n" +
-	"uploaderId\x12\x1f\n" +
-	"\vreviewer_id\x18\b \x01(\x04R\n" +
-	"reviewerId\x129\n" +
-	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
-	"\vreviewed_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"reviewedAt\"\x92\x01\n" +
-	"\x18ListAuditRecordsResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12/\n" +
-	"\arecords\x18\x04 \x03(\v2\x15.audit.v1.AuditRecordR\arecords\"\xa7\x01\n" +
-	"\x15AddToWhitelistRequest\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
-	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x04 \x01(\x04R\tcreatedBy\"L\n" +
-	"\x16AddToWhitelistResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
-	"\x1aRemoveFromWhitelistRequest\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\tR\tcontentId\"Q\n" +
-	"\x1bRemoveFromWhitelistResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xa7\x01\n" +
-	"\x15AddToBlacklistRequest\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
-	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x04 \x01(\x04R\tcreatedBy\"L\n" +
-	"\x16AddToBlacklistResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
-	"\x1aRemoveFromBlacklistRequest\x12\x1d\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\tR\tcontentId\"Q\n" +
-	"\x1bRemoveFromBlacklistResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xd0\x01\n" +
-	"\x1bGetManualReviewQueueRequest\x128\n" +
-	"\fcontent_type\x18\x01 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12*\n" +
-	"\x05level\x18\x02 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1a\n" +
-	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x12\n" +
-	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x96\x01\n" +
-	"\x1cGetManualReviewQueueResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12/\n" +
-	"\arecords\x18\x04 \x03(\v2\x15.audit.v1.AuditRecordR\arecords\"W\n" +
-	"\x19AssignManualReviewRequest\x12\x19\n" +
-	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1f\n" +
-	"\vreviewer_id\x18\x02 \x01(\x04R\n" +
-	"reviewerId\"P\n" +
-	"\x1aAssignManualReviewResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"R\n" +
-	"\vStatusCount\x12-\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"N\n" +
-	"\n" +
-	"LevelCount\x12*\n" +
-	"\x05level\x18\x01 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"[\n" +
-	"\tTypeCount\x128\n" +
-	"\fcontent_type\x18\x01 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"U\n" +
-	"\x19GetAuditStatisticsRequest\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xff\x01\n" +
-	"\x1aGetAuditStatisticsResponse\x12\x1f\n" +
-	"\vtotal_count\x18\x01 \x01(\x03R\n" +
-	"totalCount\x12\x1b\n" +
-	"\tpass_rate\x18\x02 \x01(\x01R\bpassRate\x128\n" +
-	"\fstatus_stats\x18\x03 \x03(\v2\x15.audit.v1.StatusCountR\vstatusStats\x125\n" +
-	"\vlevel_stats\x18\x04 \x03(\v2\x14.audit.v1.LevelCountR\n" +
-	"levelStats\x122\n" +
-	"\n" +
-	"type_stats\x18\x05 \x03(\v2\x13.audit.v1.TypeCountR\ttypeStats\":\n" +
-	"\x0eViolationTrend\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"U\n" +
-	"\x19GetViolationTrendsRequest\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"N\n" +
-	"\x1aGetViolationTrendsResponse\x120\n" +
-	"\x06trends\x18\x01 \x03(\v2\x18.audit.v1.ViolationTrendR\x06trends*\xf0\x01\n" +
+func (x *FailedSubmission) Reset() {
+	*x = FailedSubmission{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailedSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedSubmission) ProtoMessage() {}
+
+func (x *FailedSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedSubmission.ProtoReflect.Descriptor instead.
+func (*FailedSubmission) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FailedSubmission) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FailedSubmission) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *FailedSubmission) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *FailedSubmission) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FailedSubmission) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *FailedSubmission) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *FailedSubmission) GetNextRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return nil
+}
+
+func (x *FailedSubmission) GetAuditId() uint64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *FailedSubmission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FailedSubmission) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 获取失败提交列表请求
+type ListFailedSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                      // 重试状态，为空时返回全部
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedSubmissionsRequest) Reset() {
+	*x = ListFailedSubmissionsRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListFailedSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListFailedSubmissionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListFailedSubmissionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFailedSubmissionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取失败提交列表响应
+type ListFailedSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	Submissions   []*FailedSubmission    `protobuf:"bytes,4,rep,name=submissions,proto3" json:"submissions,omitempty"`            // 失败提交列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedSubmissionsResponse) Reset() {
+	*x = ListFailedSubmissionsResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListFailedSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFailedSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListFailedSubmissionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFailedSubmissionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFailedSubmissionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFailedSubmissionsResponse) GetSubmissions() []*FailedSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// 人工重试请求
+type RetryFailedSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 重试记录ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedSubmissionRequest) Reset() {
+	*x = RetryFailedSubmissionRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedSubmissionRequest) ProtoMessage() {}
+
+func (x *RetryFailedSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*RetryFailedSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RetryFailedSubmissionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 人工重试响应
+type RetryFailedSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`      // 本次重试是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`       // 消息
+	Submission    *FailedSubmission      `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"` // 重试后的记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedSubmissionResponse) Reset() {
+	*x = RetryFailedSubmissionResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedSubmissionResponse) ProtoMessage() {}
+
+func (x *RetryFailedSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*RetryFailedSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RetryFailedSubmissionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RetryFailedSubmissionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RetryFailedSubmissionResponse) GetSubmission() *FailedSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// 举报内容请求
+type ReportContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`                                  // 内容ID
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,json=contentType,proto3,enum=audit.v1.ContentType" json:"content_type,omitempty"` // 内容类型
+	ReporterId    uint64                 `protobuf:"varint,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`                              // 举报人ID
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                                         // 举报原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportContentRequest) Reset() {
+	*x = ReportContentRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentRequest) ProtoMessage() {}
+
+func (x *ReportContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentRequest.ProtoReflect.Descriptor instead.
+func (*ReportContentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReportContentRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *ReportContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ReportContentRequest) GetReporterId() uint64 {
+	if x != nil {
+		return x.ReporterId
+	}
+	return 0
+}
+
+func (x *ReportContentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 举报内容响应
+type ReportContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      uint64                 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`          // 举报记录ID
+	ReportCount   int64                  `protobuf:"varint,2,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"` // 举报该内容的不同用户数
+	Escalated     bool                   `protobuf:"varint,3,opt,name=escalated,proto3" json:"escalated,omitempty"`                        // 是否触发升级人工审核
+	AuditId       uint64                 `protobuf:"varint,4,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`             // 升级后的审核记录ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportContentResponse) Reset() {
+	*x = ReportContentResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentResponse) ProtoMessage() {}
+
+func (x *ReportContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentResponse.ProtoReflect.Descriptor instead.
+func (*ReportContentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReportContentResponse) GetReportId() uint64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ReportContentResponse) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ReportContentResponse) GetEscalated() bool {
+	if x != nil {
+		return x.Escalated
+	}
+	return false
+}
+
+func (x *ReportContentResponse) GetAuditId() uint64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+// 提交申诉请求
+type SubmitAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditId       uint64                 `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"` // 审核ID
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                   // 申诉理由
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAppealRequest) Reset() {
+	*x = SubmitAppealRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAppealRequest) ProtoMessage() {}
+
+func (x *SubmitAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAppealRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubmitAppealRequest) GetAuditId() uint64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *SubmitAppealRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 提交申诉响应
+type SubmitAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      uint64                 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"` // 申诉ID
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                      // 申诉状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAppealResponse) Reset() {
+	*x = SubmitAppealResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAppealResponse) ProtoMessage() {}
+
+func (x *SubmitAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAppealResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SubmitAppealResponse) GetAppealId() uint64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+func (x *SubmitAppealResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 处理申诉请求
+type ResolveAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditId       uint64                 `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`          // 审核ID
+	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`                        // 处理结论：uphold维持原判，overturn撤销原判
+	ReviewerId    uint64                 `protobuf:"varint,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"` // 审核员ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAppealRequest) Reset() {
+	*x = ResolveAppealRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAppealRequest) ProtoMessage() {}
+
+func (x *ResolveAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAppealRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResolveAppealRequest) GetAuditId() uint64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *ResolveAppealRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ResolveAppealRequest) GetReviewerId() uint64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+// 处理申诉响应
+type ResolveAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      uint64                 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"` // 申诉ID
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                      // 申诉状态：upheld或overturned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAppealResponse) Reset() {
+	*x = ResolveAppealResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAppealResponse) ProtoMessage() {}
+
+func (x *ResolveAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAppealResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResolveAppealResponse) GetAppealId() uint64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+func (x *ResolveAppealResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 申诉记录
+type AuditAppeal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      uint64                 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`         // 申诉ID
+	AuditId       uint64                 `protobuf:"varint,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`            // 审核ID
+	ContentId     string                 `protobuf:"bytes,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`       // 内容ID
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 内容类型
+	UploaderId    uint64                 `protobuf:"varint,5,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`   // 上传者ID
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                              // 申诉理由
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                              // 申诉状态
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // 提交时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditAppeal) Reset() {
+	*x = AuditAppeal{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditAppeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditAppeal) ProtoMessage() {}
+
+func (x *AuditAppeal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditAppeal.ProtoReflect.Descriptor instead.
+func (*AuditAppeal) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AuditAppeal) GetAppealId() uint64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+func (x *AuditAppeal) GetAuditId() uint64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *AuditAppeal) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *AuditAppeal) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AuditAppeal) GetUploaderId() uint64 {
+	if x != nil {
+		return x.UploaderId
+	}
+	return 0
+}
+
+func (x *AuditAppeal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditAppeal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AuditAppeal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 获取申诉队列请求
+type ListPendingAppealsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingAppealsRequest) Reset() {
+	*x = ListPendingAppealsRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingAppealsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingAppealsRequest) ProtoMessage() {}
+
+func (x *ListPendingAppealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingAppealsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingAppealsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListPendingAppealsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPendingAppealsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取申诉队列响应
+type ListPendingAppealsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	Appeals       []*AuditAppeal         `protobuf:"bytes,4,rep,name=appeals,proto3" json:"appeals,omitempty"`                    // 待处理的申诉列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingAppealsResponse) Reset() {
+	*x = ListPendingAppealsResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingAppealsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingAppealsResponse) ProtoMessage() {}
+
+func (x *ListPendingAppealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingAppealsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingAppealsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPendingAppealsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPendingAppealsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPendingAppealsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingAppealsResponse) GetAppeals() []*AuditAppeal {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
+// 批量提交中的单项内容，content和metadata未填写时使用批次共用值
+type BatchSubmitItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`                                                        // 内容ID
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                                                                             // 内容
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 元数据
+	ContentTitle  string                 `protobuf:"bytes,4,opt,name=content_title,json=contentTitle,proto3" json:"content_title,omitempty"`                                               // 内容标题
+	ContentUrl    string                 `protobuf:"bytes,5,opt,name=content_url,json=contentUrl,proto3" json:"content_url,omitempty"`                                                     // 内容地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSubmitItem) Reset() {
+	*x = BatchSubmitItem{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSubmitItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubmitItem) ProtoMessage() {}
+
+func (x *BatchSubmitItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSubmitItem.ProtoReflect.Descriptor instead.
+func (*BatchSubmitItem) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BatchSubmitItem) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *BatchSubmitItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *BatchSubmitItem) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BatchSubmitItem) GetContentTitle() string {
+	if x != nil {
+		return x.ContentTitle
+	}
+	return ""
+}
+
+func (x *BatchSubmitItem) GetContentUrl() string {
+	if x != nil {
+		return x.ContentUrl
+	}
+	return ""
+}
+
+// 批量提交内容审核请求
+type BatchSubmitContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentIds    []string               `protobuf:"bytes,1,rep,name=content_ids,json=contentIds,proto3" json:"content_ids,omitempty"`                                                     // 共用content和metadata的内容ID
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,json=contentType,proto3,enum=audit.v1.ContentType" json:"content_type,omitempty"`                       // 内容类型
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                                                             // 共用内容
+	UploaderId    uint64                 `protobuf:"varint,4,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`                                                    // 上传者ID
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 共用元数据
+	Items         []*BatchSubmitItem     `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                                                                                 // 逐项提交的内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSubmitContentRequest) Reset() {
+	*x = BatchSubmitContentRequest{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSubmitContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubmitContentRequest) ProtoMessage() {}
+
+func (x *BatchSubmitContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSubmitContentRequest.ProtoReflect.Descriptor instead.
+func (*BatchSubmitContentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BatchSubmitContentRequest) GetContentIds() []string {
+	if x != nil {
+		return x.ContentIds
+	}
+	return nil
+}
+
+func (x *BatchSubmitContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *BatchSubmitContentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *BatchSubmitContentRequest) GetUploaderId() uint64 {
+	if x != nil {
+		return x.UploaderId
+	}
+	return 0
+}
+
+func (x *BatchSubmitContentRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BatchSubmitContentRequest) GetItems() []*BatchSubmitItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 批量提交内容审核响应
+type BatchSubmitContentResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*SubmitContentResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 每项的提交结果，先content_ids后items
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 提示信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSubmitContentResponse) Reset() {
+	*x = BatchSubmitContentResponse{}
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSubmitContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubmitContentResponse) ProtoMessage() {}
+
+func (x *BatchSubmitContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_audit_v1_audit_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSubmitContentResponse.ProtoReflect.Descriptor instead.
+func (*BatchSubmitContentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_audit_v1_audit_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchSubmitContentResponse) GetResults() []*SubmitContentResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchSubmitContentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_proto_audit_v1_audit_proto protoreflect.FileDescriptor
+
+const file_proto_audit_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x1aproto/audit/v1/audit.proto\x12\baudit.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf7\x02\n" +
+	"\x14SubmitContentRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1f\n" +
+	"\vuploader_id\x18\x04 \x01(\x04R\n" +
+	"uploaderId\x12H\n" +
+	"\bmetadata\x18\x05 \x03(\v2,.audit.v1.SubmitContentRequest.MetadataEntryR\bmetadata\x12#\n" +
+	"\rcontent_title\x18\x06 \x01(\tR\fcontentTitle\x12\x1f\n" +
+	"\vcontent_url\x18\a \x01(\tR\n" +
+	"contentUrl\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x01\n" +
+	"\x15SubmitContentResponse\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12*\n" +
+	"\x05level\x18\x04 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"2\n" +
+	"\x15GetAuditResultRequest\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\"\xb2\x03\n" +
+	"\x16GetAuditResultResponse\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x03 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12*\n" +
+	"\x05level\x18\x06 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1f\n" +
+	"\vreviewer_id\x18\a \x01(\x04R\n" +
+	"reviewerId\x12;\n" +
+	"\vreviewed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\adetails\x18\n" +
+	" \x01(\tR\adetails\"\xbd\x01\n" +
+	"\x18UpdateAuditStatusRequest\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\x04R\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"violations\x18\x05 \x03(\tR\n" +
+	"violations\"O\n" +
+	"\x19UpdateAuditStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf3\x02\n" +
+	"\x17ListAuditRecordsRequest\x128\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12*\n" +
+	"\x05level\x18\x03 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1f\n" +
+	"\vuploader_id\x18\x04 \x01(\x04R\n" +
+	"uploaderId\x12\x1f\n" +
+	"\vreviewer_id\x18\x05 \x01(\x04R\n" +
+	"reviewerId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12\x12\n" +
+	"\x04page\x18\b \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\"\xae\x03\n" +
+	"\vAuditRecord\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x03 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12*\n" +
+	"\x05level\x18\x06 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1f\n" +
+	"\vuploader_id\x18\a \x01(\x04R\n" +
+	"uploaderId\x12\x1f\n" +
+	"\vreviewer_id\x18\b \x01(\x04R\n" +
+	"reviewerId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"\xb3\x01\n" +
+	"\x18ListAuditRecordsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12/\n" +
+	"\arecords\x18\x04 \x03(\v2\x15.audit.v1.AuditRecordR\arecords\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"\xa7\x01\n" +
+	"\x15AddToWhitelistRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x04R\tcreatedBy\"L\n" +
+	"\x16AddToWhitelistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
+	"\x1aRemoveFromWhitelistRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\"Q\n" +
+	"\x1bRemoveFromWhitelistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa7\x01\n" +
+	"\x15AddToBlacklistRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x04R\tcreatedBy\"L\n" +
+	"\x16AddToBlacklistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
+	"\x1aRemoveFromBlacklistRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\"Q\n" +
+	"\x1bRemoveFromBlacklistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd0\x01\n" +
+	"\x1bGetManualReviewQueueRequest\x128\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12*\n" +
+	"\x05level\x18\x02 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x96\x01\n" +
+	"\x1cGetManualReviewQueueResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12/\n" +
+	"\arecords\x18\x04 \x03(\v2\x15.audit.v1.AuditRecordR\arecords\"W\n" +
+	"\x19AssignManualReviewRequest\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x04R\n" +
+	"reviewerId\"P\n" +
+	"\x1aAssignManualReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"R\n" +
+	"\vStatusCount\x12-\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x15.audit.v1.AuditStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"N\n" +
+	"\n" +
+	"LevelCount\x12*\n" +
+	"\x05level\x18\x01 \x01(\x0e2\x14.audit.v1.AuditLevelR\x05level\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"[\n" +
+	"\tTypeCount\x128\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"U\n" +
+	"\x19GetAuditStatisticsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xff\x01\n" +
+	"\x1aGetAuditStatisticsResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x1b\n" +
+	"\tpass_rate\x18\x02 \x01(\x01R\bpassRate\x128\n" +
+	"\fstatus_stats\x18\x03 \x03(\v2\x15.audit.v1.StatusCountR\vstatusStats\x125\n" +
+	"\vlevel_stats\x18\x04 \x03(\v2\x14.audit.v1.LevelCountR\n" +
+	"levelStats\x122\n" +
+	"\n" +
+	"type_stats\x18\x05 \x03(\v2\x13.audit.v1.TypeCountR\ttypeStats\"\xc3\x01\n" +
+	"\x0eViolationTrend\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12H\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2(.audit.v1.ViolationTrend.CategoriesEntryR\n" +
+	"categories\x1a=\n" +
+	"\x0fCategoriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"U\n" +
+	"\x19GetViolationTrendsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\"N\n" +
+	"\x1aGetViolationTrendsResponse\x120\n" +
+	"\x06trends\x18\x01 \x03(\v2\x18.audit.v1.ViolationTrendR\x06trends\"\x9f\x03\n" +
+	"\x10FailedSubmission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x03 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12>\n" +
+	"\rnext_retry_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vnextRetryAt\x12\x19\n" +
+	"\baudit_id\x18\b \x01(\x04R\aauditId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
+	"\x1cListFailedSubmissionsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa4\x01\n" +
+	"\x1dListFailedSubmissionsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12<\n" +
+	"\vsubmissions\x18\x04 \x03(\v2\x1a.audit.v1.FailedSubmissionR\vsubmissions\".\n" +
+	"\x1cRetryFailedSubmissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x8f\x01\n" +
+	"\x1dRetryFailedSubmissionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\n" +
+	"submission\x18\x03 \x01(\v2\x1a.audit.v1.FailedSubmissionR\n" +
+	"submission\"\xa8\x01\n" +
+	"\x14ReportContentRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x128\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x1f\n" +
+	"\vreporter_id\x18\x03 \x01(\x04R\n" +
+	"reporterId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x90\x01\n" +
+	"\x15ReportContentResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x04R\breportId\x12!\n" +
+	"\freport_count\x18\x02 \x01(\x03R\vreportCount\x12\x1c\n" +
+	"\tescalated\x18\x03 \x01(\bR\tescalated\x12\x19\n" +
+	"\baudit_id\x18\x04 \x01(\x04R\aauditId\"H\n" +
+	"\x13SubmitAppealRequest\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"K\n" +
+	"\x14SubmitAppealResponse\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\x04R\bappealId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"n\n" +
+	"\x14ResolveAppealRequest\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x04R\aauditId\x12\x1a\n" +
+	"\bdecision\x18\x02 \x01(\tR\bdecision\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\x04R\n" +
+	"reviewerId\"L\n" +
+	"\x15ResolveAppealResponse\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\x04R\bappealId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x93\x02\n" +
+	"\vAuditAppeal\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\x04R\bappealId\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\x04R\aauditId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tR\tcontentId\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1f\n" +
+	"\vuploader_id\x18\x05 \x01(\x04R\n" +
+	"uploaderId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"L\n" +
+	"\x19ListPendingAppealsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x94\x01\n" +
+	"\x1aListPendingAppealsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12/\n" +
+	"\aappeals\x18\x04 \x03(\v2\x15.audit.v1.AuditAppealR\aappeals\"\x92\x02\n" +
+	"\x0fBatchSubmitItem\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12C\n" +
+	"\bmetadata\x18\x03 \x03(\v2'.audit.v1.BatchSubmitItem.MetadataEntryR\bmetadata\x12#\n" +
+	"\rcontent_title\x18\x04 \x01(\tR\fcontentTitle\x12\x1f\n" +
+	"\vcontent_url\x18\x05 \x01(\tR\n" +
+	"contentUrl\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xee\x02\n" +
+	"\x19BatchSubmitContentRequest\x12\x1f\n" +
+	"\vcontent_ids\x18\x01 \x03(\tR\n" +
+	"contentIds\x128\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x15.audit.v1.ContentTypeR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1f\n" +
+	"\vuploader_id\x18\x04 \x01(\x04R\n" +
+	"uploaderId\x12M\n" +
+	"\bmetadata\x18\x05 \x03(\v21.audit.v1.BatchSubmitContentRequest.MetadataEntryR\bmetadata\x12/\n" +
+	"\x05items\x18\x06 \x03(\v2\x19.audit.v1.BatchSubmitItemR\x05items\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\x1aBatchSubmitContentResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.audit.v1.SubmitContentResponseR\aresults\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\xf0\x01\n" +
 	"\vContentType\x12\x1c\n" +
 	"\x18CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11CONTENT_TYPE_TEXT\x10\x01\x12\x16\n" +
@@ -2255,7 +3583,7 @@ const file_proto_audit_v1_audit_proto_rawDesc = "" +
 	"\x0fAUDIT_LEVEL_LOW\x10\x01\x12\x16\n" +
 	"\x12AUDIT_LEVEL_MEDIUM\x10\x02\x12\x14\n" +
 	"\x10AUDIT_LEVEL_HIGH\x10\x03\x12\x18\n" +
-	"\x14AUDIT_LEVEL_CRITICAL\x10\x042\xea\b\n" +
+	"\x14AUDIT_LEVEL_CRITICAL\x10\x042\xf3\r\n" +
 	"\fAuditService\x12P\n" +
 	"\rSubmitContent\x12\x1e.audit.v1.SubmitContentRequest\x1a\x1f.audit.v1.SubmitContentResponse\x12S\n" +
 	"\x0eGetAuditResult\x12\x1f.audit.v1.GetAuditResultRequest\x1a .audit.v1.GetAuditResultResponse\x12\\\n" +
@@ -2268,7 +3596,14 @@ const file_proto_audit_v1_audit_proto_rawDesc = "" +
 	"\x14GetManualReviewQueue\x12%.audit.v1.GetManualReviewQueueRequest\x1a&.audit.v1.GetManualReviewQueueResponse\x12_\n" +
 	"\x12AssignManualReview\x12#.audit.v1.AssignManualReviewRequest\x1a$.audit.v1.AssignManualReviewResponse\x12_\n" +
 	"\x12GetAuditStatistics\x12#.audit.v1.GetAuditStatisticsRequest\x1a$.audit.v1.GetAuditStatisticsResponse\x12_\n" +
-	"\x12GetViolationTrends\x12#.audit.v1.GetViolationTrendsRequest\x1a$.audit.v1.GetViolationTrendsResponseB>Z<github.com/vision_world/audit_service/proto/audit/v1;auditv1b\x06proto3"
+	"\x12GetViolationTrends\x12#.audit.v1.GetViolationTrendsRequest\x1a$.audit.v1.GetViolationTrendsResponse\x12h\n" +
+	"\x15ListFailedSubmissions\x12&.audit.v1.ListFailedSubmissionsRequest\x1a'.audit.v1.ListFailedSubmissionsResponse\x12h\n" +
+	"\x15RetryFailedSubmission\x12&.audit.v1.RetryFailedSubmissionRequest\x1a'.audit.v1.RetryFailedSubmissionResponse\x12P\n" +
+	"\rReportContent\x12\x1e.audit.v1.ReportContentRequest\x1a\x1f.audit.v1.ReportContentResponse\x12M\n" +
+	"\fSubmitAppeal\x12\x1d.audit.v1.SubmitAppealRequest\x1a\x1e.audit.v1.SubmitAppealResponse\x12P\n" +
+	"\rResolveAppeal\x12\x1e.audit.v1.ResolveAppealRequest\x1a\x1f.audit.v1.ResolveAppealResponse\x12_\n" +
+	"\x12ListPendingAppeals\x12#.audit.v1.ListPendingAppealsRequest\x1a$.audit.v1.ListPendingAppealsResponse\x12_\n" +
+	"\x12BatchSubmitContent\x12#.audit.v1.BatchSubmitContentRequest\x1a$.audit.v1.BatchSubmitContentResponseB>Z<github.com/vision_world/audit_service/proto/audit/v1;auditv1b\x06proto3"
 
 var (
 	file_proto_audit_v1_audit_proto_rawDescOnce sync.Once
@@ -2283,54 +3618,74 @@ func file_proto_audit_v1_audit_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_audit_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_audit_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_proto_audit_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_proto_audit_v1_audit_proto_goTypes = []any{
-	(ContentType)(0),                     // 0: audit.v1.ContentType
-	(AuditStatus)(0),                     // 1: audit.v1.AuditStatus
-	(AuditLevel)(0),                      // 2: audit.v1.AuditLevel
-	(*SubmitContentRequest)(nil),         // 3: audit.v1.SubmitContentRequest
-	(*SubmitContentResponse)(nil),        // 4: audit.v1.SubmitContentResponse
-	(*GetAuditResultRequest)(nil),        // 5: audit.v1.GetAuditResultRequest
-	(*GetAuditResultResponse)(nil),       // 6: audit.v1.GetAuditResultResponse
-	(*UpdateAuditStatusRequest)(nil),     // 7: audit.v1.UpdateAuditStatusRequest
-	(*UpdateAuditStatusResponse)(nil),    // 8: audit.v1.UpdateAuditStatusResponse
-	(*ListAuditRecordsRequest)(nil),      // 9: audit.v1.ListAuditRecordsRequest
-	(*AuditRecord)(nil),                  // 10: audit.v1.AuditRecord
-	(*ListAuditRecordsResponse)(nil),     // 11: audit.v1.ListAuditRecordsResponse
-	(*AddToWhitelistRequest)(nil),        // 12: audit.v1.AddToWhitelistRequest
-	(*AddToWhitelistResponse)(nil),       // 13: audit.v1.AddToWhitelistResponse
-	(*RemoveFromWhitelistRequest)(nil),   // 14: audit.v1.RemoveFromWhitelistRequest
-	(*RemoveFromWhitelistResponse)(nil),  // 15: audit.v1.RemoveFromWhitelistResponse
-	(*AddToBlacklistRequest)(nil),        // 16: audit.v1.AddToBlacklistRequest
-	(*AddToBlacklistResponse)(nil),       // 17: audit.v1.AddToBlacklistResponse
-	(*RemoveFromBlacklistRequest)(nil),   // 18: audit.v1.RemoveFromBlacklistRequest
-	(*RemoveFromBlacklistResponse)(nil),  // 19: audit.v1.RemoveFromBlacklistResponse
-	(*GetManualReviewQueueRequest)(nil),  // 20: audit.v1.GetManualReviewQueueRequest
-	(*GetManualReviewQueueResponse)(nil), // 21: audit.v1.GetManualReviewQueueResponse
-	(*AssignManualReviewRequest)(nil),    // 22: audit.v1.AssignManualReviewRequest
-	(*AssignManualReviewResponse)(nil),   // 23: audit.v1.AssignManualReviewResponse
-	(*StatusCount)(nil),                  // 24: audit.v1.StatusCount
-	(*LevelCount)(nil),                   // 25: audit.v1.LevelCount
-	(*TypeCount)(nil),                    // 26: audit.v1.TypeCount
-	(*GetAuditStatisticsRequest)(nil),    // 27: audit.v1.GetAuditStatisticsRequest
-	(*GetAuditStatisticsResponse)(nil),   // 28: audit.v1.GetAuditStatisticsResponse
-	(*ViolationTrend)(nil),               // 29: audit.v1.ViolationTrend
-	(*GetViolationTrendsRequest)(nil),    // 30: audit.v1.GetViolationTrendsRequest
-	(*GetViolationTrendsResponse)(nil),   // 31: audit.v1.GetViolationTrendsResponse
-	nil,                                  // 32: audit.v1.SubmitContentRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
+	(ContentType)(0),                      // 0: audit.v1.ContentType
+	(AuditStatus)(0),                      // 1: audit.v1.AuditStatus
+	(AuditLevel)(0),                       // 2: audit.v1.AuditLevel
+	(*SubmitContentRequest)(nil),          // 3: audit.v1.SubmitContentRequest
+	(*SubmitContentResponse)(nil),         // 4: audit.v1.SubmitContentResponse
+	(*GetAuditResultRequest)(nil),         // 5: audit.v1.GetAuditResultRequest
+	(*GetAuditResultResponse)(nil),        // 6: audit.v1.GetAuditResultResponse
+	(*UpdateAuditStatusRequest)(nil),      // 7: audit.v1.UpdateAuditStatusRequest
+	(*UpdateAuditStatusResponse)(nil),     // 8: audit.v1.UpdateAuditStatusResponse
+	(*ListAuditRecordsRequest)(nil),       // 9: audit.v1.ListAuditRecordsRequest
+	(*AuditRecord)(nil),                   // 10: audit.v1.AuditRecord
+	(*ListAuditRecordsResponse)(nil),      // 11: audit.v1.ListAuditRecordsResponse
+	(*AddToWhitelistRequest)(nil),         // 12: audit.v1.AddToWhitelistRequest
+	(*AddToWhitelistResponse)(nil),        // 13: audit.v1.AddToWhitelistResponse
+	(*RemoveFromWhitelistRequest)(nil),    // 14: audit.v1.RemoveFromWhitelistRequest
+	(*RemoveFromWhitelistResponse)(nil),   // 15: audit.v1.RemoveFromWhitelistResponse
+	(*AddToBlacklistRequest)(nil),         // 16: audit.v1.AddToBlacklistRequest
+	(*AddToBlacklistResponse)(nil),        // 17: audit.v1.AddToBlacklistResponse
+	(*RemoveFromBlacklistRequest)(nil),    // 18: audit.v1.RemoveFromBlacklistRequest
+	(*RemoveFromBlacklistResponse)(nil),   // 19: audit.v1.RemoveFromBlacklistResponse
+	(*GetManualReviewQueueRequest)(nil),   // 20: audit.v1.GetManualReviewQueueRequest
+	(*GetManualReviewQueueResponse)(nil),  // 21: audit.v1.GetManualReviewQueueResponse
+	(*AssignManualReviewRequest)(nil),     // 22: audit.v1.AssignManualReviewRequest
+	(*AssignManualReviewResponse)(nil),    // 23: audit.v1.AssignManualReviewResponse
+	(*StatusCount)(nil),                   // 24: audit.v1.StatusCount
+	(*LevelCount)(nil),                    // 25: audit.v1.LevelCount
+	(*TypeCount)(nil),                     // 26: audit.v1.TypeCount
+	(*GetAuditStatisticsRequest)(nil),     // 27: audit.v1.GetAuditStatisticsRequest
+	(*GetAuditStatisticsResponse)(nil),    // 28: audit.v1.GetAuditStatisticsResponse
+	(*ViolationTrend)(nil),                // 29: audit.v1.ViolationTrend
+	(*GetViolationTrendsRequest)(nil),     // 30: audit.v1.GetViolationTrendsRequest
+	(*GetViolationTrendsResponse)(nil),    // 31: audit.v1.GetViolationTrendsResponse
+	(*FailedSubmission)(nil),              // 32: audit.v1.FailedSubmission
+	(*ListFailedSubmissionsRequest)(nil),  // 33: audit.v1.ListFailedSubmissionsRequest
+	(*ListFailedSubmissionsResponse)(nil), // 34: audit.v1.ListFailedSubmissionsResponse
+	(*RetryFailedSubmissionRequest)(nil),  // 35: audit.v1.RetryFailedSubmissionRequest
+	(*RetryFailedSubmissionResponse)(nil), // 36: audit.v1.RetryFailedSubmissionResponse
+	(*ReportContentRequest)(nil),          // 37: audit.v1.ReportContentRequest
+	(*ReportContentResponse)(nil),         // 38: audit.v1.ReportContentResponse
+	(*SubmitAppealRequest)(nil),           // 39: audit.v1.SubmitAppealRequest
+	(*SubmitAppealResponse)(nil),          // 40: audit.v1.SubmitAppealResponse
+	(*ResolveAppealRequest)(nil),          // 41: audit.v1.ResolveAppealRequest
+	(*ResolveAppealResponse)(nil),         // 42: audit.v1.ResolveAppealResponse
+	(*AuditAppeal)(nil),                   // 43: audit.v1.AuditAppeal
+	(*ListPendingAppealsRequest)(nil),     // 44: audit.v1.ListPendingAppealsRequest
+	(*ListPendingAppealsResponse)(nil),    // 45: audit.v1.ListPendingAppealsResponse
+	(*BatchSubmitItem)(nil),               // 46: audit.v1.BatchSubmitItem
+	(*BatchSubmitContentRequest)(nil),     // 47: audit.v1.BatchSubmitContentRequest
+	(*BatchSubmitContentResponse)(nil),    // 48: audit.v1.BatchSubmitContentResponse
+	nil,                                   // 49: audit.v1.SubmitContentRequest.MetadataEntry
+	nil,                                   // 50: audit.v1.ViolationTrend.CategoriesEntry
+	nil,                                   // 51: audit.v1.BatchSubmitItem.MetadataEntry
+	nil,                                   // 52: audit.v1.BatchSubmitContentRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),         // 53: google.protobuf.Timestamp
 }
 var file_proto_audit_v1_audit_proto_depIdxs = []int32{
 	0,  // 0: audit.v1.SubmitContentRequest.content_type:type_name -> audit.v1.ContentType
-	32, // 1: audit.v1.SubmitContentRequest.metadata:type_name -> audit.v1.SubmitContentRequest.MetadataEntry
+	49, // 1: audit.v1.SubmitContentRequest.metadata:type_name -> audit.v1.SubmitContentRequest.MetadataEntry
 	1,  // 2: audit.v1.SubmitContentResponse.status:type_name -> audit.v1.AuditStatus
 	2,  // 3: audit.v1.SubmitContentResponse.level:type_name -> audit.v1.AuditLevel
-	33, // 4: audit.v1.SubmitContentResponse.created_at:type_name -> google.protobuf.Timestamp
+	53, // 4: audit.v1.SubmitContentResponse.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: audit.v1.GetAuditResultResponse.content_type:type_name -> audit.v1.ContentType
 	1,  // 6: audit.v1.GetAuditResultResponse.status:type_name -> audit.v1.AuditStatus
 	2,  // 7: audit.v1.GetAuditResultResponse.level:type_name -> audit.v1.AuditLevel
-	33, // 8: audit.v1.GetAuditResultResponse.reviewed_at:type_name -> google.protobuf.Timestamp
-	33, // 9: audit.v1.GetAuditResultResponse.created_at:type_name -> google.protobuf.Timestamp
+	53, // 8: audit.v1.GetAuditResultResponse.reviewed_at:type_name -> google.protobuf.Timestamp
+	53, // 9: audit.v1.GetAuditResultResponse.created_at:type_name -> google.protobuf.Timestamp
 	1,  // 10: audit.v1.UpdateAuditStatusRequest.status:type_name -> audit.v1.AuditStatus
 	0,  // 11: audit.v1.ListAuditRecordsRequest.content_type:type_name -> audit.v1.ContentType
 	1,  // 12: audit.v1.ListAuditRecordsRequest.status:type_name -> audit.v1.AuditStatus
@@ -2338,8 +3693,8 @@ var file_proto_audit_v1_audit_proto_depIdxs = []int32{
 	0,  // 14: audit.v1.AuditRecord.content_type:type_name -> audit.v1.ContentType
 	1,  // 15: audit.v1.AuditRecord.status:type_name -> audit.v1.AuditStatus
 	2,  // 16: audit.v1.AuditRecord.level:type_name -> audit.v1.AuditLevel
-	33, // 17: audit.v1.AuditRecord.created_at:type_name -> google.protobuf.Timestamp
-	33, // 18: audit.v1.AuditRecord.reviewed_at:type_name -> google.protobuf.Timestamp
+	53, // 17: audit.v1.AuditRecord.created_at:type_name -> google.protobuf.Timestamp
+	53, // 18: audit.v1.AuditRecord.reviewed_at:type_name -> google.protobuf.Timestamp
 	10, // 19: audit.v1.ListAuditRecordsResponse.records:type_name -> audit.v1.AuditRecord
 	0,  // 20: audit.v1.AddToWhitelistRequest.content_type:type_name -> audit.v1.ContentType
 	0,  // 21: audit.v1.AddToBlacklistRequest.content_type:type_name -> audit.v1.ContentType
@@ -2352,36 +3707,65 @@ var file_proto_audit_v1_audit_proto_depIdxs = []int32{
 	24, // 28: audit.v1.GetAuditStatisticsResponse.status_stats:type_name -> audit.v1.StatusCount
 	25, // 29: audit.v1.GetAuditStatisticsResponse.level_stats:type_name -> audit.v1.LevelCount
 	26, // 30: audit.v1.GetAuditStatisticsResponse.type_stats:type_name -> audit.v1.TypeCount
-	29, // 31: audit.v1.GetViolationTrendsResponse.trends:type_name -> audit.v1.ViolationTrend
-	3,  // 32: audit.v1.AuditService.SubmitContent:input_type -> audit.v1.SubmitContentRequest
-	5,  // 33: audit.v1.AuditService.GetAuditResult:input_type -> audit.v1.GetAuditResultRequest
-	7,  // 34: audit.v1.AuditService.UpdateAuditStatus:input_type -> audit.v1.UpdateAuditStatusRequest
-	9,  // 35: audit.v1.AuditService.ListAuditRecords:input_type -> audit.v1.ListAuditRecordsRequest
-	12, // 36: audit.v1.AuditService.AddToWhitelist:input_type -> audit.v1.AddToWhitelistRequest
-	14, // 37: audit.v1.AuditService.RemoveFromWhitelist:input_type -> audit.v1.RemoveFromWhitelistRequest
-	16, // 38: audit.v1.AuditService.AddToBlacklist:input_type -> audit.v1.AddToBlacklistRequest
-	18, // 39: audit.v1.AuditService.RemoveFromBlacklist:input_type -> audit.v1.RemoveFromBlacklistRequest
-	20, // 40: audit.v1.AuditService.GetManualReviewQueue:input_type -> audit.v1.GetManualReviewQueueRequest
-	22, // 41: audit.v1.AuditService.AssignManualReview:input_type -> audit.v1.AssignManualReviewRequest
-	27, // 42: audit.v1.AuditService.GetAuditStatistics:input_type -> audit.v1.GetAuditStatisticsRequest
-	30, // 43: audit.v1.AuditService.GetViolationTrends:input_type -> audit.v1.GetViolationTrendsRequest
-	4,  // 44: audit.v1.AuditService.SubmitContent:output_type -> audit.v1.SubmitContentResponse
-	6,  // 45: audit.v1.AuditService.GetAuditResult:output_type -> audit.v1.GetAuditResultResponse
-	8,  // 46: audit.v1.AuditService.UpdateAuditStatus:output_type -> audit.v1.UpdateAuditStatusResponse
-	11, // 47: audit.v1.AuditService.ListAuditRecords:output_type -> audit.v1.ListAuditRecordsResponse
-	13, // 48: audit.v1.AuditService.AddToWhitelist:output_type -> audit.v1.AddToWhitelistResponse
-	15, // 49: audit.v1.AuditService.RemoveFromWhitelist:output_type -> audit.v1.RemoveFromWhitelistResponse
-	17, // 50: audit.v1.AuditService.AddToBlacklist:output_type -> audit.v1.AddToBlacklistResponse
-	19, // 51: audit.v1.AuditService.RemoveFromBlacklist:output_type -> audit.v1.RemoveFromBlacklistResponse
-	21, // 52: audit.v1.AuditService.GetManualReviewQueue:output_type -> audit.v1.GetManualReviewQueueResponse
-	23, // 53: audit.v1.AuditService.AssignManualReview:output_type -> audit.v1.AssignManualReviewResponse
-	28, // 54: audit.v1.AuditService.GetAuditStatistics:output_type -> audit.v1.GetAuditStatisticsResponse
-	31, // 55: audit.v1.AuditService.GetViolationTrends:output_type -> audit.v1.GetViolationTrendsResponse
-	44, // [44:56] is the sub-list for method output_type
-	32, // [32:44] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	50, // 31: audit.v1.ViolationTrend.categories:type_name -> audit.v1.ViolationTrend.CategoriesEntry
+	29, // 32: audit.v1.GetViolationTrendsResponse.trends:type_name -> audit.v1.ViolationTrend
+	0,  // 33: audit.v1.FailedSubmission.content_type:type_name -> audit.v1.ContentType
+	53, // 34: audit.v1.FailedSubmission.next_retry_at:type_name -> google.protobuf.Timestamp
+	53, // 35: audit.v1.FailedSubmission.created_at:type_name -> google.protobuf.Timestamp
+	53, // 36: audit.v1.FailedSubmission.updated_at:type_name -> google.protobuf.Timestamp
+	32, // 37: audit.v1.ListFailedSubmissionsResponse.submissions:type_name -> audit.v1.FailedSubmission
+	32, // 38: audit.v1.RetryFailedSubmissionResponse.submission:type_name -> audit.v1.FailedSubmission
+	0,  // 39: audit.v1.ReportContentRequest.content_type:type_name -> audit.v1.ContentType
+	53, // 40: audit.v1.AuditAppeal.created_at:type_name -> google.protobuf.Timestamp
+	43, // 41: audit.v1.ListPendingAppealsResponse.appeals:type_name -> audit.v1.AuditAppeal
+	51, // 42: audit.v1.BatchSubmitItem.metadata:type_name -> audit.v1.BatchSubmitItem.MetadataEntry
+	0,  // 43: audit.v1.BatchSubmitContentRequest.content_type:type_name -> audit.v1.ContentType
+	52, // 44: audit.v1.BatchSubmitContentRequest.metadata:type_name -> audit.v1.BatchSubmitContentRequest.MetadataEntry
+	46, // 45: audit.v1.BatchSubmitContentRequest.items:type_name -> audit.v1.BatchSubmitItem
+	4,  // 46: audit.v1.BatchSubmitContentResponse.results:type_name -> audit.v1.SubmitContentResponse
+	3,  // 47: audit.v1.AuditService.SubmitContent:input_type -> audit.v1.SubmitContentRequest
+	5,  // 48: audit.v1.AuditService.GetAuditResult:input_type -> audit.v1.GetAuditResultRequest
+	7,  // 49: audit.v1.AuditService.UpdateAuditStatus:input_type -> audit.v1.UpdateAuditStatusRequest
+	9,  // 50: audit.v1.AuditService.ListAuditRecords:input_type -> audit.v1.ListAuditRecordsRequest
+	12, // 51: audit.v1.AuditService.AddToWhitelist:input_type -> audit.v1.AddToWhitelistRequest
+	14, // 52: audit.v1.AuditService.RemoveFromWhitelist:input_type -> audit.v1.RemoveFromWhitelistRequest
+	16, // 53: audit.v1.AuditService.AddToBlacklist:input_type -> audit.v1.AddToBlacklistRequest
+	18, // 54: audit.v1.AuditService.RemoveFromBlacklist:input_type -> audit.v1.RemoveFromBlacklistRequest
+	20, // 55: audit.v1.AuditService.GetManualReviewQueue:input_type -> audit.v1.GetManualReviewQueueRequest
+	22, // 56: audit.v1.AuditService.AssignManualReview:input_type -> audit.v1.AssignManualReviewRequest
+	27, // 57: audit.v1.AuditService.GetAuditStatistics:input_type -> audit.v1.GetAuditStatisticsRequest
+	30, // 58: audit.v1.AuditService.GetViolationTrends:input_type -> audit.v1.GetViolationTrendsRequest
+	33, // 59: audit.v1.AuditService.ListFailedSubmissions:input_type -> audit.v1.ListFailedSubmissionsRequest
+	35, // 60: audit.v1.AuditService.RetryFailedSubmission:input_type -> audit.v1.RetryFailedSubmissionRequest
+	37, // 61: audit.v1.AuditService.ReportContent:input_type -> audit.v1.ReportContentRequest
+	39, // 62: audit.v1.AuditService.SubmitAppeal:input_type -> audit.v1.SubmitAppealRequest
+	41, // 63: audit.v1.AuditService.ResolveAppeal:input_type -> audit.v1.ResolveAppealRequest
+	44, // 64: audit.v1.AuditService.ListPendingAppeals:input_type -> audit.v1.ListPendingAppealsRequest
+	47, // 65: audit.v1.AuditService.BatchSubmitContent:input_type -> audit.v1.BatchSubmitContentRequest
+	4,  // 66: audit.v1.AuditService.SubmitContent:output_type -> audit.v1.SubmitContentResponse
+	6,  // 67: audit.v1.AuditService.GetAuditResult:output_type -> audit.v1.GetAuditResultResponse
+	8,  // 68: audit.v1.AuditService.UpdateAuditStatus:output_type -> audit.v1.UpdateAuditStatusResponse
+	11, // 69: audit.v1.AuditService.ListAuditRecords:output_type -> audit.v1.ListAuditRecordsResponse
+	13, // 70: audit.v1.AuditService.AddToWhitelist:output_type -> audit.v1.AddToWhitelistResponse
+	15, // 71: audit.v1.AuditService.RemoveFromWhitelist:output_type -> audit.v1.RemoveFromWhitelistResponse
+	17, // 72: audit.v1.AuditService.AddToBlacklist:output_type -> audit.v1.AddToBlacklistResponse
+	19, // 73: audit.v1.AuditService.RemoveFromBlacklist:output_type -> audit.v1.RemoveFromBlacklistResponse
+	21, // 74: audit.v1.AuditService.GetManualReviewQueue:output_type -> audit.v1.GetManualReviewQueueResponse
+	23, // 75: audit.v1.AuditService.AssignManualReview:output_type -> audit.v1.AssignManualReviewResponse
+	28, // 76: audit.v1.AuditService.GetAuditStatistics:output_type -> audit.v1.GetAuditStatisticsResponse
+	31, // 77: audit.v1.AuditService.GetViolationTrends:output_type -> audit.v1.GetViolationTrendsResponse
+	34, // 78: audit.v1.AuditService.ListFailedSubmissions:output_type -> audit.v1.ListFailedSubmissionsResponse
+	36, // 79: audit.v1.AuditService.RetryFailedSubmission:output_type -> audit.v1.RetryFailedSubmissionResponse
+	38, // 80: audit.v1.AuditService.ReportContent:output_type -> audit.v1.ReportContentResponse
+	40, // 81: audit.v1.AuditService.SubmitAppeal:output_type -> audit.v1.SubmitAppealResponse
+	42, // 82: audit.v1.AuditService.ResolveAppeal:output_type -> audit.v1.ResolveAppealResponse
+	45, // 83: audit.v1.AuditService.ListPendingAppeals:output_type -> audit.v1.ListPendingAppealsResponse
+	48, // 84: audit.v1.AuditService.BatchSubmitContent:output_type -> audit.v1.BatchSubmitContentResponse
+	66, // [66:85] is the sub-list for method output_type
+	47, // [47:66] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_proto_audit_v1_audit_proto_init() }
@@ -2395,7 +3779,7 @@ func file_proto_audit_v1_audit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_audit_v1_audit_proto_rawDesc), len(file_proto_audit_v1_audit_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   30,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
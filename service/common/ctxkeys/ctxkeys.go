@@ -0,0 +1,49 @@
+// Package ctxkeys 统一定义context中保存的值及其读写函数
+//
+// 每个值使用包内未导出的键类型，其他包无法构造相同的键，避免与字符串键或其他包的键冲突。
+package ctxkeys
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type (
+	userIDKey    struct{}
+	requestIDKey struct{}
+	txKey        struct{}
+)
+
+// WithUserID 将当前请求的用户ID绑定到context
+func WithUserID(ctx context.Context, userID uint64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID 获取context绑定的用户ID，未绑定时ok为false
+func UserID(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uint64)
+	return userID, ok
+}
+
+// WithRequestID 将请求ID绑定到context
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID 获取context绑定的请求ID，未绑定时ok为false
+func RequestID(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok
+}
+
+// WithTx 将事务绑定到context，仓库在该context下的读写都使用此事务
+func WithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// Tx 获取context绑定的事务，未绑定或绑定的事务为nil时ok为false
+func Tx(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(txKey{}).(*gorm.DB)
+	return tx, ok && tx != nil
+}
@@ -0,0 +1,58 @@
+package ctxkeys
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestValuesRoundTrip(t *testing.T) {
+	tx := &gorm.DB{}
+	ctx := WithTx(WithRequestID(WithUserID(context.Background(), 42), "req-1"), tx)
+
+	if userID, ok := UserID(ctx); !ok || userID != 42 {
+		t.Errorf("UserID = %d, %v, want 42, true", userID, ok)
+	}
+	if requestID, ok := RequestID(ctx); !ok || requestID != "req-1" {
+		t.Errorf("RequestID = %q, %v, want req-1, true", requestID, ok)
+	}
+	if got, ok := Tx(ctx); !ok || got != tx {
+		t.Errorf("Tx = %p, %v, want %p, true", got, ok, tx)
+	}
+}
+
+func TestMissingValues(t *testing.T) {
+	ctx := context.Background()
+
+	if userID, ok := UserID(ctx); ok || userID != 0 {
+		t.Errorf("UserID = %d, %v, want 0, false", userID, ok)
+	}
+	if requestID, ok := RequestID(ctx); ok || requestID != "" {
+		t.Errorf("RequestID = %q, %v, want empty, false", requestID, ok)
+	}
+	if tx, ok := Tx(ctx); ok || tx != nil {
+		t.Errorf("Tx = %p, %v, want nil, false", tx, ok)
+	}
+	// 绑定nil事务视为未绑定
+	if _, ok := Tx(WithTx(ctx, nil)); ok {
+		t.Errorf("Tx with nil transaction reported ok")
+	}
+}
+
+func TestKeysDoNotCollideWithStringKeys(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "request_id", "raw")
+	ctx = context.WithValue(ctx, "user_id", uint64(7))
+
+	if _, ok := RequestID(ctx); ok {
+		t.Errorf("RequestID read a raw string key")
+	}
+	if _, ok := UserID(ctx); ok {
+		t.Errorf("UserID read a raw string key")
+	}
+
+	ctx = WithRequestID(ctx, "typed")
+	if got := ctx.Value("request_id"); got != "raw" {
+		t.Errorf("raw string key = %v, want raw", got)
+	}
+}
@@ -0,0 +1,229 @@
+// Package health 维护gRPC存活/就绪状态并提供HTTP探针，各服务共用
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"gorm.io/gorm"
+)
+
+// gRPC健康检查中使用的服务名
+// 存活检查只反映进程是否存活，就绪检查反映依赖(DB、Redis、etcd)是否可用
+const (
+	LivenessService  = "liveness"
+	ReadinessService = "readiness"
+)
+
+const (
+	defaultCheckInterval = 10 * time.Second
+	defaultCheckTimeout  = 3 * time.Second
+)
+
+// Logger 就绪状态变化时使用的日志接口，各服务的logger.Logger均满足
+type Logger interface {
+	Info(msg string, fields ...interface{})
+	Warn(msg string, fields ...interface{})
+}
+
+// CheckFunc 依赖检查函数，返回nil表示依赖可用
+type CheckFunc func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker 定期检查依赖并维护gRPC就绪状态
+type Checker struct {
+	server      *health.Server
+	serviceName string
+	interval    time.Duration
+	timeout     time.Duration
+	logger      Logger
+
+	mu           sync.RWMutex
+	checks       []namedCheck
+	ready        bool
+	failures     map[string]string
+	shuttingDown bool
+}
+
+// shutdownFailure 停机后/readyz返回的失败原因
+const shutdownFailure = "service is shutting down"
+
+// NewChecker 创建健康检查器
+// 创建后存活状态即为SERVING，就绪状态在首次检查通过前为NOT_SERVING
+// serviceName对应的状态与就绪状态保持一致，兼容按服务名探测的客户端
+func NewChecker(server *health.Server, serviceName string, interval, timeout time.Duration, log Logger) *Checker {
+	if interval <= 0 {
+		interval = defaultCheckInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+
+	c := &Checker{
+		server:      server,
+		serviceName: serviceName,
+		interval:    interval,
+		timeout:     timeout,
+		logger:      log,
+	}
+	server.SetServingStatus(LivenessService, grpc_health_v1.HealthCheckResponse_SERVING)
+	c.setServingStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// AddCheck 添加依赖检查，需在Start之前调用
+func (c *Checker) AddCheck(name string, check CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Start 立即执行一次检查，之后按间隔定期检查，直到ctx取消
+func (c *Checker) Start(ctx context.Context) {
+	c.CheckNow(ctx)
+
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.CheckNow(ctx)
+			}
+		}
+	}()
+}
+
+// CheckNow 执行所有依赖检查并更新就绪状态，返回是否就绪
+func (c *Checker) CheckNow(ctx context.Context) bool {
+	c.mu.RLock()
+	checks := c.checks
+	c.mu.RUnlock()
+
+	failures := make(map[string]string)
+	for _, nc := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		err := nc.check(checkCtx)
+		cancel()
+		if err != nil {
+			failures[nc.name] = err.Error()
+		}
+	}
+
+	ready := len(failures) == 0
+	c.mu.Lock()
+	if c.shuttingDown {
+		// 停机后定期检查仍可能在运行，不能把就绪状态改回true
+		c.mu.Unlock()
+		return false
+	}
+	changed := c.ready != ready
+	c.ready = ready
+	c.failures = failures
+	c.mu.Unlock()
+
+	if changed {
+		if ready {
+			c.logger.Info("Service is ready", "service", c.serviceName)
+			c.setServingStatus(grpc_health_v1.HealthCheckResponse_SERVING)
+		} else {
+			c.logger.Warn("Service is not ready", "service", c.serviceName, "failures", failures)
+			c.setServingStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+		}
+	}
+	return ready
+}
+
+// Ready 返回当前就绪状态及失败的依赖
+func (c *Checker) Ready() (bool, map[string]string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	failures := make(map[string]string, len(c.failures))
+	for name, reason := range c.failures {
+		failures[name] = reason
+	}
+	return c.ready, failures
+}
+
+// Shutdown 停机时首先调用，将就绪状态置为false、所有gRPC状态置为NOT_SERVING，之后不再更新
+// HTTP探针在排空请求期间继续返回/readyz 503，负载均衡据此摘除流量
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	c.shuttingDown = true
+	c.ready = false
+	c.failures = map[string]string{"shutdown": shutdownFailure}
+	c.mu.Unlock()
+
+	c.server.Shutdown()
+}
+
+// setServingStatus 同时更新就绪状态和服务名状态
+func (c *Checker) setServingStatus(status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	c.server.SetServingStatus(ReadinessService, status)
+	c.server.SetServingStatus(c.serviceName, status)
+}
+
+// Handler 返回HTTP探针处理器：/livez 存活检查，/readyz 就绪检查
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/livez", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": "ok"})
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		ready, failures := c.Ready()
+		if !ready {
+			names := make([]string, 0, len(failures))
+			for name := range failures {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			writeJSON(w, http.StatusServiceUnavailable, map[string]interface{}{
+				"status":   "not_ready",
+				"failed":   names,
+				"failures": failures,
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": "ready"})
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
+
+// DatabaseCheck 数据库连通性检查
+func DatabaseCheck(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+// RedisCheck Redis连通性检查
+func RedisCheck(client redis.UniversalClient) CheckFunc {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
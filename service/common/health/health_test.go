@@ -0,0 +1,169 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...interface{}) {}
+func (nopLogger) Warn(string, ...interface{}) {}
+
+func readiness(t *testing.T, server *health.Server) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: ReadinessService})
+	if err != nil {
+		t.Fatalf("health check: %v", err)
+	}
+	return resp.Status
+}
+
+func TestShutdownMarksNotReadyUntilExit(t *testing.T) {
+	server := health.NewServer()
+	checker := NewChecker(server, "test_service", 0, 0, nopLogger{})
+	checker.AddCheck("database", func(ctx context.Context) error { return nil })
+
+	if !checker.CheckNow(context.Background()) || readiness(t, server) != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatal("checker not ready before shutdown")
+	}
+
+	checker.Shutdown()
+	if ready, failures := checker.Ready(); ready || failures["shutdown"] == "" {
+		t.Fatalf("Ready() after shutdown = %v, %v; want not ready with shutdown reason", ready, failures)
+	}
+
+	// 排空请求期间定期检查仍在运行，依赖正常也不能恢复就绪
+	if checker.CheckNow(context.Background()) {
+		t.Fatal("CheckNow after shutdown reported ready")
+	}
+	if ready, _ := checker.Ready(); ready {
+		t.Fatal("periodic check flipped readiness back to true during shutdown")
+	}
+	if status := readiness(t, server); status != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("gRPC readiness = %v, want NOT_SERVING", status)
+	}
+
+	rec := httptest.NewRecorder()
+	checker.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("/readyz = %d, want 503 while shutting down", rec.Code)
+	}
+}
+
+func TestNotReadyBeforeFirstCheck(t *testing.T) {
+	server := health.NewServer()
+	checker := NewChecker(server, "test_service", 0, 0, nopLogger{})
+
+	if ready, _ := checker.Ready(); ready {
+		t.Fatal("checker ready before the first check")
+	}
+	if status := readiness(t, server); status != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("gRPC readiness = %v before first check, want NOT_SERVING", status)
+	}
+	resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: LivenessService})
+	if err != nil || resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("liveness = %v, %v; want SERVING", resp, err)
+	}
+}
+
+func TestReadinessFailsOnDependencyFailure(t *testing.T) {
+	server := health.NewServer()
+	checker := NewChecker(server, "test_service", 0, 0, nopLogger{})
+	redisErr := errors.New("redis: connection refused")
+	checker.AddCheck("database", func(ctx context.Context) error { return nil })
+	checker.AddCheck("redis", func(ctx context.Context) error { return redisErr })
+
+	if checker.CheckNow(context.Background()) {
+		t.Fatal("CheckNow reported ready with a failing dependency")
+	}
+	ready, failures := checker.Ready()
+	if ready || len(failures) != 1 || failures["redis"] != redisErr.Error() {
+		t.Fatalf("Ready() = %v, %v; want not ready with the redis failure only", ready, failures)
+	}
+	for _, service := range []string{ReadinessService, "test_service"} {
+		resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil || resp.Status != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+			t.Errorf("%s status = %v, %v; want NOT_SERVING", service, resp, err)
+		}
+	}
+	// 依赖失败不影响存活检查，避免进程被反复重启
+	resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: LivenessService})
+	if err != nil || resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("liveness = %v, %v; want SERVING", resp, err)
+	}
+
+	rec := httptest.NewRecorder()
+	checker.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("/readyz = %d, want 503", rec.Code)
+	}
+	var body struct {
+		Status string   `json:"status"`
+		Failed []string `json:"failed"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode /readyz body: %v", err)
+	}
+	if body.Status != "not_ready" || len(body.Failed) != 1 || body.Failed[0] != "redis" {
+		t.Errorf("/readyz body = %+v, want not_ready with failed [redis]", body)
+	}
+	rec = httptest.NewRecorder()
+	checker.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("/livez = %d, want 200", rec.Code)
+	}
+}
+
+func TestReadinessRecoversWhenDependencyRecovers(t *testing.T) {
+	server := health.NewServer()
+	checker := NewChecker(server, "test_service", 0, 0, nopLogger{})
+	var dbErr error = errors.New("database unavailable")
+	checker.AddCheck("database", func(ctx context.Context) error { return dbErr })
+
+	if checker.CheckNow(context.Background()) {
+		t.Fatal("CheckNow reported ready while the database was down")
+	}
+	dbErr = nil
+	if !checker.CheckNow(context.Background()) || readiness(t, server) != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatal("checker did not become ready after the database recovered")
+	}
+	if _, failures := checker.Ready(); len(failures) != 0 {
+		t.Fatalf("failures = %v after recovery, want none", failures)
+	}
+
+	// 就绪后依赖再次失败时重新摘除
+	dbErr = errors.New("database unavailable")
+	if checker.CheckNow(context.Background()) || readiness(t, server) != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatal("checker stayed ready after the database failed again")
+	}
+}
+
+func TestDependencyCheckUsesTimeout(t *testing.T) {
+	server := health.NewServer()
+	checker := NewChecker(server, "test_service", 0, 20*time.Millisecond, nopLogger{})
+	checker.AddCheck("etcd", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	if checker.CheckNow(context.Background()) {
+		t.Fatal("CheckNow reported ready although the check timed out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("hanging check took %v, want it cut off by the check timeout", elapsed)
+	}
+	if _, failures := checker.Ready(); failures["etcd"] != context.DeadlineExceeded.Error() {
+		t.Fatalf("etcd failure = %q, want deadline exceeded", failures["etcd"])
+	}
+}
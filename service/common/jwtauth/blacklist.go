@@ -0,0 +1,38 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// blacklistKeyPrefix token黑名单键前缀，user_service退出登录和挤下线时写入
+const blacklistKeyPrefix = "blacklist:token:"
+
+// BlacklistKey 获取token黑名单键，使用token的SHA-256摘要，避免在Redis中保存完整token
+func BlacklistKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return blacklistKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// redisBlacklist 读取user_service写入Redis的token黑名单
+type redisBlacklist struct {
+	client redis.UniversalClient
+}
+
+// NewRedisBlacklist 创建基于Redis的token黑名单，client需连接user_service使用的Redis
+func NewRedisBlacklist(client redis.UniversalClient) Blacklist {
+	return &redisBlacklist{client: client}
+}
+
+// IsTokenBlacklisted token是否已被加入黑名单
+func (b *redisBlacklist) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	n, err := b.client.Exists(ctx, BlacklistKey(token)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check token blacklist: %w", err)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,134 @@
+// Package jwtauth 校验user_service签发的JWT，各服务使用同一套密钥轮换和黑名单规则
+//
+// token header中的kid与当前密钥或宽限期内的旧密钥匹配时使用该密钥校验；不带kid的token在没有同样不带kid的密钥时
+// 按当前密钥校验，兼容配置kid之前签发的token。
+package jwtauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	// ErrInvalidToken token格式错误、签名无效、已过期或使用了未知/已过宽限期的密钥
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenRevoked token已退出登录或被挤下线
+	ErrTokenRevoked = errors.New("token has been revoked")
+)
+
+// Key JWT签名密钥
+type Key struct {
+	ID        string    // kid，轮换前未配置kid的密钥为空
+	Secret    string    // HMAC密钥
+	ExpiresAt time.Time // 旧密钥的宽限期截止时间，当前密钥为零值
+}
+
+// ParseKey 创建轮换下来的旧密钥，expiresAt为RFC3339格式的宽限期截止时间
+func ParseKey(id, secret, expiresAt string) (Key, error) {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return Key{}, fmt.Errorf("invalid expires_at for jwt key %q: %w", id, err)
+	}
+	return Key{ID: id, Secret: secret, ExpiresAt: t}, nil
+}
+
+// Claims user_service签发的token声明
+type Claims struct {
+	UserID uint32 `json:"user_id"`
+	jwt.RegisteredClaims
+}
+
+// Blacklist token黑名单
+type Blacklist interface {
+	IsTokenBlacklisted(ctx context.Context, token string) (bool, error)
+}
+
+// Verifier JWT校验器
+type Verifier struct {
+	current   Key
+	previous  []Key
+	blacklist Blacklist
+	now       func() time.Time
+}
+
+// NewVerifier 创建校验器，current为当前密钥，previous为轮换下来的旧密钥，宽限期过后不再接受
+// blacklist为nil时不检查黑名单
+func NewVerifier(current Key, previous []Key, blacklist Blacklist) *Verifier {
+	return &Verifier{
+		current:   current,
+		previous:  previous,
+		blacklist: blacklist,
+		now:       time.Now,
+	}
+}
+
+// KeyFunc 按token header中的kid选择校验密钥，只接受HMAC签名
+func (v *Verifier) KeyFunc() jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		key, ok := v.findKey(kid, v.now())
+		if !ok {
+			if kid != "" {
+				return nil, fmt.Errorf("unknown or expired signing key: %s", kid)
+			}
+			key = v.current
+		}
+		return []byte(key.Secret), nil
+	}
+}
+
+// findKey 查找kid对应的可用密钥，已过宽限期的旧密钥视为不存在
+func (v *Verifier) findKey(kid string, now time.Time) (Key, bool) {
+	if v.current.ID == kid {
+		return v.current, true
+	}
+	for _, key := range v.previous {
+		if key.ID == kid && now.Before(key.ExpiresAt) {
+			return key, true
+		}
+	}
+	return Key{}, false
+}
+
+// Parse 校验签名和有效期并返回声明，不检查黑名单
+func (v *Verifier) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, v.KeyFunc())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// Verify 校验token并返回用户ID，已加入黑名单的token返回ErrTokenRevoked
+// 查询黑名单失败时返回错误，调用方应按未认证处理
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (uint32, error) {
+	claims, err := v.Parse(tokenString)
+	if err != nil {
+		return 0, err
+	}
+	if claims.UserID == 0 {
+		return 0, ErrInvalidToken
+	}
+
+	if v.blacklist != nil {
+		revoked, err := v.blacklist.IsTokenBlacklisted(ctx, tokenString)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check token blacklist: %w", err)
+		}
+		if revoked {
+			return 0, ErrTokenRevoked
+		}
+	}
+	return claims.UserID, nil
+}
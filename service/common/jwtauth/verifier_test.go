@@ -0,0 +1,153 @@
+package jwtauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	currentKey = Key{ID: "k2", Secret: "current-secret"}
+	oldKey     = Key{ID: "k1", Secret: "old-secret", ExpiresAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+	legacyKey  = Key{ID: "", Secret: "legacy-secret", ExpiresAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+)
+
+// fakeBlacklist 内存中的token黑名单
+type fakeBlacklist struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (b *fakeBlacklist) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	return b.revoked[token], b.err
+}
+
+// newTestVerifier 创建当前时间固定为now的校验器
+func newTestVerifier(now time.Time, previous []Key, blacklist Blacklist) *Verifier {
+	v := NewVerifier(currentKey, previous, blacklist)
+	v.now = func() time.Time { return now }
+	return v
+}
+
+// sign 用指定密钥签发token，kid为空时header不带kid
+func sign(t *testing.T, key Key, userID uint32, expiresAt time.Time) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		UserID:           userID,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(expiresAt)},
+	})
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	signed, err := token.SignedString([]byte(key.Secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestVerifyHonorsPreviousKeysDuringGraceWindow(t *testing.T) {
+	valid := time.Now().Add(time.Hour)
+	inWindow := oldKey.ExpiresAt.Add(-time.Second)
+	afterWindow := oldKey.ExpiresAt
+
+	cases := []struct {
+		name    string
+		now     time.Time
+		key     Key
+		wantErr bool
+	}{
+		{"current key", afterWindow, currentKey, false},
+		{"previous key inside grace window", inWindow, oldKey, false},
+		{"previous key at end of grace window", afterWindow, oldKey, true},
+		{"unknown kid", inWindow, Key{ID: "k0", Secret: "old-secret"}, true},
+		{"kid with wrong secret", inWindow, Key{ID: "k1", Secret: "current-secret"}, true},
+	}
+	for _, tc := range cases {
+		v := newTestVerifier(tc.now, []Key{oldKey}, nil)
+		userID, err := v.Verify(context.Background(), sign(t, tc.key, 42, valid))
+		if tc.wantErr {
+			if !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("%s: error = %v, want ErrInvalidToken", tc.name, err)
+			}
+			continue
+		}
+		if err != nil || userID != 42 {
+			t.Errorf("%s: Verify = %d, %v, want 42", tc.name, userID, err)
+		}
+	}
+}
+
+func TestVerifyTokensWithoutKid(t *testing.T) {
+	valid := time.Now().Add(time.Hour)
+	inWindow := legacyKey.ExpiresAt.Add(-time.Second)
+
+	// 没有不带kid的旧密钥时按当前密钥校验
+	v := newTestVerifier(inWindow, []Key{oldKey}, nil)
+	if _, err := v.Verify(context.Background(), sign(t, Key{Secret: currentKey.Secret}, 42, valid)); err != nil {
+		t.Errorf("token without kid signed by current key: %v", err)
+	}
+
+	// 配置kid之前的密钥在宽限期内仍可校验不带kid的token，宽限期后回退到当前密钥，旧签名不再通过
+	v = newTestVerifier(inWindow, []Key{legacyKey}, nil)
+	legacy := sign(t, legacyKey, 42, valid)
+	if _, err := v.Verify(context.Background(), legacy); err != nil {
+		t.Errorf("legacy token inside grace window: %v", err)
+	}
+	v = newTestVerifier(legacyKey.ExpiresAt, []Key{legacyKey}, nil)
+	if _, err := v.Verify(context.Background(), legacy); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("legacy token after grace window error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestVerifyRejectsInvalidTokens(t *testing.T) {
+	v := newTestVerifier(time.Now(), nil, nil)
+
+	none, err := jwt.NewWithClaims(jwt.SigningMethodNone, Claims{UserID: 42}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("sign none token: %v", err)
+	}
+	cases := map[string]string{
+		"expired":      sign(t, currentKey, 42, time.Now().Add(-time.Minute)),
+		"no user":      sign(t, currentKey, 0, time.Now().Add(time.Hour)),
+		"alg none":     none,
+		"garbage":      "not-a-token",
+		"empty string": "",
+	}
+	for name, token := range cases {
+		if _, err := v.Verify(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: error = %v, want ErrInvalidToken", name, err)
+		}
+	}
+}
+
+func TestVerifyChecksBlacklist(t *testing.T) {
+	token := sign(t, currentKey, 42, time.Now().Add(time.Hour))
+
+	v := newTestVerifier(time.Now(), nil, &fakeBlacklist{revoked: map[string]bool{token: true}})
+	if _, err := v.Verify(context.Background(), token); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("revoked token error = %v, want ErrTokenRevoked", err)
+	}
+
+	// 黑名单不可用时不能放行
+	v = newTestVerifier(time.Now(), nil, &fakeBlacklist{err: errors.New("redis unavailable")})
+	if _, err := v.Verify(context.Background(), token); err == nil {
+		t.Errorf("Verify succeeded while blacklist was unavailable")
+	}
+
+	// Parse不检查黑名单
+	v = newTestVerifier(time.Now(), nil, &fakeBlacklist{revoked: map[string]bool{token: true}})
+	if claims, err := v.Parse(token); err != nil || claims.UserID != 42 {
+		t.Errorf("Parse = %+v, %v, want user 42", claims, err)
+	}
+}
+
+func TestBlacklistKeyHashesToken(t *testing.T) {
+	key := BlacklistKey("token")
+	if key != "blacklist:token:3c469e9d6c5875d37a43f353d4f88e61fcf812c66eee3457465a40b0da4153e0" {
+		t.Errorf("BlacklistKey = %q", key)
+	}
+}
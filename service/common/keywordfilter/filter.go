@@ -0,0 +1,119 @@
+// Package keywordfilter 多语言关键词过滤，匹配前对关键词和文本做统一的规范化，识别形近字符、全角字符和逐字母拆开的规避写法
+// 以空格分词的文字按整词匹配，关键词不会命中更长单词的一部分；中日韩文字按子串匹配
+package keywordfilter
+
+import (
+	"sort"
+	"strings"
+)
+
+// Match 命中的关键词
+type Match struct {
+	Language string // 关键词所属的语言分组
+	Keyword  string // 配置中的原始关键词
+}
+
+// entry 规范化后的关键词
+type entry struct {
+	Match
+	tokens []string
+}
+
+// Filter 关键词过滤器，创建后只读，可并发使用
+type Filter struct {
+	entries []entry
+}
+
+// New 按语言分组的关键词创建过滤器，分组的键为语言代码(如zh、en、ru)
+// 所有分组都会用于匹配，混合语言的文本也能命中；规范化后为空或重复的关键词会被忽略
+func New(sets map[string][]string) *Filter {
+	languages := make([]string, 0, len(sets))
+	for lang := range sets {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+
+	f := &Filter{}
+	seen := make(map[string]bool)
+	for _, lang := range languages {
+		for _, keyword := range sets[lang] {
+			normalized := Normalize(keyword)
+			if normalized == "" || seen[normalized] {
+				continue
+			}
+			seen[normalized] = true
+			f.entries = append(f.entries, entry{
+				Match:  Match{Language: lang, Keyword: keyword},
+				tokens: strings.Fields(normalized),
+			})
+		}
+	}
+	return f
+}
+
+// Match 返回文本命中的关键词，按配置顺序排列，未命中时返回nil
+func (f *Filter) Match(text string) []Match {
+	if f == nil || len(f.entries) == 0 {
+		return nil
+	}
+	tokens := tokenize(text)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var matches []Match
+	for _, e := range f.entries {
+		if containsKeyword(tokens, e.tokens) {
+			matches = append(matches, e.Match)
+		}
+	}
+	return matches
+}
+
+// containsKeyword 判断文本中是否有与关键词逐词相同的连续词
+// 单个词的关键词还可出现在中日韩文字或逐字母拆开的词中任意位置
+func containsKeyword(tokens []token, keyword []string) bool {
+	if len(keyword) == 1 {
+		for _, t := range tokens {
+			if t.text == keyword[0] || t.loose && strings.Contains(t.text, keyword[0]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := 0; i+len(keyword) <= len(tokens); i++ {
+		matched := true
+		for j, k := range keyword {
+			if tokens[i+j].text != k {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Keywords 返回所有生效的原始关键词
+func (f *Filter) Keywords() []string {
+	if f == nil {
+		return []string{}
+	}
+	keywords := make([]string, 0, len(f.entries))
+	for _, e := range f.entries {
+		keywords = append(keywords, e.Keyword)
+	}
+	return keywords
+}
+
+// Keywords 返回命中关键词的原始写法
+func Keywords(matches []Match) []string {
+	keywords := make([]string, 0, len(matches))
+	for _, m := range matches {
+		keywords = append(keywords, m.Keyword)
+	}
+	return keywords
+}
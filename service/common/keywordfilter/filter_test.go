@@ -0,0 +1,104 @@
+package keywordfilter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"Hello, World!":  "hello world",
+		"ＦＵＣＫ":           "fuck",
+		"Café naïve":     "cafe naive",
+		"fuсk":           "fuck", // 西里尔字母с
+		"sh1t 2024":      "shit 2024",
+		"sh!t":           "shit",
+		"@ss":            "ass",
+		"wow!!!":         "wow",
+		"f u c k":        "fuck",
+		"f.u.c.k you":    "fuck you",
+		"傻 逼":            "傻逼",
+		"你好hello世界":      "你好 hello 世界",
+		"がんばって":          "がんばって",
+		"バーカ":            "バーカ",
+		"classic skill":  "classic skill",
+		"  \t\n ":        "",
+		"kill|ing":       "killiing",
+		"a $ b":          "ab",
+		"no-no":          "no no",
+		"ｈｔｔｐ://x.com":   "http x com",
+		"stop... please": "stop please",
+	}
+	for in, want := range cases {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMatchCatchesEvasion(t *testing.T) {
+	f := New(map[string][]string{
+		"en": {"fuck", "ass", "son of a bitch"},
+		"zh": {"傻逼"},
+		"ja": {"バカ"},
+	})
+	cases := map[string][]string{
+		"fuck you":            {"fuck"},
+		"FUCK":                {"fuck"},
+		"ｆｕｃｋ":                {"fuck"},
+		"fuсk":                {"fuck"}, // 西里尔字母с
+		"f u c k off":         {"fuck"},
+		"f.u.c.k":             {"fuck"},
+		"what a f-u-c-k":      {"fuck"},
+		"@ss":                 {"ass"},
+		"kiss my a s s":       {"ass"},
+		"you SON of a b1tch!": {"son of a bitch"},
+		"你是傻逼吧":               {"傻逼"},
+		"你是傻 逼":               {"傻逼"},
+		"お前バカだな":              {"バカ"},
+	}
+	for text, want := range cases {
+		if got := Keywords(f.Match(text)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Match(%q) = %v, want %v", text, got, want)
+		}
+	}
+}
+
+func TestMatchOnWordBoundaries(t *testing.T) {
+	f := New(map[string][]string{
+		"en": {"ass", "hell", "fuck", "son of a bitch"},
+	})
+	for _, text := range []string{
+		"a classic assignment",
+		"hello shell",
+		"assassin",
+		"skill and kill",
+		"Scunthorpe",
+		"as s",
+		"wow!!! nice",
+		"son of a gun",
+		"2024 was 1337",
+	} {
+		if matches := f.Match(text); len(matches) != 0 {
+			t.Errorf("Match(%q) = %v, want no match", text, Keywords(matches))
+		}
+	}
+}
+
+func TestNewSkipsEmptyAndDuplicateKeywords(t *testing.T) {
+	f := New(map[string][]string{
+		"en": {"Spam", " ", "!!!"},
+		"ru": {"SPAM"},
+	})
+	if got := f.Keywords(); !reflect.DeepEqual(got, []string{"Spam"}) {
+		t.Errorf("Keywords() = %v, want [Spam]", got)
+	}
+	if matches := f.Match("spam!"); len(matches) != 1 || matches[0].Language != "en" {
+		t.Errorf("Match(spam!) = %v, want en match", matches)
+	}
+
+	var nilFilter *Filter
+	if matches := nilFilter.Match("spam"); matches != nil {
+		t.Errorf("nil filter Match = %v, want nil", matches)
+	}
+}
@@ -0,0 +1,193 @@
+package keywordfilter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// letterHomoglyphs 形近字母到拉丁字母的映射，覆盖常见的西里尔、希腊字母替换
+// 映射在转小写之后进行，只需列出小写形式
+var letterHomoglyphs = map[rune]rune{
+	// 西里尔字母
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o',
+	'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ї': 'i',
+	'ј': 'j', 'ԁ': 'd', 'һ': 'h', 'ԛ': 'q', 'ԝ': 'w',
+	// 希腊字母
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o',
+	'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x', 'ω': 'w',
+}
+
+// digitHomoglyphs 数字到拉丁字母的映射，只用于同时含有字母的词(如"sh1t")，纯数字保持原样
+var digitHomoglyphs = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't',
+}
+
+// symbolHomoglyphs 符号到拉丁字母的映射，只在符号后紧跟字母或数字时生效(如"@ss"、"sh!t")，
+// 句末的"!"等仍作为分隔符
+var symbolHomoglyphs = map[rune]rune{
+	'@': 'a', '$': 's', '!': 'i', '|': 'i',
+}
+
+// token 规范化后的词
+type token struct {
+	text string
+	// loose 为true时关键词可出现在词中任意位置：中日韩文字不以空格分词，连续的文字作为一个词；
+	// 逐字母拆开的写法(如"f u c k")合并后的词也按此匹配
+	loose bool
+}
+
+// Normalize 规范化文本用于关键词匹配，返回以空格分隔的词，关键词和待检测文本使用同一规则
+//  1. 兼容分解(NFKD)，全角字符、上下标、连字等转为基本字符
+//  2. 去掉拉丁、希腊、西里尔字母上的变音符号
+//  3. 转小写并映射形近字母，含字母的词中的数字和词内符号按形近字母映射
+//  4. 按空白、标点和符号分词，中日韩文字之间的分隔符去掉，连续的单字母合并为一个词
+func Normalize(s string) string {
+	tokens := tokenize(s)
+	texts := make([]string, len(tokens))
+	for i, t := range tokens {
+		texts[i] = t.text
+	}
+	return strings.Join(texts, " ")
+}
+
+// tokenize 将文本规范化并分词
+func tokenize(s string) []token {
+	runes := []rune(norm.NFKD.String(s))
+	var (
+		tokens []token
+		word   []rune
+		cjk    []rune
+		last   rune // 上一个写入词中的字符，用于判断组合符号的归属
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, token{text: norm.NFC.String(string(foldDigits(word)))})
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		if len(cjk) > 0 {
+			tokens = append(tokens, token{text: norm.NFC.String(string(cjk)), loose: true})
+			cjk = cjk[:0]
+		}
+	}
+
+	for i, r := range runes {
+		if unicode.Is(unicode.Mn, r) {
+			// 拉丁、希腊、西里尔字母的变音符号去掉，其他文字(如日文浊音)的组合符号保留
+			switch {
+			case last == 0 || isAlphabetic(last):
+			case isCJK(last):
+				cjk = append(cjk, r)
+			default:
+				word = append(word, r)
+			}
+			continue
+		}
+
+		r = unicode.ToLower(r)
+		if m, ok := letterHomoglyphs[r]; ok {
+			r = m
+		}
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if len(word) == 0 {
+				flushCJK()
+			}
+			word = append(word, r)
+		case symbolHomoglyphs[r] != 0 && symbolInWord(runes, i):
+			if len(word) == 0 {
+				flushCJK()
+			}
+			r = symbolHomoglyphs[r]
+			word = append(word, r)
+		default:
+			// 分隔符结束当前词，中日韩文字之间的分隔符直接去掉
+			flushWord()
+			last = 0
+			continue
+		}
+		last = r
+	}
+	flushWord()
+	flushCJK()
+	return collapseLetters(tokens)
+}
+
+// symbolInWord 判断runes[i]起的连续符号后是否紧跟字母或数字，是则这些符号按形近字母处理
+func symbolInWord(runes []rune, i int) bool {
+	for ; i < len(runes); i++ {
+		r := unicode.ToLower(runes[i])
+		if symbolHomoglyphs[r] != 0 {
+			continue
+		}
+		return (unicode.IsLetter(r) || unicode.IsNumber(r)) && !isCJK(r)
+	}
+	return false
+}
+
+// foldDigits 词中含有字母时将数字按形近字母映射
+func foldDigits(word []rune) []rune {
+	hasLetter := false
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+			break
+		}
+	}
+	if !hasLetter {
+		return word
+	}
+	folded := make([]rune, len(word))
+	for i, r := range word {
+		if m, ok := digitHomoglyphs[r]; ok {
+			r = m
+		}
+		folded[i] = r
+	}
+	return folded
+}
+
+// collapseLetters 将连续两个以上的单字符词合并为一个词，识别"f u c k"、"f.u.c.k"之类逐字母拆开的写法
+func collapseLetters(tokens []token) []token {
+	collapsed := make([]token, 0, len(tokens))
+	for i := 0; i < len(tokens); {
+		j := i
+		for j < len(tokens) && isSingleLetter(tokens[j]) {
+			j++
+		}
+		if j-i < 2 {
+			collapsed = append(collapsed, tokens[i])
+			i++
+			continue
+		}
+		var b strings.Builder
+		for _, t := range tokens[i:j] {
+			b.WriteString(t.text)
+		}
+		collapsed = append(collapsed, token{text: b.String(), loose: true})
+		i = j
+	}
+	return collapsed
+}
+
+// isSingleLetter 是否为单个字符的非中日韩词
+func isSingleLetter(t token) bool {
+	return !t.loose && utf8.RuneCountInString(t.text) == 1
+}
+
+// isAlphabetic 是否为带变音符号时需要去掉符号的字母
+func isAlphabetic(r rune) bool {
+	return unicode.In(r, unicode.Latin, unicode.Greek, unicode.Cyrillic)
+}
+
+// isCJK 是否为不以空格分词的中日韩文字，片假名长音符属于通用字符，需单独列出
+func isCJK(r rune) bool {
+	return r == 'ー' || unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
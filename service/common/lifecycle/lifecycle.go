@@ -0,0 +1,133 @@
+// Package lifecycle 按固定顺序执行服务优雅退出步骤，各服务共用
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultStepTimeout 单个退出步骤的默认超时时间
+const DefaultStepTimeout = 10 * time.Second
+
+// Logger 记录退出步骤的日志接口，各服务的logger.Logger均满足
+type Logger interface {
+	Info(msg string, fields ...interface{})
+	Error(msg string, fields ...interface{})
+}
+
+// StepFunc 退出步骤，ctx到期后应尽快返回
+type StepFunc func(ctx context.Context) error
+
+type step struct {
+	name    string
+	timeout time.Duration
+	fn      StepFunc
+}
+
+// Shutdown 优雅退出流程
+// 步骤按添加顺序依次执行，前一步完成或超时后才执行下一步。标准顺序为：
+// 健康检查置为未就绪 -> gRPC停止接收并排空请求 -> 关闭Redis/DB/etcd等依赖 -> 最后停止健康检查和HTTP探针，
+// 保证依赖在所有在途请求处理完成后才关闭，且整个退出期间探针都报告未就绪
+type Shutdown struct {
+	logger  Logger
+	timeout time.Duration
+	steps   []step
+}
+
+// New 创建退出流程，timeout<=0时使用默认单步超时
+func New(log Logger, timeout time.Duration) *Shutdown {
+	if timeout <= 0 {
+		timeout = DefaultStepTimeout
+	}
+	return &Shutdown{logger: log, timeout: timeout}
+}
+
+// Add 追加退出步骤，使用默认单步超时
+func (s *Shutdown) Add(name string, fn StepFunc) {
+	s.AddWithTimeout(name, s.timeout, fn)
+}
+
+// AddWithTimeout 追加退出步骤并指定超时
+func (s *Shutdown) AddWithTimeout(name string, timeout time.Duration, fn StepFunc) {
+	s.steps = append(s.steps, step{name: name, timeout: timeout, fn: fn})
+}
+
+// Run 依次执行所有退出步骤
+// 单步失败或超时只记录日志并继续执行后续步骤，避免一个依赖卡住导致其余资源无法释放
+func (s *Shutdown) Run() {
+	for i, st := range s.steps {
+		start := time.Now()
+		s.logger.Info("Shutdown step started", "step", i+1, "name", st.name)
+
+		if err := s.runStep(st); err != nil {
+			s.logger.Error("Shutdown step failed", "step", i+1, "name", st.name, "error", err, "duration", time.Since(start))
+			continue
+		}
+		s.logger.Info("Shutdown step completed", "step", i+1, "name", st.name, "duration", time.Since(start))
+	}
+}
+
+// runStep 在超时时间内执行步骤，超时后不再等待步骤返回
+func (s *Shutdown) runStep(st step) error {
+	ctx, cancel := context.WithTimeout(context.Background(), st.timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- st.fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitForSignal 阻塞直到收到SIGINT或SIGTERM
+func WaitForSignal() os.Signal {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+	return <-sigChan
+}
+
+// GRPCServer gRPC服务退出步骤：停止接收新请求并等待在途请求完成，超时后强制关闭
+func GRPCServer(server *grpc.Server) StepFunc {
+	return func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// HTTPServer HTTP服务退出步骤，等待在途请求完成后关闭
+func HTTPServer(server *http.Server) StepFunc {
+	return func(ctx context.Context) error {
+		return server.Shutdown(ctx)
+	}
+}
+
+// Closer 将无参数的关闭函数包装为退出步骤
+func Closer(closeFn func() error) StepFunc {
+	return func(ctx context.Context) error {
+		return closeFn()
+	}
+}
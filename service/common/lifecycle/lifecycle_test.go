@@ -0,0 +1,143 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+
+	healthcheck "common/health"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+func TestRunExecutesStepsInOrder(t *testing.T) {
+	var order []string
+	record := func(name string, err error) StepFunc {
+		return func(ctx context.Context) error {
+			order = append(order, name)
+			return err
+		}
+	}
+
+	shutdown := New(nopLogger{}, time.Second)
+	shutdown.Add("health", record("health", nil))
+	shutdown.Add("grpc", record("grpc", nil))
+	// 单步失败不影响后续依赖的释放
+	shutdown.Add("redis", record("redis", errors.New("close failed")))
+	shutdown.Add("database", record("database", nil))
+	shutdown.Run()
+
+	want := []string{"health", "grpc", "redis", "database"}
+	if !reflect.DeepEqual(order, want) {
+		t.Fatalf("steps ran in %v, want %v", order, want)
+	}
+}
+
+func TestRunMovesOnAfterStepTimeout(t *testing.T) {
+	started := make(chan struct{})
+	blocked := make(chan struct{})
+	defer close(blocked)
+	redisClosed := false
+
+	shutdown := New(nopLogger{}, time.Second)
+	shutdown.AddWithTimeout("grpc", 20*time.Millisecond, func(ctx context.Context) error {
+		close(started)
+		<-blocked
+		return nil
+	})
+	shutdown.Add("redis", func(ctx context.Context) error {
+		<-started
+		redisClosed = true
+		return nil
+	})
+
+	start := time.Now()
+	shutdown.Run()
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("Run waited %v for a timed-out step", elapsed)
+	}
+	if !redisClosed {
+		t.Fatal("step after a timed-out step did not run")
+	}
+}
+
+// TestStandardShutdownOrderKeepsProbesNotReady 按服务的标准退出顺序执行：
+// 先置为未就绪，再排空请求、关闭依赖，最后停止健康检查，整个过程中/readyz都返回503
+func TestStandardShutdownOrderKeepsProbesNotReady(t *testing.T) {
+	server := health.NewServer()
+	checker := healthcheck.NewChecker(server, "test_service", time.Hour, time.Second, nopLogger{})
+	checker.AddCheck("database", func(ctx context.Context) error { return nil })
+	healthCtx, cancelHealth := context.WithCancel(context.Background())
+	defer cancelHealth()
+	checker.Start(healthCtx)
+	probes := checker.Handler()
+
+	readyz := func() int {
+		rec := httptest.NewRecorder()
+		probes.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return rec.Code
+	}
+	if code := readyz(); code != http.StatusOK {
+		t.Fatalf("/readyz before shutdown = %d, want 200", code)
+	}
+
+	var order []string
+	notReady := func(name string) StepFunc {
+		return func(ctx context.Context) error {
+			order = append(order, name)
+			// 依赖检查仍在运行，也不能把就绪状态改回true
+			checker.CheckNow(ctx)
+			if code := readyz(); code != http.StatusServiceUnavailable {
+				t.Errorf("/readyz during %s = %d, want 503", name, code)
+			}
+			return nil
+		}
+	}
+
+	shutdown := New(nopLogger{}, time.Second)
+	shutdown.Add("health", func(ctx context.Context) error {
+		order = append(order, "health")
+		checker.Shutdown()
+		return nil
+	})
+	shutdown.Add("grpc", notReady("grpc"))
+	shutdown.Add("redis", notReady("redis"))
+	shutdown.Add("database", notReady("database"))
+	shutdown.Add("health_checker", func(ctx context.Context) error {
+		order = append(order, "health_checker")
+		cancelHealth()
+		return nil
+	})
+	shutdown.Run()
+
+	want := []string{"health", "grpc", "redis", "database", "health_checker"}
+	if !reflect.DeepEqual(order, want) {
+		t.Fatalf("steps ran in %v, want %v", order, want)
+	}
+	if code := readyz(); code != http.StatusServiceUnavailable {
+		t.Fatalf("/readyz after shutdown = %d, want 503", code)
+	}
+}
+
+func TestCloserAndHTTPServerSteps(t *testing.T) {
+	closed := false
+	if err := Closer(func() error { closed = true; return nil })(context.Background()); err != nil || !closed {
+		t.Fatalf("Closer step = %v, closed=%v", err, closed)
+	}
+
+	srv := &http.Server{}
+	if err := HTTPServer(srv)(context.Background()); err != nil {
+		t.Fatalf("HTTPServer step on idle server: %v", err)
+	}
+}
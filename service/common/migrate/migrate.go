@@ -0,0 +1,118 @@
+// Package migrate 按配置执行AutoMigrate并记录表结构差异，各服务共用
+package migrate
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Logger 迁移使用的日志接口，各服务的logger.Logger均满足
+type Logger interface {
+	Debug(msg string, fields ...interface{})
+	Info(msg string, fields ...interface{})
+	Warn(msg string, fields ...interface{})
+}
+
+// Options 迁移选项
+type Options struct {
+	// AllowDestructive 是否允许删除模型中已不存在的列，默认只记录不删除
+	AllowDestructive bool
+}
+
+// TableDiff 单张表的结构差异
+type TableDiff struct {
+	Table        string
+	Create       bool     // 表不存在，需要新建
+	AddColumns   []string // 模型中新增的列
+	ExtraColumns []string // 数据库中多余的列(模型已删除)
+}
+
+// Empty 是否无差异
+func (d TableDiff) Empty() bool {
+	return !d.Create && len(d.AddColumns) == 0 && len(d.ExtraColumns) == 0
+}
+
+// Run 对给定模型执行AutoMigrate并记录结构差异
+// GORM的AutoMigrate本身不会删除列，多余的列仅在AllowDestructive开启时删除
+func Run(db *gorm.DB, log Logger, opts Options, models ...interface{}) error {
+	for _, m := range models {
+		diff, err := Diff(db, m)
+		if err != nil {
+			return err
+		}
+
+		if diff.Empty() {
+			log.Debug("Schema up to date", "table", diff.Table)
+		} else {
+			log.Info("Schema diff detected",
+				"table", diff.Table,
+				"create", diff.Create,
+				"add_columns", diff.AddColumns,
+				"extra_columns", diff.ExtraColumns,
+			)
+		}
+
+		if err := db.AutoMigrate(m); err != nil {
+			return fmt.Errorf("failed to migrate table %s: %w", diff.Table, err)
+		}
+
+		if len(diff.ExtraColumns) == 0 {
+			continue
+		}
+		if !opts.AllowDestructive {
+			log.Warn("Skip dropping extra columns, allow_destructive is disabled",
+				"table", diff.Table, "columns", diff.ExtraColumns)
+			continue
+		}
+		for _, column := range diff.ExtraColumns {
+			if err := db.Migrator().DropColumn(m, column); err != nil {
+				return fmt.Errorf("failed to drop column %s.%s: %w", diff.Table, column, err)
+			}
+			log.Warn("Dropped column", "table", diff.Table, "column", column)
+		}
+	}
+
+	return nil
+}
+
+// Diff 比较模型与数据库中的表结构
+func Diff(db *gorm.DB, model interface{}) (TableDiff, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return TableDiff{}, fmt.Errorf("failed to parse model %T: %w", model, err)
+	}
+
+	diff := TableDiff{Table: stmt.Schema.Table}
+	migrator := db.Migrator()
+	if !migrator.HasTable(model) {
+		diff.Create = true
+		return diff, nil
+	}
+
+	columnTypes, err := migrator.ColumnTypes(model)
+	if err != nil {
+		return TableDiff{}, fmt.Errorf("failed to load columns of %s: %w", diff.Table, err)
+	}
+
+	existing := make(map[string]struct{}, len(columnTypes))
+	for _, ct := range columnTypes {
+		existing[ct.Name()] = struct{}{}
+	}
+
+	expected := make(map[string]struct{}, len(stmt.Schema.DBNames))
+	for _, name := range stmt.Schema.DBNames {
+		expected[name] = struct{}{}
+		if _, ok := existing[name]; !ok {
+			diff.AddColumns = append(diff.AddColumns, name)
+		}
+	}
+
+	for _, ct := range columnTypes {
+		if _, ok := expected[ct.Name()]; !ok {
+			diff.ExtraColumns = append(diff.ExtraColumns, ct.Name())
+		}
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,156 @@
+package migrate
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// recordingLogger 测试用日志，记录Warn消息
+type recordingLogger struct {
+	warnings []string
+}
+
+func (l *recordingLogger) Debug(string, ...interface{})      {}
+func (l *recordingLogger) Info(string, ...interface{})       {}
+func (l *recordingLogger) Warn(msg string, _ ...interface{}) { l.warnings = append(l.warnings, msg) }
+
+type testUser struct {
+	ID    uint
+	Name  string
+	Email string
+}
+
+// columnType gorm.ColumnType自身有ColumnType方法，通过别名嵌入避免字段与方法同名
+type columnType = gorm.ColumnType
+
+// fakeColumn 只提供列名
+type fakeColumn struct {
+	columnType
+	name string
+}
+
+func (c fakeColumn) Name() string { return c.name }
+
+// fakeMigrator 按预置的表结构回答查询，并记录AutoMigrate和DropColumn调用
+type fakeMigrator struct {
+	gorm.Migrator
+	columns    []string // nil表示表不存在
+	migrated   int
+	dropped    []string
+	migrateErr error
+}
+
+func (m *fakeMigrator) HasTable(dst interface{}) bool { return m.columns != nil }
+
+func (m *fakeMigrator) ColumnTypes(dst interface{}) ([]gorm.ColumnType, error) {
+	types := make([]gorm.ColumnType, 0, len(m.columns))
+	for _, name := range m.columns {
+		types = append(types, fakeColumn{name: name})
+	}
+	return types, nil
+}
+
+func (m *fakeMigrator) AutoMigrate(dst ...interface{}) error {
+	m.migrated++
+	return m.migrateErr
+}
+
+func (m *fakeMigrator) DropColumn(dst interface{}, name string) error {
+	m.dropped = append(m.dropped, name)
+	return nil
+}
+
+// fakeDialector 不连接数据库，迁移操作交给fakeMigrator
+type fakeDialector struct {
+	migrator *fakeMigrator
+}
+
+func (d fakeDialector) Name() string                                          { return "fake" }
+func (d fakeDialector) Initialize(*gorm.DB) error                             { return nil }
+func (d fakeDialector) Migrator(*gorm.DB) gorm.Migrator                       { return d.migrator }
+func (d fakeDialector) DataTypeOf(*schema.Field) string                       { return "" }
+func (d fakeDialector) DefaultValueOf(*schema.Field) clause.Expression        { return nil }
+func (d fakeDialector) BindVarTo(clause.Writer, *gorm.Statement, interface{}) {}
+func (d fakeDialector) QuoteTo(w clause.Writer, s string)                     { w.WriteString(s) }
+func (d fakeDialector) Explain(sql string, vars ...interface{}) string        { return sql }
+
+func openFake(t *testing.T, columns []string) (*gorm.DB, *fakeMigrator) {
+	t.Helper()
+	migrator := &fakeMigrator{columns: columns}
+	db, err := gorm.Open(fakeDialector{migrator: migrator}, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open fake db: %v", err)
+	}
+	return db, migrator
+}
+
+func TestDiff(t *testing.T) {
+	db, _ := openFake(t, nil)
+	diff, err := Diff(db, &testUser{})
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	if diff.Table != "test_users" || !diff.Create || diff.Empty() {
+		t.Errorf("missing table diff = %+v, want create test_users", diff)
+	}
+
+	db, _ = openFake(t, []string{"id", "name", "legacy_score"})
+	diff, err = Diff(db, &testUser{})
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	if diff.Create || !reflect.DeepEqual(diff.AddColumns, []string{"email"}) || !reflect.DeepEqual(diff.ExtraColumns, []string{"legacy_score"}) {
+		t.Errorf("diff = %+v, want add [email] and extra [legacy_score]", diff)
+	}
+
+	db, _ = openFake(t, []string{"id", "name", "email"})
+	if diff, _ := Diff(db, &testUser{}); !diff.Empty() {
+		t.Errorf("up-to-date table diff = %+v, want empty", diff)
+	}
+}
+
+func TestRunSkipsDropsUnlessAllowed(t *testing.T) {
+	db, migrator := openFake(t, []string{"id", "name", "email", "legacy_score"})
+	log := &recordingLogger{}
+
+	if err := Run(db, log, Options{}, &testUser{}); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if migrator.migrated != 1 {
+		t.Errorf("AutoMigrate called %d times, want 1", migrator.migrated)
+	}
+	if len(migrator.dropped) != 0 {
+		t.Fatalf("dropped %v with allow_destructive disabled", migrator.dropped)
+	}
+	if len(log.warnings) != 1 {
+		t.Errorf("warnings = %v, want one skipped-drop warning", log.warnings)
+	}
+}
+
+func TestRunDropsExtraColumnsWhenAllowed(t *testing.T) {
+	db, migrator := openFake(t, []string{"id", "name", "email", "legacy_score", "legacy_rank"})
+
+	if err := Run(db, &recordingLogger{}, Options{AllowDestructive: true}, &testUser{}); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if !reflect.DeepEqual(migrator.dropped, []string{"legacy_score", "legacy_rank"}) {
+		t.Fatalf("dropped = %v, want [legacy_score legacy_rank]", migrator.dropped)
+	}
+}
+
+func TestRunStopsOnMigrateError(t *testing.T) {
+	db, migrator := openFake(t, []string{"id", "name", "email", "legacy_score"})
+	migrator.migrateErr = errors.New("alter table failed")
+
+	if err := Run(db, &recordingLogger{}, Options{AllowDestructive: true}, &testUser{}); !errors.Is(err, migrator.migrateErr) {
+		t.Fatalf("Run error = %v, want the AutoMigrate error", err)
+	}
+	if len(migrator.dropped) != 0 {
+		t.Fatalf("dropped %v after AutoMigrate failed", migrator.dropped)
+	}
+}
@@ -7,20 +7,29 @@ import (
 	"live_service/internal/model"
 	"log"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
+	"net/http"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"gorm.io/gorm"
+
+	"common/ctxkeys"
+	healthcheck "common/health"
+	"common/lifecycle"
+	"common/migrate"
 
 	"live_service/internal/config"
 	"live_service/internal/handler"
 	"live_service/pkg/database"
+	"live_service/pkg/grpctls"
+	"live_service/pkg/interceptors"
 	"live_service/pkg/logger"
+	"live_service/pkg/retry"
 	"live_service/proto/proto_gen"
 	// 使用审计服务客户端
 	auditclient "live_service/internal/client"
@@ -42,6 +51,10 @@ func main() {
 		Level:      cfg.Logger.Level,
 		Format:     cfg.Logger.Format,
 		OutputPath: cfg.Logger.OutputPath,
+		MaxSize:    cfg.Logger.MaxSize,
+		MaxAge:     cfg.Logger.MaxAge,
+		MaxBackups: cfg.Logger.MaxBackups,
+		Compress:   cfg.Logger.Compress,
 	})
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
@@ -53,51 +66,100 @@ func main() {
 	log.Printf("Attempting to connect to database")
 	log.Printf("Database config: Host=%s, Port=%d, Username=%s, Database=%s",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.Username, cfg.Database.Database)
-	db, err := database.NewMySQLConnection(cfg.Database)
+	connectRetry := retry.Options{
+		Attempts:    cfg.ConnectRetry.Attempts,
+		Interval:    cfg.ConnectRetry.Interval,
+		MaxInterval: cfg.ConnectRetry.MaxInterval,
+	}
+	db, err := retry.Do(context.Background(), connectRetry, logger, "mysql", func() (*gorm.DB, error) {
+		return database.NewMySQLConnection(cfg.Database)
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	logger.Info("Database connected successfully")
-	defer func() {
-		sqlDB, _ := db.DB()
-		if sqlDB != nil {
-			sqlDB.Close()
-		}
-	}()
 
 	// 设置模型数据库连接
 	model.SetDB(db)
 	logger.Info("Database models initialized successfully")
 
+	// 按配置自动迁移表结构
+	if cfg.Database.AutoMigrate {
+		if err := migrate.Run(db, logger, migrate.Options{AllowDestructive: cfg.Database.AllowDestructive}, model.Models()...); err != nil {
+			logger.Fatal("Failed to migrate database", "error", err)
+		}
+		logger.Info("Database migrated successfully")
+	}
+
 	// 4. 初始化Redis连接
-	redisClient, err := database.NewRedisClient(cfg.Redis)
+	redisClient, err := retry.Do(context.Background(), connectRetry, logger, "redis", func() (redis.UniversalClient, error) {
+		return database.NewRedisClient(cfg.Redis)
+	})
 	if err != nil {
 		logger.Fatal("Failed to connect to redis", "error", err)
 	}
 	logger.Info("Redis connected successfully")
-	defer redisClient.Close()
 
 	// 5. 初始化etcd服务注册
-	etcdDiscovery, err := discovery.NewEtcdDiscovery(cfg.Etcd.Endpoints, "live-service")
+	etcdDiscovery, err := retry.Do(context.Background(), connectRetry, logger, "etcd", func() (*discovery.EtcdDiscovery, error) {
+		return discovery.NewEtcdDiscovery(cfg.Etcd.Endpoints, "live-service")
+	})
 	if err != nil {
 		logger.Fatal("Failed to connect to etcd", "error", err)
 	}
-	defer etcdDiscovery.Close()
 
-	// 6. 创建gRPC服务器
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor(logger)),
-	)
+	// 6. 创建gRPC服务器，未开启TLS时使用明文连接
+	serverCreds, err := grpctls.ServerCredentials(grpctls.Options{
+		Enabled:    cfg.Server.TLS.Enabled,
+		CertFile:   cfg.Server.TLS.CertFile,
+		KeyFile:    cfg.Server.TLS.KeyFile,
+		CAFile:     cfg.Server.TLS.ClientCAFile,
+		ClientAuth: cfg.Server.TLS.ClientAuth,
+	})
+	if err != nil {
+		logger.Fatal("Failed to load gRPC server TLS credentials", "error", err)
+	}
+	if !cfg.Server.TLS.Enabled && cfg.Server.Mode == "release" {
+		logger.Warn("gRPC TLS is disabled, traffic between services is not encrypted")
+	}
+	liveHandler := handler.NewLiveServiceHandler(cfg, logger, db, redisClient)
 
-	// 7. 注册健康检查服务
+	// 拦截器按添加顺序执行，请求日志在最外层，随后绑定已认证的用户ID，panic恢复紧贴处理函数
+	chain := interceptors.Chain(
+		unaryInterceptor(logger),
+		liveHandler.UnaryAuthInterceptor(),
+		interceptors.UnaryRecovery(logPanic(logger)),
+	).Stream(interceptors.StreamRecovery(logPanic(logger)))
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(serverCreds)}, chain.ServerOptions()...)...)
+
+	// 7. 注册健康检查服务，存活与就绪分开上报
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
-	healthServer.SetServingStatus("live_service", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthChecker := healthcheck.NewChecker(healthServer, "live_service", cfg.Health.CheckInterval, cfg.Health.CheckTimeout, logger)
+	healthChecker.AddCheck("database", healthcheck.DatabaseCheck(db))
+	healthChecker.AddCheck("redis", healthcheck.RedisCheck(redisClient))
+	healthChecker.AddCheck("etcd", etcdDiscovery.Ping)
+	healthCtx, cancelHealth := context.WithCancel(context.Background())
+	healthChecker.Start(healthCtx)
 
-	// 8. 注册用户服务
-	liveHandler := handler.NewLiveServiceHandler(cfg, logger, db, redisClient)
+	// HTTP探针（/livez、/readyz），供Kubernetes使用
+	var healthHTTPServer *http.Server
+	if cfg.Health.HTTPPort > 0 {
+		healthHTTPServer = &http.Server{
+			Addr:    fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Health.HTTPPort),
+			Handler: healthChecker.Handler(),
+		}
+		go func() {
+			logger.Info("Health HTTP server starting", "address", healthHTTPServer.Addr)
+			if err := healthHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Health HTTP server failed", "error", err)
+			}
+		}()
+	}
 
+	// 8. 注册用户服务
 	// 初始化审计服务客户端管理器
+	var auditCloser func() error
 	if len(cfg.Etcd.Endpoints) > 0 {
 		auditManager, err := auditclient.NewAuditClientManager(cfg.Etcd.Endpoints)
 		if err != nil {
@@ -107,7 +169,10 @@ func main() {
 			// 将审计管理器注入到处理器中
 			liveHandler.SetAuditManager(auditManager)
 			logger.Info("Audit client manager initialized successfully")
-			defer auditManager.Close()
+			auditCloser = func() error {
+				auditManager.Close()
+				return nil
+			}
 		}
 	} else {
 		logger.Warn("No etcd endpoints configured, audit service will not be available")
@@ -116,8 +181,11 @@ func main() {
 	proto_gen.RegisterLiveServiceServer(grpcServer, liveHandler)
 	logger.Info("Live service registered")
 
-	// 9. 注册反射服务（用于调试）
-	reflection.Register(grpcServer)
+	// 9. 注册反射服务（用于调试，release模式默认关闭）
+	if cfg.Server.ReflectionEnabled() {
+		reflection.Register(grpcServer)
+		logger.Info("gRPC reflection registered")
+	}
 
 	// 10. 启动gRPC服务器
 	go func() {
@@ -141,28 +209,64 @@ func main() {
 	logger.Info("Service registered to etcd", "address", serviceAddr)
 
 	// 12. 等待中断信号
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-	<-sigChan
-
-	logger.Info("Shutting down server...")
+	sig := lifecycle.WaitForSignal()
+	logger.Info("Shutting down server...", "signal", sig.String())
 
-	// 13. 设置健康检查为不健康状态
-	healthServer.SetServingStatus("live_service", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	// 13. 按顺序退出：先停止接收流量并排空在途请求，再关闭依赖
+	shutdown := lifecycle.New(logger, lifecycle.DefaultStepTimeout)
+	shutdown.Add("health", func(ctx context.Context) error {
+		healthChecker.Shutdown()
+		return nil
+	})
+	shutdown.Add("grpc", lifecycle.GRPCServer(grpcServer))
+	// 提交内存中尚未写入的实时统计，需在Redis关闭前完成
+	shutdown.Add("live_stats", liveHandler.FlushStats)
+	if auditCloser != nil {
+		shutdown.Add("audit_client", lifecycle.Closer(auditCloser))
+	}
+	shutdown.Add("redis", lifecycle.Closer(redisClient.Close))
+	shutdown.Add("database", lifecycle.Closer(func() error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	}))
+	shutdown.Add("etcd", lifecycle.Closer(etcdDiscovery.Close))
+	// 健康检查最后停止，排空请求和关闭依赖期间/readyz持续返回503
+	shutdown.Add("health_checker", func(ctx context.Context) error {
+		cancelHealth()
+		return nil
+	})
+	if healthHTTPServer != nil {
+		shutdown.Add("health_http", lifecycle.HTTPServer(healthHTTPServer))
+	}
+	shutdown.Run()
 
-	// 14. 停止gRPC服务器
-	grpcServer.GracefulStop()
 	logger.Info("Server stopped gracefully")
 }
 
-// unaryInterceptor gRPC一元拦截器
+// logPanic 记录gRPC处理函数中的panic
+func logPanic(log logger.Logger) interceptors.PanicHandler {
+	return func(ctx context.Context, method string, p interface{}, stack []byte) {
+		log.Error("gRPC handler panic", "method", method, "panic", p, "stack", string(stack))
+	}
+}
+
+// requestIDMetadataKey 网关透传请求ID使用的元数据键
+const requestIDMetadataKey = "x-request-id"
+
+// unaryInterceptor gRPC一元拦截器，将请求ID绑定到context并记录请求日志
 func unaryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		start := time.Now()
+		ctx = withRequestID(ctx, req)
+		requestID, _ := ctxkeys.RequestID(ctx)
 
 		log.Info("gRPC request started",
 			"method", info.FullMethod,
-			"request", req,
+			"request_id", requestID,
+			"request", interceptors.Redact(req),
 		)
 
 		// 调用实际的处理函数
@@ -173,12 +277,14 @@ func unaryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 		if err != nil {
 			log.Error("gRPC request failed",
 				"method", info.FullMethod,
+				"request_id", requestID,
 				"error", err,
 				"duration", duration,
 			)
 		} else {
 			log.Info("gRPC request completed",
 				"method", info.FullMethod,
+				"request_id", requestID,
 				"duration", duration,
 			)
 		}
@@ -186,3 +292,21 @@ func unaryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// withRequestID 将请求ID绑定到context
+// 请求ID优先取x-request-id元数据，其次取请求中的request_id；用户ID由认证拦截器按访问令牌绑定，不取请求中的user_id
+func withRequestID(ctx context.Context, req interface{}) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if r, ok := req.(interface{ GetRequestId() string }); ok && requestID == "" {
+		requestID = r.GetRequestId()
+	}
+	if requestID != "" {
+		ctx = ctxkeys.WithRequestID(ctx, requestID)
+	}
+	return ctx
+}
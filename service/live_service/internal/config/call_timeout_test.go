@@ -0,0 +1,37 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestAuditServiceCallTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want time.Duration
+	}{
+		{"configured", "services:\n  audit_service:\n    call_timeout: 500ms\n", 500 * time.Millisecond},
+		{"default", "services:\n  audit_service:\n    timeout: 5\n", defaultCallTimeout},
+		{"non-positive", "services:\n  audit_service:\n    call_timeout: -1s\n", defaultCallTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.SetConfigType("yaml")
+			if err := v.ReadConfig(strings.NewReader(tt.yaml)); err != nil {
+				t.Fatalf("read config: %v", err)
+			}
+			var cfg Config
+			if err := v.Unmarshal(&cfg); err != nil {
+				t.Fatalf("unmarshal config: %v", err)
+			}
+			if got := cfg.Services.AuditService.GetCallTimeout(); got != tt.want {
+				t.Errorf("call timeout = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
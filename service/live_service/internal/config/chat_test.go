@@ -0,0 +1,35 @@
+package config
+
+import "testing"
+
+func TestLiveChatIsAllowedEmoji(t *testing.T) {
+	tests := []struct {
+		name   string
+		emojis []string
+		code   string
+		want   bool
+	}{
+		{"default emoji", nil, "[smile]", true},
+		{"not a default emoji", nil, "[wink]", false},
+		{"configured emoji", []string{"[wink]"}, "[wink]", true},
+		// 配置后只允许配置的表情
+		{"default replaced", []string{"[wink]"}, "[smile]", false},
+		{"empty code", nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := LiveChatConfig{Emojis: tt.emojis}
+			if got := cfg.IsAllowedEmoji(tt.code); got != tt.want {
+				t.Errorf("IsAllowedEmoji(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiveChatTextLengthLimit(t *testing.T) {
+	for configured, want := range map[int]int{0: defaultChatMaxTextLength, -1: defaultChatMaxTextLength, 50: 50} {
+		if got := (LiveChatConfig{MaxTextLength: configured}).TextLengthLimit(); got != want {
+			t.Errorf("max_text_length %d: limit = %d, want %d", configured, got, want)
+		}
+	}
+}
@@ -16,9 +16,18 @@ type Config struct {
 	Redis    RedisConfig    `mapstructure:"redis"`
 	Logger   LoggerConfig   `mapstructure:"logger"`
 	Etcd     EtcdConfig     `mapstructure:"etcd"`
+	Health   HealthConfig   `mapstructure:"health"`
 	Consul   ConsulConfig   `mapstructure:"consul"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	SMS      SMSConfig      `mapstructure:"sms"`
+	Live     LiveConfig     `mapstructure:"live"`
+
+	FeatureFlags FeatureFlagsConfig `mapstructure:"feature_flags"`
+	ConnectRetry ConnectRetryConfig `mapstructure:"connect_retry"`
+	SearchIndex  SearchIndexConfig  `mapstructure:"search_index"`
+
+	UserNotification UserNotificationConfig `mapstructure:"user_notification"`
+	Services         ServicesConfig         `mapstructure:"services"`
 }
 
 // ServerConfig 服务器配置
@@ -28,21 +37,45 @@ type ServerConfig struct {
 	Mode         string        `mapstructure:"mode"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// EnableReflection 是否注册gRPC反射服务，未配置时仅debug模式开启
+	EnableReflection *bool `mapstructure:"enable_reflection"`
+	// TLS gRPC服务端TLS配置，未开启时使用明文连接
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// ReflectionEnabled 是否注册gRPC反射服务
+// 反射会暴露完整的接口定义，release模式下需显式配置enable_reflection: true才会开启
+func (c ServerConfig) ReflectionEnabled() bool {
+	if c.EnableReflection != nil {
+		return *c.EnableReflection
+	}
+	return c.Mode == "debug"
+}
+
+// TLSConfig gRPC服务端TLS配置，未开启时使用明文连接，仅适用于本地开发，跨主机部署必须开启
+type TLSConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	CertFile     string `mapstructure:"cert_file"`      // 服务端证书
+	KeyFile      string `mapstructure:"key_file"`       // 服务端私钥
+	ClientAuth   bool   `mapstructure:"client_auth"`    // 要求并校验客户端证书(mTLS)
+	ClientCAFile string `mapstructure:"client_ca_file"` // 校验客户端证书的CA，开启ClientAuth时必填
 }
 
 // DatabaseConfig 数据库配置
 type DatabaseConfig struct {
-	Host            string `mapstructure:"host"`
-	Port            int    `mapstructure:"port"`
-	Username        string `mapstructure:"username"`
-	Password        string `mapstructure:"password"`
-	Database        string `mapstructure:"database"`
-	Charset         string `mapstructure:"charset"`
-	TablePrefix     string `mapstructure:"table_prefix"`
-	LogLevel        string `mapstructure:"log_level"`
-	MaxIdleConns    int    `mapstructure:"max_idle_conns"`
-	MaxOpenConns    int    `mapstructure:"max_open_conns"`
-	ConnMaxLifetime int    `mapstructure:"conn_max_lifetime"`
+	Host             string `mapstructure:"host"`
+	Port             int    `mapstructure:"port"`
+	Username         string `mapstructure:"username"`
+	Password         string `mapstructure:"password"`
+	Database         string `mapstructure:"database"`
+	Charset          string `mapstructure:"charset"`
+	TablePrefix      string `mapstructure:"table_prefix"`
+	LogLevel         string `mapstructure:"log_level"`
+	MaxIdleConns     int    `mapstructure:"max_idle_conns"`
+	MaxOpenConns     int    `mapstructure:"max_open_conns"`
+	ConnMaxLifetime  int    `mapstructure:"conn_max_lifetime"`
+	AutoMigrate      bool   `mapstructure:"auto_migrate"`      // 启动时自动迁移表结构
+	AllowDestructive bool   `mapstructure:"allow_destructive"` // 迁移时允许删除模型中已移除的列
 }
 
 // RedisConfig Redis配置
@@ -55,6 +88,13 @@ type RedisConfig struct {
 	DialTimeout  int    `mapstructure:"dial_timeout"`
 	ReadTimeout  int    `mapstructure:"read_timeout"`
 	WriteTimeout int    `mapstructure:"write_timeout"`
+
+	// 部署模式，single(默认)、sentinel或cluster
+	Mode             string   `mapstructure:"mode"`
+	MasterName       string   `mapstructure:"master_name"`       // sentinel模式的主节点名称
+	SentinelAddrs    []string `mapstructure:"sentinel_addrs"`    // sentinel模式的哨兵地址
+	SentinelPassword string   `mapstructure:"sentinel_password"` // 哨兵的认证密码，为空时不认证
+	ClusterNodes     []string `mapstructure:"cluster_nodes"`     // cluster模式的种子节点地址
 }
 
 // LoggerConfig 日志配置
@@ -62,6 +102,17 @@ type LoggerConfig struct {
 	Level      string `mapstructure:"level"`
 	Format     string `mapstructure:"format"`
 	OutputPath string `mapstructure:"output_path"`
+	MaxSize    int    `mapstructure:"max_size"`    // 单个日志文件最大大小(MB)
+	MaxAge     int    `mapstructure:"max_age"`     // 旧日志保留天数
+	MaxBackups int    `mapstructure:"max_backups"` // 旧日志最多保留个数
+	Compress   bool   `mapstructure:"compress"`    // 是否压缩旧日志
+}
+
+// HealthConfig 健康检查配置
+type HealthConfig struct {
+	HTTPPort      int           `mapstructure:"http_port"`      // HTTP探针端口，0表示不启动
+	CheckInterval time.Duration `mapstructure:"check_interval"` // 依赖检查间隔
+	CheckTimeout  time.Duration `mapstructure:"check_timeout"`  // 单个依赖检查超时
 }
 
 // EtcdConfig etcd配置
@@ -85,6 +136,17 @@ type JWTConfig struct {
 	RefreshSecret     string        `mapstructure:"refresh_secret"`
 	TokenExpiration   time.Duration `mapstructure:"token_expiration"`
 	RefreshExpiration time.Duration `mapstructure:"refresh_expiration"`
+	// KeyID 当前密钥的kid，需与user_service一致
+	KeyID string `mapstructure:"key_id"`
+	// PreviousKeys 轮换下来的旧密钥，宽限期内旧密钥签发的token仍可校验通过，需与user_service一致
+	PreviousKeys []JWTKeyConfig `mapstructure:"previous_keys"`
+}
+
+// JWTKeyConfig 轮换下来的JWT密钥
+type JWTKeyConfig struct {
+	KeyID     string `mapstructure:"key_id"`     // 旧token header中的kid，轮换前未配置kid的密钥填空
+	Secret    string `mapstructure:"secret"`     // 访问token密钥
+	ExpiresAt string `mapstructure:"expires_at"` // 宽限期截止时间(RFC3339)，之后不再接受该密钥签发的token
 }
 
 // SMSConfig 短信服务配置
@@ -95,6 +157,146 @@ type SMSConfig struct {
 	TemplateCode string `mapstructure:"template_code"`
 }
 
+// LiveConfig 直播业务配置
+type LiveConfig struct {
+	Gift     LiveGiftConfig     `mapstructure:"gift"`
+	Chat     LiveChatConfig     `mapstructure:"chat"`
+	Stats    LiveStatsConfig    `mapstructure:"stats"`
+	Presence LivePresenceConfig `mapstructure:"presence"`
+}
+
+// LiveGiftConfig 礼物配置
+type LiveGiftConfig struct {
+	ComboWindow time.Duration `mapstructure:"combo_window"` // 连击判定窗口，窗口内同一用户连续赠送同一礼物视为连击
+}
+
+// LiveStatsConfig 实时统计配置
+type LiveStatsConfig struct {
+	FlushInterval time.Duration `mapstructure:"flush_interval"` // 观看人数等计数批量写入Redis的间隔
+}
+
+// LivePresenceConfig 观看者在线状态配置
+type LivePresenceConfig struct {
+	TTL           time.Duration `mapstructure:"ttl"`            // 心跳超时时间，超过该时间未上报心跳视为已离开
+	SweepInterval time.Duration `mapstructure:"sweep_interval"` // 清理超时观看者的间隔
+
+	AccrueInterval time.Duration `mapstructure:"accrue_interval"` // 心跳时累计观看时长的最小间隔，长时间在线的观看者据此定期落库
+}
+
+// FeatureFlagsConfig 特性开关配置
+type FeatureFlagsConfig struct {
+	RedisKey        string            `mapstructure:"redis_key"`        // Redis中覆盖值所在的hash键
+	RefreshInterval time.Duration     `mapstructure:"refresh_interval"` // 覆盖值刷新间隔
+	Defaults        map[string]string `mapstructure:"defaults"`         // 开关默认值，Redis中未覆盖时生效
+}
+
+// ConnectRetryConfig 启动时连接MySQL、Redis、etcd的重试配置
+type ConnectRetryConfig struct {
+	Attempts    int           `mapstructure:"attempts"`     // 最大尝试次数(含首次)，<=1时不重试
+	Interval    time.Duration `mapstructure:"interval"`     // 首次重试间隔，之后按指数翻倍
+	MaxInterval time.Duration `mapstructure:"max_interval"` // 重试间隔上限
+}
+
+// SearchIndexConfig 搜索索引事件配置
+type SearchIndexConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`     // 是否在内容变更后发布索引事件
+	QueueKey   string `mapstructure:"queue_key"`   // 索引事件队列，需与搜索服务一致
+	BufferSize int    `mapstructure:"buffer_size"` // 内存队列长度，队列满时丢弃新事件
+}
+
+// UserNotificationConfig 用户通知事件配置
+type UserNotificationConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`     // 是否发布用户通知事件
+	QueueKey   string `mapstructure:"queue_key"`   // 通知事件队列，需与消息服务一致
+	BufferSize int    `mapstructure:"buffer_size"` // 内存队列长度，队列满时丢弃新事件
+}
+
+// ServicesConfig 服务间调用配置
+type ServicesConfig struct {
+	AuditService ServiceConfig `mapstructure:"audit_service"`
+}
+
+// ServiceConfig 下游服务调用配置
+type ServiceConfig struct {
+	Name        string        `mapstructure:"name"`
+	Address     string        `mapstructure:"address"`
+	Timeout     int           `mapstructure:"timeout"`      // 建立连接的超时时间（秒）
+	CallTimeout time.Duration `mapstructure:"call_timeout"` // 单次调用的超时时间，下游无响应时不会一直阻塞请求
+}
+
+// defaultCallTimeout 未配置call_timeout时单次调用的超时时间
+const defaultCallTimeout = 3 * time.Second
+
+// GetCallTimeout 获取单次调用的超时时间
+func (c ServiceConfig) GetCallTimeout() time.Duration {
+	if c.CallTimeout <= 0 {
+		return defaultCallTimeout
+	}
+	return c.CallTimeout
+}
+
+// LiveChatConfig 直播聊天配置
+type LiveChatConfig struct {
+	// AdminUserIDs 平台管理员，可在任意直播间禁言用户
+	AdminUserIDs []uint64 `mapstructure:"admin_user_ids"`
+
+	// Emojis 允许发送的表情编码，为空时使用defaultChatEmojis
+	Emojis []string `mapstructure:"emojis"`
+	// MaxTextLength 文本消息的最大字符数，为0时使用defaultChatMaxTextLength
+	MaxTextLength int `mapstructure:"max_text_length"`
+	// BannedWords 按语言分组的聊天禁用词，键为语言代码，所有分组都参与匹配
+	BannedWords map[string][]string `mapstructure:"banned_words"`
+
+	Retention LiveChatRetentionConfig `mapstructure:"retention"`
+}
+
+// defaultChatEmojis 未配置emojis时允许发送的表情编码
+var defaultChatEmojis = []string{"[smile]", "[laugh]", "[love]", "[cry]", "[angry]", "[clap]", "[thumbsup]", "[fire]", "[heart]", "[666]"}
+
+// defaultChatMaxTextLength 未配置max_text_length时文本消息的最大字符数
+const defaultChatMaxTextLength = 200
+
+// LiveChatRetentionConfig 聊天记录保留配置，直播结束后超过保留期的聊天消息移入归档表或直接删除
+type LiveChatRetentionConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	RetainFor time.Duration `mapstructure:"retain_for"` // 聊天消息在主表中保留的时长，按发送时间计算
+	Archive   bool          `mapstructure:"archive"`    // true时移入归档表，聊天历史仍可查询；false时直接删除
+	Interval  time.Duration `mapstructure:"interval"`   // 清理任务执行间隔
+	BatchSize int           `mapstructure:"batch_size"` // 每批处理的消息数
+}
+
+// IsAdmin 判断用户是否为平台管理员
+func (c LiveChatConfig) IsAdmin(userID uint64) bool {
+	for _, id := range c.AdminUserIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedEmoji 判断表情编码是否在允许列表中
+func (c LiveChatConfig) IsAllowedEmoji(code string) bool {
+	emojis := c.Emojis
+	if len(emojis) == 0 {
+		emojis = defaultChatEmojis
+	}
+	for _, e := range emojis {
+		if e == code {
+			return true
+		}
+	}
+	return false
+}
+
+// TextLengthLimit 文本消息的最大字符数
+func (c LiveChatConfig) TextLengthLimit() int {
+	if c.MaxTextLength <= 0 {
+		return defaultChatMaxTextLength
+	}
+	return c.MaxTextLength
+}
+
 // LoadConfig 加载配置
 func LoadConfig(configPath string) (*Config, error) {
 	v := viper.New()
@@ -0,0 +1,29 @@
+package config
+
+import "testing"
+
+func TestServerTLSConfig(t *testing.T) {
+	got := loadServerConfig(t, `server:
+  tls:
+    enabled: true
+    cert_file: /etc/tls/server.pem
+    key_file: /etc/tls/server-key.pem
+    client_auth: true
+    client_ca_file: /etc/tls/ca.pem
+`).TLS
+	want := TLSConfig{
+		Enabled:      true,
+		CertFile:     "/etc/tls/server.pem",
+		KeyFile:      "/etc/tls/server-key.pem",
+		ClientAuth:   true,
+		ClientCAFile: "/etc/tls/ca.pem",
+	}
+	if got != want {
+		t.Errorf("tls = %+v, want %+v", got, want)
+	}
+
+	// 未配置时使用明文连接
+	if got := loadServerConfig(t, "server:\n  mode: release\n").TLS; got != (TLSConfig{}) {
+		t.Errorf("default tls = %+v, want disabled", got)
+	}
+}
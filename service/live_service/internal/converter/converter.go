@@ -1,10 +1,54 @@
 package converter
 
 import (
+	"time"
+
 	"live_service/internal/model"
+	"live_service/internal/repository"
+	"live_service/internal/service"
 	livepb "live_service/proto/proto_gen"
 )
 
+// liveStatusText 直播状态转字符串
+func liveStatusText(status uint8) string {
+	switch status {
+	case model.LiveStatusPreparing:
+		return "preparing"
+	case model.LiveStatusStreaming:
+		return "live"
+	case model.LiveStatusPaused:
+		return "paused"
+	case model.LiveStatusEnded:
+		return "ended"
+	case model.LiveStatusBanned:
+		return "banned"
+	default:
+		return "unknown"
+	}
+}
+
+// roomStatusText 直播间状态转字符串
+func roomStatusText(status uint8) string {
+	switch status {
+	case model.RoomStatusOffline:
+		return "offline"
+	case model.RoomStatusOnline:
+		return "online"
+	case model.RoomStatusBanned:
+		return "banned"
+	default:
+		return "unknown"
+	}
+}
+
+// unixTime 可空时间转Unix时间戳，空值返回0
+func unixTime(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
 // LiveStreamToProto 直播流转Proto
 func LiveStreamToProto(stream *model.LiveStream) *livepb.LiveStream {
 	if stream == nil {
@@ -17,19 +61,22 @@ func LiveStreamToProto(stream *model.LiveStream) *livepb.LiveStream {
 		Title:       stream.Title,
 		Description: stream.Description,
 		CategoryId:  stream.CategoryID,
-		Status:      string(stream.Status),
+		Status:      liveStatusText(stream.Status),
 		StreamUrl:   stream.StreamURL,
 		PlaybackUrl: stream.PlaybackURL,
-		CoverImage:  stream.CoverImage,
+		CoverImage:  stream.ThumbnailURL,
 		ViewerCount: stream.ViewerCount,
 		LikeCount:   stream.LikeCount,
 		GiftCount:   stream.GiftCount,
-		GiftValue:   stream.GiftValue,
 		Duration:    stream.Duration,
-		StartTime:   stream.StartTime,
-		EndTime:     stream.EndTime,
-		CreatedAt:   stream.CreatedAt,
-		UpdatedAt:   stream.UpdatedAt,
+		StartTime:   unixTime(stream.StartedAt),
+		EndTime:     unixTime(stream.EndedAt),
+		CreatedAt:   stream.CreatedAt.Unix(),
+		UpdatedAt:   stream.UpdatedAt.Unix(),
+		IsPublic:    stream.IsPublic,
+		HasPassword: stream.RoomPassword != "",
+		Language:    stream.Language,
+		Region:      stream.Region,
 	}
 }
 
@@ -54,19 +101,19 @@ func LiveRoomToProto(room *model.LiveRoom) *livepb.LiveRoom {
 
 	return &livepb.LiveRoom{
 		Id:             room.ID,
-		StreamId:       room.StreamID,
-		Title:          room.Title,
+		Title:          room.Name,
 		Description:    room.Description,
-		CategoryId:     room.CategoryID,
-		Status:         string(room.Status),
-		ViewerCount:    room.ViewerCount,
-		MaxViewerCount: room.MaxViewerCount,
-		LikeCount:      room.LikeCount,
-		GiftCount:      room.GiftCount,
-		GiftValue:      room.GiftValue,
-		ChatCount:      room.ChatCount,
-		CreatedAt:      room.CreatedAt,
-		UpdatedAt:      room.UpdatedAt,
+		Status:         roomStatusText(room.Status),
+		MaxViewerCount: room.MaxViewers,
+		LikeCount:      uint32(room.TotalLikes),
+		GiftCount:      uint32(room.TotalGifts),
+		CreatedAt:      room.CreatedAt.Unix(),
+		UpdatedAt:      room.UpdatedAt.Unix(),
+		UserId:         room.UserID,
+		RoomNumber:     room.RoomNumber,
+		TotalStreams:   room.TotalStreams,
+		TotalDuration:  room.TotalDuration,
+		TotalViewers:   room.TotalViewers,
 	}
 }
 
@@ -77,16 +124,13 @@ func LiveViewerToProto(viewer *model.LiveViewer) *livepb.LiveViewer {
 	}
 
 	return &livepb.LiveViewer{
-		Id:         viewer.ID,
-		StreamId:   viewer.StreamID,
-		UserId:     viewer.UserID,
-		UserName:   viewer.UserName,
-		UserAvatar: viewer.UserAvatar,
-		JoinTime:   viewer.JoinTime,
-		LeaveTime:  viewer.LeaveTime,
-		Duration:   viewer.Duration,
-		IsMuted:    viewer.IsMuted,
-		CreatedAt:  viewer.CreatedAt,
+		Id:        viewer.ID,
+		StreamId:  viewer.StreamID,
+		UserId:    viewer.UserID,
+		JoinTime:  viewer.EnterTime.Unix(),
+		LeaveTime: unixTime(viewer.ExitTime),
+		Duration:  viewer.WatchDuration,
+		CreatedAt: viewer.CreatedAt.Unix(),
 	}
 }
 
@@ -113,13 +157,13 @@ func LiveChatToProto(chat *model.LiveChat) *livepb.LiveChat {
 		Id:          chat.ID,
 		StreamId:    chat.StreamID,
 		UserId:      chat.UserID,
-		UserName:    chat.UserName,
+		UserName:    chat.UserNickname,
 		UserAvatar:  chat.UserAvatar,
 		Content:     chat.Content,
 		ContentType: chat.ContentType,
 		IsSystem:    chat.IsSystem,
-		IsDeleted:   chat.IsDeleted,
-		CreatedAt:   chat.CreatedAt,
+		IsDeleted:   chat.Status == 0,
+		CreatedAt:   chat.CreatedAt.Unix(),
 	}
 }
 
@@ -142,22 +186,25 @@ func LiveGiftToProto(gift *model.LiveGift) *livepb.LiveGift {
 		return nil
 	}
 
-	return &livepb.LiveGift{
-		Id:         gift.ID,
-		StreamId:   gift.StreamID,
-		UserId:     gift.UserID,
-		UserName:   gift.UserName,
-		UserAvatar: gift.UserAvatar,
-		GiftId:     gift.GiftID,
-		GiftName:   gift.GiftName,
-		GiftIcon:   gift.GiftIcon,
-		GiftPrice:  gift.GiftPrice,
-		GiftCount:  gift.GiftCount,
-		TotalValue: gift.TotalValue,
-		Message:    gift.Message,
-		EffectType: gift.EffectType,
-		CreatedAt:  gift.CreatedAt,
-	}
+	pbGift := &livepb.LiveGift{
+		Id:          gift.ID,
+		StreamId:    gift.StreamID,
+		UserId:      gift.UserID,
+		GiftId:      gift.GiftID,
+		GiftName:    gift.GiftName,
+		GiftIcon:    gift.GiftIcon,
+		GiftPrice:   gift.GiftValue,
+		GiftCount:   gift.GiftCount,
+		TotalValue:  gift.TotalValue,
+		EffectType:  gift.EffectType,
+		EffectValue: gift.EffectData,
+		CreatedAt:   gift.CreatedAt.Unix(),
+		Status:      uint32(gift.Status),
+	}
+	if gift.RefundedAt != nil {
+		pbGift.RefundedAt = gift.RefundedAt.Unix()
+	}
+	return pbGift
 }
 
 // LiveGiftListToProto 直播礼物列表转Proto
@@ -256,6 +303,42 @@ func LiveStatsToProto(stats *service.LiveStats) *livepb.LiveStats {
 	}
 }
 
+// LivePlaybackToProto 直播回放转Proto
+func LivePlaybackToProto(playback *service.LivePlayback) *livepb.LivePlayback {
+	if playback == nil {
+		return nil
+	}
+
+	return &livepb.LivePlayback{
+		StreamId:    playback.StreamID,
+		PlaybackUrl: playback.PlaybackURL,
+		Duration:    uint64(playback.Duration),
+		FileSize:    playback.FileSize,
+		Format:      playback.Format,
+		Quality:     playback.Quality,
+		CreatedAt:   playback.CreatedAt,
+		Status:      playback.Status,
+	}
+}
+
+// GiftStatsToProto 礼物统计转Proto
+func GiftStatsToProto(stats *service.GiftStats) *livepb.GiftStats {
+	if stats == nil {
+		return nil
+	}
+
+	return &livepb.GiftStats{
+		StreamId:      stats.StreamID,
+		TotalGifts:    stats.TotalGifts,
+		TotalValue:    stats.TotalValue,
+		TotalCoins:    stats.TotalCoins,
+		UniqueSenders: stats.UniqueSenders,
+		TopGiftId:     stats.TopGiftID,
+		TopGiftCount:  stats.TopGiftCount,
+		TopGiftValue:  stats.TopGiftValue,
+	}
+}
+
 // GiftRankingItemToProto 礼物排行榜项转Proto
 func GiftRankingItemToProto(item *service.GiftRankingItem) *livepb.GiftRankingItem {
 	if item == nil {
@@ -313,7 +396,6 @@ func SendLiveChatRequestToModel(req *livepb.SendLiveChatRequest, userID uint64)
 		Content:     req.Content,
 		ContentType: req.ContentType,
 		IsSystem:    false,
-		IsDeleted:   false,
 	}
 }
 
@@ -328,6 +410,29 @@ func SendLiveGiftRequestToModel(req *livepb.SendLiveGiftRequest, userID uint64)
 		UserID:    userID,
 		GiftID:    req.GiftId,
 		GiftCount: req.GiftCount,
-		Message:   req.Message,
 	}
 }
+
+// LiveEventToProto 直播间事件转Proto
+func LiveEventToProto(event *model.LiveEvent) *livepb.LiveEvent {
+	return &livepb.LiveEvent{
+		Type:      string(event.Type),
+		StreamId:  event.StreamID,
+		UserId:    event.UserID,
+		Timestamp: event.Timestamp,
+		Data:      string(event.Data),
+	}
+}
+
+// LeaderboardEntryListToProto 排行榜条目列表转Proto
+func LeaderboardEntryListToProto(entries []*repository.LeaderboardEntry) []*livepb.LeaderboardEntry {
+	result := make([]*livepb.LeaderboardEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = &livepb.LeaderboardEntry{
+			Rank:  uint32(entry.Rank),
+			Id:    entry.ID,
+			Score: entry.Score,
+		}
+	}
+	return result
+}
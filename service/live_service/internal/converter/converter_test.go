@@ -0,0 +1,145 @@
+package converter
+
+import (
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+)
+
+func TestLiveGiftToProto(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 20, 30, 0, 0, time.UTC)
+	refundedAt := createdAt.Add(time.Hour)
+	gift := &model.LiveGift{
+		ID:         11,
+		StreamID:   3,
+		UserID:     7,
+		GiftID:     2,
+		GiftName:   "火箭",
+		GiftIcon:   "https://cdn.example.com/rocket.png",
+		GiftValue:  500,
+		GiftCount:  3,
+		TotalValue: 1500,
+		EffectType: "fullscreen",
+		EffectData: `{"duration":3}`,
+		Status:     model.GiftStatusRefunded,
+		CreatedAt:  createdAt,
+		RefundedAt: &refundedAt,
+	}
+
+	got := LiveGiftToProto(gift)
+	if got.Id != 11 || got.StreamId != 3 || got.UserId != 7 || got.GiftId != 2 {
+		t.Errorf("ids = %d/%d/%d/%d, want 11/3/7/2", got.Id, got.StreamId, got.UserId, got.GiftId)
+	}
+	if got.GiftName != "火箭" || got.GiftIcon != gift.GiftIcon || got.GiftPrice != 500 || got.GiftCount != 3 || got.TotalValue != 1500 {
+		t.Errorf("gift fields = %+v, want name/icon/price/count/total copied", got)
+	}
+	if got.EffectType != "fullscreen" || got.EffectValue != `{"duration":3}` {
+		t.Errorf("effect = %q/%q, want fullscreen with data", got.EffectType, got.EffectValue)
+	}
+	if got.Status != model.GiftStatusRefunded || got.CreatedAt != createdAt.Unix() || got.RefundedAt != refundedAt.Unix() {
+		t.Errorf("status=%d created=%d refunded=%d, want refunded with unix times", got.Status, got.CreatedAt, got.RefundedAt)
+	}
+
+	gift.Status = model.GiftStatusSuccess
+	gift.RefundedAt = nil
+	if got := LiveGiftToProto(gift); got.RefundedAt != 0 {
+		t.Errorf("refunded_at = %d for a gift without refund, want 0", got.RefundedAt)
+	}
+	if LiveGiftToProto(nil) != nil {
+		t.Error("LiveGiftToProto(nil) should be nil")
+	}
+}
+
+func TestLiveGiftListToProto(t *testing.T) {
+	if LiveGiftListToProto(nil) != nil {
+		t.Error("LiveGiftListToProto(nil) should be nil")
+	}
+	got := LiveGiftListToProto([]*model.LiveGift{{ID: 2}, {ID: 1}})
+	if len(got) != 2 || got[0].Id != 2 || got[1].Id != 1 {
+		t.Fatalf("LiveGiftListToProto = %v, want ids [2 1] in order", got)
+	}
+}
+
+func TestLiveChatToProto(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 20, 30, 0, 0, time.UTC)
+	chat := &model.LiveChat{
+		ID:           5,
+		StreamID:     3,
+		UserID:       7,
+		Content:      "主播好",
+		ContentType:  "text",
+		UserNickname: "小明",
+		UserAvatar:   "https://cdn.example.com/a.png",
+		Status:       1,
+		CreatedAt:    createdAt,
+	}
+
+	got := LiveChatToProto(chat)
+	if got.Id != 5 || got.StreamId != 3 || got.UserId != 7 || got.Content != "主播好" || got.ContentType != "text" {
+		t.Errorf("chat = %+v, want ids and content copied", got)
+	}
+	if got.UserName != "小明" || got.UserAvatar != chat.UserAvatar || got.CreatedAt != createdAt.Unix() {
+		t.Errorf("chat user/time = %q/%q/%d, want nickname, avatar and unix time", got.UserName, got.UserAvatar, got.CreatedAt)
+	}
+	if got.IsDeleted || got.IsSystem {
+		t.Errorf("normal chat marked deleted=%v system=%v", got.IsDeleted, got.IsSystem)
+	}
+
+	chat.Status = 0
+	chat.IsSystem = true
+	if got := LiveChatToProto(chat); !got.IsDeleted || !got.IsSystem {
+		t.Errorf("deleted system chat = deleted %v system %v, want both true", got.IsDeleted, got.IsSystem)
+	}
+	if LiveChatToProto(nil) != nil {
+		t.Error("LiveChatToProto(nil) should be nil")
+	}
+	if LiveChatListToProto(nil) != nil {
+		t.Error("LiveChatListToProto(nil) should be nil")
+	}
+}
+
+func TestLiveStatsToProto(t *testing.T) {
+	stats := &service.LiveStats{
+		StreamID:       3,
+		TotalViewers:   120,
+		CurrentViewers: 15,
+		MaxViewers:     40,
+		LikeCount:      30,
+		GiftCount:      3,
+		CommentCount:   8,
+		ShareCount:     2,
+		Duration:       3600,
+		GiftValue:      700,
+	}
+
+	got := LiveStatsToProto(stats)
+	if got.StreamId != 3 || got.TotalViewers != 120 || got.CurrentViewers != 15 || got.MaxViewers != 40 {
+		t.Errorf("viewer stats = %+v, want viewers copied", got)
+	}
+	if got.LikeCount != 30 || got.GiftCount != 3 || got.CommentCount != 8 || got.ShareCount != 2 {
+		t.Errorf("interaction stats = %+v, want counters copied", got)
+	}
+	if got.Duration != 3600 || got.GiftValue != 700 {
+		t.Errorf("duration/gift value = %d/%d, want 3600/700", got.Duration, got.GiftValue)
+	}
+	if LiveStatsToProto(nil) != nil {
+		t.Error("LiveStatsToProto(nil) should be nil")
+	}
+}
+
+func TestLiveEventToProto(t *testing.T) {
+	event, err := model.NewLiveEvent(model.LiveEventLike, 3, 7, model.LiveLikeEventData{Count: 1})
+	if err != nil {
+		t.Fatalf("NewLiveEvent: %v", err)
+	}
+
+	got := LiveEventToProto(event)
+	if got.Type != "like" || got.StreamId != 3 || got.UserId != 7 || got.Timestamp != event.Timestamp {
+		t.Errorf("event = %+v, want like from user 7 in stream 3", got)
+	}
+	if got.Data != `{"count":1}` {
+		t.Errorf("data = %s, want the JSON event data", got.Data)
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+func (s *stubLiveService) ForceStopLive(ctx context.Context, operatorID, streamID uint64, reason string) (*model.LiveStream, error) {
+	s.operatorID = operatorID
+	return &model.LiveStream{ID: streamID, Status: model.LiveStatusEnded}, nil
+}
+
+func (s *stubLiveService) RecomputeLiveStats(ctx context.Context, operatorID, streamID uint64) (*service.LiveStats, error) {
+	s.operatorID = operatorID
+	return &service.LiveStats{}, nil
+}
+
+func (s *stubLiveService) MuteViewer(ctx context.Context, streamID, operatorID, userID uint64, duration uint32, reason string) error {
+	s.operatorID = operatorID
+	return nil
+}
+
+func (s *stubLiveService) UnmuteViewer(ctx context.Context, streamID, operatorID, userID uint64) error {
+	s.operatorID = operatorID
+	return nil
+}
+
+func TestForceStopLiveUsesTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+
+	// 客户端把user_id填成管理员ID也不能获得管理员权限，操作人以访问令牌为准
+	resp, err := h.ForceStopLive(withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour)),
+		&proto_gen.ForceStopLiveRequest{UserId: 1, StreamId: 3, Reason: "违规"})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("ForceStopLive = (%v, %v), want code 200", resp, err)
+	}
+	if svc.operatorID != 7 {
+		t.Fatalf("operatorID = %d, want 7 from token", svc.operatorID)
+	}
+
+	svc.operatorID = 0
+	resp, _ = h.ForceStopLive(context.Background(), &proto_gen.ForceStopLiveRequest{UserId: 1, StreamId: 3})
+	if resp.Code != 401 || svc.operatorID != 0 {
+		t.Fatalf("unauthenticated force stop: code %d, service called with %d; want 401 and no call", resp.Code, svc.operatorID)
+	}
+}
+
+func TestRecomputeLiveStatsUsesTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+
+	resp, err := h.RecomputeLiveStats(withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour)),
+		&proto_gen.RecomputeLiveStatsRequest{UserId: 1, StreamId: 3})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("RecomputeLiveStats = (%v, %v), want code 200", resp, err)
+	}
+	if svc.operatorID != 7 {
+		t.Fatalf("operatorID = %d, want 7 from token", svc.operatorID)
+	}
+
+	svc.operatorID = 0
+	resp, _ = h.RecomputeLiveStats(withToken(t, 7, "forged-secret", time.Now().Add(time.Hour)),
+		&proto_gen.RecomputeLiveStatsRequest{UserId: 1, StreamId: 3})
+	if resp.Code != 401 || svc.operatorID != 0 {
+		t.Fatalf("forged token: code %d, service called with %d; want 401 and no call", resp.Code, svc.operatorID)
+	}
+}
+
+func TestMuteViewerUsesTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+	ctx := withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour))
+
+	// 观众把user_id填成主播ID也不能禁言他人，操作人以访问令牌为准
+	resp, err := h.MuteViewer(ctx, &proto_gen.MuteViewerRequest{UserId: 1, StreamId: 3, TargetUserId: 9})
+	if err != nil || resp.Code != 200 || svc.operatorID != 7 {
+		t.Fatalf("MuteViewer = (%v, %v), operator %d; want code 200 with operator 7", resp, err, svc.operatorID)
+	}
+
+	svc.operatorID = 0
+	unmute, err := h.UnmuteViewer(ctx, &proto_gen.UnmuteViewerRequest{UserId: 1, StreamId: 3, TargetUserId: 9})
+	if err != nil || unmute.Code != 200 || svc.operatorID != 7 {
+		t.Fatalf("UnmuteViewer = (%v, %v), operator %d; want code 200 with operator 7", unmute, err, svc.operatorID)
+	}
+
+	svc.operatorID = 0
+	resp, _ = h.MuteViewer(context.Background(), &proto_gen.MuteViewerRequest{UserId: 1, StreamId: 3, TargetUserId: 9})
+	unmute, _ = h.UnmuteViewer(context.Background(), &proto_gen.UnmuteViewerRequest{UserId: 1, StreamId: 3, TargetUserId: 9})
+	if resp.Code != 401 || unmute.Code != 401 || svc.operatorID != 0 {
+		t.Fatalf("unauthenticated mute/unmute: codes %d/%d, service called with %d; want 401 and no call", resp.Code, unmute.Code, svc.operatorID)
+	}
+}
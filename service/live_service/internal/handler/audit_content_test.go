@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+	auditv1 "live_service/proto/proto_gen/audit"
+)
+
+func TestModerateLiveTextSubmitsTitle(t *testing.T) {
+	manager := &blockingAuditManager{resp: &auditv1.SubmitContentResponse{}}
+	h := newAuditTimeoutHandler(time.Second, manager)
+
+	h.moderateLiveText(context.Background(), "s1", 7, liveTextFieldTitle, "今晚开黑")
+	if len(manager.reqs) != 1 {
+		t.Fatalf("submitted %d audit requests, want 1", len(manager.reqs))
+	}
+	req := manager.reqs[0]
+	if req.ContentTitle != "今晚开黑" || req.Content != "今晚开黑" || req.UploaderId != 7 || req.Metadata["field"] != liveTextFieldTitle {
+		t.Errorf("audit request = %v, want the title as content title", req)
+	}
+}
+
+func TestForceStopAuditSubmitsStreamTitle(t *testing.T) {
+	manager := &blockingAuditManager{resp: &auditv1.SubmitContentResponse{}}
+	h := newAuditTimeoutHandler(time.Second, manager)
+
+	stream := &model.LiveStream{ID: 3, Title: "违规直播", UserID: 7}
+	h.submitForceStopAudit(context.Background(), stream, 1, "涉黄")
+	if len(manager.reqs) != 1 {
+		t.Fatalf("submitted %d audit requests, want 1", len(manager.reqs))
+	}
+	req := manager.reqs[0]
+	if req.ContentId != "live_3" || req.ContentTitle != "违规直播" || req.Content != "涉黄" || req.UploaderId != 7 {
+		t.Errorf("audit request = %v, want the stream title and stop reason", req)
+	}
+	if req.Metadata["action"] != "force_stop" || req.Metadata["operator_id"] != "1" {
+		t.Errorf("metadata = %v, want the force stop operator", req.Metadata)
+	}
+}
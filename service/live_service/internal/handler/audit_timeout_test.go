@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"live_service/internal/config"
+	auditv1 "live_service/proto/proto_gen/audit"
+)
+
+// blockingAuditManager 模拟审核服务，delay内未结束的调用等待ctx结束
+type blockingAuditManager struct {
+	delay    time.Duration
+	resp     *auditv1.SubmitContentResponse
+	deadline time.Duration // 调用ctx的剩余时间
+	reqs     []*auditv1.SubmitContentRequest
+}
+
+func (m *blockingAuditManager) SubmitContent(ctx context.Context, req interface{}) (interface{}, error) {
+	if r, ok := req.(*auditv1.SubmitContentRequest); ok {
+		m.reqs = append(m.reqs, r)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		m.deadline = time.Until(deadline)
+	}
+	select {
+	case <-time.After(m.delay):
+		return m.resp, nil
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (m *blockingAuditManager) GetAuditResult(ctx context.Context, req *auditv1.GetAuditResultRequest) (*auditv1.GetAuditResultResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *blockingAuditManager) Close() error { return nil }
+
+func newAuditTimeoutHandler(callTimeout time.Duration, manager *blockingAuditManager) *LiveServiceHandler {
+	cfg := &config.Config{}
+	cfg.Services.AuditService.CallTimeout = callTimeout
+	return &LiveServiceHandler{config: cfg, logger: nopLogger{}, auditManager: manager}
+}
+
+func TestSubmitAuditAppliesCallTimeout(t *testing.T) {
+	manager := &blockingAuditManager{delay: time.Minute}
+	h := newAuditTimeoutHandler(30*time.Millisecond, manager)
+
+	begin := time.Now()
+	_, err := h.submitAudit(context.Background(), &auditv1.SubmitContentRequest{ContentId: "live_1"})
+	if !isDeadlineExceeded(err) {
+		t.Fatalf("submitAudit error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Errorf("submitAudit returned after %v, want about the call timeout", elapsed)
+	}
+	if manager.deadline <= 0 || manager.deadline > 30*time.Millisecond {
+		t.Errorf("call deadline = %v, want within the call timeout", manager.deadline)
+	}
+}
+
+func TestSubmitAuditKeepsShorterRequestDeadline(t *testing.T) {
+	manager := &blockingAuditManager{resp: &auditv1.SubmitContentResponse{}}
+	h := newAuditTimeoutHandler(time.Minute, manager)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := h.submitAudit(ctx, &auditv1.SubmitContentRequest{}); err != nil {
+		t.Fatalf("submitAudit: %v", err)
+	}
+	// 请求本身的截止时间更早时不会被延长
+	if manager.deadline > time.Second {
+		t.Errorf("call deadline = %v, want the request's own deadline", manager.deadline)
+	}
+}
+
+func TestModerateLiveTextTimeoutTreatedAsPending(t *testing.T) {
+	manager := &blockingAuditManager{delay: time.Minute}
+	h := newAuditTimeoutHandler(20*time.Millisecond, manager)
+
+	rejected, reason := h.moderateLiveText(context.Background(), "s1", 7, liveTextFieldTitle, "标题")
+	if rejected || reason != "" {
+		t.Errorf("moderateLiveText = (%v, %q), want not rejected on timeout", rejected, reason)
+	}
+
+	// 审核服务及时返回拒绝时仍按结果处理
+	manager.delay = 0
+	manager.resp = &auditv1.SubmitContentResponse{Status: auditv1.AuditStatus_AUDIT_STATUS_REJECTED, Reason: "违规"}
+	if rejected, reason := h.moderateLiveText(context.Background(), "s1", 7, liveTextFieldTitle, "标题"); !rejected || reason != "违规" {
+		t.Errorf("moderateLiveText = (%v, %q), want rejected", rejected, reason)
+	}
+}
+
+func TestIsDeadlineExceeded(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"context", context.DeadlineExceeded, true},
+		{"wrapped context", fmt.Errorf("submit: %w", context.DeadlineExceeded), true},
+		{"grpc status", status.Error(codes.DeadlineExceeded, "deadline"), true},
+		{"canceled", context.Canceled, false},
+		{"unavailable", status.Error(codes.Unavailable, "down"), false},
+		{"other", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDeadlineExceeded(tt.err); got != tt.want {
+				t.Errorf("isDeadlineExceeded(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
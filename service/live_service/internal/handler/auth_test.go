@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"common/ctxkeys"
+	"common/jwtauth"
+
+	"live_service/internal/config"
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+const testJWTSecret = "test-secret"
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// stubLiveService 记录管理接口收到的操作人ID
+type stubLiveService struct {
+	service.LiveService
+	operatorID uint64
+}
+
+func (s *stubLiveService) RefundLiveGift(ctx context.Context, operatorID, giftRecordID uint64, reason string) (*model.LiveGift, error) {
+	s.operatorID = operatorID
+	return &model.LiveGift{ID: giftRecordID, Status: model.GiftStatusRefunded}, nil
+}
+
+func newTestHandler(svc service.LiveService) *LiveServiceHandler {
+	cfg := &config.Config{}
+	cfg.JWT.Secret = testJWTSecret
+	return &LiveServiceHandler{config: cfg, logger: nopLogger{}, liveService: svc, verifier: newTokenVerifier(cfg.JWT, nil, nopLogger{})}
+}
+
+// withToken 返回携带指定用户访问令牌的请求context
+func withToken(t *testing.T, userID uint32, secret string, expiresAt time.Time) context.Context {
+	t.Helper()
+	return withKeyToken(t, userID, "", secret, expiresAt)
+}
+
+// withKeyToken 返回携带指定kid签发的访问令牌的请求context
+func withKeyToken(t *testing.T, userID uint32, kid, secret string, expiresAt time.Time) context.Context {
+	t.Helper()
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, "Bearer "+signToken(t, userID, kid, secret, expiresAt)))
+}
+
+// signToken 签发与user_service结构一致的访问令牌，kid为空时header不带kid
+func signToken(t *testing.T, userID uint32, kid, secret string, expiresAt time.Time) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtauth.Claims{
+		UserID:           userID,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(expiresAt)},
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+// memoryBlacklist 内存中的令牌黑名单
+type memoryBlacklist map[string]bool
+
+func (b memoryBlacklist) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	return b[token], nil
+}
+
+func TestAuthenticatedUserID(t *testing.T) {
+	h := newTestHandler(nil)
+	valid := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want uint64
+		ok   bool
+	}{
+		{"valid token", withToken(t, 42, testJWTSecret, valid), 42, true},
+		{"no metadata", context.Background(), 0, false},
+		{"wrong secret", withToken(t, 42, "other-secret", valid), 0, false},
+		{"expired", withToken(t, 42, testJWTSecret, time.Now().Add(-time.Minute)), 0, false},
+		{"zero user", withToken(t, 0, testJWTSecret, valid), 0, false},
+	}
+	for _, tc := range cases {
+		got, err := h.authenticatedUserID(tc.ctx)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("%s: got (%d, %v), want (%d, ok=%v)", tc.name, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestAuthenticatedUserIDHonorsKeyRotation(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.JWT.Secret = "new-secret"
+	cfg.JWT.KeyID = "k2"
+	cfg.JWT.PreviousKeys = []config.JWTKeyConfig{
+		{KeyID: "k1", Secret: "old-secret", ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339)},
+		{KeyID: "k0", Secret: "older-secret", ExpiresAt: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+		{KeyID: "bad", Secret: "bad-secret", ExpiresAt: "tomorrow"},
+	}
+	h := &LiveServiceHandler{config: cfg, logger: nopLogger{}, verifier: newTokenVerifier(cfg.JWT, nil, nopLogger{})}
+	valid := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		name        string
+		kid, secret string
+		ok          bool
+	}{
+		{"current key", "k2", "new-secret", true},
+		{"previous key inside grace window", "k1", "old-secret", true},
+		{"previous key after grace window", "k0", "older-secret", false},
+		{"previous key with invalid expires_at", "bad", "bad-secret", false},
+		{"unknown kid", "k9", "new-secret", false},
+	}
+	for _, tc := range cases {
+		got, err := h.authenticatedUserID(withKeyToken(t, 42, tc.kid, tc.secret, valid))
+		if (err == nil) != tc.ok || (tc.ok && got != 42) {
+			t.Errorf("%s: got (%d, %v), want ok=%v", tc.name, got, err, tc.ok)
+		}
+	}
+}
+
+func TestAuthenticatedUserIDRejectsBlacklistedToken(t *testing.T) {
+	h := newTestHandler(nil)
+	revoked := signToken(t, 42, "", testJWTSecret, time.Now().Add(time.Hour))
+	h.verifier = jwtauth.NewVerifier(jwtauth.Key{Secret: testJWTSecret}, nil, memoryBlacklist{revoked: true})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, "Bearer "+revoked))
+	if _, err := h.authenticatedUserID(ctx); err == nil {
+		t.Fatal("blacklisted token was accepted")
+	}
+	if got := h.viewerUserID(ctx); got != 0 {
+		t.Fatalf("viewerUserID = %d for blacklisted token, want anonymous", got)
+	}
+	if got, err := h.authenticatedUserID(withToken(t, 43, testJWTSecret, time.Now().Add(time.Hour))); err != nil || got != 43 {
+		t.Fatalf("authenticatedUserID = (%d, %v), want 43", got, err)
+	}
+}
+
+func TestRefundLiveGiftUsesTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+
+	// 请求中填写的user_id不作为操作人
+	resp, err := h.RefundLiveGift(withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour)),
+		&proto_gen.RefundLiveGiftRequest{UserId: 99, GiftRecordId: 1})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("RefundLiveGift = (%v, %v), want code 200", resp, err)
+	}
+	if svc.operatorID != 7 {
+		t.Fatalf("operatorID = %d, want 7 from token", svc.operatorID)
+	}
+
+	svc.operatorID = 0
+	resp, _ = h.RefundLiveGift(context.Background(), &proto_gen.RefundLiveGiftRequest{UserId: 99, GiftRecordId: 1})
+	if resp.Code != 401 || svc.operatorID != 0 {
+		t.Fatalf("unauthenticated refund: code %d, service called with %d; want 401 and no call", resp.Code, svc.operatorID)
+	}
+}
+
+func TestUnaryAuthInterceptorBindsTokenUserID(t *testing.T) {
+	h := newTestHandler(nil)
+	interceptor := h.UnaryAuthInterceptor()
+	req := &proto_gen.StopLiveRequest{UserId: 99}
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want uint64
+		ok   bool
+	}{
+		{"valid token", withToken(t, 42, testJWTSecret, time.Now().Add(time.Hour)), 42, true},
+		{"no token", context.Background(), 0, false},
+		{"invalid token", withToken(t, 42, "other-secret", time.Now().Add(time.Hour)), 0, false},
+	}
+	for _, tc := range cases {
+		var got uint64
+		var ok bool
+		_, err := interceptor(tc.ctx, req, &grpc.UnaryServerInfo{FullMethod: "/live.LiveService/StopLive"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				got, ok = ctxkeys.UserID(ctx)
+				return nil, nil
+			})
+		if err != nil {
+			t.Fatalf("%s: interceptor error = %v", tc.name, err)
+		}
+		// 请求中由客户端填写的user_id不会被绑定
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("%s: bound user = (%d, %v), want (%d, %v)", tc.name, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestAuthenticatedUserIDUsesBoundUserID(t *testing.T) {
+	h := newTestHandler(nil)
+
+	ctx := ctxkeys.WithUserID(context.Background(), 42)
+	if got, err := h.authenticatedUserID(ctx); err != nil || got != 42 {
+		t.Fatalf("authenticatedUserID = (%d, %v), want 42 from context", got, err)
+	}
+	if got := h.viewerUserID(ctx); got != 42 {
+		t.Fatalf("viewerUserID = %d, want 42 from context", got)
+	}
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubChatListService 返回预设的聊天列表并记录查询参数
+type stubChatListService struct {
+	service.LiveService
+	chats []*model.LiveChat
+	total int64
+	err   error
+
+	streamID       uint64
+	page, pageSize int
+	oldestFirst    bool
+}
+
+func (s *stubChatListService) GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error) {
+	s.streamID, s.page, s.pageSize, s.oldestFirst = streamID, page, pageSize, oldestFirst
+	return s.chats, s.total, s.err
+}
+
+func TestGetLiveChatList(t *testing.T) {
+	svc := &stubChatListService{
+		chats: []*model.LiveChat{{ID: 2, StreamID: 3, Content: "第二条", Status: 1}, {ID: 1, StreamID: 3, Content: "第一条", Status: 1}},
+		total: 45,
+	}
+	resp, err := newTestHandler(svc).GetLiveChatList(context.Background(),
+		&proto_gen.GetLiveChatListRequest{StreamId: 3, Page: 2, PageSize: 20, OldestFirst: true, RequestId: "req-1"})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("GetLiveChatList = (%v, %v), want code 200", resp, err)
+	}
+	if svc.streamID != 3 || svc.page != 2 || svc.pageSize != 20 || !svc.oldestFirst {
+		t.Errorf("service called with stream %d page %d size %d oldestFirst %v", svc.streamID, svc.page, svc.pageSize, svc.oldestFirst)
+	}
+	if len(resp.Chats) != 2 || resp.Chats[0].Id != 2 || resp.Chats[0].Content != "第二条" || resp.Chats[1].Id != 1 {
+		t.Errorf("chats = %v, want converted chats in service order", resp.Chats)
+	}
+	if resp.Total != 45 || resp.TotalPages != 3 || resp.RequestId != "req-1" {
+		t.Errorf("total=%d pages=%d request=%q, want 45/3/req-1", resp.Total, resp.TotalPages, resp.RequestId)
+	}
+}
+
+func TestGetLiveChatListTotalPages(t *testing.T) {
+	tests := []struct {
+		pageSize int32
+		total    int64
+		want     int64
+	}{
+		{20, 0, 0},
+		{20, 20, 1},
+		{20, 21, 2},
+		{0, 25, 3},    // 未指定时按默认每页10条计算
+		{500, 250, 3}, // 超过上限时按每页100条计算
+	}
+	for _, tt := range tests {
+		resp, _ := newTestHandler(&stubChatListService{total: tt.total}).GetLiveChatList(context.Background(),
+			&proto_gen.GetLiveChatListRequest{StreamId: 3, Page: 1, PageSize: tt.pageSize})
+		if resp.TotalPages != tt.want {
+			t.Errorf("page size %d total %d: total pages = %d, want %d", tt.pageSize, tt.total, resp.TotalPages, tt.want)
+		}
+	}
+}
+
+func TestGetLiveChatListError(t *testing.T) {
+	resp, err := newTestHandler(&stubChatListService{err: errors.New("db down")}).GetLiveChatList(context.Background(),
+		&proto_gen.GetLiveChatListRequest{StreamId: 3, RequestId: "req-1"})
+	if err != nil || resp.Code != 500 || resp.RequestId != "req-1" || len(resp.Chats) != 0 {
+		t.Fatalf("service error: response (%v, %v), want code 500 without chats", resp, err)
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubSendChatService 返回预设的聊天消息或错误
+type stubSendChatService struct {
+	service.LiveService
+	err error
+}
+
+func (s *stubSendChatService) SendLiveChat(ctx context.Context, streamID, userID uint64, content, contentType string) (*model.LiveChat, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &model.LiveChat{ID: 1, StreamID: streamID, UserID: userID, Content: content, ContentType: contentType}, nil
+}
+
+func TestSendLiveChatErrorCodes(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int32
+	}{
+		{service.ErrChatContentEmpty, 400},
+		{service.ErrChatContentTooLong, 400},
+		{service.ErrChatEmojiInvalid, 400},
+		{service.ErrChatTypeInvalid, 400},
+		// 客户端不能冒充系统消息或送礼通知
+		{service.ErrChatTypeNotAllowed, 403},
+		{service.ErrUserMuted, 403},
+		{service.ErrStreamNotFound, 404},
+		{errors.New("db down"), 500},
+	}
+	for _, tt := range tests {
+		resp, err := newTestHandler(&stubSendChatService{err: tt.err}).SendLiveChat(context.Background(), &proto_gen.SendLiveChatRequest{StreamId: 1, UserId: 20, Content: "hi"})
+		if err != nil || resp.Code != tt.want || resp.Chat != nil {
+			t.Errorf("SendLiveChat with %v = (%v, %v), want code %d without a chat", tt.err, resp, err, tt.want)
+		}
+	}
+}
+
+func TestSendLiveChatReturnsChat(t *testing.T) {
+	resp, err := newTestHandler(&stubSendChatService{}).SendLiveChat(context.Background(), &proto_gen.SendLiveChatRequest{
+		StreamId: 1, UserId: 20, Content: "[smile]", ContentType: model.ContentTypeEmoji, RequestId: "req-1",
+	})
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("SendLiveChat = (%v, %v), want code 200", resp, err)
+	}
+	if resp.Chat.GetContent() != "[smile]" || resp.Chat.GetContentType() != model.ContentTypeEmoji {
+		t.Errorf("chat = %v, want the emoji message", resp.Chat)
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	"live_service/pkg/paginate"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubGiftListService 返回预设的礼物列表并记录查询参数
+type stubGiftListService struct {
+	service.LiveService
+	gifts      []*model.LiveGift
+	total      int64
+	nextCursor string
+	err        error
+
+	id             uint64
+	page, pageSize int
+	cursor         string
+}
+
+func (s *stubGiftListService) GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int, cursor string) ([]*model.LiveGift, int64, string, error) {
+	s.id, s.page, s.pageSize, s.cursor = streamID, page, pageSize, cursor
+	return s.gifts, s.total, s.nextCursor, s.err
+}
+
+func (s *stubGiftListService) GetUserLiveGiftList(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
+	s.id, s.page, s.pageSize = userID, page, pageSize
+	return s.gifts, s.total, s.err
+}
+
+func TestGetLiveGiftList(t *testing.T) {
+	svc := &stubGiftListService{
+		gifts:      []*model.LiveGift{{ID: 2, StreamID: 3, GiftName: "火箭"}, {ID: 1, StreamID: 3, GiftName: "鲜花"}},
+		total:      5,
+		nextCursor: "next",
+	}
+	resp, err := newTestHandler(svc).GetLiveGiftList(context.Background(),
+		&proto_gen.GetLiveGiftListRequest{StreamId: 3, Page: 2, PageSize: 2, Cursor: "cur", RequestId: "req-1"})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("GetLiveGiftList = (%v, %v), want code 200", resp, err)
+	}
+	if svc.id != 3 || svc.page != 2 || svc.pageSize != 2 || svc.cursor != "cur" {
+		t.Errorf("service called with stream %d page %d size %d cursor %q", svc.id, svc.page, svc.pageSize, svc.cursor)
+	}
+	if len(resp.Gifts) != 2 || resp.Gifts[0].Id != 2 || resp.Gifts[0].GiftName != "火箭" || resp.Gifts[1].Id != 1 {
+		t.Errorf("gifts = %v, want converted gifts in service order", resp.Gifts)
+	}
+	if resp.Total != 5 || resp.NextCursor != "next" || resp.RequestId != "req-1" {
+		t.Errorf("total=%d next=%q request=%q, want 5/next/req-1", resp.Total, resp.NextCursor, resp.RequestId)
+	}
+}
+
+func TestGetLiveGiftListErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int32
+	}{
+		{paginate.ErrInvalidCursor, 400},
+		{errors.New("db down"), 500},
+	}
+	for _, tt := range tests {
+		resp, err := newTestHandler(&stubGiftListService{err: tt.err}).GetLiveGiftList(context.Background(),
+			&proto_gen.GetLiveGiftListRequest{StreamId: 3, RequestId: "req-1"})
+		if err != nil || resp.Code != tt.code || resp.RequestId != "req-1" || len(resp.Gifts) != 0 {
+			t.Errorf("service error %v: response (%v, %v), want code %d", tt.err, resp, err, tt.code)
+		}
+	}
+}
+
+func TestGetUserLiveGiftList(t *testing.T) {
+	svc := &stubGiftListService{gifts: []*model.LiveGift{{ID: 4, UserID: 7, TotalValue: 100}}, total: 1}
+	resp, err := newTestHandler(svc).GetUserLiveGiftList(context.Background(),
+		&proto_gen.GetUserLiveGiftListRequest{UserId: 7, Page: 1, PageSize: 20})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("GetUserLiveGiftList = (%v, %v), want code 200", resp, err)
+	}
+	if svc.id != 7 || svc.page != 1 || svc.pageSize != 20 {
+		t.Errorf("service called with user %d page %d size %d", svc.id, svc.page, svc.pageSize)
+	}
+	if resp.Total != 1 || len(resp.Gifts) != 1 || resp.Gifts[0].UserId != 7 || resp.Gifts[0].TotalValue != 100 {
+		t.Errorf("response = %v, want one converted gift", resp)
+	}
+
+	resp, err = newTestHandler(&stubGiftListService{err: errors.New("db down")}).GetUserLiveGiftList(context.Background(),
+		&proto_gen.GetUserLiveGiftListRequest{UserId: 7})
+	if err != nil || resp.Code != 500 || len(resp.Gifts) != 0 {
+		t.Errorf("service error: response (%v, %v), want code 500", resp, err)
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubGiftStatsService 返回预设的礼物统计并记录查询的直播流
+type stubGiftStatsService struct {
+	service.LiveService
+	stats    *service.GiftStats
+	err      error
+	streamID uint64
+}
+
+func (s *stubGiftStatsService) GetLiveGiftStats(ctx context.Context, streamID uint64) (*service.GiftStats, error) {
+	s.streamID = streamID
+	return s.stats, s.err
+}
+
+func TestGetLiveGiftStats(t *testing.T) {
+	svc := &stubGiftStatsService{stats: &service.GiftStats{
+		StreamID:      3,
+		TotalGifts:    4,
+		TotalValue:    1020,
+		TotalCoins:    1020,
+		UniqueSenders: 2,
+		TopGiftID:     4,
+		TopGiftCount:  2,
+		TopGiftValue:  1000,
+	}}
+	resp, err := newTestHandler(svc).GetLiveGiftStats(context.Background(), &proto_gen.GetLiveGiftStatsRequest{StreamId: 3, RequestId: "req-1"})
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("GetLiveGiftStats = (%v, %v), want code 200", resp, err)
+	}
+	if svc.streamID != 3 {
+		t.Errorf("service called with stream %d, want 3", svc.streamID)
+	}
+	stats := resp.Stats
+	if stats.GetStreamId() != 3 || stats.GetTotalGifts() != 4 || stats.GetTotalValue() != 1020 || stats.GetTotalCoins() != 1020 ||
+		stats.GetUniqueSenders() != 2 || stats.GetTopGiftId() != 4 || stats.GetTopGiftCount() != 2 || stats.GetTopGiftValue() != 1000 {
+		t.Errorf("stats = %v, want the converted gift stats", stats)
+	}
+}
+
+func TestGetLiveGiftStatsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int32
+	}{
+		{"not found", service.ErrStreamNotFound, 404},
+		{"internal", errors.New("db down"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestHandler(&stubGiftStatsService{err: tt.err}).GetLiveGiftStats(context.Background(),
+				&proto_gen.GetLiveGiftStatsRequest{StreamId: 3, RequestId: "req-1"})
+			if err != nil || resp.Code != tt.want || resp.RequestId != "req-1" || resp.Stats != nil {
+				t.Errorf("GetLiveGiftStats = (%v, %v), want code %d without stats", resp, err, tt.want)
+			}
+		})
+	}
+}
@@ -2,16 +2,27 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	pb "live_service/proto/proto_gen/audit"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 
+	"common/jwtauth"
+
 	"live_service/internal/config"
+	"live_service/internal/converter"
+	"live_service/internal/model"
+	"live_service/internal/repository"
 	"live_service/internal/service"
 	"live_service/pkg/logger"
+	"live_service/pkg/paginate"
 	proto_gen "live_service/proto/proto_gen"
 	auditv1 "live_service/proto/proto_gen/audit"
 )
@@ -21,6 +32,7 @@ type LiveServiceHandler struct {
 	config       *config.Config
 	logger       logger.Logger
 	liveService  service.LiveService
+	verifier     *jwtauth.Verifier
 	auditManager interface { // 使用接口定义，降低耦合
 		SubmitContent(ctx context.Context, req interface{}) (interface{}, error)
 		GetAuditResult(ctx context.Context, req *pb.GetAuditResultRequest) (*pb.GetAuditResultResponse, error)
@@ -30,7 +42,7 @@ type LiveServiceHandler struct {
 }
 
 // NewLiveServiceHandler 创建直播服务处理器
-func NewLiveServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis *redis.Client) *LiveServiceHandler {
+func NewLiveServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis redis.UniversalClient) *LiveServiceHandler {
 	// 创建直播服务
 	liveService := service.NewLiveService(cfg, log, db, redis)
 
@@ -38,9 +50,15 @@ func NewLiveServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, r
 		config:      cfg,
 		logger:      log,
 		liveService: liveService,
+		verifier:    newTokenVerifier(cfg.JWT, redis, log),
 	}
 }
 
+// FlushStats 提交内存中尚未写入的实时统计，服务退出时调用
+func (h *LiveServiceHandler) FlushStats(ctx context.Context) error {
+	return h.liveService.Close(ctx)
+}
+
 // SetAuditManager 设置审计管理器
 func (h *LiveServiceHandler) SetAuditManager(manager interface {
 	SubmitContent(ctx context.Context, req interface{}) (interface{}, error)
@@ -60,12 +78,28 @@ func (h *LiveServiceHandler) StartLive(ctx context.Context, req *proto_gen.Start
 
 	// 如果有审核服务客户端管理器，调用审核服务进行直播间审核
 	if h.auditManager != nil {
-		// 创建审核请求 - 使用pb_gen生成的类型
+		// 标题和简介单独提交文本审核，任一被直接拒绝时不允许开播
+		if rejected, reason := h.moderateLiveText(ctx, streamID, req.UserId, liveTextFieldTitle, req.Title); rejected {
+			return &proto_gen.StartLiveResponse{
+				Code:      403,
+				Message:   fmt.Sprintf("直播标题违规，无法开始直播: %s", reason),
+				RequestId: req.RequestId,
+			}, nil
+		}
+		if rejected, reason := h.moderateLiveText(ctx, streamID, req.UserId, liveTextFieldDescription, req.Description); rejected {
+			return &proto_gen.StartLiveResponse{
+				Code:      403,
+				Message:   fmt.Sprintf("直播简介违规，无法开始直播: %s", reason),
+				RequestId: req.RequestId,
+			}, nil
+		}
+
+		// 创建直播流审核请求 - 使用pb_gen生成的类型
 		auditReq := &auditv1.SubmitContentRequest{
-			ContentId:   fmt.Sprintf("live_%s", streamID),
-			ContentType: auditv1.ContentType_CONTENT_TYPE_LIVE, // 使用pb_gen定义的常量
-			UploaderId:  req.UserId,
-			Content:     req.Description,
+			ContentId:    fmt.Sprintf("live_%s", streamID),
+			ContentType:  auditv1.ContentType_CONTENT_TYPE_LIVE, // 使用pb_gen定义的常量
+			ContentTitle: req.Title,
+			UploaderId:   req.UserId,
 			Metadata: map[string]string{
 				"title":       req.Title,
 				"create_time": time.Now().Format(time.RFC3339),
@@ -73,8 +107,13 @@ func (h *LiveServiceHandler) StartLive(ctx context.Context, req *proto_gen.Start
 		}
 
 		// 调用审核服务
-		resp, err := h.auditManager.SubmitContent(ctx, auditReq)
-		if err != nil {
+		resp, err := h.submitAudit(ctx, auditReq)
+		if isDeadlineExceeded(err) {
+			// 审核超时按待审核处理，允许直播开始
+			h.logger.Warn("Live content audit timed out, treating as pending",
+				"content_id", auditReq.ContentId,
+				"timeout", h.config.Services.AuditService.GetCallTimeout())
+		} else if err != nil {
 			h.logger.Error("Failed to submit live content for audit", "error", err, "content_id", auditReq.ContentId)
 			// 审核服务调用失败，仍然允许直播开始，但记录日志
 			// 这里可以根据业务需求决定是否阻止直播开始
@@ -119,33 +158,58 @@ func (h *LiveServiceHandler) StartLive(ctx context.Context, req *proto_gen.Start
 		h.logger.Warn("Audit manager not available, skipping content audit", "content_id", streamID)
 	}
 
-	// TODO: 实现开始直播逻辑
-	h.logger.Info("Starting live stream",
-		"stream_id", streamID,
-		"user_id", req.UserId,
-		"title", req.Title)
+	stream, err := h.liveService.StartLive(ctx, req.UserId, req.Title, req.Description, req.CategoryId, req.Language, req.Region)
+	if err != nil {
+		resp := &proto_gen.StartLiveResponse{
+			RequestId: req.RequestId,
+		}
+		if errors.Is(err, service.ErrRoomBanned) {
+			resp.Code = 403
+			resp.Message = "直播间已被禁播"
+		} else {
+			h.logger.Error("Failed to start live", "user_id", req.UserId, "error", err)
+			resp.Code = 500
+			resp.Message = "直播开始失败"
+		}
+		return resp, nil
+	}
 
 	return &proto_gen.StartLiveResponse{
 		Code:      200,
 		Message:   "直播开始成功",
 		RequestId: req.RequestId,
-		Stream: &proto_gen.LiveStream{
-			Id:          3,
-			UserId:      req.UserId,
-			Title:       req.Title,
-			Status:      "live",
-			ViewerCount: 0,
-		},
-		StreamUrl: fmt.Sprintf("rtmp://localhost:1935/live/%s", streamID),
-		StreamKey: streamID,
+		Stream:    converter.LiveStreamToProto(stream),
+		StreamUrl: fmt.Sprintf("rtmp://localhost:1935/live/%s", stream.StreamKey),
+		StreamKey: stream.StreamKey,
 	}, nil
 }
 
 // StopLive 结束直播
 func (h *LiveServiceHandler) StopLive(ctx context.Context, req *proto_gen.StopLiveRequest) (*proto_gen.StopLiveResponse, error) {
-	h.logger.Info("StopLive called")
+	h.logger.Info("StopLive called", "stream_id", req.StreamId, "user_id", req.UserId)
+
+	if err := h.liveService.StopLive(ctx, req.StreamId, req.UserId); err != nil {
+		resp := &proto_gen.StopLiveResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "无权结束该直播"
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			resp.Code = 400
+			resp.Message = "当前直播状态不允许结束"
+		default:
+			h.logger.Error("Failed to stop live", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "直播结束失败"
+		}
+		return resp, nil
+	}
 
-	// TODO: 实现结束直播逻辑
 	return &proto_gen.StopLiveResponse{
 		Code:      200,
 		Message:   "直播结束成功",
@@ -153,30 +217,258 @@ func (h *LiveServiceHandler) StopLive(ctx context.Context, req *proto_gen.StopLi
 	}, nil
 }
 
+// PauseLive 暂停直播
+func (h *LiveServiceHandler) PauseLive(ctx context.Context, req *proto_gen.PauseLiveRequest) (*proto_gen.PauseLiveResponse, error) {
+	h.logger.Info("PauseLive called", "stream_id", req.StreamId)
+
+	// 主播取自访问令牌，不信任请求中的user_id
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.PauseLiveResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	if err := h.liveService.PauseLive(ctx, req.StreamId, userID); err != nil {
+		resp := &proto_gen.PauseLiveResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "无权暂停该直播"
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			resp.Code = 400
+			resp.Message = "当前直播状态不允许暂停"
+		default:
+			h.logger.Error("Failed to pause live", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "直播暂停失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.PauseLiveResponse{
+		Code:      200,
+		Message:   "直播已暂停",
+		RequestId: req.RequestId,
+	}, nil
+}
+
+// ResumeLive 恢复直播
+func (h *LiveServiceHandler) ResumeLive(ctx context.Context, req *proto_gen.ResumeLiveRequest) (*proto_gen.ResumeLiveResponse, error) {
+	h.logger.Info("ResumeLive called", "stream_id", req.StreamId)
+
+	// 主播取自访问令牌，不信任请求中的user_id
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.ResumeLiveResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	if err := h.liveService.ResumeLive(ctx, req.StreamId, userID); err != nil {
+		resp := &proto_gen.ResumeLiveResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "无权恢复该直播"
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			resp.Code = 400
+			resp.Message = "当前直播状态不允许恢复"
+		default:
+			h.logger.Error("Failed to resume live", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "直播恢复失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.ResumeLiveResponse{
+		Code:      200,
+		Message:   "直播已恢复",
+		RequestId: req.RequestId,
+	}, nil
+}
+
+// GetLiveRoom 获取直播间
+func (h *LiveServiceHandler) GetLiveRoom(ctx context.Context, req *proto_gen.GetLiveRoomRequest) (*proto_gen.GetLiveRoomResponse, error) {
+	h.logger.Info("GetLiveRoom called", "room_id", req.RoomId, "streamer_id", req.StreamerId)
+
+	if req.RoomId == 0 && req.StreamerId == 0 {
+		return &proto_gen.GetLiveRoomResponse{
+			Code:      400,
+			Message:   "直播间ID和主播ID不能同时为空",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	room, err := h.liveService.GetLiveRoom(ctx, req.RoomId, req.StreamerId)
+	if err != nil {
+		resp := &proto_gen.GetLiveRoomResponse{
+			RequestId: req.RequestId,
+		}
+		if errors.Is(err, service.ErrRoomNotFound) {
+			resp.Code = 404
+			resp.Message = "直播间不存在"
+		} else {
+			h.logger.Error("Failed to get live room", "room_id", req.RoomId, "streamer_id", req.StreamerId, "error", err)
+			resp.Code = 500
+			resp.Message = "获取直播间失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.GetLiveRoomResponse{
+		Code:      200,
+		Message:   "获取直播间成功",
+		RequestId: req.RequestId,
+		Room:      converter.LiveRoomToProto(room),
+	}, nil
+}
+
+// GetUserLiveStream 获取用户当前的直播
+func (h *LiveServiceHandler) GetUserLiveStream(ctx context.Context, req *proto_gen.GetUserLiveStreamRequest) (*proto_gen.GetUserLiveStreamResponse, error) {
+	h.logger.Info("GetUserLiveStream called", "target_user_id", req.TargetUserId)
+
+	if req.TargetUserId == 0 {
+		return &proto_gen.GetUserLiveStreamResponse{
+			Code:      400,
+			Message:   "主播ID不能为空",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	stream, err := h.liveService.GetUserLiveStream(ctx, req.TargetUserId)
+	if err != nil {
+		resp := &proto_gen.GetUserLiveStreamResponse{
+			RequestId: req.RequestId,
+		}
+		if errors.Is(err, service.ErrStreamNotFound) {
+			resp.Code = 404
+			resp.Message = "用户未在直播"
+		} else {
+			h.logger.Error("Failed to get user live stream", "target_user_id", req.TargetUserId, "error", err)
+			resp.Code = 500
+			resp.Message = "获取用户直播失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.GetUserLiveStreamResponse{
+		Code:      200,
+		Message:   "获取用户直播成功",
+		RequestId: req.RequestId,
+		Stream:    converter.LiveStreamToProto(stream),
+	}, nil
+}
+
 // GetLiveStream 获取直播流信息
 func (h *LiveServiceHandler) GetLiveStream(ctx context.Context, req *proto_gen.GetLiveStreamRequest) (*proto_gen.GetLiveStreamResponse, error) {
-	h.logger.Info("GetLiveStream called")
+	h.logger.Info("GetLiveStream called", "stream_id", req.StreamId)
+
+	stream, err := h.liveService.GetLiveStream(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, service.ErrStreamNotFound) {
+			return &proto_gen.GetLiveStreamResponse{
+				Code:      404,
+				Message:   "直播不存在或已结束",
+				RequestId: req.RequestId,
+			}, nil
+		}
+		h.logger.Error("Failed to get live stream", "stream_id", req.StreamId, "error", err)
+		return &proto_gen.GetLiveStreamResponse{
+			Code:      500,
+			Message:   "获取直播流信息失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现获取直播流信息逻辑
 	return &proto_gen.GetLiveStreamResponse{
 		Code:      200,
 		Message:   "获取直播流信息成功",
 		RequestId: req.RequestId,
-		Stream:    &proto_gen.LiveStream{},
+		Stream:    converter.LiveStreamToProto(stream),
 	}, nil
 }
 
 // GetLiveList 获取直播列表
 func (h *LiveServiceHandler) GetLiveList(ctx context.Context, req *proto_gen.GetLiveListRequest) (*proto_gen.GetLiveListResponse, error) {
-	h.logger.Info("GetLiveList called")
+	viewerID := h.viewerUserID(ctx)
+	h.logger.Info("GetLiveList called", "user_id", viewerID, "category_id", req.CategoryId)
+
+	streams, total, err := h.liveService.GetLiveList(ctx, viewerID, int(req.Page), int(req.PageSize), req.CategoryId)
+	if err != nil {
+		h.logger.Error("Failed to get live list", "error", err)
+		return &proto_gen.GetLiveListResponse{
+			Code:      500,
+			Message:   "获取直播列表失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现获取直播列表逻辑
 	return &proto_gen.GetLiveListResponse{
+		Code:       200,
+		Message:    "获取直播列表成功",
+		RequestId:  req.RequestId,
+		Streams:    converter.LiveStreamListToProto(streams),
+		Total:      total,
+		TotalPages: paginate.NewPage(int(req.Page), int(req.PageSize)).TotalPages(total),
+	}, nil
+}
+
+// UpdateLiveStreamPrivacy 更新直播隐私设置
+func (h *LiveServiceHandler) UpdateLiveStreamPrivacy(ctx context.Context, req *proto_gen.UpdateLiveStreamPrivacyRequest) (*proto_gen.UpdateLiveStreamPrivacyResponse, error) {
+	h.logger.Info("UpdateLiveStreamPrivacy called", "stream_id", req.StreamId, "is_public", req.IsPublic)
+
+	// 主播取自访问令牌，不信任请求中的user_id
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.UpdateLiveStreamPrivacyResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	if err := h.liveService.UpdateLiveStreamPrivacy(ctx, req.StreamId, userID, req.IsPublic, req.Password); err != nil {
+		resp := &proto_gen.UpdateLiveStreamPrivacyResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "无权修改该直播"
+		case errors.Is(err, service.ErrRoomPasswordTooLong):
+			resp.Code = 400
+			resp.Message = "房间密码过长"
+		default:
+			h.logger.Error("Failed to update live stream privacy", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "更新直播隐私设置失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.UpdateLiveStreamPrivacyResponse{
 		Code:      200,
-		Message:   "获取直播列表成功",
+		Message:   "更新直播隐私设置成功",
 		RequestId: req.RequestId,
-		Streams:   []*proto_gen.LiveStream{},
-		Total:     0,
 	}, nil
 }
 
@@ -194,24 +486,136 @@ func (h *LiveServiceHandler) GetHotLiveList(ctx context.Context, req *proto_gen.
 	}, nil
 }
 
+// AuthenticateStreamKey 推流鉴权回调
+func (h *LiveServiceHandler) AuthenticateStreamKey(ctx context.Context, req *proto_gen.AuthenticateStreamKeyRequest) (*proto_gen.AuthenticateStreamKeyResponse, error) {
+	h.logger.Info("AuthenticateStreamKey called", "stream_key", model.MaskStreamKey(req.StreamKey), "client_ip", req.ClientIp)
+
+	stream, err := h.liveService.AuthenticateStreamKey(ctx, req.StreamKey)
+	if err != nil {
+		resp := &proto_gen.AuthenticateStreamKeyResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamKeyNotFound):
+			resp.Code = 404
+			resp.Message = "推流密钥不存在"
+		case errors.Is(err, service.ErrStreamKeyInactive):
+			resp.Code = 403
+			resp.Message = "直播已结束，推流密钥已失效"
+		default:
+			h.logger.Error("Failed to authenticate stream key", "stream_key", model.MaskStreamKey(req.StreamKey), "error", err)
+			resp.Code = 500
+			resp.Message = "推流鉴权失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.AuthenticateStreamKeyResponse{
+		Code:      200,
+		Message:   "推流鉴权成功",
+		RequestId: req.RequestId,
+		StreamId:  stream.ID,
+		UserId:    stream.UserID,
+	}, nil
+}
+
+// OnStreamEnded 断流回调
+func (h *LiveServiceHandler) OnStreamEnded(ctx context.Context, req *proto_gen.OnStreamEndedRequest) (*proto_gen.OnStreamEndedResponse, error) {
+	h.logger.Info("OnStreamEnded called", "stream_key", model.MaskStreamKey(req.StreamKey), "client_ip", req.ClientIp)
+
+	stream, err := h.liveService.OnStreamEnded(ctx, req.StreamKey)
+	if err != nil {
+		resp := &proto_gen.OnStreamEndedResponse{
+			RequestId: req.RequestId,
+		}
+		if errors.Is(err, service.ErrStreamKeyNotFound) {
+			resp.Code = 404
+			resp.Message = "推流密钥不存在"
+		} else {
+			h.logger.Error("Failed to handle stream ended", "stream_key", model.MaskStreamKey(req.StreamKey), "error", err)
+			resp.Code = 500
+			resp.Message = "断流处理失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.OnStreamEndedResponse{
+		Code:      200,
+		Message:   "断流处理成功",
+		RequestId: req.RequestId,
+		StreamId:  stream.ID,
+		Duration:  stream.Duration,
+	}, nil
+}
+
 // JoinLiveRoom 加入直播间
 func (h *LiveServiceHandler) JoinLiveRoom(ctx context.Context, req *proto_gen.JoinLiveRoomRequest) (*proto_gen.JoinLiveRoomResponse, error) {
-	h.logger.Info("JoinLiveRoom called")
+	h.logger.Info("JoinLiveRoom called", "stream_id", req.StreamId)
+
+	// 观看者取自访问令牌，私密直播的可见性按令牌中的用户判断，不信任请求中的user_id
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.JoinLiveRoomResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	viewer, err := h.liveService.JoinLiveRoom(ctx, req.StreamId, userID, req.Password)
+	if err != nil {
+		resp := &proto_gen.JoinLiveRoomResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound), errors.Is(err, service.ErrStreamPrivate):
+			// 私密直播对非关注者按不存在处理，与列表和搜索中隐藏保持一致
+			resp.Code = 404
+			resp.Message = "直播不存在或已结束"
+		case errors.Is(err, service.ErrRoomPasswordRequired):
+			resp.Code = 401
+			resp.Message = "该直播间需要密码"
+		case errors.Is(err, service.ErrRoomPasswordIncorrect):
+			resp.Code = 403
+			resp.Message = "直播间密码错误"
+		default:
+			h.logger.Error("Failed to join live room", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "加入直播间失败"
+		}
+		return resp, nil
+	}
 
-	// TODO: 实现加入直播间逻辑
 	return &proto_gen.JoinLiveRoomResponse{
 		Code:      200,
 		Message:   "加入直播间成功",
 		RequestId: req.RequestId,
-		Viewer:    &proto_gen.LiveViewer{},
+		Viewer:    converter.LiveViewerToProto(viewer),
 	}, nil
 }
 
 // LeaveLiveRoom 离开直播间
 func (h *LiveServiceHandler) LeaveLiveRoom(ctx context.Context, req *proto_gen.LeaveLiveRoomRequest) (*proto_gen.LeaveLiveRoomResponse, error) {
-	h.logger.Info("LeaveLiveRoom called")
+	h.logger.Info("LeaveLiveRoom called", "stream_id", req.StreamId)
+
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.LeaveLiveRoomResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	if err := h.liveService.LeaveLiveRoom(ctx, req.StreamId, userID); err != nil {
+		h.logger.Error("Failed to leave live room", "stream_id", req.StreamId, "user_id", userID, "error", err)
+		return &proto_gen.LeaveLiveRoomResponse{
+			Code:      500,
+			Message:   "离开直播间失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现离开直播间逻辑
 	return &proto_gen.LeaveLiveRoomResponse{
 		Code:      200,
 		Message:   "离开直播间成功",
@@ -219,110 +623,736 @@ func (h *LiveServiceHandler) LeaveLiveRoom(ctx context.Context, req *proto_gen.L
 	}, nil
 }
 
+// Heartbeat 观看者心跳，维持在线状态
+func (h *LiveServiceHandler) Heartbeat(ctx context.Context, req *proto_gen.HeartbeatRequest) (*proto_gen.HeartbeatResponse, error) {
+	interval := int32(service.HeartbeatInterval(h.config) / time.Second)
+
+	if err := h.liveService.Heartbeat(ctx, req.StreamId, req.UserId); err != nil {
+		resp := &proto_gen.HeartbeatResponse{
+			RequestId: req.RequestId,
+		}
+		if errors.Is(err, service.ErrViewerNotInRoom) {
+			resp.Code = 404
+			resp.Message = "未在直播间中，请重新进入"
+		} else {
+			h.logger.Error("Failed to handle heartbeat", "stream_id", req.StreamId, "user_id", req.UserId, "error", err)
+			resp.Code = 500
+			resp.Message = "心跳上报失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.HeartbeatResponse{
+		Code:      200,
+		Message:   "心跳上报成功",
+		RequestId: req.RequestId,
+		Interval:  interval,
+	}, nil
+}
+
+// SubscribeLiveEvents 订阅直播间实时事件，持续推送直到客户端断开或服务退出
+// 流式接口没有响应码，订阅失败以gRPC状态码返回
+func (h *LiveServiceHandler) SubscribeLiveEvents(req *proto_gen.SubscribeLiveEventsRequest, stream proto_gen.LiveService_SubscribeLiveEventsServer) error {
+	h.logger.Info("SubscribeLiveEvents called", "stream_id", req.StreamId, "user_id", req.UserId)
+
+	ctx := stream.Context()
+	sub, err := h.liveService.SubscribeLiveEvents(ctx, req.StreamId, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound), errors.Is(err, service.ErrStreamPrivate):
+			// 私密直播对非关注者按不存在处理，与进入直播间保持一致
+			return status.Error(codes.NotFound, "直播不存在或已结束")
+		default:
+			h.logger.Error("Failed to subscribe live events", "stream_id", req.StreamId, "user_id", req.UserId, "error", err)
+			return status.Error(codes.Internal, "订阅直播间事件失败")
+		}
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Error(codes.Unavailable, "直播间事件订阅已关闭")
+			}
+			if event.Type == model.LiveEventViewerCount {
+				// 在线人数由StreamViewerCount限频推送
+				continue
+			}
+			if err := stream.Send(converter.LiveEventToProto(event)); err != nil {
+				return err
+			}
+			if event.Type == model.LiveEventTerminated || event.Type == model.LiveEventEnded {
+				// 直播已结束，推送结束事件后结束订阅
+				return nil
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// StreamViewerCount 推送直播间在线人数，人数变化时推送，最多每秒一次，直播结束时结束推送
+func (h *LiveServiceHandler) StreamViewerCount(req *proto_gen.StreamViewerCountRequest, stream proto_gen.LiveService_StreamViewerCountServer) error {
+	h.logger.Info("StreamViewerCount called", "stream_id", req.StreamId, "user_id", req.UserId)
+
+	ctx := stream.Context()
+	counts, err := h.liveService.StreamViewerCount(ctx, req.StreamId, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound), errors.Is(err, service.ErrStreamPrivate):
+			return status.Error(codes.NotFound, "直播不存在或已结束")
+		default:
+			h.logger.Error("Failed to stream viewer count", "stream_id", req.StreamId, "user_id", req.UserId, "error", err)
+			return status.Error(codes.Internal, "订阅在线人数失败")
+		}
+	}
+
+	for count := range counts {
+		if err := stream.Send(&proto_gen.ViewerCountUpdate{
+			StreamId:    req.StreamId,
+			ViewerCount: uint32(count),
+			Timestamp:   time.Now().UnixMilli(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SendLiveChat 发送直播聊天消息
 func (h *LiveServiceHandler) SendLiveChat(ctx context.Context, req *proto_gen.SendLiveChatRequest) (*proto_gen.SendLiveChatResponse, error) {
-	h.logger.Info("SendLiveChat called")
+	h.logger.Info("SendLiveChat called", "stream_id", req.StreamId, "user_id", req.UserId)
+
+	chat, err := h.liveService.SendLiveChat(ctx, req.StreamId, req.UserId, req.Content, req.ContentType)
+	if err != nil {
+		resp := &proto_gen.SendLiveChatResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrChatContentEmpty):
+			resp.Code = 400
+			resp.Message = "消息内容不能为空"
+		case errors.Is(err, service.ErrChatContentTooLong):
+			resp.Code = 400
+			resp.Message = "消息内容过长"
+		case errors.Is(err, service.ErrChatEmojiInvalid):
+			resp.Code = 400
+			resp.Message = "不支持的表情"
+		case errors.Is(err, service.ErrChatTypeInvalid):
+			resp.Code = 400
+			resp.Message = "不支持的消息类型"
+		case errors.Is(err, service.ErrChatTypeNotAllowed):
+			resp.Code = 403
+			resp.Message = "不允许发送该类型的消息"
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrUserMuted):
+			resp.Code = 403
+			resp.Message = "您已被禁言，暂时无法发言"
+		case errors.Is(err, service.ErrChatRejected):
+			resp.Code = 403
+			resp.Message = "消息包含违规内容"
+		default:
+			h.logger.Error("Failed to send live chat", "stream_id", req.StreamId, "user_id", req.UserId, "error", err)
+			resp.Code = 500
+			resp.Message = "消息发送失败"
+		}
+		return resp, nil
+	}
 
-	// TODO: 实现发送直播聊天消息逻辑
 	return &proto_gen.SendLiveChatResponse{
 		Code:      200,
 		Message:   "消息发送成功",
 		RequestId: req.RequestId,
-		Chat:      &proto_gen.LiveChat{},
+		Chat:      converter.LiveChatToProto(chat),
 	}, nil
 }
 
 // GetLiveChatList 获取直播聊天列表
 func (h *LiveServiceHandler) GetLiveChatList(ctx context.Context, req *proto_gen.GetLiveChatListRequest) (*proto_gen.GetLiveChatListResponse, error) {
-	h.logger.Info("GetLiveChatList called")
+	h.logger.Info("GetLiveChatList called", "stream_id", req.StreamId, "page", req.Page, "page_size", req.PageSize, "oldest_first", req.OldestFirst)
+
+	chats, total, err := h.liveService.GetLiveChatList(ctx, req.StreamId, int(req.Page), int(req.PageSize), req.OldestFirst)
+	if err != nil {
+		h.logger.Error("Failed to get live chat list", "stream_id", req.StreamId, "error", err)
+		return &proto_gen.GetLiveChatListResponse{
+			Code:      500,
+			Message:   "获取直播聊天列表失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现获取直播聊天列表逻辑
 	return &proto_gen.GetLiveChatListResponse{
+		Code:       200,
+		Message:    "获取直播聊天列表成功",
+		RequestId:  req.RequestId,
+		Chats:      converter.LiveChatListToProto(chats),
+		Total:      total,
+		TotalPages: paginate.NewPage(int(req.Page), int(req.PageSize)).TotalPages(total),
+	}, nil
+}
+
+// MuteViewer 直播间禁言观众
+func (h *LiveServiceHandler) MuteViewer(ctx context.Context, req *proto_gen.MuteViewerRequest) (*proto_gen.MuteViewerResponse, error) {
+	h.logger.Info("MuteViewer called", "stream_id", req.StreamId, "user_id", req.UserId, "target_user_id", req.TargetUserId, "duration", req.Duration)
+
+	// 操作人取自访问令牌，请求中的user_id由客户端填写，不能用于判断主播或管理员身份
+	operatorID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.MuteViewerResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	if err := h.liveService.MuteViewer(ctx, req.StreamId, operatorID, req.TargetUserId, req.Duration, req.Reason); err != nil {
+		resp := &proto_gen.MuteViewerResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "仅主播或管理员可以禁言"
+		case errors.Is(err, service.ErrCannotMuteStreamer):
+			resp.Code = 400
+			resp.Message = "不能禁言主播"
+		default:
+			h.logger.Error("Failed to mute viewer", "stream_id", req.StreamId, "target_user_id", req.TargetUserId, "error", err)
+			resp.Code = 500
+			resp.Message = "禁言失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.MuteViewerResponse{
 		Code:      200,
-		Message:   "获取直播聊天列表成功",
+		Message:   "禁言成功",
+		RequestId: req.RequestId,
+	}, nil
+}
+
+// UnmuteViewer 解除观众禁言
+func (h *LiveServiceHandler) UnmuteViewer(ctx context.Context, req *proto_gen.UnmuteViewerRequest) (*proto_gen.UnmuteViewerResponse, error) {
+	h.logger.Info("UnmuteViewer called", "stream_id", req.StreamId, "user_id", req.UserId, "target_user_id", req.TargetUserId)
+
+	// 操作人取自访问令牌，请求中的user_id由客户端填写，不能用于判断主播或管理员身份
+	operatorID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.UnmuteViewerResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	if err := h.liveService.UnmuteViewer(ctx, req.StreamId, operatorID, req.TargetUserId); err != nil {
+		resp := &proto_gen.UnmuteViewerResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "仅主播或管理员可以解除禁言"
+		default:
+			h.logger.Error("Failed to unmute viewer", "stream_id", req.StreamId, "target_user_id", req.TargetUserId, "error", err)
+			resp.Code = 500
+			resp.Message = "解除禁言失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.UnmuteViewerResponse{
+		Code:      200,
+		Message:   "解除禁言成功",
 		RequestId: req.RequestId,
-		Chats:     []*proto_gen.LiveChat{},
 	}, nil
 }
 
 // SendLiveGift 发送直播礼物
 func (h *LiveServiceHandler) SendLiveGift(ctx context.Context, req *proto_gen.SendLiveGiftRequest) (*proto_gen.SendLiveGiftResponse, error) {
-	h.logger.Info("SendLiveGift called")
+	h.logger.Info("SendLiveGift called", "stream_id", req.StreamId, "gift_id", req.GiftId)
+
+	// 送礼用户取自访问令牌，不信任请求中的user_id
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.SendLiveGiftResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	gift, err := h.liveService.SendLiveGift(ctx, req.StreamId, userID, req.GiftId, req.GiftCount, req.RequestId)
+	if err != nil {
+		if errors.Is(err, service.ErrGiftRequestInProgress) {
+			return &proto_gen.SendLiveGiftResponse{
+				Code:      409,
+				Message:   "礼物请求处理中，请稍后重试",
+				RequestId: req.RequestId,
+			}, nil
+		}
+		h.logger.Error("Failed to send live gift", "stream_id", req.StreamId, "user_id", userID, "error", err)
+		return &proto_gen.SendLiveGiftResponse{
+			Code:      500,
+			Message:   "礼物发送失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现发送直播礼物逻辑
 	return &proto_gen.SendLiveGiftResponse{
 		Code:      200,
 		Message:   "礼物发送成功",
 		RequestId: req.RequestId,
-		Gift:      &proto_gen.LiveGift{},
+		Gift: &proto_gen.LiveGift{
+			Id:          gift.ID,
+			StreamId:    gift.StreamID,
+			UserId:      gift.UserID,
+			GiftId:      gift.GiftID,
+			GiftName:    gift.GiftName,
+			GiftIcon:    gift.GiftIcon,
+			GiftPrice:   gift.GiftValue,
+			GiftCount:   gift.GiftCount,
+			TotalValue:  gift.TotalValue,
+			Message:     req.Message,
+			EffectType:  gift.EffectType,
+			EffectValue: gift.EffectData,
+			CreatedAt:   gift.SendTime.Unix(),
+		},
+		ComboCount:      gift.ComboCount,
+		ComboMultiplier: gift.ComboMultiplier,
+		DisplayValue:    gift.DisplayValue,
 	}, nil
 }
 
 // GetLiveGiftList 获取直播礼物列表
 func (h *LiveServiceHandler) GetLiveGiftList(ctx context.Context, req *proto_gen.GetLiveGiftListRequest) (*proto_gen.GetLiveGiftListResponse, error) {
-	h.logger.Info("GetLiveGiftList called")
+	h.logger.Info("GetLiveGiftList called", "stream_id", req.StreamId, "page", req.Page, "page_size", req.PageSize)
+
+	gifts, total, nextCursor, err := h.liveService.GetLiveGiftList(ctx, req.StreamId, int(req.Page), int(req.PageSize), req.Cursor)
+	if err != nil {
+		if errors.Is(err, paginate.ErrInvalidCursor) {
+			return &proto_gen.GetLiveGiftListResponse{
+				Code:      400,
+				Message:   "分页游标无效",
+				RequestId: req.RequestId,
+			}, nil
+		}
+		h.logger.Error("Failed to get live gift list", "stream_id", req.StreamId, "error", err)
+		return &proto_gen.GetLiveGiftListResponse{
+			Code:      500,
+			Message:   "获取礼物列表失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现获取礼物列表逻辑
 	return &proto_gen.GetLiveGiftListResponse{
+		Code:       200,
+		Message:    "获取礼物列表成功",
+		RequestId:  req.RequestId,
+		Gifts:      converter.LiveGiftListToProto(gifts),
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetUserLiveGiftList 获取用户送出的礼物列表
+func (h *LiveServiceHandler) GetUserLiveGiftList(ctx context.Context, req *proto_gen.GetUserLiveGiftListRequest) (*proto_gen.GetUserLiveGiftListResponse, error) {
+	h.logger.Info("GetUserLiveGiftList called", "user_id", req.UserId, "page", req.Page, "page_size", req.PageSize)
+
+	gifts, total, err := h.liveService.GetUserLiveGiftList(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to get user live gift list", "user_id", req.UserId, "error", err)
+		return &proto_gen.GetUserLiveGiftListResponse{
+			Code:      500,
+			Message:   "获取用户礼物列表失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	return &proto_gen.GetUserLiveGiftListResponse{
 		Code:      200,
-		Message:   "获取礼物列表成功",
+		Message:   "获取用户礼物列表成功",
 		RequestId: req.RequestId,
-		Gifts:     []*proto_gen.LiveGift{},
-		Total:     0,
+		Gifts:     converter.LiveGiftListToProto(gifts),
+		Total:     total,
+	}, nil
+}
+
+// GetLiveGiftStats 获取直播礼物统计
+func (h *LiveServiceHandler) GetLiveGiftStats(ctx context.Context, req *proto_gen.GetLiveGiftStatsRequest) (*proto_gen.GetLiveGiftStatsResponse, error) {
+	h.logger.Info("GetLiveGiftStats called", "stream_id", req.StreamId)
+
+	stats, err := h.liveService.GetLiveGiftStats(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, service.ErrStreamNotFound) {
+			return &proto_gen.GetLiveGiftStatsResponse{
+				Code:      404,
+				Message:   "直播不存在",
+				RequestId: req.RequestId,
+			}, nil
+		}
+		h.logger.Error("Failed to get live gift stats", "stream_id", req.StreamId, "error", err)
+		return &proto_gen.GetLiveGiftStatsResponse{
+			Code:      500,
+			Message:   "获取礼物统计失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	return &proto_gen.GetLiveGiftStatsResponse{
+		Code:      200,
+		Message:   "获取礼物统计成功",
+		RequestId: req.RequestId,
+		Stats:     converter.GiftStatsToProto(stats),
 	}, nil
 }
 
 // LikeLive 点赞直播
 func (h *LiveServiceHandler) LikeLive(ctx context.Context, req *proto_gen.LikeLiveRequest) (*proto_gen.LikeLiveResponse, error) {
-	h.logger.Info("LikeLive called")
+	h.logger.Info("LikeLive called", "stream_id", req.StreamId, "user_id", req.UserId)
+
+	if err := h.liveService.LikeLive(ctx, req.StreamId, req.UserId); err != nil {
+		if errors.Is(err, service.ErrStreamNotFound) {
+			return &proto_gen.LikeLiveResponse{
+				Code:      404,
+				Message:   "直播不存在",
+				RequestId: req.RequestId,
+			}, nil
+		}
+		h.logger.Error("Failed to like live stream", "stream_id", req.StreamId, "user_id", req.UserId, "error", err)
+		return &proto_gen.LikeLiveResponse{
+			Code:      500,
+			Message:   "点赞失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现点赞直播逻辑
 	return &proto_gen.LikeLiveResponse{
 		Code:      200,
 		Message:   "点赞成功",
 		RequestId: req.RequestId,
-		LikeCount: 0,
 	}, nil
 }
 
 // GetLiveViewerList 获取直播观看者列表
 func (h *LiveServiceHandler) GetLiveViewerList(ctx context.Context, req *proto_gen.GetLiveViewerListRequest) (*proto_gen.GetLiveViewerListResponse, error) {
-	h.logger.Info("GetLiveViewerList called")
+	h.logger.Info("GetLiveViewerList called", "stream_id", req.StreamId, "page", req.Page, "page_size", req.PageSize)
+
+	viewers, total, err := h.liveService.GetLiveViewerList(ctx, req.StreamId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to get live viewer list", "stream_id", req.StreamId, "error", err)
+		return &proto_gen.GetLiveViewerListResponse{
+			Code:      500,
+			Message:   "获取观看者列表失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现获取直播观看者列表逻辑
 	return &proto_gen.GetLiveViewerListResponse{
-		Code:      200,
-		Message:   "获取观看者列表成功",
-		RequestId: req.RequestId,
-		Viewers:   []*proto_gen.LiveViewer{},
-		Total:     0,
+		Code:       200,
+		Message:    "获取观看者列表成功",
+		RequestId:  req.RequestId,
+		Viewers:    converter.LiveViewerListToProto(viewers),
+		Total:      total,
+		TotalPages: paginate.NewPage(int(req.Page), int(req.PageSize)).TotalPages(total),
 	}, nil
 }
 
 // GetLiveStats 获取直播统计
 func (h *LiveServiceHandler) GetLiveStats(ctx context.Context, req *proto_gen.GetLiveStatsRequest) (*proto_gen.GetLiveStatsResponse, error) {
-	h.logger.Info("GetLiveStats called")
+	h.logger.Info("GetLiveStats called", "stream_id", req.StreamId)
+
+	stats, err := h.liveService.GetLiveStats(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, service.ErrStreamNotFound) {
+			return &proto_gen.GetLiveStatsResponse{
+				Code:      404,
+				Message:   "直播不存在",
+				RequestId: req.RequestId,
+			}, nil
+		}
+		h.logger.Error("Failed to get live stats", "stream_id", req.StreamId, "error", err)
+		return &proto_gen.GetLiveStatsResponse{
+			Code:      500,
+			Message:   "获取直播统计失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现获取直播统计逻辑
 	return &proto_gen.GetLiveStatsResponse{
 		Code:      200,
 		Message:   "获取直播统计成功",
 		RequestId: req.RequestId,
-		Stats:     &proto_gen.LiveStats{},
+		Stats:     converter.LiveStatsToProto(stats),
 	}, nil
 }
 
+// RecomputeLiveStats 管理员从明细重算已结束直播的统计
+func (h *LiveServiceHandler) RecomputeLiveStats(ctx context.Context, req *proto_gen.RecomputeLiveStatsRequest) (*proto_gen.RecomputeLiveStatsResponse, error) {
+	h.logger.Info("RecomputeLiveStats called", "stream_id", req.StreamId, "user_id", req.UserId)
+
+	// 操作人取自访问令牌，请求中的user_id由客户端填写，不能用于判断管理员身份
+	operatorID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.RecomputeLiveStatsResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	stats, err := h.liveService.RecomputeLiveStats(ctx, operatorID, req.StreamId)
+	if err != nil {
+		resp := &proto_gen.RecomputeLiveStatsResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "仅管理员可以重算直播统计"
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrStreamNotFinished):
+			resp.Code = 400
+			resp.Message = "直播尚未结束"
+		default:
+			h.logger.Error("Failed to recompute live stats", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "重算直播统计失败"
+		}
+		return resp, nil
+	}
+
+	return &proto_gen.RecomputeLiveStatsResponse{
+		Code:      200,
+		Message:   "重算直播统计成功",
+		RequestId: req.RequestId,
+		Stats:     converter.LiveStatsToProto(stats),
+	}, nil
+}
+
+// ForceStopLive 管理员强制结束违规直播
+func (h *LiveServiceHandler) ForceStopLive(ctx context.Context, req *proto_gen.ForceStopLiveRequest) (*proto_gen.ForceStopLiveResponse, error) {
+	h.logger.Info("ForceStopLive called", "stream_id", req.StreamId, "user_id", req.UserId, "reason", req.Reason)
+
+	// 操作人取自访问令牌，请求中的user_id由客户端填写，不能用于判断管理员身份
+	operatorID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return &proto_gen.ForceStopLiveResponse{
+			Code:      401,
+			Message:   "未登录或登录已过期",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	stream, err := h.liveService.ForceStopLive(ctx, operatorID, req.StreamId, req.Reason)
+	if err != nil {
+		resp := &proto_gen.ForceStopLiveResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "仅管理员可以强制结束直播"
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		default:
+			h.logger.Error("Failed to force stop live", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "强制结束直播失败"
+		}
+		return resp, nil
+	}
+
+	h.submitForceStopAudit(ctx, stream, operatorID, req.Reason)
+
+	return &proto_gen.ForceStopLiveResponse{
+		Code:      200,
+		Message:   "强制结束直播成功",
+		RequestId: req.RequestId,
+		Stream:    converter.LiveStreamToProto(stream),
+	}, nil
+}
+
+// RefundLiveGift 管理员退还礼物
+func (h *LiveServiceHandler) RefundLiveGift(ctx context.Context, req *proto_gen.RefundLiveGiftRequest) (*proto_gen.RefundLiveGiftResponse, error) {
+	h.logger.Info("RefundLiveGift called", "gift_record_id", req.GiftRecordId, "user_id", req.UserId, "reason", req.Reason)
+
+	resp := &proto_gen.RefundLiveGiftResponse{
+		RequestId: req.RequestId,
+	}
+	// 操作人取自访问令牌，不信任请求中的user_id，服务内部自动补偿使用的SystemOperatorID也无法通过接口传入
+	operatorID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		resp.Code = 401
+		resp.Message = "未登录或登录已过期"
+		return resp, nil
+	}
+
+	gift, err := h.liveService.RefundLiveGift(ctx, operatorID, req.GiftRecordId, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrStreamPermissionDenied):
+			resp.Code = 403
+			resp.Message = "仅管理员可以退还礼物"
+		case errors.Is(err, service.ErrGiftNotFound):
+			resp.Code = 404
+			resp.Message = "礼物记录不存在"
+		case errors.Is(err, service.ErrGiftNotRefundable):
+			resp.Code = 409
+			resp.Message = "礼物未送出成功，无法退款"
+		default:
+			h.logger.Error("Failed to refund live gift", "gift_record_id", req.GiftRecordId, "error", err)
+			resp.Code = 500
+			resp.Message = "退还礼物失败"
+		}
+		return resp, nil
+	}
+
+	resp.Code = 200
+	resp.Message = "退还礼物成功"
+	resp.Gift = converter.LiveGiftToProto(gift)
+	return resp, nil
+}
+
+// 直播间文本审核的字段
+const (
+	liveTextFieldTitle       = "title"
+	liveTextFieldDescription = "description"
+)
+
+// moderateLiveText 提交直播标题或简介的文本审核，返回是否被直接拒绝及拒绝原因
+// 内容为空时不提交；审核超时、调用失败或待审核时按未拒绝处理，不阻止开播
+func (h *LiveServiceHandler) moderateLiveText(ctx context.Context, streamID string, userID uint64, field, text string) (bool, string) {
+	if strings.TrimSpace(text) == "" {
+		return false, ""
+	}
+
+	auditReq := &auditv1.SubmitContentRequest{
+		ContentId:    fmt.Sprintf("live_%s_%s", streamID, field),
+		ContentType:  auditv1.ContentType_CONTENT_TYPE_TEXT,
+		ContentTitle: text,
+		UploaderId:   userID,
+		Content:      text,
+		Metadata: map[string]string{
+			"source":      "live",
+			"field":       field,
+			"stream_id":   streamID,
+			"create_time": time.Now().Format(time.RFC3339),
+		},
+	}
+
+	resp, err := h.submitAudit(ctx, auditReq)
+	if isDeadlineExceeded(err) {
+		h.logger.Warn("Live text audit timed out, treating as pending",
+			"content_id", auditReq.ContentId,
+			"timeout", h.config.Services.AuditService.GetCallTimeout())
+		return false, ""
+	}
+	if err != nil {
+		h.logger.Error("Failed to submit live text for audit", "error", err, "content_id", auditReq.ContentId)
+		return false, ""
+	}
+	auditResp, ok := resp.(*auditv1.SubmitContentResponse)
+	if !ok {
+		h.logger.Error("Failed to cast audit response to auditv1.SubmitContentResponse", "content_id", auditReq.ContentId)
+		return false, ""
+	}
+
+	if auditResp.Status == auditv1.AuditStatus_AUDIT_STATUS_REJECTED {
+		h.logger.Warn("Live text rejected by audit",
+			"content_id", auditReq.ContentId,
+			"field", field,
+			"reason", auditResp.Reason,
+			"level", auditResp.Level)
+		return true, auditResp.Reason
+	}
+	h.logger.Info("Live text audit response received",
+		"content_id", auditReq.ContentId,
+		"audit_id", auditResp.AuditId,
+		"status", auditResp.Status)
+	return false, ""
+}
+
+// submitForceStopAudit 将强制结束直播的处置写入审核记录，审核服务不可用时只记录日志，不影响已完成的处置
+func (h *LiveServiceHandler) submitForceStopAudit(ctx context.Context, stream *model.LiveStream, operatorID uint64, reason string) {
+	if h.auditManager == nil {
+		h.logger.Warn("Audit manager not available, skipping force stop audit record", "stream_id", stream.ID)
+		return
+	}
+
+	auditReq := &auditv1.SubmitContentRequest{
+		ContentId:    fmt.Sprintf("live_%d", stream.ID),
+		ContentType:  auditv1.ContentType_CONTENT_TYPE_LIVE,
+		ContentTitle: stream.Title,
+		UploaderId:   stream.UserID,
+		Content:      reason,
+		Metadata: map[string]string{
+			"action":      "force_stop",
+			"operator_id": strconv.FormatUint(operatorID, 10),
+			"title":       stream.Title,
+			"stop_time":   time.Now().Format(time.RFC3339),
+		},
+	}
+	if _, err := h.submitAudit(ctx, auditReq); err != nil {
+		h.logger.Error("Failed to submit force stop audit record", "error", err, "content_id", auditReq.ContentId)
+	}
+}
+
+// submitAudit 提交内容审核，每次调用单独设置超时，避免审核服务无响应时阻塞请求
+func (h *LiveServiceHandler) submitAudit(ctx context.Context, req *auditv1.SubmitContentRequest) (interface{}, error) {
+	callCtx, cancel := context.WithTimeout(ctx, h.config.Services.AuditService.GetCallTimeout())
+	defer cancel()
+	return h.auditManager.SubmitContent(callCtx, req)
+}
+
+// isDeadlineExceeded 判断调用是否因超时失败
+func isDeadlineExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	return status.Code(err) == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded)
+}
+
 // SearchLive 搜索直播
 func (h *LiveServiceHandler) SearchLive(ctx context.Context, req *proto_gen.SearchLiveRequest) (*proto_gen.SearchLiveResponse, error) {
-	h.logger.Info("SearchLive called")
+	viewerID := h.viewerUserID(ctx)
+	h.logger.Info("SearchLive called", "user_id", viewerID, "keyword", req.Keyword)
+
+	filter := repository.LiveStreamSearchFilter{
+		Keyword:    req.Keyword,
+		CategoryID: req.CategoryId,
+		MinViewers: req.MinViewers,
+		Language:   req.Language,
+		Region:     req.Region,
+	}
+	streams, total, err := h.liveService.SearchLive(ctx, viewerID, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to search live", "keyword", req.Keyword, "error", err)
+		return &proto_gen.SearchLiveResponse{
+			Code:      500,
+			Message:   "搜索直播失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
 
-	// TODO: 实现搜索直播逻辑
 	return &proto_gen.SearchLiveResponse{
 		Code:      200,
 		Message:   "搜索直播成功",
 		RequestId: req.RequestId,
-		Streams:   []*proto_gen.LiveStream{},
-		Total:     0,
+		Streams:   converter.LiveStreamListToProto(streams),
+		Total:     total,
 	}, nil
 }
 
@@ -341,14 +1371,58 @@ func (h *LiveServiceHandler) GetLiveCategories(ctx context.Context, req *proto_g
 
 // GetLivePlayback 获取直播回放
 func (h *LiveServiceHandler) GetLivePlayback(ctx context.Context, req *proto_gen.GetLivePlaybackRequest) (*proto_gen.GetLivePlaybackResponse, error) {
-	h.logger.Info("GetLivePlayback called")
+	h.logger.Info("GetLivePlayback called", "stream_id", req.StreamId)
+
+	playback, err := h.liveService.GetLivePlayback(ctx, req.StreamId)
+	if err != nil {
+		resp := &proto_gen.GetLivePlaybackResponse{
+			RequestId: req.RequestId,
+		}
+		switch {
+		case errors.Is(err, service.ErrStreamNotFound):
+			resp.Code = 404
+			resp.Message = "直播不存在"
+		case errors.Is(err, service.ErrPlaybackNotFound):
+			resp.Code = 404
+			resp.Message = "该直播没有回放"
+		default:
+			h.logger.Error("Failed to get live playback", "stream_id", req.StreamId, "error", err)
+			resp.Code = 500
+			resp.Message = "获取直播回放失败"
+		}
+		return resp, nil
+	}
 
-	// TODO: 实现获取直播回放逻辑
+	// 转码中和转码失败的回放也返回成功，客户端按status展示
 	return &proto_gen.GetLivePlaybackResponse{
 		Code:      200,
 		Message:   "获取直播回放成功",
 		RequestId: req.RequestId,
-		Playback:  &proto_gen.LivePlayback{},
+		Playback:  converter.LivePlaybackToProto(playback),
+	}, nil
+}
+
+// GetDailyLeaderboards 获取当日排行榜
+func (h *LiveServiceHandler) GetDailyLeaderboards(ctx context.Context, req *proto_gen.GetDailyLeaderboardsRequest) (*proto_gen.GetDailyLeaderboardsResponse, error) {
+	h.logger.Info("GetDailyLeaderboards called")
+
+	boards, err := h.liveService.GetDailyLeaderboards(ctx)
+	if err != nil {
+		h.logger.Error("Failed to get daily leaderboards", "error", err)
+		return &proto_gen.GetDailyLeaderboardsResponse{
+			Code:      500,
+			Message:   "获取排行榜失败",
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	return &proto_gen.GetDailyLeaderboardsResponse{
+		Code:         200,
+		Message:      "获取排行榜成功",
+		RequestId:    req.RequestId,
+		Date:         boards.Date,
+		TopStreamers: converter.LeaderboardEntryListToProto(boards.TopStreamers),
+		TopStreams:   converter.LeaderboardEntryListToProto(boards.TopStreams),
 	}, nil
 }
 
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubListPagesService 返回预设总数的直播列表和观看者列表
+type stubListPagesService struct {
+	service.LiveService
+	total int64
+
+	page, pageSize int
+}
+
+func (s *stubListPagesService) GetLiveList(ctx context.Context, viewerID uint64, page, pageSize int, categoryID uint32) ([]*model.LiveStream, int64, error) {
+	s.page, s.pageSize = page, pageSize
+	return []*model.LiveStream{}, s.total, nil
+}
+
+func (s *stubListPagesService) GetLiveViewerList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveViewer, int64, error) {
+	s.page, s.pageSize = page, pageSize
+	return []*model.LiveViewer{{ID: 1, StreamID: streamID, UserID: 7}}, s.total, nil
+}
+
+func TestListTotalPages(t *testing.T) {
+	tests := []struct {
+		pageSize int32
+		total    int64
+		want     int64
+	}{
+		{10, 0, 0},
+		{10, 10, 1},
+		{10, 11, 2},
+		{0, 25, 3},    // 未指定时按默认每页10条计算
+		{500, 250, 3}, // 超过上限时按每页100条计算
+	}
+	for _, tt := range tests {
+		svc := &stubListPagesService{total: tt.total}
+		h := newTestHandler(svc)
+
+		live, err := h.GetLiveList(context.Background(), &proto_gen.GetLiveListRequest{Page: 1, PageSize: tt.pageSize})
+		if err != nil || live.Code != 200 || live.Total != tt.total || live.TotalPages != tt.want {
+			t.Errorf("GetLiveList size %d total %d = (%v, %v), want total pages %d", tt.pageSize, tt.total, live, err, tt.want)
+		}
+		viewers, err := h.GetLiveViewerList(context.Background(), &proto_gen.GetLiveViewerListRequest{StreamId: 3, Page: 1, PageSize: tt.pageSize})
+		if err != nil || viewers.Code != 200 || viewers.Total != tt.total || viewers.TotalPages != tt.want {
+			t.Errorf("GetLiveViewerList size %d total %d = (%v, %v), want total pages %d", tt.pageSize, tt.total, viewers, err, tt.want)
+		}
+	}
+}
+
+func TestGetLiveViewerListPassesPage(t *testing.T) {
+	svc := &stubListPagesService{total: 1}
+	resp, err := newTestHandler(svc).GetLiveViewerList(context.Background(), &proto_gen.GetLiveViewerListRequest{StreamId: 3, Page: 2, PageSize: 20, RequestId: "req-1"})
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("GetLiveViewerList = (%v, %v), want code 200", resp, err)
+	}
+	if svc.page != 2 || svc.pageSize != 20 {
+		t.Errorf("service called with page %d size %d, want 2/20", svc.page, svc.pageSize)
+	}
+	if len(resp.Viewers) != 1 || resp.Viewers[0].GetUserId() != 7 {
+		t.Errorf("viewers = %v, want the converted viewer", resp.Viewers)
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubLivePlaybackService 返回预设的直播回放
+type stubLivePlaybackService struct {
+	service.LiveService
+	playback *service.LivePlayback
+	err      error
+}
+
+func (s *stubLivePlaybackService) GetLivePlayback(ctx context.Context, streamID uint64) (*service.LivePlayback, error) {
+	return s.playback, s.err
+}
+
+func TestGetLivePlayback(t *testing.T) {
+	tests := []struct {
+		name     string
+		playback *service.LivePlayback
+		wantURL  string
+	}{
+		{"ready", &service.LivePlayback{StreamID: 1, PlaybackURL: "https://cdn.example.com/1.mp4", Duration: 3600, Format: "mp4", Status: model.PlaybackStatusReady}, "https://cdn.example.com/1.mp4"},
+		// 转码中的回放同样返回成功，客户端按status展示
+		{"processing", &service.LivePlayback{StreamID: 1, Duration: 3600, Status: model.PlaybackStatusProcessing}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &stubLivePlaybackService{playback: tt.playback}
+			resp, err := newTestHandler(svc).GetLivePlayback(context.Background(), &proto_gen.GetLivePlaybackRequest{StreamId: 1, RequestId: "req-1"})
+			if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+				t.Fatalf("GetLivePlayback = (%v, %v), want code 200", resp, err)
+			}
+			playback := resp.Playback
+			if playback.GetStreamId() != 1 || playback.GetStatus() != tt.playback.Status || playback.GetPlaybackUrl() != tt.wantURL || playback.GetDuration() != 3600 {
+				t.Errorf("playback = %v, want the converted %s playback", playback, tt.playback.Status)
+			}
+		})
+	}
+}
+
+func TestGetLivePlaybackErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int32
+	}{
+		{"stream not found", service.ErrStreamNotFound, 404},
+		{"playback not found", fmt.Errorf("lookup: %w", service.ErrPlaybackNotFound), 404},
+		{"internal", errors.New("db down"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestHandler(&stubLivePlaybackService{err: tt.err}).GetLivePlayback(context.Background(), &proto_gen.GetLivePlaybackRequest{StreamId: 1})
+			if err != nil || resp.Code != tt.want || resp.Playback != nil {
+				t.Errorf("GetLivePlayback = (%v, %v), want code %d without a playback", resp, err, tt.want)
+			}
+		})
+	}
+}
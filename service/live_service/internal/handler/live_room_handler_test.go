@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubLiveRoomService 返回预设的直播间并记录查询条件
+type stubLiveRoomService struct {
+	service.LiveService
+	room               *model.LiveRoom
+	err                error
+	calls              int
+	roomID, streamerID uint64
+}
+
+func (s *stubLiveRoomService) GetLiveRoom(ctx context.Context, roomID, streamerID uint64) (*model.LiveRoom, error) {
+	s.calls++
+	s.roomID, s.streamerID = roomID, streamerID
+	return s.room, s.err
+}
+
+func TestGetLiveRoom(t *testing.T) {
+	svc := &stubLiveRoomService{room: &model.LiveRoom{
+		ID:            3,
+		UserID:        7,
+		RoomNumber:    "12345678",
+		TotalStreams:  4,
+		TotalDuration: 3600,
+		TotalViewers:  250,
+	}}
+	resp, err := newTestHandler(svc).GetLiveRoom(context.Background(), &proto_gen.GetLiveRoomRequest{StreamerId: 7, RequestId: "req-1"})
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("GetLiveRoom = (%v, %v), want code 200", resp, err)
+	}
+	if svc.roomID != 0 || svc.streamerID != 7 {
+		t.Errorf("service called with room %d streamer %d, want streamer 7", svc.roomID, svc.streamerID)
+	}
+	room := resp.Room
+	if room.GetId() != 3 || room.GetUserId() != 7 || room.GetRoomNumber() != "12345678" ||
+		room.GetTotalStreams() != 4 || room.GetTotalDuration() != 3600 || room.GetTotalViewers() != 250 {
+		t.Errorf("room = %v, want the converted room with cumulative stats", room)
+	}
+}
+
+func TestGetLiveRoomErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *proto_gen.GetLiveRoomRequest
+		err       error
+		want      int32
+		wantCalls int
+	}{
+		{"missing ids", &proto_gen.GetLiveRoomRequest{}, nil, 400, 0},
+		{"not found", &proto_gen.GetLiveRoomRequest{RoomId: 3}, fmt.Errorf("lookup: %w", service.ErrRoomNotFound), 404, 1},
+		{"internal", &proto_gen.GetLiveRoomRequest{RoomId: 3}, errors.New("db down"), 500, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &stubLiveRoomService{err: tt.err}
+			resp, err := newTestHandler(svc).GetLiveRoom(context.Background(), tt.req)
+			if err != nil || resp.Code != tt.want || resp.Room != nil {
+				t.Errorf("GetLiveRoom = (%v, %v), want code %d without a room", resp, err, tt.want)
+			}
+			if svc.calls != tt.wantCalls {
+				t.Errorf("service called %d times, want %d", svc.calls, tt.wantCalls)
+			}
+		})
+	}
+}
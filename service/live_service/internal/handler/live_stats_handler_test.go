@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubLiveStatsService 返回预设的直播统计并记录查询的直播流
+type stubLiveStatsService struct {
+	service.LiveService
+	stats    *service.LiveStats
+	err      error
+	streamID uint64
+}
+
+func (s *stubLiveStatsService) GetLiveStats(ctx context.Context, streamID uint64) (*service.LiveStats, error) {
+	s.streamID = streamID
+	return s.stats, s.err
+}
+
+func TestGetLiveStats(t *testing.T) {
+	svc := &stubLiveStatsService{stats: &service.LiveStats{
+		StreamID:       3,
+		TotalViewers:   120,
+		CurrentViewers: 15,
+		GiftCount:      3,
+		GiftValue:      700,
+		Duration:       60,
+	}}
+	resp, err := newTestHandler(svc).GetLiveStats(context.Background(), &proto_gen.GetLiveStatsRequest{StreamId: 3, RequestId: "req-1"})
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("GetLiveStats = (%v, %v), want code 200", resp, err)
+	}
+	if svc.streamID != 3 {
+		t.Errorf("service called with stream %d, want 3", svc.streamID)
+	}
+	stats := resp.Stats
+	if stats.GetStreamId() != 3 || stats.GetTotalViewers() != 120 || stats.GetCurrentViewers() != 15 ||
+		stats.GetGiftCount() != 3 || stats.GetGiftValue() != 700 || stats.GetDuration() != 60 {
+		t.Errorf("stats = %v, want the converted service stats", stats)
+	}
+}
+
+func TestGetLiveStatsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int32
+	}{
+		{"not found", fmt.Errorf("lookup: %w", service.ErrStreamNotFound), 404},
+		{"internal", errors.New("db down"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestHandler(&stubLiveStatsService{err: tt.err}).GetLiveStats(context.Background(),
+				&proto_gen.GetLiveStatsRequest{StreamId: 3, RequestId: "req-1"})
+			if err != nil || resp.Code != tt.want || resp.RequestId != "req-1" || resp.Stats != nil {
+				t.Errorf("GetLiveStats = (%v, %v), want code %d without stats", resp, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubSearchLiveService 记录搜索条件并返回预设的直播
+type stubSearchLiveService struct {
+	service.LiveService
+	streams []*model.LiveStream
+	err     error
+
+	filter         repository.LiveStreamSearchFilter
+	page, pageSize int
+}
+
+func (s *stubSearchLiveService) SearchLive(ctx context.Context, viewerID uint64, filter repository.LiveStreamSearchFilter, page, pageSize int) ([]*model.LiveStream, int64, error) {
+	s.filter, s.page, s.pageSize = filter, page, pageSize
+	return s.streams, int64(len(s.streams)), s.err
+}
+
+func TestSearchLivePassesFilter(t *testing.T) {
+	svc := &stubSearchLiveService{streams: []*model.LiveStream{{ID: 1, Language: "zh", Region: "CN"}}}
+	req := &proto_gen.SearchLiveRequest{Keyword: "开黑", CategoryId: 7, MinViewers: 100, Language: "zh", Region: "CN", Page: 2, PageSize: 10, RequestId: "req-1"}
+	resp, err := newTestHandler(svc).SearchLive(context.Background(), req)
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("SearchLive = (%v, %v), want code 200", resp, err)
+	}
+	want := repository.LiveStreamSearchFilter{Keyword: "开黑", CategoryID: 7, MinViewers: 100, Language: "zh", Region: "CN"}
+	if svc.filter != want || svc.page != 2 || svc.pageSize != 10 {
+		t.Errorf("service got filter %+v page %d/%d, want %+v page 2/10", svc.filter, svc.page, svc.pageSize, want)
+	}
+	if resp.Total != 1 || len(resp.Streams) != 1 || resp.Streams[0].GetLanguage() != "zh" || resp.Streams[0].GetRegion() != "CN" {
+		t.Errorf("streams = %v total %d, want stream 1 with its language and region", resp.Streams, resp.Total)
+	}
+}
+
+func TestSearchLiveError(t *testing.T) {
+	resp, err := newTestHandler(&stubSearchLiveService{err: errors.New("db down")}).SearchLive(context.Background(), &proto_gen.SearchLiveRequest{RequestId: "req-1"})
+	if err != nil || resp.Code != 500 || resp.Streams != nil || resp.RequestId != "req-1" {
+		t.Errorf("SearchLive = (%v, %v), want code 500 without streams", resp, err)
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"live_service/internal/config"
+	"live_service/proto/proto_gen"
+	auditv1 "live_service/proto/proto_gen/audit"
+)
+
+// fieldAuditManager 按文本审核字段返回审核结果，记录提交的请求
+type fieldAuditManager struct {
+	blockingAuditManager
+	results map[string]*auditv1.SubmitContentResponse
+	err     error
+}
+
+func (m *fieldAuditManager) SubmitContent(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*auditv1.SubmitContentRequest)
+	m.reqs = append(m.reqs, r)
+	if m.err != nil {
+		return nil, m.err
+	}
+	if resp, ok := m.results[r.Metadata["field"]]; ok {
+		return resp, nil
+	}
+	return &auditv1.SubmitContentResponse{Status: auditv1.AuditStatus_AUDIT_STATUS_PASSED}, nil
+}
+
+func newModerationHandler(manager *fieldAuditManager) *LiveServiceHandler {
+	cfg := &config.Config{}
+	cfg.Services.AuditService.CallTimeout = time.Second
+	return &LiveServiceHandler{config: cfg, logger: nopLogger{}, auditManager: manager}
+}
+
+func rejectedAudit(reason string) *auditv1.SubmitContentResponse {
+	return &auditv1.SubmitContentResponse{Status: auditv1.AuditStatus_AUDIT_STATUS_REJECTED, Reason: reason}
+}
+
+func TestStartLiveRejectsTextField(t *testing.T) {
+	tests := []struct {
+		name        string
+		field       string
+		wantMessage string
+		wantReqs    int
+	}{
+		// 标题被拒绝时不再提交简介
+		{"title rejected", liveTextFieldTitle, "直播标题违规", 1},
+		{"description rejected", liveTextFieldDescription, "直播简介违规", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := &fieldAuditManager{results: map[string]*auditv1.SubmitContentResponse{tt.field: rejectedAudit("涉政")}}
+			h := newModerationHandler(manager)
+
+			resp, err := h.StartLive(context.Background(), &proto_gen.StartLiveRequest{
+				UserId:      7,
+				Title:       "今晚开黑",
+				Description: "一起来玩",
+				RequestId:   "req-1",
+			})
+			if err != nil {
+				t.Fatalf("StartLive: %v", err)
+			}
+			if resp.Code != 403 || !strings.Contains(resp.Message, tt.wantMessage) || !strings.Contains(resp.Message, "涉政") || resp.RequestId != "req-1" {
+				t.Errorf("response = %v, want 403 with %q and the reason", resp, tt.wantMessage)
+			}
+			if resp.Stream != nil || resp.StreamKey != "" {
+				t.Errorf("rejected StartLive returned stream %v key %q", resp.Stream, resp.StreamKey)
+			}
+			if len(manager.reqs) != tt.wantReqs {
+				t.Fatalf("submitted %d audit requests, want %d", len(manager.reqs), tt.wantReqs)
+			}
+			last := manager.reqs[len(manager.reqs)-1]
+			if last.ContentType != auditv1.ContentType_CONTENT_TYPE_TEXT || last.Metadata["field"] != tt.field || last.Metadata["source"] != "live" {
+				t.Errorf("last audit request = %v, want a live text audit of %s", last, tt.field)
+			}
+		})
+	}
+}
+
+func TestModerateLiveText(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		resp         *auditv1.SubmitContentResponse
+		err          error
+		wantRejected bool
+		wantReason   string
+		wantSubmit   bool
+	}{
+		{"rejected", "违规标题", rejectedAudit("涉黄"), nil, true, "涉黄", true},
+		{"passed", "正常标题", &auditv1.SubmitContentResponse{Status: auditv1.AuditStatus_AUDIT_STATUS_PASSED}, nil, false, "", true},
+		{"pending", "正常标题", &auditv1.SubmitContentResponse{Status: auditv1.AuditStatus_AUDIT_STATUS_PENDING}, nil, false, "", true},
+		// 审核服务不可用时不阻止开播
+		{"audit error", "正常标题", nil, errors.New("unavailable"), false, "", true},
+		{"empty text", "", nil, nil, false, "", false},
+		{"blank text", "  \t", nil, nil, false, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := &fieldAuditManager{err: tt.err}
+			if tt.resp != nil {
+				manager.results = map[string]*auditv1.SubmitContentResponse{liveTextFieldTitle: tt.resp}
+			}
+			h := newModerationHandler(manager)
+
+			rejected, reason := h.moderateLiveText(context.Background(), "s1", 7, liveTextFieldTitle, tt.text)
+			if rejected != tt.wantRejected || reason != tt.wantReason {
+				t.Errorf("moderateLiveText = (%v, %q), want (%v, %q)", rejected, reason, tt.wantRejected, tt.wantReason)
+			}
+			if submitted := len(manager.reqs) > 0; submitted != tt.wantSubmit {
+				t.Fatalf("submitted = %v, want %v", submitted, tt.wantSubmit)
+			}
+			if tt.wantSubmit && manager.reqs[0].ContentId != "live_s1_title" {
+				t.Errorf("content id = %q, want live_s1_title", manager.reqs[0].ContentId)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+func (s *stubLiveService) SendLiveGift(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32, requestID string) (*model.LiveGift, error) {
+	s.operatorID = userID
+	return &model.LiveGift{ID: 1, StreamID: streamID, UserID: userID, GiftID: giftID, GiftCount: giftCount, SendTime: time.Now()}, nil
+}
+
+func (s *stubLiveService) JoinLiveRoom(ctx context.Context, streamID, userID uint64, password string) (*model.LiveViewer, error) {
+	s.operatorID = userID
+	return &model.LiveViewer{StreamID: streamID, UserID: userID, EnterTime: time.Now()}, nil
+}
+
+func (s *stubLiveService) LeaveLiveRoom(ctx context.Context, streamID, userID uint64) error {
+	s.operatorID = userID
+	return nil
+}
+
+func (s *stubLiveService) GetLiveList(ctx context.Context, viewerID uint64, page, pageSize int, categoryID uint32) ([]*model.LiveStream, int64, error) {
+	s.operatorID = viewerID
+	return nil, 0, nil
+}
+
+func (s *stubLiveService) SearchLive(ctx context.Context, viewerID uint64, filter repository.LiveStreamSearchFilter, page, pageSize int) ([]*model.LiveStream, int64, error) {
+	s.operatorID = viewerID
+	return nil, 0, nil
+}
+
+func TestJoinAndLeaveLiveRoomUseTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+	ctx := withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour))
+
+	// 把user_id填成主播或其关注者也不能进入私密直播，观看者以访问令牌为准
+	resp, err := h.JoinLiveRoom(ctx, &proto_gen.JoinLiveRoomRequest{UserId: 1, StreamId: 3})
+	if err != nil || resp.Code != 200 || svc.operatorID != 7 {
+		t.Fatalf("JoinLiveRoom = (%v, %v), viewer %d; want code 200 with viewer 7", resp, err, svc.operatorID)
+	}
+	svc.operatorID = 0
+	leave, err := h.LeaveLiveRoom(ctx, &proto_gen.LeaveLiveRoomRequest{UserId: 1, StreamId: 3})
+	if err != nil || leave.Code != 200 || svc.operatorID != 7 {
+		t.Fatalf("LeaveLiveRoom = (%v, %v), viewer %d; want code 200 with viewer 7", leave, err, svc.operatorID)
+	}
+
+	svc.operatorID = 0
+	resp, _ = h.JoinLiveRoom(context.Background(), &proto_gen.JoinLiveRoomRequest{UserId: 1, StreamId: 3})
+	leave, _ = h.LeaveLiveRoom(context.Background(), &proto_gen.LeaveLiveRoomRequest{UserId: 1, StreamId: 3})
+	if resp.Code != 401 || leave.Code != 401 || svc.operatorID != 0 {
+		t.Fatalf("unauthenticated join/leave: codes %d/%d, service called with %d; want 401 and no call", resp.Code, leave.Code, svc.operatorID)
+	}
+}
+
+func TestLiveListingsUseTokenViewer(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+	ctx := withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour))
+
+	// 私密直播对关注者可见，观看者ID取自令牌；未登录或令牌无效时按匿名用户只返回公开直播
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want uint64
+	}{
+		{"token", ctx, 7},
+		{"anonymous", context.Background(), 0},
+		{"forged token", withToken(t, 7, "forged-secret", time.Now().Add(time.Hour)), 0},
+	}
+	for _, tc := range cases {
+		svc.operatorID = 99
+		if resp, err := h.GetLiveList(tc.ctx, &proto_gen.GetLiveListRequest{UserId: 1}); err != nil || resp.Code != 200 || svc.operatorID != tc.want {
+			t.Errorf("%s: GetLiveList viewer = %d (code %d, err %v), want %d", tc.name, svc.operatorID, resp.GetCode(), err, tc.want)
+		}
+		svc.operatorID = 99
+		if resp, err := h.SearchLive(tc.ctx, &proto_gen.SearchLiveRequest{UserId: 1, Keyword: "game"}); err != nil || resp.Code != 200 || svc.operatorID != tc.want {
+			t.Errorf("%s: SearchLive viewer = %d (code %d, err %v), want %d", tc.name, svc.operatorID, resp.GetCode(), err, tc.want)
+		}
+	}
+}
+
+func (s *stubLiveService) PauseLive(ctx context.Context, streamID, userID uint64) error {
+	s.operatorID = userID
+	return nil
+}
+
+func (s *stubLiveService) ResumeLive(ctx context.Context, streamID, userID uint64) error {
+	s.operatorID = userID
+	return nil
+}
+
+func (s *stubLiveService) UpdateLiveStreamPrivacy(ctx context.Context, streamID, userID uint64, isPublic bool, password string) error {
+	s.operatorID = userID
+	return nil
+}
+
+func TestStreamOwnerActionsUseTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+
+	// 请求中的user_id填成主播ID也不能操作他人的直播，服务按令牌中的用户校验主播身份
+	calls := map[string]func(ctx context.Context) int32{
+		"PauseLive": func(ctx context.Context) int32 {
+			resp, _ := h.PauseLive(ctx, &proto_gen.PauseLiveRequest{UserId: 1, StreamId: 3})
+			return resp.Code
+		},
+		"ResumeLive": func(ctx context.Context) int32 {
+			resp, _ := h.ResumeLive(ctx, &proto_gen.ResumeLiveRequest{UserId: 1, StreamId: 3})
+			return resp.Code
+		},
+		"UpdateLiveStreamPrivacy": func(ctx context.Context) int32 {
+			resp, _ := h.UpdateLiveStreamPrivacy(ctx, &proto_gen.UpdateLiveStreamPrivacyRequest{UserId: 1, StreamId: 3, IsPublic: true})
+			return resp.Code
+		},
+	}
+	for name, call := range calls {
+		svc.operatorID = 0
+		if code := call(withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour))); code != 200 || svc.operatorID != 7 {
+			t.Errorf("%s: code %d, owner %d; want code 200 with owner 7 from token", name, code, svc.operatorID)
+		}
+		svc.operatorID = 0
+		if code := call(context.Background()); code != 401 || svc.operatorID != 0 {
+			t.Errorf("%s unauthenticated: code %d, service called with %d; want 401 and no call", name, code, svc.operatorID)
+		}
+	}
+}
+
+func TestSendLiveGiftUsesTokenIdentity(t *testing.T) {
+	svc := &stubLiveService{}
+	h := newTestHandler(svc)
+
+	// 送礼用户以访问令牌为准，请求中填写的user_id不会被记为送礼人
+	resp, err := h.SendLiveGift(withToken(t, 7, testJWTSecret, time.Now().Add(time.Hour)),
+		&proto_gen.SendLiveGiftRequest{UserId: 99, StreamId: 3, GiftId: 1, GiftCount: 1})
+	if err != nil || resp.Code != 200 {
+		t.Fatalf("SendLiveGift = (%v, %v), want code 200", resp, err)
+	}
+	if svc.operatorID != 7 || resp.Gift.UserId != 7 {
+		t.Fatalf("sender = %d (response %d), want 7 from token", svc.operatorID, resp.Gift.UserId)
+	}
+
+	svc.operatorID = 0
+	resp, _ = h.SendLiveGift(context.Background(), &proto_gen.SendLiveGiftRequest{UserId: 99, StreamId: 3, GiftId: 1, GiftCount: 1})
+	if resp.Code != 401 || svc.operatorID != 0 {
+		t.Fatalf("unauthenticated send: code %d, service called with %d; want 401 and no call", resp.Code, svc.operatorID)
+	}
+}
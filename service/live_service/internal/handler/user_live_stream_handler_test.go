@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubUserLiveService 返回预设的用户当前直播
+type stubUserLiveService struct {
+	service.LiveService
+	stream *model.LiveStream
+	err    error
+	userID uint64
+}
+
+func (s *stubUserLiveService) GetUserLiveStream(ctx context.Context, userID uint64) (*model.LiveStream, error) {
+	s.userID = userID
+	return s.stream, s.err
+}
+
+func TestGetUserLiveStream(t *testing.T) {
+	svc := &stubUserLiveService{stream: &model.LiveStream{ID: 1, UserID: 7, Title: "今晚开黑", Status: model.LiveStatusStreaming, ViewerCount: 42}}
+	resp, err := newTestHandler(svc).GetUserLiveStream(context.Background(), &proto_gen.GetUserLiveStreamRequest{TargetUserId: 7, RequestId: "req-1"})
+	if err != nil || resp.Code != 200 || resp.RequestId != "req-1" {
+		t.Fatalf("GetUserLiveStream = (%v, %v), want code 200", resp, err)
+	}
+	if svc.userID != 7 {
+		t.Errorf("looked up user %d, want 7", svc.userID)
+	}
+	if stream := resp.Stream; stream.GetId() != 1 || stream.GetUserId() != 7 || stream.GetViewerCount() != 42 {
+		t.Errorf("stream = %v, want stream 1 of user 7 with 42 viewers", stream)
+	}
+}
+
+func TestGetUserLiveStreamErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID uint64
+		err    error
+		want   int32
+	}{
+		{"missing user", 0, nil, 400},
+		{"not live", 7, service.ErrStreamNotFound, 404},
+		{"internal", 7, errors.New("db down"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &stubUserLiveService{err: tt.err}
+			resp, err := newTestHandler(svc).GetUserLiveStream(context.Background(), &proto_gen.GetUserLiveStreamRequest{TargetUserId: tt.userID, RequestId: "req-1"})
+			if err != nil || resp.Code != tt.want || resp.Stream != nil || resp.RequestId != "req-1" {
+				t.Errorf("GetUserLiveStream = (%v, %v), want code %d without a stream", resp, err, tt.want)
+			}
+		})
+	}
+}
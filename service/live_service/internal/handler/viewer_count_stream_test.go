@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"live_service/internal/service"
+	proto_gen "live_service/proto/proto_gen"
+)
+
+// stubViewerCountService 返回预设的在线人数序列
+type stubViewerCountService struct {
+	service.LiveService
+	counts []int64
+	err    error
+}
+
+func (s *stubViewerCountService) StreamViewerCount(ctx context.Context, streamID, userID uint64) (<-chan int64, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	ch := make(chan int64, len(s.counts))
+	for _, count := range s.counts {
+		ch <- count
+	}
+	close(ch)
+	return ch, nil
+}
+
+// viewerCountServer 记录推送给客户端的在线人数
+type viewerCountServer struct {
+	grpc.ServerStream
+	sent    []*proto_gen.ViewerCountUpdate
+	sendErr error
+}
+
+func (s *viewerCountServer) Context() context.Context { return context.Background() }
+
+func (s *viewerCountServer) Send(update *proto_gen.ViewerCountUpdate) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, update)
+	return nil
+}
+
+func TestStreamViewerCountSendsUpdates(t *testing.T) {
+	server := &viewerCountServer{}
+	h := newTestHandler(&stubViewerCountService{counts: []int64{3, 5, 0}})
+
+	if err := h.StreamViewerCount(&proto_gen.StreamViewerCountRequest{StreamId: 9, UserId: 7}, server); err != nil {
+		t.Fatalf("StreamViewerCount: %v", err)
+	}
+	if len(server.sent) != 3 {
+		t.Fatalf("sent %d updates, want 3", len(server.sent))
+	}
+	for i, want := range []uint32{3, 5, 0} {
+		if got := server.sent[i]; got.StreamId != 9 || got.ViewerCount != want || got.Timestamp == 0 {
+			t.Errorf("update %d = %v, want stream 9 count %d with timestamp", i, got, want)
+		}
+	}
+}
+
+func TestStreamViewerCountErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", fmt.Errorf("subscribe: %w", service.ErrStreamNotFound), codes.NotFound},
+		{"private", service.ErrStreamPrivate, codes.NotFound},
+		{"internal", errors.New("redis down"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newTestHandler(&stubViewerCountService{err: tt.err}).
+				StreamViewerCount(&proto_gen.StreamViewerCountRequest{StreamId: 9}, &viewerCountServer{})
+			if status.Code(err) != tt.want {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamViewerCountStopsOnSendError(t *testing.T) {
+	sendErr := errors.New("client gone")
+	h := newTestHandler(&stubViewerCountService{counts: []int64{3, 5}})
+	if err := h.StreamViewerCount(&proto_gen.StreamViewerCountRequest{StreamId: 9}, &viewerCountServer{sendErr: sendErr}); !errors.Is(err, sendErr) {
+		t.Errorf("error = %v, want the send error", err)
+	}
+}
@@ -36,10 +36,29 @@ const (
 	GlobalLiveCounterKey = "counter:live:global:%s" // 全局直播计数器
 
 	// 实时数据相关
-	LiveRealTimeKey    = "live:realtime:%d"     // 实时直播数据
-	LiveViewerCountKey = "live:viewer:count:%d" // 实时观看人数
-	LiveLikeCountKey   = "live:like:count:%d"   // 实时点赞数
-	LiveGiftRankKey    = "live:gift:rank:%d"    // 实时礼物排行
+	LiveRealTimeKey    = "live:realtime:%d"         // 实时直播数据
+	LiveViewerCountKey = "live:viewer:count:%d"     // 实时观看人数
+	LiveLikeCountKey   = "live:like:count:%d"       // 实时点赞数
+	LiveGiftRankKey    = "live:gift:rank:%d"        // 实时礼物排行
+	LiveGiftComboKey   = "live:gift:combo:%d:%d:%d" // 礼物连击计数
+	LiveGiftRequestKey = "live:gift:request:%d:%s"  // 送礼请求幂等记录(用户ID, 请求ID)
+	LiveChatMuteKey    = "live:chat:mute:%d:%d"     // 直播间禁言记录(直播流ID, 用户ID)
+
+	// 用户当前直播
+	LiveUserActiveStreamKey = "live:user:active:%d" // 用户未结束的直播流ID，0表示未在直播
+
+	// 观看者在线状态相关
+	// 两个键在同一事务或脚本中一起读写，使用相同的哈希标签保证Redis Cluster下落在同一个槽
+	LiveViewerPresenceKey  = "{live:presence}:%d"      // 直播间在线观看者(有序集合，分值为心跳过期时间的毫秒时间戳)
+	LivePresenceStreamsKey = "{live:presence}:streams" // 存在在线观看者的直播流集合，供清理任务遍历
+
+	// 直播间实时事件
+	LiveEventChannelKey = "live:events:%d" // 直播间事件发布订阅频道(聊天/礼物/点赞/进出房间)
+
+	// 每日排行榜相关，按自然日分桶，%s为日期(20060102)
+	LiveDailyStreamerGiftKey = "live:leaderboard:gift:%s"   // 当日主播收礼价值排行
+	LiveDailyPeakViewerKey   = "live:leaderboard:viewer:%s" // 当日直播峰值在线排行
+	LiveDailyLeaderboardKey  = "live:leaderboard:daily:%s"  // 当日排行榜结果缓存
 
 	// 推荐相关
 	LiveRecommendKey     = "live:recommend:%d"      // 直播推荐缓存
@@ -57,6 +76,17 @@ const (
 	LiveRealTimeTTL = 5 * time.Second  // 实时数据缓存5秒
 	LiveTrendTTL    = 5 * time.Minute  // 趋势缓存5分钟
 	LockExpiration  = 10 * time.Second // 分布式锁过期时间
+	GiftComboWindow = 5 * time.Second  // 礼物连击窗口5秒
+	GiftRequestTTL  = 24 * time.Hour   // 送礼请求幂等记录保留24小时
+
+	LiveViewerCountTTL = time.Minute // 观看人数计数1分钟，到期后从数据库校准
+
+	LiveGiftStatsTTL = 30 * time.Second // 礼物统计缓存30秒，送礼后主动失效
+
+	LiveUserActiveStreamTTL = 30 * time.Second // 用户当前直播缓存30秒，开播和下播时主动失效
+
+	LiveDailyBucketTTL      = 48 * time.Hour   // 每日排行榜分桶保留2天
+	LiveDailyLeaderboardTTL = 10 * time.Second // 每日排行榜结果缓存10秒
 )
 
 // LiveStreamCache 直播流缓存数据结构
@@ -161,6 +191,16 @@ type LiveGiftRankCache struct {
 	UpdatedAt time.Time     `json:"updated_at"`
 }
 
+// LiveChatMuteCache 直播间禁言记录，EndTime为0表示本场直播永久禁言
+type LiveChatMuteCache struct {
+	StreamID  uint64 `json:"stream_id"`
+	UserID    uint64 `json:"user_id"`
+	MutedBy   uint64 `json:"muted_by"`
+	Reason    string `json:"reason"`
+	StartTime int64  `json:"start_time"`
+	EndTime   int64  `json:"end_time"`
+}
+
 // GiftRanking 礼物排行项
 type GiftRanking struct {
 	UserID       uint64 `json:"user_id"`
@@ -258,6 +298,51 @@ func GetLiveGiftRankKey(streamID uint64) string {
 	return fmt.Sprintf(LiveGiftRankKey, streamID)
 }
 
+// GetLiveGiftComboKey 获取礼物连击计数键
+func GetLiveGiftComboKey(streamID, userID uint64, giftID uint32) string {
+	return fmt.Sprintf(LiveGiftComboKey, streamID, userID, giftID)
+}
+
+// GetLiveGiftRequestKey 获取送礼请求幂等键
+func GetLiveGiftRequestKey(userID uint64, requestID string) string {
+	return fmt.Sprintf(LiveGiftRequestKey, userID, requestID)
+}
+
+// GetLiveChatMuteKey 获取直播间禁言键
+func GetLiveChatMuteKey(streamID, userID uint64) string {
+	return fmt.Sprintf(LiveChatMuteKey, streamID, userID)
+}
+
+// GetLiveViewerPresenceKey 获取直播间在线观看者键
+func GetLiveViewerPresenceKey(streamID uint64) string {
+	return fmt.Sprintf(LiveViewerPresenceKey, streamID)
+}
+
+// GetLiveEventChannelKey 获取直播间事件频道键
+func GetLiveEventChannelKey(streamID uint64) string {
+	return fmt.Sprintf(LiveEventChannelKey, streamID)
+}
+
+// LeaderboardDay 排行榜日期分桶，按服务器本地时间的自然日划分
+func LeaderboardDay(t time.Time) string {
+	return t.Format("20060102")
+}
+
+// GetLiveDailyStreamerGiftKey 获取当日主播收礼排行键
+func GetLiveDailyStreamerGiftKey(day string) string {
+	return fmt.Sprintf(LiveDailyStreamerGiftKey, day)
+}
+
+// GetLiveDailyPeakViewerKey 获取当日峰值在线排行键
+func GetLiveDailyPeakViewerKey(day string) string {
+	return fmt.Sprintf(LiveDailyPeakViewerKey, day)
+}
+
+// GetLiveDailyLeaderboardKey 获取当日排行榜结果缓存键
+func GetLiveDailyLeaderboardKey(day string) string {
+	return fmt.Sprintf(LiveDailyLeaderboardKey, day)
+}
+
 // GetLiveRecommendKey 获取直播推荐键
 func GetLiveRecommendKey(userID uint64) string {
 	return fmt.Sprintf(LiveRecommendKey, userID)
@@ -288,7 +373,7 @@ func (c *LiveStreamCache) FromJSONBytes(data []byte) error {
 }
 
 // SetCache 设置缓存
-func SetCache(ctx context.Context, redisClient *redis.Client, key string, data interface{}, expiration time.Duration) error {
+func SetCache(ctx context.Context, redisClient redis.UniversalClient, key string, data interface{}, expiration time.Duration) error {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("failed to marshal data: %w", err)
@@ -298,7 +383,7 @@ func SetCache(ctx context.Context, redisClient *redis.Client, key string, data i
 }
 
 // GetCache 获取缓存
-func GetCache(ctx context.Context, redisClient *redis.Client, key string, dest interface{}) error {
+func GetCache(ctx context.Context, redisClient redis.UniversalClient, key string, dest interface{}) error {
 	data, err := redisClient.Get(ctx, key).Result()
 	if err != nil {
 		if err == redis.Nil {
@@ -311,10 +396,15 @@ func GetCache(ctx context.Context, redisClient *redis.Client, key string, dest i
 }
 
 // DeleteCache 删除缓存
-func DeleteCache(ctx context.Context, redisClient *redis.Client, key string) error {
+func DeleteCache(ctx context.Context, redisClient redis.UniversalClient, key string) error {
 	return redisClient.Del(ctx, key).Err()
 }
 
+// GetLiveUserActiveStreamKey 获取用户当前直播缓存键
+func GetLiveUserActiveStreamKey(userID uint64) string {
+	return fmt.Sprintf(LiveUserActiveStreamKey, userID)
+}
+
 // GetLiveViewerCountCacheKey 获取直播观看人数缓存键
 func GetLiveViewerCountCacheKey(streamID uint64) string {
 	return GetLiveViewerCountKey(streamID)
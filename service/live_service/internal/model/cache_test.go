@@ -0,0 +1,41 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// hashTag 返回键的Redis Cluster哈希标签，没有标签时返回整个键
+func hashTag(key string) string {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return key[start+1 : start+1+end]
+		}
+	}
+	return key
+}
+
+func TestPresenceKeysShareHashSlot(t *testing.T) {
+	// popExpiredPresenceScript和在线状态的事务管道同时操作这两个键
+	for _, streamID := range []uint64{1, 42, 987654321} {
+		if a, b := hashTag(GetLiveViewerPresenceKey(streamID)), hashTag(LivePresenceStreamsKey); a != b {
+			t.Errorf("stream %d: presence key tag %q != streams key tag %q", streamID, a, b)
+		}
+	}
+}
+
+func TestLeaderboardDayBoundary(t *testing.T) {
+	lastSecond := time.Date(2024, 5, 1, 23, 59, 59, 999999999, time.Local)
+	midnight := lastSecond.Add(time.Nanosecond)
+
+	if got := LeaderboardDay(lastSecond); got != "20240501" {
+		t.Errorf("LeaderboardDay(23:59:59.999) = %s, want 20240501", got)
+	}
+	if got := LeaderboardDay(midnight); got != "20240502" {
+		t.Errorf("LeaderboardDay(00:00) = %s, want 20240502", got)
+	}
+	if GetLiveDailyStreamerGiftKey(LeaderboardDay(lastSecond)) == GetLiveDailyStreamerGiftKey(LeaderboardDay(midnight)) {
+		t.Error("gifts on either side of midnight share a leaderboard bucket")
+	}
+}
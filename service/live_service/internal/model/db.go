@@ -2,6 +2,8 @@ package model
 
 import (
 	"gorm.io/gorm"
+
+	"live_service/pkg/paginate"
 )
 
 // DB 数据库连接实例
@@ -25,20 +27,17 @@ func Transaction(fc func(tx *gorm.DB) error) error {
 // Paginate 分页查询辅助函数
 func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		if page <= 0 {
-			page = 1
-		}
-		switch {
-		case pageSize > 100:
-			pageSize = 100
-		case pageSize <= 0:
-			pageSize = 10
-		}
-		offset := (page - 1) * pageSize
-		return db.Offset(offset).Limit(pageSize)
+		p := paginate.NewPage(page, pageSize)
+		return db.Offset(p.Offset()).Limit(p.Size)
 	}
 }
 
+// NormalizePage 规范化分页参数，页码从1开始，每页默认10条、最多100条
+func NormalizePage(page, pageSize int) (int, int) {
+	p := paginate.NewPage(page, pageSize)
+	return p.Number, p.Size
+}
+
 // LiveTabler 直播表接口
 type LiveTabler interface {
 	TableName() string
@@ -50,5 +49,20 @@ var (
 	_ LiveTabler = (*LiveRoom)(nil)
 	_ LiveTabler = (*LiveViewer)(nil)
 	_ LiveTabler = (*LiveGift)(nil)
+	_ LiveTabler = (*LiveCoinAccount)(nil)
 	_ LiveTabler = (*LiveChat)(nil)
+	_ LiveTabler = (*LiveChatArchive)(nil)
 )
+
+// Models 需要迁移的全部模型
+func Models() []interface{} {
+	return []interface{}{
+		&LiveStream{},
+		&LiveRoom{},
+		&LiveViewer{},
+		&LiveGift{},
+		&LiveCoinAccount{},
+		&LiveChat{},
+		&LiveChatArchive{},
+	}
+}
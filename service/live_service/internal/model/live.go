@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 )
 
@@ -12,13 +13,18 @@ type LiveStream struct {
 	Description  string `gorm:"type:text;comment:直播描述"`
 	UserID       uint64 `gorm:"index;not null;comment:主播用户ID"`
 	RoomID       uint64 `gorm:"index;not null;comment:直播间ID"`
-	CategoryID   uint32 `gorm:"index;default:0;comment:直播分类ID"`
-	Status       uint8  `gorm:"index;default:0;comment:直播状态:0-准备中,1-直播中,2-暂停,3-结束,4-封禁"`
+	CategoryID   uint32 `gorm:"index;index:idx_live_status_category,priority:2;default:0;comment:直播分类ID"`
+	Language     string `gorm:"size:16;index;default:'';comment:直播语言,如zh、en"`
+	Region       string `gorm:"size:16;index;default:'';comment:直播地区,如CN、US"`
+	Status       uint8  `gorm:"index;index:idx_live_status_category,priority:1;default:0;comment:直播状态:0-准备中,1-直播中,2-暂停,3-结束,4-封禁"`
 	StreamType   string `gorm:"size:20;default:'rtmp';comment:直播流类型:rtmp,webrtc"`
 	StreamURL    string `gorm:"size:500;comment:直播流URL"`
 	PlaybackURL  string `gorm:"size:500;comment:回放URL"`
 	ThumbnailURL string `gorm:"size:500;comment:缩略图URL"`
 
+	// PlaybackStatus 回放状态，录制的直播结束后为processing，转码完成后为ready，未录制的直播为空
+	PlaybackStatus string `gorm:"size:20;default:'';comment:回放状态:processing,ready,failed"`
+
 	// 直播统计
 	ViewerCount  uint32 `gorm:"default:0;comment:观看人数"`
 	LikeCount    uint32 `gorm:"default:0;comment:点赞数"`
@@ -31,6 +37,8 @@ type LiveStream struct {
 	IsRecord      bool `gorm:"default:false;comment:是否录制"`
 	IsChatEnabled bool `gorm:"default:true;comment:是否开启聊天"`
 	IsGiftEnabled bool `gorm:"default:true;comment:是否开启礼物"`
+	// RoomPassword 房间密码哈希，为空表示无需密码
+	RoomPassword string `gorm:"size:64;comment:房间密码哈希"`
 
 	// 直播质量
 	VideoQuality string `gorm:"size:20;default:'720p';comment:视频质量"`
@@ -39,15 +47,16 @@ type LiveStream struct {
 	Framerate    uint8  `gorm:"default:30;comment:帧率"`
 
 	// 时间信息
-	StartedAt    *time.Time `gorm:"comment:开始时间"`
+	StartedAt    *time.Time `gorm:"index:idx_live_status_category,priority:4;comment:开始时间"`
 	EndedAt      *time.Time `gorm:"comment:结束时间"`
+	PausedAt     *time.Time `gorm:"comment:暂停时间，恢复直播后清空"`
 	LastActiveAt *time.Time `gorm:"comment:最后活跃时间"`
 	Duration     uint32     `gorm:"default:0;comment:直播时长(秒)"`
 
 	// 状态信息
 	IsRecommended bool  `gorm:"default:false;index;comment:是否推荐"`
 	IsFeatured    bool  `gorm:"default:false;index;comment:是否精选"`
-	Weight        int32 `gorm:"default:0;index;comment:权重排序"`
+	Weight        int32 `gorm:"default:0;index;index:idx_live_status_category,priority:3;comment:权重排序"`
 
 	// 时间戳
 	CreatedAt time.Time  `gorm:"comment:创建时间"`
@@ -60,6 +69,17 @@ func (LiveStream) TableName() string {
 	return "live_streams"
 }
 
+// streamKeyVisiblePrefix 日志中保留的推流密钥前缀长度
+const streamKeyVisiblePrefix = 4
+
+// MaskStreamKey 遮盖推流密钥用于记录日志，只保留前4个字符便于排查，持有日志的人无法用它推流
+func MaskStreamKey(streamKey string) string {
+	if len(streamKey) <= 2*streamKeyVisiblePrefix {
+		return "****"
+	}
+	return streamKey[:streamKeyVisiblePrefix] + "****"
+}
+
 // LiveRoom 直播间表
 type LiveRoom struct {
 	ID          uint64 `gorm:"primaryKey;autoIncrement;comment:直播间ID"`
@@ -111,6 +131,7 @@ type LiveViewer struct {
 	EnterTime     time.Time  `gorm:"comment:进入时间"`
 	ExitTime      *time.Time `gorm:"comment:离开时间"`
 	WatchDuration uint32     `gorm:"default:0;comment:观看时长(秒)"`
+	AccruedAt     *time.Time `gorm:"comment:观看时长已累计至该时间，为空时从进入时间起算"`
 
 	// 互动信息
 	IsLiked      bool       `gorm:"default:false;comment:是否点赞"`
@@ -149,14 +170,23 @@ type LiveGift struct {
 	GiftCount  uint32 `gorm:"default:1;comment:礼物数量"`
 	TotalValue uint64 `gorm:"default:0;comment:总价值"`
 
+	// 连击信息
+	ComboCount      uint32 `gorm:"default:1;comment:连击次数"`
+	ComboMultiplier uint32 `gorm:"default:1;comment:连击倍数"`
+	DisplayValue    uint64 `gorm:"default:0;comment:展示价值(总价值*连击倍数)"`
+
 	// 特效信息
 	EffectType string `gorm:"size:50;comment:特效类型"`
 	EffectData string `gorm:"type:text;comment:特效数据"`
 
 	// 状态信息
-	Status   uint8     `gorm:"default:1;comment:状态:0-失败,1-成功"`
+	Status   uint8     `gorm:"default:1;comment:状态:0-失败,1-成功,2-已退款"`
 	SendTime time.Time `gorm:"comment:发送时间"`
 
+	// 退款信息
+	RefundReason string     `gorm:"size:255;comment:退款原因"`
+	RefundedAt   *time.Time `gorm:"comment:退款时间"`
+
 	// 时间戳
 	CreatedAt time.Time  `gorm:"comment:创建时间"`
 	UpdatedAt time.Time  `gorm:"comment:更新时间"`
@@ -168,6 +198,19 @@ func (LiveGift) TableName() string {
 	return "live_gifts"
 }
 
+// LiveCoinAccount 用户金币账户表，礼物退款时退还的金币记入此账户
+type LiveCoinAccount struct {
+	UserID    uint64    `gorm:"primaryKey;autoIncrement:false;comment:用户ID"`
+	Balance   uint64    `gorm:"default:0;not null;comment:金币余额"`
+	CreatedAt time.Time `gorm:"comment:创建时间"`
+	UpdatedAt time.Time `gorm:"comment:更新时间"`
+}
+
+// TableName 设置表名
+func (LiveCoinAccount) TableName() string {
+	return "live_coin_accounts"
+}
+
 // LiveChat 直播聊天消息表
 type LiveChat struct {
 	ID       uint64 `gorm:"primaryKey;autoIncrement;comment:聊天消息ID"`
@@ -177,7 +220,7 @@ type LiveChat struct {
 
 	// 消息内容
 	Content     string `gorm:"type:text;not null;comment:消息内容"`
-	ContentType string `gorm:"size:20;default:'text';comment:内容类型:text,image,emoji"`
+	ContentType string `gorm:"size:20;default:'text';comment:内容类型:text,emoji,system,gift_notice"`
 
 	// 用户信息
 	UserNickname string `gorm:"size:100;comment:用户昵称"`
@@ -209,6 +252,17 @@ func (LiveChat) TableName() string {
 	return "live_chats"
 }
 
+// LiveChatArchive 直播聊天归档表，保存超过保留期的聊天消息，字段与LiveChat一致并保留原消息ID
+type LiveChatArchive struct {
+	LiveChat
+	ArchivedAt time.Time `gorm:"index;comment:归档时间"`
+}
+
+// TableName 设置表名
+func (LiveChatArchive) TableName() string {
+	return "live_chat_archives"
+}
+
 // 直播状态常量
 const (
 	LiveStatusPreparing = 0 // 准备中
@@ -225,18 +279,43 @@ const (
 	RoomStatusBanned  = 2 // 禁播
 )
 
+// 回放状态常量
+const (
+	PlaybackStatusProcessing = "processing" // 转码中
+	PlaybackStatusReady      = "ready"      // 可播放
+	PlaybackStatusFailed     = "failed"     // 录制或转码失败
+)
+
+// 礼物记录状态常量
+const (
+	GiftStatusFailed   = 0 // 失败
+	GiftStatusSuccess  = 1 // 成功
+	GiftStatusRefunded = 2 // 已退款，不再计入礼物统计
+)
+
 // 直播流类型常量
 const (
 	StreamTypeRTMP   = "rtmp"
 	StreamTypeWebRTC = "webrtc"
 )
 
-// 内容类型常量
+// 聊天消息类型常量，system和gift_notice只能由服务端发送
 const (
-	ContentTypeText  = "text"
-	ContentTypeImage = "image"
-	ContentTypeEmoji = "emoji"
+	ContentTypeText       = "text"        // 文本消息
+	ContentTypeEmoji      = "emoji"       // 表情消息，内容为表情编码
+	ContentTypeSystem     = "system"      // 系统消息
+	ContentTypeGiftNotice = "gift_notice" // 送礼通知
 )
 
+// NormalizeLanguage 规范化直播语言代码为小写，如"ZH"转为"zh"
+func NormalizeLanguage(language string) string {
+	return strings.ToLower(strings.TrimSpace(language))
+}
+
+// NormalizeRegion 规范化直播地区代码为大写，如"cn"转为"CN"
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
 // LiveStatus 直播状态类型
 type LiveStatus uint8
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LiveEventType 直播间实时事件类型，客户端据此解析Data
+type LiveEventType string
+
+const (
+	LiveEventChat  LiveEventType = "chat"  // 聊天消息
+	LiveEventGift  LiveEventType = "gift"  // 礼物
+	LiveEventLike  LiveEventType = "like"  // 点赞
+	LiveEventJoin  LiveEventType = "join"  // 进入直播间
+	LiveEventLeave LiveEventType = "leave" // 离开直播间
+
+	// LiveEventTerminated 直播被管理员强制结束，客户端收到后退出直播间
+	LiveEventTerminated LiveEventType = "terminated"
+	// LiveEventEnded 直播正常结束（主播下播或断流）
+	LiveEventEnded LiveEventType = "ended"
+
+	// LiveEventPaused 主播暂停直播，LiveEventResumed 主播恢复直播，事件数据为空
+	LiveEventPaused  LiveEventType = "paused"
+	LiveEventResumed LiveEventType = "resumed"
+
+	// LiveEventViewerCount 在线人数变化，观看人数批量提交后发布，由StreamViewerCount推送给客户端
+	LiveEventViewerCount LiveEventType = "viewer_count"
+)
+
+// LiveEvent 直播间实时事件信封，Type区分事件类型，Data为对应类型的事件数据
+type LiveEvent struct {
+	Type      LiveEventType   `json:"type"`
+	StreamID  uint64          `json:"stream_id"`
+	UserID    uint64          `json:"user_id"`
+	Timestamp int64           `json:"timestamp"` // 毫秒时间戳
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// LiveChatEventData 聊天事件数据
+type LiveChatEventData struct {
+	ChatID      uint64 `json:"chat_id"`
+	Content     string `json:"content"`
+	ContentType string `json:"content_type"`
+	IsAnchor    bool   `json:"is_anchor"`
+	IsAdmin     bool   `json:"is_admin"`
+}
+
+// LiveGiftEventData 礼物事件数据，连击倍数和展示价值用于客户端渲染特效
+type LiveGiftEventData struct {
+	GiftRecordID    uint64 `json:"gift_record_id"`
+	GiftID          uint32 `json:"gift_id"`
+	GiftName        string `json:"gift_name"`
+	GiftIcon        string `json:"gift_icon"`
+	GiftCount       uint32 `json:"gift_count"`
+	TotalValue      uint64 `json:"total_value"`
+	ComboCount      uint32 `json:"combo_count"`
+	ComboMultiplier uint32 `json:"combo_multiplier"`
+	DisplayValue    uint64 `json:"display_value"`
+	EffectType      string `json:"effect_type"`
+	EffectValue     string `json:"effect_value"` // 特效值，含义由effect_type决定
+}
+
+// LiveLikeEventData 点赞事件数据
+type LiveLikeEventData struct {
+	Count int64 `json:"count"`
+}
+
+// LiveViewerEventData 进出直播间事件数据，Count为本次进出的人数，清理任务会批量产生离开事件
+type LiveViewerEventData struct {
+	Count int64 `json:"count"`
+}
+
+// LiveViewerCountEventData 在线人数事件数据，Count为提交后的在线人数
+type LiveViewerCountEventData struct {
+	Count int64 `json:"count"`
+}
+
+// LiveTerminatedEventData 直播被强制结束事件数据
+type LiveTerminatedEventData struct {
+	Reason string `json:"reason"`
+}
+
+// NewLiveEvent 创建直播间事件，data序列化后作为事件数据
+func NewLiveEvent(eventType LiveEventType, streamID, userID uint64, data interface{}) (*LiveEvent, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event data: %w", eventType, err)
+	}
+	return &LiveEvent{
+		Type:      eventType,
+		StreamID:  streamID,
+		UserID:    userID,
+		Timestamp: time.Now().UnixMilli(),
+		Data:      raw,
+	}, nil
+}
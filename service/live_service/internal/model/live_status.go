@@ -0,0 +1,81 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidLiveStatusTransition 非法的直播状态变更
+var ErrInvalidLiveStatusTransition = errors.New("invalid live status transition")
+
+// liveStatusTransitions 直播状态转换表，key为当前状态，value为允许转换到的状态
+// 准备中 -> 直播中 -> 暂停/结束，暂停可恢复直播；任何未结束的状态都可被封禁；结束和封禁为终态
+var liveStatusTransitions = map[LiveStatus][]LiveStatus{
+	LiveStatusPreparing: {LiveStatusStreaming, LiveStatusEnded, LiveStatusBanned},
+	LiveStatusStreaming: {LiveStatusPaused, LiveStatusEnded, LiveStatusBanned},
+	LiveStatusPaused:    {LiveStatusStreaming, LiveStatusEnded, LiveStatusBanned},
+	LiveStatusEnded:     {},
+	LiveStatusBanned:    {},
+}
+
+// String 直播状态名称
+func (s LiveStatus) String() string {
+	switch s {
+	case LiveStatusPreparing:
+		return "preparing"
+	case LiveStatusStreaming:
+		return "streaming"
+	case LiveStatusPaused:
+		return "paused"
+	case LiveStatusEnded:
+		return "ended"
+	case LiveStatusBanned:
+		return "banned"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// IsValid 是否为已定义的直播状态
+func (s LiveStatus) IsValid() bool {
+	_, ok := liveStatusTransitions[s]
+	return ok
+}
+
+// IsTerminal 是否为终态，终态不允许再变更
+func (s LiveStatus) IsTerminal() bool {
+	return s.IsValid() && len(liveStatusTransitions[s]) == 0
+}
+
+// CanTransitionTo 是否允许从当前状态变更到next，状态不变不视为转换
+func (s LiveStatus) CanTransitionTo(next LiveStatus) bool {
+	for _, allowed := range liveStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateLiveStatusTransition 校验状态变更，非法时返回包装了ErrInvalidLiveStatusTransition的错误
+// 目标状态与当前状态相同时视为幂等操作，不报错
+func ValidateLiveStatusTransition(from, to LiveStatus) error {
+	if from == to && from.IsValid() {
+		return nil
+	}
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidLiveStatusTransition, from, to)
+	}
+	return nil
+}
+
+// LiveStatusesBefore 允许变更到to的全部前置状态，用于条件更新
+func LiveStatusesBefore(to LiveStatus) []LiveStatus {
+	var from []LiveStatus
+	for status := LiveStatus(LiveStatusPreparing); status <= LiveStatusBanned; status++ {
+		if status.CanTransitionTo(to) {
+			from = append(from, status)
+		}
+	}
+	return from
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLiveStatusTransitionTable(t *testing.T) {
+	const (
+		P = LiveStatusPreparing
+		S = LiveStatusStreaming
+		A = LiveStatusPaused
+		E = LiveStatusEnded
+		B = LiveStatusBanned
+	)
+	allowed := map[[2]LiveStatus]bool{
+		{P, S}: true, {P, E}: true, {P, B}: true,
+		{S, A}: true, {S, E}: true, {S, B}: true,
+		{A, S}: true, {A, E}: true, {A, B}: true,
+	}
+
+	statuses := []LiveStatus{P, S, A, E, B}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[[2]LiveStatus{from, to}]
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s -> %s allowed = %v, want %v", from, to, got, want)
+			}
+
+			err := ValidateLiveStatusTransition(from, to)
+			switch {
+			case from == to || want:
+				if err != nil {
+					t.Errorf("Validate(%s -> %s) = %v, want nil", from, to, err)
+				}
+			case !errors.Is(err, ErrInvalidLiveStatusTransition):
+				t.Errorf("Validate(%s -> %s) = %v, want ErrInvalidLiveStatusTransition", from, to, err)
+			}
+		}
+	}
+}
+
+func TestLiveStatusTerminalAndUnknown(t *testing.T) {
+	for _, status := range []LiveStatus{LiveStatusEnded, LiveStatusBanned} {
+		if !status.IsTerminal() {
+			t.Errorf("%s should be terminal", status)
+		}
+	}
+	for _, status := range []LiveStatus{LiveStatusPreparing, LiveStatusStreaming, LiveStatusPaused} {
+		if status.IsTerminal() {
+			t.Errorf("%s should not be terminal", status)
+		}
+	}
+
+	unknown := LiveStatus(9)
+	if unknown.IsValid() || unknown.IsTerminal() || unknown.String() != "unknown(9)" {
+		t.Errorf("unknown status: valid=%v terminal=%v name=%q", unknown.IsValid(), unknown.IsTerminal(), unknown.String())
+	}
+	// 未知状态即使与目标相同也不能通过校验
+	if err := ValidateLiveStatusTransition(unknown, unknown); !errors.Is(err, ErrInvalidLiveStatusTransition) {
+		t.Errorf("Validate(unknown -> unknown) = %v, want ErrInvalidLiveStatusTransition", err)
+	}
+	if err := ValidateLiveStatusTransition(unknown, LiveStatusStreaming); !errors.Is(err, ErrInvalidLiveStatusTransition) {
+		t.Errorf("Validate(unknown -> streaming) = %v, want ErrInvalidLiveStatusTransition", err)
+	}
+}
+
+func TestLiveStatusesBefore(t *testing.T) {
+	tests := []struct {
+		to   LiveStatus
+		want []LiveStatus
+	}{
+		{LiveStatusStreaming, []LiveStatus{LiveStatusPreparing, LiveStatusPaused}},
+		{LiveStatusPaused, []LiveStatus{LiveStatusStreaming}},
+		{LiveStatusEnded, []LiveStatus{LiveStatusPreparing, LiveStatusStreaming, LiveStatusPaused}},
+		{LiveStatusBanned, []LiveStatus{LiveStatusPreparing, LiveStatusStreaming, LiveStatusPaused}},
+		{LiveStatusPreparing, nil},
+	}
+	for _, tt := range tests {
+		got := LiveStatusesBefore(tt.to)
+		if len(got) != len(tt.want) {
+			t.Errorf("LiveStatusesBefore(%s) = %v, want %v", tt.to, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("LiveStatusesBefore(%s) = %v, want %v", tt.to, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+import "testing"
+
+func TestMaskStreamKey(t *testing.T) {
+	cases := map[string]string{
+		"0123456789abcdef0123456789abcdef":         "0123****",
+		"revoked_0123456789abcdef0123456789abcdef": "revo****",
+		"short":    "****",
+		"12345678": "****",
+		"":         "****",
+	}
+	for key, want := range cases {
+		if got := MaskStreamKey(key); got != want {
+			t.Errorf("MaskStreamKey(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestNormalizeLanguageAndRegion(t *testing.T) {
+	tests := []struct {
+		in, language, region string
+	}{
+		{"zh", "zh", "ZH"},
+		{" EN ", "en", "EN"},
+		{"cn", "cn", "CN"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeLanguage(tt.in); got != tt.language {
+			t.Errorf("NormalizeLanguage(%q) = %q, want %q", tt.in, got, tt.language)
+		}
+		if got := NormalizeRegion(tt.in); got != tt.region {
+			t.Errorf("NormalizeRegion(%q) = %q, want %q", tt.in, got, tt.region)
+		}
+	}
+}
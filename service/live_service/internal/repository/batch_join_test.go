@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// txConnPool 只支持开启事务的连接池，记录事务是否提交或回滚；配合DryRun使用，不执行SQL
+type txConnPool struct {
+	gorm.ConnPool
+	committed, rolledBack bool
+}
+
+func (p *txConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &txConn{pool: p}, nil
+}
+
+// txConn 事务连接，不支持再开启事务，事务内的语句不会嵌套默认事务
+type txConn struct {
+	gorm.ConnPool
+	pool *txConnPool
+}
+
+func (tx *txConn) Commit() error {
+	tx.pool.committed = true
+	return nil
+}
+
+func (tx *txConn) Rollback() error {
+	tx.pool.rolledBack = true
+	return nil
+}
+
+// batchJoinStatement 批量加入时执行的语句
+type batchJoinStatement struct {
+	kind string // query、update或create
+	sql  string
+	vars []interface{}
+}
+
+// newDryRunBatchJoinRepository 只生成SQL的仓库，existing为已有观看记录的用户，reentered为重新进入的记录数
+func newDryRunBatchJoinRepository(t *testing.T, existing []uint64, reentered int64, createErr error) (*liveRepository, *txConnPool, *[]batchJoinStatement) {
+	t.Helper()
+	pool := &txConnPool{}
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: pool, SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+
+	var statements []batchJoinStatement
+	record := func(kind string) func(tx *gorm.DB) {
+		return func(tx *gorm.DB) {
+			statements = append(statements, batchJoinStatement{kind: kind, sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars})
+			switch kind {
+			case "query":
+				if dest, ok := tx.Statement.Dest.(*[]uint64); ok {
+					*dest = append(*dest, existing...)
+				}
+			case "update":
+				tx.RowsAffected = reentered
+			case "create":
+				if createErr != nil {
+					tx.AddError(createErr)
+				}
+			}
+		}
+	}
+	callbacks := []error{
+		db.Callback().Query().After("gorm:query").Register("test:record", record("query")),
+		db.Callback().Update().After("gorm:update").Register("test:record", record("update")),
+		db.Callback().Create().After("gorm:create").Register("test:record", record("create")),
+	}
+	for _, err := range callbacks {
+		if err != nil {
+			t.Fatalf("register callback: %v", err)
+		}
+	}
+	return &liveRepository{db: db, tx: true}, pool, &statements
+}
+
+func TestBatchJoinLiveViewers(t *testing.T) {
+	// 20仍在观看，21已离开，22和23首次进入
+	repo, pool, statements := newDryRunBatchJoinRepository(t, []uint64{20, 21}, 1, nil)
+	enterTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	joined, err := repo.BatchJoinLiveViewers(context.Background(), 1, 5, []uint64{20, 21, 22, 23}, enterTime)
+	if err != nil {
+		t.Fatalf("BatchJoinLiveViewers: %v", err)
+	}
+	if joined != 3 {
+		t.Errorf("joined = %d, want 1 re-entered and 2 created", joined)
+	}
+	if !pool.committed || pool.rolledBack {
+		t.Errorf("committed %v rolled back %v, want one committed transaction", pool.committed, pool.rolledBack)
+	}
+
+	if len(*statements) != 3 {
+		t.Fatalf("executed %d statements, want lock, re-enter and insert", len(*statements))
+	}
+	lock, reenter, insert := (*statements)[0], (*statements)[1], (*statements)[2]
+	if lock.kind != "query" || !strings.Contains(lock.sql, "stream_id = ? AND user_id IN (?,?,?,?)") || !strings.HasSuffix(lock.sql, "FOR UPDATE") {
+		t.Errorf("lock query = %s, want existing records locked for update", lock.sql)
+	}
+	// 只有已离开的记录重新进入
+	if reenter.kind != "update" || !strings.Contains(reenter.sql, "exit_time IS NOT NULL") ||
+		!strings.Contains(reenter.sql, "`accrued_at`=?") || !strings.Contains(reenter.sql, "`enter_time`=?") || !strings.Contains(reenter.sql, "`exit_time`=?") {
+		t.Errorf("re-enter update = %s, want exited records reset", reenter.sql)
+	}
+	// 只为没有观看记录的用户插入
+	if insert.kind != "create" || !strings.HasPrefix(insert.sql, "INSERT INTO `live_viewers`") || strings.Count(insert.sql, "),(") != 1 {
+		t.Errorf("insert = %s, want two new records", insert.sql)
+	}
+	var users []interface{}
+	for _, v := range insert.vars {
+		if id, ok := v.(uint64); ok && id >= 20 {
+			users = append(users, id)
+		}
+	}
+	if len(users) != 2 || users[0] != uint64(22) || users[1] != uint64(23) {
+		t.Errorf("inserted users = %v, want 22 and 23", users)
+	}
+}
+
+func TestBatchJoinLiveViewersAllPresent(t *testing.T) {
+	repo, _, statements := newDryRunBatchJoinRepository(t, []uint64{20, 21}, 0, nil)
+
+	joined, err := repo.BatchJoinLiveViewers(context.Background(), 1, 5, []uint64{20, 21}, time.Now())
+	if err != nil || joined != 0 {
+		t.Fatalf("BatchJoinLiveViewers = (%d, %v), want 0", joined, err)
+	}
+	for _, stmt := range *statements {
+		if stmt.kind == "create" {
+			t.Errorf("unexpected insert %s", stmt.sql)
+		}
+	}
+}
+
+func TestBatchJoinLiveViewersRollsBack(t *testing.T) {
+	createErr := errors.New("duplicate entry")
+	repo, pool, _ := newDryRunBatchJoinRepository(t, nil, 0, createErr)
+
+	joined, err := repo.BatchJoinLiveViewers(context.Background(), 1, 5, []uint64{20}, time.Now())
+	if !errors.Is(err, createErr) || joined != 0 {
+		t.Errorf("BatchJoinLiveViewers = (%d, %v), want the insert failure", joined, err)
+	}
+	if pool.committed || !pool.rolledBack {
+		t.Errorf("committed %v rolled back %v, want the transaction rolled back", pool.committed, pool.rolledBack)
+	}
+}
+
+func TestBatchJoinLiveViewersEmpty(t *testing.T) {
+	repo, pool, statements := newDryRunBatchJoinRepository(t, nil, 0, nil)
+	if joined, err := repo.BatchJoinLiveViewers(context.Background(), 1, 5, nil, time.Now()); err != nil || joined != 0 {
+		t.Errorf("BatchJoinLiveViewers = (%d, %v), want 0", joined, err)
+	}
+	if len(*statements) != 0 || pool.committed {
+		t.Errorf("empty batch executed %d statements", len(*statements))
+	}
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"live_service/internal/model"
+)
+
+// chatQuery 聊天查询的表、SQL和分页
+type chatQuery struct {
+	table  string
+	sql    string
+	vars   []interface{}
+	offset int
+	limit  int
+}
+
+// newDryRunChatRepository 只生成SQL不连接数据库的仓库，主表有recent条、归档表有archived条符合条件的消息
+// 统计查询返回对应表的条数，分页查询按limit和offset返回对应数量的消息
+func newDryRunChatRepository(t *testing.T, recent, archived int) (*liveRepository, *[]chatQuery) {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/live", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+
+	var queries []chatQuery
+	err = db.Callback().Query().After("gorm:query").Register("test:record", func(tx *gorm.DB) {
+		q := chatQuery{table: tx.Statement.Table, sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars}
+		if c, ok := tx.Statement.Clauses["LIMIT"]; ok {
+			if limit, ok := c.Expression.(clause.Limit); ok {
+				q.offset = limit.Offset
+				if limit.Limit != nil {
+					q.limit = *limit.Limit
+				}
+			}
+		}
+		queries = append(queries, q)
+
+		rows := recent
+		if q.table == "live_chat_archives" {
+			rows = archived
+		}
+		// 当前页的消息数
+		n := rows - q.offset
+		if n > q.limit {
+			n = q.limit
+		}
+		switch dest := tx.Statement.Dest.(type) {
+		case *int64:
+			*dest = int64(rows)
+			tx.RowsAffected = 1
+		case *[]*model.LiveChat:
+			for i := 0; i < n; i++ {
+				*dest = append(*dest, &model.LiveChat{ID: uint64(q.offset + i + 1)})
+			}
+		case *[]*model.LiveChatArchive:
+			for i := 0; i < n; i++ {
+				*dest = append(*dest, &model.LiveChatArchive{LiveChat: model.LiveChat{ID: uint64(1000 + q.offset + i + 1)}})
+			}
+		}
+	})
+	if err != nil {
+		t.Fatalf("register query callback: %v", err)
+	}
+	return &liveRepository{db: db}, &queries
+}
+
+// pageQueries 返回分页查询，不含统计查询
+func pageQueries(queries []chatQuery) []chatQuery {
+	var pages []chatQuery
+	for _, q := range queries {
+		if q.limit > 0 {
+			pages = append(pages, q)
+		}
+	}
+	return pages
+}
+
+func TestGetLiveChatHistoryContinuesIntoArchive(t *testing.T) {
+	// 主表25条，归档表30条，每页10条
+	tests := []struct {
+		name      string
+		page      int
+		wantPages []chatQuery // 期望的分页查询，只比较表、offset和limit
+		wantIDs   []uint64    // 期望返回的第一条和最后一条消息ID
+		wantLen   int
+	}{
+		{"recent only", 1, []chatQuery{{table: "live_chats", offset: 0, limit: 10}}, []uint64{1, 10}, 10},
+		{"spans both tables", 3, []chatQuery{
+			{table: "live_chats", offset: 20, limit: 10},
+			{table: "live_chat_archives", offset: 0, limit: 5},
+		}, []uint64{21, 1005}, 10},
+		{"archive only", 4, []chatQuery{{table: "live_chat_archives", offset: 5, limit: 10}}, []uint64{1006, 1015}, 10},
+		{"last page", 6, []chatQuery{{table: "live_chat_archives", offset: 25, limit: 10}}, []uint64{1026, 1030}, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, queries := newDryRunChatRepository(t, 25, 30)
+
+			chats, total, err := repo.GetLiveChatHistory(context.Background(), 3, 1000, 2000, tt.page, 10)
+			if err != nil {
+				t.Fatalf("GetLiveChatHistory: %v", err)
+			}
+			if total != 55 {
+				t.Errorf("total = %d, want both tables counted", total)
+			}
+			if len(chats) != tt.wantLen || chats[0].ID != tt.wantIDs[0] || chats[len(chats)-1].ID != tt.wantIDs[1] {
+				t.Fatalf("got %d chats, want %d from %d to %d", len(chats), tt.wantLen, tt.wantIDs[0], tt.wantIDs[1])
+			}
+
+			pages := pageQueries(*queries)
+			if len(pages) != len(tt.wantPages) {
+				t.Fatalf("page queries = %+v, want %+v", pages, tt.wantPages)
+			}
+			for i, want := range tt.wantPages {
+				if got := pages[i]; got.table != want.table || got.offset != want.offset || got.limit != want.limit {
+					t.Errorf("page query %d = %s offset %d limit %d, want %s offset %d limit %d",
+						i, got.table, got.offset, got.limit, want.table, want.offset, want.limit)
+				}
+			}
+		})
+	}
+}
+
+func TestGetLiveChatHistoryConditions(t *testing.T) {
+	repo, queries := newDryRunChatRepository(t, 5, 5)
+
+	if _, _, err := repo.GetLiveChatHistory(context.Background(), 3, 1000, 2000, 1, 20); err != nil {
+		t.Fatalf("GetLiveChatHistory: %v", err)
+	}
+	// 秒级时间戳转换为时间比较，两张表使用相同条件和排序
+	start, end := time.Unix(1000, 0), time.Unix(2000, 0)
+	for _, q := range *queries {
+		if !strings.Contains(q.sql, "stream_id = ? AND created_at >= ? AND created_at <= ? AND deleted_at IS NULL") {
+			t.Errorf("%s query = %s, want the stream and time range", q.table, q.sql)
+		}
+		if !hasVar(q.vars, uint64(3)) || !hasVar(q.vars, start) || !hasVar(q.vars, end) {
+			t.Errorf("%s vars = %v, want stream 3 between %v and %v", q.table, q.vars, start, end)
+		}
+		if q.limit > 0 && !strings.Contains(q.sql, "ORDER BY created_at DESC, id DESC") {
+			t.Errorf("%s page query = %s, want newest first", q.table, q.sql)
+		}
+	}
+}
+
+func TestListChatRetentionStreamsQuery(t *testing.T) {
+	repo, queries := newDryRunChatRepository(t, 0, 0)
+	before := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := repo.ListChatRetentionStreams(context.Background(), before, 100); err != nil {
+		t.Fatalf("ListChatRetentionStreams: %v", err)
+	}
+	if len(*queries) != 1 {
+		t.Fatalf("queries = %d, want 1", len(*queries))
+	}
+	q := (*queries)[0]
+	// 只处理已结束或封禁的直播，直播中的聊天不受影响
+	for _, want := range []string{"SELECT DISTINCT `live_chats`.`stream_id`", "JOIN live_streams", "live_streams.status IN (?,?)", "live_chats.created_at < ?"} {
+		if !strings.Contains(q.sql, want) {
+			t.Errorf("query missing %q: %s", want, q.sql)
+		}
+	}
+	if !hasVar(q.vars, model.LiveStatus(model.LiveStatusEnded)) || !hasVar(q.vars, model.LiveStatus(model.LiveStatusBanned)) || !hasVar(q.vars, before) || q.limit != 100 {
+		t.Errorf("vars = %v limit %d, want ended and banned before %v, limit 100", q.vars, q.limit, before)
+	}
+}
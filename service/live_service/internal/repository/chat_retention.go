@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"live_service/internal/model"
+)
+
+// ListChatRetentionStreams 获取已结束且有早于before的聊天消息的直播
+func (r *liveRepository) ListChatRetentionStreams(ctx context.Context, before time.Time, limit int) ([]uint64, error) {
+	var streamIDs []uint64
+	err := r.conn(ctx).
+		Model(&model.LiveChat{}).
+		Joins("JOIN live_streams ON live_streams.id = live_chats.stream_id").
+		Where("live_streams.status IN ? AND live_chats.created_at < ?",
+			[]model.LiveStatus{model.LiveStatusEnded, model.LiveStatusBanned}, before).
+		Distinct().
+		Limit(limit).
+		Pluck("live_chats.stream_id", &streamIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return streamIDs, nil
+}
+
+// ArchiveLiveChats 将直播中早于before的一批聊天消息移出主表，返回处理的消息数
+// archive为true时先写入归档表再删除，同一事务内完成，重复执行不会产生重复的归档记录
+func (r *liveRepository) ArchiveLiveChats(ctx context.Context, streamID uint64, before time.Time, batchSize int, archive bool) (int64, error) {
+	var moved int64
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var chats []*model.LiveChat
+		if err := tx.Where("stream_id = ? AND created_at < ?", streamID, before).
+			Order("id ASC").
+			Limit(batchSize).
+			Find(&chats).Error; err != nil {
+			return err
+		}
+		if len(chats) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, len(chats))
+		for i, chat := range chats {
+			ids[i] = chat.ID
+		}
+
+		if archive {
+			now := time.Now()
+			archived := make([]*model.LiveChatArchive, len(chats))
+			for i, chat := range chats {
+				archived[i] = &model.LiveChatArchive{LiveChat: *chat, ArchivedAt: now}
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&archived).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Where("id IN ?", ids).Delete(&model.LiveChat{})
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return moved, nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"live_service/internal/model"
+)
+
+// CreditCoinBalance 退还用户金币，账户不存在时创建
+func (r *liveRepository) CreditCoinBalance(ctx context.Context, userID, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	return r.conn(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"balance": gorm.Expr("balance + ?", amount)}),
+	}).Create(&model.LiveCoinAccount{UserID: userID, Balance: amount}).Error
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"live_service/internal/model"
+)
+
+// RefundLiveGift 在同一事务中将成功的礼物记录标记为已退款，并从直播间和观看者的礼物统计中扣除
+// 礼物记录保留用于对账，已退款状态不计入直播间礼物列表和统计；直播已结算时一并扣除累加到直播间的礼物数
+// 记录不是成功状态(已退款或送礼失败)时不做修改，返回记录当前状态和false，重复退款不会重复扣除统计
+func (r *liveRepository) RefundLiveGift(ctx context.Context, giftID uint64, reason string, refundedAt time.Time) (*model.LiveGift, bool, error) {
+	var gift model.LiveGift
+	refunded := false
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&gift, giftID).Error; err != nil {
+			return err
+		}
+		if gift.Status != model.GiftStatusSuccess {
+			return nil
+		}
+
+		// 锁定直播流，避免与下播结算并发导致直播间的礼物数漏扣或重复扣除
+		var stream model.LiveStream
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "room_id", "status").
+			First(&stream, gift.StreamID).Error; err != nil {
+			return fmt.Errorf("failed to get live stream: %w", err)
+		}
+
+		result := tx.Model(&model.LiveGift{}).
+			Where("id = ? AND status = ?", giftID, model.GiftStatusSuccess).
+			Updates(map[string]interface{}{
+				"status":        model.GiftStatusRefunded,
+				"refund_reason": reason,
+				"refunded_at":   refundedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update live gift: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		// 统计字段为无符号整数，扣除时不低于0
+		if err := tx.Model(&model.LiveStream{}).Where("id = ?", gift.StreamID).
+			Update("gift_count", gorm.Expr("GREATEST(gift_count, ?) - ?", gift.GiftCount, gift.GiftCount)).Error; err != nil {
+			return fmt.Errorf("failed to update live stream gift count: %w", err)
+		}
+		if err := tx.Model(&model.LiveViewer{}).
+			Where("stream_id = ? AND user_id = ?", gift.StreamID, gift.UserID).
+			Update("gift_value", gorm.Expr("GREATEST(gift_value, ?) - ?", gift.TotalValue, gift.TotalValue)).Error; err != nil {
+			return fmt.Errorf("failed to update live viewer gift value: %w", err)
+		}
+		if stream.Status == model.LiveStatusEnded || stream.Status == model.LiveStatusBanned {
+			if err := tx.Model(&model.LiveRoom{}).Where("id = ?", stream.RoomID).
+				Update("total_gifts", gorm.Expr("GREATEST(total_gifts, ?) - ?", gift.GiftCount, gift.GiftCount)).Error; err != nil {
+				return fmt.Errorf("failed to update live room gift count: %w", err)
+			}
+		}
+
+		gift.Status = model.GiftStatusRefunded
+		gift.RefundReason = reason
+		gift.RefundedAt = &refundedAt
+		refunded = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &gift, refunded, nil
+}
+
+// DecrDailyStreamerGiftValue 扣除主播当日收礼价值，当日分桶已过期或主播不在榜上时不做修改
+func (r *liveRepository) DecrDailyStreamerGiftValue(ctx context.Context, day string, anchorID uint64, value uint64) error {
+	err := r.redis.ZAddArgsIncr(ctx, model.GetLiveDailyStreamerGiftKey(day), redis.ZAddArgs{
+		XX:      true,
+		Members: []redis.Z{{Score: -float64(value), Member: strconv.FormatUint(anchorID, 10)}},
+	}).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/internal/model"
+)
+
+// zsetRedis 按Redis的ZREVRANGE语义返回预置的有序集合，并记录查询参数
+type zsetRedis struct {
+	redis.UniversalClient
+	members     []redis.Z // 已按分数倒序排列
+	key         string
+	start, stop int64
+}
+
+func (c *zsetRedis) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) *redis.ZSliceCmd {
+	c.key, c.start, c.stop = key, start, stop
+	end := stop + 1
+	if end > int64(len(c.members)) {
+		end = int64(len(c.members))
+	}
+	cmd := redis.NewZSliceCmd(ctx)
+	cmd.SetVal(c.members[start:end])
+	return cmd
+}
+
+func TestGetDailyLeaderboardKeepsScoreOrderAndRanks(t *testing.T) {
+	client := &zsetRedis{members: []redis.Z{
+		{Score: 900, Member: "12"},
+		{Score: 500, Member: "7"},
+		{Score: 500, Member: "3"},
+		{Score: 100, Member: "not-an-id"},
+		{Score: 50, Member: "20"},
+	}}
+	repo := &liveRepository{redis: client}
+	key := model.GetLiveDailyStreamerGiftKey("20240501")
+
+	entries, err := repo.GetDailyLeaderboard(context.Background(), key, 10)
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboard: %v", err)
+	}
+	if client.key != key || client.start != 0 || client.stop != 9 {
+		t.Errorf("ZREVRANGE %s %d %d, want %s 0 9", client.key, client.start, client.stop, key)
+	}
+
+	// 无法解析的成员被跳过，名次保持连续
+	want := []LeaderboardEntry{{1, 12, 900}, {2, 7, 500}, {3, 3, 500}, {4, 20, 50}}
+	if len(entries) != len(want) {
+		t.Fatalf("entries = %d, want %d", len(entries), len(want))
+	}
+	for i, entry := range entries {
+		if *entry != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, *entry, want[i])
+		}
+	}
+}
+
+func TestGetDailyLeaderboardLimit(t *testing.T) {
+	client := &zsetRedis{members: []redis.Z{{Score: 3, Member: "1"}, {Score: 2, Member: "2"}, {Score: 1, Member: "3"}}}
+	entries, err := (&liveRepository{redis: client}).GetDailyLeaderboard(context.Background(), "k", 2)
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboard: %v", err)
+	}
+	if client.stop != 1 || len(entries) != 2 || entries[0].ID != 1 || entries[1].ID != 2 {
+		t.Fatalf("stop=%d entries=%v, want top 2", client.stop, entries)
+	}
+}
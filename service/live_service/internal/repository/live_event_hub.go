@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/internal/model"
+	"live_service/pkg/logger"
+)
+
+// DefaultLiveEventBufferSize 每个订阅者默认的事件缓冲数
+const DefaultLiveEventBufferSize = 256
+
+// ErrLiveEventHubClosed 事件中心已关闭
+var ErrLiveEventHubClosed = errors.New("live event hub is closed")
+
+// LiveEventSubscription 直播间事件订阅，事件从Events读取，用完需调用Close
+// 订阅者消费过慢导致缓冲区满时丢弃新事件，不阻塞其他订阅者
+type LiveEventSubscription struct {
+	streamID uint64
+	events   chan *model.LiveEvent
+	hub      *LiveEventHub
+	once     sync.Once
+}
+
+// Events 事件通道，订阅关闭或事件中心停止后被关闭
+func (sub *LiveEventSubscription) Events() <-chan *model.LiveEvent {
+	return sub.events
+}
+
+// Close 取消订阅
+func (sub *LiveEventSubscription) Close() {
+	sub.once.Do(func() {
+		sub.hub.unsubscribe(sub)
+	})
+}
+
+// liveEventRoom 单个直播间的本地订阅者，所有订阅者共享一个Redis频道订阅
+type liveEventRoom struct {
+	pubsub      *redis.PubSub
+	subscribers map[*LiveEventSubscription]struct{}
+}
+
+// LiveEventHub 直播间实时事件中心
+// 事件经Redis发布订阅在实例间广播，每个实例对同一直播间只订阅一次频道，
+// 再分发给本实例的所有订阅者；直播间最后一个订阅者退出后取消频道订阅
+type LiveEventHub struct {
+	redis      redis.UniversalClient
+	logger     logger.Logger
+	bufferSize int
+
+	mu     sync.Mutex
+	rooms  map[uint64]*liveEventRoom
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewLiveEventHub 创建直播间事件中心，bufferSize<=0时使用默认缓冲数
+func NewLiveEventHub(redisClient redis.UniversalClient, bufferSize int, log logger.Logger) *LiveEventHub {
+	if bufferSize <= 0 {
+		bufferSize = DefaultLiveEventBufferSize
+	}
+	return &LiveEventHub{
+		redis:      redisClient,
+		logger:     log,
+		bufferSize: bufferSize,
+		rooms:      make(map[uint64]*liveEventRoom),
+	}
+}
+
+// Publish 发布直播间事件
+func (h *LiveEventHub) Publish(ctx context.Context, event *model.LiveEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal live event: %w", err)
+	}
+	return h.redis.Publish(ctx, model.GetLiveEventChannelKey(event.StreamID), data).Err()
+}
+
+// Subscribe 订阅直播间的全部事件类型
+func (h *LiveEventHub) Subscribe(ctx context.Context, streamID uint64) (*LiveEventSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrLiveEventHubClosed
+	}
+
+	room, ok := h.rooms[streamID]
+	if !ok {
+		pubsub := h.redis.Subscribe(ctx, model.GetLiveEventChannelKey(streamID))
+		// 等待订阅确认，避免订阅生效前发布的事件丢失
+		if _, err := pubsub.Receive(ctx); err != nil {
+			pubsub.Close()
+			return nil, fmt.Errorf("failed to subscribe live events: %w", err)
+		}
+		room = &liveEventRoom{
+			pubsub:      pubsub,
+			subscribers: make(map[*LiveEventSubscription]struct{}),
+		}
+		h.rooms[streamID] = room
+
+		h.wg.Add(1)
+		go h.dispatch(streamID, room)
+	}
+
+	sub := &LiveEventSubscription{
+		streamID: streamID,
+		events:   make(chan *model.LiveEvent, h.bufferSize),
+		hub:      h,
+	}
+	room.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// unsubscribe 移除订阅者，直播间没有订阅者时取消频道订阅
+func (h *LiveEventHub) unsubscribe(sub *LiveEventSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[sub.streamID]
+	if !ok {
+		return
+	}
+	if _, ok := room.subscribers[sub]; !ok {
+		return
+	}
+	delete(room.subscribers, sub)
+	close(sub.events)
+
+	if len(room.subscribers) == 0 {
+		delete(h.rooms, sub.streamID)
+		room.pubsub.Close()
+	}
+}
+
+// dispatch 将频道消息分发给直播间的本地订阅者，频道订阅关闭后退出
+func (h *LiveEventHub) dispatch(streamID uint64, room *liveEventRoom) {
+	defer h.wg.Done()
+
+	for msg := range room.pubsub.Channel() {
+		var event model.LiveEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			h.logger.Warn("Invalid live event payload", "streamID", streamID, "error", err)
+			continue
+		}
+
+		h.mu.Lock()
+		for sub := range room.subscribers {
+			select {
+			case sub.events <- &event:
+			default:
+				h.logger.Warn("Live event subscriber is too slow, dropping event", "streamID", streamID, "type", event.Type)
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// Close 关闭所有订阅并等待分发协程退出
+func (h *LiveEventHub) Close(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	for streamID, room := range h.rooms {
+		for sub := range room.subscribers {
+			close(sub.events)
+		}
+		room.pubsub.Close()
+		delete(h.rooms, streamID)
+	}
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"live_service/internal/model"
+	"live_service/pkg/paginate"
+)
+
+// recordedQuery 执行的SQL和参数
+type recordedQuery struct {
+	sql  string
+	vars []interface{}
+}
+
+// newDryRunRepository 只生成SQL不连接数据库的仓库，记录执行的查询，统计查询返回total
+func newDryRunRepository(t *testing.T, total int64) (*liveRepository, *[]recordedQuery) {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/live", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+
+	var queries []recordedQuery
+	err = db.Callback().Query().After("gorm:query").Register("test:record", func(tx *gorm.DB) {
+		// 构建子查询时也会执行查询回调，只记录直播流查询
+		if tx.Statement.Table != "live_streams" {
+			return
+		}
+		queries = append(queries, recordedQuery{sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars})
+		// 模拟统计结果，使分页继续查询当前页
+		if count, ok := tx.Statement.Dest.(*int64); ok {
+			*count = total
+			tx.RowsAffected = 1
+		}
+	})
+	if err != nil {
+		t.Fatalf("register query callback: %v", err)
+	}
+	return &liveRepository{db: db}, &queries
+}
+
+// hasVar 参数中是否包含v
+func hasVar(vars []interface{}, v interface{}) bool {
+	for _, got := range vars {
+		if got == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGetLiveStreamListFiltersByCategory(t *testing.T) {
+	repo, queries := newDryRunRepository(t, 30)
+
+	if _, _, err := repo.GetLiveStreamList(context.Background(), model.LiveStatusStreaming, 7, 0, 2, 10); err != nil {
+		t.Fatalf("GetLiveStreamList: %v", err)
+	}
+	if len(*queries) != 2 {
+		t.Fatalf("queries = %d, want count and page", len(*queries))
+	}
+	// 统计和分页使用相同的筛选条件
+	for _, q := range *queries {
+		for _, want := range []string{"status = ?", "category_id = ?", "is_public = ?"} {
+			if !strings.Contains(q.sql, want) {
+				t.Errorf("query missing %q: %s", want, q.sql)
+			}
+		}
+		if !hasVar(q.vars, uint32(7)) {
+			t.Errorf("vars = %v, want category 7", q.vars)
+		}
+	}
+	page := (*queries)[1]
+	if !strings.Contains(page.sql, "ORDER BY weight DESC, started_at DESC, id DESC LIMIT ? OFFSET ?") {
+		t.Errorf("page query = %s, want ordered by weight and start time", page.sql)
+	}
+	if n := len(page.vars); n < 2 || page.vars[n-2] != 10 || page.vars[n-1] != 10 {
+		t.Errorf("page vars = %v, want limit 10 offset 10", page.vars)
+	}
+}
+
+func TestGetLiveStreamListWithoutCategory(t *testing.T) {
+	repo, queries := newDryRunRepository(t, 1)
+
+	if _, _, err := repo.GetLiveStreamList(context.Background(), model.LiveStatusStreaming, 0, 0, 1, 10); err != nil {
+		t.Fatalf("GetLiveStreamList: %v", err)
+	}
+	for _, q := range *queries {
+		if strings.Contains(q.sql, "category_id") {
+			t.Errorf("category 0 filtered by category: %s", q.sql)
+		}
+	}
+}
+
+func TestGetLiveStreamListIncludesFollowedPrivateStreams(t *testing.T) {
+	repo, queries := newDryRunRepository(t, 1)
+
+	if _, _, err := repo.GetLiveStreamList(context.Background(), model.LiveStatusStreaming, 7, 9, 1, 10); err != nil {
+		t.Fatalf("GetLiveStreamList: %v", err)
+	}
+	for _, q := range *queries {
+		if !strings.Contains(q.sql, "category_id = ?") || !strings.Contains(q.sql, "user_follows") || !hasVar(q.vars, uint64(9)) {
+			t.Errorf("query = %s vars %v, want the category filter with the viewer's followed streams", q.sql, q.vars)
+		}
+	}
+}
+
+func TestGetLiveStreamListSkipsPageBeyondTotal(t *testing.T) {
+	repo, queries := newDryRunRepository(t, 5)
+
+	streams, total, err := repo.GetLiveStreamList(context.Background(), model.LiveStatusStreaming, 7, 0, 3, 10)
+	if err != nil {
+		t.Fatalf("GetLiveStreamList: %v", err)
+	}
+	if total != 5 || len(streams) != 0 || len(*queries) != 1 {
+		t.Errorf("got %d streams of %d with %d queries, want an empty page without the page query", len(streams), total, len(*queries))
+	}
+}
+
+func TestLiveStreamStatusCategoryIndex(t *testing.T) {
+	s, err := schema.Parse(&model.LiveStream{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("parse schema: %v", err)
+	}
+	index := s.LookIndex("idx_live_status_category")
+	if index == nil {
+		t.Fatal("idx_live_status_category not defined")
+	}
+	// 等值条件在前，排序字段在后
+	want := []string{"status", "category_id", "weight", "started_at"}
+	if len(index.Fields) != len(want) {
+		t.Fatalf("index fields = %d, want %v", len(index.Fields), want)
+	}
+	for i, field := range index.Fields {
+		if field.DBName != want[i] {
+			t.Errorf("index field %d = %s, want %s", i, field.DBName, want[i])
+		}
+	}
+}
+
+func TestGetLiveStreamListPageBounds(t *testing.T) {
+	tests := []struct {
+		name                  string
+		page, pageSize        int
+		wantLimit, wantOffset int
+	}{
+		{"first page", 1, 10, 10, 0},
+		{"page below one", 0, 10, 10, 0},
+		{"default size", 2, 0, paginate.DefaultPageSize, paginate.DefaultPageSize},
+		// 每页条数超过上限时按上限查询
+		{"size above max", 2, 500, paginate.MaxPageSize, paginate.MaxPageSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, queries := newDryRunRepository(t, 1000)
+
+			if _, _, err := repo.GetLiveStreamList(context.Background(), model.LiveStatusStreaming, 0, 0, tt.page, tt.pageSize); err != nil {
+				t.Fatalf("GetLiveStreamList: %v", err)
+			}
+			if len(*queries) != 2 {
+				t.Fatalf("queries = %d, want count and page", len(*queries))
+			}
+			page := (*queries)[1]
+			// 偏移量为0时不生成OFFSET子句
+			if tt.wantOffset == 0 {
+				if n := len(page.vars); n < 1 || page.vars[n-1] != tt.wantLimit || strings.Contains(page.sql, "OFFSET") {
+					t.Errorf("page query = %s vars %v, want limit %d without offset", page.sql, page.vars, tt.wantLimit)
+				}
+				return
+			}
+			if n := len(page.vars); n < 2 || page.vars[n-2] != tt.wantLimit || page.vars[n-1] != tt.wantOffset {
+				t.Errorf("page vars = %v, want limit %d offset %d", page.vars, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
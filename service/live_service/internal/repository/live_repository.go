@@ -2,12 +2,19 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 
+	"common/ctxkeys"
+
 	"live_service/internal/model"
+	"live_service/pkg/database"
 	"live_service/pkg/logger"
+	"live_service/pkg/paginate"
 )
 
 // LiveRepository 直播数据仓库接口
@@ -16,12 +23,26 @@ type LiveRepository interface {
 	CreateLiveStream(ctx context.Context, stream *model.LiveStream) error
 	GetLiveStream(ctx context.Context, streamID uint64) (*model.LiveStream, error)
 	GetLiveStreamByUserID(ctx context.Context, userID uint64) (*model.LiveStream, error)
+	GetLiveStreamByStreamKey(ctx context.Context, streamKey string) (*model.LiveStream, error)
 	UpdateLiveStream(ctx context.Context, stream *model.LiveStream) error
 	UpdateLiveStreamStatus(ctx context.Context, streamID uint64, status model.LiveStatus) error
+	UpdatePlaybackStatus(ctx context.Context, streamID uint64, from, to, playbackURL string) (bool, error)
+	PauseLiveStream(ctx context.Context, streamID uint64, pausedAt time.Time) error
+	ResumeLiveStream(ctx context.Context, streamID uint64, resumedAt time.Time) error
+	UpdateStreamKey(ctx context.Context, streamID uint64, streamKey string) error
+	PublishLiveStream(ctx context.Context, streamID uint64, streamKey string, publishedAt time.Time) (bool, error)
 	DeleteLiveStream(ctx context.Context, streamID uint64) error
-	GetLiveStreamList(ctx context.Context, status model.LiveStatus, page, pageSize int) ([]*model.LiveStream, int64, error)
+	GetLiveStreamList(ctx context.Context, status model.LiveStatus, categoryID uint32, viewerID uint64, page, pageSize int) ([]*model.LiveStream, int64, error)
 	GetHotLiveStreamList(ctx context.Context, page, pageSize int) ([]*model.LiveStream, int64, error)
-	SearchLiveStream(ctx context.Context, keyword string, page, pageSize int) ([]*model.LiveStream, int64, error)
+	SearchLiveStream(ctx context.Context, filter LiveStreamSearchFilter, viewerID uint64, page, pageSize int) ([]*model.LiveStream, int64, error)
+	IsFollowing(ctx context.Context, followerID, followingID uint64) (bool, error)
+
+	// 直播间
+	CreateLiveRoomIfAbsent(ctx context.Context, room *model.LiveRoom) (bool, error)
+	GetLiveRoom(ctx context.Context, roomID uint64) (*model.LiveRoom, error)
+	GetLiveRoomByUserID(ctx context.Context, userID uint64) (*model.LiveRoom, error)
+	StartLiveRoomStream(ctx context.Context, roomID uint64) error
+	AccumulateLiveRoomStats(ctx context.Context, stream *model.LiveStream) error
 
 	// 直播间管理
 	CreateLiveViewer(ctx context.Context, viewer *model.LiveViewer) error
@@ -36,30 +57,75 @@ type LiveRepository interface {
 	GetLiveChat(ctx context.Context, chatID uint64) (*model.LiveChat, error)
 	UpdateLiveChat(ctx context.Context, chat *model.LiveChat) error
 	DeleteLiveChat(ctx context.Context, chatID uint64) error
-	GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveChat, int64, error)
+	GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error)
 	GetLiveChatHistory(ctx context.Context, streamID uint64, startTime, endTime int64, page, pageSize int) ([]*model.LiveChat, int64, error)
 
+	// 聊天记录保留
+	ListChatRetentionStreams(ctx context.Context, before time.Time, limit int) ([]uint64, error)
+	ArchiveLiveChats(ctx context.Context, streamID uint64, before time.Time, batchSize int, archive bool) (int64, error)
+
 	// 礼物系统
 	CreateLiveGift(ctx context.Context, gift *model.LiveGift) error
 	GetLiveGift(ctx context.Context, giftID uint64) (*model.LiveGift, error)
 	UpdateLiveGift(ctx context.Context, gift *model.LiveGift) error
-	GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveGift, int64, error)
+	GetLiveGiftList(ctx context.Context, streamID uint64, after *paginate.Cursor, offset, limit int) ([]*model.LiveGift, int64, error)
 	GetUserLiveGiftList(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error)
 	GetLiveGiftStats(ctx context.Context, streamID uint64) (*GiftStats, error)
+	RecordLiveGift(ctx context.Context, gift *model.LiveGift) error
+	UpdateLiveGiftCombo(ctx context.Context, gift *model.LiveGift) error
+	RefundLiveGift(ctx context.Context, giftID uint64, reason string, refundedAt time.Time) (*model.LiveGift, bool, error)
+
+	// 金币账户
+	CreditCoinBalance(ctx context.Context, userID, amount uint64) error
 
 	// 缓存操作
 	SetLiveStreamCache(ctx context.Context, stream *model.LiveStream) error
 	GetLiveStreamCache(ctx context.Context, streamID uint64) (*model.LiveStream, error)
 	DeleteLiveStreamCache(ctx context.Context, streamID uint64) error
+	SetLiveGiftStatsCache(ctx context.Context, stats *GiftStats) error
+	GetLiveGiftStatsCache(ctx context.Context, streamID uint64) (*GiftStats, error)
+	DeleteLiveGiftStatsCache(ctx context.Context, streamID uint64) error
 	SetLiveViewerCountCache(ctx context.Context, streamID uint64, count int64) error
-	GetLiveViewerCountCache(ctx context.Context, streamID uint64) (int64, error)
+	GetLiveViewerCountCache(ctx context.Context, streamID uint64) (int64, bool, error)
+	SetUserActiveStreamCache(ctx context.Context, userID, streamID uint64) error
+	GetUserActiveStreamCache(ctx context.Context, userID uint64) (uint64, bool, error)
+	DeleteUserActiveStreamCache(ctx context.Context, userID uint64) error
 	IncrementLiveViewerCount(ctx context.Context, streamID uint64) error
 	DecrementLiveViewerCount(ctx context.Context, streamID uint64) error
+	IncrementGiftCombo(ctx context.Context, streamID, userID uint64, giftID uint32, window time.Duration) (int64, error)
+	AcquireGiftRequest(ctx context.Context, userID uint64, requestID string) (bool, error)
+	GetGiftRequestResult(ctx context.Context, userID uint64, requestID string) (*model.LiveGift, bool, error)
+	SetGiftRequestResult(ctx context.Context, userID uint64, requestID string, gift *model.LiveGift) error
+	ReleaseGiftRequest(ctx context.Context, userID uint64, requestID string) error
+
+	// 观看者在线状态
+	TouchViewerPresence(ctx context.Context, streamID, userID uint64, ttl time.Duration) error
+	TouchViewersPresence(ctx context.Context, streamID uint64, userIDs []uint64, ttl time.Duration) error
+	RefreshViewerPresence(ctx context.Context, streamID, userID uint64, ttl time.Duration) (bool, error)
+	RemoveViewerPresence(ctx context.Context, streamID, userID uint64) (bool, error)
+	ListPresenceStreams(ctx context.Context) ([]uint64, error)
+	PopExpiredViewers(ctx context.Context, streamID uint64, now time.Time, limit int) ([]uint64, error)
+	MarkLiveViewerExit(ctx context.Context, streamID uint64, userIDs []uint64, exitTime time.Time) (int64, error)
+	ReenterLiveViewer(ctx context.Context, streamID, userID uint64, enterTime time.Time) (bool, error)
+	BatchJoinLiveViewers(ctx context.Context, streamID, roomID uint64, userIDs []uint64, enterTime time.Time) (int64, error)
+	AccrueLiveViewerDuration(ctx context.Context, streamID, userID uint64, now time.Time, minInterval time.Duration) (bool, error)
+
+	// 聊天禁言
+	SetChatMute(ctx context.Context, mute *model.LiveChatMuteCache, ttl time.Duration) error
+	GetChatMute(ctx context.Context, streamID, userID uint64) (*model.LiveChatMuteCache, error)
+	DeleteChatMute(ctx context.Context, streamID, userID uint64) error
 
 	// 统计和排行榜
 	GetLiveStats(ctx context.Context, streamID uint64) (*LiveStats, error)
 	UpdateLiveStats(ctx context.Context, streamID uint64, stats *LiveStats) error
+	RecomputeLiveStats(ctx context.Context, stream *model.LiveStream) (*LiveStats, error)
 	GetGiftRanking(ctx context.Context, streamID uint64, rankingType string, limit int) ([]*GiftRankingItem, error)
+	IncrDailyStreamerGiftValue(ctx context.Context, day string, anchorID uint64, value uint64) error
+	DecrDailyStreamerGiftValue(ctx context.Context, day string, anchorID uint64, value uint64) error
+	UpdateDailyPeakViewers(ctx context.Context, day string, streamID uint64, viewers int64) error
+	GetDailyLeaderboard(ctx context.Context, key string, limit int) ([]*LeaderboardEntry, error)
+	SetDailyLeaderboardsCache(ctx context.Context, boards *DailyLeaderboards) error
+	GetDailyLeaderboardsCache(ctx context.Context, day string) (*DailyLeaderboards, error)
 
 	// 配置管理
 	GetGiftConfig(ctx context.Context, giftID uint32) (*GiftConfig, error)
@@ -76,6 +142,7 @@ type LiveRepository interface {
 
 	// 事务支持
 	WithTx(tx *gorm.DB) LiveRepository
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 // GiftStats 礼物统计
@@ -155,15 +222,30 @@ type GiftRankingItem struct {
 	LastGiftTime int64  `json:"last_gift_time"`
 }
 
+// LeaderboardEntry 排行榜条目，ID按榜单类型为主播ID或直播流ID
+type LeaderboardEntry struct {
+	Rank  int    `json:"rank"`
+	ID    uint64 `json:"id"`
+	Score int64  `json:"score"`
+}
+
+// DailyLeaderboards 每日排行榜
+type DailyLeaderboards struct {
+	Date         string              `json:"date"`
+	TopStreamers []*LeaderboardEntry `json:"top_streamers"` // 主播收礼价值排行
+	TopStreams   []*LeaderboardEntry `json:"top_streams"`   // 直播峰值在线排行
+}
+
 // liveRepository 直播数据仓库实现
 type liveRepository struct {
 	db     *gorm.DB
-	redis  *redis.Client
+	redis  redis.UniversalClient
 	logger logger.Logger
+	tx     bool // db为WithTx传入的事务
 }
 
 // NewLiveRepository 创建直播数据仓库
-func NewLiveRepository(db *gorm.DB, redis *redis.Client, log logger.Logger) LiveRepository {
+func NewLiveRepository(db *gorm.DB, redis redis.UniversalClient, log logger.Logger) LiveRepository {
 	return &liveRepository{
 		db:     db,
 		redis:  redis,
@@ -177,20 +259,38 @@ func (r *liveRepository) WithTx(tx *gorm.DB) LiveRepository {
 		db:     tx,
 		redis:  r.redis,
 		logger: r.logger,
+		tx:     true,
+	}
+}
+
+// Transaction 在事务中执行fn，事务绑定到传给fn的context，fn内通过该context的读写都使用此事务
+// fn返回错误或panic时回滚，否则提交；已处于事务中时作为嵌套事务(savepoint)执行
+func (r *liveRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctxkeys.WithTx(ctx, tx))
+	})
+}
+
+// conn 返回本次请求使用的数据库连接
+// context绑定了事务(见Transaction)时使用该事务，使多次写入一起提交或回滚；通过WithTx显式指定的事务优先
+func (r *liveRepository) conn(ctx context.Context) *gorm.DB {
+	if r.tx {
+		return r.db.WithContext(ctx)
 	}
+	return database.Conn(ctx, r.db)
 }
 
 // CreateLiveStream 创建直播流
 func (r *liveRepository) CreateLiveStream(ctx context.Context, stream *model.LiveStream) error {
 	// TODO: 实现创建直播流逻辑
-	return r.db.WithContext(ctx).Create(stream).Error
+	return r.conn(ctx).Create(stream).Error
 }
 
 // GetLiveStream 获取直播流
 func (r *liveRepository) GetLiveStream(ctx context.Context, streamID uint64) (*model.LiveStream, error) {
 	// TODO: 实现获取直播流逻辑
 	var stream model.LiveStream
-	err := r.db.WithContext(ctx).Where("id = ?", streamID).First(&stream).Error
+	err := r.conn(ctx).Where("id = ?", streamID).First(&stream).Error
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +301,7 @@ func (r *liveRepository) GetLiveStream(ctx context.Context, streamID uint64) (*m
 func (r *liveRepository) GetLiveStreamByUserID(ctx context.Context, userID uint64) (*model.LiveStream, error) {
 	// TODO: 实现根据用户ID获取直播流逻辑
 	var stream model.LiveStream
-	err := r.db.WithContext(ctx).Where("user_id = ? AND status IN (?)", userID, []model.LiveStatus{
+	err := r.conn(ctx).Where("user_id = ? AND status IN (?)", userID, []model.LiveStatus{
 		model.LiveStatusPreparing,
 		model.LiveStatusStreaming,
 		model.LiveStatusPaused,
@@ -212,46 +312,121 @@ func (r *liveRepository) GetLiveStreamByUserID(ctx context.Context, userID uint6
 	return &stream, nil
 }
 
+// GetLiveStreamByStreamKey 根据推流密钥获取直播流
+func (r *liveRepository) GetLiveStreamByStreamKey(ctx context.Context, streamKey string) (*model.LiveStream, error) {
+	var stream model.LiveStream
+	err := r.conn(ctx).Where("stream_key = ?", streamKey).First(&stream).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
 // UpdateLiveStream 更新直播流
 func (r *liveRepository) UpdateLiveStream(ctx context.Context, stream *model.LiveStream) error {
 	// TODO: 实现更新直播流逻辑
-	return r.db.WithContext(ctx).Save(stream).Error
+	return r.conn(ctx).Save(stream).Error
 }
 
-// UpdateLiveStreamStatus 更新直播流状态
-func (r *liveRepository) UpdateLiveStreamStatus(ctx context.Context, streamID uint64, status model.LiveStatus) error {
-	// TODO: 实现更新直播流状态逻辑
-	return r.db.WithContext(ctx).Model(&model.LiveStream{}).Where("id = ?", streamID).Update("status", status).Error
+// UpdateStreamKey 更换直播流的推流密钥，原密钥随即失效
+func (r *liveRepository) UpdateStreamKey(ctx context.Context, streamID uint64, streamKey string) error {
+	result := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("id = ?", streamID).
+		Update("stream_key", streamKey)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
-// DeleteLiveStream 删除直播流
-func (r *liveRepository) DeleteLiveStream(ctx context.Context, streamID uint64) error {
-	// TODO: 实现删除直播流逻辑
-	return r.db.WithContext(ctx).Delete(&model.LiveStream{}, streamID).Error
+// PublishLiveStream 推流鉴权通过后将直播标记为直播中，首次推流时记录开播时间，返回是否已处于直播中
+// 推流密钥和状态条件写在同一条UPDATE的WHERE中：并发的强制停播更换了密钥或结束了直播时不会更新，
+// 旧密钥的推流不能把已结束的直播改回直播中
+func (r *liveRepository) PublishLiveStream(ctx context.Context, streamID uint64, streamKey string, publishedAt time.Time) (bool, error) {
+	statuses := append(model.LiveStatusesBefore(model.LiveStatusStreaming), model.LiveStatusStreaming)
+	result := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("id = ? AND stream_key = ? AND status IN (?)", streamID, streamKey, statuses).
+		Updates(map[string]interface{}{
+			"status":         model.LiveStatusStreaming,
+			"started_at":     gorm.Expr("COALESCE(started_at, ?)", publishedAt),
+			"last_active_at": publishedAt,
+			"paused_at":      nil,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// 值未变化时MySQL也返回0行，重新读取确认密钥仍有效且处于直播中
+	var stream model.LiveStream
+	err := r.conn(ctx).Select("id", "status").Where("id = ? AND stream_key = ?", streamID, streamKey).First(&stream).Error
+	if err == gorm.ErrRecordNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return stream.Status == model.LiveStatusStreaming, nil
 }
 
-// GetLiveStreamList 获取直播流列表
-func (r *liveRepository) GetLiveStreamList(ctx context.Context, status model.LiveStatus, page, pageSize int) ([]*model.LiveStream, int64, error) {
-	// TODO: 实现获取直播流列表逻辑
-	var streams []*model.LiveStream
-	var total int64
+// UpdateLiveStreamStatus 更新直播流状态
+// 仅当当前状态允许变更到目标状态时才更新，条件写在WHERE中保证并发下不会越过状态机；
+// 目标状态与当前状态相同时视为成功，非法变更返回model.ErrInvalidLiveStatusTransition
+func (r *liveRepository) UpdateLiveStreamStatus(ctx context.Context, streamID uint64, status model.LiveStatus) error {
+	result := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("id = ? AND status IN (?)", streamID, model.LiveStatusesBefore(status)).
+		Update("status", status)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
 
-	db := r.db.WithContext(ctx).Model(&model.LiveStream{})
-	if status != 0 {
-		db = db.Where("status = ?", status)
+	// 未更新时区分直播不存在、状态未变和非法变更
+	var stream model.LiveStream
+	if err := r.conn(ctx).Select("id", "status").Where("id = ?", streamID).First(&stream).Error; err != nil {
+		return err
 	}
+	return model.ValidateLiveStatusTransition(model.LiveStatus(stream.Status), status)
+}
 
-	err := db.Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+// UpdatePlaybackStatus 回放状态为from时更新为to，playbackURL不为空时同时更新回放地址，返回是否实际更新
+func (r *liveRepository) UpdatePlaybackStatus(ctx context.Context, streamID uint64, from, to, playbackURL string) (bool, error) {
+	updates := map[string]interface{}{"playback_status": to}
+	if playbackURL != "" {
+		updates["playback_url"] = playbackURL
 	}
+	result := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("id = ? AND playback_status = ?", streamID, from).
+		Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
 
-	err = db.Offset((page - 1) * pageSize).Limit(pageSize).Find(&streams).Error
-	if err != nil {
-		return nil, 0, err
+// DeleteLiveStream 删除直播流
+func (r *liveRepository) DeleteLiveStream(ctx context.Context, streamID uint64) error {
+	// TODO: 实现删除直播流逻辑
+	return r.conn(ctx).Delete(&model.LiveStream{}, streamID).Error
+}
+
+// GetLiveStreamList 获取直播流列表，页码超过总页数时返回空页
+// 私密直播只对主播本人和关注者可见，viewerID为0表示未登录
+// 按状态和分类筛选并按权重、开播时间排序，由联合索引idx_live_status_category(status, category_id, weight, started_at)覆盖，
+// categoryID为0时不限分类，只能用到索引的status前缀
+func (r *liveRepository) GetLiveStreamList(ctx context.Context, status model.LiveStatus, categoryID uint32, viewerID uint64, page, pageSize int) ([]*model.LiveStream, int64, error) {
+	db := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("status = ?", status).
+		Scopes(visibleLiveStreams(viewerID))
+	if categoryID != 0 {
+		db = db.Where("category_id = ?", categoryID)
 	}
 
-	return streams, total, nil
+	return findPage[model.LiveStream](db, paginate.NewPage(page, pageSize), "weight DESC, started_at DESC, id DESC")
 }
 
 // GetHotLiveStreamList 获取热门直播流列表
@@ -260,7 +435,7 @@ func (r *liveRepository) GetHotLiveStreamList(ctx context.Context, page, pageSiz
 	var streams []*model.LiveStream
 	var total int64
 
-	err := r.db.WithContext(ctx).Model(&model.LiveStream{}).
+	err := r.conn(ctx).Model(&model.LiveStream{}).
 		Where("status = ?", model.LiveStatusStreaming).
 		Order("viewer_count DESC, like_count DESC, gift_value DESC").
 		Count(&total).Error
@@ -268,7 +443,7 @@ func (r *liveRepository) GetHotLiveStreamList(ctx context.Context, page, pageSiz
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).Model(&model.LiveStream{}).
+	err = r.conn(ctx).Model(&model.LiveStream{}).
 		Where("status = ?", model.LiveStatusStreaming).
 		Order("viewer_count DESC, like_count DESC, gift_value DESC").
 		Offset((page - 1) * pageSize).Limit(pageSize).Find(&streams).Error
@@ -279,43 +454,82 @@ func (r *liveRepository) GetHotLiveStreamList(ctx context.Context, page, pageSiz
 	return streams, total, nil
 }
 
-// SearchLiveStream 搜索直播流
-func (r *liveRepository) SearchLiveStream(ctx context.Context, keyword string, page, pageSize int) ([]*model.LiveStream, int64, error) {
-	// TODO: 实现搜索直播流逻辑
-	var streams []*model.LiveStream
-	var total int64
+// LiveStreamSearchFilter 直播搜索条件，零值字段不参与过滤，各条件同时满足
+type LiveStreamSearchFilter struct {
+	Keyword    string // 按标题和描述模糊匹配
+	CategoryID uint32 // 直播分类ID
+	MinViewers uint32 // 当前在线观看人数下限
+	Language   string // 直播语言，需已规范化
+	Region     string // 直播地区，需已规范化
+}
 
-	err := r.db.WithContext(ctx).Model(&model.LiveStream{}).
-		Where("status = ? AND (title LIKE ? OR description LIKE ?)",
-			model.LiveStatusStreaming, "%"+keyword+"%", "%"+keyword+"%").
-		Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+// SearchLiveStream 搜索直播中的直播流
+// 私密直播只对主播本人和关注者可见，viewerID为0表示未登录
+// 在线人数按未离开的观看记录统计，与观看人数缓存未命中时的回源口径一致
+func (r *liveRepository) SearchLiveStream(ctx context.Context, filter LiveStreamSearchFilter, viewerID uint64, page, pageSize int) ([]*model.LiveStream, int64, error) {
+	db := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("status = ?", model.LiveStatusStreaming).
+		Scopes(visibleLiveStreams(viewerID))
+	if filter.Keyword != "" {
+		db = db.Where("title LIKE ? OR description LIKE ?", "%"+filter.Keyword+"%", "%"+filter.Keyword+"%")
+	}
+	if filter.CategoryID != 0 {
+		db = db.Where("category_id = ?", filter.CategoryID)
+	}
+	if filter.Language != "" {
+		db = db.Where("language = ?", filter.Language)
+	}
+	if filter.Region != "" {
+		db = db.Where("region = ?", filter.Region)
+	}
+	if filter.MinViewers > 0 {
+		online := r.conn(ctx).Model(&model.LiveViewer{}).
+			Select("COUNT(*)").
+			Where("live_viewers.stream_id = live_streams.id AND live_viewers.exit_time IS NULL AND live_viewers.deleted_at IS NULL")
+		db = db.Where("(?) >= ?", online, filter.MinViewers)
 	}
 
-	err = r.db.WithContext(ctx).Model(&model.LiveStream{}).
-		Where("status = ? AND (title LIKE ? OR description LIKE ?)",
-			model.LiveStatusStreaming, "%"+keyword+"%", "%"+keyword+"%").
-		Order("created_at DESC").
-		Offset((page - 1) * pageSize).Limit(pageSize).Find(&streams).Error
-	if err != nil {
-		return nil, 0, err
+	return findPage[model.LiveStream](db, paginate.NewPage(page, pageSize), "created_at DESC, id DESC")
+}
+
+// visibleLiveStreams 过滤观看者不可见的私密直播
+// 关注关系由social_service维护，与直播服务共用同一个库
+func visibleLiveStreams(viewerID uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewerID == 0 {
+			return db.Where("is_public = ?", true)
+		}
+		return db.Where("is_public = ? OR user_id = ? OR user_id IN (?)",
+			true, viewerID, db.Session(&gorm.Session{NewDB: true}).
+				Table("user_follows").
+				Select("following_id").
+				Where("follower_id = ? AND deleted_at IS NULL", viewerID))
 	}
+}
 
-	return streams, total, nil
+// IsFollowing 检查followerID是否关注了followingID
+func (r *liveRepository) IsFollowing(ctx context.Context, followerID, followingID uint64) (bool, error) {
+	var count int64
+	err := r.conn(ctx).Table("user_follows").
+		Where("follower_id = ? AND following_id = ? AND deleted_at IS NULL", followerID, followingID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 // CreateLiveViewer 创建直播观看者
 func (r *liveRepository) CreateLiveViewer(ctx context.Context, viewer *model.LiveViewer) error {
 	// TODO: 实现创建直播观看者逻辑
-	return r.db.WithContext(ctx).Create(viewer).Error
+	return r.conn(ctx).Create(viewer).Error
 }
 
 // GetLiveViewer 获取直播观看者
 func (r *liveRepository) GetLiveViewer(ctx context.Context, streamID, userID uint64) (*model.LiveViewer, error) {
 	// TODO: 实现获取直播观看者逻辑
 	var viewer model.LiveViewer
-	err := r.db.WithContext(ctx).Where("stream_id = ? AND user_id = ?", streamID, userID).First(&viewer).Error
+	err := r.conn(ctx).Where("stream_id = ? AND user_id = ?", streamID, userID).First(&viewer).Error
 	if err != nil {
 		return nil, err
 	}
@@ -325,56 +539,41 @@ func (r *liveRepository) GetLiveViewer(ctx context.Context, streamID, userID uin
 // UpdateLiveViewer 更新直播观看者
 func (r *liveRepository) UpdateLiveViewer(ctx context.Context, viewer *model.LiveViewer) error {
 	// TODO: 实现更新直播观看者逻辑
-	return r.db.WithContext(ctx).Save(viewer).Error
+	return r.conn(ctx).Save(viewer).Error
 }
 
 // DeleteLiveViewer 删除直播观看者
 func (r *liveRepository) DeleteLiveViewer(ctx context.Context, streamID, userID uint64) error {
 	// TODO: 实现删除直播观看者逻辑
-	return r.db.WithContext(ctx).Where("stream_id = ? AND user_id = ?", streamID, userID).Delete(&model.LiveViewer{}).Error
+	return r.conn(ctx).Where("stream_id = ? AND user_id = ?", streamID, userID).Delete(&model.LiveViewer{}).Error
 }
 
-// GetLiveViewerList 获取直播观看者列表
+// GetLiveViewerList 获取直播观看者列表，按进入时间倒序，页码超过总页数时返回空页
 func (r *liveRepository) GetLiveViewerList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveViewer, int64, error) {
-	// TODO: 实现获取直播观看者列表逻辑
-	var viewers []*model.LiveViewer
-	var total int64
-
-	err := r.db.WithContext(ctx).Model(&model.LiveViewer{}).Where("stream_id = ?", streamID).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	err = r.db.WithContext(ctx).Model(&model.LiveViewer{}).
-		Where("stream_id = ?", streamID).
-		Order("created_at DESC").
-		Offset((page - 1) * pageSize).Limit(pageSize).Find(&viewers).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return viewers, total, nil
+	query := r.conn(ctx).Model(&model.LiveViewer{}).Where("stream_id = ?", streamID)
+	return findPage[model.LiveViewer](query, paginate.NewPage(page, pageSize), "created_at DESC, id DESC")
 }
 
-// GetLiveViewerCount 获取直播观看者数量
+// GetLiveViewerCount 获取直播当前在线观看者数量(未离开的观看记录)
 func (r *liveRepository) GetLiveViewerCount(ctx context.Context, streamID uint64) (int64, error) {
-	// TODO: 实现获取直播观看者数量逻辑
 	var count int64
-	err := r.db.WithContext(ctx).Model(&model.LiveViewer{}).Where("stream_id = ?", streamID).Count(&count).Error
+	err := r.conn(ctx).Model(&model.LiveViewer{}).
+		Where("stream_id = ? AND exit_time IS NULL AND deleted_at IS NULL", streamID).
+		Count(&count).Error
 	return count, err
 }
 
 // CreateLiveChat 创建直播聊天
 func (r *liveRepository) CreateLiveChat(ctx context.Context, chat *model.LiveChat) error {
 	// TODO: 实现创建直播聊天逻辑
-	return r.db.WithContext(ctx).Create(chat).Error
+	return r.conn(ctx).Create(chat).Error
 }
 
 // GetLiveChat 获取直播聊天
 func (r *liveRepository) GetLiveChat(ctx context.Context, chatID uint64) (*model.LiveChat, error) {
 	// TODO: 实现获取直播聊天逻辑
 	var chat model.LiveChat
-	err := r.db.WithContext(ctx).Where("id = ?", chatID).First(&chat).Error
+	err := r.conn(ctx).Where("id = ?", chatID).First(&chat).Error
 	if err != nil {
 		return nil, err
 	}
@@ -384,72 +583,92 @@ func (r *liveRepository) GetLiveChat(ctx context.Context, chatID uint64) (*model
 // UpdateLiveChat 更新直播聊天
 func (r *liveRepository) UpdateLiveChat(ctx context.Context, chat *model.LiveChat) error {
 	// TODO: 实现更新直播聊天逻辑
-	return r.db.WithContext(ctx).Save(chat).Error
+	return r.conn(ctx).Save(chat).Error
 }
 
 // DeleteLiveChat 删除直播聊天
 func (r *liveRepository) DeleteLiveChat(ctx context.Context, chatID uint64) error {
 	// TODO: 实现删除直播聊天逻辑
-	return r.db.WithContext(ctx).Delete(&model.LiveChat{}, chatID).Error
+	return r.conn(ctx).Delete(&model.LiveChat{}, chatID).Error
 }
 
-// GetLiveChatList 获取直播聊天列表
-func (r *liveRepository) GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveChat, int64, error) {
-	// TODO: 实现获取直播聊天列表逻辑
-	var chats []*model.LiveChat
-	var total int64
+// GetLiveChatList 获取直播聊天列表，默认按发送时间倒序，oldestFirst为true时按正序(用于回放)
+// 页码超过总页数时返回空页
+func (r *liveRepository) GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error) {
+	query := r.conn(ctx).Model(&model.LiveChat{}).Where("stream_id = ? AND deleted_at IS NULL", streamID)
 
-	err := r.db.WithContext(ctx).Model(&model.LiveChat{}).Where("stream_id = ?", streamID).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+	// 同一时刻的消息按ID排序，保证分页顺序稳定
+	order := "created_at DESC, id DESC"
+	if oldestFirst {
+		order = "created_at ASC, id ASC"
 	}
-
-	err = r.db.WithContext(ctx).Model(&model.LiveChat{}).
-		Where("stream_id = ?", streamID).
-		Order("created_at DESC").
-		Offset((page - 1) * pageSize).Limit(pageSize).Find(&chats).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return chats, total, nil
+	return findPage[model.LiveChat](query, paginate.NewPage(page, pageSize), order)
 }
 
-// GetLiveChatHistory 获取直播聊天历史
+// GetLiveChatHistory 获取直播聊天历史，startTime、endTime为秒级时间戳，按发送时间倒序
+// 超过保留期的消息已移入归档表，归档消息都早于主表中同一直播的消息，因此先取主表、不足一页时再从归档表接续
 func (r *liveRepository) GetLiveChatHistory(ctx context.Context, streamID uint64, startTime, endTime int64, page, pageSize int) ([]*model.LiveChat, int64, error) {
-	// TODO: 实现获取直播聊天历史逻辑
-	var chats []*model.LiveChat
-	var total int64
-
-	err := r.db.WithContext(ctx).Model(&model.LiveChat{}).
-		Where("stream_id = ? AND created_at >= ? AND created_at <= ?", streamID, startTime, endTime).
-		Count(&total).Error
-	if err != nil {
+	page, pageSize = model.NormalizePage(page, pageSize)
+	start, end := time.Unix(startTime, 0), time.Unix(endTime, 0)
+	const cond = "stream_id = ? AND created_at >= ? AND created_at <= ? AND deleted_at IS NULL"
+
+	var recentTotal, archivedTotal int64
+	if err := r.conn(ctx).Model(&model.LiveChat{}).
+		Where(cond, streamID, start, end).
+		Count(&recentTotal).Error; err != nil {
 		return nil, 0, err
 	}
-
-	err = r.db.WithContext(ctx).Model(&model.LiveChat{}).
-		Where("stream_id = ? AND created_at >= ? AND created_at <= ?", streamID, startTime, endTime).
-		Order("created_at DESC").
-		Offset((page - 1) * pageSize).Limit(pageSize).Find(&chats).Error
-	if err != nil {
+	if err := r.conn(ctx).Model(&model.LiveChatArchive{}).
+		Where(cond, streamID, start, end).
+		Count(&archivedTotal).Error; err != nil {
 		return nil, 0, err
 	}
 
-	return chats, total, nil
+	offset := int64((page - 1) * pageSize)
+	chats := make([]*model.LiveChat, 0, pageSize)
+	if offset < recentTotal {
+		if err := r.conn(ctx).
+			Where(cond, streamID, start, end).
+			Order("created_at DESC, id DESC").
+			Offset(int(offset)).Limit(pageSize).
+			Find(&chats).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if remaining := pageSize - len(chats); remaining > 0 && archivedTotal > 0 {
+		archiveOffset := offset - recentTotal
+		if archiveOffset < 0 {
+			archiveOffset = 0
+		}
+		var archived []*model.LiveChatArchive
+		if err := r.conn(ctx).
+			Where(cond, streamID, start, end).
+			Order("created_at DESC, id DESC").
+			Offset(int(archiveOffset)).Limit(remaining).
+			Find(&archived).Error; err != nil {
+			return nil, 0, err
+		}
+		for _, a := range archived {
+			chat := a.LiveChat
+			chats = append(chats, &chat)
+		}
+	}
+
+	return chats, recentTotal + archivedTotal, nil
 }
 
 // CreateLiveGift 创建直播礼物
 func (r *liveRepository) CreateLiveGift(ctx context.Context, gift *model.LiveGift) error {
 	// TODO: 实现创建直播礼物逻辑
-	return r.db.WithContext(ctx).Create(gift).Error
+	return r.conn(ctx).Create(gift).Error
 }
 
 // GetLiveGift 获取直播礼物
 func (r *liveRepository) GetLiveGift(ctx context.Context, giftID uint64) (*model.LiveGift, error) {
 	// TODO: 实现获取直播礼物逻辑
 	var gift model.LiveGift
-	err := r.db.WithContext(ctx).Where("id = ?", giftID).First(&gift).Error
+	err := r.conn(ctx).Where("id = ?", giftID).First(&gift).Error
 	if err != nil {
 		return nil, err
 	}
@@ -459,24 +678,30 @@ func (r *liveRepository) GetLiveGift(ctx context.Context, giftID uint64) (*model
 // UpdateLiveGift 更新直播礼物
 func (r *liveRepository) UpdateLiveGift(ctx context.Context, gift *model.LiveGift) error {
 	// TODO: 实现更新直播礼物逻辑
-	return r.db.WithContext(ctx).Save(gift).Error
+	return r.conn(ctx).Save(gift).Error
 }
 
-// GetLiveGiftList 获取直播礼物列表
-func (r *liveRepository) GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
-	// TODO: 实现获取直播礼物列表逻辑
+// GetLiveGiftList 获取直播礼物列表，按礼物总价值倒序
+// after不为nil时从游标位置之后查询并忽略offset，游标的排序值为礼物总价值
+func (r *liveRepository) GetLiveGiftList(ctx context.Context, streamID uint64, after *paginate.Cursor, offset, limit int) ([]*model.LiveGift, int64, error) {
 	var gifts []*model.LiveGift
 	var total int64
 
-	err := r.db.WithContext(ctx).Model(&model.LiveGift{}).Where("stream_id = ?", streamID).Count(&total).Error
-	if err != nil {
+	// 已退款的礼物保留记录但不再出现在直播间礼物列表中
+	query := r.conn(ctx).Model(&model.LiveGift{}).
+		Where("stream_id = ? AND status <> ? AND deleted_at IS NULL", streamID, model.GiftStatusRefunded)
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).Model(&model.LiveGift{}).
-		Where("stream_id = ?", streamID).
-		Order("created_at DESC").
-		Offset((page - 1) * pageSize).Limit(pageSize).Find(&gifts).Error
+	// 同价值的礼物按记录ID倒序(即发送时间倒序)，保证分页顺序稳定
+	page := query.Session(&gorm.Session{}).Order("total_value DESC").Order("id DESC")
+	if after != nil {
+		page = page.Where("total_value < ? OR (total_value = ? AND id < ?)", after.Value, after.Value, after.ID)
+	} else {
+		page = page.Offset(offset)
+	}
+	err := page.Limit(limit).Find(&gifts).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -484,21 +709,18 @@ func (r *liveRepository) GetLiveGiftList(ctx context.Context, streamID uint64, p
 	return gifts, total, nil
 }
 
-// GetUserLiveGiftList 获取用户直播礼物列表
+// GetUserLiveGiftList 获取用户直播礼物列表，按发送时间倒序，包含已退款的礼物
 func (r *liveRepository) GetUserLiveGiftList(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
-	// TODO: 实现获取用户直播礼物列表逻辑
 	var gifts []*model.LiveGift
 	var total int64
 
-	err := r.db.WithContext(ctx).Model(&model.LiveGift{}).Where("user_id = ?", userID).Count(&total).Error
-	if err != nil {
+	query := r.conn(ctx).Model(&model.LiveGift{}).Where("user_id = ? AND deleted_at IS NULL", userID)
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).Model(&model.LiveGift{}).
-		Where("user_id = ?", userID).
-		Order("created_at DESC").
-		Offset((page - 1) * pageSize).Limit(pageSize).Find(&gifts).Error
+	err := query.Order("created_at DESC").Order("id DESC").
+		Scopes(model.Paginate(page, pageSize)).Find(&gifts).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -506,13 +728,68 @@ func (r *liveRepository) GetUserLiveGiftList(ctx context.Context, userID uint64,
 	return gifts, total, nil
 }
 
+// RecordLiveGift 在同一事务中写入礼物记录并累加直播间与观看者的礼物统计
+func (r *liveRepository) RecordLiveGift(ctx context.Context, gift *model.LiveGift) error {
+	return r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(gift).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.LiveStream{}).Where("id = ?", gift.StreamID).
+			Update("gift_count", gorm.Expr("gift_count + ?", gift.GiftCount)).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.LiveViewer{}).
+			Where("stream_id = ? AND user_id = ?", gift.StreamID, gift.UserID).
+			Update("gift_value", gorm.Expr("gift_value + ?", gift.TotalValue)).Error
+	})
+}
+
+// UpdateLiveGiftCombo 回写礼物记录的连击次数、连击倍数和展示价值
+func (r *liveRepository) UpdateLiveGiftCombo(ctx context.Context, gift *model.LiveGift) error {
+	return r.conn(ctx).Model(&model.LiveGift{}).Where("id = ?", gift.ID).Updates(map[string]interface{}{
+		"combo_count":      gift.ComboCount,
+		"combo_multiplier": gift.ComboMultiplier,
+		"display_value":    gift.DisplayValue,
+	}).Error
+}
+
 // GetLiveGiftStats 获取直播礼物统计
+// 礼物按金币计价，TotalCoins与TotalValue一致；送出数量最多的礼物为TopGift，数量相同时取总价值高的
 func (r *liveRepository) GetLiveGiftStats(ctx context.Context, streamID uint64) (*GiftStats, error) {
-	// TODO: 实现获取直播礼物统计逻辑
-	// 这里应该包含复杂的聚合查询
-	return &GiftStats{
-		StreamID: streamID,
-	}, nil
+	stats := &GiftStats{StreamID: streamID}
+	err := r.conn(ctx).Model(&model.LiveGift{}).
+		Select("COALESCE(SUM(gift_count), 0) AS total_gifts, COALESCE(SUM(total_value), 0) AS total_value, COUNT(DISTINCT user_id) AS unique_senders").
+		Where("stream_id = ? AND status = ? AND deleted_at IS NULL", streamID, 1).
+		Scan(stats).Error
+	if err != nil {
+		return nil, err
+	}
+	stats.TotalCoins = stats.TotalValue
+	if stats.TotalGifts == 0 {
+		return stats, nil
+	}
+
+	var top struct {
+		GiftID       uint32
+		TopGiftCount uint32
+		TopGiftValue uint64
+	}
+	err = r.conn(ctx).Model(&model.LiveGift{}).
+		Select("gift_id, SUM(gift_count) AS top_gift_count, SUM(total_value) AS top_gift_value").
+		Where("stream_id = ? AND status = ? AND deleted_at IS NULL", streamID, 1).
+		Group("gift_id").
+		Order("top_gift_count DESC, top_gift_value DESC").
+		Limit(1).
+		Scan(&top).Error
+	if err != nil {
+		return nil, err
+	}
+	stats.TopGiftID = top.GiftID
+	stats.TopGiftCount = top.TopGiftCount
+	stats.TopGiftValue = top.TopGiftValue
+	return stats, nil
 }
 
 // SetLiveStreamCache 设置直播流缓存
@@ -541,22 +818,70 @@ func (r *liveRepository) DeleteLiveStreamCache(ctx context.Context, streamID uin
 	return r.redis.Del(ctx, key).Err()
 }
 
-// SetLiveViewerCountCache 设置观看者数量缓存
+// SetLiveGiftStatsCache 设置礼物统计缓存
+func (r *liveRepository) SetLiveGiftStatsCache(ctx context.Context, stats *GiftStats) error {
+	key := model.GetLiveGiftStatsKey(stats.StreamID)
+	return model.SetCache(ctx, r.redis, key, stats, model.LiveGiftStatsTTL)
+}
+
+// GetLiveGiftStatsCache 获取礼物统计缓存
+func (r *liveRepository) GetLiveGiftStatsCache(ctx context.Context, streamID uint64) (*GiftStats, error) {
+	key := model.GetLiveGiftStatsKey(streamID)
+	var stats GiftStats
+	if err := model.GetCache(ctx, r.redis, key, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// DeleteLiveGiftStatsCache 删除礼物统计缓存
+func (r *liveRepository) DeleteLiveGiftStatsCache(ctx context.Context, streamID uint64) error {
+	key := model.GetLiveGiftStatsKey(streamID)
+	return r.redis.Del(ctx, key).Err()
+}
+
+// SetLiveViewerCountCache 设置观看者数量缓存，到期后从数据库重新校准
 func (r *liveRepository) SetLiveViewerCountCache(ctx context.Context, streamID uint64, count int64) error {
-	// TODO: 实现设置观看者数量缓存逻辑
 	key := model.GetLiveViewerCountCacheKey(streamID)
-	return r.redis.Set(ctx, key, count, model.LiveRealTimeTTL).Err()
+	return r.redis.Set(ctx, key, count, model.LiveViewerCountTTL).Err()
 }
 
-// GetLiveViewerCountCache 获取观看者数量缓存
-func (r *liveRepository) GetLiveViewerCountCache(ctx context.Context, streamID uint64) (int64, error) {
-	// TODO: 实现获取观看者数量缓存逻辑
+// GetLiveViewerCountCache 获取观看者数量缓存，found为false表示缓存不存在(区别于真实的0人)
+func (r *liveRepository) GetLiveViewerCountCache(ctx context.Context, streamID uint64) (int64, bool, error) {
 	key := model.GetLiveViewerCountCacheKey(streamID)
 	result, err := r.redis.Get(ctx, key).Int64()
 	if err == redis.Nil {
-		return 0, nil
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return result, true, nil
+}
+
+// SetUserActiveStreamCache 缓存用户未结束的直播流ID，streamID为0表示用户未在直播
+func (r *liveRepository) SetUserActiveStreamCache(ctx context.Context, userID, streamID uint64) error {
+	key := model.GetLiveUserActiveStreamKey(userID)
+	return r.redis.Set(ctx, key, streamID, model.LiveUserActiveStreamTTL).Err()
+}
+
+// GetUserActiveStreamCache 获取缓存的用户直播流ID，found为false表示缓存不存在(区别于缓存的未在直播)
+func (r *liveRepository) GetUserActiveStreamCache(ctx context.Context, userID uint64) (uint64, bool, error) {
+	key := model.GetLiveUserActiveStreamKey(userID)
+	result, err := r.redis.Get(ctx, key).Uint64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
 	}
-	return result, err
+	return result, true, nil
+}
+
+// DeleteUserActiveStreamCache 删除用户当前直播缓存
+func (r *liveRepository) DeleteUserActiveStreamCache(ctx context.Context, userID uint64) error {
+	key := model.GetLiveUserActiveStreamKey(userID)
+	return r.redis.Del(ctx, key).Err()
 }
 
 // IncrementLiveViewerCount 增加观看者数量
@@ -573,6 +898,88 @@ func (r *liveRepository) DecrementLiveViewerCount(ctx context.Context, streamID
 	return r.redis.Decr(ctx, key).Err()
 }
 
+// IncrementGiftCombo 增加礼物连击计数，每次赠送都会刷新连击窗口
+func (r *liveRepository) IncrementGiftCombo(ctx context.Context, streamID, userID uint64, giftID uint32, window time.Duration) (int64, error) {
+	key := model.GetLiveGiftComboKey(streamID, userID, giftID)
+	pipe := r.redis.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// giftRequestProcessing 幂等记录占位值，表示请求正在处理
+const giftRequestProcessing = "processing"
+
+// AcquireGiftRequest 占用送礼请求ID，返回false表示该请求已处理或正在处理
+func (r *liveRepository) AcquireGiftRequest(ctx context.Context, userID uint64, requestID string) (bool, error) {
+	key := model.GetLiveGiftRequestKey(userID, requestID)
+	return r.redis.SetNX(ctx, key, giftRequestProcessing, model.GiftRequestTTL).Result()
+}
+
+// GetGiftRequestResult 获取已处理送礼请求的结果，请求仍在处理中时返回nil
+// 幂等记录已过期或已释放时第二个返回值为false
+func (r *liveRepository) GetGiftRequestResult(ctx context.Context, userID uint64, requestID string) (*model.LiveGift, bool, error) {
+	key := model.GetLiveGiftRequestKey(userID, requestID)
+	data, err := r.redis.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if data == giftRequestProcessing {
+		return nil, true, nil
+	}
+
+	var gift model.LiveGift
+	if err := json.Unmarshal([]byte(data), &gift); err != nil {
+		return nil, false, err
+	}
+	return &gift, true, nil
+}
+
+// SetGiftRequestResult 保存送礼请求的处理结果
+func (r *liveRepository) SetGiftRequestResult(ctx context.Context, userID uint64, requestID string, gift *model.LiveGift) error {
+	key := model.GetLiveGiftRequestKey(userID, requestID)
+	return model.SetCache(ctx, r.redis, key, gift, model.GiftRequestTTL)
+}
+
+// ReleaseGiftRequest 释放送礼请求ID，处理失败时调用以允许客户端重试
+func (r *liveRepository) ReleaseGiftRequest(ctx context.Context, userID uint64, requestID string) error {
+	return r.redis.Del(ctx, model.GetLiveGiftRequestKey(userID, requestID)).Err()
+}
+
+// SetChatMute 保存禁言记录，ttl为0表示不过期
+func (r *liveRepository) SetChatMute(ctx context.Context, mute *model.LiveChatMuteCache, ttl time.Duration) error {
+	key := model.GetLiveChatMuteKey(mute.StreamID, mute.UserID)
+	return model.SetCache(ctx, r.redis, key, mute, ttl)
+}
+
+// GetChatMute 获取禁言记录，未被禁言时返回nil
+func (r *liveRepository) GetChatMute(ctx context.Context, streamID, userID uint64) (*model.LiveChatMuteCache, error) {
+	data, err := r.redis.Get(ctx, model.GetLiveChatMuteKey(streamID, userID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var mute model.LiveChatMuteCache
+	if err := json.Unmarshal([]byte(data), &mute); err != nil {
+		return nil, err
+	}
+	return &mute, nil
+}
+
+// DeleteChatMute 删除禁言记录
+func (r *liveRepository) DeleteChatMute(ctx context.Context, streamID, userID uint64) error {
+	return r.redis.Del(ctx, model.GetLiveChatMuteKey(streamID, userID)).Err()
+}
+
 // GetLiveStats 获取直播统计
 func (r *liveRepository) GetLiveStats(ctx context.Context, streamID uint64) (*LiveStats, error) {
 	// TODO: 实现获取直播统计逻辑
@@ -594,6 +1001,67 @@ func (r *liveRepository) GetGiftRanking(ctx context.Context, streamID uint64, ra
 	return []*GiftRankingItem{}, nil
 }
 
+// IncrDailyStreamerGiftValue 累加主播当日收礼价值
+func (r *liveRepository) IncrDailyStreamerGiftValue(ctx context.Context, day string, anchorID uint64, value uint64) error {
+	key := model.GetLiveDailyStreamerGiftKey(day)
+	pipe := r.redis.TxPipeline()
+	pipe.ZIncrBy(ctx, key, float64(value), strconv.FormatUint(anchorID, 10))
+	pipe.Expire(ctx, key, model.LiveDailyBucketTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// UpdateDailyPeakViewers 记录直播当日峰值在线人数，只在新值更大时更新
+func (r *liveRepository) UpdateDailyPeakViewers(ctx context.Context, day string, streamID uint64, viewers int64) error {
+	key := model.GetLiveDailyPeakViewerKey(day)
+	pipe := r.redis.TxPipeline()
+	pipe.ZAddArgs(ctx, key, redis.ZAddArgs{
+		GT:      true,
+		Members: []redis.Z{{Score: float64(viewers), Member: strconv.FormatUint(streamID, 10)}},
+	})
+	pipe.Expire(ctx, key, model.LiveDailyBucketTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetDailyLeaderboard 按分数倒序读取排行榜前limit名
+func (r *liveRepository) GetDailyLeaderboard(ctx context.Context, key string, limit int) ([]*LeaderboardEntry, error) {
+	members, err := r.redis.ZRevRangeWithScores(ctx, key, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*LeaderboardEntry, 0, len(members))
+	for _, m := range members {
+		member, _ := m.Member.(string)
+		id, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, &LeaderboardEntry{
+			Rank:  len(entries) + 1,
+			ID:    id,
+			Score: int64(m.Score),
+		})
+	}
+	return entries, nil
+}
+
+// SetDailyLeaderboardsCache 缓存每日排行榜结果
+func (r *liveRepository) SetDailyLeaderboardsCache(ctx context.Context, boards *DailyLeaderboards) error {
+	key := model.GetLiveDailyLeaderboardKey(boards.Date)
+	return model.SetCache(ctx, r.redis, key, boards, model.LiveDailyLeaderboardTTL)
+}
+
+// GetDailyLeaderboardsCache 获取缓存的每日排行榜结果
+func (r *liveRepository) GetDailyLeaderboardsCache(ctx context.Context, day string) (*DailyLeaderboards, error) {
+	var boards DailyLeaderboards
+	if err := model.GetCache(ctx, r.redis, model.GetLiveDailyLeaderboardKey(day), &boards); err != nil {
+		return nil, err
+	}
+	return &boards, nil
+}
+
 // GetGiftConfig 获取礼物配置
 func (r *liveRepository) GetGiftConfig(ctx context.Context, giftID uint32) (*GiftConfig, error) {
 	// TODO: 实现获取礼物配置逻辑
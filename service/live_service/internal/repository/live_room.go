@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"live_service/internal/model"
+)
+
+// CreateLiveRoomIfAbsent 创建直播间，主播已有直播间或房间号冲突时不创建并返回false
+func (r *liveRepository) CreateLiveRoomIfAbsent(ctx context.Context, room *model.LiveRoom) (bool, error) {
+	result := r.conn(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(room)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetLiveRoom 获取直播间
+func (r *liveRepository) GetLiveRoom(ctx context.Context, roomID uint64) (*model.LiveRoom, error) {
+	var room model.LiveRoom
+	if err := r.conn(ctx).Where("id = ? AND deleted_at IS NULL", roomID).First(&room).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+// GetLiveRoomByUserID 获取主播的直播间
+func (r *liveRepository) GetLiveRoomByUserID(ctx context.Context, userID uint64) (*model.LiveRoom, error) {
+	var room model.LiveRoom
+	if err := r.conn(ctx).Where("user_id = ? AND deleted_at IS NULL", userID).First(&room).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+// StartLiveRoomStream 直播间开始一场新直播，累计直播次数并置为在线
+func (r *liveRepository) StartLiveRoomStream(ctx context.Context, roomID uint64) error {
+	result := r.conn(ctx).Model(&model.LiveRoom{}).
+		Where("id = ?", roomID).
+		Updates(map[string]interface{}{
+			"total_streams": gorm.Expr("total_streams + 1"),
+			"status":        model.RoomStatusOnline,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// AccumulateLiveRoomStats 将已结束直播的时长、观看人数、点赞数和礼物数累加到所属直播间，并将直播间置为离线
+// 观看人数按该场直播去重的观看用户计；同一直播只应在结算时调用一次
+func (r *liveRepository) AccumulateLiveRoomStats(ctx context.Context, stream *model.LiveStream) error {
+	return r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var viewers int64
+		if err := tx.Model(&model.LiveViewer{}).
+			Distinct("user_id").
+			Where("stream_id = ? AND deleted_at IS NULL", stream.ID).
+			Count(&viewers).Error; err != nil {
+			return fmt.Errorf("failed to count live viewers: %w", err)
+		}
+
+		// 已被禁播的直播间保持禁播状态
+		status := gorm.Expr("CASE WHEN status = ? THEN status ELSE ? END", model.RoomStatusBanned, model.RoomStatusOffline)
+		if err := tx.Model(&model.LiveRoom{}).
+			Where("id = ?", stream.RoomID).
+			Updates(map[string]interface{}{
+				"total_duration": gorm.Expr("total_duration + ?", stream.Duration),
+				"total_viewers":  gorm.Expr("total_viewers + ?", viewers),
+				"total_likes":    gorm.Expr("total_likes + ?", stream.LikeCount),
+				"total_gifts":    gorm.Expr("total_gifts + ?", stream.GiftCount),
+				"status":         status,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to update live room stats: %w", err)
+		}
+		return nil
+	})
+}
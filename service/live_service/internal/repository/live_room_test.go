@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"live_service/internal/model"
+)
+
+// newDryRunRoomRepository 只生成SQL不连接数据库的仓库，记录插入和更新语句，并按rowsAffected返回影响行数
+func newDryRunRoomRepository(t *testing.T, rowsAffected int64) (*liveRepository, *[]string) {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/live", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+
+	var statements []string
+	record := func(tx *gorm.DB) {
+		statements = append(statements, tx.Statement.SQL.String())
+		tx.RowsAffected = rowsAffected
+	}
+	if err := db.Callback().Create().After("gorm:create").Register("test:record", record); err != nil {
+		t.Fatalf("register create callback: %v", err)
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("test:record", record); err != nil {
+		t.Fatalf("register update callback: %v", err)
+	}
+	return &liveRepository{db: db, tx: true}, &statements
+}
+
+func TestCreateLiveRoomIfAbsent(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		want         bool
+	}{
+		{"created", 1, true},
+		// 主播已有直播间或房间号冲突时不插入
+		{"conflict", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, statements := newDryRunRoomRepository(t, tt.rowsAffected)
+
+			created, err := repo.CreateLiveRoomIfAbsent(context.Background(), &model.LiveRoom{UserID: 7, RoomNumber: "12345678"})
+			if err != nil {
+				t.Fatalf("CreateLiveRoomIfAbsent: %v", err)
+			}
+			if created != tt.want {
+				t.Errorf("created = %v, want %v", created, tt.want)
+			}
+			if len(*statements) != 1 {
+				t.Fatalf("executed %d statements, want 1", len(*statements))
+			}
+			if sql := (*statements)[0]; !strings.HasPrefix(sql, "INSERT INTO `live_rooms`") || !strings.Contains(sql, "ON DUPLICATE KEY UPDATE `id`=`id`") {
+				t.Errorf("insert = %s, want an insert that ignores duplicate keys", sql)
+			}
+		})
+	}
+}
+
+func TestStartLiveRoomStream(t *testing.T) {
+	repo, statements := newDryRunRoomRepository(t, 1)
+	if err := repo.StartLiveRoomStream(context.Background(), 3); err != nil {
+		t.Fatalf("StartLiveRoomStream: %v", err)
+	}
+	if len(*statements) != 1 {
+		t.Fatalf("executed %d statements, want 1", len(*statements))
+	}
+	sql := (*statements)[0]
+	for _, want := range []string{"`status`=", "`total_streams`=total_streams + 1", "WHERE id = "} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("update = %s, want it to contain %q", sql, want)
+		}
+	}
+
+	missing, _ := newDryRunRoomRepository(t, 0)
+	if err := missing.StartLiveRoomStream(context.Background(), 3); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("missing room error = %v, want gorm.ErrRecordNotFound", err)
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSearchLiveStreamFilters(t *testing.T) {
+	repo, queries := newDryRunRepository(t, 30)
+
+	filter := LiveStreamSearchFilter{Keyword: "开黑", CategoryID: 7, MinViewers: 100, Language: "zh", Region: "CN"}
+	if _, _, err := repo.SearchLiveStream(context.Background(), filter, 0, 1, 10); err != nil {
+		t.Fatalf("SearchLiveStream: %v", err)
+	}
+	if len(*queries) != 2 {
+		t.Fatalf("queries = %d, want count and page", len(*queries))
+	}
+	// 统计和分页使用相同的筛选条件，关键词的OR条件不影响其他条件
+	for _, q := range *queries {
+		for _, want := range []string{
+			"status = ?",
+			"(title LIKE ? OR description LIKE ?)",
+			"category_id = ?",
+			"language = ?",
+			"region = ?",
+			"live_viewers.exit_time IS NULL",
+			") >= ?",
+			"is_public = ?",
+		} {
+			if !strings.Contains(q.sql, want) {
+				t.Errorf("query missing %q: %s", want, q.sql)
+			}
+		}
+		for _, want := range []interface{}{"%开黑%", uint32(7), uint32(100), "zh", "CN"} {
+			if !hasVar(q.vars, want) {
+				t.Errorf("vars = %v, want %v", q.vars, want)
+			}
+		}
+	}
+}
+
+func TestSearchLiveStreamEmptyFilter(t *testing.T) {
+	repo, queries := newDryRunRepository(t, 30)
+
+	if _, _, err := repo.SearchLiveStream(context.Background(), LiveStreamSearchFilter{}, 0, 1, 10); err != nil {
+		t.Fatalf("SearchLiveStream: %v", err)
+	}
+	if len(*queries) != 2 {
+		t.Fatalf("queries = %d, want count and page", len(*queries))
+	}
+	// 零值条件不参与过滤，只返回直播中的公开直播
+	for _, q := range *queries {
+		for _, unwanted := range []string{"LIKE", "category_id", "language", "region", "live_viewers"} {
+			if strings.Contains(q.sql, unwanted) {
+				t.Errorf("query contains %q: %s", unwanted, q.sql)
+			}
+		}
+		if !strings.Contains(q.sql, "status = ?") {
+			t.Errorf("query missing the streaming status: %s", q.sql)
+		}
+	}
+}
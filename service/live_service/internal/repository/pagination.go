@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"live_service/pkg/paginate"
+)
+
+// findPage 统计符合条件的总数并查询当前页，页码超过总页数时返回空页而不再查询
+// query需已设置模型和过滤条件，order为排序子句，应包含主键以保证分页顺序稳定
+func findPage[T any](query *gorm.DB, p paginate.Page, order string) ([]*T, int64, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]*T, 0, p.Size)
+	if !p.InRange(total) {
+		return items, total, nil
+	}
+	if err := query.Session(&gorm.Session{}).
+		Order(order).
+		Offset(p.Offset()).Limit(p.Size).
+		Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestUpdatePlaybackStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		playbackURL  string
+		rowsAffected int64
+		wantURL      bool
+	}{
+		{"ready with url", "https://cdn.example.com/1.mp4", 1, true},
+		// 转码失败时不修改回放地址
+		{"failed", "", 1, false},
+		{"already updated", "https://cdn.example.com/1.mp4", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, statements := newDryRunRoomRepository(t, tt.rowsAffected)
+
+			updated, err := repo.UpdatePlaybackStatus(context.Background(), 1, model.PlaybackStatusProcessing, model.PlaybackStatusReady, tt.playbackURL)
+			if err != nil {
+				t.Fatalf("UpdatePlaybackStatus: %v", err)
+			}
+			if updated != (tt.rowsAffected > 0) {
+				t.Errorf("updated = %v, want %v", updated, tt.rowsAffected > 0)
+			}
+			if len(*statements) != 1 {
+				t.Fatalf("executed %d statements, want 1", len(*statements))
+			}
+			sql := (*statements)[0]
+			// 只更新回放状态仍为from的记录
+			if !strings.Contains(sql, "`playback_status`=?") || !strings.Contains(sql, "WHERE id = ? AND playback_status = ?") {
+				t.Errorf("update = %s, want a conditional playback status update", sql)
+			}
+			if got := strings.Contains(sql, "`playback_url`=?"); got != tt.wantURL {
+				t.Errorf("update = %s, playback_url set = %v, want %v", sql, got, tt.wantURL)
+			}
+		})
+	}
+}
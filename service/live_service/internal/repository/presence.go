@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"live_service/internal/model"
+)
+
+// refreshPresenceScript 仅为仍然在线的观看者续期，已过期或不存在的观看者需要重新进入直播间
+var refreshPresenceScript = `
+local score = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if score and tonumber(score) >= tonumber(ARGV[2]) then
+	redis.call("ZADD", KEYS[1], ARGV[3], ARGV[1])
+	return 1
+end
+return 0
+`
+
+// popExpiredPresenceScript 原子地取出并移除心跳已过期的观看者，直播间无人在线时从遍历集合中移除
+var popExpiredPresenceScript = `
+local ids = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", "(" .. ARGV[1], "LIMIT", 0, ARGV[2])
+if #ids > 0 then
+	redis.call("ZREM", KEYS[1], unpack(ids))
+end
+if redis.call("ZCARD", KEYS[1]) == 0 then
+	redis.call("SREM", KEYS[2], ARGV[3])
+end
+return ids
+`
+
+// TouchViewerPresence 记录观看者在线，心跳过期时间为当前时间加ttl
+func (r *liveRepository) TouchViewerPresence(ctx context.Context, streamID, userID uint64, ttl time.Duration) error {
+	expireAt := time.Now().Add(ttl).UnixMilli()
+	pipe := r.redis.TxPipeline()
+	pipe.ZAdd(ctx, model.GetLiveViewerPresenceKey(streamID), &redis.Z{Score: float64(expireAt), Member: userID})
+	pipe.SAdd(ctx, model.LivePresenceStreamsKey, streamID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// TouchViewersPresence 批量记录观看者在线，在同一个事务管道中写入
+func (r *liveRepository) TouchViewersPresence(ctx context.Context, streamID uint64, userIDs []uint64, ttl time.Duration) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	expireAt := float64(time.Now().Add(ttl).UnixMilli())
+	members := make([]*redis.Z, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, &redis.Z{Score: expireAt, Member: userID})
+	}
+	pipe := r.redis.TxPipeline()
+	pipe.ZAdd(ctx, model.GetLiveViewerPresenceKey(streamID), members...)
+	pipe.SAdd(ctx, model.LivePresenceStreamsKey, streamID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RefreshViewerPresence 刷新观看者心跳，观看者不在线时返回false
+func (r *liveRepository) RefreshViewerPresence(ctx context.Context, streamID, userID uint64, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	refreshed, err := r.redis.Eval(ctx, refreshPresenceScript,
+		[]string{model.GetLiveViewerPresenceKey(streamID)},
+		userID, now.UnixMilli(), now.Add(ttl).UnixMilli(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+	return refreshed == 1, nil
+}
+
+// RemoveViewerPresence 移除观看者在线状态，返回观看者此前是否在线
+func (r *liveRepository) RemoveViewerPresence(ctx context.Context, streamID, userID uint64) (bool, error) {
+	removed, err := r.redis.ZRem(ctx, model.GetLiveViewerPresenceKey(streamID), userID).Result()
+	if err != nil {
+		return false, err
+	}
+	return removed > 0, nil
+}
+
+// ListPresenceStreams 获取存在在线观看者的直播流ID
+func (r *liveRepository) ListPresenceStreams(ctx context.Context) ([]uint64, error) {
+	members, err := r.redis.SMembers(ctx, model.LivePresenceStreamsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseUint64Members(members), nil
+}
+
+// PopExpiredViewers 取出并移除心跳在now之前过期的观看者，单次最多limit个
+func (r *liveRepository) PopExpiredViewers(ctx context.Context, streamID uint64, now time.Time, limit int) ([]uint64, error) {
+	result, err := r.redis.Eval(ctx, popExpiredPresenceScript,
+		[]string{model.GetLiveViewerPresenceKey(streamID), model.LivePresenceStreamsKey},
+		now.UnixMilli(), limit, streamID,
+	).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	return parseUint64Members(result), nil
+}
+
+// watchDurationSince 观看时长从上次累计时间起算，尚未累计过时从进入时间起算
+const watchDurationSince = "COALESCE(accrued_at, enter_time)"
+
+// watchAccrueUntil 观看时长累计的截止时间，直播暂停期间截止到暂停时间，暂停期间不计入观看时长
+func watchAccrueUntil(at time.Time) clause.Expr {
+	return gorm.Expr("LEAST(?, COALESCE((SELECT paused_at FROM live_streams WHERE live_streams.id = live_viewers.stream_id), ?))", at, at)
+}
+
+// accrueWatchDurationExpr 将观看时长累计至at
+func accrueWatchDurationExpr(at time.Time) clause.Expr {
+	return gorm.Expr("watch_duration + GREATEST(TIMESTAMPDIFF(SECOND, "+watchDurationSince+", ?), 0)", watchAccrueUntil(at))
+}
+
+// accrueWatchDuration 累计观看时长至at并将accrued_at更新为accruedAt，others在两者之间赋值
+// MySQL按顺序执行SET中的赋值，后面的赋值读到的是已更新的值，watch_duration必须先于accrued_at计算；
+// 以map调用Updates时GORM按列名排序会先更新accrued_at，因此显式构造SET子句
+func accrueWatchDuration(at time.Time, accruedAt interface{}, others ...clause.Assignment) clause.Set {
+	set := clause.Set{{Column: clause.Column{Name: "watch_duration"}, Value: accrueWatchDurationExpr(at)}}
+	set = append(set, others...)
+	return append(set, clause.Assignment{Column: clause.Column{Name: "accrued_at"}, Value: accruedAt})
+}
+
+// MarkLiveViewerExit 记录观看者离开并结算剩余观看时长，只更新尚未离开的记录，返回实际更新的条数
+func (r *liveRepository) MarkLiveViewerExit(ctx context.Context, streamID uint64, userIDs []uint64, exitTime time.Time) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	result := r.conn(ctx).Model(&model.LiveViewer{}).
+		Where("stream_id = ? AND user_id IN ? AND exit_time IS NULL AND deleted_at IS NULL", streamID, userIDs).
+		Clauses(accrueWatchDuration(exitTime, exitTime, clause.Assignment{Column: clause.Column{Name: "exit_time"}, Value: exitTime})).
+		Updates(map[string]interface{}{})
+	return result.RowsAffected, result.Error
+}
+
+// AccrueLiveViewerDuration 为仍在观看的观看者累计观看时长至now，直播暂停时只累计到暂停时间
+// 距上次累计不足minInterval时不更新，返回是否实际累计
+func (r *liveRepository) AccrueLiveViewerDuration(ctx context.Context, streamID, userID uint64, now time.Time, minInterval time.Duration) (bool, error) {
+	result := r.conn(ctx).Model(&model.LiveViewer{}).
+		Where("stream_id = ? AND user_id = ? AND exit_time IS NULL AND deleted_at IS NULL", streamID, userID).
+		Where(watchDurationSince+" <= ?", now.Add(-minInterval)).
+		Clauses(accrueWatchDuration(now, watchAccrueUntil(now))).
+		Updates(map[string]interface{}{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// PauseLiveStream 暂停直播中的直播并记录暂停时间，此后观看时长只累计到暂停时间
+func (r *liveRepository) PauseLiveStream(ctx context.Context, streamID uint64, pausedAt time.Time) error {
+	result := r.conn(ctx).Model(&model.LiveStream{}).
+		Where("id = ? AND status = ?", streamID, model.LiveStatusStreaming).
+		Updates(map[string]interface{}{
+			"status":    model.LiveStatusPaused,
+			"paused_at": pausedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: stream %d is not streaming", model.ErrInvalidLiveStatusTransition, streamID)
+	}
+	return nil
+}
+
+// ResumeLiveStream 恢复暂停的直播
+// 在线观看者暂停前的观看时长累计到暂停时间，之后从恢复时间起算，暂停期间不计入观看时长
+func (r *liveRepository) ResumeLiveStream(ctx context.Context, streamID uint64, resumedAt time.Time) error {
+	return r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.LiveViewer{}).
+			Where("stream_id = ? AND exit_time IS NULL AND deleted_at IS NULL", streamID).
+			Clauses(accrueWatchDuration(resumedAt, resumedAt)).
+			Updates(map[string]interface{}{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.LiveStream{}).
+			Where("id = ? AND status = ?", streamID, model.LiveStatusPaused).
+			Updates(map[string]interface{}{
+				"status":    model.LiveStatusStreaming,
+				"paused_at": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: stream %d is not paused", model.ErrInvalidLiveStatusTransition, streamID)
+		}
+		return nil
+	})
+}
+
+// ReenterLiveViewer 已离开的观看者重新进入直播间，清空离开时间并重置进入时间，返回是否由离开状态恢复
+func (r *liveRepository) ReenterLiveViewer(ctx context.Context, streamID, userID uint64, enterTime time.Time) (bool, error) {
+	result := r.conn(ctx).Model(&model.LiveViewer{}).
+		Where("stream_id = ? AND user_id = ? AND exit_time IS NOT NULL AND deleted_at IS NULL", streamID, userID).
+		Updates(map[string]interface{}{
+			"exit_time":  nil,
+			"enter_time": enterTime,
+			"accrued_at": nil,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// BatchJoinLiveViewers 批量加入直播间，在一个事务中为没有观看记录的用户创建记录，已离开的用户重新进入
+// 仍在观看的用户不做处理，返回新计入观看人数的用户数；userIDs需由调用方去重
+func (r *liveRepository) BatchJoinLiveViewers(ctx context.Context, streamID, roomID uint64, userIDs []uint64, enterTime time.Time) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	var joined int64
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []uint64
+		if err := tx.Model(&model.LiveViewer{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("stream_id = ? AND user_id IN ? AND deleted_at IS NULL", streamID, userIDs).
+			Pluck("user_id", &existing).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.LiveViewer{}).
+			Where("stream_id = ? AND user_id IN ? AND exit_time IS NOT NULL AND deleted_at IS NULL", streamID, userIDs).
+			Updates(map[string]interface{}{
+				"exit_time":  nil,
+				"enter_time": enterTime,
+				"accrued_at": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		joined = result.RowsAffected
+
+		present := make(map[uint64]bool, len(existing))
+		for _, userID := range existing {
+			present[userID] = true
+		}
+		viewers := make([]*model.LiveViewer, 0, len(userIDs)-len(present))
+		for _, userID := range userIDs {
+			if present[userID] {
+				continue
+			}
+			viewers = append(viewers, &model.LiveViewer{
+				StreamID:  streamID,
+				UserID:    userID,
+				RoomID:    roomID,
+				EnterTime: enterTime,
+			})
+		}
+		if len(viewers) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(viewers, 100).Error; err != nil {
+			return err
+		}
+		joined += int64(len(viewers))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return joined, nil
+}
+
+// parseUint64Members 将Redis集合成员解析为ID，忽略无法解析的成员
+func parseUint64Members(members []string) []uint64 {
+	ids := make([]uint64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/internal/model"
+	"live_service/pkg/logger"
+)
+
+// DefaultStatsFlushInterval 统计增量默认刷新间隔
+const DefaultStatsFlushInterval = 200 * time.Millisecond
+
+// StatsKind 批量统计的计数类型
+type StatsKind uint8
+
+const (
+	StatsViewerCount StatsKind = iota + 1 // 实时观看人数
+	StatsLikeCount                        // 实时点赞数
+)
+
+// incrIfExistsScript 仅在计数已存在时累加，计数缺失时由读取方从数据库回填，
+// 避免用部分增量重建计数导致数值失真
+var incrIfExistsScript = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCRBY", KEYS[1], ARGV[1])
+end
+return false
+`
+
+// statsKey 批量计数键
+type statsKey struct {
+	kind     StatsKind
+	streamID uint64
+}
+
+// redisKey 对应的Redis键
+func (k statsKey) redisKey() string {
+	switch k.kind {
+	case StatsLikeCount:
+		return model.GetLiveLikeCountKey(k.streamID)
+	default:
+		return model.GetLiveViewerCountCacheKey(k.streamID)
+	}
+}
+
+// StatsFlushFunc 刷新成功后的回调，value为Redis中的最新值，计数不存在时不回调
+type StatsFlushFunc func(ctx context.Context, kind StatsKind, streamID uint64, value int64)
+
+// StatsBatcher 直播实时计数批量写入器
+// 进房、点赞等高频计数先在内存中按直播聚合，定时通过一次MULTI/EXEC提交，
+// 大幅减少Redis往返次数；读取到的计数最多滞后一个刷新间隔
+type StatsBatcher struct {
+	redis    redis.UniversalClient
+	logger   logger.Logger
+	interval time.Duration
+	onFlush  StatsFlushFunc
+
+	mu      sync.Mutex
+	pending map[statsKey]int64
+
+	flushMu   sync.Mutex
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	started   bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// NewStatsBatcher 创建计数批量写入器，interval<=0时使用默认刷新间隔
+func NewStatsBatcher(redisClient redis.UniversalClient, interval time.Duration, log logger.Logger) *StatsBatcher {
+	if interval <= 0 {
+		interval = DefaultStatsFlushInterval
+	}
+	return &StatsBatcher{
+		redis:    redisClient,
+		logger:   log,
+		interval: interval,
+		pending:  make(map[statsKey]int64),
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// OnFlush 设置刷新回调，需在Start之前调用
+func (b *StatsBatcher) OnFlush(fn StatsFlushFunc) {
+	b.onFlush = fn
+}
+
+// IncrViewerCount 累加观看人数增量，delta可为负数
+func (b *StatsBatcher) IncrViewerCount(streamID uint64, delta int64) {
+	b.add(statsKey{kind: StatsViewerCount, streamID: streamID}, delta)
+}
+
+// IncrLikeCount 累加点赞数增量
+func (b *StatsBatcher) IncrLikeCount(streamID uint64, delta int64) {
+	b.add(statsKey{kind: StatsLikeCount, streamID: streamID}, delta)
+}
+
+func (b *StatsBatcher) add(key statsKey, delta int64) {
+	if delta == 0 {
+		return
+	}
+	b.mu.Lock()
+	b.pending[key] += delta
+	b.mu.Unlock()
+}
+
+// Start 启动后台定时刷新
+func (b *StatsBatcher) Start() {
+	b.startOnce.Do(func() {
+		b.mu.Lock()
+		b.started = true
+		b.mu.Unlock()
+		go b.run()
+	})
+}
+
+func (b *StatsBatcher) run() {
+	defer close(b.doneCh)
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), b.interval*5)
+			if err := b.Flush(ctx); err != nil {
+				b.logger.Warn("Failed to flush live stats", "error", err)
+			}
+			cancel()
+		case <-b.stopCh:
+			return
+		}
+	}
+}
+
+// Flush 立即提交所有待写入的增量
+// 提交失败时增量会合并回待写入队列，下次刷新时重试，保证计数不丢失
+func (b *StatsBatcher) Flush(ctx context.Context) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	if len(b.pending) == 0 {
+		b.mu.Unlock()
+		return nil
+	}
+	batch := b.pending
+	b.pending = make(map[statsKey]int64, len(batch))
+	b.mu.Unlock()
+
+	keys := make([]statsKey, 0, len(batch))
+	cmds := make([]*redis.Cmd, 0, len(batch))
+	pipe := b.redis.TxPipeline()
+	for key, delta := range batch {
+		keys = append(keys, key)
+		cmds = append(cmds, pipe.Eval(ctx, incrIfExistsScript, []string{key.redisKey()}, delta))
+	}
+
+	// 计数不存在时脚本返回nil，不视为提交失败
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		b.mu.Lock()
+		for key, delta := range batch {
+			b.pending[key] += delta
+		}
+		b.mu.Unlock()
+		return err
+	}
+
+	if b.onFlush != nil {
+		for i, key := range keys {
+			value, err := cmds[i].Int64()
+			if err != nil {
+				continue
+			}
+			b.onFlush(ctx, key.kind, key.streamID, value)
+		}
+	}
+	return nil
+}
+
+// Stop 停止后台刷新并提交剩余增量，用于服务优雅退出
+func (b *StatsBatcher) Stop(ctx context.Context) error {
+	b.stopOnce.Do(func() {
+		close(b.stopCh)
+	})
+
+	b.mu.Lock()
+	started := b.started
+	b.mu.Unlock()
+
+	// 等待后台刷新退出，避免与最后一次提交交错
+	if started {
+		select {
+		case <-b.doneCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return b.Flush(ctx)
+}
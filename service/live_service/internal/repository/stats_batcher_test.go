@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/internal/model"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// counterRedis 内存中的计数，TxPipeline按脚本语义只累加已存在的键，并记录每次提交
+type counterRedis struct {
+	redis.UniversalClient
+
+	mu       sync.Mutex
+	counters map[string]int64
+	execs    [][]string // 每次EXEC提交的键
+	execErr  error      // 不为nil时下一次EXEC失败
+}
+
+func newCounterRedis(counters map[string]int64) *counterRedis {
+	return &counterRedis{counters: counters}
+}
+
+func (c *counterRedis) TxPipeline() redis.Pipeliner {
+	return &counterPipeline{client: c}
+}
+
+func (c *counterRedis) value(key string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counters[key]
+}
+
+type queuedIncr struct {
+	cmd   *redis.Cmd
+	key   string
+	delta int64
+}
+
+type counterPipeline struct {
+	redis.Pipeliner
+	client *counterRedis
+	queued []queuedIncr
+}
+
+func (p *counterPipeline) Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd {
+	cmd := redis.NewCmd(ctx, "eval", script, len(keys), keys[0], args[0])
+	p.queued = append(p.queued, queuedIncr{cmd: cmd, key: keys[0], delta: args[0].(int64)})
+	return cmd
+}
+
+// Exec 与go-redis一致：返回第一个失败命令的错误，脚本返回nil时错误为redis.Nil
+func (p *counterPipeline) Exec(ctx context.Context) ([]redis.Cmder, error) {
+	c := p.client
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]string, 0, len(p.queued))
+	for _, q := range p.queued {
+		keys = append(keys, q.key)
+	}
+	c.execs = append(c.execs, keys)
+	if err := c.execErr; err != nil {
+		c.execErr = nil
+		return nil, err
+	}
+
+	var firstErr error
+	cmds := make([]redis.Cmder, 0, len(p.queued))
+	for _, q := range p.queued {
+		if value, ok := c.counters[q.key]; ok {
+			c.counters[q.key] = value + q.delta
+			q.cmd.SetVal(c.counters[q.key])
+		} else {
+			q.cmd.SetErr(redis.Nil)
+			if firstErr == nil {
+				firstErr = redis.Nil
+			}
+		}
+		cmds = append(cmds, q.cmd)
+	}
+	return cmds, firstErr
+}
+
+type flushedValue struct {
+	kind     StatsKind
+	streamID uint64
+	value    int64
+}
+
+func newTestBatcher(client *counterRedis) (*StatsBatcher, *[]flushedValue) {
+	var flushed []flushedValue
+	b := NewStatsBatcher(client, 0, nopLogger{})
+	b.OnFlush(func(ctx context.Context, kind StatsKind, streamID uint64, value int64) {
+		flushed = append(flushed, flushedValue{kind, streamID, value})
+	})
+	return b, &flushed
+}
+
+func TestStatsBatcherAggregatesIntoOneTransaction(t *testing.T) {
+	viewers1, viewers2, likes1 := model.GetLiveViewerCountCacheKey(1), model.GetLiveViewerCountCacheKey(2), model.GetLiveLikeCountKey(1)
+	client := newCounterRedis(map[string]int64{viewers1: 10, viewers2: 0, likes1: 100})
+	b, flushed := newTestBatcher(client)
+
+	for i := 0; i < 3; i++ {
+		b.IncrViewerCount(1, 1)
+	}
+	b.IncrViewerCount(1, -1)
+	b.IncrViewerCount(2, 1)
+	for i := 0; i < 50; i++ {
+		b.IncrLikeCount(1, 1)
+	}
+	b.IncrViewerCount(3, 0) // 零增量不入队
+
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if len(client.execs) != 1 || len(client.execs[0]) != 3 {
+		t.Fatalf("execs = %v, want one transaction with one command per counter", client.execs)
+	}
+	if client.value(viewers1) != 12 || client.value(viewers2) != 1 || client.value(likes1) != 150 {
+		t.Errorf("counters = %v, want 12/1/150", client.counters)
+	}
+
+	want := map[flushedValue]bool{{StatsViewerCount, 1, 12}: true, {StatsViewerCount, 2, 1}: true, {StatsLikeCount, 1, 150}: true}
+	if len(*flushed) != len(want) {
+		t.Fatalf("flushed = %v, want %d callbacks", *flushed, len(want))
+	}
+	for _, f := range *flushed {
+		if !want[f] {
+			t.Errorf("unexpected flush callback %+v", f)
+		}
+	}
+
+	// 已提交的增量不会被重复提交
+	if err := b.Flush(context.Background()); err != nil || len(client.execs) != 1 {
+		t.Errorf("second Flush = %v with %d execs, want no-op", err, len(client.execs))
+	}
+}
+
+func TestStatsBatcherSkipsMissingCounter(t *testing.T) {
+	existing := model.GetLiveViewerCountCacheKey(1)
+	client := newCounterRedis(map[string]int64{existing: 5})
+	b, flushed := newTestBatcher(client)
+
+	b.IncrViewerCount(1, 1)
+	b.IncrViewerCount(2, 1)
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush with missing counter: %v", err)
+	}
+
+	// 缺失的计数不被部分增量重建，留给读取方从数据库回填
+	if _, ok := client.counters[model.GetLiveViewerCountCacheKey(2)]; ok {
+		t.Error("missing counter was created from a partial delta")
+	}
+	if client.value(existing) != 6 {
+		t.Errorf("existing counter = %d, want 6", client.value(existing))
+	}
+	if len(*flushed) != 1 || (*flushed)[0] != (flushedValue{StatsViewerCount, 1, 6}) {
+		t.Errorf("flushed = %v, want only stream 1", *flushed)
+	}
+	if err := b.Flush(context.Background()); err != nil || len(client.execs) != 1 {
+		t.Errorf("missing counter delta was retried: %v execs", client.execs)
+	}
+}
+
+func TestStatsBatcherRetriesFailedFlush(t *testing.T) {
+	key := model.GetLiveViewerCountCacheKey(1)
+	client := newCounterRedis(map[string]int64{key: 10})
+	client.execErr = errors.New("connection reset")
+	b, flushed := newTestBatcher(client)
+
+	b.IncrViewerCount(1, 2)
+	if err := b.Flush(context.Background()); err == nil {
+		t.Fatal("Flush should report the EXEC failure")
+	}
+	if client.value(key) != 10 || len(*flushed) != 0 {
+		t.Fatalf("failed flush applied counter %d with callbacks %v", client.value(key), *flushed)
+	}
+
+	// 失败的增量与新增量合并后重试
+	b.IncrViewerCount(1, 3)
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("retry Flush: %v", err)
+	}
+	if client.value(key) != 15 {
+		t.Errorf("counter = %d, want 15", client.value(key))
+	}
+	if len(client.execs) != 2 || len(client.execs[1]) != 1 {
+		t.Errorf("execs = %v, want the retry merged into one command", client.execs)
+	}
+}
+
+func TestStatsBatcherStopFlushesPending(t *testing.T) {
+	key := model.GetLiveLikeCountKey(1)
+
+	t.Run("not started", func(t *testing.T) {
+		client := newCounterRedis(map[string]int64{key: 0})
+		b, _ := newTestBatcher(client)
+		b.IncrLikeCount(1, 4)
+		if err := b.Stop(context.Background()); err != nil {
+			t.Fatalf("Stop: %v", err)
+		}
+		if client.value(key) != 4 {
+			t.Errorf("counter = %d, want 4", client.value(key))
+		}
+	})
+
+	t.Run("started", func(t *testing.T) {
+		client := newCounterRedis(map[string]int64{key: 0})
+		b, _ := newTestBatcher(client)
+		b.Start()
+		b.IncrLikeCount(1, 7)
+		if err := b.Stop(context.Background()); err != nil {
+			t.Fatalf("Stop: %v", err)
+		}
+		if client.value(key) != 7 {
+			t.Errorf("counter = %d, want 7", client.value(key))
+		}
+		// 重复Stop不会panic
+		if err := b.Stop(context.Background()); err != nil {
+			t.Errorf("second Stop: %v", err)
+		}
+	})
+}
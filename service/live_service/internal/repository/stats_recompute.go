@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// RecomputeLiveStats 根据观看、聊天、礼物明细重新计算直播统计并覆盖直播流上的计数
+// 观看人数按去重用户计，点赞数取点过赞的观看记录数，评论数不含系统消息和已删除消息，
+// 礼物数与礼物价值只统计成功的送礼记录；分享数没有明细来源，保持不变
+func (r *liveRepository) RecomputeLiveStats(ctx context.Context, stream *model.LiveStream) (*LiveStats, error) {
+	stats := &LiveStats{
+		StreamID:   stream.ID,
+		ShareCount: stream.ShareCount,
+		Duration:   stream.Duration,
+	}
+	if stream.StartedAt != nil && stream.EndedAt != nil && stream.EndedAt.After(*stream.StartedAt) {
+		stats.Duration = uint32(stream.EndedAt.Sub(*stream.StartedAt).Seconds())
+	}
+
+	err := r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var viewers struct {
+			TotalViewers uint64
+			LikeCount    uint32
+		}
+		if err := tx.Model(&model.LiveViewer{}).
+			Select("COUNT(DISTINCT user_id) AS total_viewers, COUNT(DISTINCT CASE WHEN is_liked THEN user_id END) AS like_count").
+			Where("stream_id = ? AND deleted_at IS NULL", stream.ID).
+			Scan(&viewers).Error; err != nil {
+			return fmt.Errorf("failed to aggregate live viewers: %w", err)
+		}
+
+		var comments int64
+		if err := tx.Model(&model.LiveChat{}).
+			Where("stream_id = ? AND is_system = ? AND status = ? AND deleted_at IS NULL", stream.ID, false, 1).
+			Count(&comments).Error; err != nil {
+			return fmt.Errorf("failed to count live chats: %w", err)
+		}
+
+		var gifts struct {
+			GiftCount uint32
+			GiftValue uint64
+		}
+		if err := tx.Model(&model.LiveGift{}).
+			Select("COALESCE(SUM(gift_count), 0) AS gift_count, COALESCE(SUM(total_value), 0) AS gift_value").
+			Where("stream_id = ? AND status = ? AND deleted_at IS NULL", stream.ID, 1).
+			Scan(&gifts).Error; err != nil {
+			return fmt.Errorf("failed to aggregate live gifts: %w", err)
+		}
+
+		stats.TotalViewers = viewers.TotalViewers
+		stats.LikeCount = viewers.LikeCount
+		stats.CommentCount = uint32(comments)
+		stats.GiftCount = gifts.GiftCount
+		stats.GiftValue = gifts.GiftValue
+
+		if err := tx.Model(&model.LiveStream{}).
+			Where("id = ?", stream.ID).
+			Updates(map[string]interface{}{
+				"viewer_count":  uint32(stats.TotalViewers),
+				"like_count":    stats.LikeCount,
+				"comment_count": stats.CommentCount,
+				"gift_count":    stats.GiftCount,
+				"duration":      stats.Duration,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to update live stream stats: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/internal/model"
+)
+
+// kvRedis 内存中的字符串键值，记录写入的过期时间
+type kvRedis struct {
+	redis.UniversalClient
+	values map[string]string
+	ttls   map[string]time.Duration
+}
+
+func newKVRedis() *kvRedis {
+	return &kvRedis{values: make(map[string]string), ttls: make(map[string]time.Duration)}
+}
+
+func (c *kvRedis) Get(ctx context.Context, key string) *redis.StringCmd {
+	cmd := redis.NewStringCmd(ctx, "get", key)
+	if value, ok := c.values[key]; ok {
+		cmd.SetVal(value)
+	} else {
+		cmd.SetErr(redis.Nil)
+	}
+	return cmd
+}
+
+func (c *kvRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
+	c.values[key] = fmt.Sprint(value)
+	c.ttls[key] = expiration
+	cmd := redis.NewStatusCmd(ctx, "set", key, value)
+	cmd.SetVal("OK")
+	return cmd
+}
+
+func TestViewerCountCacheDistinguishesMissingFromZero(t *testing.T) {
+	client := newKVRedis()
+	repo := &liveRepository{redis: client}
+	ctx := context.Background()
+
+	if count, found, err := repo.GetLiveViewerCountCache(ctx, 1); err != nil || found || count != 0 {
+		t.Fatalf("missing counter = (%d, %v, %v), want not found", count, found, err)
+	}
+
+	if err := repo.SetLiveViewerCountCache(ctx, 1, 0); err != nil {
+		t.Fatalf("SetLiveViewerCountCache: %v", err)
+	}
+	if count, found, err := repo.GetLiveViewerCountCache(ctx, 1); err != nil || !found || count != 0 {
+		t.Errorf("zero counter = (%d, %v, %v), want found 0", count, found, err)
+	}
+
+	// 回填的计数到期后重新从数据库校准
+	key := model.GetLiveViewerCountCacheKey(1)
+	if client.ttls[key] != model.LiveViewerCountTTL {
+		t.Errorf("ttl = %v, want %v", client.ttls[key], model.LiveViewerCountTTL)
+	}
+}
+
+func TestViewerCountCacheRejectsCorruptValue(t *testing.T) {
+	client := newKVRedis()
+	client.values[model.GetLiveViewerCountCacheKey(1)] = "not-a-number"
+	repo := &liveRepository{redis: client}
+
+	if _, found, err := repo.GetLiveViewerCountCache(context.Background(), 1); err == nil || found {
+		t.Errorf("corrupt counter = (found %v, %v), want an error", found, err)
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+)
+
+// batchJoinRepo 在fakeLiveRepo基础上按数据库语义批量加入观看者，记录每次批量写入的用户
+type batchJoinRepo struct {
+	*fakeLiveRepo
+	presenceBatches [][]uint64
+	joinBatches     [][]uint64
+	joinErr         error
+}
+
+func (r *batchJoinRepo) TouchViewersPresence(ctx context.Context, streamID uint64, userIDs []uint64, ttl time.Duration) error {
+	r.presenceBatches = append(r.presenceBatches, userIDs)
+	for _, userID := range userIDs {
+		if err := r.TouchViewerPresence(ctx, streamID, userID, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BatchJoinLiveViewers 没有观看记录的用户创建记录，已离开的用户重新进入，仍在观看的用户不计数
+func (r *batchJoinRepo) BatchJoinLiveViewers(ctx context.Context, streamID, roomID uint64, userIDs []uint64, enterTime time.Time) (int64, error) {
+	r.joinBatches = append(r.joinBatches, userIDs)
+	if r.joinErr != nil {
+		return 0, r.joinErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.watchRecords[streamID] == nil {
+		r.watchRecords[streamID] = make(map[uint64]*model.LiveViewer)
+	}
+	var joined int64
+	for _, userID := range userIDs {
+		viewer := r.watchRecords[streamID][userID]
+		switch {
+		case viewer == nil:
+			r.watchRecords[streamID][userID] = &model.LiveViewer{StreamID: streamID, UserID: userID, RoomID: roomID, EnterTime: enterTime}
+		case viewer.ExitTime != nil:
+			viewer.ExitTime, viewer.AccruedAt, viewer.EnterTime = nil, nil, enterTime
+		default:
+			continue
+		}
+		joined++
+	}
+	return joined, nil
+}
+
+// newBatchJoinTestService 创建公开直播1的直播服务，返回记录观看人数增量的Redis
+func newBatchJoinTestService(t *testing.T) (*liveService, *batchJoinRepo, *deltaRedis) {
+	t.Helper()
+	repo := &batchJoinRepo{fakeLiveRepo: newFakeLiveRepo()}
+	stream := newLiveTestStream(repo.fakeLiveRepo)
+	stream.IsPublic = true
+	repo.putStream(stream)
+	s, stats := newPresenceTestService(repo.fakeLiveRepo)
+	s.liveRepo = repo
+	return s, repo, stats
+}
+
+// viewerDelta 提交统计后返回直播1的观看人数增量
+func viewerDelta(t *testing.T, s *liveService, stats *deltaRedis) int64 {
+	t.Helper()
+	if err := s.statsBatcher.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	return stats.deltas[model.GetLiveViewerCountCacheKey(1)]
+}
+
+func TestBatchJoinLiveRoom(t *testing.T) {
+	s, repo, stats := newBatchJoinTestService(t)
+
+	// 重复的用户ID和0被忽略
+	joined, err := s.BatchJoinLiveRoom(context.Background(), 1, []uint64{20, 21, 20, 0, 22})
+	if err != nil {
+		t.Fatalf("BatchJoinLiveRoom: %v", err)
+	}
+	if joined != 3 {
+		t.Errorf("joined = %d, want 3", joined)
+	}
+	if len(repo.joinBatches) != 1 || !equalUint64s(repo.joinBatches[0], []uint64{20, 21, 22}) {
+		t.Errorf("join batches = %v, want one batch of the unique users", repo.joinBatches)
+	}
+	for _, userID := range []uint64{20, 21, 22} {
+		if time.Until(repo.presence[1][userID]) <= 0 {
+			t.Errorf("user %d has no presence", userID)
+		}
+		if viewer := repo.watchRecords[1][userID]; viewer == nil || viewer.RoomID != 5 {
+			t.Errorf("user %d watch record = %+v, want one in room 5", userID, viewer)
+		}
+	}
+
+	// 观看人数只累加一次，并发布一条批量进入事件
+	if got := viewerDelta(t, s, stats); got != 3 {
+		t.Errorf("viewer delta = %d, want 3", got)
+	}
+	events := repo.publishedLiveEvents(model.LiveEventJoin)
+	if len(events) != 1 {
+		t.Fatalf("join events = %d, want 1", len(events))
+	}
+	var data model.LiveViewerEventData
+	decodeEventData(t, events[0], &data)
+	if data.Count != 3 {
+		t.Errorf("join event count = %d, want 3", data.Count)
+	}
+}
+
+func TestBatchJoinLiveRoomCountsOnlyNewViewers(t *testing.T) {
+	s, repo, stats := newBatchJoinTestService(t)
+	exitedAt := time.Now().Add(-time.Minute)
+	repo.watchRecords[1] = map[uint64]*model.LiveViewer{
+		20: {StreamID: 1, UserID: 20, EnterTime: time.Now().Add(-time.Hour)},
+		21: {StreamID: 1, UserID: 21, EnterTime: time.Now().Add(-time.Hour), ExitTime: &exitedAt},
+	}
+
+	joined, err := s.BatchJoinLiveRoom(context.Background(), 1, []uint64{20, 21, 22})
+	if err != nil {
+		t.Fatalf("BatchJoinLiveRoom: %v", err)
+	}
+	// 20仍在观看，21重新进入，22首次进入
+	if joined != 2 {
+		t.Errorf("joined = %d, want 2", joined)
+	}
+	if viewer := repo.watchRecords[1][21]; viewer.ExitTime != nil {
+		t.Error("user 21 was not re-entered")
+	}
+	if got := viewerDelta(t, s, stats); got != 2 {
+		t.Errorf("viewer delta = %d, want 2", got)
+	}
+
+	// 全部已在直播间时不计数也不发布事件
+	joined, err = s.BatchJoinLiveRoom(context.Background(), 1, []uint64{20, 21, 22})
+	if err != nil || joined != 0 {
+		t.Fatalf("second BatchJoinLiveRoom = (%d, %v), want 0", joined, err)
+	}
+	if got := len(repo.publishedLiveEvents(model.LiveEventJoin)); got != 1 {
+		t.Errorf("join events = %d, want only the first batch", got)
+	}
+}
+
+func TestBatchJoinLiveRoomRejectsRestrictedStreams(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(stream *model.LiveStream)
+		want   error
+	}{
+		{"private", func(stream *model.LiveStream) { stream.IsPublic = false }, ErrStreamPrivate},
+		{"password", func(stream *model.LiveStream) { stream.RoomPassword = "$2a$10$hash" }, ErrRoomPasswordRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo, _ := newBatchJoinTestService(t)
+			stream := repo.stream(1)
+			tt.modify(&stream)
+			repo.putStream(stream)
+
+			if _, err := s.BatchJoinLiveRoom(context.Background(), 1, []uint64{20}); !errors.Is(err, tt.want) {
+				t.Errorf("BatchJoinLiveRoom error = %v, want %v", err, tt.want)
+			}
+			if len(repo.presenceBatches) != 0 || len(repo.joinBatches) != 0 {
+				t.Errorf("restricted stream wrote presence %v and joins %v", repo.presenceBatches, repo.joinBatches)
+			}
+		})
+	}
+}
+
+func TestBatchJoinLiveRoomWithoutUsers(t *testing.T) {
+	s, repo, _ := newBatchJoinTestService(t)
+	for _, userIDs := range [][]uint64{nil, {0, 0}} {
+		if joined, err := s.BatchJoinLiveRoom(context.Background(), 1, userIDs); err != nil || joined != 0 {
+			t.Errorf("BatchJoinLiveRoom(%v) = (%d, %v), want 0", userIDs, joined, err)
+		}
+	}
+	if len(repo.presenceBatches) != 0 || len(repo.joinBatches) != 0 {
+		t.Errorf("empty batch wrote presence %v and joins %v", repo.presenceBatches, repo.joinBatches)
+	}
+}
+
+func TestBatchJoinLiveRoomJoinError(t *testing.T) {
+	s, repo, stats := newBatchJoinTestService(t)
+	repo.joinErr = errInjected
+
+	if _, err := s.BatchJoinLiveRoom(context.Background(), 1, []uint64{20, 21}); !errors.Is(err, errInjected) {
+		t.Fatalf("BatchJoinLiveRoom error = %v, want the join failure", err)
+	}
+	if got := viewerDelta(t, s, stats); got != 0 {
+		t.Errorf("viewer delta = %d, want none after a failed join", got)
+	}
+	if got := len(repo.publishedLiveEvents(model.LiveEventJoin)); got != 0 {
+		t.Errorf("join events = %d, want none", got)
+	}
+}
+
+func TestBatchJoinLiveRoomStreamNotFound(t *testing.T) {
+	s, repo, _ := newBatchJoinTestService(t)
+	if _, err := s.BatchJoinLiveRoom(context.Background(), 99, []uint64{20}); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("BatchJoinLiveRoom error = %v, want ErrStreamNotFound", err)
+	}
+	if len(repo.joinBatches) != 0 {
+		t.Errorf("join batches = %v, want none", repo.joinBatches)
+	}
+}
+
+func equalUint64s(got, want []uint64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
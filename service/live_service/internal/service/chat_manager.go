@@ -2,6 +2,11 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"common/keywordfilter"
 
 	"live_service/internal/config"
 	"live_service/internal/model"
@@ -14,13 +19,13 @@ type ChatManager interface {
 	// 消息管理
 	SendMessage(ctx context.Context, message *model.LiveChat) error
 	DeleteMessage(ctx context.Context, messageID uint64, streamID uint64) error
-	GetMessageList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveChat, int64, error)
+	GetMessageList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error)
 
 	// 消息审核
 	ModerateMessage(ctx context.Context, message *model.LiveChat) (bool, string)
 
 	// 用户管理
-	MuteUser(ctx context.Context, streamID, userID uint64, duration uint32, reason string) error
+	MuteUser(ctx context.Context, streamID, userID, mutedBy uint64, duration uint32, reason string) error
 	UnmuteUser(ctx context.Context, streamID, userID uint64) error
 	IsUserMuted(ctx context.Context, streamID, userID uint64) (bool, uint32)
 
@@ -31,6 +36,7 @@ type ChatManager interface {
 
 	// 系统消息
 	SendSystemMessage(ctx context.Context, streamID uint64, content string) error
+	SendGiftNotice(ctx context.Context, gift *model.LiveGift, roomID uint64) error
 	SendWelcomeMessage(ctx context.Context, streamID, userID uint64) error
 
 	// 消息推送
@@ -68,32 +74,31 @@ type MuteInfo struct {
 
 // chatManager 聊天管理器实现
 type chatManager struct {
-	config   *config.Config
-	logger   logger.Logger
-	liveRepo repository.LiveRepository
+	config      *config.Config
+	logger      logger.Logger
+	liveRepo    repository.LiveRepository
+	bannedWords *keywordfilter.Filter
 }
 
 // NewChatManager 创建聊天管理器
 func NewChatManager(cfg *config.Config, log logger.Logger, repo repository.LiveRepository) ChatManager {
 	return &chatManager{
-		config:   cfg,
-		logger:   log,
-		liveRepo: repo,
+		config:      cfg,
+		logger:      log,
+		liveRepo:    repo,
+		bannedWords: keywordfilter.New(cfg.Live.Chat.BannedWords),
 	}
 }
 
-// SendMessage 发送消息
+// SendMessage 发送消息，调用方需先完成禁言校验
 func (m *chatManager) SendMessage(ctx context.Context, message *model.LiveChat) error {
 	m.logger.Info("Sending chat message", "streamID", message.StreamID, "userID", message.UserID)
 
-	// TODO: 实现发送消息逻辑
-	// 这里应该包含：
-	// 1. 验证用户权限（是否被禁言）
-	// 2. 内容审核
-	// 3. 创建消息记录
-	// 4. 广播消息给其他用户
-	// 5. 更新聊天统计
+	if err := m.liveRepo.CreateLiveChat(ctx, message); err != nil {
+		return fmt.Errorf("failed to create chat message: %w", err)
+	}
 
+	// TODO: 广播消息给其他用户并更新聊天统计
 	return nil
 }
 
@@ -112,45 +117,49 @@ func (m *chatManager) DeleteMessage(ctx context.Context, messageID uint64, strea
 }
 
 // GetMessageList 获取消息列表
-func (m *chatManager) GetMessageList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveChat, int64, error) {
-	m.logger.Info("Getting chat message list", "streamID", streamID, "page", page, "pageSize", pageSize)
+func (m *chatManager) GetMessageList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error) {
+	m.logger.Info("Getting chat message list", "streamID", streamID, "page", page, "pageSize", pageSize, "oldestFirst", oldestFirst)
 
-	// TODO: 实现获取消息列表逻辑
-	// 这里应该包含：
-	// 1. 查询消息记录
-	// 2. 过滤已删除的消息
-	// 3. 按时间排序
-	// 4. 分页查询
-	// 5. 返回消息列表
-
-	return []*model.LiveChat{}, 0, nil
+	chats, total, err := m.liveRepo.GetLiveChatList(ctx, streamID, page, pageSize, oldestFirst)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get chat list: %w", err)
+	}
+	return chats, total, nil
 }
 
-// ModerateMessage 审核消息
+// ModerateMessage 审核消息，命中禁用词时拒绝并返回命中的关键词
 func (m *chatManager) ModerateMessage(ctx context.Context, message *model.LiveChat) (bool, string) {
 	m.logger.Debug("Moderating chat message", "messageID", message.ID)
 
-	// TODO: 实现消息审核逻辑
-	// 这里应该包含：
-	// 1. 关键词过滤
-	// 2. 敏感内容检测
-	// 3. 垃圾信息识别
-	// 4. 返回审核结果和原因
+	if matches := m.bannedWords.Match(message.Content); len(matches) > 0 {
+		return false, fmt.Sprintf("banned words: %s", strings.Join(keywordfilter.Keywords(matches), ","))
+	}
 
+	// TODO: 敏感内容检测和垃圾信息识别
 	return true, ""
 }
 
-// MuteUser 禁言用户
-func (m *chatManager) MuteUser(ctx context.Context, streamID, userID uint64, duration uint32, reason string) error {
-	m.logger.Info("Muting user", "streamID", streamID, "userID", userID, "duration", duration)
+// MuteUser 禁言用户，duration为禁言秒数，0表示本场直播内永久禁言
+func (m *chatManager) MuteUser(ctx context.Context, streamID, userID, mutedBy uint64, duration uint32, reason string) error {
+	m.logger.Info("Muting user", "streamID", streamID, "userID", userID, "mutedBy", mutedBy, "duration", duration)
+
+	now := time.Now()
+	mute := &model.LiveChatMuteCache{
+		StreamID:  streamID,
+		UserID:    userID,
+		MutedBy:   mutedBy,
+		Reason:    reason,
+		StartTime: now.Unix(),
+	}
 
-	// TODO: 实现禁言用户逻辑
-	// 这里应该包含：
-	// 1. 验证禁言权限
-	// 2. 创建禁言记录
-	// 3. 设置禁言缓存
-	// 4. 发送禁言通知
+	ttl := time.Duration(duration) * time.Second
+	if duration > 0 {
+		mute.EndTime = now.Add(ttl).Unix()
+	}
 
+	if err := m.liveRepo.SetChatMute(ctx, mute, ttl); err != nil {
+		return fmt.Errorf("failed to set chat mute: %w", err)
+	}
 	return nil
 }
 
@@ -158,27 +167,34 @@ func (m *chatManager) MuteUser(ctx context.Context, streamID, userID uint64, dur
 func (m *chatManager) UnmuteUser(ctx context.Context, streamID, userID uint64) error {
 	m.logger.Info("Unmuting user", "streamID", streamID, "userID", userID)
 
-	// TODO: 实现解除禁言逻辑
-	// 这里应该包含：
-	// 1. 验证操作权限
-	// 2. 删除禁言记录
-	// 3. 清除禁言缓存
-	// 4. 发送解除通知
-
+	if err := m.liveRepo.DeleteChatMute(ctx, streamID, userID); err != nil {
+		return fmt.Errorf("failed to delete chat mute: %w", err)
+	}
 	return nil
 }
 
-// IsUserMuted 检查用户是否被禁言
+// IsUserMuted 检查用户是否被禁言，返回禁言状态和剩余秒数(永久禁言时为0)
+// 查询禁言记录失败时放行，避免Redis故障导致整个直播间无法聊天
 func (m *chatManager) IsUserMuted(ctx context.Context, streamID, userID uint64) (bool, uint32) {
 	m.logger.Debug("Checking if user is muted", "streamID", streamID, "userID", userID)
 
-	// TODO: 实现检查禁言状态逻辑
-	// 这里应该包含：
-	// 1. 查询禁言记录
-	// 2. 检查禁言时间
-	// 3. 返回禁言状态和剩余时间
+	mute, err := m.liveRepo.GetChatMute(ctx, streamID, userID)
+	if err != nil {
+		m.logger.Error("Failed to get chat mute", "streamID", streamID, "userID", userID, "error", err)
+		return false, 0
+	}
+	if mute == nil {
+		return false, 0
+	}
+	if mute.EndTime == 0 {
+		return true, 0
+	}
 
-	return false, 0
+	remaining := mute.EndTime - time.Now().Unix()
+	if remaining <= 0 {
+		return false, 0
+	}
+	return true, uint32(remaining)
 }
 
 // JoinChatRoom 加入聊天室
@@ -228,17 +244,40 @@ func (m *chatManager) GetChatRoomStats(ctx context.Context, streamID uint64) (*C
 	}, nil
 }
 
-// SendSystemMessage 发送系统消息
+// SendSystemMessage 发送系统消息，系统消息不属于任何用户
 func (m *chatManager) SendSystemMessage(ctx context.Context, streamID uint64, content string) error {
 	m.logger.Info("Sending system message", "streamID", streamID)
 
-	// TODO: 实现发送系统消息逻辑
-	// 这里应该包含：
-	// 1. 创建系统消息
-	// 2. 广播给所有用户
-	// 3. 保存消息记录
+	stream, err := m.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		return fmt.Errorf("failed to get live stream: %w", err)
+	}
+	return m.SendMessage(ctx, &model.LiveChat{
+		StreamID:    streamID,
+		RoomID:      stream.RoomID,
+		Content:     content,
+		ContentType: model.ContentTypeSystem,
+		IsSystem:    true,
+		Status:      1,
+	})
+}
 
-	return nil
+// SendGiftNotice 在聊天中发送送礼通知，消息归属送礼用户
+func (m *chatManager) SendGiftNotice(ctx context.Context, gift *model.LiveGift, roomID uint64) error {
+	m.logger.Debug("Sending gift notice", "streamID", gift.StreamID, "userID", gift.UserID, "giftID", gift.GiftID)
+
+	return m.SendMessage(ctx, &model.LiveChat{
+		StreamID:    gift.StreamID,
+		UserID:      gift.UserID,
+		RoomID:      roomID,
+		Content:     fmt.Sprintf("送出 %s x%d", gift.GiftName, gift.GiftCount),
+		ContentType: model.ContentTypeGiftNotice,
+		IsGift:      true,
+		GiftID:      gift.GiftID,
+		GiftName:    gift.GiftName,
+		GiftValue:   gift.TotalValue,
+		Status:      1,
+	})
 }
 
 // SendWelcomeMessage 发送欢迎消息
@@ -270,14 +309,12 @@ func (m *chatManager) BroadcastMessage(ctx context.Context, message *model.LiveC
 func (m *chatManager) GetChatHistory(ctx context.Context, streamID uint64, startTime, endTime int64, page, pageSize int) ([]*model.LiveChat, int64, error) {
 	m.logger.Info("Getting chat history", "streamID", streamID, "startTime", startTime, "endTime", endTime)
 
-	// TODO: 实现获取聊天记录逻辑
-	// 这里应该包含：
-	// 1. 按时间范围查询消息
-	// 2. 过滤条件处理
-	// 3. 分页查询
-	// 4. 返回历史记录
-
-	return []*model.LiveChat{}, 0, nil
+	// 超过保留期的消息由仓库从归档表中读取
+	chats, total, err := m.liveRepo.GetLiveChatHistory(ctx, streamID, startTime, endTime, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get chat history: %w", err)
+	}
+	return chats, total, nil
 }
 
 // AddBannedWord 添加禁用词
@@ -309,8 +346,5 @@ func (m *chatManager) RemoveBannedWord(ctx context.Context, word string) error {
 func (m *chatManager) GetBannedWords(ctx context.Context) ([]string, error) {
 	m.logger.Info("Getting banned words")
 
-	// TODO: 实现获取禁用词列表逻辑
-	// 这里应该返回当前的禁用词列表
-
-	return []string{}, nil
+	return m.bannedWords.Keywords(), nil
 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// MuteViewer 在直播间内禁言观众，duration为禁言秒数，0表示本场直播内永久禁言
+// 仅主播本人或平台管理员可操作
+func (s *liveService) MuteViewer(ctx context.Context, streamID, operatorID, userID uint64, duration uint32, reason string) error {
+	s.logger.Info("Muting viewer", "streamID", streamID, "operatorID", operatorID, "userID", userID, "duration", duration)
+
+	stream, err := s.getModeratableStream(ctx, streamID, operatorID)
+	if err != nil {
+		return err
+	}
+	if userID == stream.UserID {
+		return ErrCannotMuteStreamer
+	}
+
+	return s.chatManager.MuteUser(ctx, streamID, userID, operatorID, duration, reason)
+}
+
+// UnmuteViewer 解除观众禁言，仅主播本人或平台管理员可操作
+func (s *liveService) UnmuteViewer(ctx context.Context, streamID, operatorID, userID uint64) error {
+	s.logger.Info("Unmuting viewer", "streamID", streamID, "operatorID", operatorID, "userID", userID)
+
+	if _, err := s.getModeratableStream(ctx, streamID, operatorID); err != nil {
+		return err
+	}
+
+	return s.chatManager.UnmuteUser(ctx, streamID, userID)
+}
+
+// getModeratableStream 获取直播流并校验操作者是否有管理聊天的权限
+func (s *liveService) getModeratableStream(ctx context.Context, streamID, operatorID uint64) (*model.LiveStream, error) {
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+
+	if stream.UserID != operatorID && !s.config.Live.Chat.IsAdmin(operatorID) {
+		return nil, ErrStreamPermissionDenied
+	}
+	return stream, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// 聊天记录保留默认配置
+const (
+	defaultChatRetainFor         = 72 * time.Hour
+	defaultChatRetentionInterval = 10 * time.Minute
+	defaultChatRetentionBatch    = 1000
+	chatRetentionStreamsPerRun   = 100 // 每次最多处理的直播数，其余留到下次执行
+)
+
+// ApplyChatRetention 将已结束直播中超过保留期的聊天消息移入归档表或直接删除
+// 直播中的聊天不受影响；每个直播分批处理，单个直播失败不影响其他直播
+func (s *liveService) ApplyChatRetention(ctx context.Context) error {
+	cfg := s.config.Live.Chat.Retention
+	retainFor := cfg.RetainFor
+	if retainFor <= 0 {
+		retainFor = defaultChatRetainFor
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultChatRetentionBatch
+	}
+
+	before := time.Now().Add(-retainFor)
+	streamIDs, err := s.liveRepo.ListChatRetentionStreams(ctx, before, chatRetentionStreamsPerRun)
+	if err != nil {
+		return fmt.Errorf("failed to list chat retention streams: %w", err)
+	}
+
+	for _, streamID := range streamIDs {
+		var total int64
+		for {
+			moved, err := s.liveRepo.ArchiveLiveChats(ctx, streamID, before, batchSize, cfg.Archive)
+			if err != nil {
+				s.logger.Warn("Failed to apply chat retention", "streamID", streamID, "error", err)
+				break
+			}
+			total += moved
+			if moved < int64(batchSize) {
+				break
+			}
+		}
+		if total > 0 {
+			s.logger.Info("Chat retention applied", "streamID", streamID, "count", total, "archived", cfg.Archive)
+		}
+	}
+	return nil
+}
+
+// startChatRetention 启动后台聊天记录清理，未开启时不启动
+func (s *liveService) startChatRetention() {
+	if !s.config.Live.Chat.Retention.Enabled {
+		return
+	}
+	interval := s.config.Live.Chat.Retention.Interval
+	if interval <= 0 {
+		interval = defaultChatRetentionInterval
+	}
+
+	s.retentionStop = make(chan struct{})
+	s.retentionDone = make(chan struct{})
+	go func() {
+		defer close(s.retentionDone)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if err := s.ApplyChatRetention(ctx); err != nil {
+					s.logger.Warn("Failed to apply chat retention", "error", err)
+				}
+				cancel()
+			case <-s.retentionStop:
+				return
+			}
+		}
+	}()
+}
+
+// stopChatRetention 停止后台聊天记录清理并等待退出
+func (s *liveService) stopChatRetention(ctx context.Context) error {
+	if s.retentionStop == nil {
+		return nil
+	}
+	s.retentionStopOnce.Do(func() {
+		close(s.retentionStop)
+	})
+	select {
+	case <-s.retentionDone:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"live_service/internal/repository"
+)
+
+// archiveCall 一次ArchiveLiveChats调用的参数
+type archiveCall struct {
+	streamID  uint64
+	before    time.Time
+	batchSize int
+	archive   bool
+}
+
+// retentionRepo 记录聊天记录保留的调用，pending为每个直播待处理的消息数
+type retentionRepo struct {
+	repository.LiveRepository
+
+	mu         sync.Mutex
+	pending    map[uint64]int64
+	listErr    error
+	archiveErr map[uint64]error
+	listBefore time.Time
+	listLimit  int
+	calls      []archiveCall
+}
+
+func (r *retentionRepo) ListChatRetentionStreams(ctx context.Context, before time.Time, limit int) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listBefore, r.listLimit = before, limit
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	var ids []uint64
+	for id := uint64(1); id <= uint64(len(r.pending)); id++ {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r *retentionRepo) ArchiveLiveChats(ctx context.Context, streamID uint64, before time.Time, batchSize int, archive bool) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, archiveCall{streamID: streamID, before: before, batchSize: batchSize, archive: archive})
+	if err := r.archiveErr[streamID]; err != nil {
+		return 0, err
+	}
+	moved := r.pending[streamID]
+	if moved > int64(batchSize) {
+		moved = int64(batchSize)
+	}
+	r.pending[streamID] -= moved
+	return moved, nil
+}
+
+// callsFor 返回指定直播的调用次数
+func (r *retentionRepo) callsFor(streamID uint64) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, c := range r.calls {
+		if c.streamID == streamID {
+			n++
+		}
+	}
+	return n
+}
+
+func newRetentionTestService(repo *retentionRepo) *liveService {
+	s := newTestLiveService(newFakeLiveRepo())
+	s.liveRepo = repo
+	return s
+}
+
+func TestApplyChatRetentionArchivesInBatches(t *testing.T) {
+	// 直播1有25条、直播2正好10条、直播3没有待处理的消息
+	repo := &retentionRepo{pending: map[uint64]int64{1: 25, 2: 10, 3: 0}}
+	s := newRetentionTestService(repo)
+	s.config.Live.Chat.Retention.RetainFor = time.Hour
+	s.config.Live.Chat.Retention.BatchSize = 10
+	s.config.Live.Chat.Retention.Archive = true
+
+	begin := time.Now()
+	if err := s.ApplyChatRetention(context.Background()); err != nil {
+		t.Fatalf("ApplyChatRetention: %v", err)
+	}
+
+	// 满批时继续处理，不足一批时结束
+	for id, want := range map[uint64]int{1: 3, 2: 2, 3: 1} {
+		if got := repo.callsFor(id); got != want {
+			t.Errorf("stream %d archived in %d batches, want %d", id, got, want)
+		}
+		if repo.pending[id] != 0 {
+			t.Errorf("stream %d has %d chats left", id, repo.pending[id])
+		}
+	}
+	cutoff := begin.Add(-time.Hour)
+	if repo.listBefore.Before(cutoff) || repo.listBefore.After(time.Now().Add(-time.Hour)) || repo.listLimit != chatRetentionStreamsPerRun {
+		t.Errorf("listed streams before %v limit %d, want about %v limit %d", repo.listBefore, repo.listLimit, cutoff, chatRetentionStreamsPerRun)
+	}
+	for _, c := range repo.calls {
+		if !c.before.Equal(repo.listBefore) || c.batchSize != 10 || !c.archive {
+			t.Errorf("archive call = %+v, want the same cutoff, batch 10 and archive", c)
+		}
+	}
+}
+
+func TestApplyChatRetentionDefaults(t *testing.T) {
+	repo := &retentionRepo{pending: map[uint64]int64{1: 5}}
+	s := newRetentionTestService(repo)
+
+	begin := time.Now()
+	if err := s.ApplyChatRetention(context.Background()); err != nil {
+		t.Fatalf("ApplyChatRetention: %v", err)
+	}
+	if repo.listBefore.After(begin.Add(-defaultChatRetainFor).Add(time.Second)) || repo.listBefore.Before(begin.Add(-defaultChatRetainFor)) {
+		t.Errorf("cutoff = %v, want %v before now", repo.listBefore, defaultChatRetainFor)
+	}
+	// 未开启归档时直接删除
+	if len(repo.calls) != 1 || repo.calls[0].batchSize != defaultChatRetentionBatch || repo.calls[0].archive {
+		t.Errorf("archive calls = %+v, want one delete with the default batch size", repo.calls)
+	}
+}
+
+func TestApplyChatRetentionErrors(t *testing.T) {
+	t.Run("list error", func(t *testing.T) {
+		repo := &retentionRepo{listErr: errInjected}
+		if err := newRetentionTestService(repo).ApplyChatRetention(context.Background()); err == nil {
+			t.Error("ApplyChatRetention succeeded although listing streams failed")
+		}
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		repo := &retentionRepo{
+			pending:    map[uint64]int64{1: 5, 2: 5},
+			archiveErr: map[uint64]error{1: errInjected},
+		}
+		// 单个直播失败不影响其他直播
+		if err := newRetentionTestService(repo).ApplyChatRetention(context.Background()); err != nil {
+			t.Fatalf("ApplyChatRetention: %v", err)
+		}
+		if repo.callsFor(1) != 1 || repo.pending[2] != 0 {
+			t.Errorf("calls = %+v, want stream 1 tried once and stream 2 cleaned", repo.calls)
+		}
+	})
+}
+
+func TestChatRetentionTask(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		s := newRetentionTestService(&retentionRepo{})
+		s.startChatRetention()
+		if s.retentionStop != nil {
+			t.Error("retention task started although disabled")
+		}
+		if err := s.stopChatRetention(context.Background()); err != nil {
+			t.Errorf("stopChatRetention: %v", err)
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		repo := &retentionRepo{pending: map[uint64]int64{1: 5}}
+		s := newRetentionTestService(repo)
+		s.config.Live.Chat.Retention.Enabled = true
+		s.config.Live.Chat.Retention.Interval = 5 * time.Millisecond
+		s.startChatRetention()
+
+		deadline := time.Now().Add(time.Second)
+		for repo.callsFor(1) == 0 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if repo.callsFor(1) == 0 {
+			t.Error("retention task did not run")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := s.stopChatRetention(ctx); err != nil {
+			t.Fatalf("stopChatRetention: %v", err)
+		}
+		// 重复停止不会panic
+		if err := s.stopChatRetention(ctx); err != nil {
+			t.Errorf("second stopChatRetention: %v", err)
+		}
+	})
+}
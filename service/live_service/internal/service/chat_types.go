@@ -0,0 +1,47 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"live_service/internal/model"
+)
+
+// 聊天消息类型错误
+var (
+	ErrChatTypeInvalid    = errors.New("unsupported chat content type")
+	ErrChatTypeNotAllowed = errors.New("chat content type can only be sent by the server")
+	ErrChatContentTooLong = errors.New("chat content is too long")
+	ErrChatEmojiInvalid   = errors.New("emoji is not allowed")
+)
+
+// validateClientChat 校验客户端发送的聊天消息，返回规范化后的消息类型
+// 未指定类型时视为文本消息；system和gift_notice只能由服务端发送
+func (s *liveService) validateClientChat(contentType, content string) (string, error) {
+	if contentType == "" {
+		contentType = model.ContentTypeText
+	}
+
+	switch contentType {
+	case model.ContentTypeText:
+		if strings.TrimSpace(content) == "" {
+			return "", ErrChatContentEmpty
+		}
+		if utf8.RuneCountInString(content) > s.config.Live.Chat.TextLengthLimit() {
+			return "", ErrChatContentTooLong
+		}
+	case model.ContentTypeEmoji:
+		if strings.TrimSpace(content) == "" {
+			return "", ErrChatContentEmpty
+		}
+		if !s.config.Live.Chat.IsAllowedEmoji(content) {
+			return "", ErrChatEmojiInvalid
+		}
+	case model.ContentTypeSystem, model.ContentTypeGiftNotice:
+		return "", ErrChatTypeNotAllowed
+	default:
+		return "", ErrChatTypeInvalid
+	}
+	return contentType, nil
+}
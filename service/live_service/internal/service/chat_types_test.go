@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestSendLiveChatContentTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		content     string
+		wantType    string
+		wantErr     error
+	}{
+		{"default text", "", "你好", model.ContentTypeText, nil},
+		{"text", model.ContentTypeText, "hello", model.ContentTypeText, nil},
+		// 按字符而不是字节计算长度
+		{"text at limit", model.ContentTypeText, strings.Repeat("好", 200), model.ContentTypeText, nil},
+		{"text too long", model.ContentTypeText, strings.Repeat("好", 201), "", ErrChatContentTooLong},
+		{"blank text", "", "  ", "", ErrChatContentEmpty},
+		{"emoji", model.ContentTypeEmoji, "[smile]", model.ContentTypeEmoji, nil},
+		{"unknown emoji", model.ContentTypeEmoji, "[wink]", "", ErrChatEmojiInvalid},
+		{"blank emoji", model.ContentTypeEmoji, " ", "", ErrChatContentEmpty},
+		{"system", model.ContentTypeSystem, "直播即将结束", "", ErrChatTypeNotAllowed},
+		{"gift notice", model.ContentTypeGiftNotice, "送出 火箭 x1", "", ErrChatTypeNotAllowed},
+		{"image", "image", "https://cdn.example.com/1.png", "", ErrChatTypeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeLiveRepo()
+			newLiveTestStream(repo)
+			s := newTestLiveService(repo)
+
+			chat, err := s.SendLiveChat(context.Background(), 1, 20, tt.content, tt.contentType)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || chat != nil {
+					t.Errorf("SendLiveChat = (%+v, %v), want %v", chat, err, tt.wantErr)
+				}
+				if len(repo.chats) != 0 {
+					t.Errorf("stored %d chats, want none", len(repo.chats))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SendLiveChat: %v", err)
+			}
+			if chat.ContentType != tt.wantType || len(repo.chats) != 1 || repo.chats[0].ContentType != tt.wantType {
+				t.Errorf("chat type = %s stored %+v, want one %s chat", chat.ContentType, repo.chats, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestSendLiveChatConfiguredLimits(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	s.config.Live.Chat.Emojis = []string{"[wink]"}
+	s.config.Live.Chat.MaxTextLength = 5
+
+	tests := []struct {
+		contentType string
+		content     string
+		want        error
+	}{
+		{model.ContentTypeEmoji, "[wink]", nil},
+		// 配置表情列表后默认表情不再可用
+		{model.ContentTypeEmoji, "[smile]", ErrChatEmojiInvalid},
+		{model.ContentTypeText, "12345", nil},
+		{model.ContentTypeText, "123456", ErrChatContentTooLong},
+	}
+	for _, tt := range tests {
+		if _, err := s.SendLiveChat(context.Background(), 1, 20, tt.content, tt.contentType); !errors.Is(err, tt.want) {
+			t.Errorf("SendLiveChat(%s %q) = %v, want %v", tt.contentType, tt.content, err, tt.want)
+		}
+	}
+}
+
+func TestSendSystemMessage(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	if err := s.chatManager.SendSystemMessage(context.Background(), 1, "直播即将结束"); err != nil {
+		t.Fatalf("SendSystemMessage: %v", err)
+	}
+	if len(repo.chats) != 1 {
+		t.Fatalf("stored %d chats, want 1", len(repo.chats))
+	}
+	chat := repo.chats[0]
+	if chat.ContentType != model.ContentTypeSystem || !chat.IsSystem || chat.UserID != 0 || chat.RoomID != 5 || chat.Content != "直播即将结束" {
+		t.Errorf("chat = %+v, want a system message in room 5 without a user", chat)
+	}
+
+	if err := s.chatManager.SendSystemMessage(context.Background(), 2, "不存在的直播"); err == nil {
+		t.Error("SendSystemMessage to a missing stream succeeded")
+	}
+}
+
+func TestSendLiveGiftSendsGiftNotice(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 2, "req-1")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	if len(repo.chats) != 1 {
+		t.Fatalf("stored %d chats, want 1 gift notice", len(repo.chats))
+	}
+	chat := repo.chats[0]
+	if chat.ContentType != model.ContentTypeGiftNotice || !chat.IsGift || chat.UserID != 20 || chat.RoomID != 5 {
+		t.Errorf("chat = %+v, want a gift notice from user 20 in room 5", chat)
+	}
+	if chat.GiftID != gift.GiftID || chat.GiftValue != gift.TotalValue || !strings.Contains(chat.Content, "x2") {
+		t.Errorf("chat = %+v, want the notice of gift %+v", chat, gift)
+	}
+}
+
+func TestSendLiveGiftRollsBackOnGiftNoticeError(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	repo.createChatErr = errInjected
+	s := newTestLiveService(repo)
+
+	// 送礼通知与礼物记录在同一事务中写入
+	if gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); !errors.Is(err, errInjected) || gift != nil {
+		t.Errorf("SendLiveGift = (%+v, %v), want the gift notice error", gift, err)
+	}
+	if len(repo.gifts) != 0 || indexOf(repo.events, eventRollback) < 0 {
+		t.Errorf("gifts = %+v events = %v, want the gift rolled back", repo.gifts, repo.events)
+	}
+}
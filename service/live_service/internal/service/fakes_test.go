@@ -0,0 +1,823 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+
+	"live_service/internal/config"
+	"live_service/internal/model"
+	"live_service/internal/repository"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// 副作用事件，按发生顺序记录在fakeLiveRepo.events中
+const (
+	eventCommit               = "commit"
+	eventRollback             = "rollback"
+	eventPublish              = "publish"
+	eventDeleteStreamCache    = "delete_stream_cache"
+	eventDeleteGiftStatsCache = "delete_gift_stats_cache"
+)
+
+// fakeLiveRepo 内存实现的直播仓库
+// 只实现测试用到的方法，调用未实现的方法会因嵌入的nil接口panic，提示测试需要补充实现；
+// Transaction在fn失败时恢复事务开始前的数据，模拟数据库回滚
+type fakeLiveRepo struct {
+	repository.LiveRepository
+
+	mu     sync.Mutex
+	events []string
+	nextID uint64
+
+	streams      map[uint64]model.LiveStream
+	gifts        []model.LiveGift
+	chats        []model.LiveChat
+	combos       map[string]int64
+	giftRequests map[string]*model.LiveGift
+	locks        map[uint64]bool
+	balances     map[uint64]uint64
+
+	// accumulateCalls 累加直播间统计的次数，每次下播结算累加一次
+	accumulateCalls int
+
+	// Redis中的直播信息和观看人数缓存，没有写入的直播视为缓存未命中
+	streamCache  map[uint64]model.LiveStream
+	viewerCounts map[uint64]int64
+	// dbViewerCounts 数据库中未离开的观看记录数
+	dbViewerCounts map[uint64]int64
+	// getStreamCalls 从数据库读取直播信息的次数
+	getStreamCalls int
+	// viewerCountCacheErr 不为nil时读取观看人数缓存失败
+	viewerCountCacheErr error
+
+	// dailyScores 每日排行榜有序集合，key为排行榜键，成员为主播ID或直播流ID
+	dailyScores map[string]map[uint64]int64
+	// dailyBoardsCache 每日排行榜结果缓存，key为日期
+	dailyBoardsCache map[string]*repository.DailyLeaderboards
+
+	// presence 观看者心跳过期时间，key为直播流ID
+	presence map[uint64]map[uint64]time.Time
+	// viewerExits 记录离开的观看者，key为直播流ID
+	viewerExits map[uint64]map[uint64]bool
+	// accrueCalls 心跳累计观看时长的次数，accrueInterval 最近一次传入的最小累计间隔
+	accrueCalls    int
+	accrueInterval time.Duration
+	// watchRecords 观看记录，key为直播流ID和用户ID，存在时按数据库语义累计观看时长
+	watchRecords map[uint64]map[uint64]*model.LiveViewer
+
+	// liveEvents 经Redis发布的直播间事件
+	liveEvents []model.LiveEvent
+	// publishErr 不为nil时发布直播间事件失败
+	publishErr error
+
+	// 注入的写入失败
+	createChatErr     error
+	accumulateErr     error
+	setGiftRequestErr error
+	// giftStatsErr 不为nil时汇总礼物统计失败
+	giftStatsErr error
+	// giftStatsCache Redis中的礼物统计缓存，giftStatsQueries 从礼物记录汇总统计的次数
+	giftStatsCache   map[uint64]repository.GiftStats
+	giftStatsQueries int
+
+	// expireGiftRequests 读取送礼幂等记录前先删除记录的次数，模拟记录在占用与读取之间过期
+	expireGiftRequests int
+
+	// afterGetByStreamKey 按推流密钥读取直播之后调用，用于模拟读取与更新之间并发的强制停播
+	afterGetByStreamKey func()
+}
+
+func newFakeLiveRepo() *fakeLiveRepo {
+	return &fakeLiveRepo{
+		nextID:         100,
+		streams:        make(map[uint64]model.LiveStream),
+		combos:         make(map[string]int64),
+		giftRequests:   make(map[string]*model.LiveGift),
+		giftStatsCache: make(map[uint64]repository.GiftStats),
+		locks:          make(map[uint64]bool),
+		balances:       make(map[uint64]uint64),
+
+		streamCache:    make(map[uint64]model.LiveStream),
+		viewerCounts:   make(map[uint64]int64),
+		dbViewerCounts: make(map[uint64]int64),
+
+		dailyScores:      make(map[string]map[uint64]int64),
+		dailyBoardsCache: make(map[string]*repository.DailyLeaderboards),
+
+		presence:    make(map[uint64]map[uint64]time.Time),
+		viewerExits: make(map[uint64]map[uint64]bool),
+
+		watchRecords: make(map[uint64]map[uint64]*model.LiveViewer),
+	}
+}
+
+// fakeSnapshot 事务开始时的数据快照
+type fakeSnapshot struct {
+	streams  map[uint64]model.LiveStream
+	gifts    []model.LiveGift
+	chats    []model.LiveChat
+	balances map[uint64]uint64
+}
+
+func (r *fakeLiveRepo) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// eventsSnapshot 返回目前为止记录的副作用事件
+func (r *fakeLiveRepo) eventsSnapshot() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func (r *fakeLiveRepo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	r.mu.Lock()
+	snap := fakeSnapshot{
+		streams:  make(map[uint64]model.LiveStream, len(r.streams)),
+		gifts:    append([]model.LiveGift(nil), r.gifts...),
+		chats:    append([]model.LiveChat(nil), r.chats...),
+		balances: make(map[uint64]uint64, len(r.balances)),
+	}
+	for id, stream := range r.streams {
+		snap.streams[id] = stream
+	}
+	for id, balance := range r.balances {
+		snap.balances[id] = balance
+	}
+	r.mu.Unlock()
+
+	if err := fn(ctx); err != nil {
+		r.mu.Lock()
+		r.streams, r.gifts, r.chats, r.balances = snap.streams, snap.gifts, snap.chats, snap.balances
+		r.mu.Unlock()
+		r.record(eventRollback)
+		return err
+	}
+	r.record(eventCommit)
+	return nil
+}
+
+func (r *fakeLiveRepo) putStream(stream model.LiveStream) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.streams[stream.ID] = stream
+}
+
+func (r *fakeLiveRepo) stream(id uint64) model.LiveStream {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.streams[id]
+}
+
+func (r *fakeLiveRepo) GetLiveStream(ctx context.Context, streamID uint64) (*model.LiveStream, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.getStreamCalls++
+	stream, ok := r.streams[streamID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &stream, nil
+}
+
+func (r *fakeLiveRepo) GetLiveStreamByStreamKey(ctx context.Context, streamKey string) (*model.LiveStream, error) {
+	stream, err := r.getLiveStreamByStreamKey(streamKey)
+	if hook := r.afterGetByStreamKey; hook != nil && err == nil {
+		r.afterGetByStreamKey = nil
+		hook()
+	}
+	return stream, err
+}
+
+func (r *fakeLiveRepo) getLiveStreamByStreamKey(streamKey string) (*model.LiveStream, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stream := range r.streams {
+		if stream.StreamKey == streamKey {
+			stream := stream
+			return &stream, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeLiveRepo) UpdateLiveStream(ctx context.Context, stream *model.LiveStream) error {
+	r.putStream(*stream)
+	return nil
+}
+
+func (r *fakeLiveRepo) UpdateStreamKey(ctx context.Context, streamID uint64, streamKey string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stream, ok := r.streams[streamID]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	stream.StreamKey = streamKey
+	r.streams[streamID] = stream
+	return nil
+}
+
+func (r *fakeLiveRepo) ResumeLiveStream(ctx context.Context, streamID uint64, resumedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stream, ok := r.streams[streamID]
+	if !ok || stream.Status != model.LiveStatusPaused {
+		return model.ErrInvalidLiveStatusTransition
+	}
+	stream.Status = model.LiveStatusStreaming
+	stream.PausedAt = nil
+	r.streams[streamID] = stream
+	return nil
+}
+
+// PublishLiveStream 与数据库实现一致，推流密钥和状态作为更新条件
+func (r *fakeLiveRepo) PublishLiveStream(ctx context.Context, streamID uint64, streamKey string, publishedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stream, ok := r.streams[streamID]
+	if !ok || stream.StreamKey != streamKey || model.LiveStatus(stream.Status).IsTerminal() {
+		return false, nil
+	}
+	stream.Status = model.LiveStatusStreaming
+	stream.PausedAt = nil
+	if stream.StartedAt == nil {
+		stream.StartedAt = &publishedAt
+	}
+	stream.LastActiveAt = &publishedAt
+	r.streams[streamID] = stream
+	return true, nil
+}
+
+func (r *fakeLiveRepo) GetLiveStreamCache(ctx context.Context, streamID uint64) (*model.LiveStream, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stream, ok := r.streamCache[streamID]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return &stream, nil
+}
+
+func (r *fakeLiveRepo) SetLiveStreamCache(ctx context.Context, stream *model.LiveStream) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.streamCache[stream.ID] = *stream
+	return nil
+}
+
+func (r *fakeLiveRepo) DeleteLiveStreamCache(ctx context.Context, streamID uint64) error {
+	r.record(eventDeleteStreamCache)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.streamCache, streamID)
+	return nil
+}
+
+// cachedStream 返回缓存中的直播信息
+func (r *fakeLiveRepo) cachedStream(streamID uint64) (model.LiveStream, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stream, ok := r.streamCache[streamID]
+	return stream, ok
+}
+
+func (r *fakeLiveRepo) DeleteUserActiveStreamCache(ctx context.Context, userID uint64) error {
+	return nil
+}
+
+func (r *fakeLiveRepo) GetLiveViewerCountCache(ctx context.Context, streamID uint64) (int64, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.viewerCountCacheErr != nil {
+		return 0, false, r.viewerCountCacheErr
+	}
+	count, ok := r.viewerCounts[streamID]
+	return count, ok, nil
+}
+
+func (r *fakeLiveRepo) SetLiveViewerCountCache(ctx context.Context, streamID uint64, count int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.viewerCounts[streamID] = count
+	return nil
+}
+
+func (r *fakeLiveRepo) GetLiveViewerCount(ctx context.Context, streamID uint64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.dbViewerCounts[streamID], nil
+}
+
+func (r *fakeLiveRepo) RecordLiveGift(ctx context.Context, gift *model.LiveGift) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	gift.ID = r.nextID
+	r.gifts = append(r.gifts, *gift)
+	return nil
+}
+
+func (r *fakeLiveRepo) UpdateLiveGiftCombo(ctx context.Context, gift *model.LiveGift) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.gifts {
+		if r.gifts[i].ID == gift.ID {
+			r.gifts[i].ComboCount = gift.ComboCount
+			r.gifts[i].ComboMultiplier = gift.ComboMultiplier
+			r.gifts[i].DisplayValue = gift.DisplayValue
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *fakeLiveRepo) RefundLiveGift(ctx context.Context, giftID uint64, reason string, refundedAt time.Time) (*model.LiveGift, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.gifts {
+		if r.gifts[i].ID != giftID {
+			continue
+		}
+		if r.gifts[i].Status != model.GiftStatusSuccess {
+			gift := r.gifts[i]
+			return &gift, false, nil
+		}
+		r.gifts[i].Status = model.GiftStatusRefunded
+		r.gifts[i].RefundReason = reason
+		r.gifts[i].RefundedAt = &refundedAt
+		gift := r.gifts[i]
+		return &gift, true, nil
+	}
+	return nil, false, gorm.ErrRecordNotFound
+}
+
+func (r *fakeLiveRepo) DecrDailyStreamerGiftValue(ctx context.Context, day string, anchorID uint64, value uint64) error {
+	return nil
+}
+
+func (r *fakeLiveRepo) CreditCoinBalance(ctx context.Context, userID, amount uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.balances[userID] += amount
+	return nil
+}
+
+// balance 返回用户当前金币余额
+func (r *fakeLiveRepo) balance(userID uint64) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.balances[userID]
+}
+
+// gift 返回礼物记录，不存在时返回nil
+func (r *fakeLiveRepo) gift(id uint64) *model.LiveGift {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.gifts {
+		if r.gifts[i].ID == id {
+			gift := r.gifts[i]
+			return &gift
+		}
+	}
+	return nil
+}
+
+// giftCount 返回已写入的礼物记录数
+func (r *fakeLiveRepo) giftCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.gifts)
+}
+
+func (r *fakeLiveRepo) CreateLiveChat(ctx context.Context, chat *model.LiveChat) error {
+	if r.createChatErr != nil {
+		return r.createChatErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chats = append(r.chats, *chat)
+	return nil
+}
+
+// GetChatMute 测试中没有禁言记录
+func (r *fakeLiveRepo) GetChatMute(ctx context.Context, streamID, userID uint64) (*model.LiveChatMuteCache, error) {
+	return nil, nil
+}
+
+func (r *fakeLiveRepo) DeleteLiveGiftStatsCache(ctx context.Context, streamID uint64) error {
+	r.mu.Lock()
+	delete(r.giftStatsCache, streamID)
+	r.mu.Unlock()
+	r.record(eventDeleteGiftStatsCache)
+	return nil
+}
+
+func (r *fakeLiveRepo) IncrDailyStreamerGiftValue(ctx context.Context, day string, anchorID uint64, value uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dailyScore(model.GetLiveDailyStreamerGiftKey(day))[anchorID] += int64(value)
+	return nil
+}
+
+func (r *fakeLiveRepo) UpdateDailyPeakViewers(ctx context.Context, day string, streamID uint64, viewers int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	scores := r.dailyScore(model.GetLiveDailyPeakViewerKey(day))
+	if current, ok := scores[streamID]; !ok || viewers > current {
+		scores[streamID] = viewers
+	}
+	return nil
+}
+
+// dailyScore 返回排行榜有序集合，不存在时创建，调用方需持有锁
+func (r *fakeLiveRepo) dailyScore(key string) map[uint64]int64 {
+	scores, ok := r.dailyScores[key]
+	if !ok {
+		scores = make(map[uint64]int64)
+		r.dailyScores[key] = scores
+	}
+	return scores
+}
+
+// GetDailyLeaderboard 与ZREVRANGE一致，分数相同时成员较大的在前
+func (r *fakeLiveRepo) GetDailyLeaderboard(ctx context.Context, key string, limit int) ([]*repository.LeaderboardEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := make([]*repository.LeaderboardEntry, 0, len(r.dailyScores[key]))
+	for id, score := range r.dailyScores[key] {
+		entries = append(entries, &repository.LeaderboardEntry{ID: id, Score: score})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return strconv.FormatUint(entries[i].ID, 10) > strconv.FormatUint(entries[j].ID, 10)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	for i, entry := range entries {
+		entry.Rank = i + 1
+	}
+	return entries, nil
+}
+
+func (r *fakeLiveRepo) SetDailyLeaderboardsCache(ctx context.Context, boards *repository.DailyLeaderboards) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dailyBoardsCache[boards.Date] = boards
+	return nil
+}
+
+func (r *fakeLiveRepo) GetDailyLeaderboardsCache(ctx context.Context, day string) (*repository.DailyLeaderboards, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	boards, ok := r.dailyBoardsCache[day]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return boards, nil
+}
+
+func (r *fakeLiveRepo) IncrementGiftCombo(ctx context.Context, streamID, userID uint64, giftID uint32, window time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := fmt.Sprintf("%d:%d:%d", streamID, userID, giftID)
+	r.combos[key]++
+	return r.combos[key], nil
+}
+
+func (r *fakeLiveRepo) AcquireGiftRequest(ctx context.Context, userID uint64, requestID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := fmt.Sprintf("%d:%s", userID, requestID)
+	if _, ok := r.giftRequests[key]; ok {
+		return false, nil
+	}
+	r.giftRequests[key] = nil
+	return true, nil
+}
+
+func (r *fakeLiveRepo) GetGiftRequestResult(ctx context.Context, userID uint64, requestID string) (*model.LiveGift, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := fmt.Sprintf("%d:%s", userID, requestID)
+	if r.expireGiftRequests > 0 {
+		r.expireGiftRequests--
+		delete(r.giftRequests, key)
+	}
+	gift, ok := r.giftRequests[key]
+	return gift, ok, nil
+}
+
+func (r *fakeLiveRepo) SetGiftRequestResult(ctx context.Context, userID uint64, requestID string, gift *model.LiveGift) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.setGiftRequestErr != nil {
+		return r.setGiftRequestErr
+	}
+	saved := *gift
+	r.giftRequests[fmt.Sprintf("%d:%s", userID, requestID)] = &saved
+	return nil
+}
+
+func (r *fakeLiveRepo) ReleaseGiftRequest(ctx context.Context, userID uint64, requestID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.giftRequests, fmt.Sprintf("%d:%s", userID, requestID))
+	return nil
+}
+
+func (r *fakeLiveRepo) AcquireLiveStreamLock(ctx context.Context, streamID uint64, timeout int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.locks[streamID] {
+		return false, nil
+	}
+	r.locks[streamID] = true
+	return true, nil
+}
+
+func (r *fakeLiveRepo) ReleaseLiveStreamLock(ctx context.Context, streamID uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.locks, streamID)
+	return nil
+}
+
+// GetLiveGiftStats 汇总直播流成功送出的礼物，退款和失败的不计入
+// 送出数量最多的礼物为TopGift，数量相同时取总价值高的
+func (r *fakeLiveRepo) GetLiveGiftStats(ctx context.Context, streamID uint64) (*repository.GiftStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.giftStatsQueries++
+	if r.giftStatsErr != nil {
+		return nil, r.giftStatsErr
+	}
+	stats := &repository.GiftStats{StreamID: streamID}
+	senders := make(map[uint64]bool)
+	counts := make(map[uint32]uint32)
+	values := make(map[uint32]uint64)
+	for _, gift := range r.gifts {
+		if gift.StreamID != streamID || gift.Status != model.GiftStatusSuccess {
+			continue
+		}
+		stats.TotalGifts += gift.GiftCount
+		stats.TotalValue += gift.TotalValue
+		senders[gift.UserID] = true
+		counts[gift.GiftID] += gift.GiftCount
+		values[gift.GiftID] += gift.TotalValue
+	}
+	stats.TotalCoins = stats.TotalValue
+	stats.UniqueSenders = uint32(len(senders))
+	for giftID, count := range counts {
+		if count > stats.TopGiftCount || (count == stats.TopGiftCount && values[giftID] > stats.TopGiftValue) {
+			stats.TopGiftID, stats.TopGiftCount, stats.TopGiftValue = giftID, count, values[giftID]
+		}
+	}
+	return stats, nil
+}
+
+func (r *fakeLiveRepo) GetLiveGiftStatsCache(ctx context.Context, streamID uint64) (*repository.GiftStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats, ok := r.giftStatsCache[streamID]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return &stats, nil
+}
+
+func (r *fakeLiveRepo) SetLiveGiftStatsCache(ctx context.Context, stats *repository.GiftStats) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.giftStatsCache[stats.StreamID] = *stats
+	return nil
+}
+
+func (r *fakeLiveRepo) GetLiveStats(ctx context.Context, streamID uint64) (*repository.LiveStats, error) {
+	return &repository.LiveStats{StreamID: streamID}, nil
+}
+
+func (r *fakeLiveRepo) UpdateLiveStats(ctx context.Context, streamID uint64, stats *repository.LiveStats) error {
+	return nil
+}
+
+func (r *fakeLiveRepo) AccumulateLiveRoomStats(ctx context.Context, stream *model.LiveStream) error {
+	if r.accumulateErr != nil {
+		return r.accumulateErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accumulateCalls++
+	return nil
+}
+
+// finalizeCount 返回下播结算的次数
+func (r *fakeLiveRepo) finalizeCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.accumulateCalls
+}
+
+func (r *fakeLiveRepo) TouchViewerPresence(ctx context.Context, streamID, userID uint64, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.presence[streamID] == nil {
+		r.presence[streamID] = make(map[uint64]time.Time)
+	}
+	r.presence[streamID][userID] = time.Now().Add(ttl)
+	return nil
+}
+
+// RefreshViewerPresence 与Redis脚本一致：只为仍然在线的观看者续期
+func (r *fakeLiveRepo) RefreshViewerPresence(ctx context.Context, streamID, userID uint64, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	expireAt, ok := r.presence[streamID][userID]
+	if !ok || expireAt.Before(now) {
+		return false, nil
+	}
+	r.presence[streamID][userID] = now.Add(ttl)
+	return true, nil
+}
+
+func (r *fakeLiveRepo) RemoveViewerPresence(ctx context.Context, streamID, userID uint64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.presence[streamID][userID]
+	delete(r.presence[streamID], userID)
+	return ok, nil
+}
+
+func (r *fakeLiveRepo) AccrueLiveViewerDuration(ctx context.Context, streamID, userID uint64, now time.Time, minInterval time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accrueCalls++
+	r.accrueInterval = minInterval
+	viewer := r.watchRecords[streamID][userID]
+	if viewer == nil || viewer.ExitTime != nil || watchAccruedSince(viewer).After(now.Add(-minInterval)) {
+		return false, nil
+	}
+	accrueWatchDuration(viewer, now)
+	return true, nil
+}
+
+// watchAccruedSince 与COALESCE(accrued_at, enter_time)一致
+func watchAccruedSince(viewer *model.LiveViewer) time.Time {
+	if viewer.AccruedAt != nil {
+		return *viewer.AccruedAt
+	}
+	return viewer.EnterTime
+}
+
+// accrueWatchDuration 与累计观看时长的SQL一致：按整秒累计至at，时间倒退时不扣减
+func accrueWatchDuration(viewer *model.LiveViewer, at time.Time) {
+	if seconds := int64(at.Sub(watchAccruedSince(viewer)) / time.Second); seconds > 0 {
+		viewer.WatchDuration += uint32(seconds)
+	}
+	viewer.AccruedAt = &at
+}
+
+func (r *fakeLiveRepo) ListPresenceStreams(ctx context.Context) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	streamIDs := make([]uint64, 0, len(r.presence))
+	for streamID := range r.presence {
+		streamIDs = append(streamIDs, streamID)
+	}
+	sort.Slice(streamIDs, func(i, j int) bool { return streamIDs[i] < streamIDs[j] })
+	return streamIDs, nil
+}
+
+// PopExpiredViewers 与Redis脚本一致：取出过期的观看者，直播间无人在线时移出遍历集合
+func (r *fakeLiveRepo) PopExpiredViewers(ctx context.Context, streamID uint64, now time.Time, limit int) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var expired []uint64
+	for userID, expireAt := range r.presence[streamID] {
+		if expireAt.Before(now) {
+			expired = append(expired, userID)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i] < expired[j] })
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	for _, userID := range expired {
+		delete(r.presence[streamID], userID)
+	}
+	if len(r.presence[streamID]) == 0 {
+		delete(r.presence, streamID)
+	}
+	return expired, nil
+}
+
+// MarkLiveViewerExit 只更新尚未离开的观看者，返回实际更新的条数
+func (r *fakeLiveRepo) MarkLiveViewerExit(ctx context.Context, streamID uint64, userIDs []uint64, exitTime time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.viewerExits[streamID] == nil {
+		r.viewerExits[streamID] = make(map[uint64]bool)
+	}
+	var exited int64
+	for _, userID := range userIDs {
+		if !r.viewerExits[streamID][userID] {
+			r.viewerExits[streamID][userID] = true
+			exited++
+			if viewer := r.watchRecords[streamID][userID]; viewer != nil {
+				accrueWatchDuration(viewer, exitTime)
+				viewer.ExitTime = &exitTime
+			}
+		}
+	}
+	return exited, nil
+}
+
+// fakeRedis 只实现Publish，发布的直播间事件解码后记录到仓库，并记为副作用事件
+type fakeRedis struct {
+	redis.UniversalClient
+	repo *fakeLiveRepo
+}
+
+func (c *fakeRedis) Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd {
+	cmd := redis.NewIntCmd(ctx)
+	c.repo.mu.Lock()
+	publishErr := c.repo.publishErr
+	if publishErr == nil {
+		var event model.LiveEvent
+		if err := json.Unmarshal(message.([]byte), &event); err != nil {
+			publishErr = err
+		} else {
+			c.repo.liveEvents = append(c.repo.liveEvents, event)
+		}
+	}
+	c.repo.mu.Unlock()
+	if publishErr != nil {
+		cmd.SetErr(publishErr)
+		return cmd
+	}
+	c.repo.record(eventPublish)
+	cmd.SetVal(1)
+	return cmd
+}
+
+// publishedLiveEvents 返回指定类型的已发布直播间事件
+func (r *fakeLiveRepo) publishedLiveEvents(eventType model.LiveEventType) []model.LiveEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var events []model.LiveEvent
+	for _, event := range r.liveEvents {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// newTestLiveService 使用内存仓库创建直播服务，礼物和聊天管理器使用真实实现
+func newTestLiveService(repo *fakeLiveRepo) *liveService {
+	cfg := &config.Config{}
+	log := nopLogger{}
+	return &liveService{
+		config:      cfg,
+		logger:      log,
+		liveRepo:    repo,
+		chatManager: NewChatManager(cfg, log, repo),
+		giftManager: NewGiftManager(cfg, log, repo),
+		eventHub:    repository.NewLiveEventHub(&fakeRedis{repo: repo}, 0, log),
+	}
+}
+
+// indexOf 返回事件第一次出现的位置，未出现时返回-1
+func indexOf(events []string, event string) int {
+	for i, e := range events {
+		if e == event {
+			return i
+		}
+	}
+	return -1
+}
+
+var errInjected = errors.New("injected failure")
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// revokedStreamKeyPrefix 作废后的推流密钥前缀，便于排查时识别
+const revokedStreamKeyPrefix = "revoked_"
+
+// ForceStopLive 管理员强制结束违规直播
+// 直播按正常下播结算后通知直播间内的观看者，并更换推流密钥，主播无法使用原密钥重新推流；
+// 直播已结束时仍会作废密钥，便于对已断流的违规直播补充处置
+func (s *liveService) ForceStopLive(ctx context.Context, operatorID, streamID uint64, reason string) (*model.LiveStream, error) {
+	s.logger.Info("Force stopping live stream", "streamID", streamID, "operatorID", operatorID, "reason", reason)
+
+	if !s.config.Live.Chat.IsAdmin(operatorID) {
+		return nil, ErrStreamPermissionDenied
+	}
+
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+
+	// 先作废密钥并提交，再结算，避免结算期间主播重新推流把直播恢复为直播中；
+	// 结算失败时密钥保持作废，管理员重试即可完成结算
+	streamKey, err := newRevokedStreamKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.liveRepo.UpdateStreamKey(ctx, streamID, streamKey); err != nil {
+		return nil, fmt.Errorf("failed to revoke stream key: %w", err)
+	}
+
+	alreadyEnded := stream.Status == model.LiveStatusEnded || stream.Status == model.LiveStatusBanned
+	if !alreadyEnded {
+		if stream, err = s.finalizeLiveStream(ctx, streamID); err != nil {
+			return nil, err
+		}
+	}
+	stream.StreamKey = streamKey
+
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, streamID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", streamID, "error", err)
+	}
+
+	if !alreadyEnded {
+		// 不暴露操作的管理员，事件用户ID置为0
+		s.publishLiveEvent(ctx, model.LiveEventTerminated, streamID, 0, model.LiveTerminatedEventData{Reason: reason})
+	}
+
+	s.logger.Info("Live stream force stopped", "streamID", streamID, "operatorID", operatorID, "alreadyEnded", alreadyEnded)
+	return stream, nil
+}
+
+// newRevokedStreamKey 生成用于替换原推流密钥的随机值，不会与主播持有的任何密钥相同
+func newRevokedStreamKey() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate stream key: %w", err)
+	}
+	return revokedStreamKeyPrefix + hex.EncodeToString(buf), nil
+}
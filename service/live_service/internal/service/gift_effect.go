@@ -0,0 +1,41 @@
+package service
+
+import "fmt"
+
+// 礼物特效类型，对应gift_configs.effect_type，客户端按类型选择渲染方式
+const (
+	GiftEffectNone      = ""          // 无特效，只展示礼物图标
+	GiftEffectEmoji     = "emoji"     // 表情飘屏，effect_value为表情
+	GiftEffectSparkle   = "sparkle"   // 闪光特效，effect_value为闪光内容
+	GiftEffectAnimation = "animation" // 全屏动画，effect_value为客户端内置的动画资源名
+	GiftEffectCrown     = "crown"     // 皇冠特效，effect_value为展示内容
+)
+
+// maxGiftEffectValueLen 特效值最大长度，与gift_configs.effect_value字段长度一致
+const maxGiftEffectValueLen = 512
+
+// GiftEffect 客户端渲染礼物特效所需的信息
+type GiftEffect struct {
+	Type  string
+	Value string
+}
+
+// ResolveGiftEffect 根据礼物配置解析礼物特效
+// 特效类型未知、缺少特效值或特效值过长时返回错误，调用方应降级为无特效，不影响礼物发送
+func ResolveGiftEffect(config *GiftConfig) (GiftEffect, error) {
+	switch config.EffectType {
+	case GiftEffectNone:
+		return GiftEffect{}, nil
+	case GiftEffectEmoji, GiftEffectSparkle, GiftEffectAnimation, GiftEffectCrown:
+	default:
+		return GiftEffect{}, fmt.Errorf("gift %d has unknown effect type %q", config.ID, config.EffectType)
+	}
+
+	if config.EffectValue == "" {
+		return GiftEffect{}, fmt.Errorf("gift %d effect %q has no effect value", config.ID, config.EffectType)
+	}
+	if len(config.EffectValue) > maxGiftEffectValueLen {
+		return GiftEffect{}, fmt.Errorf("gift %d effect value exceeds %d bytes", config.ID, maxGiftEffectValueLen)
+	}
+	return GiftEffect{Type: config.EffectType, Value: config.EffectValue}, nil
+}
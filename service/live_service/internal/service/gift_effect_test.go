@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestResolveGiftEffect(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  GiftConfig
+		want    GiftEffect
+		wantErr bool
+	}{
+		{"no effect", GiftConfig{ID: 1}, GiftEffect{}, false},
+		// 无特效时忽略遗留的特效值
+		{"no effect ignores value", GiftConfig{ID: 1, EffectValue: "stale"}, GiftEffect{}, false},
+		{"emoji", GiftConfig{ID: 2, EffectType: GiftEffectEmoji, EffectValue: "🌹"}, GiftEffect{Type: GiftEffectEmoji, Value: "🌹"}, false},
+		{"sparkle", GiftConfig{ID: 3, EffectType: GiftEffectSparkle, EffectValue: "✨"}, GiftEffect{Type: GiftEffectSparkle, Value: "✨"}, false},
+		{"animation", GiftConfig{ID: 4, EffectType: GiftEffectAnimation, EffectValue: "rocket_launch"}, GiftEffect{Type: GiftEffectAnimation, Value: "rocket_launch"}, false},
+		{"crown", GiftConfig{ID: 5, EffectType: GiftEffectCrown, EffectValue: "👑"}, GiftEffect{Type: GiftEffectCrown, Value: "👑"}, false},
+		{"value at limit", GiftConfig{ID: 6, EffectType: GiftEffectAnimation, EffectValue: strings.Repeat("a", maxGiftEffectValueLen)},
+			GiftEffect{Type: GiftEffectAnimation, Value: strings.Repeat("a", maxGiftEffectValueLen)}, false},
+		{"unknown type", GiftConfig{ID: 7, EffectType: "fireworks", EffectValue: "big"}, GiftEffect{}, true},
+		// 类型区分大小写，与客户端渲染器的取值一致
+		{"type case sensitive", GiftConfig{ID: 8, EffectType: "Animation", EffectValue: "rocket_launch"}, GiftEffect{}, true},
+		{"missing value", GiftConfig{ID: 9, EffectType: GiftEffectAnimation}, GiftEffect{}, true},
+		{"value too long", GiftConfig{ID: 10, EffectType: GiftEffectAnimation, EffectValue: strings.Repeat("a", maxGiftEffectValueLen+1)}, GiftEffect{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveGiftEffect(&tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("effect = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// stubGiftConfigManager 返回预设礼物配置的礼物管理器
+type stubGiftConfigManager struct {
+	GiftManager
+	config GiftConfig
+}
+
+func (m *stubGiftConfigManager) GetGiftConfig(ctx context.Context, giftID uint32) (*GiftConfig, error) {
+	config := m.config
+	config.ID = giftID
+	return &config, nil
+}
+
+func TestSendLiveGiftIncludesEffect(t *testing.T) {
+	tests := []struct {
+		name   string
+		config GiftConfig
+		want   GiftEffect
+	}{
+		{"animation", GiftConfig{EffectType: GiftEffectAnimation, EffectValue: "rocket_launch"}, GiftEffect{Type: GiftEffectAnimation, Value: "rocket_launch"}},
+		// 特效配置错误时降级为无特效，礼物照常发送
+		{"invalid config", GiftConfig{EffectType: "fireworks", EffectValue: "big"}, GiftEffect{}},
+		{"no effect", GiftConfig{}, GiftEffect{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeLiveRepo()
+			newLiveTestStream(repo)
+			s := newTestLiveService(repo)
+			tt.config.Name, tt.config.CoinPrice, tt.config.IsActive = "火箭", 100, true
+			s.giftManager = &stubGiftConfigManager{GiftManager: s.giftManager, config: tt.config}
+
+			gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1")
+			if err != nil {
+				t.Fatalf("SendLiveGift: %v", err)
+			}
+			if gift.EffectType != tt.want.Type || gift.EffectData != tt.want.Value {
+				t.Errorf("gift effect = %q %q, want %+v", gift.EffectType, gift.EffectData, tt.want)
+			}
+
+			events := repo.publishedLiveEvents(model.LiveEventGift)
+			if len(events) != 1 {
+				t.Fatalf("gift events = %d, want 1", len(events))
+			}
+			var data model.LiveGiftEventData
+			decodeEventData(t, events[0], &data)
+			if data.EffectType != tt.want.Type || data.EffectValue != tt.want.Value {
+				t.Errorf("event effect = %q %q, want %+v", data.EffectType, data.EffectValue, tt.want)
+			}
+		})
+	}
+}
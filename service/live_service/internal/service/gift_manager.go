@@ -2,20 +2,27 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
 
 	"live_service/internal/config"
 	"live_service/internal/model"
 	"live_service/internal/repository"
 	"live_service/pkg/logger"
+	"live_service/pkg/paginate"
 )
 
 // GiftManager 礼物管理器接口
 type GiftManager interface {
 	// 礼物发送
 	SendGift(ctx context.Context, gift *model.LiveGift) error
+	RefundGift(ctx context.Context, giftID uint64, reason string) (*model.LiveGift, bool, error)
 
 	// 礼物查询
-	GetGiftList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveGift, int64, error)
+	GetGiftList(ctx context.Context, streamID uint64, page, pageSize int, cursor string) ([]*model.LiveGift, int64, string, error)
 	GetUserGiftHistory(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error)
 	GetStreamGiftStats(ctx context.Context, streamID uint64) (*GiftStats, error)
 
@@ -30,6 +37,9 @@ type GiftManager interface {
 	// 礼物特效
 	TriggerGiftEffect(ctx context.Context, gift *model.LiveGift) error
 
+	// 礼物连击
+	TrackGiftCombo(ctx context.Context, gift *model.LiveGift) (*GiftCombo, error)
+
 	// 排行榜
 	GetGiftRanking(ctx context.Context, streamID uint64, rankingType string, limit int) ([]*GiftRankingItem, error)
 
@@ -37,6 +47,9 @@ type GiftManager interface {
 	GetGiftStatistics(ctx context.Context, userID uint64, period string) (*GiftStatistics, error)
 }
 
+// giftListSort 礼物列表游标分页的排序方式
+const giftListSort = "total_value_desc"
+
 // GiftConfig 礼物配置
 type GiftConfig struct {
 	ID          uint32 `json:"id"`
@@ -78,6 +91,33 @@ type RevenueInfo struct {
 	EndTime       int64  `json:"end_time"`
 }
 
+// GiftCombo 礼物连击信息
+type GiftCombo struct {
+	Count      uint32 `json:"count"`      // 窗口内连击次数
+	Multiplier uint32 `json:"multiplier"` // 展示价值倍数，不影响实际扣费
+}
+
+// giftComboTiers 连击倍数档位，按连击次数从高到低匹配
+var giftComboTiers = []struct {
+	minCount   uint32
+	multiplier uint32
+}{
+	{minCount: 99, multiplier: 5},
+	{minCount: 66, multiplier: 4},
+	{minCount: 30, multiplier: 3},
+	{minCount: 10, multiplier: 2},
+}
+
+// GiftComboMultiplier 根据连击次数计算展示倍数
+func GiftComboMultiplier(count uint32) uint32 {
+	for _, tier := range giftComboTiers {
+		if count >= tier.minCount {
+			return tier.multiplier
+		}
+	}
+	return 1
+}
+
 // GiftRankingItem 礼物排行榜项
 type GiftRankingItem struct {
 	UserID       uint64 `json:"user_id"`
@@ -118,64 +158,100 @@ func NewGiftManager(cfg *config.Config, log logger.Logger, repo repository.LiveR
 }
 
 // SendGift 发送礼物
+// 礼物记录与直播间、观看者的礼物统计在同一事务中写入；ctx绑定了事务时加入该事务，
+// 礼物统计缓存由调用方在事务提交后清除
 func (m *giftManager) SendGift(ctx context.Context, gift *model.LiveGift) error {
 	m.logger.Info("Sending gift", "streamID", gift.StreamID, "userID", gift.UserID, "giftID", gift.GiftID)
 
-	// TODO: 实现发送礼物逻辑
-	// 这里应该包含：
-	// 1. 验证礼物配置
-	// 2. 检查用户余额
-	// 3. 扣除用户金币
-	// 4. 创建礼物记录
-	// 5. 增加主播收益
-	// 6. 触发礼物特效
-	// 7. 更新排行榜
-
+	if err := m.liveRepo.RecordLiveGift(ctx, gift); err != nil {
+		return fmt.Errorf("failed to record gift: %w", err)
+	}
 	return nil
 }
 
-// GetGiftList 获取礼物列表
-func (m *giftManager) GetGiftList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
-	m.logger.Info("Getting gift list", "streamID", streamID, "page", page, "pageSize", pageSize)
+// RefundGift 退还礼物，礼物记录标记为已退款并从直播间、观看者的礼物统计中扣除，礼物总价值退还到送礼用户的金币账户
+// 返回的bool表示本次调用是否完成了退款，记录已退款时返回false且不重复退还；
+// 调用方需在事务中调用，使标记退款与退还金币一起提交，并在提交后删除礼物统计缓存
+func (m *giftManager) RefundGift(ctx context.Context, giftID uint64, reason string) (*model.LiveGift, bool, error) {
+	m.logger.Info("Refunding gift", "giftRecordID", giftID, "reason", reason)
+
+	gift, refunded, err := m.liveRepo.RefundLiveGift(ctx, giftID, reason, time.Now())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, ErrGiftNotFound
+		}
+		return nil, false, fmt.Errorf("failed to refund gift: %w", err)
+	}
+	if !refunded {
+		return gift, false, nil
+	}
+	if err := m.liveRepo.CreditCoinBalance(ctx, gift.UserID, gift.TotalValue); err != nil {
+		return nil, false, fmt.Errorf("failed to credit coin balance: %w", err)
+	}
+	return gift, true, nil
+}
 
-	// TODO: 实现获取礼物列表逻辑
-	// 这里应该包含：
-	// 1. 查询礼物记录
-	// 2. 按时间排序
-	// 3. 分页查询
-	// 4. 返回礼物列表
+// GetGiftList 获取礼物列表，按礼物总价值倒序
+// cursor为上一页返回的游标，不为空时忽略page；还有下一页时返回下一页游标
+func (m *giftManager) GetGiftList(ctx context.Context, streamID uint64, page, pageSize int, cursor string) ([]*model.LiveGift, int64, string, error) {
+	m.logger.Info("Getting gift list", "streamID", streamID, "page", page, "pageSize", pageSize, "cursor", cursor != "")
+
+	after, err := paginate.Decode(cursor, giftListSort)
+	if err != nil {
+		return nil, 0, "", err
+	}
 
-	return []*model.LiveGift{}, 0, nil
+	// 多取一条判断是否还有下一页
+	page, pageSize = model.NormalizePage(page, pageSize)
+	gifts, total, err := m.liveRepo.GetLiveGiftList(ctx, streamID, after, (page-1)*pageSize, pageSize+1)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to get gift list: %w", err)
+	}
+	if len(gifts) <= pageSize {
+		return gifts, total, "", nil
+	}
+
+	gifts = gifts[:pageSize]
+	last := gifts[len(gifts)-1]
+	nextCursor := paginate.Encode(paginate.Cursor{Sort: giftListSort, Value: int64(last.TotalValue), ID: last.ID})
+	return gifts, total, nextCursor, nil
 }
 
 // GetUserGiftHistory 获取用户礼物历史
 func (m *giftManager) GetUserGiftHistory(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
 	m.logger.Info("Getting user gift history", "userID", userID, "page", page, "pageSize", pageSize)
 
-	// TODO: 实现获取用户礼物历史逻辑
-	// 这里应该包含：
-	// 1. 查询用户发送的礼物
-	// 2. 按时间排序
-	// 3. 分页查询
-	// 4. 返回礼物历史
-
-	return []*model.LiveGift{}, 0, nil
+	gifts, total, err := m.liveRepo.GetUserLiveGiftList(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get user gift history: %w", err)
+	}
+	return gifts, total, nil
 }
 
-// GetStreamGiftStats 获取直播礼物统计
+// GetStreamGiftStats 获取直播礼物统计，优先读取缓存，未命中时从礼物记录聚合并回填缓存
 func (m *giftManager) GetStreamGiftStats(ctx context.Context, streamID uint64) (*GiftStats, error) {
 	m.logger.Info("Getting stream gift stats", "streamID", streamID)
 
-	// TODO: 实现获取直播礼物统计逻辑
-	// 这里应该包含：
-	// 1. 统计礼物数量
-	// 2. 计算礼物价值
-	// 3. 统计发送者数量
-	// 4. 找出最受欢迎的礼物
-	// 5. 返回统计信息
+	stats, err := m.liveRepo.GetLiveGiftStatsCache(ctx, streamID)
+	if err != nil {
+		stats, err = m.liveRepo.GetLiveGiftStats(ctx, streamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gift stats: %w", err)
+		}
+		if err := m.liveRepo.SetLiveGiftStatsCache(ctx, stats); err != nil {
+			m.logger.Warn("Failed to set gift stats cache", "streamID", streamID, "error", err)
+		}
+	}
 
 	return &GiftStats{
-		StreamID: streamID,
+		StreamID:      stats.StreamID,
+		TotalGifts:    stats.TotalGifts,
+		TotalValue:    stats.TotalValue,
+		TotalCoins:    stats.TotalCoins,
+		UniqueSenders: stats.UniqueSenders,
+		TopGiftID:     stats.TopGiftID,
+		TopGiftCount:  stats.TopGiftCount,
+		TopGiftValue:  stats.TopGiftValue,
 	}, nil
 }
 
@@ -258,6 +334,29 @@ func (m *giftManager) TriggerGiftEffect(ctx context.Context, gift *model.LiveGif
 	return nil
 }
 
+// TrackGiftCombo 记录礼物连击
+// 同一用户在连击窗口内连续赠送同一礼物时累加连击次数，超出窗口后重新计数
+func (m *giftManager) TrackGiftCombo(ctx context.Context, gift *model.LiveGift) (*GiftCombo, error) {
+	window := m.config.Live.Gift.ComboWindow
+	if window <= 0 {
+		window = model.GiftComboWindow
+	}
+
+	count, err := m.liveRepo.IncrementGiftCombo(ctx, gift.StreamID, gift.UserID, gift.GiftID, window)
+	if err != nil {
+		m.logger.Error("Failed to track gift combo", "streamID", gift.StreamID, "userID", gift.UserID, "giftID", gift.GiftID, "error", err)
+		return nil, err
+	}
+
+	combo := &GiftCombo{
+		Count:      uint32(count),
+		Multiplier: GiftComboMultiplier(uint32(count)),
+	}
+	m.logger.Debug("Gift combo tracked", "streamID", gift.StreamID, "userID", gift.UserID, "giftID", gift.GiftID, "count", combo.Count, "multiplier", combo.Multiplier)
+
+	return combo, nil
+}
+
 // GetGiftRanking 获取礼物排行榜
 func (m *giftManager) GetGiftRanking(ctx context.Context, streamID uint64, rankingType string, limit int) ([]*GiftRankingItem, error) {
 	m.logger.Info("Getting gift ranking", "streamID", streamID, "rankingType", rankingType, "limit", limit)
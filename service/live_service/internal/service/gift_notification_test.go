@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"live_service/pkg/notify"
+)
+
+// notificationSink 记录投递的用户通知
+type notificationSink struct {
+	mu            sync.Mutex
+	notifications []notify.Notification
+}
+
+func (s *notificationSink) Push(ctx context.Context, n *notify.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notifications = append(s.notifications, *n)
+	return nil
+}
+
+// newNotifyTestService 创建带通知发布器的直播服务
+// 返回的函数停止发布器并返回已投递的通知
+func newNotifyTestService(t *testing.T, repo *fakeLiveRepo) (*liveService, func() []notify.Notification) {
+	t.Helper()
+	sink := &notificationSink{}
+	s := newTestLiveService(repo)
+	s.notifier = notify.NewPublisher(sink, 0, nopLogger{})
+	s.notifier.Start()
+	return s, func() []notify.Notification {
+		t.Helper()
+		if err := s.notifier.Stop(context.Background()); err != nil {
+			t.Fatalf("stop notifier: %v", err)
+		}
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return sink.notifications
+	}
+}
+
+func TestSendLiveGiftNotifiesAnchor(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	s, notifications := newNotifyTestService(t, repo)
+
+	gift, err := s.SendLiveGift(context.Background(), stream.ID, 20, 3, 2, "req-1")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+
+	got := notifications()
+	if len(got) != 1 {
+		t.Fatalf("notifications = %+v, want 1", got)
+	}
+	n := got[0]
+	if n.Type != notify.TypeGift || n.UserID != stream.UserID || n.ActorID != 20 || n.TargetID != "1" {
+		t.Errorf("notification = %+v, want a gift notification to anchor %d from user 20 in stream 1", n, stream.UserID)
+	}
+	if want := "送出了2个" + gift.GiftName; n.Content != want || n.Title == "" {
+		t.Errorf("notification text = %q %q, want content %q", n.Title, n.Content, want)
+	}
+}
+
+func TestSendLiveGiftNotificationSkipped(t *testing.T) {
+	t.Run("anchor gifts own stream", func(t *testing.T) {
+		repo := newFakeLiveRepo()
+		stream := newLiveTestStream(repo)
+		s, notifications := newNotifyTestService(t, repo)
+
+		if _, err := s.SendLiveGift(context.Background(), stream.ID, stream.UserID, 3, 1, "req-1"); err != nil {
+			t.Fatalf("SendLiveGift: %v", err)
+		}
+		if got := notifications(); len(got) != 0 {
+			t.Errorf("notifications = %+v, want none for a self gift", got)
+		}
+	})
+
+	t.Run("repeated request", func(t *testing.T) {
+		repo := newFakeLiveRepo()
+		newLiveTestStream(repo)
+		s, notifications := newNotifyTestService(t, repo)
+
+		for i := 0; i < 2; i++ {
+			if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); err != nil {
+				t.Fatalf("SendLiveGift: %v", err)
+			}
+		}
+		if got := notifications(); len(got) != 1 {
+			t.Errorf("notifications = %d, want 1 for a repeated request", len(got))
+		}
+	})
+
+	t.Run("rolled back", func(t *testing.T) {
+		repo := newFakeLiveRepo()
+		newLiveTestStream(repo)
+		repo.createChatErr = errInjected
+		s, notifications := newNotifyTestService(t, repo)
+
+		if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); !errors.Is(err, errInjected) {
+			t.Fatalf("SendLiveGift error = %v, want injected failure", err)
+		}
+		if got := notifications(); len(got) != 0 {
+			t.Errorf("notifications = %+v, want none for a failed gift", got)
+		}
+	})
+}
+
+func TestSendLiveGiftWithoutNotifier(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	// 未开启用户通知时发布器为nil
+	s := newTestLiveService(repo)
+
+	if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+}
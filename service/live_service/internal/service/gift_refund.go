@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"live_service/internal/model"
+)
+
+// 礼物退款错误
+var (
+	ErrGiftNotFound      = errors.New("live gift not found")
+	ErrGiftNotRefundable = errors.New("live gift was not sent successfully and cannot be refunded")
+)
+
+// SystemOperatorID 服务内部自动补偿(如下游入账失败后的冲正)使用的操作人ID，不做管理员校验
+// 接口调用的操作人ID取自调用方的访问令牌，不会为该值
+const SystemOperatorID uint64 = 0
+
+// RefundLiveGift 退还送出的礼物，用于送礼已记录但下游处理失败时的冲正
+// 仅平台管理员或服务内部的自动补偿(operatorID为SystemOperatorID)可以调用；
+// 标记退款、扣除直播间和观看者的礼物统计、退还送礼用户金币在同一事务中完成，
+// 提交后再从主播当日收礼榜中扣除并清除缓存；同一礼物重复退款直接返回已退款的记录，不会重复退还金币
+func (s *liveService) RefundLiveGift(ctx context.Context, operatorID, giftRecordID uint64, reason string) (*model.LiveGift, error) {
+	s.logger.Info("Refunding live gift", "giftRecordID", giftRecordID, "operatorID", operatorID, "reason", reason)
+
+	if operatorID != SystemOperatorID && !s.config.Live.Chat.IsAdmin(operatorID) {
+		return nil, ErrStreamPermissionDenied
+	}
+
+	var gift *model.LiveGift
+	var refunded bool
+	err := s.liveRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		gift, refunded, err = s.giftManager.RefundGift(ctx, giftRecordID, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !refunded {
+		if gift.Status != model.GiftStatusRefunded {
+			return nil, ErrGiftNotRefundable
+		}
+		s.logger.Info("Live gift already refunded", "giftRecordID", giftRecordID, "refundedAt", gift.RefundedAt)
+		return gift, nil
+	}
+
+	day := model.LeaderboardDay(gift.SendTime)
+	if err := s.liveRepo.DecrDailyStreamerGiftValue(ctx, day, gift.AnchorID, gift.TotalValue); err != nil {
+		s.logger.Warn("Failed to update daily gift leaderboard", "anchorID", gift.AnchorID, "error", err)
+	}
+	if err := s.liveRepo.DeleteLiveGiftStatsCache(ctx, gift.StreamID); err != nil {
+		s.logger.Warn("Failed to delete gift stats cache", "streamID", gift.StreamID, "error", err)
+	}
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, gift.StreamID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", gift.StreamID, "error", err)
+	}
+
+	s.logger.Info("Live gift refunded", "giftRecordID", gift.ID, "streamID", gift.StreamID, "userID", gift.UserID, "totalValue", gift.TotalValue)
+	return gift, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+// sendTestGift 用户20在直播1中送出一个价值100金币的礼物
+func sendTestGift(t *testing.T, s *liveService) *model.LiveGift {
+	t.Helper()
+	gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-refund")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	return gift
+}
+
+func TestRefundLiveGiftCreditsBalanceOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	s.config.Live.Chat.AdminUserIDs = []uint64{99}
+
+	gift := sendTestGift(t, s)
+	// 送礼不从金币账户扣费
+	if got := repo.balance(20); got != 0 {
+		t.Fatalf("balance after send = %d, want 0", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		refunded, err := s.RefundLiveGift(context.Background(), 99, gift.ID, "下游入账失败")
+		if err != nil {
+			t.Fatalf("RefundLiveGift #%d: %v", i+1, err)
+		}
+		if refunded.Status != model.GiftStatusRefunded {
+			t.Fatalf("status = %d, want refunded", refunded.Status)
+		}
+	}
+	if got := repo.balance(20); got != 100 {
+		t.Fatalf("balance after duplicate refunds = %d, want 100 credited once", got)
+	}
+
+	// 退款后保留礼物记录用于对账
+	record := repo.gift(gift.ID)
+	if record == nil || record.DeletedAt != nil || record.RefundedAt == nil {
+		t.Fatalf("refunded gift record = %+v, want kept with refunded_at set", record)
+	}
+
+	events := repo.eventsSnapshot()
+	commit := indexOf(events, eventCommit)
+	if i := indexOf(events[commit+1:], eventDeleteGiftStatsCache); i < 0 {
+		t.Errorf("gift stats cache not deleted after refund commit (events %v)", events)
+	}
+}
+
+func TestRefundLiveGiftRequiresAdmin(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	s.config.Live.Chat.AdminUserIDs = []uint64{99}
+
+	gift := sendTestGift(t, s)
+	if _, err := s.RefundLiveGift(context.Background(), 20, gift.ID, "想要回礼物"); !errors.Is(err, ErrStreamPermissionDenied) {
+		t.Fatalf("RefundLiveGift by sender error = %v, want ErrStreamPermissionDenied", err)
+	}
+	if got := repo.balance(20); got != 0 {
+		t.Fatalf("balance = %d after denied refund, want 0", got)
+	}
+	if record := repo.gift(gift.ID); record.Status != model.GiftStatusSuccess {
+		t.Fatalf("gift status = %d after denied refund, want success", record.Status)
+	}
+}
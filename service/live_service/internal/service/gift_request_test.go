@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestSendLiveGiftDuplicateRequestRecordsOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	first, err := s.SendLiveGift(ctx, 1, 20, 3, 2, "req-dup")
+	if err != nil {
+		t.Fatalf("first SendLiveGift: %v", err)
+	}
+	second, err := s.SendLiveGift(ctx, 1, 20, 3, 2, "req-dup")
+	if err != nil {
+		t.Fatalf("duplicate SendLiveGift: %v", err)
+	}
+
+	if second.ID != first.ID || second.TotalValue != first.TotalValue {
+		t.Errorf("duplicate returned gift %d (value %d), want original %d (value %d)", second.ID, second.TotalValue, first.ID, first.TotalValue)
+	}
+	if got := repo.giftCount(); got != 1 {
+		t.Errorf("gift records = %d, want 1", got)
+	}
+}
+
+func TestSendLiveGiftConcurrentDuplicatesRecordOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	const attempts = 8
+	var wg sync.WaitGroup
+	gifts := make(chan uint64, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-race")
+			switch {
+			case err == nil:
+				gifts <- gift.ID
+			case !errors.Is(err, ErrGiftRequestInProgress):
+				t.Errorf("SendLiveGift: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(gifts)
+
+	// 与首个请求并发的重复请求返回处理中，之后的重复请求返回原结果，都不会重复记录礼物
+	for id := range gifts {
+		if record := repo.gift(id); record == nil {
+			t.Errorf("returned gift %d was not recorded", id)
+		}
+	}
+	if got := repo.giftCount(); got != 1 {
+		t.Errorf("gift records = %d, want 1", got)
+	}
+}
+
+func TestSendLiveGiftRequestIDScopedToUser(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	// 不同用户使用相同的request_id互不影响，同一用户换用新的request_id视为新请求
+	for _, call := range []struct {
+		userID    uint64
+		requestID string
+	}{
+		{20, "req-1"},
+		{21, "req-1"},
+		{20, "req-2"},
+	} {
+		if _, err := s.SendLiveGift(ctx, 1, call.userID, 3, 1, call.requestID); err != nil {
+			t.Fatalf("SendLiveGift(user %d, %s): %v", call.userID, call.requestID, err)
+		}
+	}
+
+	if got := repo.giftCount(); got != 3 {
+		t.Errorf("gift records = %d, want 3", got)
+	}
+}
+
+func TestSendLiveGiftRetriesAcquireWhenRequestExpires(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	// 占用时记录仍在处理中，读取结果时已过期，应重新占用并正常送礼
+	repo.giftRequests["20:req-expired"] = nil
+	repo.expireGiftRequests = 1
+
+	gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-expired")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	if got := repo.giftCount(); got != 1 {
+		t.Errorf("gift records = %d, want 1", got)
+	}
+	if saved := repo.giftRequests["20:req-expired"]; saved == nil || saved.ID != gift.ID {
+		t.Errorf("saved result = %+v, want gift %d", saved, gift.ID)
+	}
+}
+
+func TestSendLiveGiftReleasesRequestWhenResultNotSaved(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	repo.setGiftRequestErr = errors.New("redis unavailable")
+
+	if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-unsaved"); err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	// 结果未保存时不能留下处理中占位，否则重试会一直返回处理中
+	if _, ok := repo.giftRequests["20:req-unsaved"]; ok {
+		t.Fatal("in-progress marker was kept after the result failed to save")
+	}
+
+	repo.setGiftRequestErr = nil
+	if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-unsaved"); errors.Is(err, ErrGiftRequestInProgress) {
+		t.Fatalf("retry after unsaved result returned %v", err)
+	}
+}
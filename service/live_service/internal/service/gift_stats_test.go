@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestGetLiveGiftStatsAggregatesGifts(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	stream.Status = model.LiveStatusEnded
+	repo.putStream(stream)
+	repo.gifts = []model.LiveGift{
+		{StreamID: 1, UserID: 20, GiftID: 3, GiftCount: 2, TotalValue: 20, Status: model.GiftStatusSuccess},
+		{StreamID: 1, UserID: 21, GiftID: 4, GiftCount: 1, TotalValue: 500, Status: model.GiftStatusSuccess},
+		{StreamID: 1, UserID: 20, GiftID: 4, GiftCount: 1, TotalValue: 500, Status: model.GiftStatusSuccess},
+		{StreamID: 1, UserID: 22, GiftID: 5, GiftCount: 9, TotalValue: 90, Status: model.GiftStatusRefunded},
+		{StreamID: 2, UserID: 20, GiftID: 5, GiftCount: 9, TotalValue: 90, Status: model.GiftStatusSuccess},
+	}
+	s := newTestLiveService(repo)
+
+	// 已结束的直播同样可查询
+	stats, err := s.GetLiveGiftStats(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLiveGiftStats: %v", err)
+	}
+	if stats.StreamID != 1 || stats.TotalGifts != 4 || stats.TotalValue != 1020 || stats.TotalCoins != 1020 || stats.UniqueSenders != 2 {
+		t.Errorf("stats = %+v, want 4 gifts worth 1020 from 2 senders", stats)
+	}
+	// 数量相同时取总价值高的礼物，已退款的不参与
+	if stats.TopGiftID != 4 || stats.TopGiftCount != 2 || stats.TopGiftValue != 1000 {
+		t.Errorf("top gift = %d x%d worth %d, want gift 4 x2 worth 1000", stats.TopGiftID, stats.TopGiftCount, stats.TopGiftValue)
+	}
+}
+
+func TestGetLiveGiftStatsUsesCacheUntilNextGift(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	if _, err := s.SendLiveGift(ctx, 1, 20, 3, 1, "req-1"); err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	first, err := s.GetLiveGiftStats(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetLiveGiftStats: %v", err)
+	}
+	if _, err := s.GetLiveGiftStats(ctx, 1); err != nil {
+		t.Fatalf("cached GetLiveGiftStats: %v", err)
+	}
+	if repo.giftStatsQueries != 1 {
+		t.Errorf("aggregation queries = %d, want 1 with the second call cached", repo.giftStatsQueries)
+	}
+
+	// 送礼后缓存失效，统计包含新礼物
+	if _, err := s.SendLiveGift(ctx, 1, 21, 3, 2, "req-2"); err != nil {
+		t.Fatalf("second SendLiveGift: %v", err)
+	}
+	second, err := s.GetLiveGiftStats(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetLiveGiftStats after gift: %v", err)
+	}
+	if second.TotalGifts != first.TotalGifts+2 || second.UniqueSenders != 2 {
+		t.Errorf("stats after gift = %+v, want 2 more gifts from a second sender (before %+v)", second, first)
+	}
+	if repo.giftStatsQueries != 2 {
+		t.Errorf("aggregation queries = %d, want 2 after invalidation", repo.giftStatsQueries)
+	}
+}
+
+func TestGetLiveGiftStatsErrors(t *testing.T) {
+	s := newTestLiveService(newFakeLiveRepo())
+	if _, err := s.GetLiveGiftStats(context.Background(), 99); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("unknown stream error = %v, want ErrStreamNotFound", err)
+	}
+
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	repo.giftStatsErr = errInjected
+	if _, err := newTestLiveService(repo).GetLiveGiftStats(context.Background(), 1); !errors.Is(err, errInjected) {
+		t.Errorf("aggregation failure error = %v, want it wrapped", err)
+	}
+	if len(repo.giftStatsCache) != 0 {
+		t.Errorf("failed aggregation cached %v", repo.giftStatsCache)
+	}
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+)
+
+// dailyLeaderboardSize 每日排行榜展示条数
+const dailyLeaderboardSize = 10
+
+// GetDailyLeaderboards 获取当日排行榜：主播收礼价值榜与直播峰值在线榜
+// 数据来自按自然日分桶的Redis有序集合，跨天自动切换到新的分桶，结果短暂缓存
+func (s *liveService) GetDailyLeaderboards(ctx context.Context) (*repository.DailyLeaderboards, error) {
+	day := model.LeaderboardDay(time.Now())
+
+	if boards, err := s.liveRepo.GetDailyLeaderboardsCache(ctx, day); err == nil {
+		return boards, nil
+	}
+
+	topStreamers, err := s.liveRepo.GetDailyLeaderboard(ctx, model.GetLiveDailyStreamerGiftKey(day), dailyLeaderboardSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily streamer leaderboard: %w", err)
+	}
+	topStreams, err := s.liveRepo.GetDailyLeaderboard(ctx, model.GetLiveDailyPeakViewerKey(day), dailyLeaderboardSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily stream leaderboard: %w", err)
+	}
+
+	boards := &repository.DailyLeaderboards{
+		Date:         day,
+		TopStreamers: topStreamers,
+		TopStreams:   topStreams,
+	}
+	if err := s.liveRepo.SetDailyLeaderboardsCache(ctx, boards); err != nil {
+		s.logger.Warn("Failed to cache daily leaderboards", "day", day, "error", err)
+	}
+	return boards, nil
+}
+
+// recordDailyGiftValue 累加主播当日收礼价值，失败不影响送礼
+func (s *liveService) recordDailyGiftValue(ctx context.Context, gift *model.LiveGift) {
+	day := model.LeaderboardDay(gift.SendTime)
+	if err := s.liveRepo.IncrDailyStreamerGiftValue(ctx, day, gift.AnchorID, gift.TotalValue); err != nil {
+		s.logger.Warn("Failed to update daily gift leaderboard", "anchorID", gift.AnchorID, "error", err)
+	}
+}
+
+// recordDailyPeakViewers 用当前在线人数刷新直播当日峰值，失败不影响进房
+func (s *liveService) recordDailyPeakViewers(ctx context.Context, streamID uint64, viewers int64) {
+	day := model.LeaderboardDay(time.Now())
+	if err := s.liveRepo.UpdateDailyPeakViewers(ctx, day, streamID, viewers); err != nil {
+		s.logger.Warn("Failed to update daily viewer leaderboard", "streamID", streamID, "error", err)
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+)
+
+func TestDailyLeaderboardsOrderByAccumulatedValue(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+	now := time.Now()
+
+	// 主播1收到两次礼物累计300，超过单次最高的主播2
+	for _, gift := range []*model.LiveGift{
+		{AnchorID: 1, TotalValue: 100, SendTime: now},
+		{AnchorID: 2, TotalValue: 250, SendTime: now},
+		{AnchorID: 1, TotalValue: 200, SendTime: now},
+		{AnchorID: 3, TotalValue: 50, SendTime: now},
+	} {
+		s.recordDailyGiftValue(ctx, gift)
+	}
+	// 峰值只保留当日最大值
+	s.recordDailyPeakViewers(ctx, 10, 80)
+	s.recordDailyPeakViewers(ctx, 11, 120)
+	s.recordDailyPeakViewers(ctx, 10, 150)
+	s.recordDailyPeakViewers(ctx, 10, 90)
+
+	boards, err := s.GetDailyLeaderboards(ctx)
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboards: %v", err)
+	}
+	if boards.Date != model.LeaderboardDay(now) {
+		t.Errorf("date = %s, want %s", boards.Date, model.LeaderboardDay(now))
+	}
+	assertLeaderboard(t, "streamers", boards.TopStreamers, [][2]int64{{1, 300}, {2, 250}, {3, 50}})
+	assertLeaderboard(t, "streams", boards.TopStreams, [][2]int64{{10, 150}, {11, 120}})
+}
+
+func TestDailyLeaderboardsLimitTopEntries(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	for anchorID := uint64(1); anchorID <= dailyLeaderboardSize+5; anchorID++ {
+		s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: anchorID, TotalValue: anchorID * 10, SendTime: time.Now()})
+	}
+
+	boards, err := s.GetDailyLeaderboards(context.Background())
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboards: %v", err)
+	}
+	if len(boards.TopStreamers) != dailyLeaderboardSize || boards.TopStreamers[0].ID != dailyLeaderboardSize+5 {
+		t.Fatalf("top streamers = %d led by %d, want %d led by %d", len(boards.TopStreamers), boards.TopStreamers[0].ID, dailyLeaderboardSize, dailyLeaderboardSize+5)
+	}
+}
+
+func TestDailyGiftValueSplitsAtMidnight(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	midnight := time.Date(2024, 5, 2, 0, 0, 0, 0, time.Local)
+
+	s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: 1, TotalValue: 100, SendTime: midnight.Add(-time.Second)})
+	s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: 1, TotalValue: 30, SendTime: midnight})
+
+	if got := repo.dailyScores[model.GetLiveDailyStreamerGiftKey("20240501")][1]; got != 100 {
+		t.Errorf("2024-05-01 gift value = %d, want 100", got)
+	}
+	if got := repo.dailyScores[model.GetLiveDailyStreamerGiftKey("20240502")][1]; got != 30 {
+		t.Errorf("2024-05-02 gift value = %d, want 30", got)
+	}
+}
+
+func TestDailyLeaderboardsIgnorePreviousDay(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+
+	s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: 1, TotalValue: 10000, SendTime: yesterday})
+	s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: 2, TotalValue: 10, SendTime: now})
+
+	boards, err := s.GetDailyLeaderboards(context.Background())
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboards: %v", err)
+	}
+	assertLeaderboard(t, "streamers", boards.TopStreamers, [][2]int64{{2, 10}})
+}
+
+func TestDailyLeaderboardsServedFromCache(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: 1, TotalValue: 100, SendTime: time.Now()})
+
+	first, err := s.GetDailyLeaderboards(context.Background())
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboards: %v", err)
+	}
+	// 缓存有效期内新增的礼物不会立即反映到榜单上
+	s.recordDailyGiftValue(context.Background(), &model.LiveGift{AnchorID: 2, TotalValue: 500, SendTime: time.Now()})
+	second, err := s.GetDailyLeaderboards(context.Background())
+	if err != nil {
+		t.Fatalf("GetDailyLeaderboards: %v", err)
+	}
+	if second != first {
+		t.Fatal("second call within the cache TTL did not return the cached leaderboards")
+	}
+}
+
+// assertLeaderboard 校验榜单的名次、ID和分数，want按名次排列为{ID, 分数}
+func assertLeaderboard(t *testing.T, name string, got []*repository.LeaderboardEntry, want [][2]int64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s leaderboard has %d entries, want %d", name, len(got), len(want))
+	}
+	for i, entry := range got {
+		if entry.Rank != i+1 || int64(entry.ID) != want[i][0] || entry.Score != want[i][1] {
+			t.Errorf("%s rank %d = %+v, want id %d score %d", name, i+1, *entry, want[i][0], want[i][1])
+		}
+	}
+}
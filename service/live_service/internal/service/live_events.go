@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"common/ctxkeys"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+	"live_service/pkg/notify"
+)
+
+// SubscribeLiveEvents 订阅直播间实时事件，一次订阅接收聊天、礼物、点赞和进出房间全部类型
+// 已结束的直播不可订阅，私密直播仅允许主播本人和关注者订阅
+func (s *liveService) SubscribeLiveEvents(ctx context.Context, streamID, userID uint64) (*repository.LiveEventSubscription, error) {
+	s.logger.Info("Subscribing live events", "streamID", streamID, "userID", userID)
+
+	stream, err := s.GetLiveStream(ctx, streamID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := s.canViewLiveStream(ctx, stream, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, ErrStreamPrivate
+	}
+
+	return s.eventHub.Subscribe(ctx, streamID)
+}
+
+// publishLiveEvent 广播直播间事件，失败只记录日志，不影响已完成的业务操作
+func (s *liveService) publishLiveEvent(ctx context.Context, eventType model.LiveEventType, streamID, userID uint64, data interface{}) {
+	event, err := model.NewLiveEvent(eventType, streamID, userID, data)
+	if err == nil {
+		err = s.eventHub.Publish(ctx, event)
+	}
+	if err != nil {
+		requestID, _ := ctxkeys.RequestID(ctx)
+		s.logger.Warn("Failed to publish live event", "streamID", streamID, "userID", userID, "type", eventType, "requestID", requestID, "error", err)
+	}
+}
+
+// publishGiftEvent 广播礼物事件
+func (s *liveService) publishGiftEvent(ctx context.Context, gift *model.LiveGift) {
+	s.publishLiveEvent(ctx, model.LiveEventGift, gift.StreamID, gift.UserID, model.LiveGiftEventData{
+		GiftRecordID:    gift.ID,
+		GiftID:          gift.GiftID,
+		GiftName:        gift.GiftName,
+		GiftIcon:        gift.GiftIcon,
+		GiftCount:       gift.GiftCount,
+		TotalValue:      gift.TotalValue,
+		ComboCount:      gift.ComboCount,
+		ComboMultiplier: gift.ComboMultiplier,
+		DisplayValue:    gift.DisplayValue,
+		EffectType:      gift.EffectType,
+		EffectValue:     gift.EffectData,
+	})
+}
+
+// notifyGiftReceived 通知主播收到礼物，主播给自己送礼时不通知
+func (s *liveService) notifyGiftReceived(gift *model.LiveGift) {
+	if gift.UserID == gift.AnchorID {
+		return
+	}
+	s.notifier.Notify(notify.Notification{
+		Type:     notify.TypeGift,
+		UserID:   gift.AnchorID,
+		ActorID:  gift.UserID,
+		TargetID: strconv.FormatUint(gift.StreamID, 10),
+		Title:    "收到礼物",
+		Content:  fmt.Sprintf("送出了%d个%s", gift.GiftCount, gift.GiftName),
+	})
+}
+
+// publishChatEvent 广播聊天事件
+func (s *liveService) publishChatEvent(ctx context.Context, chat *model.LiveChat) {
+	s.publishLiveEvent(ctx, model.LiveEventChat, chat.StreamID, chat.UserID, model.LiveChatEventData{
+		ChatID:      chat.ID,
+		Content:     chat.Content,
+		ContentType: chat.ContentType,
+		IsAnchor:    chat.IsAnchor,
+		IsAdmin:     chat.IsAdmin,
+	})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+// decodeEventData 解析事件数据
+func decodeEventData(t *testing.T, event model.LiveEvent, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(event.Data, v); err != nil {
+		t.Fatalf("decode %s event data %s: %v", event.Type, event.Data, err)
+	}
+}
+
+func TestSendLiveGiftPublishesGiftEvent(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	first, err := s.SendLiveGift(context.Background(), 1, 20, 3, 2, "req-1")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	second, err := s.SendLiveGift(context.Background(), 1, 20, 3, 2, "req-2")
+	if err != nil {
+		t.Fatalf("second SendLiveGift: %v", err)
+	}
+
+	events := repo.publishedLiveEvents(model.LiveEventGift)
+	if len(events) != 2 {
+		t.Fatalf("gift events = %d, want 2", len(events))
+	}
+	event := events[0]
+	if event.StreamID != 1 || event.UserID != 20 || event.Timestamp == 0 {
+		t.Errorf("event = stream %d user %d timestamp %d, want stream 1 user 20 with a timestamp", event.StreamID, event.UserID, event.Timestamp)
+	}
+	var data model.LiveGiftEventData
+	decodeEventData(t, event, &data)
+	if data.GiftRecordID != first.ID || data.GiftID != 3 || data.GiftCount != 2 || data.TotalValue != first.TotalValue || data.GiftName != first.GiftName {
+		t.Errorf("gift data = %+v, want the recorded gift %+v", data, first)
+	}
+
+	// 连击在事件发布前回写，客户端据此渲染连击特效
+	decodeEventData(t, events[1], &data)
+	if data.GiftRecordID != second.ID || data.ComboCount != second.ComboCount || data.ComboCount < 2 {
+		t.Errorf("second gift data = %+v, want combo %d", data, second.ComboCount)
+	}
+}
+
+func TestRepeatedGiftRequestPublishesOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	for i := 0; i < 2; i++ {
+		if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-dup"); err != nil {
+			t.Fatalf("SendLiveGift #%d: %v", i+1, err)
+		}
+	}
+	if got := len(repo.publishedLiveEvents(model.LiveEventGift)); got != 1 {
+		t.Errorf("gift events = %d, want 1 for a repeated request", got)
+	}
+}
+
+func TestLikeLivePublishesLikeEvent(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s, _ := newPresenceTestService(repo)
+
+	for i := 0; i < 3; i++ {
+		if err := s.LikeLive(context.Background(), 1, 20); err != nil {
+			t.Fatalf("LikeLive: %v", err)
+		}
+	}
+
+	// 每次点赞单独广播
+	events := repo.publishedLiveEvents(model.LiveEventLike)
+	if len(events) != 3 {
+		t.Fatalf("like events = %d, want 3", len(events))
+	}
+	var data model.LiveLikeEventData
+	decodeEventData(t, events[0], &data)
+	if events[0].StreamID != 1 || events[0].UserID != 20 || data.Count != 1 {
+		t.Errorf("like event = %+v data %+v, want one like from user 20", events[0], data)
+	}
+}
+
+func TestLikeLiveUnknownStreamPublishesNothing(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, _ := newPresenceTestService(repo)
+
+	if err := s.LikeLive(context.Background(), 99, 20); !errors.Is(err, ErrStreamNotFound) {
+		t.Fatalf("LikeLive error = %v, want ErrStreamNotFound", err)
+	}
+	if got := len(repo.publishedLiveEvents(model.LiveEventLike)); got != 0 {
+		t.Errorf("like events = %d, want none", got)
+	}
+}
+
+func TestSendLiveChatPublishesChatEvent(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	chat, err := s.SendLiveChat(context.Background(), 1, 10, "欢迎", "text")
+	if err != nil {
+		t.Fatalf("SendLiveChat: %v", err)
+	}
+
+	events := repo.publishedLiveEvents(model.LiveEventChat)
+	if len(events) != 1 {
+		t.Fatalf("chat events = %d, want 1", len(events))
+	}
+	var data model.LiveChatEventData
+	decodeEventData(t, events[0], &data)
+	if data.ChatID != chat.ID || data.Content != "欢迎" || !data.IsAnchor {
+		t.Errorf("chat data = %+v, want the anchor's message", data)
+	}
+}
+
+func TestLiveEventPublishFailureDoesNotFailMutation(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	repo.publishErr = errInjected
+	s, _ := newPresenceTestService(repo)
+
+	if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); err != nil {
+		t.Errorf("SendLiveGift with failing hub: %v", err)
+	}
+	if err := s.LikeLive(context.Background(), 1, 20); err != nil {
+		t.Errorf("LikeLive with failing hub: %v", err)
+	}
+	if got := repo.giftCount(); got != 1 {
+		t.Errorf("gift records = %d, want the gift kept", got)
+	}
+}
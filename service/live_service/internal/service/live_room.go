@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// 直播间房间号
+const (
+	roomNumberMin      = 10000000 // 房间号为8位数字
+	roomNumberSpan     = 90000000
+	roomNumberAttempts = 5 // 房间号冲突时的最多尝试次数
+)
+
+// GetLiveRoom 获取直播间，roomID为0时按主播ID查找
+// 直播间的累计统计在每场直播结束时结算，不包含正在进行的直播
+func (s *liveService) GetLiveRoom(ctx context.Context, roomID, streamerID uint64) (*model.LiveRoom, error) {
+	s.logger.Info("Getting live room", "roomID", roomID, "streamerID", streamerID)
+
+	var room *model.LiveRoom
+	var err error
+	if roomID != 0 {
+		room, err = s.liveRepo.GetLiveRoom(ctx, roomID)
+	} else {
+		room, err = s.liveRepo.GetLiveRoomByUserID(ctx, streamerID)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to get live room: %w", err)
+	}
+	return room, nil
+}
+
+// getOrCreateLiveRoom 获取主播的直播间，首次开播时以本场直播的标题和简介创建
+// 并发开播或房间号冲突导致未创建时重新读取，直到读到直播间或用完尝试次数
+func (s *liveService) getOrCreateLiveRoom(ctx context.Context, userID uint64, name, description string) (*model.LiveRoom, error) {
+	for attempt := 0; attempt < roomNumberAttempts; attempt++ {
+		room, err := s.liveRepo.GetLiveRoomByUserID(ctx, userID)
+		if err == nil {
+			return room, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get live room: %w", err)
+		}
+
+		roomNumber, err := newRoomNumber()
+		if err != nil {
+			return nil, err
+		}
+		room = &model.LiveRoom{
+			RoomNumber:  roomNumber,
+			Name:        name,
+			Description: description,
+			UserID:      userID,
+			Status:      model.RoomStatusOffline,
+			IsActive:    true,
+		}
+		created, err := s.liveRepo.CreateLiveRoomIfAbsent(ctx, room)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create live room: %w", err)
+		}
+		if created {
+			s.logger.Info("Live room created", "roomID", room.ID, "roomNumber", room.RoomNumber, "userID", userID)
+			return room, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to allocate live room number for user %d", userID)
+}
+
+// newRoomNumber 生成随机的8位数字房间号
+func newRoomNumber() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(roomNumberSpan))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate room number: %w", err)
+	}
+	return fmt.Sprintf("%d", roomNumberMin+n.Int64()), nil
+}
+
+// newStreamKey 生成推流密钥
+func newStreamKey() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate stream key: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// roomRepo 在fakeLiveRepo基础上保存直播间，按用户ID保证每个主播只有一个直播间
+type roomRepo struct {
+	*fakeLiveRepo
+	rooms map[uint64]*model.LiveRoom
+	// roomStreams 每个直播间开始直播的次数
+	roomStreams map[uint64]int
+	// lostCreates 创建直播间时模拟并发开播的次数，由其他请求抢先创建直播间
+	lostCreates int
+	getRoomErr  error
+}
+
+func newRoomRepo() *roomRepo {
+	return &roomRepo{
+		fakeLiveRepo: newFakeLiveRepo(),
+		rooms:        make(map[uint64]*model.LiveRoom),
+		roomStreams:  make(map[uint64]int),
+	}
+}
+
+func (r *roomRepo) GetLiveStreamByUserID(ctx context.Context, userID uint64) (*model.LiveStream, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.streams {
+		if s.UserID == userID && !model.LiveStatus(s.Status).IsTerminal() {
+			stream := s
+			return &stream, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *roomRepo) CreateLiveStream(ctx context.Context, stream *model.LiveStream) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	stream.ID = r.nextID
+	r.streams[stream.ID] = *stream
+	return nil
+}
+
+func (r *roomRepo) CreateLiveRoomIfAbsent(ctx context.Context, room *model.LiveRoom) (bool, error) {
+	if r.lostCreates > 0 {
+		r.lostCreates--
+		r.putRoom(&model.LiveRoom{UserID: room.UserID, RoomNumber: "99999999", IsActive: true})
+		return false, nil
+	}
+	for _, existing := range r.rooms {
+		if existing.UserID == room.UserID || existing.RoomNumber == room.RoomNumber {
+			return false, nil
+		}
+	}
+	r.putRoom(room)
+	return true, nil
+}
+
+func (r *roomRepo) putRoom(room *model.LiveRoom) {
+	r.nextID++
+	room.ID = r.nextID
+	r.rooms[room.ID] = room
+}
+
+func (r *roomRepo) GetLiveRoom(ctx context.Context, roomID uint64) (*model.LiveRoom, error) {
+	if r.getRoomErr != nil {
+		return nil, r.getRoomErr
+	}
+	room, ok := r.rooms[roomID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *room
+	return &copied, nil
+}
+
+func (r *roomRepo) GetLiveRoomByUserID(ctx context.Context, userID uint64) (*model.LiveRoom, error) {
+	if r.getRoomErr != nil {
+		return nil, r.getRoomErr
+	}
+	for _, room := range r.rooms {
+		if room.UserID == userID {
+			copied := *room
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *roomRepo) StartLiveRoomStream(ctx context.Context, roomID uint64) error {
+	room, ok := r.rooms[roomID]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	room.TotalStreams++
+	room.Status = model.RoomStatusOnline
+	r.roomStreams[roomID]++
+	return nil
+}
+
+func newRoomTestService(repo *roomRepo) *liveService {
+	s := newTestLiveService(repo.fakeLiveRepo)
+	s.liveRepo = repo
+	return s
+}
+
+func TestStartLiveCreatesRoomOnFirstStream(t *testing.T) {
+	repo := newRoomRepo()
+	s := newRoomTestService(repo)
+
+	stream, err := s.StartLive(context.Background(), 7, "今晚开黑", "一起来玩", 3, "", "")
+	if err != nil {
+		t.Fatalf("StartLive: %v", err)
+	}
+	if len(repo.rooms) != 1 {
+		t.Fatalf("created %d rooms, want 1", len(repo.rooms))
+	}
+	room := repo.rooms[stream.RoomID]
+	if room == nil || room.UserID != 7 || room.Name != "今晚开黑" || room.Description != "一起来玩" {
+		t.Fatalf("room = %+v, want a room of user 7 named after the first stream", room)
+	}
+	if len(room.RoomNumber) != 8 || room.RoomNumber[0] == '0' {
+		t.Errorf("room number = %q, want 8 digits", room.RoomNumber)
+	}
+	if room.TotalStreams != 1 || room.Status != model.RoomStatusOnline {
+		t.Errorf("room streams %d status %d, want 1 stream and online", room.TotalStreams, room.Status)
+	}
+	if stream.UserID != 7 || stream.Status != model.LiveStatusPreparing || stream.CategoryID != 3 || len(stream.StreamKey) != 32 {
+		t.Errorf("stream = %+v, want a preparing stream with a stream key", stream)
+	}
+}
+
+func TestStartLiveReusesRoomAcrossStreams(t *testing.T) {
+	repo := newRoomRepo()
+	s := newRoomTestService(repo)
+	ctx := context.Background()
+
+	first, err := s.StartLive(ctx, 7, "第一场", "", 0, "", "")
+	if err != nil {
+		t.Fatalf("first StartLive: %v", err)
+	}
+	ended := repo.stream(first.ID)
+	ended.Status = model.LiveStatusEnded
+	repo.putStream(ended)
+
+	second, err := s.StartLive(ctx, 7, "第二场", "", 0, "", "")
+	if err != nil {
+		t.Fatalf("second StartLive: %v", err)
+	}
+	if second.ID == first.ID || second.StreamKey == first.StreamKey {
+		t.Errorf("second stream %d key %q, want a new stream and key", second.ID, second.StreamKey)
+	}
+	if second.RoomID != first.RoomID || len(repo.rooms) != 1 {
+		t.Fatalf("second stream room %d, want the same room %d", second.RoomID, first.RoomID)
+	}
+	// 直播间名称保持首次开播时的标题
+	room := repo.rooms[first.RoomID]
+	if room.TotalStreams != 2 || room.Name != "第一场" {
+		t.Errorf("room = %+v, want 2 streams and the original name", room)
+	}
+}
+
+func TestStartLiveReturnsActiveStream(t *testing.T) {
+	repo := newRoomRepo()
+	s := newRoomTestService(repo)
+	ctx := context.Background()
+
+	first, err := s.StartLive(ctx, 7, "今晚开黑", "", 0, "", "")
+	if err != nil {
+		t.Fatalf("first StartLive: %v", err)
+	}
+	again, err := s.StartLive(ctx, 7, "重复开播", "", 0, "", "")
+	if err != nil {
+		t.Fatalf("second StartLive: %v", err)
+	}
+	if again.ID != first.ID || again.Title != "今晚开黑" {
+		t.Errorf("StartLive returned stream %d %q, want the active stream %d", again.ID, again.Title, first.ID)
+	}
+	if repo.roomStreams[first.RoomID] != 1 {
+		t.Errorf("room started %d streams, want 1", repo.roomStreams[first.RoomID])
+	}
+}
+
+func TestStartLiveRejectsBannedRoom(t *testing.T) {
+	tests := []struct {
+		name string
+		room model.LiveRoom
+	}{
+		{"banned", model.LiveRoom{UserID: 7, RoomNumber: "10000001", Status: model.RoomStatusBanned, IsActive: true}},
+		{"inactive", model.LiveRoom{UserID: 7, RoomNumber: "10000001", Status: model.RoomStatusOffline}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newRoomRepo()
+			room := tt.room
+			repo.putRoom(&room)
+			s := newRoomTestService(repo)
+
+			if _, err := s.StartLive(context.Background(), 7, "今晚开黑", "", 0, "", ""); !errors.Is(err, ErrRoomBanned) {
+				t.Fatalf("StartLive error = %v, want ErrRoomBanned", err)
+			}
+			if len(repo.streams) != 0 || room.TotalStreams != 0 {
+				t.Errorf("banned room created %d streams, total streams %d", len(repo.streams), room.TotalStreams)
+			}
+		})
+	}
+}
+
+func TestStartLiveRereadsRoomCreatedConcurrently(t *testing.T) {
+	repo := newRoomRepo()
+	repo.lostCreates = 1
+	s := newRoomTestService(repo)
+
+	stream, err := s.StartLive(context.Background(), 7, "今晚开黑", "", 0, "", "")
+	if err != nil {
+		t.Fatalf("StartLive: %v", err)
+	}
+	// 使用并发创建的直播间，不再创建新的直播间
+	if len(repo.rooms) != 1 || repo.rooms[stream.RoomID].RoomNumber != "99999999" {
+		t.Errorf("stream room %d, want the concurrently created room", stream.RoomID)
+	}
+}
+
+func TestGetLiveRoom(t *testing.T) {
+	repo := newRoomRepo()
+	room := &model.LiveRoom{UserID: 7, RoomNumber: "12345678", TotalStreams: 3}
+	repo.putRoom(room)
+	s := newRoomTestService(repo)
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name               string
+		roomID, streamerID uint64
+	}{
+		{"by room id", room.ID, 0},
+		{"by streamer id", 0, 7},
+		// 同时指定时以直播间ID为准
+		{"room id takes precedence", room.ID, 8},
+	} {
+		got, err := s.GetLiveRoom(ctx, tt.roomID, tt.streamerID)
+		if err != nil {
+			t.Fatalf("%s: GetLiveRoom: %v", tt.name, err)
+		}
+		if got.ID != room.ID || got.TotalStreams != 3 {
+			t.Errorf("%s: room = %+v, want room %d", tt.name, got, room.ID)
+		}
+	}
+
+	if _, err := s.GetLiveRoom(ctx, 0, 8); !errors.Is(err, ErrRoomNotFound) {
+		t.Errorf("unknown streamer error = %v, want ErrRoomNotFound", err)
+	}
+	repo.getRoomErr = errInjected
+	if _, err := s.GetLiveRoom(ctx, room.ID, 0); !errors.Is(err, errInjected) || errors.Is(err, ErrRoomNotFound) {
+		t.Errorf("repository failure error = %v, want the wrapped failure", err)
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+)
+
+// searchRepo 记录传给仓库的搜索条件
+type searchRepo struct {
+	*fakeLiveRepo
+	filter   repository.LiveStreamSearchFilter
+	viewerID uint64
+	err      error
+}
+
+func (r *searchRepo) SearchLiveStream(ctx context.Context, filter repository.LiveStreamSearchFilter, viewerID uint64, page, pageSize int) ([]*model.LiveStream, int64, error) {
+	r.filter, r.viewerID = filter, viewerID
+	if r.err != nil {
+		return nil, 0, r.err
+	}
+	return []*model.LiveStream{{ID: 1}}, 1, nil
+}
+
+func TestSearchLiveNormalizesFilter(t *testing.T) {
+	repo := &searchRepo{fakeLiveRepo: newFakeLiveRepo()}
+	s := newTestLiveService(repo.fakeLiveRepo)
+	s.liveRepo = repo
+
+	filter := repository.LiveStreamSearchFilter{Keyword: "开黑", CategoryID: 7, MinViewers: 100, Language: " ZH", Region: "cn "}
+	streams, total, err := s.SearchLive(context.Background(), 10, filter, 1, 20)
+	if err != nil || total != 1 || len(streams) != 1 {
+		t.Fatalf("SearchLive = (%v, %d, %v), want one stream", streams, total, err)
+	}
+	want := repository.LiveStreamSearchFilter{Keyword: "开黑", CategoryID: 7, MinViewers: 100, Language: "zh", Region: "CN"}
+	if repo.filter != want || repo.viewerID != 10 {
+		t.Errorf("repository got filter %+v for viewer %d, want %+v for viewer 10", repo.filter, repo.viewerID, want)
+	}
+}
+
+func TestSearchLiveError(t *testing.T) {
+	repo := &searchRepo{fakeLiveRepo: newFakeLiveRepo(), err: errors.New("db down")}
+	s := newTestLiveService(repo.fakeLiveRepo)
+	s.liveRepo = repo
+
+	if _, _, err := s.SearchLive(context.Background(), 0, repository.LiveStreamSearchFilter{}, 1, 20); !errors.Is(err, repo.err) {
+		t.Errorf("SearchLive error = %v, want the repository error", err)
+	}
+}
+
+func TestStartLiveNormalizesLanguageAndRegion(t *testing.T) {
+	repo := newRoomRepo()
+	s := newRoomTestService(repo)
+
+	stream, err := s.StartLive(context.Background(), 7, "今晚开黑", "", 0, "EN ", " us")
+	if err != nil {
+		t.Fatalf("StartLive: %v", err)
+	}
+	// 开播时保存规范化后的语言和地区，与搜索条件的规范化一致
+	if stored := repo.stream(stream.ID); stored.Language != "en" || stored.Region != "US" {
+		t.Errorf("stored language %q region %q, want en and US", stored.Language, stored.Region)
+	}
+}
@@ -2,50 +2,119 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/go-redis/redis/v8"
+	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 
 	"live_service/internal/config"
 	"live_service/internal/model"
 	"live_service/internal/repository"
+	"live_service/pkg/featureflags"
 	"live_service/pkg/logger"
+	"live_service/pkg/notify"
+	"live_service/pkg/searchindex"
 )
 
 // LiveService 直播服务接口
 type LiveService interface {
 	// 直播流管理
-	StartLive(ctx context.Context, userID uint64, title, description string, categoryID uint32) (*model.LiveStream, error)
+	StartLive(ctx context.Context, userID uint64, title, description string, categoryID uint32, language, region string) (*model.LiveStream, error)
 	StopLive(ctx context.Context, streamID, userID uint64) error
+	PauseLive(ctx context.Context, streamID, userID uint64) error
+	ResumeLive(ctx context.Context, streamID, userID uint64) error
 	GetLiveStream(ctx context.Context, streamID uint64) (*model.LiveStream, error)
-	GetLiveList(ctx context.Context, page, pageSize int, categoryID uint32) ([]*model.LiveStream, int64, error)
+	GetUserLiveStream(ctx context.Context, userID uint64) (*model.LiveStream, error)
+	GetLiveList(ctx context.Context, viewerID uint64, page, pageSize int, categoryID uint32) ([]*model.LiveStream, int64, error)
 	GetHotLiveList(ctx context.Context, page, pageSize int) ([]*model.LiveStream, int64, error)
+	GetLiveRoom(ctx context.Context, roomID, streamerID uint64) (*model.LiveRoom, error)
+
+	// 推流回调
+	AuthenticateStreamKey(ctx context.Context, streamKey string) (*model.LiveStream, error)
+	OnStreamEnded(ctx context.Context, streamKey string) (*model.LiveStream, error)
 
 	// 直播间管理
-	JoinLiveRoom(ctx context.Context, streamID, userID uint64) (*model.LiveViewer, error)
+	JoinLiveRoom(ctx context.Context, streamID, userID uint64, password string) (*model.LiveViewer, error)
+	BatchJoinLiveRoom(ctx context.Context, streamID uint64, userIDs []uint64) (int64, error)
+	UpdateLiveStreamPrivacy(ctx context.Context, streamID, userID uint64, isPublic bool, password string) error
 	LeaveLiveRoom(ctx context.Context, streamID, userID uint64) error
+	Heartbeat(ctx context.Context, streamID, userID uint64) error
 	GetLiveViewerList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveViewer, int64, error)
 
+	// 实时事件
+	SubscribeLiveEvents(ctx context.Context, streamID, userID uint64) (*repository.LiveEventSubscription, error)
+	StreamViewerCount(ctx context.Context, streamID, userID uint64) (<-chan int64, error)
+
 	// 聊天消息
 	SendLiveChat(ctx context.Context, streamID, userID uint64, content, contentType string) (*model.LiveChat, error)
-	GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveChat, int64, error)
+	GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error)
+	MuteViewer(ctx context.Context, streamID, operatorID, userID uint64, duration uint32, reason string) error
+	UnmuteViewer(ctx context.Context, streamID, operatorID, userID uint64) error
 
 	// 礼物系统
-	SendLiveGift(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32) (*model.LiveGift, error)
-	GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveGift, int64, error)
+	SendLiveGift(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32, requestID string) (*model.LiveGift, error)
+	GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int, cursor string) ([]*model.LiveGift, int64, string, error)
+	GetUserLiveGiftList(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error)
+	GetLiveGiftStats(ctx context.Context, streamID uint64) (*GiftStats, error)
+
+	// 排行榜
+	GetDailyLeaderboards(ctx context.Context) (*repository.DailyLeaderboards, error)
 
 	// 互动功能
 	LikeLive(ctx context.Context, streamID, userID uint64) error
 
 	// 搜索和推荐
-	SearchLive(ctx context.Context, keyword string, page, pageSize int) ([]*model.LiveStream, int64, error)
+	SearchLive(ctx context.Context, viewerID uint64, filter repository.LiveStreamSearchFilter, page, pageSize int) ([]*model.LiveStream, int64, error)
 	GetLiveCategories(ctx context.Context) ([]*LiveCategory, error)
 
 	// 统计和分析
 	GetLiveStats(ctx context.Context, streamID uint64) (*LiveStats, error)
 	GetLivePlayback(ctx context.Context, streamID uint64) (*LivePlayback, error)
+	RecomputeLiveStats(ctx context.Context, operatorID, streamID uint64) (*LiveStats, error)
+
+	// 管理
+	ForceStopLive(ctx context.Context, operatorID, streamID uint64, reason string) (*model.LiveStream, error)
+	RefundLiveGift(ctx context.Context, operatorID, giftRecordID uint64, reason string) (*model.LiveGift, error)
+
+	// Close 提交未写入的统计并释放资源，服务退出时调用
+	Close(ctx context.Context) error
 }
 
+// 直播流错误
+var (
+	ErrStreamNotFound         = errors.New("live stream not found")
+	ErrStreamPermissionDenied = errors.New("no permission to operate live stream")
+	ErrStreamKeyNotFound      = errors.New("stream key not found")
+	ErrStreamKeyInactive      = errors.New("stream key is no longer active")
+	ErrStreamPrivate          = errors.New("live stream is only visible to followers")
+	ErrRoomPasswordRequired   = errors.New("room password required")
+	ErrRoomPasswordIncorrect  = errors.New("room password incorrect")
+	ErrRoomPasswordTooLong    = errors.New("room password is longer than 72 bytes")
+	ErrGiftRequestInProgress  = errors.New("gift request is still being processed")
+	ErrUserMuted              = errors.New("user is muted in this live stream")
+	ErrCannotMuteStreamer     = errors.New("cannot mute the streamer")
+	ErrChatContentEmpty       = errors.New("chat content is empty")
+	ErrChatRejected           = errors.New("chat content rejected by moderation")
+	ErrStreamNotFinished      = errors.New("live stream has not finished")
+	ErrViewerNotInRoom        = errors.New("viewer is not in the live room")
+	ErrRoomNotFound           = errors.New("live room not found")
+	ErrRoomBanned             = errors.New("live room is banned")
+	ErrPlaybackNotFound       = errors.New("live playback not found")
+
+	// ErrInvalidStatusTransition 当前直播状态不允许该操作
+	ErrInvalidStatusTransition = model.ErrInvalidLiveStatusTransition
+)
+
+// 特性开关
+const (
+	// flagLiveChatModeration 发送聊天消息前执行内容审核，支持按发言用户灰度
+	flagLiveChatModeration = "live_chat_moderation"
+)
+
 // LiveCategory 直播分类
 type LiveCategory struct {
 	ID        uint32 `json:"id"`
@@ -78,6 +147,8 @@ type LivePlayback struct {
 	Format      string `json:"format"`
 	Quality     string `json:"quality"`
 	CreatedAt   int64  `json:"created_at"`
+	// Status 回放状态，processing时客户端应提示转码中，只有ready时PlaybackURL可播放
+	Status string `json:"status"`
 }
 
 // liveService 直播服务实现
@@ -88,90 +159,322 @@ type liveService struct {
 	streamManager StreamManager
 	chatManager   ChatManager
 	giftManager   GiftManager
+	statsBatcher  *repository.StatsBatcher
+	flags         *featureflags.Flags
+	indexer       *searchindex.Publisher
+	eventHub      *repository.LiveEventHub
+	notifier      *notify.Publisher
+
+	// 超时观看者清理任务
+	presenceStop     chan struct{}
+	presenceDone     chan struct{}
+	presenceStopOnce sync.Once
+
+	// 聊天记录保留任务
+	retentionStop     chan struct{}
+	retentionDone     chan struct{}
+	retentionStopOnce sync.Once
 }
 
 // NewLiveService 创建直播服务
-func NewLiveService(cfg *config.Config, log logger.Logger, db *gorm.DB, redis *redis.Client) LiveService {
+func NewLiveService(cfg *config.Config, log logger.Logger, db *gorm.DB, redis redis.UniversalClient) LiveService {
 	liveRepo := repository.NewLiveRepository(db, redis, log)
 	streamManager := NewStreamManager(cfg, log, liveRepo)
 	chatManager := NewChatManager(cfg, log, liveRepo)
 	giftManager := NewGiftManager(cfg, log, liveRepo)
-
-	return &liveService{
+	statsBatcher := repository.NewStatsBatcher(redis, cfg.Live.Stats.FlushInterval, log)
+	eventHub := repository.NewLiveEventHub(redis, repository.DefaultLiveEventBufferSize, log)
+	// 搜索索引事件经Redis队列投递给search_service，未开启时不发布
+	var indexer *searchindex.Publisher
+	if cfg.SearchIndex.Enabled {
+		indexer = searchindex.NewPublisher(searchindex.NewRedisSink(redis, cfg.SearchIndex.QueueKey), cfg.SearchIndex.BufferSize, log)
+	}
+	// 用户通知经Redis队列投递给message_service，未开启时不发布
+	var notifier *notify.Publisher
+	if cfg.UserNotification.Enabled {
+		notifier = notify.NewPublisher(notify.NewRedisSink(redis, cfg.UserNotification.QueueKey), cfg.UserNotification.BufferSize, log)
+	}
+	flags := featureflags.New(
+		featureflags.NewRedisSource(redis, cfg.FeatureFlags.RedisKey),
+		cfg.FeatureFlags.Defaults,
+		cfg.FeatureFlags.RefreshInterval,
+		log,
+	)
+
+	s := &liveService{
 		config:        cfg,
 		logger:        log,
 		liveRepo:      liveRepo,
 		streamManager: streamManager,
 		chatManager:   chatManager,
 		giftManager:   giftManager,
+		statsBatcher:  statsBatcher,
+		flags:         flags,
+		indexer:       indexer,
+		eventHub:      eventHub,
+		notifier:      notifier,
 	}
+
+	// 观看人数批量提交后再刷新当日峰值，保证峰值基于已落地的计数
+	statsBatcher.OnFlush(func(ctx context.Context, kind repository.StatsKind, streamID uint64, value int64) {
+		if kind == repository.StatsViewerCount {
+			s.recordDailyPeakViewers(ctx, streamID, value)
+			s.publishLiveEvent(ctx, model.LiveEventViewerCount, streamID, 0, model.LiveViewerCountEventData{Count: value})
+		}
+	})
+	statsBatcher.Start()
+	flags.Start()
+	indexer.Start()
+	notifier.Start()
+	s.startPresenceSweeper()
+	s.startChatRetention()
+
+	return s
+}
+
+// Close 提交未写入的统计并释放资源
+func (s *liveService) Close(ctx context.Context) error {
+	s.flags.Stop()
+	// 先停止清理任务，保证其扣减的观看人数随统计一并提交
+	if err := s.stopPresenceSweeper(ctx); err != nil {
+		return err
+	}
+	if err := s.stopChatRetention(ctx); err != nil {
+		return err
+	}
+	return errors.Join(s.statsBatcher.Stop(ctx), s.indexer.Stop(ctx), s.notifier.Stop(ctx), s.eventHub.Close(ctx))
 }
 
 // StartLive 开始直播
-func (s *liveService) StartLive(ctx context.Context, userID uint64, title, description string, categoryID uint32) (*model.LiveStream, error) {
+// 主播首次开播时创建直播间，之后每次开播都在同一直播间下创建新的直播流；已有未结束的直播时直接返回该直播
+// language和region用于直播搜索过滤，可为空
+func (s *liveService) StartLive(ctx context.Context, userID uint64, title, description string, categoryID uint32, language, region string) (*model.LiveStream, error) {
 	s.logger.Info("Starting live stream", "userID", userID, "title", title)
 
-	// TODO: 实现开始直播逻辑
-	// 这里应该包含：
-	// 1. 检查用户是否有权限开播
-	// 2. 创建直播流记录
-	// 3. 生成推流地址
-	// 4. 初始化直播间状态
-	// 5. 设置直播参数
-
-	return &model.LiveStream{
-		ID:          1,
-		UserID:      userID,
+	active, err := s.liveRepo.GetLiveStreamByUserID(ctx, userID)
+	if err == nil {
+		s.logger.Info("Streamer already has an active live stream", "userID", userID, "streamID", active.ID)
+		return active, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get active live stream: %w", err)
+	}
+
+	room, err := s.getOrCreateLiveRoom(ctx, userID, title, description)
+	if err != nil {
+		return nil, err
+	}
+	if room.Status == model.RoomStatusBanned || !room.IsActive {
+		return nil, ErrRoomBanned
+	}
+
+	streamKey, err := newStreamKey()
+	if err != nil {
+		return nil, err
+	}
+	stream := &model.LiveStream{
+		StreamKey:   streamKey,
 		Title:       title,
 		Description: description,
+		UserID:      userID,
+		RoomID:      room.ID,
 		CategoryID:  categoryID,
+		Language:    model.NormalizeLanguage(language),
+		Region:      model.NormalizeRegion(region),
 		Status:      model.LiveStatusPreparing,
-	}, nil
+		StreamType:  model.StreamTypeRTMP,
+	}
+	if err := s.liveRepo.CreateLiveStream(ctx, stream); err != nil {
+		return nil, fmt.Errorf("failed to create live stream: %w", err)
+	}
+	if err := s.liveRepo.StartLiveRoomStream(ctx, room.ID); err != nil {
+		return nil, fmt.Errorf("failed to update live room: %w", err)
+	}
+
+	s.invalidateUserLiveStream(ctx, userID)
+
+	s.indexLiveStream(stream)
+	s.logger.Info("Live stream created", "streamID", stream.ID, "roomID", room.ID)
+	return stream, nil
 }
 
 // StopLive 结束直播
 func (s *liveService) StopLive(ctx context.Context, streamID, userID uint64) error {
 	s.logger.Info("Stopping live stream", "streamID", streamID, "userID", userID)
 
-	// TODO: 实现结束直播逻辑
-	// 这里应该包含：
-	// 1. 验证用户权限
-	// 2. 更新直播流状态
-	// 3. 计算直播时长
-	// 4. 生成回放文件
-	// 5. 清理相关资源
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrStreamNotFound
+		}
+		return fmt.Errorf("failed to get live stream: %w", err)
+	}
+	if stream.UserID != userID {
+		return ErrStreamPermissionDenied
+	}
+	// 已结束时重复下播视为成功，已封禁的直播不能再走正常结束流程
+	if err := model.ValidateLiveStatusTransition(model.LiveStatus(stream.Status), model.LiveStatusEnded); err != nil {
+		return err
+	}
 
-	return nil
+	_, err = s.finalizeLiveStream(ctx, streamID)
+	return err
+}
+
+// finalizeLiveStream 结束直播流并结算时长、统计和回放
+// 主动下播和断流回调都会走到这里，重复调用时只有第一次会真正结算
+func (s *liveService) finalizeLiveStream(ctx context.Context, streamID uint64) (*model.LiveStream, error) {
+	locked, err := s.liveRepo.AcquireLiveStreamLock(ctx, streamID, int(model.LockExpiration.Seconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire live stream lock: %w", err)
+	}
+	if !locked {
+		// 其他请求正在结算同一直播流
+		s.logger.Info("Live stream is being finalized by another request", "streamID", streamID)
+		return s.liveRepo.GetLiveStream(ctx, streamID)
+	}
+	defer func() {
+		if err := s.liveRepo.ReleaseLiveStreamLock(ctx, streamID); err != nil {
+			s.logger.Warn("Failed to release live stream lock", "streamID", streamID, "error", err)
+		}
+	}()
+
+	// 加锁后重新读取状态，避免重复结算
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+	if stream.Status == model.LiveStatusEnded || stream.Status == model.LiveStatusBanned {
+		s.logger.Info("Live stream already finalized", "streamID", streamID, "status", stream.Status)
+		return stream, nil
+	}
+
+	if err := model.ValidateLiveStatusTransition(model.LiveStatus(stream.Status), model.LiveStatusEnded); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	stream.Status = model.LiveStatusEnded
+	stream.EndedAt = &now
+	stream.LastActiveAt = &now
+	if stream.StartedAt != nil {
+		stream.Duration = uint32(now.Sub(*stream.StartedAt).Seconds())
+	}
+
+	if stream.IsRecord {
+		stream.PlaybackStatus = s.startPlaybackProcessing(ctx, streamID)
+	}
+
+	// 直播统计、直播流状态和直播间累计统计在同一事务中写入，任一步失败全部回滚，重试时可重新结算
+	if err := s.finalizeLiveStreamTx(ctx, stream); err != nil {
+		return nil, err
+	}
+
+	// 缓存、索引和事件推送在事务提交后执行
+	s.afterLiveStreamFinalized(ctx, stream)
+
+	s.logger.Info("Live stream finalized", "streamID", streamID, "duration", stream.Duration)
+	return stream, nil
+}
+
+// finalizeLiveStreamTx 在事务中写入下播结算结果，ctx已绑定事务时加入该事务
+func (s *liveService) finalizeLiveStreamTx(ctx context.Context, stream *model.LiveStream) error {
+	return s.liveRepo.Transaction(ctx, func(ctx context.Context) error {
+		stats, err := s.liveRepo.GetLiveStats(ctx, stream.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get live stats: %w", err)
+		}
+		stats.Duration = stream.Duration
+		if err := s.liveRepo.UpdateLiveStats(ctx, stream.ID, stats); err != nil {
+			return fmt.Errorf("failed to update live stats: %w", err)
+		}
+
+		if err := s.liveRepo.UpdateLiveStream(ctx, stream); err != nil {
+			return fmt.Errorf("failed to update live stream: %w", err)
+		}
+
+		if stream.RoomID != 0 {
+			if err := s.liveRepo.AccumulateLiveRoomStats(ctx, stream); err != nil {
+				return fmt.Errorf("failed to accumulate live room stats: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// afterLiveStreamFinalized 下播结算提交后清除缓存、更新搜索索引并通知直播间
+func (s *liveService) afterLiveStreamFinalized(ctx context.Context, stream *model.LiveStream) {
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, stream.ID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", stream.ID, "error", err)
+	}
+	s.invalidateUserLiveStream(ctx, stream.UserID)
+	s.indexLiveStream(stream)
+	s.publishLiveEvent(ctx, model.LiveEventEnded, stream.ID, stream.UserID, nil)
 }
 
 // GetLiveStream 获取直播流信息
+// 优先读取缓存，未命中时回源数据库并回填缓存，观看人数以Redis实时计数为准
 func (s *liveService) GetLiveStream(ctx context.Context, streamID uint64) (*model.LiveStream, error) {
 	s.logger.Info("Getting live stream info", "streamID", streamID)
 
-	// TODO: 实现获取直播流信息逻辑
-	// 这里应该包含：
-	// 1. 从缓存或数据库获取直播流信息
-	// 2. 更新观看统计
-	// 3. 返回格式化数据
+	stream, err := s.liveRepo.GetLiveStreamCache(ctx, streamID)
+	if err != nil {
+		stream, err = s.liveRepo.GetLiveStream(ctx, streamID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrStreamNotFound
+			}
+			return nil, fmt.Errorf("failed to get live stream: %w", err)
+		}
+		if err := s.liveRepo.SetLiveStreamCache(ctx, stream); err != nil {
+			s.logger.Warn("Failed to set live stream cache", "streamID", streamID, "error", err)
+		}
+	}
+
+	if stream.Status == model.LiveStatusEnded || stream.Status == model.LiveStatusBanned {
+		return nil, ErrStreamNotFound
+	}
+
+	viewerCount, err := s.getViewerCount(ctx, streamID)
+	if err != nil {
+		s.logger.Warn("Failed to get viewer count", "streamID", streamID, "error", err)
+	} else {
+		stream.ViewerCount = uint32(viewerCount)
+	}
 
-	return &model.LiveStream{
-		ID:     streamID,
-		Status: model.LiveStatusStreaming,
-	}, nil
+	return stream, nil
 }
 
-// GetLiveList 获取直播列表
-func (s *liveService) GetLiveList(ctx context.Context, page, pageSize int, categoryID uint32) ([]*model.LiveStream, int64, error) {
-	s.logger.Info("Getting live list", "page", page, "pageSize", pageSize, "categoryID", categoryID)
+// getViewerCount 获取实时观看人数
+// Redis计数不存在(过期或被淘汰)时回源数据库统计在线观看记录并回填，计数存在时即使为0也直接使用
+func (s *liveService) getViewerCount(ctx context.Context, streamID uint64) (int64, error) {
+	count, found, err := s.liveRepo.GetLiveViewerCountCache(ctx, streamID)
+	if err != nil {
+		s.logger.Warn("Failed to get viewer count cache", "streamID", streamID, "error", err)
+	} else if found {
+		return count, nil
+	}
 
-	// TODO: 实现获取直播列表逻辑
-	// 这里应该包含：
-	// 1. 根据分类筛选直播
-	// 2. 按热度或时间排序
-	// 3. 分页查询
-	// 4. 返回格式化的直播列表
+	count, err = s.liveRepo.GetLiveViewerCount(ctx, streamID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count live viewers: %w", err)
+	}
+	if err := s.liveRepo.SetLiveViewerCountCache(ctx, streamID, count); err != nil {
+		s.logger.Warn("Failed to set viewer count cache", "streamID", streamID, "error", err)
+	}
+	return count, nil
+}
 
-	return []*model.LiveStream{}, 0, nil
+// GetLiveList 获取直播列表
+// 只返回直播中的直播，私密直播仅对主播本人和关注者可见
+func (s *liveService) GetLiveList(ctx context.Context, viewerID uint64, page, pageSize int, categoryID uint32) ([]*model.LiveStream, int64, error) {
+	s.logger.Info("Getting live list", "viewerID", viewerID, "page", page, "pageSize", pageSize, "categoryID", categoryID)
+
+	streams, total, err := s.liveRepo.GetLiveStreamList(ctx, model.LiveStatusStreaming, categoryID, viewerID, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get live list: %w", err)
+	}
+	return streams, total, nil
 }
 
 // GetHotLiveList 获取热门直播列表
@@ -188,148 +491,572 @@ func (s *liveService) GetHotLiveList(ctx context.Context, page, pageSize int) ([
 	return []*model.LiveStream{}, 0, nil
 }
 
+// AuthenticateStreamKey 推流鉴权
+// 媒体服务器在主播开始推流时回调(on_publish)，密钥对应准备中或直播中的直播流才允许推流
+func (s *liveService) AuthenticateStreamKey(ctx context.Context, streamKey string) (*model.LiveStream, error) {
+	s.logger.Info("Authenticating stream key", "streamKey", model.MaskStreamKey(streamKey))
+
+	if streamKey == "" {
+		return nil, ErrStreamKeyNotFound
+	}
+
+	stream, err := s.liveRepo.GetLiveStreamByStreamKey(ctx, streamKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+
+	// 准备中首次推流开播，暂停后推流恢复直播；直播中再次推流为主播断线重连，沿用原开播时间
+	now := time.Now()
+	if err := model.ValidateLiveStatusTransition(model.LiveStatus(stream.Status), model.LiveStatusStreaming); err != nil {
+		s.logger.Warn("Rejected publish for inactive stream", "streamID", stream.ID, "status", model.LiveStatus(stream.Status))
+		return nil, ErrStreamKeyInactive
+	}
+	// 暂停后推流先结算暂停前的观看时长，再按恢复直播处理；
+	// 状态更新以推流密钥和状态为条件，读取之后被强制停播更换密钥或结束的直播不会恢复为直播中
+	resumed := stream.Status == model.LiveStatusPaused
+	err = s.liveRepo.Transaction(ctx, func(ctx context.Context) error {
+		if resumed {
+			if err := s.liveRepo.ResumeLiveStream(ctx, stream.ID, now); err != nil {
+				if errors.Is(err, model.ErrInvalidLiveStatusTransition) {
+					return ErrStreamKeyInactive
+				}
+				return fmt.Errorf("failed to resume live stream: %w", err)
+			}
+		}
+		published, err := s.liveRepo.PublishLiveStream(ctx, stream.ID, streamKey, now)
+		if err != nil {
+			return fmt.Errorf("failed to update live stream: %w", err)
+		}
+		if !published {
+			return ErrStreamKeyInactive
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrStreamKeyInactive) {
+			s.logger.Warn("Rejected publish for stream changed concurrently", "streamID", stream.ID)
+		}
+		return nil, err
+	}
+	stream.Status = model.LiveStatusStreaming
+	stream.PausedAt = nil
+	if stream.StartedAt == nil {
+		stream.StartedAt = &now
+	}
+	stream.LastActiveAt = &now
+
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, stream.ID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", stream.ID, "error", err)
+	}
+	s.indexLiveStream(stream)
+	if resumed {
+		s.publishLiveEvent(ctx, model.LiveEventResumed, stream.ID, stream.UserID, nil)
+	}
+
+	return stream, nil
+}
+
+// OnStreamEnded 断流回调
+// 媒体服务器在主播断开推流时回调(on_unpublish)，回调可能重复触发，结算保证幂等
+func (s *liveService) OnStreamEnded(ctx context.Context, streamKey string) (*model.LiveStream, error) {
+	s.logger.Info("Stream ended callback", "streamKey", model.MaskStreamKey(streamKey))
+
+	if streamKey == "" {
+		return nil, ErrStreamKeyNotFound
+	}
+
+	stream, err := s.liveRepo.GetLiveStreamByStreamKey(ctx, streamKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+
+	return s.finalizeLiveStream(ctx, stream.ID)
+}
+
 // JoinLiveRoom 加入直播间
-func (s *liveService) JoinLiveRoom(ctx context.Context, streamID, userID uint64) (*model.LiveViewer, error) {
+// 私密直播只允许主播本人和关注者进入，设置了房间密码的直播需要校验密码，主播本人免校验
+func (s *liveService) JoinLiveRoom(ctx context.Context, streamID, userID uint64, password string) (*model.LiveViewer, error) {
 	s.logger.Info("Joining live room", "streamID", streamID, "userID", userID)
 
-	// TODO: 实现加入直播间逻辑
-	// 这里应该包含：
-	// 1. 验证直播间状态
-	// 2. 创建观看者记录
-	// 3. 更新观看人数
-	// 4. 发送系统消息
-	// 5. 返回观看者信息
+	stream, err := s.GetLiveStream(ctx, streamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.UserID != userID {
+		visible, err := s.canViewLiveStream(ctx, stream, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, ErrStreamPrivate
+		}
+
+		if stream.RoomPassword != "" {
+			if password == "" {
+				return nil, ErrRoomPasswordRequired
+			}
+			if !checkRoomPassword(stream, password) {
+				return nil, ErrRoomPasswordIncorrect
+			}
+		}
+	}
+
+	// 先记录在线状态，清理任务以此判断观看者是否仍在直播间
+	if err := s.liveRepo.TouchViewerPresence(ctx, streamID, userID, presenceTTL(s.config)); err != nil {
+		return nil, fmt.Errorf("failed to set viewer presence: %w", err)
+	}
+
+	now := time.Now()
+	viewer, err := s.liveRepo.GetLiveViewer(ctx, streamID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get live viewer: %w", err)
+	}
+	if viewer != nil {
+		if viewer.ExitTime == nil {
+			return viewer, nil
+		}
+		// 离开或心跳超时后重新进入，重新计入观看人数
+		reentered, err := s.liveRepo.ReenterLiveViewer(ctx, streamID, userID, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reenter live viewer: %w", err)
+		}
+		if reentered {
+			s.statsBatcher.IncrViewerCount(streamID, 1)
+			s.publishLiveEvent(ctx, model.LiveEventJoin, streamID, userID, model.LiveViewerEventData{Count: 1})
+		}
+		viewer.ExitTime = nil
+		viewer.EnterTime = now
+		return viewer, nil
+	}
+
+	viewer = &model.LiveViewer{
+		StreamID:  streamID,
+		UserID:    userID,
+		RoomID:    stream.RoomID,
+		EnterTime: now,
+	}
+	if err := s.liveRepo.CreateLiveViewer(ctx, viewer); err != nil {
+		return nil, fmt.Errorf("failed to create live viewer: %w", err)
+	}
+	s.statsBatcher.IncrViewerCount(streamID, 1)
+	s.publishLiveEvent(ctx, model.LiveEventJoin, streamID, userID, model.LiveViewerEventData{Count: 1})
+
+	return viewer, nil
+}
+
+// BatchJoinLiveRoom 批量加入直播间，用于一起看等多人同时进入的场景，返回新计入观看人数的用户数
+// 观看记录在一个事务中写入，观看人数只累加一次；重复的用户ID和已在直播间的用户不重复计数。
+// 批量加入不校验关注关系和房间密码，只支持公开且未设置房间密码的直播
+func (s *liveService) BatchJoinLiveRoom(ctx context.Context, streamID uint64, userIDs []uint64) (int64, error) {
+	s.logger.Info("Batch joining live room", "streamID", streamID, "users", len(userIDs))
+
+	stream, err := s.GetLiveStream(ctx, streamID)
+	if err != nil {
+		return 0, err
+	}
+	if !stream.IsPublic {
+		return 0, ErrStreamPrivate
+	}
+	if stream.RoomPassword != "" {
+		return 0, ErrRoomPasswordRequired
+	}
+
+	seen := make(map[uint64]bool, len(userIDs))
+	unique := make([]uint64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == 0 || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		unique = append(unique, userID)
+	}
+	if len(unique) == 0 {
+		return 0, nil
+	}
 
-	return &model.LiveViewer{
-		ID:       1,
-		StreamID: streamID,
-		UserID:   userID,
-	}, nil
+	if err := s.liveRepo.TouchViewersPresence(ctx, streamID, unique, presenceTTL(s.config)); err != nil {
+		return 0, fmt.Errorf("failed to set viewer presence: %w", err)
+	}
+
+	joined, err := s.liveRepo.BatchJoinLiveViewers(ctx, streamID, stream.RoomID, unique, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to batch join live viewers: %w", err)
+	}
+	if joined > 0 {
+		s.statsBatcher.IncrViewerCount(streamID, joined)
+		s.publishLiveEvent(ctx, model.LiveEventJoin, streamID, 0, model.LiveViewerEventData{Count: joined})
+	}
+	return joined, nil
 }
 
-// LeaveLiveRoom 离开直播间
+// UpdateLiveStreamPrivacy 更新直播隐私设置，password为空表示取消房间密码
+func (s *liveService) UpdateLiveStreamPrivacy(ctx context.Context, streamID, userID uint64, isPublic bool, password string) error {
+	s.logger.Info("Updating live stream privacy", "streamID", streamID, "userID", userID, "isPublic", isPublic)
+
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrStreamNotFound
+		}
+		return fmt.Errorf("failed to get live stream: %w", err)
+	}
+	if stream.UserID != userID {
+		return ErrStreamPermissionDenied
+	}
+
+	roomPassword := ""
+	if password != "" {
+		if roomPassword, err = hashRoomPassword(password); err != nil {
+			return err
+		}
+	}
+	stream.IsPublic = isPublic
+	stream.RoomPassword = roomPassword
+
+	if err := s.liveRepo.UpdateLiveStream(ctx, stream); err != nil {
+		return fmt.Errorf("failed to update live stream: %w", err)
+	}
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, streamID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", streamID, "error", err)
+	}
+	s.indexLiveStream(stream)
+	return nil
+}
+
+// canViewLiveStream 判断用户能否看到直播，私密直播仅对主播本人和关注者可见
+func (s *liveService) canViewLiveStream(ctx context.Context, stream *model.LiveStream, viewerID uint64) (bool, error) {
+	if stream.IsPublic || stream.UserID == viewerID {
+		return true, nil
+	}
+	if viewerID == 0 {
+		return false, nil
+	}
+
+	following, err := s.liveRepo.IsFollowing(ctx, viewerID, stream.UserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check follow relation: %w", err)
+	}
+	return following, nil
+}
+
+// hashRoomPassword 使用bcrypt计算房间密码哈希，哈希自带随机盐，与推流密钥无关，
+// 强制停播轮换推流密钥后密码仍然有效；超过bcrypt上限(72字节)的密码返回ErrRoomPasswordTooLong
+func hashRoomPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return "", ErrRoomPasswordTooLong
+		}
+		return "", fmt.Errorf("failed to hash room password: %w", err)
+	}
+	return string(hashed), nil
+}
+
+// checkRoomPassword 校验房间密码
+func checkRoomPassword(stream *model.LiveStream, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(stream.RoomPassword), []byte(password)) == nil
+}
+
+// LeaveLiveRoom 离开直播间，记录离开时间和观看时长并扣减观看人数
+// 重复离开或已被清理任务移除时不会重复扣减
 func (s *liveService) LeaveLiveRoom(ctx context.Context, streamID, userID uint64) error {
 	s.logger.Info("Leaving live room", "streamID", streamID, "userID", userID)
 
-	// TODO: 实现离开直播间逻辑
-	// 这里应该包含：
-	// 1. 更新观看者记录
-	// 2. 减少观看人数
-	// 3. 计算观看时长
+	if _, err := s.liveRepo.RemoveViewerPresence(ctx, streamID, userID); err != nil {
+		s.logger.Warn("Failed to remove viewer presence", "streamID", streamID, "userID", userID, "error", err)
+	}
+
+	exited, err := s.liveRepo.MarkLiveViewerExit(ctx, streamID, []uint64{userID}, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to mark live viewer exit: %w", err)
+	}
+	s.statsBatcher.IncrViewerCount(streamID, -exited)
+	if exited > 0 {
+		s.publishLiveEvent(ctx, model.LiveEventLeave, streamID, userID, model.LiveViewerEventData{Count: exited})
+	}
 
 	return nil
 }
 
-// GetLiveViewerList 获取直播观看者列表
+// GetLiveViewerList 获取直播观看者列表，按进入时间倒序，页码超过总页数时返回空列表
 func (s *liveService) GetLiveViewerList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveViewer, int64, error) {
 	s.logger.Info("Getting live viewer list", "streamID", streamID, "page", page, "pageSize", pageSize)
 
-	// TODO: 实现获取观看者列表逻辑
-	// 这里应该包含：
-	// 1. 查询当前观看者
-	// 2. 按进入时间排序
-	// 3. 分页查询
-	// 4. 返回观看者列表
-
-	return []*model.LiveViewer{}, 0, nil
+	viewers, total, err := s.liveRepo.GetLiveViewerList(ctx, streamID, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get live viewer list: %w", err)
+	}
+	return viewers, total, nil
 }
 
-// SendLiveChat 发送直播聊天消息
+// SendLiveChat 发送直播聊天消息，被禁言的用户返回ErrUserMuted
+// 消息按类型校验，客户端不能发送系统消息和送礼通知
 func (s *liveService) SendLiveChat(ctx context.Context, streamID, userID uint64, content, contentType string) (*model.LiveChat, error) {
-	s.logger.Info("Sending live chat", "streamID", streamID, "userID", userID)
+	s.logger.Info("Sending live chat", "streamID", streamID, "userID", userID, "contentType", contentType)
 
-	// TODO: 实现发送聊天消息逻辑
-	// 这里应该包含：
-	// 1. 验证用户权限
-	// 2. 内容过滤和审核
-	// 3. 创建聊天消息
-	// 4. 推送给其他观看者
-	// 5. 更新聊天统计
+	contentType, err := s.validateClientChat(contentType, content)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
 
-	return &model.LiveChat{
-		ID:       1,
-		StreamID: streamID,
-		UserID:   userID,
-		Content:  content,
-	}, nil
+	if muted, remaining := s.chatManager.IsUserMuted(ctx, streamID, userID); muted {
+		s.logger.Info("Rejected chat from muted user", "streamID", streamID, "userID", userID, "remaining", remaining)
+		return nil, ErrUserMuted
+	}
+
+	chat := &model.LiveChat{
+		StreamID:    streamID,
+		UserID:      userID,
+		RoomID:      stream.RoomID,
+		Content:     content,
+		ContentType: contentType,
+		IsAnchor:    userID == stream.UserID,
+		IsAdmin:     s.config.Live.Chat.IsAdmin(userID),
+		Status:      1,
+	}
+	if s.flags.EnabledFor(flagLiveChatModeration, userID) {
+		if ok, reason := s.chatManager.ModerateMessage(ctx, chat); !ok {
+			s.logger.Info("Rejected chat by moderation", "streamID", streamID, "userID", userID, "reason", reason)
+			return nil, ErrChatRejected
+		}
+	}
+	if err := s.chatManager.SendMessage(ctx, chat); err != nil {
+		return nil, err
+	}
+	s.publishChatEvent(ctx, chat)
+
+	return chat, nil
 }
 
 // GetLiveChatList 获取直播聊天列表
-func (s *liveService) GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveChat, int64, error) {
-	s.logger.Info("Getting live chat list", "streamID", streamID, "page", page, "pageSize", pageSize)
+// 默认最新消息在前，oldestFirst为true时按发送顺序返回，用于直播回放
+func (s *liveService) GetLiveChatList(ctx context.Context, streamID uint64, page, pageSize int, oldestFirst bool) ([]*model.LiveChat, int64, error) {
+	s.logger.Info("Getting live chat list", "streamID", streamID, "page", page, "pageSize", pageSize, "oldestFirst", oldestFirst)
 
-	// TODO: 实现获取聊天列表逻辑
-	// 这里应该包含：
-	// 1. 查询聊天记录
-	// 2. 按时间排序
-	// 3. 分页查询
-	// 4. 返回聊天列表
-
-	return []*model.LiveChat{}, 0, nil
+	return s.chatManager.GetMessageList(ctx, streamID, page, pageSize, oldestFirst)
 }
 
 // SendLiveGift 发送直播礼物
-func (s *liveService) SendLiveGift(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32) (*model.LiveGift, error) {
-	s.logger.Info("Sending live gift", "streamID", streamID, "userID", userID, "giftID", giftID)
+// requestID由客户端生成，同一用户重复提交相同requestID时直接返回首次结果，不会重复记录礼物
+func (s *liveService) SendLiveGift(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32, requestID string) (*model.LiveGift, error) {
+	s.logger.Info("Sending live gift", "streamID", streamID, "userID", userID, "giftID", giftID, "requestID", requestID)
 
-	// TODO: 实现发送礼物逻辑
-	// 这里应该包含：
-	// 1. 验证用户余额
-	// 2. 扣除用户金币
-	// 3. 创建礼物记录
-	// 4. 增加主播收益
-	// 5. 发送礼物特效
-	// 6. 更新礼物统计
-
-	return &model.LiveGift{
-		ID:       1,
-		StreamID: streamID,
-		UserID:   userID,
-		GiftID:   giftID,
-	}, nil
-}
-
-// GetLiveGiftList 获取直播礼物列表
-func (s *liveService) GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
+	if requestID == "" {
+		return s.sendLiveGift(ctx, streamID, userID, giftID, giftCount)
+	}
+
+	for attempt := 0; attempt < giftRequestAcquireAttempts; attempt++ {
+		acquired, err := s.liveRepo.AcquireGiftRequest(ctx, userID, requestID)
+		if err != nil {
+			// 无法确认请求是否已处理时拒绝发送，避免重复送礼
+			return nil, fmt.Errorf("failed to acquire gift request: %w", err)
+		}
+		if acquired {
+			return s.sendLiveGiftOnce(ctx, streamID, userID, giftID, giftCount, requestID)
+		}
+
+		gift, found, err := s.liveRepo.GetGiftRequestResult(ctx, userID, requestID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gift request result: %w", err)
+		}
+		if !found {
+			// 占用失败后幂等记录恰好过期或被释放，重新占用
+			continue
+		}
+		if gift == nil {
+			return nil, ErrGiftRequestInProgress
+		}
+		s.logger.Info("Duplicate gift request, returning original result", "userID", userID, "requestID", requestID, "giftRecordID", gift.ID)
+		return gift, nil
+	}
+	return nil, ErrGiftRequestInProgress
+}
+
+// giftRequestAcquireAttempts 幂等记录在占用和读取之间消失时重新占用的次数
+const giftRequestAcquireAttempts = 3
+
+// sendLiveGiftOnce 已占用requestID时发送礼物并保存结果，失败时释放占用以允许客户端重试
+func (s *liveService) sendLiveGiftOnce(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32, requestID string) (*model.LiveGift, error) {
+	// 礼物已记录后即使请求被取消也要保存结果或释放占用，不使用已取消的ctx
+	saveCtx := context.WithoutCancel(ctx)
+
+	gift, err := s.sendLiveGift(ctx, streamID, userID, giftID, giftCount)
+	if err != nil {
+		if releaseErr := s.liveRepo.ReleaseGiftRequest(saveCtx, userID, requestID); releaseErr != nil {
+			s.logger.Warn("Failed to release gift request", "userID", userID, "requestID", requestID, "error", releaseErr)
+		}
+		return nil, err
+	}
+
+	if err := s.liveRepo.SetGiftRequestResult(saveCtx, userID, requestID, gift); err != nil {
+		// 结果未保存时释放处理中占位，否则重试会在幂等记录过期前一直返回处理中
+		s.logger.Error("Failed to save gift request result, releasing request", "userID", userID, "requestID", requestID, "giftRecordID", gift.ID, "error", err)
+		if releaseErr := s.liveRepo.ReleaseGiftRequest(saveCtx, userID, requestID); releaseErr != nil {
+			s.logger.Warn("Failed to release gift request", "userID", userID, "requestID", requestID, "error", releaseErr)
+		}
+	}
+	return gift, nil
+}
+
+// sendLiveGift 校验礼物配置、计算连击并记录礼物
+func (s *liveService) sendLiveGift(ctx context.Context, streamID, userID uint64, giftID uint32, giftCount uint32) (*model.LiveGift, error) {
+
+	if giftCount == 0 {
+		giftCount = 1
+	}
+
+	stream, err := s.GetLiveStream(ctx, streamID)
+	if err != nil {
+		return nil, err
+	}
+
+	giftConfig, err := s.giftManager.GetGiftConfig(ctx, giftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gift config: %w", err)
+	}
+	if !giftConfig.IsActive {
+		return nil, fmt.Errorf("gift %d is not active", giftID)
+	}
+
+	effect, err := ResolveGiftEffect(giftConfig)
+	if err != nil {
+		s.logger.Warn("Invalid gift effect config, sending gift without effect", "giftID", giftID, "error", err)
+	}
+
+	totalValue := giftConfig.CoinPrice * uint64(giftCount)
+	gift := &model.LiveGift{
+		StreamID:        streamID,
+		UserID:          userID,
+		AnchorID:        stream.UserID,
+		GiftID:          giftID,
+		GiftName:        giftConfig.Name,
+		GiftIcon:        giftConfig.Icon,
+		GiftValue:       giftConfig.CoinPrice,
+		GiftCount:       giftCount,
+		TotalValue:      totalValue,
+		ComboCount:      1,
+		ComboMultiplier: 1,
+		DisplayValue:    totalValue,
+		EffectType:      effect.Type,
+		EffectData:      effect.Value,
+		Status:          1,
+		SendTime:        time.Now(),
+	}
+
+	// 礼物记录、统计累加和礼物通知在同一事务中写入，任一步失败全部回滚
+	err = s.liveRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.giftManager.SendGift(ctx, gift); err != nil {
+			return fmt.Errorf("failed to send gift: %w", err)
+		}
+		if err := s.chatManager.SendGiftNotice(ctx, gift, stream.RoomID); err != nil {
+			return fmt.Errorf("failed to send gift notice: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 连击、缓存、排行和事件推送在事务提交后执行，避免回滚后仍对外可见，发送失败的礼物也不会累加连击
+	s.applyGiftCombo(ctx, gift)
+	if err := s.liveRepo.DeleteLiveGiftStatsCache(ctx, streamID); err != nil {
+		s.logger.Warn("Failed to delete gift stats cache", "streamID", streamID, "error", err)
+	}
+	s.recordDailyGiftValue(ctx, gift)
+	s.publishGiftEvent(ctx, gift)
+	s.notifyGiftReceived(gift)
+
+	return gift, nil
+}
+
+// applyGiftCombo 记录已发送礼物的连击并回写礼物记录的连击字段
+// 连击只影响展示价值，实际扣费仍按TotalValue计算；连击不可用时按单次赠送展示
+func (s *liveService) applyGiftCombo(ctx context.Context, gift *model.LiveGift) {
+	combo, err := s.giftManager.TrackGiftCombo(ctx, gift)
+	if err != nil {
+		s.logger.Warn("Gift combo unavailable, falling back to single gift", "streamID", gift.StreamID, "userID", gift.UserID, "error", err)
+		return
+	}
+	if combo.Count <= 1 {
+		return
+	}
+
+	gift.ComboCount = combo.Count
+	gift.ComboMultiplier = combo.Multiplier
+	gift.DisplayValue = gift.TotalValue * uint64(combo.Multiplier)
+	if err := s.liveRepo.UpdateLiveGiftCombo(ctx, gift); err != nil {
+		s.logger.Warn("Failed to update gift combo", "giftRecordID", gift.ID, "comboCount", gift.ComboCount, "error", err)
+	}
+}
+
+// GetLiveGiftList 获取直播礼物列表，cursor不为空时按游标分页，游标无效时返回paginate.ErrInvalidCursor
+func (s *liveService) GetLiveGiftList(ctx context.Context, streamID uint64, page, pageSize int, cursor string) ([]*model.LiveGift, int64, string, error) {
 	s.logger.Info("Getting live gift list", "streamID", streamID, "page", page, "pageSize", pageSize)
 
-	// TODO: 实现获取礼物列表逻辑
-	// 这里应该包含：
-	// 1. 查询礼物记录
-	// 2. 按价值排序
-	// 3. 分页查询
-	// 4. 返回礼物列表
+	return s.giftManager.GetGiftList(ctx, streamID, page, pageSize, cursor)
+}
 
-	return []*model.LiveGift{}, 0, nil
+// GetUserLiveGiftList 获取用户送出的礼物列表
+func (s *liveService) GetUserLiveGiftList(ctx context.Context, userID uint64, page, pageSize int) ([]*model.LiveGift, int64, error) {
+	s.logger.Info("Getting user live gift list", "userID", userID, "page", page, "pageSize", pageSize)
+
+	return s.giftManager.GetUserGiftHistory(ctx, userID, page, pageSize)
 }
 
-// LikeLive 点赞直播
+// GetLiveGiftStats 获取直播礼物统计，已结束的直播同样可查询
+func (s *liveService) GetLiveGiftStats(ctx context.Context, streamID uint64) (*GiftStats, error) {
+	s.logger.Info("Getting live gift stats", "streamID", streamID)
+
+	if _, err := s.liveRepo.GetLiveStream(ctx, streamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+
+	return s.giftManager.GetStreamGiftStats(ctx, streamID)
+}
+
+// LikeLive 点赞直播，允许连续点赞，每次点赞累加点赞数并广播点赞事件
 func (s *liveService) LikeLive(ctx context.Context, streamID, userID uint64) error {
 	s.logger.Info("Liking live stream", "streamID", streamID, "userID", userID)
 
-	// TODO: 实现点赞逻辑
-	// 这里应该包含：
-	// 1. 检查是否已点赞
-	// 2. 创建点赞记录
-	// 3. 更新点赞统计
-	// 4. 发送点赞特效
+	if _, err := s.GetLiveStream(ctx, streamID); err != nil {
+		return err
+	}
 
+	s.statsBatcher.IncrLikeCount(streamID, 1)
+	s.publishLiveEvent(ctx, model.LiveEventLike, streamID, userID, model.LiveLikeEventData{Count: 1})
 	return nil
 }
 
 // SearchLive 搜索直播
-func (s *liveService) SearchLive(ctx context.Context, keyword string, page, pageSize int) ([]*model.LiveStream, int64, error) {
-	s.logger.Info("Searching live streams", "keyword", keyword, "page", page, "pageSize", pageSize)
-
-	// TODO: 实现搜索直播逻辑
-	// 这里应该包含：
-	// 1. 关键词分词
-	// 2. 全文搜索
-	// 3. 相关性排序
-	// 4. 分页查询
-	// 5. 返回搜索结果
-
-	return []*model.LiveStream{}, 0, nil
+// 按关键词、分类、在线人数下限、语言和地区组合过滤直播中的直播，私密直播仅对主播本人和关注者可见
+func (s *liveService) SearchLive(ctx context.Context, viewerID uint64, filter repository.LiveStreamSearchFilter, page, pageSize int) ([]*model.LiveStream, int64, error) {
+	s.logger.Info("Searching live streams", "viewerID", viewerID, "filter", filter, "page", page, "pageSize", pageSize)
+
+	filter.Language = model.NormalizeLanguage(filter.Language)
+	filter.Region = model.NormalizeRegion(filter.Region)
+	streams, total, err := s.liveRepo.SearchLiveStream(ctx, filter, viewerID, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search live streams: %w", err)
+	}
+	return streams, total, nil
 }
 
 // GetLiveCategories 获取直播分类
@@ -343,33 +1070,47 @@ func (s *liveService) GetLiveCategories(ctx context.Context) ([]*LiveCategory, e
 }
 
 // GetLiveStats 获取直播统计
+// 累计数据取直播流记录上的计数，礼物数据从送礼明细汇总；直播未结束时补充实时在线人数和已播时长
 func (s *liveService) GetLiveStats(ctx context.Context, streamID uint64) (*LiveStats, error) {
 	s.logger.Info("Getting live stats", "streamID", streamID)
 
-	// TODO: 实现获取直播统计逻辑
-	// 这里应该包含：
-	// 1. 查询观看人数
-	// 2. 查询互动数据
-	// 3. 计算直播时长
-	// 4. 返回统计信息
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
 
-	return &LiveStats{
-		StreamID: streamID,
-	}, nil
-}
+	stats := &LiveStats{
+		StreamID:     stream.ID,
+		TotalViewers: uint64(stream.ViewerCount),
+		LikeCount:    stream.LikeCount,
+		GiftCount:    stream.GiftCount,
+		CommentCount: stream.CommentCount,
+		ShareCount:   stream.ShareCount,
+		Duration:     stream.Duration,
+	}
 
-// GetLivePlayback 获取直播回放
-func (s *liveService) GetLivePlayback(ctx context.Context, streamID uint64) (*LivePlayback, error) {
-	s.logger.Info("Getting live playback", "streamID", streamID)
+	giftStats, err := s.liveRepo.GetLiveGiftStats(ctx, streamID)
+	if err != nil {
+		s.logger.Warn("Failed to get live gift stats", "streamID", streamID, "error", err)
+	} else {
+		stats.GiftCount = giftStats.TotalGifts
+		stats.GiftValue = giftStats.TotalValue
+	}
 
-	// TODO: 实现获取直播回放逻辑
-	// 这里应该包含：
-	// 1. 检查回放文件是否存在
-	// 2. 获取回放文件信息
-	// 3. 生成播放地址
-	// 4. 返回回放信息
-
-	return &LivePlayback{
-		StreamID: streamID,
-	}, nil
+	if !model.LiveStatus(stream.Status).IsTerminal() {
+		viewerCount, err := s.getViewerCount(ctx, streamID)
+		if err != nil {
+			s.logger.Warn("Failed to get viewer count", "streamID", streamID, "error", err)
+		} else {
+			stats.CurrentViewers = uint32(viewerCount)
+		}
+		if stream.StartedAt != nil {
+			stats.Duration = uint32(time.Since(*stream.StartedAt).Seconds())
+		}
+	}
+
+	return stats, nil
 }
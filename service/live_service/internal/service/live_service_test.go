@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+)
+
+func newLiveTestStream(repo *fakeLiveRepo) model.LiveStream {
+	startedAt := time.Now().Add(-time.Minute)
+	stream := model.LiveStream{
+		ID:        1,
+		UserID:    10,
+		RoomID:    5,
+		StreamKey: "key-1",
+		Status:    model.LiveStatusStreaming,
+		StartedAt: &startedAt,
+	}
+	repo.putStream(stream)
+	return stream
+}
+
+func TestSendLiveGiftPublishesAfterCommit(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	gift, err := s.SendLiveGift(context.Background(), 1, 20, 3, 2, "req-1")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	if gift.ID == 0 {
+		t.Fatal("gift record id not set")
+	}
+	if got := repo.giftCount(); got != 1 {
+		t.Fatalf("gift records = %d, want 1", got)
+	}
+
+	events := repo.eventsSnapshot()
+	commit := indexOf(events, eventCommit)
+	if commit < 0 {
+		t.Fatalf("no commit in events %v", events)
+	}
+	for _, event := range []string{eventPublish, eventDeleteGiftStatsCache} {
+		if i := indexOf(events, event); i < commit {
+			t.Errorf("%s at %d, want after commit at %d (events %v)", event, i, commit, events)
+		}
+	}
+}
+
+func TestSendLiveGiftRollsBackWhenNoticeFails(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	repo.createChatErr = errInjected
+	s := newTestLiveService(repo)
+
+	if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); !errors.Is(err, errInjected) {
+		t.Fatalf("SendLiveGift error = %v, want injected failure", err)
+	}
+	if got := repo.giftCount(); got != 0 {
+		t.Fatalf("gift records = %d after rollback, want 0", got)
+	}
+	events := repo.eventsSnapshot()
+	for _, event := range []string{eventCommit, eventPublish, eventDeleteGiftStatsCache} {
+		if indexOf(events, event) >= 0 {
+			t.Errorf("unexpected %s after failed send (events %v)", event, events)
+		}
+	}
+
+	// 失败的请求释放了requestID，客户端重试时可以正常发送
+	repo.createChatErr = nil
+	if _, err := s.SendLiveGift(context.Background(), 1, 20, 3, 1, "req-1"); err != nil {
+		t.Fatalf("retry SendLiveGift: %v", err)
+	}
+	if got := repo.giftCount(); got != 1 {
+		t.Fatalf("gift records = %d after retry, want 1", got)
+	}
+}
+
+func TestFinalizeLiveStreamRollsBackOnFailure(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	repo.accumulateErr = errInjected
+	s := newTestLiveService(repo)
+
+	if err := s.StopLive(context.Background(), 1, 10); !errors.Is(err, errInjected) {
+		t.Fatalf("StopLive error = %v, want injected failure", err)
+	}
+	if got := repo.stream(1).Status; got != model.LiveStatusStreaming {
+		t.Fatalf("stream status = %d after rollback, want live", got)
+	}
+	events := repo.eventsSnapshot()
+	for _, event := range []string{eventCommit, eventPublish, eventDeleteStreamCache} {
+		if indexOf(events, event) >= 0 {
+			t.Errorf("unexpected %s after failed finalize (events %v)", event, events)
+		}
+	}
+
+	// 回滚后锁已释放，重试可以完成结算
+	repo.accumulateErr = nil
+	if err := s.StopLive(context.Background(), 1, 10); err != nil {
+		t.Fatalf("retry StopLive: %v", err)
+	}
+	if got := repo.stream(1).Status; got != model.LiveStatusEnded {
+		t.Fatalf("stream status = %d, want ended", got)
+	}
+	events = repo.eventsSnapshot()
+	commit := indexOf(events, eventCommit)
+	if i := indexOf(events, eventPublish); commit < 0 || i < commit {
+		t.Errorf("publish at %d, want after commit at %d (events %v)", i, commit, events)
+	}
+}
+
+func TestSendLiveGiftComboCountsOnlySuccessfulSends(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	repo.createChatErr = errInjected
+	if _, err := s.SendLiveGift(ctx, 1, 20, 3, 1, "req-1"); err == nil {
+		t.Fatal("SendLiveGift succeeded, want injected failure")
+	}
+	repo.createChatErr = nil
+
+	first, err := s.SendLiveGift(ctx, 1, 20, 3, 1, "req-2")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	if first.ComboCount != 1 {
+		t.Fatalf("combo after failed send = %d, want 1", first.ComboCount)
+	}
+
+	second, err := s.SendLiveGift(ctx, 1, 20, 3, 1, "req-3")
+	if err != nil {
+		t.Fatalf("SendLiveGift: %v", err)
+	}
+	if second.ComboCount != 2 {
+		t.Fatalf("combo = %d, want 2", second.ComboCount)
+	}
+	if want := second.TotalValue * uint64(GiftComboMultiplier(2)); second.DisplayValue != want {
+		t.Errorf("display value = %d, want %d", second.DisplayValue, want)
+	}
+	if saved := repo.gifts[1]; saved.ComboCount != 2 || saved.DisplayValue != second.DisplayValue {
+		t.Errorf("stored combo = %d/%d, want 2/%d", saved.ComboCount, saved.DisplayValue, second.DisplayValue)
+	}
+}
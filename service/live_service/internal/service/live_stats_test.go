@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+// newStatsTestStream 带累计计数的直播流
+func newStatsTestStream(repo *fakeLiveRepo, status uint8) model.LiveStream {
+	stream := newLiveTestStream(repo)
+	stream.Status = status
+	stream.ViewerCount = 120
+	stream.LikeCount = 30
+	stream.GiftCount = 9
+	stream.CommentCount = 8
+	stream.ShareCount = 2
+	stream.Duration = 3600
+	repo.putStream(stream)
+	return stream
+}
+
+func TestGetLiveStatsForActiveStream(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newStatsTestStream(repo, model.LiveStatusStreaming)
+	repo.gifts = []model.LiveGift{
+		{StreamID: 1, UserID: 20, GiftCount: 2, TotalValue: 200, Status: model.GiftStatusSuccess},
+		{StreamID: 1, UserID: 21, GiftCount: 1, TotalValue: 500, Status: model.GiftStatusSuccess},
+		{StreamID: 1, UserID: 22, GiftCount: 5, TotalValue: 900, Status: model.GiftStatusRefunded},
+		{StreamID: 2, UserID: 20, GiftCount: 4, TotalValue: 400, Status: model.GiftStatusSuccess},
+	}
+	repo.viewerCounts[1] = 15
+	s := newTestLiveService(repo)
+
+	stats, err := s.GetLiveStats(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLiveStats: %v", err)
+	}
+	if stats.StreamID != 1 || stats.TotalViewers != 120 || stats.LikeCount != 30 || stats.CommentCount != 8 || stats.ShareCount != 2 {
+		t.Errorf("stats = %+v, want the stream counters", stats)
+	}
+	// 礼物数据以送礼明细为准，退款和其他直播的礼物不计入
+	if stats.GiftCount != 3 || stats.GiftValue != 700 {
+		t.Errorf("gifts = %d value %d, want 3 worth 700", stats.GiftCount, stats.GiftValue)
+	}
+	if stats.CurrentViewers != 15 {
+		t.Errorf("current viewers = %d, want 15", stats.CurrentViewers)
+	}
+	// 未结束的直播按开播时间计算已播时长
+	if stats.Duration < 59 || stats.Duration > 120 {
+		t.Errorf("duration = %ds, want about one minute since start", stats.Duration)
+	}
+}
+
+func TestGetLiveStatsForEndedStream(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newStatsTestStream(repo, model.LiveStatusEnded)
+	repo.viewerCounts[1] = 15
+	s := newTestLiveService(repo)
+
+	stats, err := s.GetLiveStats(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLiveStats: %v", err)
+	}
+	if stats.CurrentViewers != 0 || stats.Duration != 3600 {
+		t.Errorf("stats = current %d duration %d, want no live viewers and the stored duration", stats.CurrentViewers, stats.Duration)
+	}
+	if stats.GiftCount != 0 || stats.GiftValue != 0 {
+		t.Errorf("gifts = %d value %d, want the empty gift summary", stats.GiftCount, stats.GiftValue)
+	}
+}
+
+func TestGetLiveStatsFallsBackWhenGiftStatsFail(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newStatsTestStream(repo, model.LiveStatusEnded)
+	repo.giftStatsErr = errInjected
+	s := newTestLiveService(repo)
+
+	stats, err := s.GetLiveStats(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLiveStats: %v", err)
+	}
+	if stats.GiftCount != 9 || stats.GiftValue != 0 {
+		t.Errorf("gifts = %d value %d, want the stream gift counter", stats.GiftCount, stats.GiftValue)
+	}
+}
+
+func TestGetLiveStatsUnknownStream(t *testing.T) {
+	s := newTestLiveService(newFakeLiveRepo())
+	if _, err := s.GetLiveStats(context.Background(), 99); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("GetLiveStats error = %v, want ErrStreamNotFound", err)
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestStopLiveRespectsStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  uint8
+		wantErr error
+		want    uint8
+	}{
+		{"streaming", model.LiveStatusStreaming, nil, model.LiveStatusEnded},
+		{"paused", model.LiveStatusPaused, nil, model.LiveStatusEnded},
+		{"already ended", model.LiveStatusEnded, nil, model.LiveStatusEnded},
+		{"banned", model.LiveStatusBanned, ErrInvalidStatusTransition, model.LiveStatusBanned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeLiveRepo()
+			stream := newLiveTestStream(repo)
+			stream.Status = tt.status
+			repo.putStream(stream)
+			s := newTestLiveService(repo)
+
+			if err := s.StopLive(context.Background(), stream.ID, stream.UserID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("StopLive error = %v, want %v", err, tt.wantErr)
+			}
+			if got := repo.stream(stream.ID).Status; got != tt.want {
+				t.Errorf("status = %s, want %s", model.LiveStatus(got), model.LiveStatus(tt.want))
+			}
+		})
+	}
+}
+
+func TestAuthenticateStreamKeyStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  uint8
+		wantErr error
+	}{
+		{"preparing starts", model.LiveStatusPreparing, nil},
+		{"paused resumes", model.LiveStatusPaused, nil},
+		{"streaming reconnects", model.LiveStatusStreaming, nil},
+		{"ended rejected", model.LiveStatusEnded, ErrStreamKeyInactive},
+		{"banned rejected", model.LiveStatusBanned, ErrStreamKeyInactive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeLiveRepo()
+			stream := newLiveTestStream(repo)
+			startedAt := *stream.StartedAt
+			stream.Status = tt.status
+			if tt.status == model.LiveStatusPreparing {
+				stream.StartedAt = nil
+			}
+			repo.putStream(stream)
+			s := newTestLiveService(repo)
+
+			_, err := s.AuthenticateStreamKey(context.Background(), stream.StreamKey)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AuthenticateStreamKey error = %v, want %v", err, tt.wantErr)
+			}
+			got := repo.stream(stream.ID)
+			if tt.wantErr != nil {
+				if got.Status != tt.status {
+					t.Errorf("rejected publish changed status to %s", model.LiveStatus(got.Status))
+				}
+				return
+			}
+			if got.Status != model.LiveStatusStreaming || got.StartedAt == nil {
+				t.Fatalf("stream = status %s started %v, want streaming with a start time", model.LiveStatus(got.Status), got.StartedAt)
+			}
+			// 恢复或重连沿用原开播时间
+			if tt.status != model.LiveStatusPreparing && !got.StartedAt.Equal(startedAt) {
+				t.Errorf("started_at = %v, want original %v", got.StartedAt, startedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestGetLiveStreamServesCacheHit(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	cached := stream
+	cached.Title = "缓存中的标题"
+	repo.streamCache[stream.ID] = cached
+	s := newTestLiveService(repo)
+
+	got, err := s.GetLiveStream(context.Background(), stream.ID)
+	if err != nil {
+		t.Fatalf("GetLiveStream: %v", err)
+	}
+	if got.Title != cached.Title {
+		t.Errorf("title = %q, want cached %q", got.Title, cached.Title)
+	}
+	if repo.getStreamCalls != 0 {
+		t.Errorf("cache hit read the database %d times", repo.getStreamCalls)
+	}
+}
+
+func TestGetLiveStreamMissLoadsDatabaseAndFillsCache(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	if _, err := s.GetLiveStream(context.Background(), stream.ID); err != nil {
+		t.Fatalf("GetLiveStream: %v", err)
+	}
+	if repo.getStreamCalls != 1 {
+		t.Fatalf("cache miss read the database %d times, want 1", repo.getStreamCalls)
+	}
+	if _, ok := repo.cachedStream(stream.ID); !ok {
+		t.Fatal("cache miss did not fill the cache")
+	}
+
+	if _, err := s.GetLiveStream(context.Background(), stream.ID); err != nil {
+		t.Fatalf("GetLiveStream: %v", err)
+	}
+	if repo.getStreamCalls != 1 {
+		t.Errorf("second read went to the database, %d reads in total", repo.getStreamCalls)
+	}
+}
+
+func TestGetLiveStreamMergesRealtimeViewerCount(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	// 缓存的直播信息中观看人数已过时
+	cached := stream
+	cached.ViewerCount = 100
+	repo.streamCache[stream.ID] = cached
+	repo.viewerCounts[stream.ID] = 7
+	s := newTestLiveService(repo)
+
+	got, err := s.GetLiveStream(context.Background(), stream.ID)
+	if err != nil {
+		t.Fatalf("GetLiveStream: %v", err)
+	}
+	if got.ViewerCount != 7 {
+		t.Errorf("viewer count = %d, want realtime count 7", got.ViewerCount)
+	}
+	if cached, _ := repo.cachedStream(stream.ID); cached.ViewerCount != 100 {
+		t.Errorf("realtime count was written back into the stream cache: %d", cached.ViewerCount)
+	}
+}
+
+func TestGetLiveStreamNotFound(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	ended := stream
+	ended.ID = 2
+	ended.StreamKey = "key-2"
+	ended.Status = model.LiveStatusEnded
+	repo.putStream(ended)
+	s := newTestLiveService(repo)
+
+	for _, id := range []uint64{99, ended.ID} {
+		if _, err := s.GetLiveStream(context.Background(), id); !errors.Is(err, ErrStreamNotFound) {
+			t.Errorf("GetLiveStream(%d) error = %v, want ErrStreamNotFound", id, err)
+		}
+	}
+}
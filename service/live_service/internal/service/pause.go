@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// PauseLive 主播暂停直播
+// 暂停期间观看者仍留在直播间，但不再累计观看时长
+func (s *liveService) PauseLive(ctx context.Context, streamID, userID uint64) error {
+	s.logger.Info("Pausing live stream", "streamID", streamID, "userID", userID)
+
+	stream, err := s.getOwnedLiveStream(ctx, streamID, userID)
+	if err != nil {
+		return err
+	}
+	if stream.Status != model.LiveStatusStreaming {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, model.LiveStatus(stream.Status), model.LiveStatus(model.LiveStatusPaused))
+	}
+
+	now := time.Now()
+	if err := s.liveRepo.PauseLiveStream(ctx, streamID, now); err != nil {
+		if errors.Is(err, ErrInvalidStatusTransition) {
+			return err
+		}
+		return fmt.Errorf("failed to pause live stream: %w", err)
+	}
+	stream.Status = model.LiveStatusPaused
+	stream.PausedAt = &now
+
+	s.afterPauseStateChange(ctx, stream, model.LiveEventPaused)
+	s.logger.Info("Live stream paused", "streamID", streamID)
+	return nil
+}
+
+// ResumeLive 主播恢复暂停的直播
+func (s *liveService) ResumeLive(ctx context.Context, streamID, userID uint64) error {
+	s.logger.Info("Resuming live stream", "streamID", streamID, "userID", userID)
+
+	stream, err := s.getOwnedLiveStream(ctx, streamID, userID)
+	if err != nil {
+		return err
+	}
+	if stream.Status != model.LiveStatusPaused {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, model.LiveStatus(stream.Status), model.LiveStatus(model.LiveStatusStreaming))
+	}
+
+	if err := s.liveRepo.ResumeLiveStream(ctx, streamID, time.Now()); err != nil {
+		if errors.Is(err, ErrInvalidStatusTransition) {
+			return err
+		}
+		return fmt.Errorf("failed to resume live stream: %w", err)
+	}
+	stream.Status = model.LiveStatusStreaming
+	stream.PausedAt = nil
+
+	s.afterPauseStateChange(ctx, stream, model.LiveEventResumed)
+	s.logger.Info("Live stream resumed", "streamID", streamID)
+	return nil
+}
+
+// getOwnedLiveStream 获取直播流并校验操作者是否为主播本人
+func (s *liveService) getOwnedLiveStream(ctx context.Context, streamID, userID uint64) (*model.LiveStream, error) {
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+	if stream.UserID != userID {
+		return nil, ErrStreamPermissionDenied
+	}
+	return stream, nil
+}
+
+// afterPauseStateChange 暂停或恢复后清理缓存、更新搜索索引并通知直播间内的观看者
+func (s *liveService) afterPauseStateChange(ctx context.Context, stream *model.LiveStream, eventType model.LiveEventType) {
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, stream.ID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", stream.ID, "error", err)
+	}
+	s.indexLiveStream(stream)
+	s.publishLiveEvent(ctx, eventType, stream.ID, stream.UserID, nil)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// GetLivePlayback 获取直播回放
+// 回放转码中时查询转码状态，转码已结束则更新为ready或failed；未录制的直播返回ErrPlaybackNotFound
+func (s *liveService) GetLivePlayback(ctx context.Context, streamID uint64) (*LivePlayback, error) {
+	s.logger.Info("Getting live playback", "streamID", streamID)
+
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+	if !stream.IsRecord || stream.PlaybackStatus == "" {
+		return nil, ErrPlaybackNotFound
+	}
+
+	if stream.PlaybackStatus == model.PlaybackStatusProcessing {
+		if err := s.syncPlaybackStatus(ctx, stream); err != nil {
+			// 查询失败时仍按转码中返回
+			s.logger.Warn("Failed to sync playback status", "streamID", streamID, "error", err)
+		}
+	}
+
+	playback := &LivePlayback{
+		StreamID: stream.ID,
+		Duration: stream.Duration,
+		Quality:  stream.VideoQuality,
+		Status:   stream.PlaybackStatus,
+	}
+	if stream.EndedAt != nil {
+		playback.CreatedAt = stream.EndedAt.Unix()
+	}
+	if stream.PlaybackStatus == model.PlaybackStatusReady {
+		playback.PlaybackURL = stream.PlaybackURL
+		if recording, err := s.streamManager.GetRecordingStatus(ctx, streamID); err == nil {
+			playback.FileSize = recording.FileSize
+			playback.Format = recording.Format
+		}
+	}
+	return playback, nil
+}
+
+// startPlaybackProcessing 直播结束时停止录制并提交回放转码，返回回放的初始状态
+func (s *liveService) startPlaybackProcessing(ctx context.Context, streamID uint64) string {
+	if err := s.streamManager.StopRecording(ctx, streamID); err != nil {
+		s.logger.Warn("Failed to stop recording", "streamID", streamID, "error", err)
+		return model.PlaybackStatusFailed
+	}
+	if err := s.streamManager.StartTranscoding(ctx, streamID); err != nil {
+		s.logger.Warn("Failed to start playback transcoding", "streamID", streamID, "error", err)
+		return model.PlaybackStatusFailed
+	}
+	return model.PlaybackStatusProcessing
+}
+
+// syncPlaybackStatus 根据转码状态更新转码中的回放，转码仍在进行时不做修改
+// 转码成功后回放地址取录制文件路径，录制文件路径为空时保留原有回放地址
+func (s *liveService) syncPlaybackStatus(ctx context.Context, stream *model.LiveStream) error {
+	transcoding, err := s.streamManager.GetTranscodingStatus(ctx, stream.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get transcoding status: %w", err)
+	}
+	if transcoding.IsTranscoding {
+		return nil
+	}
+
+	status, playbackURL := model.PlaybackStatusReady, ""
+	if transcoding.ErrorMessage != "" {
+		s.logger.Warn("Playback transcoding failed", "streamID", stream.ID, "error", transcoding.ErrorMessage)
+		status = model.PlaybackStatusFailed
+	} else {
+		recording, err := s.streamManager.GetRecordingStatus(ctx, stream.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get recording status: %w", err)
+		}
+		playbackURL = recording.FilePath
+	}
+
+	updated, err := s.liveRepo.UpdatePlaybackStatus(ctx, stream.ID, model.PlaybackStatusProcessing, status, playbackURL)
+	if err != nil {
+		return fmt.Errorf("failed to update playback status: %w", err)
+	}
+	if !updated {
+		// 已被并发请求更新，重新读取
+		latest, err := s.liveRepo.GetLiveStream(ctx, stream.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get live stream: %w", err)
+		}
+		*stream = *latest
+		return nil
+	}
+
+	stream.PlaybackStatus = status
+	if playbackURL != "" {
+		stream.PlaybackURL = playbackURL
+	}
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, stream.ID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", stream.ID, "error", err)
+	}
+	return nil
+}
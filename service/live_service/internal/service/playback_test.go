@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+)
+
+// fakeStreamManager 返回预设录制和转码状态的流管理器
+type fakeStreamManager struct {
+	StreamManager
+
+	stopErr        error
+	startErr       error
+	transcoding    *TranscodingStatus
+	transcodingErr error
+	recording      *RecordingStatus
+	recordingErr   error
+
+	// transcoded 提交转码的直播流
+	transcoded []uint64
+}
+
+func (m *fakeStreamManager) StopRecording(ctx context.Context, streamID uint64) error {
+	return m.stopErr
+}
+
+func (m *fakeStreamManager) StartTranscoding(ctx context.Context, streamID uint64) error {
+	if m.startErr != nil {
+		return m.startErr
+	}
+	m.transcoded = append(m.transcoded, streamID)
+	return nil
+}
+
+func (m *fakeStreamManager) GetTranscodingStatus(ctx context.Context, streamID uint64) (*TranscodingStatus, error) {
+	return m.transcoding, m.transcodingErr
+}
+
+func (m *fakeStreamManager) GetRecordingStatus(ctx context.Context, streamID uint64) (*RecordingStatus, error) {
+	return m.recording, m.recordingErr
+}
+
+// playbackRepo 按数据库语义更新回放状态，concurrentStatus不为空时模拟更新前回放已被并发请求改为该状态
+type playbackRepo struct {
+	*fakeLiveRepo
+	concurrentStatus string
+	updateCalls      int
+}
+
+func (r *playbackRepo) UpdatePlaybackStatus(ctx context.Context, streamID uint64, from, to, playbackURL string) (bool, error) {
+	r.updateCalls++
+	if r.concurrentStatus != "" {
+		stream := r.stream(streamID)
+		stream.PlaybackStatus, stream.PlaybackURL = r.concurrentStatus, "https://cdn.example.com/concurrent.mp4"
+		r.putStream(stream)
+	}
+	stream := r.stream(streamID)
+	if stream.PlaybackStatus != from {
+		return false, nil
+	}
+	stream.PlaybackStatus = to
+	if playbackURL != "" {
+		stream.PlaybackURL = playbackURL
+	}
+	r.putStream(stream)
+	return true, nil
+}
+
+// newPlaybackTestService 创建带一场已结束录制直播的服务，回放状态为status
+func newPlaybackTestService(status string, manager *fakeStreamManager) (*liveService, *playbackRepo) {
+	repo := &playbackRepo{fakeLiveRepo: newFakeLiveRepo()}
+	endedAt := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+	repo.putStream(model.LiveStream{
+		ID:             1,
+		UserID:         10,
+		Status:         model.LiveStatusEnded,
+		IsRecord:       true,
+		EndedAt:        &endedAt,
+		Duration:       3600,
+		VideoQuality:   "1080p",
+		PlaybackStatus: status,
+		PlaybackURL:    "https://cdn.example.com/old.mp4",
+	})
+	s := newTestLiveService(repo.fakeLiveRepo)
+	s.liveRepo = repo
+	s.streamManager = manager
+	return s, repo
+}
+
+func TestGetLivePlaybackReady(t *testing.T) {
+	manager := &fakeStreamManager{recording: &RecordingStatus{FileSize: 2048, Format: "mp4"}}
+	s, _ := newPlaybackTestService(model.PlaybackStatusReady, manager)
+
+	playback, err := s.GetLivePlayback(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLivePlayback: %v", err)
+	}
+	if playback.Status != model.PlaybackStatusReady || playback.PlaybackURL != "https://cdn.example.com/old.mp4" {
+		t.Errorf("playback = %+v, want ready with the stored url", playback)
+	}
+	if playback.FileSize != 2048 || playback.Format != "mp4" || playback.Duration != 3600 || playback.Quality != "1080p" {
+		t.Errorf("playback = %+v, want the recording file info and stream duration", playback)
+	}
+	if want := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC).Unix(); playback.CreatedAt != want {
+		t.Errorf("created_at = %d, want the stream end time %d", playback.CreatedAt, want)
+	}
+}
+
+func TestGetLivePlaybackNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamID uint64
+		isRecord bool
+		status   string
+		want     error
+	}{
+		{"stream missing", 2, true, model.PlaybackStatusReady, ErrStreamNotFound},
+		{"not recorded", 1, false, "", ErrPlaybackNotFound},
+		// 升级前结束的录制直播没有回放状态
+		{"no playback status", 1, true, "", ErrPlaybackNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := newPlaybackTestService(tt.status, &fakeStreamManager{})
+			stream := repo.stream(1)
+			stream.IsRecord = tt.isRecord
+			repo.putStream(stream)
+
+			if playback, err := s.GetLivePlayback(context.Background(), tt.streamID); !errors.Is(err, tt.want) || playback != nil {
+				t.Errorf("GetLivePlayback = (%+v, %v), want %v", playback, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLivePlaybackSyncsTranscoding(t *testing.T) {
+	tests := []struct {
+		name        string
+		manager     *fakeStreamManager
+		wantStatus  string
+		wantURL     string
+		wantStored  string
+		wantUpdates int
+	}{
+		{"still transcoding", &fakeStreamManager{transcoding: &TranscodingStatus{IsTranscoding: true, Progress: 40}},
+			model.PlaybackStatusProcessing, "", model.PlaybackStatusProcessing, 0},
+		{"transcoded", &fakeStreamManager{transcoding: &TranscodingStatus{}, recording: &RecordingStatus{FilePath: "https://cdn.example.com/new.mp4", Format: "mp4"}},
+			model.PlaybackStatusReady, "https://cdn.example.com/new.mp4", model.PlaybackStatusReady, 1},
+		{"transcoding failed", &fakeStreamManager{transcoding: &TranscodingStatus{ErrorMessage: "codec error"}},
+			model.PlaybackStatusFailed, "", model.PlaybackStatusFailed, 1},
+		// 查询转码状态失败时仍按转码中返回
+		{"status unavailable", &fakeStreamManager{transcodingErr: errInjected},
+			model.PlaybackStatusProcessing, "", model.PlaybackStatusProcessing, 0},
+		{"recording unavailable", &fakeStreamManager{transcoding: &TranscodingStatus{}, recordingErr: errInjected},
+			model.PlaybackStatusProcessing, "", model.PlaybackStatusProcessing, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := newPlaybackTestService(model.PlaybackStatusProcessing, tt.manager)
+
+			playback, err := s.GetLivePlayback(context.Background(), 1)
+			if err != nil {
+				t.Fatalf("GetLivePlayback: %v", err)
+			}
+			// 只有ready的回放返回播放地址
+			if playback.Status != tt.wantStatus || playback.PlaybackURL != tt.wantURL {
+				t.Errorf("playback status = %s url = %q, want %s %q", playback.Status, playback.PlaybackURL, tt.wantStatus, tt.wantURL)
+			}
+			if got := repo.stream(1).PlaybackStatus; got != tt.wantStored {
+				t.Errorf("stored playback status = %s, want %s", got, tt.wantStored)
+			}
+			if repo.updateCalls != tt.wantUpdates {
+				t.Errorf("playback status updates = %d, want %d", repo.updateCalls, tt.wantUpdates)
+			}
+			if deleted := indexOf(repo.events, eventDeleteStreamCache) >= 0; deleted != (tt.wantUpdates > 0) {
+				t.Errorf("stream cache deleted = %v, want %v", deleted, tt.wantUpdates > 0)
+			}
+		})
+	}
+}
+
+func TestGetLivePlaybackConcurrentSync(t *testing.T) {
+	manager := &fakeStreamManager{transcoding: &TranscodingStatus{}, recording: &RecordingStatus{FilePath: "https://cdn.example.com/new.mp4"}}
+	s, repo := newPlaybackTestService(model.PlaybackStatusProcessing, manager)
+	repo.concurrentStatus = model.PlaybackStatusReady
+
+	playback, err := s.GetLivePlayback(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLivePlayback: %v", err)
+	}
+	// 条件更新未命中时返回并发请求写入的回放
+	if playback.Status != model.PlaybackStatusReady || playback.PlaybackURL != "https://cdn.example.com/concurrent.mp4" {
+		t.Errorf("playback = %+v, want the concurrently stored ready playback", playback)
+	}
+}
+
+func TestStartPlaybackProcessing(t *testing.T) {
+	tests := []struct {
+		name           string
+		manager        *fakeStreamManager
+		want           string
+		wantTranscoded int
+	}{
+		{"submitted", &fakeStreamManager{}, model.PlaybackStatusProcessing, 1},
+		{"stop recording failed", &fakeStreamManager{stopErr: errInjected}, model.PlaybackStatusFailed, 0},
+		{"transcoding failed", &fakeStreamManager{startErr: errInjected}, model.PlaybackStatusFailed, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newPlaybackTestService("", tt.manager)
+			if got := s.startPlaybackProcessing(context.Background(), 1); got != tt.want {
+				t.Errorf("playback status = %s, want %s", got, tt.want)
+			}
+			if len(tt.manager.transcoded) != tt.wantTranscoded {
+				t.Errorf("transcoding submitted %d times, want %d", len(tt.manager.transcoded), tt.wantTranscoded)
+			}
+		})
+	}
+}
+
+func TestOnStreamEndedStartsPlaybackProcessing(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	stream.IsRecord = true
+	repo.putStream(stream)
+	manager := &fakeStreamManager{}
+	s := newTestLiveService(repo)
+	s.streamManager = manager
+
+	if _, err := s.OnStreamEnded(context.Background(), "key-1"); err != nil {
+		t.Fatalf("OnStreamEnded: %v", err)
+	}
+	if got := repo.stream(1).PlaybackStatus; got != model.PlaybackStatusProcessing || len(manager.transcoded) != 1 {
+		t.Errorf("playback status = %s after %d transcoding submissions, want processing after one", got, len(manager.transcoded))
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"live_service/internal/config"
+	"live_service/internal/model"
+)
+
+// 观看者在线状态默认参数
+const (
+	defaultPresenceTTL           = time.Minute
+	defaultPresenceSweepInterval = 15 * time.Second
+	defaultWatchAccrueInterval   = time.Minute
+	presenceSweepBatchSize       = 500 // 单个直播间每次最多清理的观看者数
+)
+
+// presenceTTL 心跳超时时间
+func presenceTTL(cfg *config.Config) time.Duration {
+	if ttl := cfg.Live.Presence.TTL; ttl > 0 {
+		return ttl
+	}
+	return defaultPresenceTTL
+}
+
+// HeartbeatInterval 建议客户端上报心跳的间隔，取超时时间的三分之一，容忍偶发的心跳丢失
+func HeartbeatInterval(cfg *config.Config) time.Duration {
+	return presenceTTL(cfg) / 3
+}
+
+// watchAccrueInterval 心跳累计观看时长的最小间隔
+func watchAccrueInterval(cfg *config.Config) time.Duration {
+	if interval := cfg.Live.Presence.AccrueInterval; interval > 0 {
+		return interval
+	}
+	return defaultWatchAccrueInterval
+}
+
+// Heartbeat 观看者心跳，刷新在线状态并定期累计观看时长
+// 心跳已超时或未进入直播间时返回ErrViewerNotInRoom，客户端需要重新进入直播间
+func (s *liveService) Heartbeat(ctx context.Context, streamID, userID uint64) error {
+	refreshed, err := s.liveRepo.RefreshViewerPresence(ctx, streamID, userID, presenceTTL(s.config))
+	if err != nil {
+		return fmt.Errorf("failed to refresh viewer presence: %w", err)
+	}
+	if !refreshed {
+		return ErrViewerNotInRoom
+	}
+
+	// 长时间不离开的观看者也能及时反映观看时长，离开时再结算剩余部分
+	if _, err := s.liveRepo.AccrueLiveViewerDuration(ctx, streamID, userID, time.Now(), watchAccrueInterval(s.config)); err != nil {
+		s.logger.Warn("Failed to accrue watch duration", "streamID", streamID, "userID", userID, "error", err)
+	}
+	return nil
+}
+
+// SweepExpiredViewers 清理心跳超时的观看者，记录离开时间并扣减观看人数
+// 客户端崩溃或断网时不会调用LeaveLiveRoom，由该任务兜底，避免在线人数虚高
+func (s *liveService) SweepExpiredViewers(ctx context.Context) error {
+	streamIDs, err := s.liveRepo.ListPresenceStreams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list presence streams: %w", err)
+	}
+
+	now := time.Now()
+	for _, streamID := range streamIDs {
+		for {
+			userIDs, err := s.liveRepo.PopExpiredViewers(ctx, streamID, now, presenceSweepBatchSize)
+			if err != nil {
+				s.logger.Warn("Failed to pop expired viewers", "streamID", streamID, "error", err)
+				break
+			}
+			if len(userIDs) == 0 {
+				break
+			}
+
+			exited, err := s.liveRepo.MarkLiveViewerExit(ctx, streamID, userIDs, now)
+			if err != nil {
+				s.logger.Warn("Failed to mark expired viewers exited", "streamID", streamID, "count", len(userIDs), "error", err)
+			}
+			s.statsBatcher.IncrViewerCount(streamID, -exited)
+			if exited > 0 {
+				// 批量清理不区分用户，以一条离开事件通知本批离开人数
+				s.publishLiveEvent(ctx, model.LiveEventLeave, streamID, 0, model.LiveViewerEventData{Count: exited})
+			}
+			s.logger.Info("Expired viewers removed", "streamID", streamID, "count", len(userIDs), "exited", exited)
+
+			if len(userIDs) < presenceSweepBatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// startPresenceSweeper 启动后台清理超时观看者
+func (s *liveService) startPresenceSweeper() {
+	interval := s.config.Live.Presence.SweepInterval
+	if interval <= 0 {
+		interval = defaultPresenceSweepInterval
+	}
+
+	s.presenceStop = make(chan struct{})
+	s.presenceDone = make(chan struct{})
+	go func() {
+		defer close(s.presenceDone)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if err := s.SweepExpiredViewers(ctx); err != nil {
+					s.logger.Warn("Failed to sweep expired viewers", "error", err)
+				}
+				cancel()
+			case <-s.presenceStop:
+				return
+			}
+		}
+	}()
+}
+
+// stopPresenceSweeper 停止后台清理并等待退出
+func (s *liveService) stopPresenceSweeper(ctx context.Context) error {
+	s.presenceStopOnce.Do(func() {
+		close(s.presenceStop)
+	})
+	select {
+	case <-s.presenceDone:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/internal/model"
+	"live_service/internal/repository"
+)
+
+// deltaRedis 记录统计批量写入器提交的计数增量
+type deltaRedis struct {
+	redis.UniversalClient
+	deltas map[string]int64
+}
+
+func (c *deltaRedis) TxPipeline() redis.Pipeliner {
+	return &deltaPipeline{client: c}
+}
+
+type deltaPipeline struct {
+	redis.Pipeliner
+	client *deltaRedis
+}
+
+func (p *deltaPipeline) Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd {
+	p.client.deltas[keys[0]] += args[0].(int64)
+	return redis.NewCmd(ctx)
+}
+
+func (p *deltaPipeline) Exec(ctx context.Context) ([]redis.Cmder, error) {
+	return nil, nil
+}
+
+// newPresenceTestService 创建带统计批量写入器的直播服务，返回记录观看人数增量的Redis
+func newPresenceTestService(repo *fakeLiveRepo) (*liveService, *deltaRedis) {
+	s := newTestLiveService(repo)
+	stats := &deltaRedis{deltas: make(map[string]int64)}
+	s.statsBatcher = repository.NewStatsBatcher(stats, 0, nopLogger{})
+	return s, stats
+}
+
+func TestHeartbeatRefreshesPresence(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+	repo.presence[1] = map[uint64]time.Time{20: time.Now().Add(time.Second)}
+
+	if err := s.Heartbeat(ctx, 1, 20); err != nil {
+		t.Fatalf("Heartbeat: %v", err)
+	}
+	if expireAt := repo.presence[1][20]; time.Until(expireAt) < defaultPresenceTTL-time.Second {
+		t.Errorf("presence expires in %v, want extended by the ttl", time.Until(expireAt))
+	}
+	if repo.accrueCalls != 1 {
+		t.Errorf("accrue calls = %d, want 1", repo.accrueCalls)
+	}
+}
+
+func TestHeartbeatRequiresLivePresence(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	repo.presence[1] = map[uint64]time.Time{21: time.Now().Add(-time.Second)}
+
+	for _, userID := range []uint64{20, 21} {
+		if err := s.Heartbeat(context.Background(), 1, userID); !errors.Is(err, ErrViewerNotInRoom) {
+			t.Errorf("Heartbeat(user %d) error = %v, want ErrViewerNotInRoom", userID, err)
+		}
+	}
+	if _, ok := repo.presence[1][20]; ok {
+		t.Error("heartbeat created presence for a viewer who never joined")
+	}
+	if repo.accrueCalls != 0 {
+		t.Errorf("accrue calls = %d, want none for absent viewers", repo.accrueCalls)
+	}
+}
+
+func TestSweepExpiredViewers(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, stats := newPresenceTestService(repo)
+	ctx := context.Background()
+	expired := time.Now().Add(-time.Second)
+	live := time.Now().Add(time.Minute)
+	repo.presence[1] = map[uint64]time.Time{20: expired, 21: expired, 22: live}
+	repo.presence[2] = map[uint64]time.Time{30: expired}
+
+	if err := s.SweepExpiredViewers(ctx); err != nil {
+		t.Fatalf("SweepExpiredViewers: %v", err)
+	}
+
+	if !repo.viewerExits[1][20] || !repo.viewerExits[1][21] || repo.viewerExits[1][22] || !repo.viewerExits[2][30] {
+		t.Errorf("exits = %v, want 20 and 21 in stream 1 and 30 in stream 2", repo.viewerExits)
+	}
+	if _, ok := repo.presence[1][22]; !ok {
+		t.Error("live viewer was removed")
+	}
+	if _, ok := repo.presence[2]; ok {
+		t.Error("empty stream should leave the presence set")
+	}
+	// 每个直播间一条批量离开事件
+	if got := countEvents(repo.eventsSnapshot(), eventPublish); got != 2 {
+		t.Errorf("leave events = %d, want 2", got)
+	}
+
+	if err := s.statsBatcher.Flush(ctx); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if got := stats.deltas[model.GetLiveViewerCountCacheKey(1)]; got != -2 {
+		t.Errorf("stream 1 viewer delta = %d, want -2", got)
+	}
+	if got := stats.deltas[model.GetLiveViewerCountCacheKey(2)]; got != -1 {
+		t.Errorf("stream 2 viewer delta = %d, want -1", got)
+	}
+}
+
+func TestSweepExpiredViewersInBatches(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, stats := newPresenceTestService(repo)
+	expired := time.Now().Add(-time.Second)
+	total := presenceSweepBatchSize*2 + 1
+	repo.presence[1] = make(map[uint64]time.Time, total)
+	for userID := 1; userID <= total; userID++ {
+		repo.presence[1][uint64(userID)] = expired
+	}
+
+	if err := s.SweepExpiredViewers(context.Background()); err != nil {
+		t.Fatalf("SweepExpiredViewers: %v", err)
+	}
+	if len(repo.viewerExits[1]) != total {
+		t.Errorf("exited = %d, want all %d expired viewers", len(repo.viewerExits[1]), total)
+	}
+	if got := countEvents(repo.eventsSnapshot(), eventPublish); got != 3 {
+		t.Errorf("leave events = %d, want one per batch", got)
+	}
+	if err := s.statsBatcher.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if got := stats.deltas[model.GetLiveViewerCountCacheKey(1)]; got != -int64(total) {
+		t.Errorf("viewer delta = %d, want %d", got, -total)
+	}
+}
+
+func TestSweepSkipsViewersWhoAlreadyLeft(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, stats := newPresenceTestService(repo)
+	// 观看者已通过LeaveLiveRoom离开，心跳记录尚未清理
+	repo.viewerExits[1] = map[uint64]bool{20: true}
+	repo.presence[1] = map[uint64]time.Time{20: time.Now().Add(-time.Second)}
+
+	if err := s.SweepExpiredViewers(context.Background()); err != nil {
+		t.Fatalf("SweepExpiredViewers: %v", err)
+	}
+	if got := countEvents(repo.eventsSnapshot(), eventPublish); got != 0 {
+		t.Errorf("leave events = %d, want none", got)
+	}
+	if err := s.statsBatcher.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if len(stats.deltas) != 0 {
+		t.Errorf("viewer deltas = %v, want none for viewers who already left", stats.deltas)
+	}
+}
+
+func TestHeartbeatInterval(t *testing.T) {
+	s := newTestLiveService(newFakeLiveRepo())
+	if got := HeartbeatInterval(s.config); got != 20*time.Second {
+		t.Errorf("default interval = %v, want 20s", got)
+	}
+	s.config.Live.Presence.TTL = 90 * time.Second
+	if got := HeartbeatInterval(s.config); got != 30*time.Second {
+		t.Errorf("configured interval = %v, want 30s", got)
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRoomPasswordHashedWithBcrypt(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	if err := s.UpdateLiveStreamPrivacy(ctx, 1, 10, true, "open-sesame"); err != nil {
+		t.Fatalf("UpdateLiveStreamPrivacy: %v", err)
+	}
+	stream := repo.stream(1)
+	if !strings.HasPrefix(stream.RoomPassword, "$2") || strings.Contains(stream.RoomPassword, "open-sesame") {
+		t.Fatalf("stored room password = %q, want a bcrypt hash", stream.RoomPassword)
+	}
+
+	if _, err := s.JoinLiveRoom(ctx, 1, 20, ""); !errors.Is(err, ErrRoomPasswordRequired) {
+		t.Errorf("join without password error = %v, want ErrRoomPasswordRequired", err)
+	}
+	if _, err := s.JoinLiveRoom(ctx, 1, 20, "wrong"); !errors.Is(err, ErrRoomPasswordIncorrect) {
+		t.Errorf("join with wrong password error = %v, want ErrRoomPasswordIncorrect", err)
+	}
+	if !checkRoomPassword(&stream, "open-sesame") {
+		t.Error("correct password rejected")
+	}
+}
+
+func TestRoomPasswordSurvivesStreamKeyRotation(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	if err := s.UpdateLiveStreamPrivacy(context.Background(), 1, 10, true, "open-sesame"); err != nil {
+		t.Fatalf("UpdateLiveStreamPrivacy: %v", err)
+	}
+	// 强制停播会更换推流密钥，房间密码不依赖推流密钥
+	stream := repo.stream(1)
+	stream.StreamKey = "revoked_0123"
+	if !checkRoomPassword(&stream, "open-sesame") {
+		t.Error("room password rejected after the stream key was rotated")
+	}
+}
+
+func TestRoomPasswordTooLong(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	err := s.UpdateLiveStreamPrivacy(context.Background(), 1, 10, true, strings.Repeat("x", 73))
+	if !errors.Is(err, ErrRoomPasswordTooLong) {
+		t.Fatalf("UpdateLiveStreamPrivacy error = %v, want ErrRoomPasswordTooLong", err)
+	}
+	if got := repo.stream(1).RoomPassword; got != "" {
+		t.Errorf("room password = %q after rejected update, want unchanged", got)
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"strconv"
+
+	"live_service/internal/model"
+	"live_service/pkg/searchindex"
+)
+
+// indexLiveStream 发布直播索引事件
+// 只有未结束的直播可被搜索，结束或封禁后从索引中删除
+func (s *liveService) indexLiveStream(stream *model.LiveStream) {
+	id := strconv.FormatUint(stream.ID, 10)
+	if model.LiveStatus(stream.Status).IsTerminal() {
+		s.indexer.Publish(searchindex.Event{
+			Op:      searchindex.OpDelete,
+			DocType: searchindex.DocTypeLive,
+			ID:      id,
+		})
+		return
+	}
+
+	fields := map[string]interface{}{
+		"user_id":       stream.UserID,
+		"room_id":       stream.RoomID,
+		"title":         stream.Title,
+		"description":   stream.Description,
+		"category_id":   stream.CategoryID,
+		"thumbnail_url": stream.ThumbnailURL,
+		"status":        model.LiveStatus(stream.Status).String(),
+		"is_public":     stream.IsPublic,
+		"has_password":  stream.RoomPassword != "",
+	}
+	if stream.StartedAt != nil {
+		fields["started_at"] = stream.StartedAt.Unix()
+	}
+
+	s.indexer.Publish(searchindex.Event{
+		Op:      searchindex.OpUpsert,
+		DocType: searchindex.DocTypeLive,
+		ID:      id,
+		Fields:  fields,
+	})
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"live_service/internal/model"
+	"live_service/pkg/searchindex"
+)
+
+// recordingSink 记录投递的索引事件
+type recordingSink struct {
+	mu     sync.Mutex
+	events []searchindex.Event
+}
+
+func (s *recordingSink) Push(ctx context.Context, event *searchindex.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, *event)
+	return nil
+}
+
+// newIndexTestService 创建带索引发布器的直播服务
+// 返回的函数停止发布器并返回已投递的事件
+func newIndexTestService(t *testing.T, repo *fakeLiveRepo) (*liveService, func() []searchindex.Event) {
+	t.Helper()
+	sink := &recordingSink{}
+	s := newTestLiveService(repo)
+	s.indexer = searchindex.NewPublisher(sink, 0, nopLogger{})
+	s.indexer.Start()
+	return s, func() []searchindex.Event {
+		t.Helper()
+		if err := s.indexer.Stop(context.Background()); err != nil {
+			t.Fatalf("stop indexer: %v", err)
+		}
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return sink.events
+	}
+}
+
+func TestAuthenticateStreamKeyIndexesLiveStream(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	stream.Status = model.LiveStatusPreparing
+	stream.StartedAt = nil
+	stream.Title = "深夜电台"
+	stream.IsPublic = true
+	repo.putStream(stream)
+	s, events := newIndexTestService(t, repo)
+
+	if _, err := s.AuthenticateStreamKey(context.Background(), stream.StreamKey); err != nil {
+		t.Fatalf("AuthenticateStreamKey: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 {
+		t.Fatalf("index events = %v, want 1", got)
+	}
+	event := got[0]
+	if event.Op != searchindex.OpUpsert || event.DocType != searchindex.DocTypeLive || event.ID != "1" {
+		t.Errorf("event = %s %s %s, want upsert live 1", event.Op, event.DocType, event.ID)
+	}
+	if event.Fields["title"] != "深夜电台" || event.Fields["status"] != model.LiveStatus(model.LiveStatusStreaming).String() {
+		t.Errorf("fields = %v, want the streaming title", event.Fields)
+	}
+	if _, ok := event.Fields["started_at"]; !ok {
+		t.Error("started_at missing from a started stream")
+	}
+	if event.Timestamp == 0 {
+		t.Error("event timestamp not set")
+	}
+}
+
+func TestStopLiveDeletesLiveStreamIndex(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	s, events := newIndexTestService(t, repo)
+
+	if err := s.StopLive(context.Background(), stream.ID, stream.UserID); err != nil {
+		t.Fatalf("StopLive: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 || got[0].Op != searchindex.OpDelete || got[0].ID != "1" || got[0].Fields != nil {
+		t.Errorf("index events = %v, want a single delete for stream 1", got)
+	}
+}
+
+func TestUpdateLiveStreamPrivacyReindexes(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s, events := newIndexTestService(t, repo)
+
+	if err := s.UpdateLiveStreamPrivacy(context.Background(), 1, 10, false, "open-sesame"); err != nil {
+		t.Fatalf("UpdateLiveStreamPrivacy: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 || got[0].Op != searchindex.OpUpsert {
+		t.Fatalf("index events = %v, want a single upsert", got)
+	}
+	// 只发布是否有密码，不泄露密码哈希
+	if got[0].Fields["is_public"] != false || got[0].Fields["has_password"] != true {
+		t.Errorf("fields = %v, want private with password", got[0].Fields)
+	}
+	for _, v := range got[0].Fields {
+		if v == repo.stream(1).RoomPassword {
+			t.Errorf("room password hash leaked into the index: %v", got[0].Fields)
+		}
+	}
+}
+
+func TestFailedMutationsDoNotIndex(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	stream.Status = model.LiveStatusBanned
+	repo.putStream(stream)
+	s, events := newIndexTestService(t, repo)
+
+	if err := s.StopLive(context.Background(), stream.ID, stream.UserID); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("StopLive error = %v, want ErrInvalidStatusTransition", err)
+	}
+	if err := s.UpdateLiveStreamPrivacy(context.Background(), stream.ID, 99, true, ""); !errors.Is(err, ErrStreamPermissionDenied) {
+		t.Fatalf("UpdateLiveStreamPrivacy error = %v, want ErrStreamPermissionDenied", err)
+	}
+	if got := events(); len(got) != 0 {
+		t.Errorf("index events = %v, want none for rejected mutations", got)
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// RecomputeLiveStats 从明细数据重新计算已结束直播的统计，用于结算失败后的补数
+// 仅平台管理员可调用，直播未结束时返回ErrStreamNotFinished
+func (s *liveService) RecomputeLiveStats(ctx context.Context, operatorID, streamID uint64) (*LiveStats, error) {
+	s.logger.Info("Recomputing live stats", "streamID", streamID, "operatorID", operatorID)
+
+	if !s.config.Live.Chat.IsAdmin(operatorID) {
+		return nil, ErrStreamPermissionDenied
+	}
+
+	stream, err := s.liveRepo.GetLiveStream(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStreamNotFound
+		}
+		return nil, fmt.Errorf("failed to get live stream: %w", err)
+	}
+	if stream.Status != model.LiveStatusEnded && stream.Status != model.LiveStatusBanned {
+		return nil, ErrStreamNotFinished
+	}
+
+	stats, err := s.liveRepo.RecomputeLiveStats(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.liveRepo.DeleteLiveStreamCache(ctx, streamID); err != nil {
+		s.logger.Warn("Failed to delete live stream cache", "streamID", streamID, "error", err)
+	}
+
+	s.logger.Info("Live stats recomputed", "streamID", streamID, "operatorID", operatorID,
+		"viewers", stats.TotalViewers, "likes", stats.LikeCount, "comments", stats.CommentCount,
+		"gifts", stats.GiftCount, "giftValue", stats.GiftValue)
+
+	return &LiveStats{
+		StreamID:     stats.StreamID,
+		TotalViewers: stats.TotalViewers,
+		LikeCount:    stats.LikeCount,
+		GiftCount:    stats.GiftCount,
+		CommentCount: stats.CommentCount,
+		ShareCount:   stats.ShareCount,
+		Duration:     stats.Duration,
+		GiftValue:    stats.GiftValue,
+	}, nil
+}
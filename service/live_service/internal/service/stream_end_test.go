@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+// countEvents 返回事件出现的次数
+func countEvents(events []string, event string) int {
+	n := 0
+	for _, e := range events {
+		if e == event {
+			n++
+		}
+	}
+	return n
+}
+
+func TestOnStreamEndedFinalizesStream(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	stream, err := s.OnStreamEnded(context.Background(), "key-1")
+	if err != nil {
+		t.Fatalf("OnStreamEnded: %v", err)
+	}
+	if stream.Status != model.LiveStatusEnded || stream.EndedAt == nil || stream.Duration == 0 {
+		t.Fatalf("returned stream = status %d ended_at %v duration %d, want ended with duration", stream.Status, stream.EndedAt, stream.Duration)
+	}
+	if got := repo.stream(1).Status; got != model.LiveStatusEnded {
+		t.Errorf("stored stream status = %d, want ended", got)
+	}
+	if got := repo.finalizeCount(); got != 1 {
+		t.Errorf("finalizations = %d, want 1", got)
+	}
+	if got := countEvents(repo.eventsSnapshot(), eventPublish); got != 1 {
+		t.Errorf("ended events published = %d, want 1", got)
+	}
+}
+
+func TestOnStreamEndedDuplicateCallbackFinalizesOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	first, err := s.OnStreamEnded(ctx, "key-1")
+	if err != nil {
+		t.Fatalf("first OnStreamEnded: %v", err)
+	}
+	second, err := s.OnStreamEnded(ctx, "key-1")
+	if err != nil {
+		t.Fatalf("duplicate OnStreamEnded: %v", err)
+	}
+
+	if second.Status != model.LiveStatusEnded || !second.EndedAt.Equal(*first.EndedAt) || second.Duration != first.Duration {
+		t.Errorf("duplicate returned ended_at %v duration %d, want original %v %d", second.EndedAt, second.Duration, first.EndedAt, first.Duration)
+	}
+	if got := repo.finalizeCount(); got != 1 {
+		t.Errorf("finalizations = %d, want 1", got)
+	}
+	events := repo.eventsSnapshot()
+	if got := countEvents(events, eventCommit); got != 1 {
+		t.Errorf("commits = %d, want 1 (events %v)", got, events)
+	}
+	if got := countEvents(events, eventPublish); got != 1 {
+		t.Errorf("ended events published = %d, want 1", got)
+	}
+}
+
+func TestOnStreamEndedConcurrentCallbacksFinalizeOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.OnStreamEnded(context.Background(), "key-1"); err != nil {
+				t.Errorf("OnStreamEnded: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := repo.finalizeCount(); got != 1 {
+		t.Errorf("finalizations = %d, want 1", got)
+	}
+	if got := repo.stream(1).Status; got != model.LiveStatusEnded {
+		t.Errorf("stream status = %d, want ended", got)
+	}
+}
+
+func TestOnStreamEndedAfterStopLiveIsNoop(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	ctx := context.Background()
+
+	if err := s.StopLive(ctx, 1, 10); err != nil {
+		t.Fatalf("StopLive: %v", err)
+	}
+	if _, err := s.OnStreamEnded(ctx, "key-1"); err != nil {
+		t.Fatalf("OnStreamEnded after StopLive: %v", err)
+	}
+	if got := repo.finalizeCount(); got != 1 {
+		t.Errorf("finalizations = %d, want 1", got)
+	}
+}
+
+func TestOnStreamEndedUnknownStreamKey(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	for _, key := range []string{"", "missing"} {
+		if _, err := s.OnStreamEnded(context.Background(), key); !errors.Is(err, ErrStreamKeyNotFound) {
+			t.Errorf("OnStreamEnded(%q) error = %v, want ErrStreamKeyNotFound", key, err)
+		}
+	}
+	if got := repo.finalizeCount(); got != 0 {
+		t.Errorf("finalizations = %d, want 0", got)
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+func TestAuthenticateStreamKeyLosesToConcurrentForceStop(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	stream.Status = model.LiveStatusPreparing
+	stream.StartedAt = nil
+	repo.putStream(stream)
+	s := newTestLiveService(repo)
+	s.config.Live.Chat.AdminUserIDs = []uint64{99}
+
+	// 推流鉴权读取直播之后，管理员强制停播更换了密钥并结束直播
+	repo.afterGetByStreamKey = func() {
+		if _, err := s.ForceStopLive(context.Background(), 99, 1, "违规"); err != nil {
+			t.Errorf("ForceStopLive: %v", err)
+		}
+	}
+
+	if _, err := s.AuthenticateStreamKey(context.Background(), "key-1"); !errors.Is(err, ErrStreamKeyInactive) {
+		t.Fatalf("AuthenticateStreamKey error = %v, want ErrStreamKeyInactive", err)
+	}
+	got := repo.stream(1)
+	if got.Status != model.LiveStatusEnded {
+		t.Errorf("status = %s, want the force stop to stand", model.LiveStatus(got.Status))
+	}
+	if got.StreamKey == "key-1" {
+		t.Error("stream key was not rotated by the force stop")
+	}
+}
+
+func TestAuthenticateStreamKeyAcceptsValidKey(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	stream.Status = model.LiveStatusPreparing
+	stream.StartedAt = nil
+	repo.putStream(stream)
+	s := newTestLiveService(repo)
+
+	got, err := s.AuthenticateStreamKey(context.Background(), "key-1")
+	if err != nil {
+		t.Fatalf("AuthenticateStreamKey: %v", err)
+	}
+	if got.ID != 1 || got.UserID != 10 {
+		t.Errorf("authenticated stream %d of user %d, want stream 1 of user 10", got.ID, got.UserID)
+	}
+	stored := repo.stream(1)
+	if stored.Status != model.LiveStatusStreaming || stored.StartedAt == nil {
+		t.Errorf("stored stream status %s, started_at %v; want streaming with a start time", model.LiveStatus(stored.Status), stored.StartedAt)
+	}
+}
+
+func TestAuthenticateStreamKeyRejectsUnknownKey(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+
+	for _, key := range []string{"", "key-2", "KEY-1"} {
+		if _, err := s.AuthenticateStreamKey(context.Background(), key); !errors.Is(err, ErrStreamKeyNotFound) {
+			t.Errorf("AuthenticateStreamKey(%q) error = %v, want ErrStreamKeyNotFound", key, err)
+		}
+	}
+}
+
+func TestAuthenticateStreamKeyRejectsRevokedKey(t *testing.T) {
+	repo := newFakeLiveRepo()
+	newLiveTestStream(repo)
+	s := newTestLiveService(repo)
+	s.config.Live.Chat.AdminUserIDs = []uint64{99}
+
+	stopped, err := s.ForceStopLive(context.Background(), 99, 1, "违规")
+	if err != nil {
+		t.Fatalf("ForceStopLive: %v", err)
+	}
+
+	// 原密钥已作废，替换后的密钥对应已结束的直播，都不能再推流
+	if _, err := s.AuthenticateStreamKey(context.Background(), "key-1"); !errors.Is(err, ErrStreamKeyNotFound) {
+		t.Errorf("original key error = %v, want ErrStreamKeyNotFound", err)
+	}
+	if _, err := s.AuthenticateStreamKey(context.Background(), stopped.StreamKey); !errors.Is(err, ErrStreamKeyInactive) {
+		t.Errorf("revoked key error = %v, want ErrStreamKeyInactive", err)
+	}
+	if got := repo.stream(1).Status; got != model.LiveStatusEnded {
+		t.Errorf("status = %s after rejected publishes, want ended", model.LiveStatus(got))
+	}
+}
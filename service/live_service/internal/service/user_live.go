@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"live_service/internal/model"
+)
+
+// GetUserLiveStream 获取用户当前未结束(准备中、直播中或暂停)的直播，用户未在直播时返回ErrStreamNotFound
+// 用户对应的直播流ID缓存在Redis中，未在直播的结果同样缓存，开播和下播时主动失效
+func (s *liveService) GetUserLiveStream(ctx context.Context, userID uint64) (*model.LiveStream, error) {
+	s.logger.Info("Getting user live stream", "userID", userID)
+
+	streamID, found, err := s.liveRepo.GetUserActiveStreamCache(ctx, userID)
+	if err != nil {
+		s.logger.Warn("Failed to get user active stream cache", "userID", userID, "error", err)
+	} else if found {
+		if streamID == 0 {
+			return nil, ErrStreamNotFound
+		}
+		stream, err := s.GetLiveStream(ctx, streamID)
+		if err == nil {
+			return stream, nil
+		}
+		// 缓存的直播已结束时回源数据库，用户可能已开始新的直播
+		if !errors.Is(err, ErrStreamNotFound) {
+			return nil, err
+		}
+	}
+
+	stream, err := s.liveRepo.GetLiveStreamByUserID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get user live stream: %w", err)
+		}
+		if err := s.liveRepo.SetUserActiveStreamCache(ctx, userID, 0); err != nil {
+			s.logger.Warn("Failed to set user active stream cache", "userID", userID, "error", err)
+		}
+		return nil, ErrStreamNotFound
+	}
+	if err := s.liveRepo.SetUserActiveStreamCache(ctx, userID, stream.ID); err != nil {
+		s.logger.Warn("Failed to set user active stream cache", "userID", userID, "error", err)
+	}
+
+	viewerCount, err := s.getViewerCount(ctx, stream.ID)
+	if err != nil {
+		s.logger.Warn("Failed to get viewer count", "streamID", stream.ID, "error", err)
+	} else {
+		stream.ViewerCount = uint32(viewerCount)
+	}
+	return stream, nil
+}
+
+// invalidateUserLiveStream 用户开播或下播后删除其当前直播缓存，失败时等待缓存过期
+func (s *liveService) invalidateUserLiveStream(ctx context.Context, userID uint64) {
+	if err := s.liveRepo.DeleteUserActiveStreamCache(ctx, userID); err != nil {
+		s.logger.Warn("Failed to delete user active stream cache", "userID", userID, "error", err)
+	}
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"live_service/internal/model"
+)
+
+// userLiveRepo 在roomRepo基础上缓存用户当前直播的流ID，记录回源数据库和删除缓存的次数
+type userLiveRepo struct {
+	*roomRepo
+	active   map[uint64]uint64
+	cacheErr error
+	dbErr    error
+
+	dbLookups    int
+	cacheDeletes []uint64
+}
+
+func newUserLiveRepo() *userLiveRepo {
+	return &userLiveRepo{roomRepo: newRoomRepo(), active: make(map[uint64]uint64)}
+}
+
+func (r *userLiveRepo) GetUserActiveStreamCache(ctx context.Context, userID uint64) (uint64, bool, error) {
+	if r.cacheErr != nil {
+		return 0, false, r.cacheErr
+	}
+	streamID, ok := r.active[userID]
+	return streamID, ok, nil
+}
+
+func (r *userLiveRepo) SetUserActiveStreamCache(ctx context.Context, userID, streamID uint64) error {
+	r.active[userID] = streamID
+	return nil
+}
+
+func (r *userLiveRepo) DeleteUserActiveStreamCache(ctx context.Context, userID uint64) error {
+	r.cacheDeletes = append(r.cacheDeletes, userID)
+	delete(r.active, userID)
+	return nil
+}
+
+func (r *userLiveRepo) GetLiveStreamByUserID(ctx context.Context, userID uint64) (*model.LiveStream, error) {
+	r.dbLookups++
+	if r.dbErr != nil {
+		return nil, r.dbErr
+	}
+	return r.roomRepo.GetLiveStreamByUserID(ctx, userID)
+}
+
+func newUserLiveTestService(repo *userLiveRepo) *liveService {
+	s := newTestLiveService(repo.fakeLiveRepo)
+	s.liveRepo = repo
+	return s
+}
+
+func TestGetUserLiveStream(t *testing.T) {
+	tests := []struct {
+		name   string
+		cached map[uint64]uint64
+		// status 数据库中用户10的直播状态
+		status        uint8
+		wantID        uint64
+		wantErr       error
+		wantDBLookups int
+		wantCached    uint64
+	}{
+		{"cache miss", nil, model.LiveStatusStreaming, 1, nil, 1, 1},
+		{"cache hit", map[uint64]uint64{10: 1}, model.LiveStatusStreaming, 1, nil, 0, 1},
+		{"paused", nil, model.LiveStatusPaused, 1, nil, 1, 1},
+		// 缓存的未在直播结果直接返回，不查询数据库
+		{"cached not live", map[uint64]uint64{10: 0}, model.LiveStatusStreaming, 0, ErrStreamNotFound, 0, 0},
+		{"not live", nil, model.LiveStatusEnded, 0, ErrStreamNotFound, 1, 0},
+		// 缓存的直播已结束时回源数据库并缓存结果
+		{"stale cache", map[uint64]uint64{10: 1}, model.LiveStatusEnded, 0, ErrStreamNotFound, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newUserLiveRepo()
+			stream := newLiveTestStream(repo.fakeLiveRepo)
+			stream.Status = tt.status
+			repo.putStream(stream)
+			repo.viewerCounts[1] = 42
+			for userID, streamID := range tt.cached {
+				repo.active[userID] = streamID
+			}
+			s := newUserLiveTestService(repo)
+
+			got, err := s.GetUserLiveStream(context.Background(), 10)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetUserLiveStream error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (got.ID != tt.wantID || got.ViewerCount != 42) {
+				t.Errorf("stream = %+v, want stream %d with 42 viewers", got, tt.wantID)
+			}
+			if repo.dbLookups != tt.wantDBLookups {
+				t.Errorf("database lookups = %d, want %d", repo.dbLookups, tt.wantDBLookups)
+			}
+			if cached, ok := repo.active[10]; !ok || cached != tt.wantCached {
+				t.Errorf("cached stream = (%d, %v), want %d", cached, ok, tt.wantCached)
+			}
+		})
+	}
+}
+
+func TestGetUserLiveStreamCacheError(t *testing.T) {
+	repo := newUserLiveRepo()
+	repo.putStream(newLiveTestStream(repo.fakeLiveRepo))
+	repo.cacheErr = errors.New("redis down")
+	s := newUserLiveTestService(repo)
+
+	// 缓存不可用时回源数据库
+	got, err := s.GetUserLiveStream(context.Background(), 10)
+	if err != nil || got.ID != 1 || repo.dbLookups != 1 {
+		t.Errorf("GetUserLiveStream = (%+v, %v) after %d lookups, want stream 1 from the database", got, err, repo.dbLookups)
+	}
+}
+
+func TestGetUserLiveStreamDBError(t *testing.T) {
+	repo := newUserLiveRepo()
+	repo.dbErr = errors.New("db down")
+	s := newUserLiveTestService(repo)
+
+	got, err := s.GetUserLiveStream(context.Background(), 10)
+	if !errors.Is(err, repo.dbErr) || errors.Is(err, ErrStreamNotFound) || got != nil {
+		t.Errorf("GetUserLiveStream = (%+v, %v), want the database error", got, err)
+	}
+	// 查询失败不缓存未在直播的结果
+	if _, ok := repo.active[10]; ok {
+		t.Errorf("cached %d after a database error, want no cache", repo.active[10])
+	}
+}
+
+func TestStartLiveInvalidatesUserLiveStream(t *testing.T) {
+	repo := newUserLiveRepo()
+	repo.active[7] = 0
+	s := newUserLiveTestService(repo)
+	ctx := context.Background()
+
+	stream, err := s.StartLive(ctx, 7, "今晚开黑", "", 0, "", "")
+	if err != nil {
+		t.Fatalf("StartLive: %v", err)
+	}
+	// 开播前缓存的未在直播结果失效，随后的查询返回新直播
+	got, err := s.GetUserLiveStream(ctx, 7)
+	if err != nil || got.ID != stream.ID {
+		t.Errorf("GetUserLiveStream = (%+v, %v), want the new stream %d", got, err, stream.ID)
+	}
+}
+
+func TestOnStreamEndedInvalidatesUserLiveStream(t *testing.T) {
+	repo := newUserLiveRepo()
+	repo.putStream(newLiveTestStream(repo.fakeLiveRepo))
+	s := newUserLiveTestService(repo)
+	ctx := context.Background()
+
+	if _, err := s.GetUserLiveStream(ctx, 10); err != nil {
+		t.Fatalf("GetUserLiveStream: %v", err)
+	}
+	if _, err := s.OnStreamEnded(ctx, "key-1"); err != nil {
+		t.Fatalf("OnStreamEnded: %v", err)
+	}
+	if len(repo.cacheDeletes) != 1 || repo.cacheDeletes[0] != 10 {
+		t.Fatalf("deleted user caches %v, want user 10", repo.cacheDeletes)
+	}
+	if _, err := s.GetUserLiveStream(ctx, 10); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("GetUserLiveStream after end = %v, want ErrStreamNotFound", err)
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"live_service/internal/model"
+)
+
+// viewerCountPushInterval 在线人数推送的最小间隔
+const viewerCountPushInterval = time.Second
+
+// StreamViewerCount 订阅直播间在线人数，返回的通道先推送当前人数，之后人数变化时推送
+// 两次推送间隔不小于1秒，间隔内的多次变化只推送最新值；直播结束或ctx取消后通道关闭
+func (s *liveService) StreamViewerCount(ctx context.Context, streamID, userID uint64) (<-chan int64, error) {
+	// 先订阅再读取当前人数，避免两者之间的变化丢失
+	sub, err := s.SubscribeLiveEvents(ctx, streamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.getViewerCount(ctx, streamID)
+	if err != nil {
+		sub.Close()
+		return nil, err
+	}
+
+	counts := make(chan int64)
+	go func() {
+		defer close(counts)
+		defer sub.Close()
+		throttleViewerCount(ctx, sub.Events(), current, viewerCountPushInterval, counts)
+	}()
+	return counts, nil
+}
+
+// throttleViewerCount 从直播间事件中提取在线人数写入out，相邻两次写入至少间隔interval，
+// 与上次写入相同的人数不重复写入；直播结束时先写入尚未推送的最新人数再返回
+func throttleViewerCount(ctx context.Context, events <-chan *model.LiveEvent, initial int64, interval time.Duration, out chan<- int64) {
+	var (
+		last     = initial
+		lastSent time.Time
+		pending  bool
+		timer    *time.Timer
+		timerC   <-chan time.Time
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	send := func(count int64) bool {
+		select {
+		case out <- count:
+			last = count
+			lastSent = time.Now()
+			pending = false
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if !send(initial) {
+		return
+	}
+
+	latest := initial
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			switch event.Type {
+			case model.LiveEventEnded, model.LiveEventTerminated:
+				if pending {
+					send(latest)
+				}
+				return
+			case model.LiveEventViewerCount:
+				var data model.LiveViewerCountEventData
+				if err := json.Unmarshal(event.Data, &data); err != nil {
+					continue
+				}
+				latest = data.Count
+				pending = latest != last
+				if !pending || timerC != nil {
+					continue
+				}
+				if wait := interval - time.Since(lastSent); wait > 0 {
+					timer = time.NewTimer(wait)
+					timerC = timer.C
+					continue
+				}
+				if !send(latest) {
+					return
+				}
+			}
+		case <-timerC:
+			timerC = nil
+			if pending && !send(latest) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+)
+
+// viewerCountEvent 构造在线人数事件
+func viewerCountEvent(t *testing.T, count int64) *model.LiveEvent {
+	t.Helper()
+	data, err := json.Marshal(model.LiveViewerCountEventData{Count: count})
+	if err != nil {
+		t.Fatalf("marshal viewer count: %v", err)
+	}
+	return &model.LiveEvent{Type: model.LiveEventViewerCount, Data: data}
+}
+
+// runThrottle 在后台运行throttleViewerCount，返回输出通道和退出通知
+func runThrottle(ctx context.Context, events <-chan *model.LiveEvent, initial int64, interval time.Duration) (<-chan int64, <-chan struct{}) {
+	out := make(chan int64)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		throttleViewerCount(ctx, events, initial, interval, out)
+	}()
+	return out, done
+}
+
+// receiveCount 在timeout内读取一个人数
+func receiveCount(t *testing.T, out <-chan int64, timeout time.Duration) int64 {
+	t.Helper()
+	select {
+	case count := <-out:
+		return count
+	case <-time.After(timeout):
+		t.Fatal("no viewer count received")
+		return 0
+	}
+}
+
+// expectNoCount 确认wait内没有推送
+func expectNoCount(t *testing.T, out <-chan int64, wait time.Duration) {
+	t.Helper()
+	select {
+	case count := <-out:
+		t.Fatalf("unexpected viewer count %d", count)
+	case <-time.After(wait):
+	}
+}
+
+func TestThrottleViewerCountCoalescesUpdates(t *testing.T) {
+	events := make(chan *model.LiveEvent)
+	out, done := runThrottle(context.Background(), events, 10, 50*time.Millisecond)
+
+	// 先推送当前人数
+	if got := receiveCount(t, out, time.Second); got != 10 {
+		t.Fatalf("initial count = %d, want 10", got)
+	}
+
+	// 间隔内的多次变化只推送最新值，且不早于间隔
+	begin := time.Now()
+	events <- viewerCountEvent(t, 11)
+	events <- viewerCountEvent(t, 12)
+	events <- viewerCountEvent(t, 13)
+	if got := receiveCount(t, out, time.Second); got != 13 {
+		t.Errorf("coalesced count = %d, want 13", got)
+	}
+	if elapsed := time.Since(begin); elapsed < 40*time.Millisecond {
+		t.Errorf("pushed after %v, want at least the interval", elapsed)
+	}
+
+	close(events)
+	<-done
+}
+
+func TestThrottleViewerCountSkipsUnchanged(t *testing.T) {
+	events := make(chan *model.LiveEvent)
+	out, done := runThrottle(context.Background(), events, 10, 10*time.Millisecond)
+	receiveCount(t, out, time.Second)
+
+	// 与上次推送相同的人数和无法解析的事件不推送
+	events <- viewerCountEvent(t, 10)
+	events <- &model.LiveEvent{Type: model.LiveEventViewerCount, Data: []byte("not json")}
+	events <- &model.LiveEvent{Type: model.LiveEventLike}
+	expectNoCount(t, out, 30*time.Millisecond)
+
+	// 间隔已过的变化立即推送
+	events <- viewerCountEvent(t, 20)
+	if got := receiveCount(t, out, time.Second); got != 20 {
+		t.Errorf("count = %d, want 20", got)
+	}
+
+	// 变化后又回到已推送的人数时不再推送
+	events <- viewerCountEvent(t, 21)
+	events <- viewerCountEvent(t, 20)
+	expectNoCount(t, out, 30*time.Millisecond)
+
+	close(events)
+	<-done
+}
+
+func TestThrottleViewerCountFlushesOnStreamEnd(t *testing.T) {
+	for _, eventType := range []model.LiveEventType{model.LiveEventEnded, model.LiveEventTerminated} {
+		t.Run(string(eventType), func(t *testing.T) {
+			events := make(chan *model.LiveEvent)
+			out, done := runThrottle(context.Background(), events, 10, time.Minute)
+			receiveCount(t, out, time.Second)
+
+			// 直播结束时先推送间隔内尚未推送的最新人数
+			events <- viewerCountEvent(t, 0)
+			events <- &model.LiveEvent{Type: eventType}
+			if got := receiveCount(t, out, time.Second); got != 0 {
+				t.Errorf("final count = %d, want 0", got)
+			}
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("throttle did not stop after the stream ended")
+			}
+		})
+	}
+}
+
+func TestThrottleViewerCountStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *model.LiveEvent)
+	_, done := runThrottle(ctx, events, 10, time.Minute)
+
+	// 客户端未读取时取消也能退出
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("throttle did not stop after cancel")
+	}
+}
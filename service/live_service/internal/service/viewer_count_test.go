@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLiveStreamViewerCountFallsBackToDatabase(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	repo.dbViewerCounts[stream.ID] = 12
+	s := newTestLiveService(repo)
+
+	got, err := s.GetLiveStream(context.Background(), stream.ID)
+	if err != nil {
+		t.Fatalf("GetLiveStream: %v", err)
+	}
+	if got.ViewerCount != 12 {
+		t.Errorf("viewer count = %d, want database count 12", got.ViewerCount)
+	}
+	if cached, ok := repo.viewerCounts[stream.ID]; !ok || cached != 12 {
+		t.Errorf("viewer count cache = %d (found %v), want repopulated with 12", cached, ok)
+	}
+}
+
+func TestGetLiveStreamViewerCountTrustsCachedZero(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	// 所有观众都已离开，计数为0但仍存在，不应被数据库中的旧值覆盖
+	repo.viewerCounts[stream.ID] = 0
+	repo.dbViewerCounts[stream.ID] = 5
+	s := newTestLiveService(repo)
+
+	got, err := s.GetLiveStream(context.Background(), stream.ID)
+	if err != nil {
+		t.Fatalf("GetLiveStream: %v", err)
+	}
+	if got.ViewerCount != 0 {
+		t.Errorf("viewer count = %d, want cached 0", got.ViewerCount)
+	}
+	if repo.viewerCounts[stream.ID] != 0 {
+		t.Errorf("cached zero was overwritten with %d", repo.viewerCounts[stream.ID])
+	}
+}
+
+func TestGetLiveStreamViewerCountCacheError(t *testing.T) {
+	repo := newFakeLiveRepo()
+	stream := newLiveTestStream(repo)
+	repo.viewerCountCacheErr = errInjected
+	repo.dbViewerCounts[stream.ID] = 3
+	s := newTestLiveService(repo)
+
+	got, err := s.GetLiveStream(context.Background(), stream.ID)
+	if err != nil {
+		t.Fatalf("GetLiveStream with cache error: %v", err)
+	}
+	if got.ViewerCount != 3 {
+		t.Errorf("viewer count = %d, want database count 3", got.ViewerCount)
+	}
+}
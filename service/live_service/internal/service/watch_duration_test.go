@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"live_service/internal/model"
+)
+
+// newWatchRecord 写入进入时间在enteredAgo之前的观看记录，并保持在线状态
+func newWatchRecord(repo *fakeLiveRepo, streamID, userID uint64, enteredAgo time.Duration) *model.LiveViewer {
+	viewer := &model.LiveViewer{StreamID: streamID, UserID: userID, EnterTime: time.Now().Add(-enteredAgo)}
+	if repo.watchRecords[streamID] == nil {
+		repo.watchRecords[streamID] = make(map[uint64]*model.LiveViewer)
+	}
+	repo.watchRecords[streamID][userID] = viewer
+	if repo.presence[streamID] == nil {
+		repo.presence[streamID] = make(map[uint64]time.Time)
+	}
+	repo.presence[streamID][userID] = time.Now().Add(time.Minute)
+	return viewer
+}
+
+// assertWatchDuration 观看时长按整秒累计，允许测试执行耗时带来的一秒误差
+func assertWatchDuration(t *testing.T, viewer *model.LiveViewer, want uint32) {
+	t.Helper()
+	if viewer.WatchDuration < want || viewer.WatchDuration > want+1 {
+		t.Errorf("watch duration = %ds, want %ds", viewer.WatchDuration, want)
+	}
+}
+
+func TestHeartbeatAccruesWatchDuration(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	viewer := newWatchRecord(repo, 1, 20, 3*time.Minute)
+
+	if err := s.Heartbeat(context.Background(), 1, 20); err != nil {
+		t.Fatalf("Heartbeat: %v", err)
+	}
+	if repo.accrueInterval != defaultWatchAccrueInterval {
+		t.Errorf("accrue interval = %v, want the default %v", repo.accrueInterval, defaultWatchAccrueInterval)
+	}
+	assertWatchDuration(t, viewer, 180)
+	if viewer.AccruedAt == nil || time.Since(*viewer.AccruedAt) > time.Second {
+		t.Fatalf("accrued at = %v, want now", viewer.AccruedAt)
+	}
+
+	// 距上次累计不足间隔的心跳不再累计
+	if err := s.Heartbeat(context.Background(), 1, 20); err != nil {
+		t.Fatalf("second Heartbeat: %v", err)
+	}
+	assertWatchDuration(t, viewer, 180)
+}
+
+func TestHeartbeatHonorsConfiguredAccrueInterval(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s := newTestLiveService(repo)
+	s.config.Live.Presence.AccrueInterval = 5 * time.Minute
+	viewer := newWatchRecord(repo, 1, 20, 3*time.Minute)
+
+	if err := s.Heartbeat(context.Background(), 1, 20); err != nil {
+		t.Fatalf("Heartbeat: %v", err)
+	}
+	if repo.accrueInterval != 5*time.Minute {
+		t.Errorf("accrue interval = %v, want 5m", repo.accrueInterval)
+	}
+	if viewer.WatchDuration != 0 || viewer.AccruedAt != nil {
+		t.Errorf("viewer = %ds accrued at %v, want nothing accrued within the interval", viewer.WatchDuration, viewer.AccruedAt)
+	}
+}
+
+func TestLeaveLiveRoomSettlesRemainingWatchDuration(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, _ := newPresenceTestService(repo)
+	viewer := newWatchRecord(repo, 1, 20, 5*time.Minute)
+	// 心跳已累计至90秒前
+	accruedAt := time.Now().Add(-90 * time.Second)
+	viewer.WatchDuration, viewer.AccruedAt = 210, &accruedAt
+
+	if err := s.LeaveLiveRoom(context.Background(), 1, 20); err != nil {
+		t.Fatalf("LeaveLiveRoom: %v", err)
+	}
+	if viewer.ExitTime == nil {
+		t.Fatal("exit time not recorded")
+	}
+	assertWatchDuration(t, viewer, 300)
+
+	// 重复离开不重复结算
+	if err := s.LeaveLiveRoom(context.Background(), 1, 20); err != nil {
+		t.Fatalf("second LeaveLiveRoom: %v", err)
+	}
+	assertWatchDuration(t, viewer, 300)
+}
+
+func TestHeartbeatThenLeaveCountsSessionOnce(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, _ := newPresenceTestService(repo)
+	viewer := newWatchRecord(repo, 1, 20, 3*time.Minute)
+
+	if err := s.Heartbeat(context.Background(), 1, 20); err != nil {
+		t.Fatalf("Heartbeat: %v", err)
+	}
+	if err := s.LeaveLiveRoom(context.Background(), 1, 20); err != nil {
+		t.Fatalf("LeaveLiveRoom: %v", err)
+	}
+	// 离开时只结算心跳之后的部分，已累计的时长不会从进入时间重新计算
+	assertWatchDuration(t, viewer, 180)
+
+	// 离开后的心跳不再累计
+	if err := s.Heartbeat(context.Background(), 1, 20); !errors.Is(err, ErrViewerNotInRoom) {
+		t.Errorf("Heartbeat after leave error = %v, want ErrViewerNotInRoom", err)
+	}
+	assertWatchDuration(t, viewer, 180)
+}
+
+func TestSweepSettlesExpiredViewerWatchDuration(t *testing.T) {
+	repo := newFakeLiveRepo()
+	s, _ := newPresenceTestService(repo)
+	viewer := newWatchRecord(repo, 1, 20, 2*time.Minute)
+	repo.presence[1][20] = time.Now().Add(-time.Second)
+
+	if err := s.SweepExpiredViewers(context.Background()); err != nil {
+		t.Fatalf("SweepExpiredViewers: %v", err)
+	}
+	if viewer.ExitTime == nil {
+		t.Fatal("expired viewer exit time not recorded")
+	}
+	assertWatchDuration(t, viewer, 120)
+}
@@ -19,23 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	LiveService_StartLive_FullMethodName         = "/livepb.LiveService/StartLive"
-	LiveService_StopLive_FullMethodName          = "/livepb.LiveService/StopLive"
-	LiveService_GetLiveStream_FullMethodName     = "/livepb.LiveService/GetLiveStream"
-	LiveService_GetLiveList_FullMethodName       = "/livepb.LiveService/GetLiveList"
-	LiveService_GetHotLiveList_FullMethodName    = "/livepb.LiveService/GetHotLiveList"
-	LiveService_JoinLiveRoom_FullMethodName      = "/livepb.LiveService/JoinLiveRoom"
-	LiveService_LeaveLiveRoom_FullMethodName     = "/livepb.LiveService/LeaveLiveRoom"
-	LiveService_GetLiveViewerList_FullMethodName = "/livepb.LiveService/GetLiveViewerList"
-	LiveService_SendLiveChat_FullMethodName      = "/livepb.LiveService/SendLiveChat"
-	LiveService_GetLiveChatList_FullMethodName   = "/livepb.LiveService/GetLiveChatList"
-	LiveService_SendLiveGift_FullMethodName      = "/livepb.LiveService/SendLiveGift"
-	LiveService_GetLiveGiftList_FullMethodName   = "/livepb.LiveService/GetLiveGiftList"
-	LiveService_LikeLive_FullMethodName          = "/livepb.LiveService/LikeLive"
-	LiveService_SearchLive_FullMethodName        = "/livepb.LiveService/SearchLive"
-	LiveService_GetLiveCategories_FullMethodName = "/livepb.LiveService/GetLiveCategories"
-	LiveService_GetLiveStats_FullMethodName      = "/livepb.LiveService/GetLiveStats"
-	LiveService_GetLivePlayback_FullMethodName   = "/livepb.LiveService/GetLivePlayback"
+	LiveService_StartLive_FullMethodName               = "/livepb.LiveService/StartLive"
+	LiveService_StopLive_FullMethodName                = "/livepb.LiveService/StopLive"
+	LiveService_GetLiveStream_FullMethodName           = "/livepb.LiveService/GetLiveStream"
+	LiveService_GetLiveList_FullMethodName             = "/livepb.LiveService/GetLiveList"
+	LiveService_GetHotLiveList_FullMethodName          = "/livepb.LiveService/GetHotLiveList"
+	LiveService_UpdateLiveStreamPrivacy_FullMethodName = "/livepb.LiveService/UpdateLiveStreamPrivacy"
+	LiveService_AuthenticateStreamKey_FullMethodName   = "/livepb.LiveService/AuthenticateStreamKey"
+	LiveService_OnStreamEnded_FullMethodName           = "/livepb.LiveService/OnStreamEnded"
+	LiveService_JoinLiveRoom_FullMethodName            = "/livepb.LiveService/JoinLiveRoom"
+	LiveService_LeaveLiveRoom_FullMethodName           = "/livepb.LiveService/LeaveLiveRoom"
+	LiveService_Heartbeat_FullMethodName               = "/livepb.LiveService/Heartbeat"
+	LiveService_GetLiveViewerList_FullMethodName       = "/livepb.LiveService/GetLiveViewerList"
+	LiveService_SubscribeLiveEvents_FullMethodName     = "/livepb.LiveService/SubscribeLiveEvents"
+	LiveService_SendLiveChat_FullMethodName            = "/livepb.LiveService/SendLiveChat"
+	LiveService_GetLiveChatList_FullMethodName         = "/livepb.LiveService/GetLiveChatList"
+	LiveService_MuteViewer_FullMethodName              = "/livepb.LiveService/MuteViewer"
+	LiveService_UnmuteViewer_FullMethodName            = "/livepb.LiveService/UnmuteViewer"
+	LiveService_SendLiveGift_FullMethodName            = "/livepb.LiveService/SendLiveGift"
+	LiveService_GetLiveGiftList_FullMethodName         = "/livepb.LiveService/GetLiveGiftList"
+	LiveService_GetUserLiveGiftList_FullMethodName     = "/livepb.LiveService/GetUserLiveGiftList"
+	LiveService_GetLiveGiftStats_FullMethodName        = "/livepb.LiveService/GetLiveGiftStats"
+	LiveService_LikeLive_FullMethodName                = "/livepb.LiveService/LikeLive"
+	LiveService_SearchLive_FullMethodName              = "/livepb.LiveService/SearchLive"
+	LiveService_GetLiveCategories_FullMethodName       = "/livepb.LiveService/GetLiveCategories"
+	LiveService_GetLiveStats_FullMethodName            = "/livepb.LiveService/GetLiveStats"
+	LiveService_GetLivePlayback_FullMethodName         = "/livepb.LiveService/GetLivePlayback"
+	LiveService_GetDailyLeaderboards_FullMethodName    = "/livepb.LiveService/GetDailyLeaderboards"
+	LiveService_RecomputeLiveStats_FullMethodName      = "/livepb.LiveService/RecomputeLiveStats"
+	LiveService_ForceStopLive_FullMethodName           = "/livepb.LiveService/ForceStopLive"
+	LiveService_StreamViewerCount_FullMethodName       = "/livepb.LiveService/StreamViewerCount"
+	LiveService_PauseLive_FullMethodName               = "/livepb.LiveService/PauseLive"
+	LiveService_ResumeLive_FullMethodName              = "/livepb.LiveService/ResumeLive"
+	LiveService_GetLiveRoom_FullMethodName             = "/livepb.LiveService/GetLiveRoom"
+	LiveService_RefundLiveGift_FullMethodName          = "/livepb.LiveService/RefundLiveGift"
+	LiveService_GetUserLiveStream_FullMethodName       = "/livepb.LiveService/GetUserLiveStream"
 )
 
 // LiveServiceClient is the client API for LiveService service.
@@ -48,16 +66,27 @@ type LiveServiceClient interface {
 	GetLiveStream(ctx context.Context, in *GetLiveStreamRequest, opts ...grpc.CallOption) (*GetLiveStreamResponse, error)
 	GetLiveList(ctx context.Context, in *GetLiveListRequest, opts ...grpc.CallOption) (*GetLiveListResponse, error)
 	GetHotLiveList(ctx context.Context, in *GetHotLiveListRequest, opts ...grpc.CallOption) (*GetHotLiveListResponse, error)
+	UpdateLiveStreamPrivacy(ctx context.Context, in *UpdateLiveStreamPrivacyRequest, opts ...grpc.CallOption) (*UpdateLiveStreamPrivacyResponse, error)
+	// 推流回调(由媒体服务器调用)
+	AuthenticateStreamKey(ctx context.Context, in *AuthenticateStreamKeyRequest, opts ...grpc.CallOption) (*AuthenticateStreamKeyResponse, error)
+	OnStreamEnded(ctx context.Context, in *OnStreamEndedRequest, opts ...grpc.CallOption) (*OnStreamEndedResponse, error)
 	// 直播间管理
 	JoinLiveRoom(ctx context.Context, in *JoinLiveRoomRequest, opts ...grpc.CallOption) (*JoinLiveRoomResponse, error)
 	LeaveLiveRoom(ctx context.Context, in *LeaveLiveRoomRequest, opts ...grpc.CallOption) (*LeaveLiveRoomResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	GetLiveViewerList(ctx context.Context, in *GetLiveViewerListRequest, opts ...grpc.CallOption) (*GetLiveViewerListResponse, error)
+	// 实时事件
+	SubscribeLiveEvents(ctx context.Context, in *SubscribeLiveEventsRequest, opts ...grpc.CallOption) (LiveService_SubscribeLiveEventsClient, error)
 	// 聊天消息
 	SendLiveChat(ctx context.Context, in *SendLiveChatRequest, opts ...grpc.CallOption) (*SendLiveChatResponse, error)
 	GetLiveChatList(ctx context.Context, in *GetLiveChatListRequest, opts ...grpc.CallOption) (*GetLiveChatListResponse, error)
+	MuteViewer(ctx context.Context, in *MuteViewerRequest, opts ...grpc.CallOption) (*MuteViewerResponse, error)
+	UnmuteViewer(ctx context.Context, in *UnmuteViewerRequest, opts ...grpc.CallOption) (*UnmuteViewerResponse, error)
 	// 礼物系统
 	SendLiveGift(ctx context.Context, in *SendLiveGiftRequest, opts ...grpc.CallOption) (*SendLiveGiftResponse, error)
 	GetLiveGiftList(ctx context.Context, in *GetLiveGiftListRequest, opts ...grpc.CallOption) (*GetLiveGiftListResponse, error)
+	GetUserLiveGiftList(ctx context.Context, in *GetUserLiveGiftListRequest, opts ...grpc.CallOption) (*GetUserLiveGiftListResponse, error)
+	GetLiveGiftStats(ctx context.Context, in *GetLiveGiftStatsRequest, opts ...grpc.CallOption) (*GetLiveGiftStatsResponse, error)
 	// 互动功能
 	LikeLive(ctx context.Context, in *LikeLiveRequest, opts ...grpc.CallOption) (*LikeLiveResponse, error)
 	// 搜索和推荐
@@ -66,6 +95,18 @@ type LiveServiceClient interface {
 	// 统计和分析
 	GetLiveStats(ctx context.Context, in *GetLiveStatsRequest, opts ...grpc.CallOption) (*GetLiveStatsResponse, error)
 	GetLivePlayback(ctx context.Context, in *GetLivePlaybackRequest, opts ...grpc.CallOption) (*GetLivePlaybackResponse, error)
+	GetDailyLeaderboards(ctx context.Context, in *GetDailyLeaderboardsRequest, opts ...grpc.CallOption) (*GetDailyLeaderboardsResponse, error)
+	RecomputeLiveStats(ctx context.Context, in *RecomputeLiveStatsRequest, opts ...grpc.CallOption) (*RecomputeLiveStatsResponse, error)
+	ForceStopLive(ctx context.Context, in *ForceStopLiveRequest, opts ...grpc.CallOption) (*ForceStopLiveResponse, error)
+	StreamViewerCount(ctx context.Context, in *StreamViewerCountRequest, opts ...grpc.CallOption) (LiveService_StreamViewerCountClient, error)
+	PauseLive(ctx context.Context, in *PauseLiveRequest, opts ...grpc.CallOption) (*PauseLiveResponse, error)
+	ResumeLive(ctx context.Context, in *ResumeLiveRequest, opts ...grpc.CallOption) (*ResumeLiveResponse, error)
+	// 获取主播的直播间及跨场次累计统计
+	GetLiveRoom(ctx context.Context, in *GetLiveRoomRequest, opts ...grpc.CallOption) (*GetLiveRoomResponse, error)
+	// 管理员退还礼物，冲正礼物统计，重复退款直接返回已退款的记录
+	RefundLiveGift(ctx context.Context, in *RefundLiveGiftRequest, opts ...grpc.CallOption) (*RefundLiveGiftResponse, error)
+	// 获取用户当前未结束的直播
+	GetUserLiveStream(ctx context.Context, in *GetUserLiveStreamRequest, opts ...grpc.CallOption) (*GetUserLiveStreamResponse, error)
 }
 
 type liveServiceClient struct {
@@ -121,6 +162,33 @@ func (c *liveServiceClient) GetHotLiveList(ctx context.Context, in *GetHotLiveLi
 	return out, nil
 }
 
+func (c *liveServiceClient) UpdateLiveStreamPrivacy(ctx context.Context, in *UpdateLiveStreamPrivacyRequest, opts ...grpc.CallOption) (*UpdateLiveStreamPrivacyResponse, error) {
+	out := new(UpdateLiveStreamPrivacyResponse)
+	err := c.cc.Invoke(ctx, LiveService_UpdateLiveStreamPrivacy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) AuthenticateStreamKey(ctx context.Context, in *AuthenticateStreamKeyRequest, opts ...grpc.CallOption) (*AuthenticateStreamKeyResponse, error) {
+	out := new(AuthenticateStreamKeyResponse)
+	err := c.cc.Invoke(ctx, LiveService_AuthenticateStreamKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) OnStreamEnded(ctx context.Context, in *OnStreamEndedRequest, opts ...grpc.CallOption) (*OnStreamEndedResponse, error) {
+	out := new(OnStreamEndedResponse)
+	err := c.cc.Invoke(ctx, LiveService_OnStreamEnded_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *liveServiceClient) JoinLiveRoom(ctx context.Context, in *JoinLiveRoomRequest, opts ...grpc.CallOption) (*JoinLiveRoomResponse, error) {
 	out := new(JoinLiveRoomResponse)
 	err := c.cc.Invoke(ctx, LiveService_JoinLiveRoom_FullMethodName, in, out, opts...)
@@ -139,6 +207,15 @@ func (c *liveServiceClient) LeaveLiveRoom(ctx context.Context, in *LeaveLiveRoom
 	return out, nil
 }
 
+func (c *liveServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, LiveService_Heartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *liveServiceClient) GetLiveViewerList(ctx context.Context, in *GetLiveViewerListRequest, opts ...grpc.CallOption) (*GetLiveViewerListResponse, error) {
 	out := new(GetLiveViewerListResponse)
 	err := c.cc.Invoke(ctx, LiveService_GetLiveViewerList_FullMethodName, in, out, opts...)
@@ -148,6 +225,38 @@ func (c *liveServiceClient) GetLiveViewerList(ctx context.Context, in *GetLiveVi
 	return out, nil
 }
 
+func (c *liveServiceClient) SubscribeLiveEvents(ctx context.Context, in *SubscribeLiveEventsRequest, opts ...grpc.CallOption) (LiveService_SubscribeLiveEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LiveService_ServiceDesc.Streams[0], LiveService_SubscribeLiveEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &liveServiceSubscribeLiveEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LiveService_SubscribeLiveEventsClient interface {
+	Recv() (*LiveEvent, error)
+	grpc.ClientStream
+}
+
+type liveServiceSubscribeLiveEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *liveServiceSubscribeLiveEventsClient) Recv() (*LiveEvent, error) {
+	m := new(LiveEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *liveServiceClient) SendLiveChat(ctx context.Context, in *SendLiveChatRequest, opts ...grpc.CallOption) (*SendLiveChatResponse, error) {
 	out := new(SendLiveChatResponse)
 	err := c.cc.Invoke(ctx, LiveService_SendLiveChat_FullMethodName, in, out, opts...)
@@ -166,6 +275,24 @@ func (c *liveServiceClient) GetLiveChatList(ctx context.Context, in *GetLiveChat
 	return out, nil
 }
 
+func (c *liveServiceClient) MuteViewer(ctx context.Context, in *MuteViewerRequest, opts ...grpc.CallOption) (*MuteViewerResponse, error) {
+	out := new(MuteViewerResponse)
+	err := c.cc.Invoke(ctx, LiveService_MuteViewer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) UnmuteViewer(ctx context.Context, in *UnmuteViewerRequest, opts ...grpc.CallOption) (*UnmuteViewerResponse, error) {
+	out := new(UnmuteViewerResponse)
+	err := c.cc.Invoke(ctx, LiveService_UnmuteViewer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *liveServiceClient) SendLiveGift(ctx context.Context, in *SendLiveGiftRequest, opts ...grpc.CallOption) (*SendLiveGiftResponse, error) {
 	out := new(SendLiveGiftResponse)
 	err := c.cc.Invoke(ctx, LiveService_SendLiveGift_FullMethodName, in, out, opts...)
@@ -184,6 +311,24 @@ func (c *liveServiceClient) GetLiveGiftList(ctx context.Context, in *GetLiveGift
 	return out, nil
 }
 
+func (c *liveServiceClient) GetUserLiveGiftList(ctx context.Context, in *GetUserLiveGiftListRequest, opts ...grpc.CallOption) (*GetUserLiveGiftListResponse, error) {
+	out := new(GetUserLiveGiftListResponse)
+	err := c.cc.Invoke(ctx, LiveService_GetUserLiveGiftList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) GetLiveGiftStats(ctx context.Context, in *GetLiveGiftStatsRequest, opts ...grpc.CallOption) (*GetLiveGiftStatsResponse, error) {
+	out := new(GetLiveGiftStatsResponse)
+	err := c.cc.Invoke(ctx, LiveService_GetLiveGiftStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *liveServiceClient) LikeLive(ctx context.Context, in *LikeLiveRequest, opts ...grpc.CallOption) (*LikeLiveResponse, error) {
 	out := new(LikeLiveResponse)
 	err := c.cc.Invoke(ctx, LiveService_LikeLive_FullMethodName, in, out, opts...)
@@ -229,6 +374,110 @@ func (c *liveServiceClient) GetLivePlayback(ctx context.Context, in *GetLivePlay
 	return out, nil
 }
 
+func (c *liveServiceClient) GetDailyLeaderboards(ctx context.Context, in *GetDailyLeaderboardsRequest, opts ...grpc.CallOption) (*GetDailyLeaderboardsResponse, error) {
+	out := new(GetDailyLeaderboardsResponse)
+	err := c.cc.Invoke(ctx, LiveService_GetDailyLeaderboards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) RecomputeLiveStats(ctx context.Context, in *RecomputeLiveStatsRequest, opts ...grpc.CallOption) (*RecomputeLiveStatsResponse, error) {
+	out := new(RecomputeLiveStatsResponse)
+	err := c.cc.Invoke(ctx, LiveService_RecomputeLiveStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) ForceStopLive(ctx context.Context, in *ForceStopLiveRequest, opts ...grpc.CallOption) (*ForceStopLiveResponse, error) {
+	out := new(ForceStopLiveResponse)
+	err := c.cc.Invoke(ctx, LiveService_ForceStopLive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) StreamViewerCount(ctx context.Context, in *StreamViewerCountRequest, opts ...grpc.CallOption) (LiveService_StreamViewerCountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LiveService_ServiceDesc.Streams[1], LiveService_StreamViewerCount_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &liveServiceStreamViewerCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LiveService_StreamViewerCountClient interface {
+	Recv() (*ViewerCountUpdate, error)
+	grpc.ClientStream
+}
+
+type liveServiceStreamViewerCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *liveServiceStreamViewerCountClient) Recv() (*ViewerCountUpdate, error) {
+	m := new(ViewerCountUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *liveServiceClient) PauseLive(ctx context.Context, in *PauseLiveRequest, opts ...grpc.CallOption) (*PauseLiveResponse, error) {
+	out := new(PauseLiveResponse)
+	err := c.cc.Invoke(ctx, LiveService_PauseLive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) ResumeLive(ctx context.Context, in *ResumeLiveRequest, opts ...grpc.CallOption) (*ResumeLiveResponse, error) {
+	out := new(ResumeLiveResponse)
+	err := c.cc.Invoke(ctx, LiveService_ResumeLive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) GetLiveRoom(ctx context.Context, in *GetLiveRoomRequest, opts ...grpc.CallOption) (*GetLiveRoomResponse, error) {
+	out := new(GetLiveRoomResponse)
+	err := c.cc.Invoke(ctx, LiveService_GetLiveRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) RefundLiveGift(ctx context.Context, in *RefundLiveGiftRequest, opts ...grpc.CallOption) (*RefundLiveGiftResponse, error) {
+	out := new(RefundLiveGiftResponse)
+	err := c.cc.Invoke(ctx, LiveService_RefundLiveGift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveServiceClient) GetUserLiveStream(ctx context.Context, in *GetUserLiveStreamRequest, opts ...grpc.CallOption) (*GetUserLiveStreamResponse, error) {
+	out := new(GetUserLiveStreamResponse)
+	err := c.cc.Invoke(ctx, LiveService_GetUserLiveStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LiveServiceServer is the server API for LiveService service.
 // All implementations must embed UnimplementedLiveServiceServer
 // for forward compatibility
@@ -239,16 +488,27 @@ type LiveServiceServer interface {
 	GetLiveStream(context.Context, *GetLiveStreamRequest) (*GetLiveStreamResponse, error)
 	GetLiveList(context.Context, *GetLiveListRequest) (*GetLiveListResponse, error)
 	GetHotLiveList(context.Context, *GetHotLiveListRequest) (*GetHotLiveListResponse, error)
+	UpdateLiveStreamPrivacy(context.Context, *UpdateLiveStreamPrivacyRequest) (*UpdateLiveStreamPrivacyResponse, error)
+	// 推流回调(由媒体服务器调用)
+	AuthenticateStreamKey(context.Context, *AuthenticateStreamKeyRequest) (*AuthenticateStreamKeyResponse, error)
+	OnStreamEnded(context.Context, *OnStreamEndedRequest) (*OnStreamEndedResponse, error)
 	// 直播间管理
 	JoinLiveRoom(context.Context, *JoinLiveRoomRequest) (*JoinLiveRoomResponse, error)
 	LeaveLiveRoom(context.Context, *LeaveLiveRoomRequest) (*LeaveLiveRoomResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	GetLiveViewerList(context.Context, *GetLiveViewerListRequest) (*GetLiveViewerListResponse, error)
+	// 实时事件
+	SubscribeLiveEvents(*SubscribeLiveEventsRequest, LiveService_SubscribeLiveEventsServer) error
 	// 聊天消息
 	SendLiveChat(context.Context, *SendLiveChatRequest) (*SendLiveChatResponse, error)
 	GetLiveChatList(context.Context, *GetLiveChatListRequest) (*GetLiveChatListResponse, error)
+	MuteViewer(context.Context, *MuteViewerRequest) (*MuteViewerResponse, error)
+	UnmuteViewer(context.Context, *UnmuteViewerRequest) (*UnmuteViewerResponse, error)
 	// 礼物系统
 	SendLiveGift(context.Context, *SendLiveGiftRequest) (*SendLiveGiftResponse, error)
 	GetLiveGiftList(context.Context, *GetLiveGiftListRequest) (*GetLiveGiftListResponse, error)
+	GetUserLiveGiftList(context.Context, *GetUserLiveGiftListRequest) (*GetUserLiveGiftListResponse, error)
+	GetLiveGiftStats(context.Context, *GetLiveGiftStatsRequest) (*GetLiveGiftStatsResponse, error)
 	// 互动功能
 	LikeLive(context.Context, *LikeLiveRequest) (*LikeLiveResponse, error)
 	// 搜索和推荐
@@ -257,6 +517,18 @@ type LiveServiceServer interface {
 	// 统计和分析
 	GetLiveStats(context.Context, *GetLiveStatsRequest) (*GetLiveStatsResponse, error)
 	GetLivePlayback(context.Context, *GetLivePlaybackRequest) (*GetLivePlaybackResponse, error)
+	GetDailyLeaderboards(context.Context, *GetDailyLeaderboardsRequest) (*GetDailyLeaderboardsResponse, error)
+	RecomputeLiveStats(context.Context, *RecomputeLiveStatsRequest) (*RecomputeLiveStatsResponse, error)
+	ForceStopLive(context.Context, *ForceStopLiveRequest) (*ForceStopLiveResponse, error)
+	StreamViewerCount(*StreamViewerCountRequest, LiveService_StreamViewerCountServer) error
+	PauseLive(context.Context, *PauseLiveRequest) (*PauseLiveResponse, error)
+	ResumeLive(context.Context, *ResumeLiveRequest) (*ResumeLiveResponse, error)
+	// 获取主播的直播间及跨场次累计统计
+	GetLiveRoom(context.Context, *GetLiveRoomRequest) (*GetLiveRoomResponse, error)
+	// 管理员退还礼物，冲正礼物统计，重复退款直接返回已退款的记录
+	RefundLiveGift(context.Context, *RefundLiveGiftRequest) (*RefundLiveGiftResponse, error)
+	// 获取用户当前未结束的直播
+	GetUserLiveStream(context.Context, *GetUserLiveStreamRequest) (*GetUserLiveStreamResponse, error)
 	mustEmbedUnimplementedLiveServiceServer()
 }
 
@@ -279,27 +551,54 @@ func (UnimplementedLiveServiceServer) GetLiveList(context.Context, *GetLiveListR
 func (UnimplementedLiveServiceServer) GetHotLiveList(context.Context, *GetHotLiveListRequest) (*GetHotLiveListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHotLiveList not implemented")
 }
+func (UnimplementedLiveServiceServer) UpdateLiveStreamPrivacy(context.Context, *UpdateLiveStreamPrivacyRequest) (*UpdateLiveStreamPrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiveStreamPrivacy not implemented")
+}
+func (UnimplementedLiveServiceServer) AuthenticateStreamKey(context.Context, *AuthenticateStreamKeyRequest) (*AuthenticateStreamKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateStreamKey not implemented")
+}
+func (UnimplementedLiveServiceServer) OnStreamEnded(context.Context, *OnStreamEndedRequest) (*OnStreamEndedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnStreamEnded not implemented")
+}
 func (UnimplementedLiveServiceServer) JoinLiveRoom(context.Context, *JoinLiveRoomRequest) (*JoinLiveRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinLiveRoom not implemented")
 }
 func (UnimplementedLiveServiceServer) LeaveLiveRoom(context.Context, *LeaveLiveRoomRequest) (*LeaveLiveRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveLiveRoom not implemented")
 }
+func (UnimplementedLiveServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
 func (UnimplementedLiveServiceServer) GetLiveViewerList(context.Context, *GetLiveViewerListRequest) (*GetLiveViewerListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLiveViewerList not implemented")
 }
+func (UnimplementedLiveServiceServer) SubscribeLiveEvents(*SubscribeLiveEventsRequest, LiveService_SubscribeLiveEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeLiveEvents not implemented")
+}
 func (UnimplementedLiveServiceServer) SendLiveChat(context.Context, *SendLiveChatRequest) (*SendLiveChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendLiveChat not implemented")
 }
 func (UnimplementedLiveServiceServer) GetLiveChatList(context.Context, *GetLiveChatListRequest) (*GetLiveChatListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLiveChatList not implemented")
 }
+func (UnimplementedLiveServiceServer) MuteViewer(context.Context, *MuteViewerRequest) (*MuteViewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteViewer not implemented")
+}
+func (UnimplementedLiveServiceServer) UnmuteViewer(context.Context, *UnmuteViewerRequest) (*UnmuteViewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteViewer not implemented")
+}
 func (UnimplementedLiveServiceServer) SendLiveGift(context.Context, *SendLiveGiftRequest) (*SendLiveGiftResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendLiveGift not implemented")
 }
 func (UnimplementedLiveServiceServer) GetLiveGiftList(context.Context, *GetLiveGiftListRequest) (*GetLiveGiftListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLiveGiftList not implemented")
 }
+func (UnimplementedLiveServiceServer) GetUserLiveGiftList(context.Context, *GetUserLiveGiftListRequest) (*GetUserLiveGiftListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLiveGiftList not implemented")
+}
+func (UnimplementedLiveServiceServer) GetLiveGiftStats(context.Context, *GetLiveGiftStatsRequest) (*GetLiveGiftStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveGiftStats not implemented")
+}
 func (UnimplementedLiveServiceServer) LikeLive(context.Context, *LikeLiveRequest) (*LikeLiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LikeLive not implemented")
 }
@@ -315,6 +614,33 @@ func (UnimplementedLiveServiceServer) GetLiveStats(context.Context, *GetLiveStat
 func (UnimplementedLiveServiceServer) GetLivePlayback(context.Context, *GetLivePlaybackRequest) (*GetLivePlaybackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLivePlayback not implemented")
 }
+func (UnimplementedLiveServiceServer) GetDailyLeaderboards(context.Context, *GetDailyLeaderboardsRequest) (*GetDailyLeaderboardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyLeaderboards not implemented")
+}
+func (UnimplementedLiveServiceServer) RecomputeLiveStats(context.Context, *RecomputeLiveStatsRequest) (*RecomputeLiveStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeLiveStats not implemented")
+}
+func (UnimplementedLiveServiceServer) ForceStopLive(context.Context, *ForceStopLiveRequest) (*ForceStopLiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceStopLive not implemented")
+}
+func (UnimplementedLiveServiceServer) StreamViewerCount(*StreamViewerCountRequest, LiveService_StreamViewerCountServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamViewerCount not implemented")
+}
+func (UnimplementedLiveServiceServer) PauseLive(context.Context, *PauseLiveRequest) (*PauseLiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseLive not implemented")
+}
+func (UnimplementedLiveServiceServer) ResumeLive(context.Context, *ResumeLiveRequest) (*ResumeLiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeLive not implemented")
+}
+func (UnimplementedLiveServiceServer) GetLiveRoom(context.Context, *GetLiveRoomRequest) (*GetLiveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveRoom not implemented")
+}
+func (UnimplementedLiveServiceServer) RefundLiveGift(context.Context, *RefundLiveGiftRequest) (*RefundLiveGiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundLiveGift not implemented")
+}
+func (UnimplementedLiveServiceServer) GetUserLiveStream(context.Context, *GetUserLiveStreamRequest) (*GetUserLiveStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLiveStream not implemented")
+}
 func (UnimplementedLiveServiceServer) mustEmbedUnimplementedLiveServiceServer() {}
 
 // UnsafeLiveServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -418,6 +744,60 @@ func _LiveService_GetHotLiveList_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiveService_UpdateLiveStreamPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLiveStreamPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).UpdateLiveStreamPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_UpdateLiveStreamPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).UpdateLiveStreamPrivacy(ctx, req.(*UpdateLiveStreamPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_AuthenticateStreamKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateStreamKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).AuthenticateStreamKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_AuthenticateStreamKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).AuthenticateStreamKey(ctx, req.(*AuthenticateStreamKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_OnStreamEnded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnStreamEndedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).OnStreamEnded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_OnStreamEnded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).OnStreamEnded(ctx, req.(*OnStreamEndedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LiveService_JoinLiveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinLiveRoomRequest)
 	if err := dec(in); err != nil {
@@ -454,6 +834,24 @@ func _LiveService_LeaveLiveRoom_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiveService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LiveService_GetLiveViewerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLiveViewerListRequest)
 	if err := dec(in); err != nil {
@@ -472,6 +870,27 @@ func _LiveService_GetLiveViewerList_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiveService_SubscribeLiveEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeLiveEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveServiceServer).SubscribeLiveEvents(m, &liveServiceSubscribeLiveEventsServer{stream})
+}
+
+type LiveService_SubscribeLiveEventsServer interface {
+	Send(*LiveEvent) error
+	grpc.ServerStream
+}
+
+type liveServiceSubscribeLiveEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *liveServiceSubscribeLiveEventsServer) Send(m *LiveEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _LiveService_SendLiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendLiveChatRequest)
 	if err := dec(in); err != nil {
@@ -508,6 +927,42 @@ func _LiveService_GetLiveChatList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiveService_MuteViewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteViewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).MuteViewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_MuteViewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).MuteViewer(ctx, req.(*MuteViewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_UnmuteViewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteViewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).UnmuteViewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_UnmuteViewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).UnmuteViewer(ctx, req.(*UnmuteViewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LiveService_SendLiveGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendLiveGiftRequest)
 	if err := dec(in); err != nil {
@@ -544,6 +999,42 @@ func _LiveService_GetLiveGiftList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiveService_GetUserLiveGiftList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLiveGiftListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).GetUserLiveGiftList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_GetUserLiveGiftList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).GetUserLiveGiftList(ctx, req.(*GetUserLiveGiftListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_GetLiveGiftStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiveGiftStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).GetLiveGiftStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_GetLiveGiftStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).GetLiveGiftStats(ctx, req.(*GetLiveGiftStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LiveService_LikeLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikeLiveRequest)
 	if err := dec(in); err != nil {
@@ -634,6 +1125,171 @@ func _LiveService_GetLivePlayback_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LiveService_GetDailyLeaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyLeaderboardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).GetDailyLeaderboards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_GetDailyLeaderboards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).GetDailyLeaderboards(ctx, req.(*GetDailyLeaderboardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_RecomputeLiveStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeLiveStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).RecomputeLiveStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_RecomputeLiveStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).RecomputeLiveStats(ctx, req.(*RecomputeLiveStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_ForceStopLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceStopLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).ForceStopLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_ForceStopLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).ForceStopLive(ctx, req.(*ForceStopLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_StreamViewerCount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamViewerCountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveServiceServer).StreamViewerCount(m, &liveServiceStreamViewerCountServer{stream})
+}
+
+type LiveService_StreamViewerCountServer interface {
+	Send(*ViewerCountUpdate) error
+	grpc.ServerStream
+}
+
+type liveServiceStreamViewerCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *liveServiceStreamViewerCountServer) Send(m *ViewerCountUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LiveService_PauseLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).PauseLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_PauseLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).PauseLive(ctx, req.(*PauseLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_ResumeLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).ResumeLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_ResumeLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).ResumeLive(ctx, req.(*ResumeLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_GetLiveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).GetLiveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_GetLiveRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).GetLiveRoom(ctx, req.(*GetLiveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_RefundLiveGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundLiveGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).RefundLiveGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_RefundLiveGift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).RefundLiveGift(ctx, req.(*RefundLiveGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveService_GetUserLiveStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLiveStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveServiceServer).GetUserLiveStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveService_GetUserLiveStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveServiceServer).GetUserLiveStream(ctx, req.(*GetUserLiveStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LiveService_ServiceDesc is the grpc.ServiceDesc for LiveService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -661,6 +1317,18 @@ var LiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHotLiveList",
 			Handler:    _LiveService_GetHotLiveList_Handler,
 		},
+		{
+			MethodName: "UpdateLiveStreamPrivacy",
+			Handler:    _LiveService_UpdateLiveStreamPrivacy_Handler,
+		},
+		{
+			MethodName: "AuthenticateStreamKey",
+			Handler:    _LiveService_AuthenticateStreamKey_Handler,
+		},
+		{
+			MethodName: "OnStreamEnded",
+			Handler:    _LiveService_OnStreamEnded_Handler,
+		},
 		{
 			MethodName: "JoinLiveRoom",
 			Handler:    _LiveService_JoinLiveRoom_Handler,
@@ -669,6 +1337,10 @@ var LiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveLiveRoom",
 			Handler:    _LiveService_LeaveLiveRoom_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _LiveService_Heartbeat_Handler,
+		},
 		{
 			MethodName: "GetLiveViewerList",
 			Handler:    _LiveService_GetLiveViewerList_Handler,
@@ -681,6 +1353,14 @@ var LiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLiveChatList",
 			Handler:    _LiveService_GetLiveChatList_Handler,
 		},
+		{
+			MethodName: "MuteViewer",
+			Handler:    _LiveService_MuteViewer_Handler,
+		},
+		{
+			MethodName: "UnmuteViewer",
+			Handler:    _LiveService_UnmuteViewer_Handler,
+		},
 		{
 			MethodName: "SendLiveGift",
 			Handler:    _LiveService_SendLiveGift_Handler,
@@ -689,6 +1369,14 @@ var LiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLiveGiftList",
 			Handler:    _LiveService_GetLiveGiftList_Handler,
 		},
+		{
+			MethodName: "GetUserLiveGiftList",
+			Handler:    _LiveService_GetUserLiveGiftList_Handler,
+		},
+		{
+			MethodName: "GetLiveGiftStats",
+			Handler:    _LiveService_GetLiveGiftStats_Handler,
+		},
 		{
 			MethodName: "LikeLive",
 			Handler:    _LiveService_LikeLive_Handler,
@@ -709,7 +1397,50 @@ var LiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLivePlayback",
 			Handler:    _LiveService_GetLivePlayback_Handler,
 		},
+		{
+			MethodName: "GetDailyLeaderboards",
+			Handler:    _LiveService_GetDailyLeaderboards_Handler,
+		},
+		{
+			MethodName: "RecomputeLiveStats",
+			Handler:    _LiveService_RecomputeLiveStats_Handler,
+		},
+		{
+			MethodName: "ForceStopLive",
+			Handler:    _LiveService_ForceStopLive_Handler,
+		},
+		{
+			MethodName: "PauseLive",
+			Handler:    _LiveService_PauseLive_Handler,
+		},
+		{
+			MethodName: "ResumeLive",
+			Handler:    _LiveService_ResumeLive_Handler,
+		},
+		{
+			MethodName: "GetLiveRoom",
+			Handler:    _LiveService_GetLiveRoom_Handler,
+		},
+		{
+			MethodName: "RefundLiveGift",
+			Handler:    _LiveService_RefundLiveGift_Handler,
+		},
+		{
+			MethodName: "GetUserLiveStream",
+			Handler:    _LiveService_GetUserLiveStream_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLiveEvents",
+			Handler:       _LiveService_SubscribeLiveEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamViewerCount",
+			Handler:       _LiveService_StreamViewerCount_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/live.proto",
 }
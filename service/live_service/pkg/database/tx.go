@@ -0,0 +1,17 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"common/ctxkeys"
+)
+
+// Conn 返回context绑定的事务，未绑定时返回db，结果均已关联ctx
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctxkeys.Tx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
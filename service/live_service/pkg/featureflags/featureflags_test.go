@@ -0,0 +1,146 @@
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// mapSource 返回预置覆盖值的数据源，err不为nil时加载失败
+type mapSource struct {
+	values map[string]string
+	err    error
+}
+
+func (s *mapSource) Load(ctx context.Context) (map[string]string, error) {
+	return s.values, s.err
+}
+
+func TestDefaultsApplyWithoutOverrides(t *testing.T) {
+	f := New(nil, map[string]string{
+		"Live.Chat.Enabled": "true",
+		"live.chat.limit":   "20",
+		"live.chat.window":  "5s",
+		"live.chat.ratio":   "0.5",
+		"live.chat.mode":    "strict",
+	}, 0, nopLogger{})
+
+	// 配置键经viper读取后为小写，查询时大小写不敏感
+	if !f.Bool("live.chat.enabled", false) || !f.Bool("LIVE.CHAT.ENABLED", false) {
+		t.Error("Bool should use the config default regardless of case")
+	}
+	if got := f.Int("live.chat.limit", 0); got != 20 {
+		t.Errorf("Int = %d, want 20", got)
+	}
+	if got := f.Duration("live.chat.window", 0); got != 5*time.Second {
+		t.Errorf("Duration = %v, want 5s", got)
+	}
+	if got := f.Float("live.chat.ratio", 0); got != 0.5 {
+		t.Errorf("Float = %v, want 0.5", got)
+	}
+	if got := f.String("live.chat.mode", ""); got != "strict" {
+		t.Errorf("String = %q, want strict", got)
+	}
+}
+
+func TestOverridesTakePrecedenceOverDefaults(t *testing.T) {
+	source := &mapSource{values: map[string]string{"live.chat.enabled": "off", "LIVE.CHAT.LIMIT": "50"}}
+	f := New(source, map[string]string{"live.chat.enabled": "true", "live.chat.limit": "20"}, 0, nopLogger{})
+	if err := f.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	if f.Bool("live.chat.enabled", true) {
+		t.Error("override off should disable the flag")
+	}
+	if got := f.Int("live.chat.limit", 0); got != 50 {
+		t.Errorf("Int = %d, want override 50", got)
+	}
+
+	// 覆盖值被删除后回落到配置默认值
+	source.values = map[string]string{}
+	if err := f.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if !f.Bool("live.chat.enabled", false) || f.Int("live.chat.limit", 0) != 20 {
+		t.Error("removed overrides should fall back to config defaults")
+	}
+}
+
+func TestUnknownAndInvalidFlagsReturnCallerDefault(t *testing.T) {
+	f := New(nil, map[string]string{
+		"live.bad.bool":     "maybe",
+		"live.bad.int":      "ten",
+		"live.bad.duration": "soon",
+	}, 0, nopLogger{})
+
+	if !f.Bool("live.unknown", true) || f.Int("live.unknown", 7) != 7 || f.String("live.unknown", "x") != "x" {
+		t.Error("unknown flags should return the caller default")
+	}
+	if !f.Bool("live.bad.bool", true) || f.Int("live.bad.int", 7) != 7 || f.Duration("live.bad.duration", time.Minute) != time.Minute {
+		t.Error("unparseable values should return the caller default")
+	}
+	if f.EnabledFor("live.unknown", 1) {
+		t.Error("EnabledFor should be false for unknown flags")
+	}
+
+	// nil开关可安全使用
+	var nilFlags *Flags
+	if !nilFlags.Bool("live.chat.enabled", true) || nilFlags.Int("live.chat.limit", 3) != 3 {
+		t.Error("nil Flags should return the caller default")
+	}
+	if err := nilFlags.Refresh(context.Background()); err != nil {
+		t.Errorf("nil Refresh: %v", err)
+	}
+}
+
+func TestRefreshFailureKeepsLastSnapshot(t *testing.T) {
+	source := &mapSource{values: map[string]string{"live.chat.limit": "50"}}
+	f := New(source, map[string]string{"live.chat.limit": "20"}, 0, nopLogger{})
+	if err := f.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	source.err = errors.New("redis down")
+	if err := f.Refresh(context.Background()); err == nil {
+		t.Fatal("Refresh should report the source error")
+	}
+	if got := f.Int("live.chat.limit", 0); got != 50 {
+		t.Errorf("Int after failed refresh = %d, want last override 50", got)
+	}
+}
+
+func TestEnabledForPercentageRollout(t *testing.T) {
+	f := New(nil, map[string]string{
+		"live.all":  "true",
+		"live.none": "0%",
+		"live.full": "100",
+		"live.half": "50%",
+	}, 0, nopLogger{})
+
+	hits := 0
+	for userID := uint64(1); userID <= 1000; userID++ {
+		if !f.EnabledFor("live.all", userID) || f.EnabledFor("live.none", userID) || !f.EnabledFor("live.full", userID) {
+			t.Fatalf("user %d: boolean and boundary rollouts should apply to everyone", userID)
+		}
+		if f.EnabledFor("live.half", userID) {
+			hits++
+			if !f.EnabledFor("live.half", userID) {
+				t.Fatalf("user %d: rollout result is not stable", userID)
+			}
+		}
+	}
+	if hits < 400 || hits > 600 {
+		t.Errorf("50%% rollout enabled %d of 1000 users", hits)
+	}
+}
@@ -0,0 +1,156 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordUnary 记录进入和返回顺序的一元拦截器
+func recordUnary(name string, calls *[]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		*calls = append(*calls, name+" in")
+		resp, err := handler(ctx, req)
+		*calls = append(*calls, name+" out")
+		return resp, err
+	}
+}
+
+// recordStream 记录进入和返回顺序的流拦截器
+func recordStream(name string, calls *[]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		*calls = append(*calls, name+" in")
+		err := handler(srv, ss)
+		*calls = append(*calls, name+" out")
+		return err
+	}
+}
+
+// testServerStream 只提供Context的服务端流
+type testServerStream struct {
+	grpc.ServerStream
+}
+
+func (testServerStream) Context() context.Context { return context.Background() }
+
+var testUnaryInfo = &grpc.UnaryServerInfo{FullMethod: "/live.v1.LiveService/GetLiveStream"}
+
+func TestUnaryInterceptorOrder(t *testing.T) {
+	var calls []string
+	chain := Chain(recordUnary("a", &calls), nil).
+		UnaryIf(false, recordUnary("skipped", &calls)).
+		Unary(recordUnary("b", &calls)).
+		UnaryIf(true, recordUnary("c", &calls)).
+		UnaryInterceptor()
+
+	resp, err := chain(context.Background(), "req", testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls = append(calls, "handler")
+		return "resp", nil
+	})
+	if err != nil || resp != "resp" {
+		t.Fatalf("chain = (%v, %v), want the handler response", resp, err)
+	}
+	// 先添加的拦截器位于外层，nil和未开启的拦截器被忽略
+	want := "a in,b in,c in,handler,c out,b out,a out"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("calls = %s, want %s", got, want)
+	}
+}
+
+func TestUnaryInterceptorShortCircuit(t *testing.T) {
+	var calls []string
+	deny := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "deny")
+		return nil, status.Error(codes.Unauthenticated, "no token")
+	}
+	chain := Chain(recordUnary("a", &calls), deny, recordUnary("b", &calls)).UnaryInterceptor()
+
+	_, err := chain(context.Background(), "req", testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls = append(calls, "handler")
+		return nil, nil
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("err = %v, want Unauthenticated", err)
+	}
+	if got := strings.Join(calls, ","); got != "a in,deny,a out" {
+		t.Errorf("calls = %s, want the chain stopped at deny", got)
+	}
+}
+
+func TestStreamInterceptorOrder(t *testing.T) {
+	var calls []string
+	chain := Chain().
+		Stream(recordStream("a", &calls)).
+		StreamIf(false, recordStream("skipped", &calls)).
+		StreamIf(true, recordStream("b", &calls), nil).
+		StreamInterceptor()
+
+	err := chain(nil, testServerStream{}, &grpc.StreamServerInfo{FullMethod: "/live.v1.LiveService/WatchViewerCount"}, func(srv interface{}, ss grpc.ServerStream) error {
+		calls = append(calls, "handler")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("chain: %v", err)
+	}
+	if got, want := strings.Join(calls, ","), "a in,b in,handler,b out,a out"; got != want {
+		t.Errorf("calls = %s, want %s", got, want)
+	}
+}
+
+func TestEmptyAndSingleChain(t *testing.T) {
+	empty := Chain(nil)
+	if empty.UnaryInterceptor() != nil || empty.StreamInterceptor() != nil || len(empty.ServerOptions()) != 0 {
+		t.Error("empty chain produced interceptors, want none")
+	}
+
+	var calls []string
+	single := Chain(recordUnary("a", &calls)).Stream(recordStream("s", &calls))
+	if len(single.ServerOptions()) != 2 {
+		t.Errorf("server options = %d, want unary and stream", len(single.ServerOptions()))
+	}
+	if _, err := single.UnaryInterceptor()(context.Background(), "req", testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil || strings.Join(calls, ",") != "a in,a out" {
+		t.Errorf("single chain = %v calls %v, want the only interceptor called", err, calls)
+	}
+}
+
+func TestUnaryRecovery(t *testing.T) {
+	var method string
+	var recovered interface{}
+	chain := Chain(UnaryRecovery(func(ctx context.Context, m string, p interface{}, stack []byte) {
+		method, recovered = m, p
+	})).UnaryInterceptor()
+
+	_, err := chain(context.Background(), "req", testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("err = %v, want Internal", err)
+	}
+	if method != testUnaryInfo.FullMethod || recovered != "boom" {
+		t.Errorf("panic handler got (%s, %v), want the method and panic value", method, recovered)
+	}
+
+	// 未发生panic时原样返回处理结果
+	handlerErr := errors.New("not found")
+	if _, err := chain(context.Background(), "req", testUnaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, handlerErr
+	}); !errors.Is(err, handlerErr) {
+		t.Errorf("err = %v, want the handler error", err)
+	}
+}
+
+func TestStreamRecovery(t *testing.T) {
+	err := StreamRecovery(nil)(nil, testServerStream{}, &grpc.StreamServerInfo{FullMethod: "/live.v1.LiveService/WatchViewerCount"}, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("err = %v, want Internal", err)
+	}
+}
@@ -0,0 +1,45 @@
+package interceptors
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactedValue 敏感字段在日志中的替代值
+const redactedValue = "[REDACTED]"
+
+// sensitiveFields 不能写入日志的请求字段(proto字段名)，包括房间密码和推流密钥
+var sensitiveFields = map[protoreflect.Name]bool{
+	"password":   true,
+	"stream_key": true,
+}
+
+// Redact 返回用于记录日志的请求副本，敏感字符串字段(包括嵌套消息中的)替换为[REDACTED]
+// 不修改原请求；非proto消息原样返回
+func Redact(req interface{}) interface{} {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return req
+	}
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+	return clone
+}
+
+// redactMessage 替换消息及其嵌套消息中的敏感字段
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case sensitiveFields[fd.Name()] && fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap():
+			m.Set(fd, protoreflect.ValueOfString(redactedValue))
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
@@ -0,0 +1,43 @@
+package interceptors
+
+import (
+	"strings"
+	"testing"
+
+	proto_gen "live_service/proto/proto_gen"
+)
+
+func TestRedactHidesPasswordsAndStreamKeys(t *testing.T) {
+	join := &proto_gen.JoinLiveRoomRequest{StreamId: 3, UserId: 7, Password: "room-secret"}
+	auth := &proto_gen.AuthenticateStreamKeyRequest{StreamKey: "live_abcdef", ClientIp: "10.0.0.1"}
+
+	for _, req := range []interface{}{join, auth} {
+		logged := Redact(req).(interface{ String() string }).String()
+		for _, secret := range []string{"room-secret", "live_abcdef"} {
+			if strings.Contains(logged, secret) {
+				t.Errorf("redacted request %q still contains %q", logged, secret)
+			}
+		}
+		if !strings.Contains(logged, redactedValue) {
+			t.Errorf("redacted request %q has no %s marker", logged, redactedValue)
+		}
+	}
+
+	// 原请求不受影响，非敏感字段保留
+	if join.Password != "room-secret" || auth.StreamKey != "live_abcdef" {
+		t.Errorf("Redact modified the original request: %v / %v", join, auth)
+	}
+	if got := Redact(join).(*proto_gen.JoinLiveRoomRequest); got.StreamId != 3 || got.UserId != 7 {
+		t.Errorf("redacted copy lost fields: %v", got)
+	}
+}
+
+func TestRedactLeavesEmptyAndNonProtoValues(t *testing.T) {
+	req := &proto_gen.JoinLiveRoomRequest{StreamId: 3}
+	if got := Redact(req).(*proto_gen.JoinLiveRoomRequest); got.Password != "" {
+		t.Errorf("empty password redacted to %q, want it left unset", got.Password)
+	}
+	if got := Redact("plain"); got != "plain" {
+		t.Errorf("Redact(non-proto) = %v, want unchanged", got)
+	}
+}
@@ -0,0 +1,187 @@
+// Package notify 发布用户通知事件
+// 业务服务在用户收到礼物、被关注、内容审核未通过等场景发布通知事件，
+// 事件经Redis队列投递给message_service，由其保存并推送给接收者
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"live_service/pkg/logger"
+)
+
+// 默认参数
+const (
+	DefaultQueueKey   = "notification:events" // 与消息服务约定的通知事件队列
+	DefaultBufferSize = 1024
+	pushTimeout       = 3 * time.Second
+)
+
+// 通知类型
+const (
+	TypeGift          = "gift"           // 收到礼物
+	TypeFollow        = "follow"         // 新增粉丝
+	TypeAuditRejected = "audit_rejected" // 内容审核未通过
+)
+
+// Notification 通知事件
+type Notification struct {
+	Type      string `json:"type"`
+	UserID    uint64 `json:"user_id"`             // 接收者
+	ActorID   uint64 `json:"actor_id,omitempty"`  // 触发者，系统通知为0
+	TargetID  string `json:"target_id,omitempty"` // 关联对象ID，如直播间ID、内容ID
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Timestamp int64  `json:"timestamp"` // 事件时间(毫秒)
+}
+
+// Notifier 用户通知发送接口，实现不应阻塞调用方
+type Notifier interface {
+	Notify(n Notification)
+}
+
+// Sink 通知事件投递目标
+type Sink interface {
+	Push(ctx context.Context, n *Notification) error
+}
+
+// redisSink 将事件写入Redis列表，消息服务从列表另一端消费
+type redisSink struct {
+	client redis.UniversalClient
+	key    string
+}
+
+// NewRedisSink 创建Redis事件投递目标，key为空时使用默认队列
+func NewRedisSink(client redis.UniversalClient, key string) Sink {
+	if key == "" {
+		key = DefaultQueueKey
+	}
+	return &redisSink{client: client, key: key}
+}
+
+// Push 投递事件
+func (s *redisSink) Push(ctx context.Context, n *Notification) error {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	return s.client.LPush(ctx, s.key, payload).Err()
+}
+
+// Publisher 通知事件异步发布器，实现Notifier
+// Notify只把事件放入内存队列，由后台协程投递；队列已满或投递失败时丢弃事件并记录日志，
+// 通知是尽力而为的，不会阻塞或影响业务流程。nil可安全使用，此时不发布任何事件。
+type Publisher struct {
+	sink   Sink
+	logger logger.Logger
+	events chan *Notification
+
+	mu        sync.Mutex
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	started   bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+var _ Notifier = (*Publisher)(nil)
+
+// NewPublisher 创建通知事件发布器，bufferSize<=0时使用默认队列长度
+func NewPublisher(sink Sink, bufferSize int, log logger.Logger) *Publisher {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &Publisher{
+		sink:   sink,
+		logger: log,
+		events: make(chan *Notification, bufferSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+// Notify 发布通知事件，接收者为0时忽略
+func (p *Publisher) Notify(n Notification) {
+	if p == nil || n.UserID == 0 {
+		return
+	}
+	if n.Timestamp == 0 {
+		n.Timestamp = time.Now().UnixMilli()
+	}
+
+	select {
+	case <-p.stopCh:
+		p.logger.Warn("Notification publisher stopped, dropping notification", "type", n.Type, "userID", n.UserID)
+	case p.events <- &n:
+	default:
+		p.logger.Warn("Notification queue full, dropping notification", "type", n.Type, "userID", n.UserID)
+	}
+}
+
+// Start 启动后台投递
+func (p *Publisher) Start() {
+	if p == nil {
+		return
+	}
+	p.startOnce.Do(func() {
+		p.mu.Lock()
+		p.started = true
+		p.mu.Unlock()
+		go p.run()
+	})
+}
+
+func (p *Publisher) run() {
+	defer close(p.doneCh)
+
+	for {
+		select {
+		case n := <-p.events:
+			p.push(n)
+		case <-p.stopCh:
+			// 退出前投递已入队的事件
+			for {
+				select {
+				case n := <-p.events:
+					p.push(n)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *Publisher) push(n *Notification) {
+	ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+	defer cancel()
+	if err := p.sink.Push(ctx, n); err != nil {
+		p.logger.Warn("Failed to publish notification", "type", n.Type, "userID", n.UserID, "error", err)
+	}
+}
+
+// Stop 停止后台投递并等待已入队的事件投递完成
+func (p *Publisher) Stop(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+	p.mu.Lock()
+	started := p.started
+	p.mu.Unlock()
+	if !started {
+		return nil
+	}
+	select {
+	case <-p.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,45 @@
+package paginate
+
+// 偏移分页每页条数的默认值和上限
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// Page 偏移分页参数，页码从1开始
+type Page struct {
+	Number int // 页码
+	Size   int // 每页条数
+}
+
+// NewPage 校验并规范化分页参数，页码小于1时取第1页，每页条数未指定时取默认值、超过上限时取上限
+func NewPage(number, size int) Page {
+	if number <= 0 {
+		number = 1
+	}
+	switch {
+	case size > MaxPageSize:
+		size = MaxPageSize
+	case size <= 0:
+		size = DefaultPageSize
+	}
+	return Page{Number: number, Size: size}
+}
+
+// Offset 当前页第一条记录的偏移量
+func (p Page) Offset() int {
+	return (p.Number - 1) * p.Size
+}
+
+// TotalPages 按总条数计算总页数，没有记录时为0
+func (p Page) TotalPages(total int64) int64 {
+	if total <= 0 {
+		return 0
+	}
+	return (total + int64(p.Size) - 1) / int64(p.Size)
+}
+
+// InRange 当前页是否有数据，页码超过总页数时返回false，调用方直接返回空页而不再查询
+func (p Page) InRange(total int64) bool {
+	return int64(p.Offset()) < total
+}
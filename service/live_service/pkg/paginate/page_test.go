@@ -0,0 +1,85 @@
+package paginate
+
+import "testing"
+
+func TestNewPageBounds(t *testing.T) {
+	tests := []struct {
+		name         string
+		number, size int
+		want         Page
+	}{
+		{"valid", 3, 20, Page{Number: 3, Size: 20}},
+		{"zero page", 0, 20, Page{Number: 1, Size: 20}},
+		{"negative page", -2, 20, Page{Number: 1, Size: 20}},
+		{"zero size", 2, 0, Page{Number: 2, Size: DefaultPageSize}},
+		{"negative size", 2, -5, Page{Number: 2, Size: DefaultPageSize}},
+		{"max size", 1, MaxPageSize, Page{Number: 1, Size: MaxPageSize}},
+		// 超过上限时取上限，避免一次查询过多数据
+		{"above max size", 1, MaxPageSize + 1, Page{Number: 1, Size: MaxPageSize}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPage(tt.number, tt.size); got != tt.want {
+				t.Errorf("NewPage(%d, %d) = %+v, want %+v", tt.number, tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageOffset(t *testing.T) {
+	tests := []struct {
+		page Page
+		want int
+	}{
+		{NewPage(1, 10), 0},
+		{NewPage(3, 10), 20},
+		{NewPage(0, 0), 0},
+		{NewPage(2, 1000), MaxPageSize},
+	}
+	for _, tt := range tests {
+		if got := tt.page.Offset(); got != tt.want {
+			t.Errorf("%+v offset = %d, want %d", tt.page, got, tt.want)
+		}
+	}
+}
+
+func TestPageTotalPages(t *testing.T) {
+	tests := []struct {
+		size  int
+		total int64
+		want  int64
+	}{
+		{10, 0, 0},
+		{10, -1, 0},
+		{10, 1, 1},
+		{10, 10, 1},
+		{10, 11, 2},
+		{100, 1000, 10},
+		{1, 7, 7},
+	}
+	for _, tt := range tests {
+		if got := NewPage(1, tt.size).TotalPages(tt.total); got != tt.want {
+			t.Errorf("size %d total %d: total pages = %d, want %d", tt.size, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestPageInRange(t *testing.T) {
+	tests := []struct {
+		number int
+		total  int64
+		want   bool
+	}{
+		{1, 0, false},
+		{1, 1, true},
+		{3, 21, true},
+		// 最后一页之后的页码没有数据
+		{3, 20, false},
+		{4, 21, false},
+	}
+	for _, tt := range tests {
+		if got := NewPage(tt.number, 10).InRange(tt.total); got != tt.want {
+			t.Errorf("page %d of total %d in range = %v, want %v", tt.number, tt.total, got, tt.want)
+		}
+	}
+}
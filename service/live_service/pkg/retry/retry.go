@@ -0,0 +1,86 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"live_service/pkg/logger"
+)
+
+// 默认退避参数
+const (
+	DefaultInterval    = time.Second
+	DefaultMaxInterval = 30 * time.Second
+)
+
+// Options 重试选项
+type Options struct {
+	Attempts    int           // 最大尝试次数(含首次)，<=1时不重试
+	Interval    time.Duration // 首次重试前的等待时间，之后每次翻倍
+	MaxInterval time.Duration // 单次等待时间上限
+}
+
+// Backoff 第attempt次失败后的等待时间，attempt从1开始
+func (o Options) Backoff(attempt int) time.Duration {
+	interval := o.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	maxInterval := o.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxInterval
+	}
+
+	for i := 1; i < attempt && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return interval
+}
+
+// Do 执行fn，失败时按指数退避重试，直到成功、达到最大次数或ctx结束
+// 用于启动阶段连接数据库、Redis、etcd等依赖，避免依赖稍晚就绪时服务直接退出
+func Do[T any](ctx context.Context, opts Options, log logger.Logger, name string, fn func() (T, error)) (T, error) {
+	attempts := opts.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var zero T
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		result, err := fn()
+		if err == nil {
+			if attempt > 1 {
+				log.Info("Connected after retry", "target", name, "attempt", attempt)
+			}
+			return result, nil
+		}
+		lastErr = err
+
+		if attempt == attempts {
+			break
+		}
+		wait := opts.Backoff(attempt)
+		log.Warn("Connect failed, retrying",
+			"target", name,
+			"attempt", attempt,
+			"max_attempts", attempts,
+			"retry_in", wait,
+			"error", err,
+		)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return zero, fmt.Errorf("%s: %w (last error: %v)", name, ctx.Err(), lastErr)
+		}
+	}
+
+	return zero, fmt.Errorf("%s: giving up after %d attempts: %w", name, attempts, lastErr)
+}
@@ -19,18 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuditService_SubmitContent_FullMethodName        = "/audit.v1.AuditService/SubmitContent"
-	AuditService_GetAuditResult_FullMethodName       = "/audit.v1.AuditService/GetAuditResult"
-	AuditService_UpdateAuditStatus_FullMethodName    = "/audit.v1.AuditService/UpdateAuditStatus"
-	AuditService_ListAuditRecords_FullMethodName     = "/audit.v1.AuditService/ListAuditRecords"
-	AuditService_AddToWhitelist_FullMethodName       = "/audit.v1.AuditService/AddToWhitelist"
-	AuditService_RemoveFromWhitelist_FullMethodName  = "/audit.v1.AuditService/RemoveFromWhitelist"
-	AuditService_AddToBlacklist_FullMethodName       = "/audit.v1.AuditService/AddToBlacklist"
-	AuditService_RemoveFromBlacklist_FullMethodName  = "/audit.v1.AuditService/RemoveFromBlacklist"
-	AuditService_GetManualReviewQueue_FullMethodName = "/audit.v1.AuditService/GetManualReviewQueue"
-	AuditService_AssignManualReview_FullMethodName   = "/audit.v1.AuditService/AssignManualReview"
-	AuditService_GetAuditStatistics_FullMethodName   = "/audit.v1.AuditService/GetAuditStatistics"
-	AuditService_GetViolationTrends_FullMethodName   = "/audit.v1.AuditService/GetViolationTrends"
+	AuditService_SubmitContent_FullMethodName         = "/audit.v1.AuditService/SubmitContent"
+	AuditService_GetAuditResult_FullMethodName        = "/audit.v1.AuditService/GetAuditResult"
+	AuditService_UpdateAuditStatus_FullMethodName     = "/audit.v1.AuditService/UpdateAuditStatus"
+	AuditService_ListAuditRecords_FullMethodName      = "/audit.v1.AuditService/ListAuditRecords"
+	AuditService_AddToWhitelist_FullMethodName        = "/audit.v1.AuditService/AddToWhitelist"
+	AuditService_RemoveFromWhitelist_FullMethodName   = "/audit.v1.AuditService/RemoveFromWhitelist"
+	AuditService_AddToBlacklist_FullMethodName        = "/audit.v1.AuditService/AddToBlacklist"
+	AuditService_RemoveFromBlacklist_FullMethodName   = "/audit.v1.AuditService/RemoveFromBlacklist"
+	AuditService_GetManualReviewQueue_FullMethodName  = "/audit.v1.AuditService/GetManualReviewQueue"
+	AuditService_AssignManualReview_FullMethodName    = "/audit.v1.AuditService/AssignManualReview"
+	AuditService_GetAuditStatistics_FullMethodName    = "/audit.v1.AuditService/GetAuditStatistics"
+	AuditService_GetViolationTrends_FullMethodName    = "/audit.v1.AuditService/GetViolationTrends"
+	AuditService_ListFailedSubmissions_FullMethodName = "/audit.v1.AuditService/ListFailedSubmissions"
+	AuditService_RetryFailedSubmission_FullMethodName = "/audit.v1.AuditService/RetryFailedSubmission"
+	AuditService_ReportContent_FullMethodName         = "/audit.v1.AuditService/ReportContent"
+	AuditService_SubmitAppeal_FullMethodName          = "/audit.v1.AuditService/SubmitAppeal"
+	AuditService_ResolveAppeal_FullMethodName         = "/audit.v1.AuditService/ResolveAppeal"
+	AuditService_ListPendingAppeals_FullMethodName    = "/audit.v1.AuditService/ListPendingAppeals"
+	AuditService_BatchSubmitContent_FullMethodName    = "/audit.v1.AuditService/BatchSubmitContent"
 )
 
 // AuditServiceClient is the client API for AuditService service.
@@ -61,6 +68,20 @@ type AuditServiceClient interface {
 	GetAuditStatistics(ctx context.Context, in *GetAuditStatisticsRequest, opts ...grpc.CallOption) (*GetAuditStatisticsResponse, error)
 	// 获取违规趋势
 	GetViolationTrends(ctx context.Context, in *GetViolationTrendsRequest, opts ...grpc.CallOption) (*GetViolationTrendsResponse, error)
+	// 获取提交失败的审核内容（重试队列）
+	ListFailedSubmissions(ctx context.Context, in *ListFailedSubmissionsRequest, opts ...grpc.CallOption) (*ListFailedSubmissionsResponse, error)
+	// 人工重试提交失败的审核内容
+	RetryFailedSubmission(ctx context.Context, in *RetryFailedSubmissionRequest, opts ...grpc.CallOption) (*RetryFailedSubmissionResponse, error)
+	// 用户举报内容，达到举报阈值后自动升级人工审核
+	ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentResponse, error)
+	// 被拒绝内容的上传者提交申诉，审核记录进入申诉队列并提升为高级别
+	SubmitAppeal(ctx context.Context, in *SubmitAppealRequest, opts ...grpc.CallOption) (*SubmitAppealResponse, error)
+	// 审核员处理申诉，维持或撤销原审核结果
+	ResolveAppeal(ctx context.Context, in *ResolveAppealRequest, opts ...grpc.CallOption) (*ResolveAppealResponse, error)
+	// 获取申诉队列中待处理的申诉，按提交时间先后排列
+	ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...grpc.CallOption) (*ListPendingAppealsResponse, error)
+	// 批量提交内容审核，items中的每项可以携带各自的内容和元数据
+	BatchSubmitContent(ctx context.Context, in *BatchSubmitContentRequest, opts ...grpc.CallOption) (*BatchSubmitContentResponse, error)
 }
 
 type auditServiceClient struct {
@@ -179,6 +200,69 @@ func (c *auditServiceClient) GetViolationTrends(ctx context.Context, in *GetViol
 	return out, nil
 }
 
+func (c *auditServiceClient) ListFailedSubmissions(ctx context.Context, in *ListFailedSubmissionsRequest, opts ...grpc.CallOption) (*ListFailedSubmissionsResponse, error) {
+	out := new(ListFailedSubmissionsResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListFailedSubmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) RetryFailedSubmission(ctx context.Context, in *RetryFailedSubmissionRequest, opts ...grpc.CallOption) (*RetryFailedSubmissionResponse, error) {
+	out := new(RetryFailedSubmissionResponse)
+	err := c.cc.Invoke(ctx, AuditService_RetryFailedSubmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentResponse, error) {
+	out := new(ReportContentResponse)
+	err := c.cc.Invoke(ctx, AuditService_ReportContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) SubmitAppeal(ctx context.Context, in *SubmitAppealRequest, opts ...grpc.CallOption) (*SubmitAppealResponse, error) {
+	out := new(SubmitAppealResponse)
+	err := c.cc.Invoke(ctx, AuditService_SubmitAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) ResolveAppeal(ctx context.Context, in *ResolveAppealRequest, opts ...grpc.CallOption) (*ResolveAppealResponse, error) {
+	out := new(ResolveAppealResponse)
+	err := c.cc.Invoke(ctx, AuditService_ResolveAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...grpc.CallOption) (*ListPendingAppealsResponse, error) {
+	out := new(ListPendingAppealsResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListPendingAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) BatchSubmitContent(ctx context.Context, in *BatchSubmitContentRequest, opts ...grpc.CallOption) (*BatchSubmitContentResponse, error) {
+	out := new(BatchSubmitContentResponse)
+	err := c.cc.Invoke(ctx, AuditService_BatchSubmitContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuditServiceServer is the server API for AuditService service.
 // All implementations must embed UnimplementedAuditServiceServer
 // for forward compatibility
@@ -207,6 +291,20 @@ type AuditServiceServer interface {
 	GetAuditStatistics(context.Context, *GetAuditStatisticsRequest) (*GetAuditStatisticsResponse, error)
 	// 获取违规趋势
 	GetViolationTrends(context.Context, *GetViolationTrendsRequest) (*GetViolationTrendsResponse, error)
+	// 获取提交失败的审核内容（重试队列）
+	ListFailedSubmissions(context.Context, *ListFailedSubmissionsRequest) (*ListFailedSubmissionsResponse, error)
+	// 人工重试提交失败的审核内容
+	RetryFailedSubmission(context.Context, *RetryFailedSubmissionRequest) (*RetryFailedSubmissionResponse, error)
+	// 用户举报内容，达到举报阈值后自动升级人工审核
+	ReportContent(context.Context, *ReportContentRequest) (*ReportContentResponse, error)
+	// 被拒绝内容的上传者提交申诉，审核记录进入申诉队列并提升为高级别
+	SubmitAppeal(context.Context, *SubmitAppealRequest) (*SubmitAppealResponse, error)
+	// 审核员处理申诉，维持或撤销原审核结果
+	ResolveAppeal(context.Context, *ResolveAppealRequest) (*ResolveAppealResponse, error)
+	// 获取申诉队列中待处理的申诉，按提交时间先后排列
+	ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsResponse, error)
+	// 批量提交内容审核，items中的每项可以携带各自的内容和元数据
+	BatchSubmitContent(context.Context, *BatchSubmitContentRequest) (*BatchSubmitContentResponse, error)
 	mustEmbedUnimplementedAuditServiceServer()
 }
 
@@ -250,6 +348,27 @@ func (UnimplementedAuditServiceServer) GetAuditStatistics(context.Context, *GetA
 func (UnimplementedAuditServiceServer) GetViolationTrends(context.Context, *GetViolationTrendsRequest) (*GetViolationTrendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetViolationTrends not implemented")
 }
+func (UnimplementedAuditServiceServer) ListFailedSubmissions(context.Context, *ListFailedSubmissionsRequest) (*ListFailedSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedSubmissions not implemented")
+}
+func (UnimplementedAuditServiceServer) RetryFailedSubmission(context.Context, *RetryFailedSubmissionRequest) (*RetryFailedSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedSubmission not implemented")
+}
+func (UnimplementedAuditServiceServer) ReportContent(context.Context, *ReportContentRequest) (*ReportContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (UnimplementedAuditServiceServer) SubmitAppeal(context.Context, *SubmitAppealRequest) (*SubmitAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAppeal not implemented")
+}
+func (UnimplementedAuditServiceServer) ResolveAppeal(context.Context, *ResolveAppealRequest) (*ResolveAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAppeal not implemented")
+}
+func (UnimplementedAuditServiceServer) ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingAppeals not implemented")
+}
+func (UnimplementedAuditServiceServer) BatchSubmitContent(context.Context, *BatchSubmitContentRequest) (*BatchSubmitContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSubmitContent not implemented")
+}
 func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
 
 // UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -479,6 +598,132 @@ func _AuditService_GetViolationTrends_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditService_ListFailedSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListFailedSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListFailedSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListFailedSubmissions(ctx, req.(*ListFailedSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_RetryFailedSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).RetryFailedSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_RetryFailedSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).RetryFailedSubmission(ctx, req.(*RetryFailedSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ReportContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ReportContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ReportContent(ctx, req.(*ReportContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_SubmitAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).SubmitAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_SubmitAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).SubmitAppeal(ctx, req.(*SubmitAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_ResolveAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ResolveAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ResolveAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ResolveAppeal(ctx, req.(*ResolveAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_ListPendingAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListPendingAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListPendingAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListPendingAppeals(ctx, req.(*ListPendingAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_BatchSubmitContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSubmitContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).BatchSubmitContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_BatchSubmitContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).BatchSubmitContent(ctx, req.(*BatchSubmitContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -534,6 +779,34 @@ var AuditService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetViolationTrends",
 			Handler:    _AuditService_GetViolationTrends_Handler,
 		},
+		{
+			MethodName: "ListFailedSubmissions",
+			Handler:    _AuditService_ListFailedSubmissions_Handler,
+		},
+		{
+			MethodName: "RetryFailedSubmission",
+			Handler:    _AuditService_RetryFailedSubmission_Handler,
+		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _AuditService_ReportContent_Handler,
+		},
+		{
+			MethodName: "SubmitAppeal",
+			Handler:    _AuditService_SubmitAppeal_Handler,
+		},
+		{
+			MethodName: "ResolveAppeal",
+			Handler:    _AuditService_ResolveAppeal_Handler,
+		},
+		{
+			MethodName: "ListPendingAppeals",
+			Handler:    _AuditService_ListPendingAppeals_Handler,
+		},
+		{
+			MethodName: "BatchSubmitContent",
+			Handler:    _AuditService_BatchSubmitContent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/audit/v1/audit.proto",
@@ -142,6 +142,8 @@ type StartLiveRequest struct {
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	CategoryId    uint32                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Language      string                 `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"` // 直播语言，如zh、en，可为空
+	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`     // 直播地区，如CN、US，可为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -211,6 +213,20 @@ func (x *StartLiveRequest) GetRequestId() string {
 	return ""
 }
 
+func (x *StartLiveRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *StartLiveRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type StartLiveResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -415,29 +431,30 @@ func (x *StopLiveResponse) GetRequestId() string {
 	return ""
 }
 
-type GetLiveStreamRequest struct {
+// 推流鉴权(on_publish回调)
+type AuthenticateStreamKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	StreamKey     string                 `protobuf:"bytes,1,opt,name=stream_key,json=streamKey,proto3" json:"stream_key,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveStreamRequest) Reset() {
-	*x = GetLiveStreamRequest{}
+func (x *AuthenticateStreamKeyRequest) Reset() {
+	*x = AuthenticateStreamKeyRequest{}
 	mi := &file_proto_live_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveStreamRequest) String() string {
+func (x *AuthenticateStreamKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveStreamRequest) ProtoMessage() {}
+func (*AuthenticateStreamKeyRequest) ProtoMessage() {}
 
-func (x *GetLiveStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthenticateStreamKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,56 +466,57 @@ func (x *GetLiveStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthenticateStreamKeyRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateStreamKeyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetLiveStreamRequest) GetUserId() uint64 {
+func (x *AuthenticateStreamKeyRequest) GetStreamKey() string {
 	if x != nil {
-		return x.UserId
+		return x.StreamKey
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLiveStreamRequest) GetStreamId() uint64 {
+func (x *AuthenticateStreamKeyRequest) GetClientIp() string {
 	if x != nil {
-		return x.StreamId
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLiveStreamRequest) GetRequestId() string {
+func (x *AuthenticateStreamKeyRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveStreamResponse struct {
+type AuthenticateStreamKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Stream        *LiveStream            `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,4,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveStreamResponse) Reset() {
-	*x = GetLiveStreamResponse{}
+func (x *AuthenticateStreamKeyResponse) Reset() {
+	*x = AuthenticateStreamKeyResponse{}
 	mi := &file_proto_live_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveStreamResponse) String() string {
+func (x *AuthenticateStreamKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveStreamResponse) ProtoMessage() {}
+func (*AuthenticateStreamKeyResponse) ProtoMessage() {}
 
-func (x *GetLiveStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthenticateStreamKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -510,64 +528,70 @@ func (x *GetLiveStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveStreamResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthenticateStreamKeyResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateStreamKeyResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetLiveStreamResponse) GetCode() int32 {
+func (x *AuthenticateStreamKeyResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLiveStreamResponse) GetMessage() string {
+func (x *AuthenticateStreamKeyResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLiveStreamResponse) GetRequestId() string {
+func (x *AuthenticateStreamKeyResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLiveStreamResponse) GetStream() *LiveStream {
+func (x *AuthenticateStreamKeyResponse) GetStreamId() uint64 {
 	if x != nil {
-		return x.Stream
+		return x.StreamId
 	}
-	return nil
+	return 0
 }
 
-type GetLiveListRequest struct {
+func (x *AuthenticateStreamKeyResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 断流回调(on_unpublish回调)
+type OnStreamEndedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	CategoryId    uint32                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	StreamKey     string                 `protobuf:"bytes,1,opt,name=stream_key,json=streamKey,proto3" json:"stream_key,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveListRequest) Reset() {
-	*x = GetLiveListRequest{}
+func (x *OnStreamEndedRequest) Reset() {
+	*x = OnStreamEndedRequest{}
 	mi := &file_proto_live_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveListRequest) String() string {
+func (x *OnStreamEndedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveListRequest) ProtoMessage() {}
+func (*OnStreamEndedRequest) ProtoMessage() {}
 
-func (x *GetLiveListRequest) ProtoReflect() protoreflect.Message {
+func (x *OnStreamEndedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,71 +603,57 @@ func (x *GetLiveListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveListRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OnStreamEndedRequest.ProtoReflect.Descriptor instead.
+func (*OnStreamEndedRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetLiveListRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *GetLiveListRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetLiveListRequest) GetPageSize() int32 {
+func (x *OnStreamEndedRequest) GetStreamKey() string {
 	if x != nil {
-		return x.PageSize
+		return x.StreamKey
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLiveListRequest) GetCategoryId() uint32 {
+func (x *OnStreamEndedRequest) GetClientIp() string {
 	if x != nil {
-		return x.CategoryId
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLiveListRequest) GetRequestId() string {
+func (x *OnStreamEndedRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveListResponse struct {
+type OnStreamEndedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Streams       []*LiveStream          `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
-	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,4,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Duration      uint32                 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveListResponse) Reset() {
-	*x = GetLiveListResponse{}
+func (x *OnStreamEndedResponse) Reset() {
+	*x = OnStreamEndedResponse{}
 	mi := &file_proto_live_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveListResponse) String() string {
+func (x *OnStreamEndedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveListResponse) ProtoMessage() {}
+func (*OnStreamEndedResponse) ProtoMessage() {}
 
-func (x *GetLiveListResponse) ProtoReflect() protoreflect.Message {
+func (x *OnStreamEndedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,70 +665,72 @@ func (x *GetLiveListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveListResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OnStreamEndedResponse.ProtoReflect.Descriptor instead.
+func (*OnStreamEndedResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetLiveListResponse) GetCode() int32 {
+func (x *OnStreamEndedResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLiveListResponse) GetMessage() string {
+func (x *OnStreamEndedResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLiveListResponse) GetRequestId() string {
+func (x *OnStreamEndedResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLiveListResponse) GetStreams() []*LiveStream {
+func (x *OnStreamEndedResponse) GetStreamId() uint64 {
 	if x != nil {
-		return x.Streams
+		return x.StreamId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLiveListResponse) GetTotal() int64 {
+func (x *OnStreamEndedResponse) GetDuration() uint32 {
 	if x != nil {
-		return x.Total
+		return x.Duration
 	}
 	return 0
 }
 
-type GetHotLiveListRequest struct {
+// 更新直播隐私设置，仅主播本人可操作
+type UpdateLiveStreamPrivacyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，主播取自authorization元数据中的访问令牌
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,3,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"` // 房间密码，为空表示取消密码
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHotLiveListRequest) Reset() {
-	*x = GetHotLiveListRequest{}
+func (x *UpdateLiveStreamPrivacyRequest) Reset() {
+	*x = UpdateLiveStreamPrivacyRequest{}
 	mi := &file_proto_live_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHotLiveListRequest) String() string {
+func (x *UpdateLiveStreamPrivacyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHotLiveListRequest) ProtoMessage() {}
+func (*UpdateLiveStreamPrivacyRequest) ProtoMessage() {}
 
-func (x *GetHotLiveListRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateLiveStreamPrivacyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,64 +742,69 @@ func (x *GetHotLiveListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHotLiveListRequest.ProtoReflect.Descriptor instead.
-func (*GetHotLiveListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLiveStreamPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLiveStreamPrivacyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetHotLiveListRequest) GetUserId() uint64 {
+func (x *UpdateLiveStreamPrivacyRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetHotLiveListRequest) GetPage() int32 {
+func (x *UpdateLiveStreamPrivacyRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.StreamId
 	}
 	return 0
 }
 
-func (x *GetHotLiveListRequest) GetPageSize() int32 {
+func (x *UpdateLiveStreamPrivacyRequest) GetIsPublic() bool {
 	if x != nil {
-		return x.PageSize
+		return x.IsPublic
 	}
-	return 0
+	return false
 }
 
-func (x *GetHotLiveListRequest) GetRequestId() string {
+func (x *UpdateLiveStreamPrivacyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateLiveStreamPrivacyRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetHotLiveListResponse struct {
+type UpdateLiveStreamPrivacyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Streams       []*LiveStream          `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
-	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHotLiveListResponse) Reset() {
-	*x = GetHotLiveListResponse{}
+func (x *UpdateLiveStreamPrivacyResponse) Reset() {
+	*x = UpdateLiveStreamPrivacyResponse{}
 	mi := &file_proto_live_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHotLiveListResponse) String() string {
+func (x *UpdateLiveStreamPrivacyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHotLiveListResponse) ProtoMessage() {}
+func (*UpdateLiveStreamPrivacyResponse) ProtoMessage() {}
 
-func (x *GetHotLiveListResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateLiveStreamPrivacyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -799,48 +816,33 @@ func (x *GetHotLiveListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHotLiveListResponse.ProtoReflect.Descriptor instead.
-func (*GetHotLiveListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLiveStreamPrivacyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLiveStreamPrivacyResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetHotLiveListResponse) GetCode() int32 {
+func (x *UpdateLiveStreamPrivacyResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetHotLiveListResponse) GetMessage() string {
+func (x *UpdateLiveStreamPrivacyResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetHotLiveListResponse) GetRequestId() string {
+func (x *UpdateLiveStreamPrivacyResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetHotLiveListResponse) GetStreams() []*LiveStream {
-	if x != nil {
-		return x.Streams
-	}
-	return nil
-}
-
-func (x *GetHotLiveListResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// 直播间相关
-type JoinLiveRoomRequest struct {
+type GetLiveStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
@@ -849,20 +851,20 @@ type JoinLiveRoomRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinLiveRoomRequest) Reset() {
-	*x = JoinLiveRoomRequest{}
+func (x *GetLiveStreamRequest) Reset() {
+	*x = GetLiveStreamRequest{}
 	mi := &file_proto_live_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinLiveRoomRequest) String() string {
+func (x *GetLiveStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinLiveRoomRequest) ProtoMessage() {}
+func (*GetLiveStreamRequest) ProtoMessage() {}
 
-func (x *JoinLiveRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLiveStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,56 +876,56 @@ func (x *JoinLiveRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinLiveRoomRequest.ProtoReflect.Descriptor instead.
-func (*JoinLiveRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveStreamRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *JoinLiveRoomRequest) GetUserId() uint64 {
+func (x *GetLiveStreamRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *JoinLiveRoomRequest) GetStreamId() uint64 {
+func (x *GetLiveStreamRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *JoinLiveRoomRequest) GetRequestId() string {
+func (x *GetLiveStreamRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type JoinLiveRoomResponse struct {
+type GetLiveStreamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Viewer        *LiveViewer            `protobuf:"bytes,4,opt,name=viewer,proto3" json:"viewer,omitempty"`
+	Stream        *LiveStream            `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinLiveRoomResponse) Reset() {
-	*x = JoinLiveRoomResponse{}
+func (x *GetLiveStreamResponse) Reset() {
+	*x = GetLiveStreamResponse{}
 	mi := &file_proto_live_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinLiveRoomResponse) String() string {
+func (x *GetLiveStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinLiveRoomResponse) ProtoMessage() {}
+func (*GetLiveStreamResponse) ProtoMessage() {}
 
-func (x *JoinLiveRoomResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLiveStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,62 +937,64 @@ func (x *JoinLiveRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinLiveRoomResponse.ProtoReflect.Descriptor instead.
-func (*JoinLiveRoomResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveStreamResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *JoinLiveRoomResponse) GetCode() int32 {
+func (x *GetLiveStreamResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *JoinLiveRoomResponse) GetMessage() string {
+func (x *GetLiveStreamResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *JoinLiveRoomResponse) GetRequestId() string {
+func (x *GetLiveStreamResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *JoinLiveRoomResponse) GetViewer() *LiveViewer {
+func (x *GetLiveStreamResponse) GetStream() *LiveStream {
 	if x != nil {
-		return x.Viewer
+		return x.Stream
 	}
 	return nil
 }
 
-type LeaveLiveRoomRequest struct {
+type GetLiveListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，观看者取自authorization元数据中的访问令牌，未登录时只返回公开直播
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CategoryId    uint32                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaveLiveRoomRequest) Reset() {
-	*x = LeaveLiveRoomRequest{}
+func (x *GetLiveListRequest) Reset() {
+	*x = GetLiveListRequest{}
 	mi := &file_proto_live_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveLiveRoomRequest) String() string {
+func (x *GetLiveListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveLiveRoomRequest) ProtoMessage() {}
+func (*GetLiveListRequest) ProtoMessage() {}
 
-func (x *LeaveLiveRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLiveListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,55 +1006,72 @@ func (x *LeaveLiveRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveLiveRoomRequest.ProtoReflect.Descriptor instead.
-func (*LeaveLiveRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveListRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveListRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LeaveLiveRoomRequest) GetUserId() uint64 {
+func (x *GetLiveListRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *LeaveLiveRoomRequest) GetStreamId() uint64 {
+func (x *GetLiveListRequest) GetPage() int32 {
 	if x != nil {
-		return x.StreamId
+		return x.Page
 	}
 	return 0
 }
 
-func (x *LeaveLiveRoomRequest) GetRequestId() string {
+func (x *GetLiveListRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RequestId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type LeaveLiveRoomResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+func (x *GetLiveListRequest) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetLiveListRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetLiveListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Streams       []*LiveStream          `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,6,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // 总页数，请求的页码超过总页数时返回空列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaveLiveRoomResponse) Reset() {
-	*x = LeaveLiveRoomResponse{}
+func (x *GetLiveListResponse) Reset() {
+	*x = GetLiveListResponse{}
 	mi := &file_proto_live_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveLiveRoomResponse) String() string {
+func (x *GetLiveListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveLiveRoomResponse) ProtoMessage() {}
+func (*GetLiveListResponse) ProtoMessage() {}
 
-func (x *LeaveLiveRoomResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLiveListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1062,57 +1083,77 @@ func (x *LeaveLiveRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveLiveRoomResponse.ProtoReflect.Descriptor instead.
-func (*LeaveLiveRoomResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveListResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveListResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LeaveLiveRoomResponse) GetCode() int32 {
+func (x *GetLiveListResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *LeaveLiveRoomResponse) GetMessage() string {
+func (x *GetLiveListResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *LeaveLiveRoomResponse) GetRequestId() string {
+func (x *GetLiveListResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveViewerListRequest struct {
+func (x *GetLiveListResponse) GetStreams() []*LiveStream {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *GetLiveListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLiveListResponse) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type GetHotLiveListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveViewerListRequest) Reset() {
-	*x = GetLiveViewerListRequest{}
+func (x *GetHotLiveListRequest) Reset() {
+	*x = GetHotLiveListRequest{}
 	mi := &file_proto_live_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveViewerListRequest) String() string {
+func (x *GetHotLiveListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveViewerListRequest) ProtoMessage() {}
+func (*GetHotLiveListRequest) ProtoMessage() {}
 
-func (x *GetLiveViewerListRequest) ProtoReflect() protoreflect.Message {
+func (x *GetHotLiveListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,71 +1165,64 @@ func (x *GetLiveViewerListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveViewerListRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveViewerListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHotLiveListRequest.ProtoReflect.Descriptor instead.
+func (*GetHotLiveListRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetLiveViewerListRequest) GetUserId() uint64 {
+func (x *GetHotLiveListRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetLiveViewerListRequest) GetStreamId() uint64 {
-	if x != nil {
-		return x.StreamId
-	}
-	return 0
-}
-
-func (x *GetLiveViewerListRequest) GetPage() int32 {
+func (x *GetHotLiveListRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetLiveViewerListRequest) GetPageSize() int32 {
+func (x *GetHotLiveListRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetLiveViewerListRequest) GetRequestId() string {
+func (x *GetHotLiveListRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveViewerListResponse struct {
+type GetHotLiveListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Viewers       []*LiveViewer          `protobuf:"bytes,4,rep,name=viewers,proto3" json:"viewers,omitempty"`
+	Streams       []*LiveStream          `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
 	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveViewerListResponse) Reset() {
-	*x = GetLiveViewerListResponse{}
+func (x *GetHotLiveListResponse) Reset() {
+	*x = GetHotLiveListResponse{}
 	mi := &file_proto_live_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveViewerListResponse) String() string {
+func (x *GetHotLiveListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveViewerListResponse) ProtoMessage() {}
+func (*GetHotLiveListResponse) ProtoMessage() {}
 
-func (x *GetLiveViewerListResponse) ProtoReflect() protoreflect.Message {
+func (x *GetHotLiveListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,72 +1234,71 @@ func (x *GetLiveViewerListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveViewerListResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveViewerListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHotLiveListResponse.ProtoReflect.Descriptor instead.
+func (*GetHotLiveListResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetLiveViewerListResponse) GetCode() int32 {
+func (x *GetHotLiveListResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLiveViewerListResponse) GetMessage() string {
+func (x *GetHotLiveListResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLiveViewerListResponse) GetRequestId() string {
+func (x *GetHotLiveListResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLiveViewerListResponse) GetViewers() []*LiveViewer {
+func (x *GetHotLiveListResponse) GetStreams() []*LiveStream {
 	if x != nil {
-		return x.Viewers
+		return x.Streams
 	}
 	return nil
 }
 
-func (x *GetLiveViewerListResponse) GetTotal() int64 {
+func (x *GetHotLiveListResponse) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-// 聊天消息相关
-type SendLiveChatRequest struct {
+// 直播间相关
+type JoinLiveRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，用户取自authorization元数据中的访问令牌
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"` // 房间密码，直播设置了密码时必填
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendLiveChatRequest) Reset() {
-	*x = SendLiveChatRequest{}
+func (x *JoinLiveRoomRequest) Reset() {
+	*x = JoinLiveRoomRequest{}
 	mi := &file_proto_live_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendLiveChatRequest) String() string {
+func (x *JoinLiveRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendLiveChatRequest) ProtoMessage() {}
+func (*JoinLiveRoomRequest) ProtoMessage() {}
 
-func (x *SendLiveChatRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinLiveRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1277,70 +1310,63 @@ func (x *SendLiveChatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*SendLiveChatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinLiveRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinLiveRoomRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SendLiveChatRequest) GetUserId() uint64 {
+func (x *JoinLiveRoomRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SendLiveChatRequest) GetStreamId() uint64 {
+func (x *JoinLiveRoomRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *SendLiveChatRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *SendLiveChatRequest) GetContentType() string {
+func (x *JoinLiveRoomRequest) GetRequestId() string {
 	if x != nil {
-		return x.ContentType
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *SendLiveChatRequest) GetRequestId() string {
+func (x *JoinLiveRoomRequest) GetPassword() string {
 	if x != nil {
-		return x.RequestId
+		return x.Password
 	}
 	return ""
 }
 
-type SendLiveChatResponse struct {
+type JoinLiveRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Chat          *LiveChat              `protobuf:"bytes,4,opt,name=chat,proto3" json:"chat,omitempty"`
+	Viewer        *LiveViewer            `protobuf:"bytes,4,opt,name=viewer,proto3" json:"viewer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendLiveChatResponse) Reset() {
-	*x = SendLiveChatResponse{}
+func (x *JoinLiveRoomResponse) Reset() {
+	*x = JoinLiveRoomResponse{}
 	mi := &file_proto_live_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendLiveChatResponse) String() string {
+func (x *JoinLiveRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendLiveChatResponse) ProtoMessage() {}
+func (*JoinLiveRoomResponse) ProtoMessage() {}
 
-func (x *SendLiveChatResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinLiveRoomResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1352,64 +1378,62 @@ func (x *SendLiveChatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*SendLiveChatResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinLiveRoomResponse.ProtoReflect.Descriptor instead.
+func (*JoinLiveRoomResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SendLiveChatResponse) GetCode() int32 {
+func (x *JoinLiveRoomResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *SendLiveChatResponse) GetMessage() string {
+func (x *JoinLiveRoomResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *SendLiveChatResponse) GetRequestId() string {
+func (x *JoinLiveRoomResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *SendLiveChatResponse) GetChat() *LiveChat {
+func (x *JoinLiveRoomResponse) GetViewer() *LiveViewer {
 	if x != nil {
-		return x.Chat
+		return x.Viewer
 	}
 	return nil
 }
 
-type GetLiveChatListRequest struct {
+type LeaveLiveRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，用户取自authorization元数据中的访问令牌
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveChatListRequest) Reset() {
-	*x = GetLiveChatListRequest{}
+func (x *LeaveLiveRoomRequest) Reset() {
+	*x = LeaveLiveRoomRequest{}
 	mi := &file_proto_live_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveChatListRequest) String() string {
+func (x *LeaveLiveRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveChatListRequest) ProtoMessage() {}
+func (*LeaveLiveRoomRequest) ProtoMessage() {}
 
-func (x *GetLiveChatListRequest) ProtoReflect() protoreflect.Message {
+func (x *LeaveLiveRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1421,71 +1445,55 @@ func (x *GetLiveChatListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveChatListRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveChatListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveLiveRoomRequest.ProtoReflect.Descriptor instead.
+func (*LeaveLiveRoomRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetLiveChatListRequest) GetUserId() uint64 {
+func (x *LeaveLiveRoomRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetLiveChatListRequest) GetStreamId() uint64 {
+func (x *LeaveLiveRoomRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *GetLiveChatListRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetLiveChatListRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetLiveChatListRequest) GetRequestId() string {
+func (x *LeaveLiveRoomRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveChatListResponse struct {
+type LeaveLiveRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Chats         []*LiveChat            `protobuf:"bytes,4,rep,name=chats,proto3" json:"chats,omitempty"`
-	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveChatListResponse) Reset() {
-	*x = GetLiveChatListResponse{}
+func (x *LeaveLiveRoomResponse) Reset() {
+	*x = LeaveLiveRoomResponse{}
 	mi := &file_proto_live_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveChatListResponse) String() string {
+func (x *LeaveLiveRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveChatListResponse) ProtoMessage() {}
+func (*LeaveLiveRoomResponse) ProtoMessage() {}
 
-func (x *GetLiveChatListResponse) ProtoReflect() protoreflect.Message {
+func (x *LeaveLiveRoomResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1497,73 +1505,56 @@ func (x *GetLiveChatListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveChatListResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveChatListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveLiveRoomResponse.ProtoReflect.Descriptor instead.
+func (*LeaveLiveRoomResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetLiveChatListResponse) GetCode() int32 {
+func (x *LeaveLiveRoomResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLiveChatListResponse) GetMessage() string {
+func (x *LeaveLiveRoomResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLiveChatListResponse) GetRequestId() string {
+func (x *LeaveLiveRoomResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLiveChatListResponse) GetChats() []*LiveChat {
-	if x != nil {
-		return x.Chats
-	}
-	return nil
-}
-
-func (x *GetLiveChatListResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// 礼物相关
-type SendLiveGiftRequest struct {
+// 观看者心跳，客户端按interval间隔上报，超时未上报视为已离开直播间
+type HeartbeatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	GiftId        uint32                 `protobuf:"varint,3,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
-	GiftCount     uint32                 `protobuf:"varint,4,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendLiveGiftRequest) Reset() {
-	*x = SendLiveGiftRequest{}
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
 	mi := &file_proto_live_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendLiveGiftRequest) String() string {
+func (x *HeartbeatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendLiveGiftRequest) ProtoMessage() {}
+func (*HeartbeatRequest) ProtoMessage() {}
 
-func (x *SendLiveGiftRequest) ProtoReflect() protoreflect.Message {
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1575,77 +1566,56 @@ func (x *SendLiveGiftRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendLiveGiftRequest.ProtoReflect.Descriptor instead.
-func (*SendLiveGiftRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SendLiveGiftRequest) GetUserId() uint64 {
+func (x *HeartbeatRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SendLiveGiftRequest) GetStreamId() uint64 {
+func (x *HeartbeatRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *SendLiveGiftRequest) GetGiftId() uint32 {
-	if x != nil {
-		return x.GiftId
-	}
-	return 0
-}
-
-func (x *SendLiveGiftRequest) GetGiftCount() uint32 {
-	if x != nil {
-		return x.GiftCount
-	}
-	return 0
-}
-
-func (x *SendLiveGiftRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *SendLiveGiftRequest) GetRequestId() string {
+func (x *HeartbeatRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type SendLiveGiftResponse struct {
+type HeartbeatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Gift          *LiveGift              `protobuf:"bytes,4,opt,name=gift,proto3" json:"gift,omitempty"`
+	Interval      int32                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"` // 建议的下次心跳间隔(秒)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendLiveGiftResponse) Reset() {
-	*x = SendLiveGiftResponse{}
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
 	mi := &file_proto_live_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendLiveGiftResponse) String() string {
+func (x *HeartbeatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendLiveGiftResponse) ProtoMessage() {}
+func (*HeartbeatResponse) ProtoMessage() {}
 
-func (x *SendLiveGiftResponse) ProtoReflect() protoreflect.Message {
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1657,64 +1627,63 @@ func (x *SendLiveGiftResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendLiveGiftResponse.ProtoReflect.Descriptor instead.
-func (*SendLiveGiftResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SendLiveGiftResponse) GetCode() int32 {
+func (x *HeartbeatResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *SendLiveGiftResponse) GetMessage() string {
+func (x *HeartbeatResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *SendLiveGiftResponse) GetRequestId() string {
+func (x *HeartbeatResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *SendLiveGiftResponse) GetGift() *LiveGift {
+func (x *HeartbeatResponse) GetInterval() int32 {
 	if x != nil {
-		return x.Gift
+		return x.Interval
 	}
-	return nil
+	return 0
 }
 
-type GetLiveGiftListRequest struct {
+// 直播间实时事件订阅，一次订阅接收全部事件类型
+type SubscribeLiveEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveGiftListRequest) Reset() {
-	*x = GetLiveGiftListRequest{}
+func (x *SubscribeLiveEventsRequest) Reset() {
+	*x = SubscribeLiveEventsRequest{}
 	mi := &file_proto_live_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveGiftListRequest) String() string {
+func (x *SubscribeLiveEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveGiftListRequest) ProtoMessage() {}
+func (*SubscribeLiveEventsRequest) ProtoMessage() {}
 
-func (x *GetLiveGiftListRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeLiveEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1726,71 +1695,58 @@ func (x *GetLiveGiftListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveGiftListRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveGiftListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeLiveEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeLiveEventsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetLiveGiftListRequest) GetUserId() uint64 {
+func (x *SubscribeLiveEventsRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetLiveGiftListRequest) GetStreamId() uint64 {
+func (x *SubscribeLiveEventsRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *GetLiveGiftListRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetLiveGiftListRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetLiveGiftListRequest) GetRequestId() string {
+func (x *SubscribeLiveEventsRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveGiftListResponse struct {
+// 直播间实时事件，type取值chat/gift/like/join/leave，data为对应类型的JSON事件数据
+type LiveEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Gifts         []*LiveGift            `protobuf:"bytes,4,rep,name=gifts,proto3" json:"gifts,omitempty"`
-	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 毫秒时间戳
+	Data          string                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveGiftListResponse) Reset() {
-	*x = GetLiveGiftListResponse{}
+func (x *LiveEvent) Reset() {
+	*x = LiveEvent{}
 	mi := &file_proto_live_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveGiftListResponse) String() string {
+func (x *LiveEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveGiftListResponse) ProtoMessage() {}
+func (*LiveEvent) ProtoMessage() {}
 
-func (x *GetLiveGiftListResponse) ProtoReflect() protoreflect.Message {
+func (x *LiveEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1802,70 +1758,71 @@ func (x *GetLiveGiftListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveGiftListResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveGiftListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LiveEvent.ProtoReflect.Descriptor instead.
+func (*LiveEvent) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetLiveGiftListResponse) GetCode() int32 {
+func (x *LiveEvent) GetType() string {
 	if x != nil {
-		return x.Code
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLiveGiftListResponse) GetMessage() string {
+func (x *LiveEvent) GetStreamId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLiveGiftListResponse) GetRequestId() string {
+func (x *LiveEvent) GetUserId() uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLiveGiftListResponse) GetGifts() []*LiveGift {
+func (x *LiveEvent) GetTimestamp() int64 {
 	if x != nil {
-		return x.Gifts
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLiveGiftListResponse) GetTotal() int64 {
+func (x *LiveEvent) GetData() string {
 	if x != nil {
-		return x.Total
+		return x.Data
 	}
-	return 0
+	return ""
 }
 
-// 互动相关
-type LikeLiveRequest struct {
+type GetLiveViewerListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeLiveRequest) Reset() {
-	*x = LikeLiveRequest{}
+func (x *GetLiveViewerListRequest) Reset() {
+	*x = GetLiveViewerListRequest{}
 	mi := &file_proto_live_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeLiveRequest) String() string {
+func (x *GetLiveViewerListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeLiveRequest) ProtoMessage() {}
+func (*GetLiveViewerListRequest) ProtoMessage() {}
 
-func (x *LikeLiveRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLiveViewerListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1877,56 +1834,72 @@ func (x *LikeLiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeLiveRequest.ProtoReflect.Descriptor instead.
-func (*LikeLiveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveViewerListRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveViewerListRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *LikeLiveRequest) GetUserId() uint64 {
+func (x *GetLiveViewerListRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *LikeLiveRequest) GetStreamId() uint64 {
+func (x *GetLiveViewerListRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *LikeLiveRequest) GetRequestId() string {
+func (x *GetLiveViewerListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetLiveViewerListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLiveViewerListRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type LikeLiveResponse struct {
+type GetLiveViewerListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	LikeCount     uint64                 `protobuf:"varint,4,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Viewers       []*LiveViewer          `protobuf:"bytes,4,rep,name=viewers,proto3" json:"viewers,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,6,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // 总页数，请求的页码超过总页数时返回空列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeLiveResponse) Reset() {
-	*x = LikeLiveResponse{}
+func (x *GetLiveViewerListResponse) Reset() {
+	*x = GetLiveViewerListResponse{}
 	mi := &file_proto_live_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeLiveResponse) String() string {
+func (x *GetLiveViewerListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeLiveResponse) ProtoMessage() {}
+func (*GetLiveViewerListResponse) ProtoMessage() {}
 
-func (x *LikeLiveResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLiveViewerListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1938,65 +1911,79 @@ func (x *LikeLiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeLiveResponse.ProtoReflect.Descriptor instead.
-func (*LikeLiveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveViewerListResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveViewerListResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *LikeLiveResponse) GetCode() int32 {
+func (x *GetLiveViewerListResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *LikeLiveResponse) GetMessage() string {
+func (x *GetLiveViewerListResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *LikeLiveResponse) GetRequestId() string {
+func (x *GetLiveViewerListResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LikeLiveResponse) GetLikeCount() uint64 {
+func (x *GetLiveViewerListResponse) GetViewers() []*LiveViewer {
 	if x != nil {
-		return x.LikeCount
+		return x.Viewers
+	}
+	return nil
+}
+
+func (x *GetLiveViewerListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-// 搜索相关
-type SearchLiveRequest struct {
+func (x *GetLiveViewerListResponse) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// 聊天消息相关
+type SendLiveChatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchLiveRequest) Reset() {
-	*x = SearchLiveRequest{}
+func (x *SendLiveChatRequest) Reset() {
+	*x = SendLiveChatRequest{}
 	mi := &file_proto_live_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchLiveRequest) String() string {
+func (x *SendLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchLiveRequest) ProtoMessage() {}
+func (*SendLiveChatRequest) ProtoMessage() {}
 
-func (x *SearchLiveRequest) ProtoReflect() protoreflect.Message {
+func (x *SendLiveChatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2008,71 +1995,70 @@ func (x *SearchLiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchLiveRequest.ProtoReflect.Descriptor instead.
-func (*SearchLiveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*SendLiveChatRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SearchLiveRequest) GetUserId() uint64 {
+func (x *SendLiveChatRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SearchLiveRequest) GetKeyword() string {
+func (x *SendLiveChatRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.Keyword
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchLiveRequest) GetPage() int32 {
+func (x *SendLiveChatRequest) GetContent() string {
 	if x != nil {
-		return x.Page
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchLiveRequest) GetPageSize() int32 {
+func (x *SendLiveChatRequest) GetContentType() string {
 	if x != nil {
-		return x.PageSize
+		return x.ContentType
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchLiveRequest) GetRequestId() string {
+func (x *SendLiveChatRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type SearchLiveResponse struct {
+type SendLiveChatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Streams       []*LiveStream          `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
-	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Chat          *LiveChat              `protobuf:"bytes,4,opt,name=chat,proto3" json:"chat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchLiveResponse) Reset() {
-	*x = SearchLiveResponse{}
+func (x *SendLiveChatResponse) Reset() {
+	*x = SendLiveChatResponse{}
 	mi := &file_proto_live_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchLiveResponse) String() string {
+func (x *SendLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchLiveResponse) ProtoMessage() {}
+func (*SendLiveChatResponse) ProtoMessage() {}
 
-func (x *SearchLiveResponse) ProtoReflect() protoreflect.Message {
+func (x *SendLiveChatResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2084,68 +2070,65 @@ func (x *SearchLiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchLiveResponse.ProtoReflect.Descriptor instead.
-func (*SearchLiveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*SendLiveChatResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SearchLiveResponse) GetCode() int32 {
+func (x *SendLiveChatResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *SearchLiveResponse) GetMessage() string {
+func (x *SendLiveChatResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *SearchLiveResponse) GetRequestId() string {
+func (x *SendLiveChatResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *SearchLiveResponse) GetStreams() []*LiveStream {
+func (x *SendLiveChatResponse) GetChat() *LiveChat {
 	if x != nil {
-		return x.Streams
+		return x.Chat
 	}
 	return nil
 }
 
-func (x *SearchLiveResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type GetLiveCategoriesRequest struct {
+type GetLiveChatListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OldestFirst   bool                   `protobuf:"varint,6,opt,name=oldest_first,json=oldestFirst,proto3" json:"oldest_first,omitempty"` // true-按发送时间正序(回放)，默认最新消息在前
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveCategoriesRequest) Reset() {
-	*x = GetLiveCategoriesRequest{}
+func (x *GetLiveChatListRequest) Reset() {
+	*x = GetLiveChatListRequest{}
 	mi := &file_proto_live_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveCategoriesRequest) String() string {
+func (x *GetLiveChatListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveCategoriesRequest) ProtoMessage() {}
+func (*GetLiveChatListRequest) ProtoMessage() {}
 
-func (x *GetLiveCategoriesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLiveChatListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,49 +2140,79 @@ func (x *GetLiveCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveCategoriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveChatListRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveChatListRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetLiveCategoriesRequest) GetUserId() uint64 {
+func (x *GetLiveChatListRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetLiveCategoriesRequest) GetRequestId() string {
+func (x *GetLiveChatListRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *GetLiveChatListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetLiveChatListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLiveChatListRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveCategoriesResponse struct {
+func (x *GetLiveChatListRequest) GetOldestFirst() bool {
+	if x != nil {
+		return x.OldestFirst
+	}
+	return false
+}
+
+type GetLiveChatListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Categories    []*LiveCategory        `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Chats         []*LiveChat            `protobuf:"bytes,4,rep,name=chats,proto3" json:"chats,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,6,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // 总页数，请求的页码超过总页数时返回空列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveCategoriesResponse) Reset() {
-	*x = GetLiveCategoriesResponse{}
+func (x *GetLiveChatListResponse) Reset() {
+	*x = GetLiveChatListResponse{}
 	mi := &file_proto_live_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveCategoriesResponse) String() string {
+func (x *GetLiveChatListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveCategoriesResponse) ProtoMessage() {}
+func (*GetLiveChatListResponse) ProtoMessage() {}
 
-func (x *GetLiveCategoriesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLiveChatListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2211,63 +2224,80 @@ func (x *GetLiveCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveCategoriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLiveChatListResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveChatListResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetLiveCategoriesResponse) GetCode() int32 {
+func (x *GetLiveChatListResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLiveCategoriesResponse) GetMessage() string {
+func (x *GetLiveChatListResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLiveCategoriesResponse) GetRequestId() string {
+func (x *GetLiveChatListResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLiveCategoriesResponse) GetCategories() []*LiveCategory {
+func (x *GetLiveChatListResponse) GetChats() []*LiveChat {
 	if x != nil {
-		return x.Categories
+		return x.Chats
 	}
 	return nil
 }
 
-// 统计相关
-type GetLiveStatsRequest struct {
+func (x *GetLiveChatListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLiveChatListResponse) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// 禁言相关，操作者(主播或管理员)取自访问令牌
+type MuteViewerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，操作人取自authorization元数据中的访问令牌
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TargetUserId  uint64                 `protobuf:"varint,3,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Duration      uint32                 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"` // 禁言秒数，0表示本场直播内永久禁言
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveStatsRequest) Reset() {
-	*x = GetLiveStatsRequest{}
+func (x *MuteViewerRequest) Reset() {
+	*x = MuteViewerRequest{}
 	mi := &file_proto_live_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveStatsRequest) String() string {
+func (x *MuteViewerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveStatsRequest) ProtoMessage() {}
+func (*MuteViewerRequest) ProtoMessage() {}
 
-func (x *GetLiveStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *MuteViewerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2279,56 +2309,76 @@ func (x *GetLiveStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetLiveStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MuteViewerRequest.ProtoReflect.Descriptor instead.
+func (*MuteViewerRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetLiveStatsRequest) GetUserId() uint64 {
+func (x *MuteViewerRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetLiveStatsRequest) GetStreamId() uint64 {
+func (x *MuteViewerRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *GetLiveStatsRequest) GetRequestId() string {
+func (x *MuteViewerRequest) GetTargetUserId() uint64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *MuteViewerRequest) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MuteViewerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MuteViewerRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLiveStatsResponse struct {
+type MuteViewerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Stats         *LiveStats             `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLiveStatsResponse) Reset() {
-	*x = GetLiveStatsResponse{}
+func (x *MuteViewerResponse) Reset() {
+	*x = MuteViewerResponse{}
 	mi := &file_proto_live_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLiveStatsResponse) String() string {
+func (x *MuteViewerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiveStatsResponse) ProtoMessage() {}
+func (*MuteViewerResponse) ProtoMessage() {}
 
-func (x *GetLiveStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *MuteViewerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2340,62 +2390,56 @@ func (x *GetLiveStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiveStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetLiveStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MuteViewerResponse.ProtoReflect.Descriptor instead.
+func (*MuteViewerResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetLiveStatsResponse) GetCode() int32 {
+func (x *MuteViewerResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLiveStatsResponse) GetMessage() string {
+func (x *MuteViewerResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLiveStatsResponse) GetRequestId() string {
+func (x *MuteViewerResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLiveStatsResponse) GetStats() *LiveStats {
-	if x != nil {
-		return x.Stats
-	}
-	return nil
-}
-
-type GetLivePlaybackRequest struct {
+type UnmuteViewerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，操作人取自authorization元数据中的访问令牌
 	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TargetUserId  uint64                 `protobuf:"varint,3,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLivePlaybackRequest) Reset() {
-	*x = GetLivePlaybackRequest{}
+func (x *UnmuteViewerRequest) Reset() {
+	*x = UnmuteViewerRequest{}
 	mi := &file_proto_live_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLivePlaybackRequest) String() string {
+func (x *UnmuteViewerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLivePlaybackRequest) ProtoMessage() {}
+func (*UnmuteViewerRequest) ProtoMessage() {}
 
-func (x *GetLivePlaybackRequest) ProtoReflect() protoreflect.Message {
+func (x *UnmuteViewerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2407,56 +2451,62 @@ func (x *GetLivePlaybackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLivePlaybackRequest.ProtoReflect.Descriptor instead.
-func (*GetLivePlaybackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnmuteViewerRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteViewerRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetLivePlaybackRequest) GetUserId() uint64 {
+func (x *UnmuteViewerRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetLivePlaybackRequest) GetStreamId() uint64 {
+func (x *UnmuteViewerRequest) GetStreamId() uint64 {
 	if x != nil {
 		return x.StreamId
 	}
 	return 0
 }
 
-func (x *GetLivePlaybackRequest) GetRequestId() string {
+func (x *UnmuteViewerRequest) GetTargetUserId() uint64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *UnmuteViewerRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type GetLivePlaybackResponse struct {
+type UnmuteViewerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Playback      *LivePlayback          `protobuf:"bytes,4,opt,name=playback,proto3" json:"playback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLivePlaybackResponse) Reset() {
-	*x = GetLivePlaybackResponse{}
+func (x *UnmuteViewerResponse) Reset() {
+	*x = UnmuteViewerResponse{}
 	mi := &file_proto_live_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLivePlaybackResponse) String() string {
+func (x *UnmuteViewerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLivePlaybackResponse) ProtoMessage() {}
+func (*UnmuteViewerResponse) ProtoMessage() {}
 
-func (x *GetLivePlaybackResponse) ProtoReflect() protoreflect.Message {
+func (x *UnmuteViewerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2468,78 +2518,59 @@ func (x *GetLivePlaybackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLivePlaybackResponse.ProtoReflect.Descriptor instead.
-func (*GetLivePlaybackResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnmuteViewerResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteViewerResponse) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetLivePlaybackResponse) GetCode() int32 {
+func (x *UnmuteViewerResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetLivePlaybackResponse) GetMessage() string {
+func (x *UnmuteViewerResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetLivePlaybackResponse) GetRequestId() string {
+func (x *UnmuteViewerResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetLivePlaybackResponse) GetPlayback() *LivePlayback {
-	if x != nil {
-		return x.Playback
-	}
-	return nil
-}
-
-// 数据模型
-type LiveStream struct {
+// 礼物相关
+type SendLiveGiftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CategoryId    uint32                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	StreamUrl     string                 `protobuf:"bytes,7,opt,name=stream_url,json=streamUrl,proto3" json:"stream_url,omitempty"`
-	PlaybackUrl   string                 `protobuf:"bytes,8,opt,name=playback_url,json=playbackUrl,proto3" json:"playback_url,omitempty"`
-	CoverImage    string                 `protobuf:"bytes,9,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
-	ViewerCount   uint32                 `protobuf:"varint,10,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
-	LikeCount     uint32                 `protobuf:"varint,11,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	GiftCount     uint32                 `protobuf:"varint,12,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
-	GiftValue     uint64                 `protobuf:"varint,13,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
-	Duration      uint32                 `protobuf:"varint,14,opt,name=duration,proto3" json:"duration,omitempty"`
-	StartTime     int64                  `protobuf:"varint,15,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       int64                  `protobuf:"varint,16,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，用户取自authorization元数据中的访问令牌
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	GiftId        uint32                 `protobuf:"varint,3,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
+	GiftCount     uint32                 `protobuf:"varint,4,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveStream) Reset() {
-	*x = LiveStream{}
+func (x *SendLiveGiftRequest) Reset() {
+	*x = SendLiveGiftRequest{}
 	mi := &file_proto_live_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveStream) String() string {
+func (x *SendLiveGiftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveStream) ProtoMessage() {}
+func (*SendLiveGiftRequest) ProtoMessage() {}
 
-func (x *LiveStream) ProtoReflect() protoreflect.Message {
+func (x *SendLiveGiftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_live_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2551,172 +2582,256 @@ func (x *LiveStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveStream.ProtoReflect.Descriptor instead.
-func (*LiveStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendLiveGiftRequest.ProtoReflect.Descriptor instead.
+func (*SendLiveGiftRequest) Descriptor() ([]byte, []int) {
 	return file_proto_live_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *LiveStream) GetId() uint64 {
+func (x *SendLiveGiftRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *LiveStream) GetUserId() uint64 {
+func (x *SendLiveGiftRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.StreamId
 	}
 	return 0
 }
 
-func (x *LiveStream) GetTitle() string {
+func (x *SendLiveGiftRequest) GetGiftId() uint32 {
 	if x != nil {
-		return x.Title
+		return x.GiftId
+	}
+	return 0
+}
+
+func (x *SendLiveGiftRequest) GetGiftCount() uint32 {
+	if x != nil {
+		return x.GiftCount
+	}
+	return 0
+}
+
+func (x *SendLiveGiftRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *LiveStream) GetDescription() string {
+func (x *SendLiveGiftRequest) GetRequestId() string {
 	if x != nil {
-		return x.Description
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LiveStream) GetCategoryId() uint32 {
+type SendLiveGiftResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId       string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Gift            *LiveGift              `protobuf:"bytes,4,opt,name=gift,proto3" json:"gift,omitempty"`
+	ComboCount      uint32                 `protobuf:"varint,5,opt,name=combo_count,json=comboCount,proto3" json:"combo_count,omitempty"`                // 连击次数
+	ComboMultiplier uint32                 `protobuf:"varint,6,opt,name=combo_multiplier,json=comboMultiplier,proto3" json:"combo_multiplier,omitempty"` // 连击倍数
+	DisplayValue    uint64                 `protobuf:"varint,7,opt,name=display_value,json=displayValue,proto3" json:"display_value,omitempty"`          // 展示价值(总价值*连击倍数)，不影响实际扣费
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SendLiveGiftResponse) Reset() {
+	*x = SendLiveGiftResponse{}
+	mi := &file_proto_live_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLiveGiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLiveGiftResponse) ProtoMessage() {}
+
+func (x *SendLiveGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[37]
 	if x != nil {
-		return x.CategoryId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiveStream) GetStatus() string {
+// Deprecated: Use SendLiveGiftResponse.ProtoReflect.Descriptor instead.
+func (*SendLiveGiftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SendLiveGiftResponse) GetCode() int32 {
 	if x != nil {
-		return x.Status
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *LiveStream) GetStreamUrl() string {
+func (x *SendLiveGiftResponse) GetMessage() string {
 	if x != nil {
-		return x.StreamUrl
+		return x.Message
 	}
 	return ""
 }
 
-func (x *LiveStream) GetPlaybackUrl() string {
+func (x *SendLiveGiftResponse) GetRequestId() string {
 	if x != nil {
-		return x.PlaybackUrl
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LiveStream) GetCoverImage() string {
+func (x *SendLiveGiftResponse) GetGift() *LiveGift {
 	if x != nil {
-		return x.CoverImage
+		return x.Gift
 	}
-	return ""
+	return nil
 }
 
-func (x *LiveStream) GetViewerCount() uint32 {
+func (x *SendLiveGiftResponse) GetComboCount() uint32 {
 	if x != nil {
-		return x.ViewerCount
+		return x.ComboCount
 	}
 	return 0
 }
 
-func (x *LiveStream) GetLikeCount() uint32 {
+func (x *SendLiveGiftResponse) GetComboMultiplier() uint32 {
 	if x != nil {
-		return x.LikeCount
+		return x.ComboMultiplier
 	}
 	return 0
 }
 
-func (x *LiveStream) GetGiftCount() uint32 {
+func (x *SendLiveGiftResponse) GetDisplayValue() uint64 {
 	if x != nil {
-		return x.GiftCount
+		return x.DisplayValue
 	}
 	return 0
 }
 
-func (x *LiveStream) GetGiftValue() uint64 {
+type GetLiveGiftListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"` // 分页游标，传上一页返回的next_cursor，不为空时忽略page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveGiftListRequest) Reset() {
+	*x = GetLiveGiftListRequest{}
+	mi := &file_proto_live_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveGiftListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveGiftListRequest) ProtoMessage() {}
+
+func (x *GetLiveGiftListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[38]
 	if x != nil {
-		return x.GiftValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiveStream) GetDuration() uint32 {
+// Deprecated: Use GetLiveGiftListRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveGiftListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetLiveGiftListRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Duration
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *LiveStream) GetStartTime() int64 {
+func (x *GetLiveGiftListRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.StartTime
+		return x.StreamId
 	}
 	return 0
 }
 
-func (x *LiveStream) GetEndTime() int64 {
+func (x *GetLiveGiftListRequest) GetPage() int32 {
 	if x != nil {
-		return x.EndTime
+		return x.Page
 	}
 	return 0
 }
 
-func (x *LiveStream) GetCreatedAt() int64 {
+func (x *GetLiveGiftListRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *LiveStream) GetUpdatedAt() int64 {
+func (x *GetLiveGiftListRequest) GetRequestId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type LiveRoom struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StreamId       uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CategoryId     uint32                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	ViewerCount    uint32                 `protobuf:"varint,7,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
-	MaxViewerCount uint32                 `protobuf:"varint,8,opt,name=max_viewer_count,json=maxViewerCount,proto3" json:"max_viewer_count,omitempty"`
-	LikeCount      uint32                 `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	GiftCount      uint32                 `protobuf:"varint,10,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
-	GiftValue      uint64                 `protobuf:"varint,11,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
-	ChatCount      uint32                 `protobuf:"varint,12,opt,name=chat_count,json=chatCount,proto3" json:"chat_count,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetLiveGiftListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
 }
 
-func (x *LiveRoom) Reset() {
-	*x = LiveRoom{}
-	mi := &file_proto_live_proto_msgTypes[37]
+type GetLiveGiftListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Gifts         []*LiveGift            `protobuf:"bytes,4,rep,name=gifts,proto3" json:"gifts,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标，没有更多时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveGiftListResponse) Reset() {
+	*x = GetLiveGiftListResponse{}
+	mi := &file_proto_live_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveRoom) String() string {
+func (x *GetLiveGiftListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveRoom) ProtoMessage() {}
+func (*GetLiveGiftListResponse) ProtoMessage() {}
 
-func (x *LiveRoom) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[37]
+func (x *GetLiveGiftListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2727,140 +2842,2774 @@ func (x *LiveRoom) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveRoom.ProtoReflect.Descriptor instead.
-func (*LiveRoom) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetLiveGiftListResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveGiftListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *LiveRoom) GetId() uint64 {
+func (x *GetLiveGiftListResponse) GetCode() int32 {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
 	return 0
 }
 
-func (x *LiveRoom) GetStreamId() uint64 {
+func (x *GetLiveGiftListResponse) GetMessage() string {
 	if x != nil {
-		return x.StreamId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveRoom) GetTitle() string {
+func (x *GetLiveGiftListResponse) GetRequestId() string {
 	if x != nil {
-		return x.Title
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LiveRoom) GetDescription() string {
+func (x *GetLiveGiftListResponse) GetGifts() []*LiveGift {
 	if x != nil {
-		return x.Description
+		return x.Gifts
 	}
-	return ""
+	return nil
 }
 
-func (x *LiveRoom) GetCategoryId() uint32 {
+func (x *GetLiveGiftListResponse) GetTotal() int64 {
 	if x != nil {
-		return x.CategoryId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *LiveRoom) GetStatus() string {
+func (x *GetLiveGiftListResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Status
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *LiveRoom) GetViewerCount() uint32 {
+type GetUserLiveGiftListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLiveGiftListRequest) Reset() {
+	*x = GetUserLiveGiftListRequest{}
+	mi := &file_proto_live_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLiveGiftListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLiveGiftListRequest) ProtoMessage() {}
+
+func (x *GetUserLiveGiftListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLiveGiftListRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLiveGiftListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUserLiveGiftListRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserLiveGiftListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserLiveGiftListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserLiveGiftListRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetUserLiveGiftListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Gifts         []*LiveGift            `protobuf:"bytes,4,rep,name=gifts,proto3" json:"gifts,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLiveGiftListResponse) Reset() {
+	*x = GetUserLiveGiftListResponse{}
+	mi := &file_proto_live_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLiveGiftListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLiveGiftListResponse) ProtoMessage() {}
+
+func (x *GetUserLiveGiftListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLiveGiftListResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLiveGiftListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserLiveGiftListResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetUserLiveGiftListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetUserLiveGiftListResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetUserLiveGiftListResponse) GetGifts() []*LiveGift {
+	if x != nil {
+		return x.Gifts
+	}
+	return nil
+}
+
+func (x *GetUserLiveGiftListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 互动相关
+type GetLiveGiftStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveGiftStatsRequest) Reset() {
+	*x = GetLiveGiftStatsRequest{}
+	mi := &file_proto_live_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveGiftStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveGiftStatsRequest) ProtoMessage() {}
+
+func (x *GetLiveGiftStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveGiftStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveGiftStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLiveGiftStatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLiveGiftStatsRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *GetLiveGiftStatsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetLiveGiftStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Stats         *GiftStats             `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveGiftStatsResponse) Reset() {
+	*x = GetLiveGiftStatsResponse{}
+	mi := &file_proto_live_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveGiftStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveGiftStatsResponse) ProtoMessage() {}
+
+func (x *GetLiveGiftStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveGiftStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveGiftStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetLiveGiftStatsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetLiveGiftStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLiveGiftStatsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetLiveGiftStatsResponse) GetStats() *GiftStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type LikeLiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeLiveRequest) Reset() {
+	*x = LikeLiveRequest{}
+	mi := &file_proto_live_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeLiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeLiveRequest) ProtoMessage() {}
+
+func (x *LikeLiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeLiveRequest.ProtoReflect.Descriptor instead.
+func (*LikeLiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LikeLiveRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikeLiveRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *LikeLiveRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type LikeLiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	LikeCount     uint64                 `protobuf:"varint,4,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeLiveResponse) Reset() {
+	*x = LikeLiveResponse{}
+	mi := &file_proto_live_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeLiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeLiveResponse) ProtoMessage() {}
+
+func (x *LikeLiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeLiveResponse.ProtoReflect.Descriptor instead.
+func (*LikeLiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LikeLiveResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *LikeLiveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LikeLiveResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *LikeLiveResponse) GetLikeCount() uint64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+// 搜索相关
+type SearchLiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，观看者取自authorization元数据中的访问令牌，未登录时只返回公开直播
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CategoryId    uint32                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 直播分类ID，为0时不限
+	MinViewers    uint32                 `protobuf:"varint,7,opt,name=min_viewers,json=minViewers,proto3" json:"min_viewers,omitempty"` // 当前在线观看人数下限，为0时不限
+	Language      string                 `protobuf:"bytes,8,opt,name=language,proto3" json:"language,omitempty"`                        // 直播语言，为空时不限
+	Region        string                 `protobuf:"bytes,9,opt,name=region,proto3" json:"region,omitempty"`                            // 直播地区，为空时不限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLiveRequest) Reset() {
+	*x = SearchLiveRequest{}
+	mi := &file_proto_live_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLiveRequest) ProtoMessage() {}
+
+func (x *SearchLiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLiveRequest.ProtoReflect.Descriptor instead.
+func (*SearchLiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchLiveRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchLiveRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchLiveRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchLiveRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchLiveRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SearchLiveRequest) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SearchLiveRequest) GetMinViewers() uint32 {
+	if x != nil {
+		return x.MinViewers
+	}
+	return 0
+}
+
+func (x *SearchLiveRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchLiveRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type SearchLiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Streams       []*LiveStream          `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLiveResponse) Reset() {
+	*x = SearchLiveResponse{}
+	mi := &file_proto_live_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLiveResponse) ProtoMessage() {}
+
+func (x *SearchLiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLiveResponse.ProtoReflect.Descriptor instead.
+func (*SearchLiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchLiveResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SearchLiveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchLiveResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SearchLiveResponse) GetStreams() []*LiveStream {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *SearchLiveResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetLiveCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveCategoriesRequest) Reset() {
+	*x = GetLiveCategoriesRequest{}
+	mi := &file_proto_live_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveCategoriesRequest) ProtoMessage() {}
+
+func (x *GetLiveCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetLiveCategoriesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLiveCategoriesRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetLiveCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Categories    []*LiveCategory        `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveCategoriesResponse) Reset() {
+	*x = GetLiveCategoriesResponse{}
+	mi := &file_proto_live_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveCategoriesResponse) ProtoMessage() {}
+
+func (x *GetLiveCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetLiveCategoriesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetLiveCategoriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLiveCategoriesResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetLiveCategoriesResponse) GetCategories() []*LiveCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// 统计相关
+type GetLiveStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveStatsRequest) Reset() {
+	*x = GetLiveStatsRequest{}
+	mi := &file_proto_live_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveStatsRequest) ProtoMessage() {}
+
+func (x *GetLiveStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetLiveStatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLiveStatsRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *GetLiveStatsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetLiveStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Stats         *LiveStats             `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveStatsResponse) Reset() {
+	*x = GetLiveStatsResponse{}
+	mi := &file_proto_live_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveStatsResponse) ProtoMessage() {}
+
+func (x *GetLiveStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetLiveStatsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetLiveStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLiveStatsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetLiveStatsResponse) GetStats() *LiveStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type RecomputeLiveStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，操作人取自authorization元数据中的访问令牌
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeLiveStatsRequest) Reset() {
+	*x = RecomputeLiveStatsRequest{}
+	mi := &file_proto_live_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeLiveStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeLiveStatsRequest) ProtoMessage() {}
+
+func (x *RecomputeLiveStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeLiveStatsRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeLiveStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RecomputeLiveStatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecomputeLiveStatsRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *RecomputeLiveStatsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RecomputeLiveStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Stats         *LiveStats             `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeLiveStatsResponse) Reset() {
+	*x = RecomputeLiveStatsResponse{}
+	mi := &file_proto_live_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeLiveStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeLiveStatsResponse) ProtoMessage() {}
+
+func (x *RecomputeLiveStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeLiveStatsResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeLiveStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RecomputeLiveStatsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RecomputeLiveStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecomputeLiveStatsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RecomputeLiveStatsResponse) GetStats() *LiveStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ForceStopLiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，操作人取自authorization元数据中的访问令牌
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // 处置原因，推送给直播间观看者并写入审核记录
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceStopLiveRequest) Reset() {
+	*x = ForceStopLiveRequest{}
+	mi := &file_proto_live_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceStopLiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceStopLiveRequest) ProtoMessage() {}
+
+func (x *ForceStopLiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceStopLiveRequest.ProtoReflect.Descriptor instead.
+func (*ForceStopLiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ForceStopLiveRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ForceStopLiveRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *ForceStopLiveRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ForceStopLiveRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type ForceStopLiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Stream        *LiveStream            `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceStopLiveResponse) Reset() {
+	*x = ForceStopLiveResponse{}
+	mi := &file_proto_live_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceStopLiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceStopLiveResponse) ProtoMessage() {}
+
+func (x *ForceStopLiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceStopLiveResponse.ProtoReflect.Descriptor instead.
+func (*ForceStopLiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ForceStopLiveResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ForceStopLiveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ForceStopLiveResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ForceStopLiveResponse) GetStream() *LiveStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+type GetDailyLeaderboardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyLeaderboardsRequest) Reset() {
+	*x = GetDailyLeaderboardsRequest{}
+	mi := &file_proto_live_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyLeaderboardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardsRequest) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardsRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetDailyLeaderboardsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDailyLeaderboardsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetDailyLeaderboardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`                                     // 榜单日期(20060102)
+	TopStreamers  []*LeaderboardEntry    `protobuf:"bytes,5,rep,name=top_streamers,json=topStreamers,proto3" json:"top_streamers,omitempty"` // 主播收礼价值排行，id为主播用户ID
+	TopStreams    []*LeaderboardEntry    `protobuf:"bytes,6,rep,name=top_streams,json=topStreams,proto3" json:"top_streams,omitempty"`       // 直播峰值在线排行，id为直播流ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyLeaderboardsResponse) Reset() {
+	*x = GetDailyLeaderboardsResponse{}
+	mi := &file_proto_live_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyLeaderboardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardsResponse) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardsResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDailyLeaderboardsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDailyLeaderboardsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardsResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardsResponse) GetTopStreamers() []*LeaderboardEntry {
+	if x != nil {
+		return x.TopStreamers
+	}
+	return nil
+}
+
+func (x *GetDailyLeaderboardsResponse) GetTopStreams() []*LeaderboardEntry {
+	if x != nil {
+		return x.TopStreams
+	}
+	return nil
+}
+
+type GetLivePlaybackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLivePlaybackRequest) Reset() {
+	*x = GetLivePlaybackRequest{}
+	mi := &file_proto_live_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLivePlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLivePlaybackRequest) ProtoMessage() {}
+
+func (x *GetLivePlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLivePlaybackRequest.ProtoReflect.Descriptor instead.
+func (*GetLivePlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetLivePlaybackRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLivePlaybackRequest) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *GetLivePlaybackRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetLivePlaybackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Playback      *LivePlayback          `protobuf:"bytes,4,opt,name=playback,proto3" json:"playback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLivePlaybackResponse) Reset() {
+	*x = GetLivePlaybackResponse{}
+	mi := &file_proto_live_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLivePlaybackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLivePlaybackResponse) ProtoMessage() {}
+
+func (x *GetLivePlaybackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLivePlaybackResponse.ProtoReflect.Descriptor instead.
+func (*GetLivePlaybackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetLivePlaybackResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetLivePlaybackResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLivePlaybackResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetLivePlaybackResponse) GetPlayback() *LivePlayback {
+	if x != nil {
+		return x.Playback
+	}
+	return nil
+}
+
+// 数据模型
+type LiveStream struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId    uint32                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	StreamUrl     string                 `protobuf:"bytes,7,opt,name=stream_url,json=streamUrl,proto3" json:"stream_url,omitempty"`
+	PlaybackUrl   string                 `protobuf:"bytes,8,opt,name=playback_url,json=playbackUrl,proto3" json:"playback_url,omitempty"`
+	CoverImage    string                 `protobuf:"bytes,9,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	ViewerCount   uint32                 `protobuf:"varint,10,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	LikeCount     uint32                 `protobuf:"varint,11,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	GiftCount     uint32                 `protobuf:"varint,12,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
+	GiftValue     uint64                 `protobuf:"varint,13,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
+	Duration      uint32                 `protobuf:"varint,14,opt,name=duration,proto3" json:"duration,omitempty"`
+	StartTime     int64                  `protobuf:"varint,15,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,16,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,19,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`          // 是否公开，私密直播仅对关注者可见
+	HasPassword   bool                   `protobuf:"varint,20,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"` // 是否需要房间密码
+	Language      string                 `protobuf:"bytes,21,opt,name=language,proto3" json:"language,omitempty"`                           // 直播语言，如zh、en
+	Region        string                 `protobuf:"bytes,22,opt,name=region,proto3" json:"region,omitempty"`                               // 直播地区，如CN、US
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveStream) Reset() {
+	*x = LiveStream{}
+	mi := &file_proto_live_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveStream) ProtoMessage() {}
+
+func (x *LiveStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveStream.ProtoReflect.Descriptor instead.
+func (*LiveStream) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LiveStream) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveStream) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LiveStream) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LiveStream) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LiveStream) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *LiveStream) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LiveStream) GetStreamUrl() string {
+	if x != nil {
+		return x.StreamUrl
+	}
+	return ""
+}
+
+func (x *LiveStream) GetPlaybackUrl() string {
+	if x != nil {
+		return x.PlaybackUrl
+	}
+	return ""
+}
+
+func (x *LiveStream) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *LiveStream) GetViewerCount() uint32 {
+	if x != nil {
+		return x.ViewerCount
+	}
+	return 0
+}
+
+func (x *LiveStream) GetLikeCount() uint32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *LiveStream) GetGiftCount() uint32 {
+	if x != nil {
+		return x.GiftCount
+	}
+	return 0
+}
+
+func (x *LiveStream) GetGiftValue() uint64 {
+	if x != nil {
+		return x.GiftValue
+	}
+	return 0
+}
+
+func (x *LiveStream) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *LiveStream) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *LiveStream) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *LiveStream) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LiveStream) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *LiveStream) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *LiveStream) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *LiveStream) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *LiveStream) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type LiveRoom struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamId       uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId     uint32                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ViewerCount    uint32                 `protobuf:"varint,7,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	MaxViewerCount uint32                 `protobuf:"varint,8,opt,name=max_viewer_count,json=maxViewerCount,proto3" json:"max_viewer_count,omitempty"`
+	LikeCount      uint32                 `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	GiftCount      uint32                 `protobuf:"varint,10,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
+	GiftValue      uint64                 `protobuf:"varint,11,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
+	ChatCount      uint32                 `protobuf:"varint,12,opt,name=chat_count,json=chatCount,proto3" json:"chat_count,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId         uint64                 `protobuf:"varint,15,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // 主播用户ID
+	RoomNumber     string                 `protobuf:"bytes,16,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`           // 房间号，主播首次开播时分配，之后不变
+	TotalStreams   uint32                 `protobuf:"varint,17,opt,name=total_streams,json=totalStreams,proto3" json:"total_streams,omitempty"`    // 累计直播次数
+	TotalDuration  uint64                 `protobuf:"varint,18,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"` // 已结束直播的累计时长(秒)
+	TotalViewers   uint64                 `protobuf:"varint,19,opt,name=total_viewers,json=totalViewers,proto3" json:"total_viewers,omitempty"`    // 已结束直播的累计观看人数，每场按去重用户计
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LiveRoom) Reset() {
+	*x = LiveRoom{}
+	mi := &file_proto_live_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveRoom) ProtoMessage() {}
+
+func (x *LiveRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveRoom.ProtoReflect.Descriptor instead.
+func (*LiveRoom) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LiveRoom) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LiveRoom) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LiveRoom) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LiveRoom) GetViewerCount() uint32 {
+	if x != nil {
+		return x.ViewerCount
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetMaxViewerCount() uint32 {
+	if x != nil {
+		return x.MaxViewerCount
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetLikeCount() uint32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetGiftCount() uint32 {
+	if x != nil {
+		return x.GiftCount
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetGiftValue() uint64 {
+	if x != nil {
+		return x.GiftValue
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetChatCount() uint32 {
+	if x != nil {
+		return x.ChatCount
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetRoomNumber() string {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return ""
+}
+
+func (x *LiveRoom) GetTotalStreams() uint32 {
+	if x != nil {
+		return x.TotalStreams
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetTotalDuration() uint64 {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return 0
+}
+
+func (x *LiveRoom) GetTotalViewers() uint64 {
+	if x != nil {
+		return x.TotalViewers
+	}
+	return 0
+}
+
+type LiveViewer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	JoinTime      int64                  `protobuf:"varint,6,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
+	LeaveTime     int64                  `protobuf:"varint,7,opt,name=leave_time,json=leaveTime,proto3" json:"leave_time,omitempty"`
+	Duration      uint32                 `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	IsMuted       bool                   `protobuf:"varint,9,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveViewer) Reset() {
+	*x = LiveViewer{}
+	mi := &file_proto_live_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveViewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveViewer) ProtoMessage() {}
+
+func (x *LiveViewer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveViewer.ProtoReflect.Descriptor instead.
+func (*LiveViewer) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LiveViewer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveViewer) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *LiveViewer) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LiveViewer) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *LiveViewer) GetUserAvatar() string {
+	if x != nil {
+		return x.UserAvatar
+	}
+	return ""
+}
+
+func (x *LiveViewer) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+func (x *LiveViewer) GetLeaveTime() int64 {
+	if x != nil {
+		return x.LeaveTime
+	}
+	return 0
+}
+
+func (x *LiveViewer) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *LiveViewer) GetIsMuted() bool {
+	if x != nil {
+		return x.IsMuted
+	}
+	return false
+}
+
+func (x *LiveViewer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type LiveChat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	IsSystem      bool                   `protobuf:"varint,8,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+	IsDeleted     bool                   `protobuf:"varint,9,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveChat) Reset() {
+	*x = LiveChat{}
+	mi := &file_proto_live_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveChat) ProtoMessage() {}
+
+func (x *LiveChat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveChat.ProtoReflect.Descriptor instead.
+func (*LiveChat) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LiveChat) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveChat) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *LiveChat) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LiveChat) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *LiveChat) GetUserAvatar() string {
+	if x != nil {
+		return x.UserAvatar
+	}
+	return ""
+}
+
+func (x *LiveChat) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LiveChat) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *LiveChat) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *LiveChat) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *LiveChat) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type LiveGift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	GiftId        uint32                 `protobuf:"varint,6,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
+	GiftName      string                 `protobuf:"bytes,7,opt,name=gift_name,json=giftName,proto3" json:"gift_name,omitempty"`
+	GiftIcon      string                 `protobuf:"bytes,8,opt,name=gift_icon,json=giftIcon,proto3" json:"gift_icon,omitempty"`
+	GiftPrice     uint64                 `protobuf:"varint,9,opt,name=gift_price,json=giftPrice,proto3" json:"gift_price,omitempty"`
+	GiftCount     uint32                 `protobuf:"varint,10,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
+	TotalValue    uint64                 `protobuf:"varint,11,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Message       string                 `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	EffectType    string                 `protobuf:"bytes,13,opt,name=effect_type,json=effectType,proto3" json:"effect_type,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EffectValue   string                 `protobuf:"bytes,15,opt,name=effect_value,json=effectValue,proto3" json:"effect_value,omitempty"` // 特效值，含义由effect_type决定
+	Status        uint32                 `protobuf:"varint,16,opt,name=status,proto3" json:"status,omitempty"`                             // 礼物记录状态:0-失败,1-成功,2-已退款
+	RefundedAt    int64                  `protobuf:"varint,17,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`   // 退款时间，未退款时为0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveGift) Reset() {
+	*x = LiveGift{}
+	mi := &file_proto_live_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveGift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveGift) ProtoMessage() {}
+
+func (x *LiveGift) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveGift.ProtoReflect.Descriptor instead.
+func (*LiveGift) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LiveGift) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveGift) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *LiveGift) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LiveGift) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *LiveGift) GetUserAvatar() string {
+	if x != nil {
+		return x.UserAvatar
+	}
+	return ""
+}
+
+func (x *LiveGift) GetGiftId() uint32 {
+	if x != nil {
+		return x.GiftId
+	}
+	return 0
+}
+
+func (x *LiveGift) GetGiftName() string {
+	if x != nil {
+		return x.GiftName
+	}
+	return ""
+}
+
+func (x *LiveGift) GetGiftIcon() string {
+	if x != nil {
+		return x.GiftIcon
+	}
+	return ""
+}
+
+func (x *LiveGift) GetGiftPrice() uint64 {
+	if x != nil {
+		return x.GiftPrice
+	}
+	return 0
+}
+
+func (x *LiveGift) GetGiftCount() uint32 {
+	if x != nil {
+		return x.GiftCount
+	}
+	return 0
+}
+
+func (x *LiveGift) GetTotalValue() uint64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *LiveGift) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LiveGift) GetEffectType() string {
+	if x != nil {
+		return x.EffectType
+	}
+	return ""
+}
+
+func (x *LiveGift) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LiveGift) GetEffectValue() string {
+	if x != nil {
+		return x.EffectValue
+	}
+	return ""
+}
+
+func (x *LiveGift) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *LiveGift) GetRefundedAt() int64 {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return 0
+}
+
+type GiftConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Price         uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	CoinPrice     uint64                 `protobuf:"varint,5,opt,name=coin_price,json=coinPrice,proto3" json:"coin_price,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Level         uint32                 `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
+	EffectType    string                 `protobuf:"bytes,8,opt,name=effect_type,json=effectType,proto3" json:"effect_type,omitempty"`
+	EffectValue   string                 `protobuf:"bytes,9,opt,name=effect_value,json=effectValue,proto3" json:"effect_value,omitempty"`
+	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	IsActive      bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SortOrder     uint32                 `protobuf:"varint,12,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftConfig) Reset() {
+	*x = GiftConfig{}
+	mi := &file_proto_live_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftConfig) ProtoMessage() {}
+
+func (x *GiftConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftConfig.ProtoReflect.Descriptor instead.
+func (*GiftConfig) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GiftConfig) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GiftConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GiftConfig) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *GiftConfig) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GiftConfig) GetCoinPrice() uint64 {
+	if x != nil {
+		return x.CoinPrice
+	}
+	return 0
+}
+
+func (x *GiftConfig) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GiftConfig) GetLevel() uint32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GiftConfig) GetEffectType() string {
+	if x != nil {
+		return x.EffectType
+	}
+	return ""
+}
+
+func (x *GiftConfig) GetEffectValue() string {
+	if x != nil {
+		return x.EffectValue
+	}
+	return ""
+}
+
+func (x *GiftConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GiftConfig) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *GiftConfig) GetSortOrder() uint32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type LiveCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	SortOrder     uint32                 `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveCategory) Reset() {
+	*x = LiveCategory{}
+	mi := &file_proto_live_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveCategory) ProtoMessage() {}
+
+func (x *LiveCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveCategory.ProtoReflect.Descriptor instead.
+func (*LiveCategory) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LiveCategory) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LiveCategory) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *LiveCategory) GetSortOrder() uint32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *LiveCategory) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type LiveStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StreamId       uint64                 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	TotalViewers   uint64                 `protobuf:"varint,2,opt,name=total_viewers,json=totalViewers,proto3" json:"total_viewers,omitempty"`
+	CurrentViewers uint64                 `protobuf:"varint,3,opt,name=current_viewers,json=currentViewers,proto3" json:"current_viewers,omitempty"`
+	MaxViewers     uint64                 `protobuf:"varint,4,opt,name=max_viewers,json=maxViewers,proto3" json:"max_viewers,omitempty"`
+	LikeCount      uint64                 `protobuf:"varint,5,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	GiftCount      uint64                 `protobuf:"varint,6,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
+	CommentCount   uint64                 `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	ShareCount     uint64                 `protobuf:"varint,8,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	Duration       uint64                 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	GiftValue      uint64                 `protobuf:"varint,10,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LiveStats) Reset() {
+	*x = LiveStats{}
+	mi := &file_proto_live_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveStats) ProtoMessage() {}
+
+func (x *LiveStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveStats.ProtoReflect.Descriptor instead.
+func (*LiveStats) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LiveStats) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *LiveStats) GetTotalViewers() uint64 {
+	if x != nil {
+		return x.TotalViewers
+	}
+	return 0
+}
+
+func (x *LiveStats) GetCurrentViewers() uint64 {
+	if x != nil {
+		return x.CurrentViewers
+	}
+	return 0
+}
+
+func (x *LiveStats) GetMaxViewers() uint64 {
+	if x != nil {
+		return x.MaxViewers
+	}
+	return 0
+}
+
+func (x *LiveStats) GetLikeCount() uint64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *LiveStats) GetGiftCount() uint64 {
+	if x != nil {
+		return x.GiftCount
+	}
+	return 0
+}
+
+func (x *LiveStats) GetCommentCount() uint64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *LiveStats) GetShareCount() uint64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+func (x *LiveStats) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *LiveStats) GetGiftValue() uint64 {
+	if x != nil {
+		return x.GiftValue
+	}
+	return 0
+}
+
+// 直播礼物统计，top_gift为送出数量最多的礼物
+type GiftStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamId      uint64                 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	TotalGifts    uint32                 `protobuf:"varint,2,opt,name=total_gifts,json=totalGifts,proto3" json:"total_gifts,omitempty"`
+	TotalValue    uint64                 `protobuf:"varint,3,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	TotalCoins    uint64                 `protobuf:"varint,4,opt,name=total_coins,json=totalCoins,proto3" json:"total_coins,omitempty"`
+	UniqueSenders uint32                 `protobuf:"varint,5,opt,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders,omitempty"`
+	TopGiftId     uint32                 `protobuf:"varint,6,opt,name=top_gift_id,json=topGiftId,proto3" json:"top_gift_id,omitempty"`
+	TopGiftCount  uint32                 `protobuf:"varint,7,opt,name=top_gift_count,json=topGiftCount,proto3" json:"top_gift_count,omitempty"`
+	TopGiftValue  uint64                 `protobuf:"varint,8,opt,name=top_gift_value,json=topGiftValue,proto3" json:"top_gift_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftStats) Reset() {
+	*x = GiftStats{}
+	mi := &file_proto_live_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftStats) ProtoMessage() {}
+
+func (x *GiftStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftStats.ProtoReflect.Descriptor instead.
+func (*GiftStats) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GiftStats) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *GiftStats) GetTotalGifts() uint32 {
+	if x != nil {
+		return x.TotalGifts
+	}
+	return 0
+}
+
+func (x *GiftStats) GetTotalValue() uint64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *GiftStats) GetTotalCoins() uint64 {
+	if x != nil {
+		return x.TotalCoins
+	}
+	return 0
+}
+
+func (x *GiftStats) GetUniqueSenders() uint32 {
+	if x != nil {
+		return x.UniqueSenders
+	}
+	return 0
+}
+
+func (x *GiftStats) GetTopGiftId() uint32 {
+	if x != nil {
+		return x.TopGiftId
+	}
+	return 0
+}
+
+func (x *GiftStats) GetTopGiftCount() uint32 {
+	if x != nil {
+		return x.TopGiftCount
+	}
+	return 0
+}
+
+func (x *GiftStats) GetTopGiftValue() uint64 {
+	if x != nil {
+		return x.TopGiftValue
+	}
+	return 0
+}
+
+type LivePlayback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamId      uint64                 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	PlaybackUrl   string                 `protobuf:"bytes,2,opt,name=playback_url,json=playbackUrl,proto3" json:"playback_url,omitempty"`
+	Duration      uint64                 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	FileSize      uint64                 `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Format        string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	Quality       string                 `protobuf:"bytes,6,opt,name=quality,proto3" json:"quality,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // 回放状态:processing转码中,ready可播放,failed失败
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LivePlayback) Reset() {
+	*x = LivePlayback{}
+	mi := &file_proto_live_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivePlayback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivePlayback) ProtoMessage() {}
+
+func (x *LivePlayback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivePlayback.ProtoReflect.Descriptor instead.
+func (*LivePlayback) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LivePlayback) GetStreamId() uint64 {
 	if x != nil {
-		return x.ViewerCount
+		return x.StreamId
 	}
 	return 0
 }
 
-func (x *LiveRoom) GetMaxViewerCount() uint32 {
+func (x *LivePlayback) GetPlaybackUrl() string {
 	if x != nil {
-		return x.MaxViewerCount
+		return x.PlaybackUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveRoom) GetLikeCount() uint32 {
+func (x *LivePlayback) GetDuration() uint64 {
 	if x != nil {
-		return x.LikeCount
+		return x.Duration
 	}
 	return 0
 }
 
-func (x *LiveRoom) GetGiftCount() uint32 {
+func (x *LivePlayback) GetFileSize() uint64 {
 	if x != nil {
-		return x.GiftCount
+		return x.FileSize
 	}
 	return 0
 }
 
-func (x *LiveRoom) GetGiftValue() uint64 {
+func (x *LivePlayback) GetFormat() string {
 	if x != nil {
-		return x.GiftValue
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveRoom) GetChatCount() uint32 {
+func (x *LivePlayback) GetQuality() string {
 	if x != nil {
-		return x.ChatCount
+		return x.Quality
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveRoom) GetCreatedAt() int64 {
+func (x *LivePlayback) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *LiveRoom) GetUpdatedAt() int64 {
+func (x *LivePlayback) GetStatus() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type LiveViewer struct {
+type GiftRankingItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	JoinTime      int64                  `protobuf:"varint,6,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
-	LeaveTime     int64                  `protobuf:"varint,7,opt,name=leave_time,json=leaveTime,proto3" json:"leave_time,omitempty"`
-	Duration      uint32                 `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
-	IsMuted       bool                   `protobuf:"varint,9,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar    string                 `protobuf:"bytes,3,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	GiftCount     uint64                 `protobuf:"varint,4,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
+	GiftValue     uint64                 `protobuf:"varint,5,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
+	Rank          uint32                 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	LastGiftTime  int64                  `protobuf:"varint,7,opt,name=last_gift_time,json=lastGiftTime,proto3" json:"last_gift_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveViewer) Reset() {
-	*x = LiveViewer{}
-	mi := &file_proto_live_proto_msgTypes[38]
+func (x *GiftRankingItem) Reset() {
+	*x = GiftRankingItem{}
+	mi := &file_proto_live_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveViewer) String() string {
+func (x *GiftRankingItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveViewer) ProtoMessage() {}
+func (*GiftRankingItem) ProtoMessage() {}
 
-func (x *LiveViewer) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[38]
+func (x *GiftRankingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,112 +5620,84 @@ func (x *LiveViewer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveViewer.ProtoReflect.Descriptor instead.
-func (*LiveViewer) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *LiveViewer) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *LiveViewer) GetStreamId() uint64 {
-	if x != nil {
-		return x.StreamId
-	}
-	return 0
+// Deprecated: Use GiftRankingItem.ProtoReflect.Descriptor instead.
+func (*GiftRankingItem) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *LiveViewer) GetUserId() uint64 {
+func (x *GiftRankingItem) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *LiveViewer) GetUserName() string {
+func (x *GiftRankingItem) GetUserName() string {
 	if x != nil {
 		return x.UserName
 	}
 	return ""
 }
 
-func (x *LiveViewer) GetUserAvatar() string {
+func (x *GiftRankingItem) GetUserAvatar() string {
 	if x != nil {
 		return x.UserAvatar
 	}
 	return ""
 }
 
-func (x *LiveViewer) GetJoinTime() int64 {
+func (x *GiftRankingItem) GetGiftCount() uint64 {
 	if x != nil {
-		return x.JoinTime
+		return x.GiftCount
 	}
 	return 0
 }
 
-func (x *LiveViewer) GetLeaveTime() int64 {
+func (x *GiftRankingItem) GetGiftValue() uint64 {
 	if x != nil {
-		return x.LeaveTime
+		return x.GiftValue
 	}
 	return 0
 }
 
-func (x *LiveViewer) GetDuration() uint32 {
+func (x *GiftRankingItem) GetRank() uint32 {
 	if x != nil {
-		return x.Duration
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *LiveViewer) GetIsMuted() bool {
-	if x != nil {
-		return x.IsMuted
-	}
-	return false
-}
-
-func (x *LiveViewer) GetCreatedAt() int64 {
+func (x *GiftRankingItem) GetLastGiftTime() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.LastGiftTime
 	}
 	return 0
 }
 
-type LiveChat struct {
+type LeaderboardEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	IsSystem      bool                   `protobuf:"varint,8,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
-	IsDeleted     bool                   `protobuf:"varint,9,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Rank          uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Score         int64                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveChat) Reset() {
-	*x = LiveChat{}
-	mi := &file_proto_live_proto_msgTypes[39]
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_proto_live_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveChat) String() string {
+func (x *LeaderboardEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveChat) ProtoMessage() {}
+func (*LeaderboardEntry) ProtoMessage() {}
 
-func (x *LiveChat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[39]
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2987,116 +5708,118 @@ func (x *LiveChat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveChat.ProtoReflect.Descriptor instead.
-func (*LiveChat) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *LiveChat) GetId() uint64 {
+func (x *LeaderboardEntry) GetRank() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *LiveChat) GetStreamId() uint64 {
+func (x *LeaderboardEntry) GetId() uint64 {
 	if x != nil {
-		return x.StreamId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *LiveChat) GetUserId() uint64 {
+func (x *LeaderboardEntry) GetScore() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Score
 	}
 	return 0
 }
 
-func (x *LiveChat) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+// 在线人数推送订阅
+type StreamViewerCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveChat) GetUserAvatar() string {
-	if x != nil {
-		return x.UserAvatar
-	}
-	return ""
+func (x *StreamViewerCountRequest) Reset() {
+	*x = StreamViewerCountRequest{}
+	mi := &file_proto_live_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveChat) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *StreamViewerCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LiveChat) GetContentType() string {
+func (*StreamViewerCountRequest) ProtoMessage() {}
+
+func (x *StreamViewerCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[72]
 	if x != nil {
-		return x.ContentType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LiveChat) GetIsSystem() bool {
+// Deprecated: Use StreamViewerCountRequest.ProtoReflect.Descriptor instead.
+func (*StreamViewerCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StreamViewerCountRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.IsSystem
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *LiveChat) GetIsDeleted() bool {
+func (x *StreamViewerCountRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.IsDeleted
+		return x.StreamId
 	}
-	return false
+	return 0
 }
 
-func (x *LiveChat) GetCreatedAt() int64 {
+func (x *StreamViewerCountRequest) GetRequestId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type LiveGift struct {
+// 在线人数更新，订阅后先推送当前人数，之后人数变化时推送
+type ViewerCountUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	GiftId        uint32                 `protobuf:"varint,6,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
-	GiftName      string                 `protobuf:"bytes,7,opt,name=gift_name,json=giftName,proto3" json:"gift_name,omitempty"`
-	GiftIcon      string                 `protobuf:"bytes,8,opt,name=gift_icon,json=giftIcon,proto3" json:"gift_icon,omitempty"`
-	GiftPrice     uint64                 `protobuf:"varint,9,opt,name=gift_price,json=giftPrice,proto3" json:"gift_price,omitempty"`
-	GiftCount     uint32                 `protobuf:"varint,10,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
-	TotalValue    uint64                 `protobuf:"varint,11,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
-	Message       string                 `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
-	EffectType    string                 `protobuf:"bytes,13,opt,name=effect_type,json=effectType,proto3" json:"effect_type,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StreamId      uint64                 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	ViewerCount   uint32                 `protobuf:"varint,2,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 毫秒时间戳
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveGift) Reset() {
-	*x = LiveGift{}
-	mi := &file_proto_live_proto_msgTypes[40]
+func (x *ViewerCountUpdate) Reset() {
+	*x = ViewerCountUpdate{}
+	mi := &file_proto_live_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveGift) String() string {
+func (x *ViewerCountUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveGift) ProtoMessage() {}
+func (*ViewerCountUpdate) ProtoMessage() {}
 
-func (x *LiveGift) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[40]
+func (x *ViewerCountUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,142 +5830,178 @@ func (x *LiveGift) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveGift.ProtoReflect.Descriptor instead.
-func (*LiveGift) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ViewerCountUpdate.ProtoReflect.Descriptor instead.
+func (*ViewerCountUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *LiveGift) GetId() uint64 {
+func (x *ViewerCountUpdate) GetStreamId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.StreamId
 	}
 	return 0
 }
 
-func (x *LiveGift) GetStreamId() uint64 {
+func (x *ViewerCountUpdate) GetViewerCount() uint32 {
 	if x != nil {
-		return x.StreamId
+		return x.ViewerCount
 	}
 	return 0
 }
 
-func (x *LiveGift) GetUserId() uint64 {
+func (x *ViewerCountUpdate) GetTimestamp() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *LiveGift) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+// 暂停直播
+type PauseLiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，主播取自authorization元数据中的访问令牌
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveGift) GetUserAvatar() string {
+func (x *PauseLiveRequest) Reset() {
+	*x = PauseLiveRequest{}
+	mi := &file_proto_live_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseLiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseLiveRequest) ProtoMessage() {}
+
+func (x *PauseLiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[74]
 	if x != nil {
-		return x.UserAvatar
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseLiveRequest.ProtoReflect.Descriptor instead.
+func (*PauseLiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *LiveGift) GetGiftId() uint32 {
+func (x *PauseLiveRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.GiftId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *LiveGift) GetGiftName() string {
+func (x *PauseLiveRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.GiftName
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (x *LiveGift) GetGiftIcon() string {
+func (x *PauseLiveRequest) GetRequestId() string {
 	if x != nil {
-		return x.GiftIcon
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LiveGift) GetGiftPrice() uint64 {
-	if x != nil {
-		return x.GiftPrice
-	}
-	return 0
+type PauseLiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveGift) GetGiftCount() uint32 {
+func (x *PauseLiveResponse) Reset() {
+	*x = PauseLiveResponse{}
+	mi := &file_proto_live_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseLiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseLiveResponse) ProtoMessage() {}
+
+func (x *PauseLiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[75]
 	if x != nil {
-		return x.GiftCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiveGift) GetTotalValue() uint64 {
+// Deprecated: Use PauseLiveResponse.ProtoReflect.Descriptor instead.
+func (*PauseLiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PauseLiveResponse) GetCode() int32 {
 	if x != nil {
-		return x.TotalValue
+		return x.Code
 	}
 	return 0
 }
 
-func (x *LiveGift) GetMessage() string {
+func (x *PauseLiveResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *LiveGift) GetEffectType() string {
+func (x *PauseLiveResponse) GetRequestId() string {
 	if x != nil {
-		return x.EffectType
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LiveGift) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-type GiftConfig struct {
+// 恢复直播
+type ResumeLiveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Price         uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	CoinPrice     uint64                 `protobuf:"varint,5,opt,name=coin_price,json=coinPrice,proto3" json:"coin_price,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
-	Level         uint32                 `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
-	EffectType    string                 `protobuf:"bytes,8,opt,name=effect_type,json=effectType,proto3" json:"effect_type,omitempty"`
-	EffectValue   string                 `protobuf:"bytes,9,opt,name=effect_value,json=effectValue,proto3" json:"effect_value,omitempty"`
-	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	IsActive      bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	SortOrder     uint32                 `protobuf:"varint,12,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已弃用，主播取自authorization元数据中的访问令牌
+	StreamId      uint64                 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GiftConfig) Reset() {
-	*x = GiftConfig{}
-	mi := &file_proto_live_proto_msgTypes[41]
+func (x *ResumeLiveRequest) Reset() {
+	*x = ResumeLiveRequest{}
+	mi := &file_proto_live_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GiftConfig) String() string {
+func (x *ResumeLiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GiftConfig) ProtoMessage() {}
+func (*ResumeLiveRequest) ProtoMessage() {}
 
-func (x *GiftConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[41]
+func (x *ResumeLiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,121 +6012,118 @@ func (x *GiftConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GiftConfig.ProtoReflect.Descriptor instead.
-func (*GiftConfig) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ResumeLiveRequest.ProtoReflect.Descriptor instead.
+func (*ResumeLiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GiftConfig) GetId() uint32 {
+func (x *ResumeLiveRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GiftConfig) GetName() string {
+func (x *ResumeLiveRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (x *GiftConfig) GetIcon() string {
+func (x *ResumeLiveRequest) GetRequestId() string {
 	if x != nil {
-		return x.Icon
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GiftConfig) GetPrice() uint64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+type ResumeLiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GiftConfig) GetCoinPrice() uint64 {
-	if x != nil {
-		return x.CoinPrice
-	}
-	return 0
+func (x *ResumeLiveResponse) Reset() {
+	*x = ResumeLiveResponse{}
+	mi := &file_proto_live_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GiftConfig) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+func (x *ResumeLiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GiftConfig) GetLevel() uint32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
+func (*ResumeLiveResponse) ProtoMessage() {}
 
-func (x *GiftConfig) GetEffectType() string {
+func (x *ResumeLiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[77]
 	if x != nil {
-		return x.EffectType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GiftConfig) GetEffectValue() string {
-	if x != nil {
-		return x.EffectValue
-	}
-	return ""
+// Deprecated: Use ResumeLiveResponse.ProtoReflect.Descriptor instead.
+func (*ResumeLiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GiftConfig) GetDescription() string {
+func (x *ResumeLiveResponse) GetCode() int32 {
 	if x != nil {
-		return x.Description
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *GiftConfig) GetIsActive() bool {
+func (x *ResumeLiveResponse) GetMessage() string {
 	if x != nil {
-		return x.IsActive
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *GiftConfig) GetSortOrder() uint32 {
+func (x *ResumeLiveResponse) GetRequestId() string {
 	if x != nil {
-		return x.SortOrder
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type LiveCategory struct {
+// 获取直播间
+type GetLiveRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	SortOrder     uint32                 `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoomId        uint64                 `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`             // 直播间ID，为0时按streamer_id查找
+	StreamerId    uint64                 `protobuf:"varint,3,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"` // 主播用户ID
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveCategory) Reset() {
-	*x = LiveCategory{}
-	mi := &file_proto_live_proto_msgTypes[42]
+func (x *GetLiveRoomRequest) Reset() {
+	*x = GetLiveRoomRequest{}
+	mi := &file_proto_live_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveCategory) String() string {
+func (x *GetLiveRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveCategory) ProtoMessage() {}
+func (*GetLiveRoomRequest) ProtoMessage() {}
 
-func (x *LiveCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[42]
+func (x *GetLiveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3378,77 +6134,64 @@ func (x *LiveCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveCategory.ProtoReflect.Descriptor instead.
-func (*LiveCategory) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetLiveRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *LiveCategory) GetId() uint32 {
+func (x *GetLiveRoomRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *LiveCategory) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *LiveCategory) GetIcon() string {
+func (x *GetLiveRoomRequest) GetRoomId() uint64 {
 	if x != nil {
-		return x.Icon
+		return x.RoomId
 	}
-	return ""
+	return 0
 }
 
-func (x *LiveCategory) GetSortOrder() uint32 {
+func (x *GetLiveRoomRequest) GetStreamerId() uint64 {
 	if x != nil {
-		return x.SortOrder
+		return x.StreamerId
 	}
 	return 0
 }
 
-func (x *LiveCategory) GetIsActive() bool {
+func (x *GetLiveRoomRequest) GetRequestId() string {
 	if x != nil {
-		return x.IsActive
+		return x.RequestId
 	}
-	return false
+	return ""
 }
 
-type LiveStats struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StreamId       uint64                 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	TotalViewers   uint64                 `protobuf:"varint,2,opt,name=total_viewers,json=totalViewers,proto3" json:"total_viewers,omitempty"`
-	CurrentViewers uint64                 `protobuf:"varint,3,opt,name=current_viewers,json=currentViewers,proto3" json:"current_viewers,omitempty"`
-	MaxViewers     uint64                 `protobuf:"varint,4,opt,name=max_viewers,json=maxViewers,proto3" json:"max_viewers,omitempty"`
-	LikeCount      uint64                 `protobuf:"varint,5,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	GiftCount      uint64                 `protobuf:"varint,6,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
-	CommentCount   uint64                 `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	ShareCount     uint64                 `protobuf:"varint,8,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
-	Duration       uint64                 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
-	GiftValue      uint64                 `protobuf:"varint,10,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetLiveRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Room          *LiveRoom              `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LiveStats) Reset() {
-	*x = LiveStats{}
-	mi := &file_proto_live_proto_msgTypes[43]
+func (x *GetLiveRoomResponse) Reset() {
+	*x = GetLiveRoomResponse{}
+	mi := &file_proto_live_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LiveStats) String() string {
+func (x *GetLiveRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveStats) ProtoMessage() {}
+func (*GetLiveRoomResponse) ProtoMessage() {}
 
-func (x *LiveStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[43]
+func (x *GetLiveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3459,109 +6202,133 @@ func (x *LiveStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveStats.ProtoReflect.Descriptor instead.
-func (*LiveStats) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetLiveRoomResponse.ProtoReflect.Descriptor instead.
+func (*GetLiveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *LiveStats) GetStreamId() uint64 {
+func (x *GetLiveRoomResponse) GetCode() int32 {
 	if x != nil {
-		return x.StreamId
+		return x.Code
 	}
 	return 0
 }
 
-func (x *LiveStats) GetTotalViewers() uint64 {
+func (x *GetLiveRoomResponse) GetMessage() string {
 	if x != nil {
-		return x.TotalViewers
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveStats) GetCurrentViewers() uint64 {
+func (x *GetLiveRoomResponse) GetRequestId() string {
 	if x != nil {
-		return x.CurrentViewers
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveStats) GetMaxViewers() uint64 {
+func (x *GetLiveRoomResponse) GetRoom() *LiveRoom {
 	if x != nil {
-		return x.MaxViewers
+		return x.Room
 	}
-	return 0
+	return nil
 }
 
-func (x *LiveStats) GetLikeCount() uint64 {
+// 退还礼物
+type RefundLiveGiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 已弃用，操作人取自authorization元数据中的访问令牌
+	GiftRecordId  uint64                 `protobuf:"varint,2,opt,name=gift_record_id,json=giftRecordId,proto3" json:"gift_record_id,omitempty"` // 礼物记录ID
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 退款原因
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundLiveGiftRequest) Reset() {
+	*x = RefundLiveGiftRequest{}
+	mi := &file_proto_live_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundLiveGiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundLiveGiftRequest) ProtoMessage() {}
+
+func (x *RefundLiveGiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[80]
 	if x != nil {
-		return x.LikeCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiveStats) GetGiftCount() uint64 {
-	if x != nil {
-		return x.GiftCount
-	}
-	return 0
+// Deprecated: Use RefundLiveGiftRequest.ProtoReflect.Descriptor instead.
+func (*RefundLiveGiftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *LiveStats) GetCommentCount() uint64 {
+func (x *RefundLiveGiftRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.CommentCount
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *LiveStats) GetShareCount() uint64 {
+func (x *RefundLiveGiftRequest) GetGiftRecordId() uint64 {
 	if x != nil {
-		return x.ShareCount
+		return x.GiftRecordId
 	}
 	return 0
 }
 
-func (x *LiveStats) GetDuration() uint64 {
+func (x *RefundLiveGiftRequest) GetReason() string {
 	if x != nil {
-		return x.Duration
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *LiveStats) GetGiftValue() uint64 {
+func (x *RefundLiveGiftRequest) GetRequestId() string {
 	if x != nil {
-		return x.GiftValue
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type LivePlayback struct {
+type RefundLiveGiftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StreamId      uint64                 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	PlaybackUrl   string                 `protobuf:"bytes,2,opt,name=playback_url,json=playbackUrl,proto3" json:"playback_url,omitempty"`
-	Duration      uint64                 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	FileSize      uint64                 `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	Format        string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
-	Quality       string                 `protobuf:"bytes,6,opt,name=quality,proto3" json:"quality,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Gift          *LiveGift              `protobuf:"bytes,4,opt,name=gift,proto3" json:"gift,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LivePlayback) Reset() {
-	*x = LivePlayback{}
-	mi := &file_proto_live_proto_msgTypes[44]
+func (x *RefundLiveGiftResponse) Reset() {
+	*x = RefundLiveGiftResponse{}
+	mi := &file_proto_live_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LivePlayback) String() string {
+func (x *RefundLiveGiftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LivePlayback) ProtoMessage() {}
+func (*RefundLiveGiftResponse) ProtoMessage() {}
 
-func (x *LivePlayback) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[44]
+func (x *RefundLiveGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3572,88 +6339,64 @@ func (x *LivePlayback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LivePlayback.ProtoReflect.Descriptor instead.
-func (*LivePlayback) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *LivePlayback) GetStreamId() uint64 {
-	if x != nil {
-		return x.StreamId
-	}
-	return 0
-}
-
-func (x *LivePlayback) GetPlaybackUrl() string {
-	if x != nil {
-		return x.PlaybackUrl
-	}
-	return ""
-}
-
-func (x *LivePlayback) GetDuration() uint64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+// Deprecated: Use RefundLiveGiftResponse.ProtoReflect.Descriptor instead.
+func (*RefundLiveGiftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *LivePlayback) GetFileSize() uint64 {
+func (x *RefundLiveGiftResponse) GetCode() int32 {
 	if x != nil {
-		return x.FileSize
+		return x.Code
 	}
 	return 0
 }
 
-func (x *LivePlayback) GetFormat() string {
+func (x *RefundLiveGiftResponse) GetMessage() string {
 	if x != nil {
-		return x.Format
+		return x.Message
 	}
 	return ""
 }
 
-func (x *LivePlayback) GetQuality() string {
+func (x *RefundLiveGiftResponse) GetRequestId() string {
 	if x != nil {
-		return x.Quality
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *LivePlayback) GetCreatedAt() int64 {
+func (x *RefundLiveGiftResponse) GetGift() *LiveGift {
 	if x != nil {
-		return x.CreatedAt
+		return x.Gift
 	}
-	return 0
+	return nil
 }
 
-type GiftRankingItem struct {
+// 获取用户当前的直播
+type GetUserLiveStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar    string                 `protobuf:"bytes,3,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	GiftCount     uint64                 `protobuf:"varint,4,opt,name=gift_count,json=giftCount,proto3" json:"gift_count,omitempty"`
-	GiftValue     uint64                 `protobuf:"varint,5,opt,name=gift_value,json=giftValue,proto3" json:"gift_value,omitempty"`
-	Rank          uint32                 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
-	LastGiftTime  int64                  `protobuf:"varint,7,opt,name=last_gift_time,json=lastGiftTime,proto3" json:"last_gift_time,omitempty"`
+	TargetUserId  uint64                 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"` // 主播用户ID
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GiftRankingItem) Reset() {
-	*x = GiftRankingItem{}
-	mi := &file_proto_live_proto_msgTypes[45]
+func (x *GetUserLiveStreamRequest) Reset() {
+	*x = GetUserLiveStreamRequest{}
+	mi := &file_proto_live_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GiftRankingItem) String() string {
+func (x *GetUserLiveStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GiftRankingItem) ProtoMessage() {}
+func (*GetUserLiveStreamRequest) ProtoMessage() {}
 
-func (x *GiftRankingItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_live_proto_msgTypes[45]
+func (x *GetUserLiveStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3664,58 +6407,98 @@ func (x *GiftRankingItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GiftRankingItem.ProtoReflect.Descriptor instead.
-func (*GiftRankingItem) Descriptor() ([]byte, []int) {
-	return file_proto_live_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetUserLiveStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLiveStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GiftRankingItem) GetUserId() uint64 {
+func (x *GetUserLiveStreamRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GiftRankingItem) GetUserName() string {
+func (x *GetUserLiveStreamRequest) GetTargetUserId() uint64 {
 	if x != nil {
-		return x.UserName
+		return x.TargetUserId
 	}
-	return ""
+	return 0
 }
 
-func (x *GiftRankingItem) GetUserAvatar() string {
+func (x *GetUserLiveStreamRequest) GetRequestId() string {
 	if x != nil {
-		return x.UserAvatar
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GiftRankingItem) GetGiftCount() uint64 {
+type GetUserLiveStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Stream        *LiveStream            `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLiveStreamResponse) Reset() {
+	*x = GetUserLiveStreamResponse{}
+	mi := &file_proto_live_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLiveStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLiveStreamResponse) ProtoMessage() {}
+
+func (x *GetUserLiveStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_live_proto_msgTypes[83]
 	if x != nil {
-		return x.GiftCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GiftRankingItem) GetGiftValue() uint64 {
+// Deprecated: Use GetUserLiveStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLiveStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_live_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetUserLiveStreamResponse) GetCode() int32 {
 	if x != nil {
-		return x.GiftValue
+		return x.Code
 	}
 	return 0
 }
 
-func (x *GiftRankingItem) GetRank() uint32 {
+func (x *GetUserLiveStreamResponse) GetMessage() string {
 	if x != nil {
-		return x.Rank
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *GiftRankingItem) GetLastGiftTime() int64 {
+func (x *GetUserLiveStreamResponse) GetRequestId() string {
 	if x != nil {
-		return x.LastGiftTime
+		return x.RequestId
 	}
-	return 0
+	return ""
+}
+
+func (x *GetUserLiveStreamResponse) GetStream() *LiveStream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
 }
 
 var File_proto_live_proto protoreflect.FileDescriptor
@@ -3731,7 +6514,7 @@ const file_proto_live_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x03 \x01(\tR\trequestId\"\xa3\x01\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\xd7\x01\n" +
 	"\x10StartLiveRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -3739,7 +6522,9 @@ const file_proto_live_proto_rawDesc = "" +
 	"\vcategory_id\x18\x04 \x01(\rR\n" +
 	"categoryId\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestId\"\xca\x01\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12\x16\n" +
+	"\x06region\x18\a \x01(\tR\x06region\"\xca\x01\n" +
 	"\x11StartLiveResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
@@ -3759,6 +6544,44 @@ const file_proto_live_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"y\n" +
+	"\x1cAuthenticateStreamKeyRequest\x12\x1d\n" +
+	"\n" +
+	"stream_key\x18\x01 \x01(\tR\tstreamKey\x12\x1b\n" +
+	"\tclient_ip\x18\x02 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\xa2\x01\n" +
+	"\x1dAuthenticateStreamKeyResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tstream_id\x18\x04 \x01(\x04R\bstreamId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x04R\x06userId\"q\n" +
+	"\x14OnStreamEndedRequest\x12\x1d\n" +
+	"\n" +
+	"stream_key\x18\x01 \x01(\tR\tstreamKey\x12\x1b\n" +
+	"\tclient_ip\x18\x02 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x9d\x01\n" +
+	"\x15OnStreamEndedResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tstream_id\x18\x04 \x01(\x04R\bstreamId\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\rR\bduration\"\xae\x01\n" +
+	"\x1eUpdateLiveStreamPrivacyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1b\n" +
+	"\tis_public\x18\x03 \x01(\bR\bisPublic\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"n\n" +
+	"\x1fUpdateLiveStreamPrivacyResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\"k\n" +
 	"\x14GetLiveStreamRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
@@ -3778,14 +6601,16 @@ const file_proto_live_proto_rawDesc = "" +
 	"\vcategory_id\x18\x04 \x01(\rR\n" +
 	"categoryId\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestId\"\xa6\x01\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"\xc7\x01\n" +
 	"\x13GetLiveListResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12,\n" +
 	"\astreams\x18\x04 \x03(\v2\x12.livepb.LiveStreamR\astreams\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x03R\x05total\"\x80\x01\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x06 \x01(\x03R\n" +
+	"totalPages\"\x80\x01\n" +
 	"\x15GetHotLiveListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -3798,12 +6623,13 @@ const file_proto_live_proto_rawDesc = "" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12,\n" +
 	"\astreams\x18\x04 \x03(\v2\x12.livepb.LiveStreamR\astreams\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x03R\x05total\"j\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\"\x86\x01\n" +
 	"\x13JoinLiveRoomRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x03 \x01(\tR\trequestId\"\x8f\x01\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\"\x8f\x01\n" +
 	"\x14JoinLiveRoomResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
@@ -3819,21 +6645,45 @@ const file_proto_live_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x03 \x01(\tR\trequestId\"\xa0\x01\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"g\n" +
+	"\x10HeartbeatRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"|\n" +
+	"\x11HeartbeatResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\x05R\binterval\"q\n" +
+	"\x1aSubscribeLiveEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x87\x01\n" +
+	"\tLiveEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\tR\x04data\"\xa0\x01\n" +
 	"\x18GetLiveViewerListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestId\"\xac\x01\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"\xcd\x01\n" +
 	"\x19GetLiveViewerListResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12,\n" +
 	"\aviewers\x18\x04 \x03(\v2\x12.livepb.LiveViewerR\aviewers\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x03R\x05total\"\xa7\x01\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x06 \x01(\x03R\n" +
+	"totalPages\"\xa7\x01\n" +
 	"\x13SendLiveChatRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x18\n" +
@@ -3846,21 +6696,48 @@ const file_proto_live_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12$\n" +
-	"\x04chat\x18\x04 \x01(\v2\x10.livepb.LiveChatR\x04chat\"\x9e\x01\n" +
+	"\x04chat\x18\x04 \x01(\v2\x10.livepb.LiveChatR\x04chat\"\xc1\x01\n" +
 	"\x16GetLiveChatListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestId\"\xa4\x01\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12!\n" +
+	"\foldest_first\x18\x06 \x01(\bR\voldestFirst\"\xc5\x01\n" +
 	"\x17GetLiveChatListResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12&\n" +
 	"\x05chats\x18\x04 \x03(\v2\x10.livepb.LiveChatR\x05chats\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x03R\x05total\"\xbc\x01\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x06 \x01(\x03R\n" +
+	"totalPages\"\xc2\x01\n" +
+	"\x11MuteViewerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12$\n" +
+	"\x0etarget_user_id\x18\x03 \x01(\x04R\ftargetUserId\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\rR\bduration\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\"a\n" +
+	"\x12MuteViewerResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x90\x01\n" +
+	"\x13UnmuteViewerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12$\n" +
+	"\x0etarget_user_id\x18\x03 \x01(\x04R\ftargetUserId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"c\n" +
+	"\x14UnmuteViewerResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\xbc\x01\n" +
 	"\x13SendLiveGiftRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x17\n" +
@@ -3869,27 +6746,58 @@ const file_proto_live_proto_rawDesc = "" +
 	"gift_count\x18\x04 \x01(\rR\tgiftCount\x12\x18\n" +
 	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x06 \x01(\tR\trequestId\"\x89\x01\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\"\xfa\x01\n" +
 	"\x14SendLiveGiftResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12$\n" +
-	"\x04gift\x18\x04 \x01(\v2\x10.livepb.LiveGiftR\x04gift\"\x9e\x01\n" +
+	"\x04gift\x18\x04 \x01(\v2\x10.livepb.LiveGiftR\x04gift\x12\x1f\n" +
+	"\vcombo_count\x18\x05 \x01(\rR\n" +
+	"comboCount\x12)\n" +
+	"\x10combo_multiplier\x18\x06 \x01(\rR\x0fcomboMultiplier\x12#\n" +
+	"\rdisplay_value\x18\a \x01(\x04R\fdisplayValue\"\xb6\x01\n" +
 	"\x16GetLiveGiftListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestId\"\xa4\x01\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"\xc5\x01\n" +
 	"\x17GetLiveGiftListResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12&\n" +
 	"\x05gifts\x18\x04 \x03(\v2\x10.livepb.LiveGiftR\x05gifts\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x03R\x05total\"f\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\"\x85\x01\n" +
+	"\x1aGetUserLiveGiftListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\xa8\x01\n" +
+	"\x1bGetUserLiveGiftListResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12&\n" +
+	"\x05gifts\x18\x04 \x03(\v2\x10.livepb.LiveGiftR\x05gifts\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\"n\n" +
+	"\x17GetLiveGiftStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x90\x01\n" +
+	"\x18GetLiveGiftStatsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12'\n" +
+	"\x05stats\x18\x04 \x01(\v2\x11.livepb.GiftStatsR\x05stats\"f\n" +
 	"\x0fLikeLiveRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
@@ -3901,14 +6809,20 @@ const file_proto_live_proto_rawDesc = "" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1d\n" +
 	"\n" +
-	"like_count\x18\x04 \x01(\x04R\tlikeCount\"\x96\x01\n" +
+	"like_count\x18\x04 \x01(\x04R\tlikeCount\"\x8c\x02\n" +
 	"\x11SearchLiveRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
 	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestId\"\xa5\x01\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\rR\n" +
+	"categoryId\x12\x1f\n" +
+	"\vmin_viewers\x18\a \x01(\rR\n" +
+	"minViewers\x12\x1a\n" +
+	"\blanguage\x18\b \x01(\tR\blanguage\x12\x16\n" +
+	"\x06region\x18\t \x01(\tR\x06region\"\xa5\x01\n" +
 	"\x12SearchLiveResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
@@ -3938,7 +6852,43 @@ const file_proto_live_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12'\n" +
-	"\x05stats\x18\x04 \x01(\v2\x11.livepb.LiveStatsR\x05stats\"m\n" +
+	"\x05stats\x18\x04 \x01(\v2\x11.livepb.LiveStatsR\x05stats\"p\n" +
+	"\x19RecomputeLiveStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x92\x01\n" +
+	"\x1aRecomputeLiveStatsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12'\n" +
+	"\x05stats\x18\x04 \x01(\v2\x11.livepb.LiveStatsR\x05stats\"\x83\x01\n" +
+	"\x14ForceStopLiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\x90\x01\n" +
+	"\x15ForceStopLiveResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12*\n" +
+	"\x06stream\x18\x04 \x01(\v2\x12.livepb.LiveStreamR\x06stream\"U\n" +
+	"\x1bGetDailyLeaderboardsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"\xf9\x01\n" +
+	"\x1cGetDailyLeaderboardsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12=\n" +
+	"\rtop_streamers\x18\x05 \x03(\v2\x18.livepb.LeaderboardEntryR\ftopStreamers\x129\n" +
+	"\vtop_streams\x18\x06 \x03(\v2\x18.livepb.LeaderboardEntryR\n" +
+	"topStreams\"m\n" +
 	"\x16GetLivePlaybackRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
@@ -3949,7 +6899,7 @@ const file_proto_live_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x120\n" +
-	"\bplayback\x18\x04 \x01(\v2\x14.livepb.LivePlaybackR\bplayback\"\x9d\x04\n" +
+	"\bplayback\x18\x04 \x01(\v2\x14.livepb.LivePlaybackR\bplayback\"\x91\x05\n" +
 	"\n" +
 	"LiveStream\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
@@ -3979,7 +6929,11 @@ const file_proto_live_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x11 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x12 \x01(\x03R\tupdatedAt\"\xaf\x03\n" +
+	"updated_at\x18\x12 \x01(\x03R\tupdatedAt\x12\x1b\n" +
+	"\tis_public\x18\x13 \x01(\bR\bisPublic\x12!\n" +
+	"\fhas_password\x18\x14 \x01(\bR\vhasPassword\x12\x1a\n" +
+	"\blanguage\x18\x15 \x01(\tR\blanguage\x12\x16\n" +
+	"\x06region\x18\x16 \x01(\tR\x06region\"\xda\x04\n" +
 	"\bLiveRoom\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x14\n" +
@@ -4002,7 +6956,13 @@ const file_proto_live_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\"\xa2\x02\n" +
+	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\x12\x17\n" +
+	"\auser_id\x18\x0f \x01(\x04R\x06userId\x12\x1f\n" +
+	"\vroom_number\x18\x10 \x01(\tR\n" +
+	"roomNumber\x12#\n" +
+	"\rtotal_streams\x18\x11 \x01(\rR\ftotalStreams\x12%\n" +
+	"\x0etotal_duration\x18\x12 \x01(\x04R\rtotalDuration\x12#\n" +
+	"\rtotal_viewers\x18\x13 \x01(\x04R\ftotalViewers\"\xa2\x02\n" +
 	"\n" +
 	"LiveViewer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
@@ -4033,7 +6993,7 @@ const file_proto_live_proto_rawDesc = "" +
 	"is_deleted\x18\t \x01(\bR\tisDeleted\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\x03R\tcreatedAt\"\x9a\x03\n" +
+	" \x01(\x03R\tcreatedAt\"\xf6\x03\n" +
 	"\bLiveGift\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
 	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x17\n" +
@@ -4055,7 +7015,11 @@ const file_proto_live_proto_rawDesc = "" +
 	"\veffect_type\x18\r \x01(\tR\n" +
 	"effectType\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x0e \x01(\x03R\tcreatedAt\"\xcd\x02\n" +
+	"created_at\x18\x0e \x01(\x03R\tcreatedAt\x12!\n" +
+	"\feffect_value\x18\x0f \x01(\tR\veffectValue\x12\x16\n" +
+	"\x06status\x18\x10 \x01(\rR\x06status\x12\x1f\n" +
+	"\vrefunded_at\x18\x11 \x01(\x03R\n" +
+	"refundedAt\"\xcd\x02\n" +
 	"\n" +
 	"GiftConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
@@ -4097,7 +7061,19 @@ const file_proto_live_proto_rawDesc = "" +
 	"\bduration\x18\t \x01(\x04R\bduration\x12\x1d\n" +
 	"\n" +
 	"gift_value\x18\n" +
-	" \x01(\x04R\tgiftValue\"\xd8\x01\n" +
+	" \x01(\x04R\tgiftValue\"\x9e\x02\n" +
+	"\tGiftStats\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\x04R\bstreamId\x12\x1f\n" +
+	"\vtotal_gifts\x18\x02 \x01(\rR\n" +
+	"totalGifts\x12\x1f\n" +
+	"\vtotal_value\x18\x03 \x01(\x04R\n" +
+	"totalValue\x12\x1f\n" +
+	"\vtotal_coins\x18\x04 \x01(\x04R\n" +
+	"totalCoins\x12%\n" +
+	"\x0eunique_senders\x18\x05 \x01(\rR\runiqueSenders\x12\x1e\n" +
+	"\vtop_gift_id\x18\x06 \x01(\rR\ttopGiftId\x12$\n" +
+	"\x0etop_gift_count\x18\a \x01(\rR\ftopGiftCount\x12$\n" +
+	"\x0etop_gift_value\x18\b \x01(\x04R\ftopGiftValue\"\xf0\x01\n" +
 	"\fLivePlayback\x12\x1b\n" +
 	"\tstream_id\x18\x01 \x01(\x04R\bstreamId\x12!\n" +
 	"\fplayback_url\x18\x02 \x01(\tR\vplaybackUrl\x12\x1a\n" +
@@ -4106,7 +7082,8 @@ const file_proto_live_proto_rawDesc = "" +
 	"\x06format\x18\x05 \x01(\tR\x06format\x12\x18\n" +
 	"\aquality\x18\x06 \x01(\tR\aquality\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\x03R\tcreatedAt\"\xe0\x01\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"\xe0\x01\n" +
 	"\x0fGiftRankingItem\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1f\n" +
@@ -4117,27 +7094,115 @@ const file_proto_live_proto_rawDesc = "" +
 	"\n" +
 	"gift_value\x18\x05 \x01(\x04R\tgiftValue\x12\x12\n" +
 	"\x04rank\x18\x06 \x01(\rR\x04rank\x12$\n" +
-	"\x0elast_gift_time\x18\a \x01(\x03R\flastGiftTime2\xa3\n" +
+	"\x0elast_gift_time\x18\a \x01(\x03R\flastGiftTime\"L\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\rR\x04rank\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x03R\x05score\"o\n" +
+	"\x18StreamViewerCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"q\n" +
+	"\x11ViewerCountUpdate\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\x04R\bstreamId\x12!\n" +
+	"\fviewer_count\x18\x02 \x01(\rR\vviewerCount\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"g\n" +
+	"\x10PauseLiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"`\n" +
+	"\x11PauseLiveResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"h\n" +
+	"\x11ResumeLiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tstream_id\x18\x02 \x01(\x04R\bstreamId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"a\n" +
+	"\x12ResumeLiveResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x86\x01\n" +
+	"\x12GetLiveRoomRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\x04R\x06roomId\x12\x1f\n" +
+	"\vstreamer_id\x18\x03 \x01(\x04R\n" +
+	"streamerId\x12\x1d\n" +
 	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\x88\x01\n" +
+	"\x13GetLiveRoomResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12$\n" +
+	"\x04room\x18\x04 \x01(\v2\x10.livepb.LiveRoomR\x04room\"\x8d\x01\n" +
+	"\x15RefundLiveGiftRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12$\n" +
+	"\x0egift_record_id\x18\x02 \x01(\x04R\fgiftRecordId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\x8b\x01\n" +
+	"\x16RefundLiveGiftResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12$\n" +
+	"\x04gift\x18\x04 \x01(\v2\x10.livepb.LiveGiftR\x04gift\"x\n" +
+	"\x18GetUserLiveStreamRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\x04R\ftargetUserId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x94\x01\n" +
+	"\x19GetUserLiveStreamResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12*\n" +
+	"\x06stream\x18\x04 \x01(\v2\x12.livepb.LiveStreamR\x06stream2\xf8\x15\n" +
 	"\vLiveService\x12@\n" +
 	"\tStartLive\x12\x18.livepb.StartLiveRequest\x1a\x19.livepb.StartLiveResponse\x12=\n" +
 	"\bStopLive\x12\x17.livepb.StopLiveRequest\x1a\x18.livepb.StopLiveResponse\x12L\n" +
 	"\rGetLiveStream\x12\x1c.livepb.GetLiveStreamRequest\x1a\x1d.livepb.GetLiveStreamResponse\x12F\n" +
 	"\vGetLiveList\x12\x1a.livepb.GetLiveListRequest\x1a\x1b.livepb.GetLiveListResponse\x12O\n" +
-	"\x0eGetHotLiveList\x12\x1d.livepb.GetHotLiveListRequest\x1a\x1e.livepb.GetHotLiveListResponse\x12I\n" +
+	"\x0eGetHotLiveList\x12\x1d.livepb.GetHotLiveListRequest\x1a\x1e.livepb.GetHotLiveListResponse\x12j\n" +
+	"\x17UpdateLiveStreamPrivacy\x12&.livepb.UpdateLiveStreamPrivacyRequest\x1a'.livepb.UpdateLiveStreamPrivacyResponse\x12d\n" +
+	"\x15AuthenticateStreamKey\x12$.livepb.AuthenticateStreamKeyRequest\x1a%.livepb.AuthenticateStreamKeyResponse\x12L\n" +
+	"\rOnStreamEnded\x12\x1c.livepb.OnStreamEndedRequest\x1a\x1d.livepb.OnStreamEndedResponse\x12I\n" +
 	"\fJoinLiveRoom\x12\x1b.livepb.JoinLiveRoomRequest\x1a\x1c.livepb.JoinLiveRoomResponse\x12L\n" +
-	"\rLeaveLiveRoom\x12\x1c.livepb.LeaveLiveRoomRequest\x1a\x1d.livepb.LeaveLiveRoomResponse\x12X\n" +
-	"\x11GetLiveViewerList\x12 .livepb.GetLiveViewerListRequest\x1a!.livepb.GetLiveViewerListResponse\x12I\n" +
+	"\rLeaveLiveRoom\x12\x1c.livepb.LeaveLiveRoomRequest\x1a\x1d.livepb.LeaveLiveRoomResponse\x12@\n" +
+	"\tHeartbeat\x12\x18.livepb.HeartbeatRequest\x1a\x19.livepb.HeartbeatResponse\x12X\n" +
+	"\x11GetLiveViewerList\x12 .livepb.GetLiveViewerListRequest\x1a!.livepb.GetLiveViewerListResponse\x12N\n" +
+	"\x13SubscribeLiveEvents\x12\".livepb.SubscribeLiveEventsRequest\x1a\x11.livepb.LiveEvent0\x01\x12I\n" +
 	"\fSendLiveChat\x12\x1b.livepb.SendLiveChatRequest\x1a\x1c.livepb.SendLiveChatResponse\x12R\n" +
-	"\x0fGetLiveChatList\x12\x1e.livepb.GetLiveChatListRequest\x1a\x1f.livepb.GetLiveChatListResponse\x12I\n" +
+	"\x0fGetLiveChatList\x12\x1e.livepb.GetLiveChatListRequest\x1a\x1f.livepb.GetLiveChatListResponse\x12C\n" +
+	"\n" +
+	"MuteViewer\x12\x19.livepb.MuteViewerRequest\x1a\x1a.livepb.MuteViewerResponse\x12I\n" +
+	"\fUnmuteViewer\x12\x1b.livepb.UnmuteViewerRequest\x1a\x1c.livepb.UnmuteViewerResponse\x12I\n" +
 	"\fSendLiveGift\x12\x1b.livepb.SendLiveGiftRequest\x1a\x1c.livepb.SendLiveGiftResponse\x12R\n" +
-	"\x0fGetLiveGiftList\x12\x1e.livepb.GetLiveGiftListRequest\x1a\x1f.livepb.GetLiveGiftListResponse\x12=\n" +
+	"\x0fGetLiveGiftList\x12\x1e.livepb.GetLiveGiftListRequest\x1a\x1f.livepb.GetLiveGiftListResponse\x12^\n" +
+	"\x13GetUserLiveGiftList\x12\".livepb.GetUserLiveGiftListRequest\x1a#.livepb.GetUserLiveGiftListResponse\x12U\n" +
+	"\x10GetLiveGiftStats\x12\x1f.livepb.GetLiveGiftStatsRequest\x1a .livepb.GetLiveGiftStatsResponse\x12=\n" +
 	"\bLikeLive\x12\x17.livepb.LikeLiveRequest\x1a\x18.livepb.LikeLiveResponse\x12C\n" +
 	"\n" +
 	"SearchLive\x12\x19.livepb.SearchLiveRequest\x1a\x1a.livepb.SearchLiveResponse\x12X\n" +
 	"\x11GetLiveCategories\x12 .livepb.GetLiveCategoriesRequest\x1a!.livepb.GetLiveCategoriesResponse\x12I\n" +
 	"\fGetLiveStats\x12\x1b.livepb.GetLiveStatsRequest\x1a\x1c.livepb.GetLiveStatsResponse\x12R\n" +
-	"\x0fGetLivePlayback\x12\x1e.livepb.GetLivePlaybackRequest\x1a\x1f.livepb.GetLivePlaybackResponseB\x18Z\x16live_service/proto_genb\x06proto3"
+	"\x0fGetLivePlayback\x12\x1e.livepb.GetLivePlaybackRequest\x1a\x1f.livepb.GetLivePlaybackResponse\x12a\n" +
+	"\x14GetDailyLeaderboards\x12#.livepb.GetDailyLeaderboardsRequest\x1a$.livepb.GetDailyLeaderboardsResponse\x12[\n" +
+	"\x12RecomputeLiveStats\x12!.livepb.RecomputeLiveStatsRequest\x1a\".livepb.RecomputeLiveStatsResponse\x12L\n" +
+	"\rForceStopLive\x12\x1c.livepb.ForceStopLiveRequest\x1a\x1d.livepb.ForceStopLiveResponse\x12R\n" +
+	"\x11StreamViewerCount\x12 .livepb.StreamViewerCountRequest\x1a\x19.livepb.ViewerCountUpdate0\x01\x12@\n" +
+	"\tPauseLive\x12\x18.livepb.PauseLiveRequest\x1a\x19.livepb.PauseLiveResponse\x12C\n" +
+	"\n" +
+	"ResumeLive\x12\x19.livepb.ResumeLiveRequest\x1a\x1a.livepb.ResumeLiveResponse\x12F\n" +
+	"\vGetLiveRoom\x12\x1a.livepb.GetLiveRoomRequest\x1a\x1b.livepb.GetLiveRoomResponse\x12O\n" +
+	"\x0eRefundLiveGift\x12\x1d.livepb.RefundLiveGiftRequest\x1a\x1e.livepb.RefundLiveGiftResponse\x12X\n" +
+	"\x11GetUserLiveStream\x12 .livepb.GetUserLiveStreamRequest\x1a!.livepb.GetUserLiveStreamResponseB\x18Z\x16live_service/proto_genb\x06proto3"
 
 var (
 	file_proto_live_proto_rawDescOnce sync.Once
@@ -4151,109 +7216,192 @@ func file_proto_live_proto_rawDescGZIP() []byte {
 	return file_proto_live_proto_rawDescData
 }
 
-var file_proto_live_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_proto_live_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_proto_live_proto_goTypes = []any{
-	(*BaseRequest)(nil),               // 0: livepb.BaseRequest
-	(*BaseResponse)(nil),              // 1: livepb.BaseResponse
-	(*StartLiveRequest)(nil),          // 2: livepb.StartLiveRequest
-	(*StartLiveResponse)(nil),         // 3: livepb.StartLiveResponse
-	(*StopLiveRequest)(nil),           // 4: livepb.StopLiveRequest
-	(*StopLiveResponse)(nil),          // 5: livepb.StopLiveResponse
-	(*GetLiveStreamRequest)(nil),      // 6: livepb.GetLiveStreamRequest
-	(*GetLiveStreamResponse)(nil),     // 7: livepb.GetLiveStreamResponse
-	(*GetLiveListRequest)(nil),        // 8: livepb.GetLiveListRequest
-	(*GetLiveListResponse)(nil),       // 9: livepb.GetLiveListResponse
-	(*GetHotLiveListRequest)(nil),     // 10: livepb.GetHotLiveListRequest
-	(*GetHotLiveListResponse)(nil),    // 11: livepb.GetHotLiveListResponse
-	(*JoinLiveRoomRequest)(nil),       // 12: livepb.JoinLiveRoomRequest
-	(*JoinLiveRoomResponse)(nil),      // 13: livepb.JoinLiveRoomResponse
-	(*LeaveLiveRoomRequest)(nil),      // 14: livepb.LeaveLiveRoomRequest
-	(*LeaveLiveRoomResponse)(nil),     // 15: livepb.LeaveLiveRoomResponse
-	(*GetLiveViewerListRequest)(nil),  // 16: livepb.GetLiveViewerListRequest
-	(*GetLiveViewerListResponse)(nil), // 17: livepb.GetLiveViewerListResponse
-	(*SendLiveChatRequest)(nil),       // 18: livepb.SendLiveChatRequest
-	(*SendLiveChatResponse)(nil),      // 19: livepb.SendLiveChatResponse
-	(*GetLiveChatListRequest)(nil),    // 20: livepb.GetLiveChatListRequest
-	(*GetLiveChatListResponse)(nil),   // 21: livepb.GetLiveChatListResponse
-	(*SendLiveGiftRequest)(nil),       // 22: livepb.SendLiveGiftRequest
-	(*SendLiveGiftResponse)(nil),      // 23: livepb.SendLiveGiftResponse
-	(*GetLiveGiftListRequest)(nil),    // 24: livepb.GetLiveGiftListRequest
-	(*GetLiveGiftListResponse)(nil),   // 25: livepb.GetLiveGiftListResponse
-	(*LikeLiveRequest)(nil),           // 26: livepb.LikeLiveRequest
-	(*LikeLiveResponse)(nil),          // 27: livepb.LikeLiveResponse
-	(*SearchLiveRequest)(nil),         // 28: livepb.SearchLiveRequest
-	(*SearchLiveResponse)(nil),        // 29: livepb.SearchLiveResponse
-	(*GetLiveCategoriesRequest)(nil),  // 30: livepb.GetLiveCategoriesRequest
-	(*GetLiveCategoriesResponse)(nil), // 31: livepb.GetLiveCategoriesResponse
-	(*GetLiveStatsRequest)(nil),       // 32: livepb.GetLiveStatsRequest
-	(*GetLiveStatsResponse)(nil),      // 33: livepb.GetLiveStatsResponse
-	(*GetLivePlaybackRequest)(nil),    // 34: livepb.GetLivePlaybackRequest
-	(*GetLivePlaybackResponse)(nil),   // 35: livepb.GetLivePlaybackResponse
-	(*LiveStream)(nil),                // 36: livepb.LiveStream
-	(*LiveRoom)(nil),                  // 37: livepb.LiveRoom
-	(*LiveViewer)(nil),                // 38: livepb.LiveViewer
-	(*LiveChat)(nil),                  // 39: livepb.LiveChat
-	(*LiveGift)(nil),                  // 40: livepb.LiveGift
-	(*GiftConfig)(nil),                // 41: livepb.GiftConfig
-	(*LiveCategory)(nil),              // 42: livepb.LiveCategory
-	(*LiveStats)(nil),                 // 43: livepb.LiveStats
-	(*LivePlayback)(nil),              // 44: livepb.LivePlayback
-	(*GiftRankingItem)(nil),           // 45: livepb.GiftRankingItem
+	(*BaseRequest)(nil),                     // 0: livepb.BaseRequest
+	(*BaseResponse)(nil),                    // 1: livepb.BaseResponse
+	(*StartLiveRequest)(nil),                // 2: livepb.StartLiveRequest
+	(*StartLiveResponse)(nil),               // 3: livepb.StartLiveResponse
+	(*StopLiveRequest)(nil),                 // 4: livepb.StopLiveRequest
+	(*StopLiveResponse)(nil),                // 5: livepb.StopLiveResponse
+	(*AuthenticateStreamKeyRequest)(nil),    // 6: livepb.AuthenticateStreamKeyRequest
+	(*AuthenticateStreamKeyResponse)(nil),   // 7: livepb.AuthenticateStreamKeyResponse
+	(*OnStreamEndedRequest)(nil),            // 8: livepb.OnStreamEndedRequest
+	(*OnStreamEndedResponse)(nil),           // 9: livepb.OnStreamEndedResponse
+	(*UpdateLiveStreamPrivacyRequest)(nil),  // 10: livepb.UpdateLiveStreamPrivacyRequest
+	(*UpdateLiveStreamPrivacyResponse)(nil), // 11: livepb.UpdateLiveStreamPrivacyResponse
+	(*GetLiveStreamRequest)(nil),            // 12: livepb.GetLiveStreamRequest
+	(*GetLiveStreamResponse)(nil),           // 13: livepb.GetLiveStreamResponse
+	(*GetLiveListRequest)(nil),              // 14: livepb.GetLiveListRequest
+	(*GetLiveListResponse)(nil),             // 15: livepb.GetLiveListResponse
+	(*GetHotLiveListRequest)(nil),           // 16: livepb.GetHotLiveListRequest
+	(*GetHotLiveListResponse)(nil),          // 17: livepb.GetHotLiveListResponse
+	(*JoinLiveRoomRequest)(nil),             // 18: livepb.JoinLiveRoomRequest
+	(*JoinLiveRoomResponse)(nil),            // 19: livepb.JoinLiveRoomResponse
+	(*LeaveLiveRoomRequest)(nil),            // 20: livepb.LeaveLiveRoomRequest
+	(*LeaveLiveRoomResponse)(nil),           // 21: livepb.LeaveLiveRoomResponse
+	(*HeartbeatRequest)(nil),                // 22: livepb.HeartbeatRequest
+	(*HeartbeatResponse)(nil),               // 23: livepb.HeartbeatResponse
+	(*SubscribeLiveEventsRequest)(nil),      // 24: livepb.SubscribeLiveEventsRequest
+	(*LiveEvent)(nil),                       // 25: livepb.LiveEvent
+	(*GetLiveViewerListRequest)(nil),        // 26: livepb.GetLiveViewerListRequest
+	(*GetLiveViewerListResponse)(nil),       // 27: livepb.GetLiveViewerListResponse
+	(*SendLiveChatRequest)(nil),             // 28: livepb.SendLiveChatRequest
+	(*SendLiveChatResponse)(nil),            // 29: livepb.SendLiveChatResponse
+	(*GetLiveChatListRequest)(nil),          // 30: livepb.GetLiveChatListRequest
+	(*GetLiveChatListResponse)(nil),         // 31: livepb.GetLiveChatListResponse
+	(*MuteViewerRequest)(nil),               // 32: livepb.MuteViewerRequest
+	(*MuteViewerResponse)(nil),              // 33: livepb.MuteViewerResponse
+	(*UnmuteViewerRequest)(nil),             // 34: livepb.UnmuteViewerRequest
+	(*UnmuteViewerResponse)(nil),            // 35: livepb.UnmuteViewerResponse
+	(*SendLiveGiftRequest)(nil),             // 36: livepb.SendLiveGiftRequest
+	(*SendLiveGiftResponse)(nil),            // 37: livepb.SendLiveGiftResponse
+	(*GetLiveGiftListRequest)(nil),          // 38: livepb.GetLiveGiftListRequest
+	(*GetLiveGiftListResponse)(nil),         // 39: livepb.GetLiveGiftListResponse
+	(*GetUserLiveGiftListRequest)(nil),      // 40: livepb.GetUserLiveGiftListRequest
+	(*GetUserLiveGiftListResponse)(nil),     // 41: livepb.GetUserLiveGiftListResponse
+	(*GetLiveGiftStatsRequest)(nil),         // 42: livepb.GetLiveGiftStatsRequest
+	(*GetLiveGiftStatsResponse)(nil),        // 43: livepb.GetLiveGiftStatsResponse
+	(*LikeLiveRequest)(nil),                 // 44: livepb.LikeLiveRequest
+	(*LikeLiveResponse)(nil),                // 45: livepb.LikeLiveResponse
+	(*SearchLiveRequest)(nil),               // 46: livepb.SearchLiveRequest
+	(*SearchLiveResponse)(nil),              // 47: livepb.SearchLiveResponse
+	(*GetLiveCategoriesRequest)(nil),        // 48: livepb.GetLiveCategoriesRequest
+	(*GetLiveCategoriesResponse)(nil),       // 49: livepb.GetLiveCategoriesResponse
+	(*GetLiveStatsRequest)(nil),             // 50: livepb.GetLiveStatsRequest
+	(*GetLiveStatsResponse)(nil),            // 51: livepb.GetLiveStatsResponse
+	(*RecomputeLiveStatsRequest)(nil),       // 52: livepb.RecomputeLiveStatsRequest
+	(*RecomputeLiveStatsResponse)(nil),      // 53: livepb.RecomputeLiveStatsResponse
+	(*ForceStopLiveRequest)(nil),            // 54: livepb.ForceStopLiveRequest
+	(*ForceStopLiveResponse)(nil),           // 55: livepb.ForceStopLiveResponse
+	(*GetDailyLeaderboardsRequest)(nil),     // 56: livepb.GetDailyLeaderboardsRequest
+	(*GetDailyLeaderboardsResponse)(nil),    // 57: livepb.GetDailyLeaderboardsResponse
+	(*GetLivePlaybackRequest)(nil),          // 58: livepb.GetLivePlaybackRequest
+	(*GetLivePlaybackResponse)(nil),         // 59: livepb.GetLivePlaybackResponse
+	(*LiveStream)(nil),                      // 60: livepb.LiveStream
+	(*LiveRoom)(nil),                        // 61: livepb.LiveRoom
+	(*LiveViewer)(nil),                      // 62: livepb.LiveViewer
+	(*LiveChat)(nil),                        // 63: livepb.LiveChat
+	(*LiveGift)(nil),                        // 64: livepb.LiveGift
+	(*GiftConfig)(nil),                      // 65: livepb.GiftConfig
+	(*LiveCategory)(nil),                    // 66: livepb.LiveCategory
+	(*LiveStats)(nil),                       // 67: livepb.LiveStats
+	(*GiftStats)(nil),                       // 68: livepb.GiftStats
+	(*LivePlayback)(nil),                    // 69: livepb.LivePlayback
+	(*GiftRankingItem)(nil),                 // 70: livepb.GiftRankingItem
+	(*LeaderboardEntry)(nil),                // 71: livepb.LeaderboardEntry
+	(*StreamViewerCountRequest)(nil),        // 72: livepb.StreamViewerCountRequest
+	(*ViewerCountUpdate)(nil),               // 73: livepb.ViewerCountUpdate
+	(*PauseLiveRequest)(nil),                // 74: livepb.PauseLiveRequest
+	(*PauseLiveResponse)(nil),               // 75: livepb.PauseLiveResponse
+	(*ResumeLiveRequest)(nil),               // 76: livepb.ResumeLiveRequest
+	(*ResumeLiveResponse)(nil),              // 77: livepb.ResumeLiveResponse
+	(*GetLiveRoomRequest)(nil),              // 78: livepb.GetLiveRoomRequest
+	(*GetLiveRoomResponse)(nil),             // 79: livepb.GetLiveRoomResponse
+	(*RefundLiveGiftRequest)(nil),           // 80: livepb.RefundLiveGiftRequest
+	(*RefundLiveGiftResponse)(nil),          // 81: livepb.RefundLiveGiftResponse
+	(*GetUserLiveStreamRequest)(nil),        // 82: livepb.GetUserLiveStreamRequest
+	(*GetUserLiveStreamResponse)(nil),       // 83: livepb.GetUserLiveStreamResponse
 }
 var file_proto_live_proto_depIdxs = []int32{
-	36, // 0: livepb.StartLiveResponse.stream:type_name -> livepb.LiveStream
-	36, // 1: livepb.GetLiveStreamResponse.stream:type_name -> livepb.LiveStream
-	36, // 2: livepb.GetLiveListResponse.streams:type_name -> livepb.LiveStream
-	36, // 3: livepb.GetHotLiveListResponse.streams:type_name -> livepb.LiveStream
-	38, // 4: livepb.JoinLiveRoomResponse.viewer:type_name -> livepb.LiveViewer
-	38, // 5: livepb.GetLiveViewerListResponse.viewers:type_name -> livepb.LiveViewer
-	39, // 6: livepb.SendLiveChatResponse.chat:type_name -> livepb.LiveChat
-	39, // 7: livepb.GetLiveChatListResponse.chats:type_name -> livepb.LiveChat
-	40, // 8: livepb.SendLiveGiftResponse.gift:type_name -> livepb.LiveGift
-	40, // 9: livepb.GetLiveGiftListResponse.gifts:type_name -> livepb.LiveGift
-	36, // 10: livepb.SearchLiveResponse.streams:type_name -> livepb.LiveStream
-	42, // 11: livepb.GetLiveCategoriesResponse.categories:type_name -> livepb.LiveCategory
-	43, // 12: livepb.GetLiveStatsResponse.stats:type_name -> livepb.LiveStats
-	44, // 13: livepb.GetLivePlaybackResponse.playback:type_name -> livepb.LivePlayback
-	2,  // 14: livepb.LiveService.StartLive:input_type -> livepb.StartLiveRequest
-	4,  // 15: livepb.LiveService.StopLive:input_type -> livepb.StopLiveRequest
-	6,  // 16: livepb.LiveService.GetLiveStream:input_type -> livepb.GetLiveStreamRequest
-	8,  // 17: livepb.LiveService.GetLiveList:input_type -> livepb.GetLiveListRequest
-	10, // 18: livepb.LiveService.GetHotLiveList:input_type -> livepb.GetHotLiveListRequest
-	12, // 19: livepb.LiveService.JoinLiveRoom:input_type -> livepb.JoinLiveRoomRequest
-	14, // 20: livepb.LiveService.LeaveLiveRoom:input_type -> livepb.LeaveLiveRoomRequest
-	16, // 21: livepb.LiveService.GetLiveViewerList:input_type -> livepb.GetLiveViewerListRequest
-	18, // 22: livepb.LiveService.SendLiveChat:input_type -> livepb.SendLiveChatRequest
-	20, // 23: livepb.LiveService.GetLiveChatList:input_type -> livepb.GetLiveChatListRequest
-	22, // 24: livepb.LiveService.SendLiveGift:input_type -> livepb.SendLiveGiftRequest
-	24, // 25: livepb.LiveService.GetLiveGiftList:input_type -> livepb.GetLiveGiftListRequest
-	26, // 26: livepb.LiveService.LikeLive:input_type -> livepb.LikeLiveRequest
-	28, // 27: livepb.LiveService.SearchLive:input_type -> livepb.SearchLiveRequest
-	30, // 28: livepb.LiveService.GetLiveCategories:input_type -> livepb.GetLiveCategoriesRequest
-	32, // 29: livepb.LiveService.GetLiveStats:input_type -> livepb.GetLiveStatsRequest
-	34, // 30: livepb.LiveService.GetLivePlayback:input_type -> livepb.GetLivePlaybackRequest
-	3,  // 31: livepb.LiveService.StartLive:output_type -> livepb.StartLiveResponse
-	5,  // 32: livepb.LiveService.StopLive:output_type -> livepb.StopLiveResponse
-	7,  // 33: livepb.LiveService.GetLiveStream:output_type -> livepb.GetLiveStreamResponse
-	9,  // 34: livepb.LiveService.GetLiveList:output_type -> livepb.GetLiveListResponse
-	11, // 35: livepb.LiveService.GetHotLiveList:output_type -> livepb.GetHotLiveListResponse
-	13, // 36: livepb.LiveService.JoinLiveRoom:output_type -> livepb.JoinLiveRoomResponse
-	15, // 37: livepb.LiveService.LeaveLiveRoom:output_type -> livepb.LeaveLiveRoomResponse
-	17, // 38: livepb.LiveService.GetLiveViewerList:output_type -> livepb.GetLiveViewerListResponse
-	19, // 39: livepb.LiveService.SendLiveChat:output_type -> livepb.SendLiveChatResponse
-	21, // 40: livepb.LiveService.GetLiveChatList:output_type -> livepb.GetLiveChatListResponse
-	23, // 41: livepb.LiveService.SendLiveGift:output_type -> livepb.SendLiveGiftResponse
-	25, // 42: livepb.LiveService.GetLiveGiftList:output_type -> livepb.GetLiveGiftListResponse
-	27, // 43: livepb.LiveService.LikeLive:output_type -> livepb.LikeLiveResponse
-	29, // 44: livepb.LiveService.SearchLive:output_type -> livepb.SearchLiveResponse
-	31, // 45: livepb.LiveService.GetLiveCategories:output_type -> livepb.GetLiveCategoriesResponse
-	33, // 46: livepb.LiveService.GetLiveStats:output_type -> livepb.GetLiveStatsResponse
-	35, // 47: livepb.LiveService.GetLivePlayback:output_type -> livepb.GetLivePlaybackResponse
-	31, // [31:48] is the sub-list for method output_type
-	14, // [14:31] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	60, // 0: livepb.StartLiveResponse.stream:type_name -> livepb.LiveStream
+	60, // 1: livepb.GetLiveStreamResponse.stream:type_name -> livepb.LiveStream
+	60, // 2: livepb.GetLiveListResponse.streams:type_name -> livepb.LiveStream
+	60, // 3: livepb.GetHotLiveListResponse.streams:type_name -> livepb.LiveStream
+	62, // 4: livepb.JoinLiveRoomResponse.viewer:type_name -> livepb.LiveViewer
+	62, // 5: livepb.GetLiveViewerListResponse.viewers:type_name -> livepb.LiveViewer
+	63, // 6: livepb.SendLiveChatResponse.chat:type_name -> livepb.LiveChat
+	63, // 7: livepb.GetLiveChatListResponse.chats:type_name -> livepb.LiveChat
+	64, // 8: livepb.SendLiveGiftResponse.gift:type_name -> livepb.LiveGift
+	64, // 9: livepb.GetLiveGiftListResponse.gifts:type_name -> livepb.LiveGift
+	64, // 10: livepb.GetUserLiveGiftListResponse.gifts:type_name -> livepb.LiveGift
+	68, // 11: livepb.GetLiveGiftStatsResponse.stats:type_name -> livepb.GiftStats
+	60, // 12: livepb.SearchLiveResponse.streams:type_name -> livepb.LiveStream
+	66, // 13: livepb.GetLiveCategoriesResponse.categories:type_name -> livepb.LiveCategory
+	67, // 14: livepb.GetLiveStatsResponse.stats:type_name -> livepb.LiveStats
+	67, // 15: livepb.RecomputeLiveStatsResponse.stats:type_name -> livepb.LiveStats
+	60, // 16: livepb.ForceStopLiveResponse.stream:type_name -> livepb.LiveStream
+	71, // 17: livepb.GetDailyLeaderboardsResponse.top_streamers:type_name -> livepb.LeaderboardEntry
+	71, // 18: livepb.GetDailyLeaderboardsResponse.top_streams:type_name -> livepb.LeaderboardEntry
+	69, // 19: livepb.GetLivePlaybackResponse.playback:type_name -> livepb.LivePlayback
+	61, // 20: livepb.GetLiveRoomResponse.room:type_name -> livepb.LiveRoom
+	64, // 21: livepb.RefundLiveGiftResponse.gift:type_name -> livepb.LiveGift
+	60, // 22: livepb.GetUserLiveStreamResponse.stream:type_name -> livepb.LiveStream
+	2,  // 23: livepb.LiveService.StartLive:input_type -> livepb.StartLiveRequest
+	4,  // 24: livepb.LiveService.StopLive:input_type -> livepb.StopLiveRequest
+	12, // 25: livepb.LiveService.GetLiveStream:input_type -> livepb.GetLiveStreamRequest
+	14, // 26: livepb.LiveService.GetLiveList:input_type -> livepb.GetLiveListRequest
+	16, // 27: livepb.LiveService.GetHotLiveList:input_type -> livepb.GetHotLiveListRequest
+	10, // 28: livepb.LiveService.UpdateLiveStreamPrivacy:input_type -> livepb.UpdateLiveStreamPrivacyRequest
+	6,  // 29: livepb.LiveService.AuthenticateStreamKey:input_type -> livepb.AuthenticateStreamKeyRequest
+	8,  // 30: livepb.LiveService.OnStreamEnded:input_type -> livepb.OnStreamEndedRequest
+	18, // 31: livepb.LiveService.JoinLiveRoom:input_type -> livepb.JoinLiveRoomRequest
+	20, // 32: livepb.LiveService.LeaveLiveRoom:input_type -> livepb.LeaveLiveRoomRequest
+	22, // 33: livepb.LiveService.Heartbeat:input_type -> livepb.HeartbeatRequest
+	26, // 34: livepb.LiveService.GetLiveViewerList:input_type -> livepb.GetLiveViewerListRequest
+	24, // 35: livepb.LiveService.SubscribeLiveEvents:input_type -> livepb.SubscribeLiveEventsRequest
+	28, // 36: livepb.LiveService.SendLiveChat:input_type -> livepb.SendLiveChatRequest
+	30, // 37: livepb.LiveService.GetLiveChatList:input_type -> livepb.GetLiveChatListRequest
+	32, // 38: livepb.LiveService.MuteViewer:input_type -> livepb.MuteViewerRequest
+	34, // 39: livepb.LiveService.UnmuteViewer:input_type -> livepb.UnmuteViewerRequest
+	36, // 40: livepb.LiveService.SendLiveGift:input_type -> livepb.SendLiveGiftRequest
+	38, // 41: livepb.LiveService.GetLiveGiftList:input_type -> livepb.GetLiveGiftListRequest
+	40, // 42: livepb.LiveService.GetUserLiveGiftList:input_type -> livepb.GetUserLiveGiftListRequest
+	42, // 43: livepb.LiveService.GetLiveGiftStats:input_type -> livepb.GetLiveGiftStatsRequest
+	44, // 44: livepb.LiveService.LikeLive:input_type -> livepb.LikeLiveRequest
+	46, // 45: livepb.LiveService.SearchLive:input_type -> livepb.SearchLiveRequest
+	48, // 46: livepb.LiveService.GetLiveCategories:input_type -> livepb.GetLiveCategoriesRequest
+	50, // 47: livepb.LiveService.GetLiveStats:input_type -> livepb.GetLiveStatsRequest
+	58, // 48: livepb.LiveService.GetLivePlayback:input_type -> livepb.GetLivePlaybackRequest
+	56, // 49: livepb.LiveService.GetDailyLeaderboards:input_type -> livepb.GetDailyLeaderboardsRequest
+	52, // 50: livepb.LiveService.RecomputeLiveStats:input_type -> livepb.RecomputeLiveStatsRequest
+	54, // 51: livepb.LiveService.ForceStopLive:input_type -> livepb.ForceStopLiveRequest
+	72, // 52: livepb.LiveService.StreamViewerCount:input_type -> livepb.StreamViewerCountRequest
+	74, // 53: livepb.LiveService.PauseLive:input_type -> livepb.PauseLiveRequest
+	76, // 54: livepb.LiveService.ResumeLive:input_type -> livepb.ResumeLiveRequest
+	78, // 55: livepb.LiveService.GetLiveRoom:input_type -> livepb.GetLiveRoomRequest
+	80, // 56: livepb.LiveService.RefundLiveGift:input_type -> livepb.RefundLiveGiftRequest
+	82, // 57: livepb.LiveService.GetUserLiveStream:input_type -> livepb.GetUserLiveStreamRequest
+	3,  // 58: livepb.LiveService.StartLive:output_type -> livepb.StartLiveResponse
+	5,  // 59: livepb.LiveService.StopLive:output_type -> livepb.StopLiveResponse
+	13, // 60: livepb.LiveService.GetLiveStream:output_type -> livepb.GetLiveStreamResponse
+	15, // 61: livepb.LiveService.GetLiveList:output_type -> livepb.GetLiveListResponse
+	17, // 62: livepb.LiveService.GetHotLiveList:output_type -> livepb.GetHotLiveListResponse
+	11, // 63: livepb.LiveService.UpdateLiveStreamPrivacy:output_type -> livepb.UpdateLiveStreamPrivacyResponse
+	7,  // 64: livepb.LiveService.AuthenticateStreamKey:output_type -> livepb.AuthenticateStreamKeyResponse
+	9,  // 65: livepb.LiveService.OnStreamEnded:output_type -> livepb.OnStreamEndedResponse
+	19, // 66: livepb.LiveService.JoinLiveRoom:output_type -> livepb.JoinLiveRoomResponse
+	21, // 67: livepb.LiveService.LeaveLiveRoom:output_type -> livepb.LeaveLiveRoomResponse
+	23, // 68: livepb.LiveService.Heartbeat:output_type -> livepb.HeartbeatResponse
+	27, // 69: livepb.LiveService.GetLiveViewerList:output_type -> livepb.GetLiveViewerListResponse
+	25, // 70: livepb.LiveService.SubscribeLiveEvents:output_type -> livepb.LiveEvent
+	29, // 71: livepb.LiveService.SendLiveChat:output_type -> livepb.SendLiveChatResponse
+	31, // 72: livepb.LiveService.GetLiveChatList:output_type -> livepb.GetLiveChatListResponse
+	33, // 73: livepb.LiveService.MuteViewer:output_type -> livepb.MuteViewerResponse
+	35, // 74: livepb.LiveService.UnmuteViewer:output_type -> livepb.UnmuteViewerResponse
+	37, // 75: livepb.LiveService.SendLiveGift:output_type -> livepb.SendLiveGiftResponse
+	39, // 76: livepb.LiveService.GetLiveGiftList:output_type -> livepb.GetLiveGiftListResponse
+	41, // 77: livepb.LiveService.GetUserLiveGiftList:output_type -> livepb.GetUserLiveGiftListResponse
+	43, // 78: livepb.LiveService.GetLiveGiftStats:output_type -> livepb.GetLiveGiftStatsResponse
+	45, // 79: livepb.LiveService.LikeLive:output_type -> livepb.LikeLiveResponse
+	47, // 80: livepb.LiveService.SearchLive:output_type -> livepb.SearchLiveResponse
+	49, // 81: livepb.LiveService.GetLiveCategories:output_type -> livepb.GetLiveCategoriesResponse
+	51, // 82: livepb.LiveService.GetLiveStats:output_type -> livepb.GetLiveStatsResponse
+	59, // 83: livepb.LiveService.GetLivePlayback:output_type -> livepb.GetLivePlaybackResponse
+	57, // 84: livepb.LiveService.GetDailyLeaderboards:output_type -> livepb.GetDailyLeaderboardsResponse
+	53, // 85: livepb.LiveService.RecomputeLiveStats:output_type -> livepb.RecomputeLiveStatsResponse
+	55, // 86: livepb.LiveService.ForceStopLive:output_type -> livepb.ForceStopLiveResponse
+	73, // 87: livepb.LiveService.StreamViewerCount:output_type -> livepb.ViewerCountUpdate
+	75, // 88: livepb.LiveService.PauseLive:output_type -> livepb.PauseLiveResponse
+	77, // 89: livepb.LiveService.ResumeLive:output_type -> livepb.ResumeLiveResponse
+	79, // 90: livepb.LiveService.GetLiveRoom:output_type -> livepb.GetLiveRoomResponse
+	81, // 91: livepb.LiveService.RefundLiveGift:output_type -> livepb.RefundLiveGiftResponse
+	83, // 92: livepb.LiveService.GetUserLiveStream:output_type -> livepb.GetUserLiveStreamResponse
+	58, // [58:93] is the sub-list for method output_type
+	23, // [23:58] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_live_proto_init() }
@@ -4267,7 +7415,7 @@ func file_proto_live_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_live_proto_rawDesc), len(file_proto_live_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
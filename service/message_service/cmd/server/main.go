@@ -8,18 +8,26 @@ import (
 	"message_service/internal/discovery"
 	"message_service/internal/handler"
 	"message_service/internal/model"
+	"message_service/internal/repository"
+	"message_service/internal/service"
 	"message_service/pkg/database"
+	"message_service/pkg/grpctls"
+	"message_service/pkg/interceptors"
 	"message_service/pkg/logger"
+	"message_service/pkg/retry"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
+	"net/http"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"gorm.io/gorm"
+
+	healthcheck "common/health"
+	"common/lifecycle"
 )
 
 func main() {
@@ -40,6 +48,10 @@ func main() {
 		Level:      cfg.Logger.Level,
 		Format:     cfg.Logger.Format,
 		OutputPath: cfg.Logger.OutputPath,
+		MaxSize:    cfg.Logger.MaxSize,
+		MaxAge:     cfg.Logger.MaxAge,
+		MaxBackups: cfg.Logger.MaxBackups,
+		Compress:   cfg.Logger.Compress,
 	})
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
@@ -51,54 +63,101 @@ func main() {
 	log.Printf("Attempting to connect to database")
 	log.Printf("Database config: Host=%s, Port=%d, Username=%s, Database=%s",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.Username, cfg.Database.Database)
-	db, err := database.NewMySQLConnection(cfg.Database)
+	connectRetry := retry.Options{
+		Attempts:    cfg.ConnectRetry.Attempts,
+		Interval:    cfg.ConnectRetry.Interval,
+		MaxInterval: cfg.ConnectRetry.MaxInterval,
+	}
+	db, err := retry.Do(context.Background(), connectRetry, logger, "mysql", func() (*gorm.DB, error) {
+		return database.NewMySQLConnection(cfg.Database)
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	logger.Info("Database connected successfully")
-	defer func() {
-		sqlDB, _ := db.DB()
-		if sqlDB != nil {
-			sqlDB.Close()
-		}
-	}()
 
 	// 设置模型数据库连接
 	model.SetDB(db)
 	logger.Info("Database models initialized successfully")
 
 	// 4. 初始化Redis连接
-	redisClient, err := database.NewRedisClient(cfg.Redis)
+	redisClient, err := retry.Do(context.Background(), connectRetry, logger, "redis", func() (redis.UniversalClient, error) {
+		return database.NewRedisClient(cfg.Redis)
+	})
 	if err != nil {
 		logger.Fatal("Failed to connect to redis", "error", err)
 	}
 	logger.Info("Redis connected successfully")
-	defer redisClient.Close()
 
 	// 5. 初始化etcd服务注册
-	etcdDiscovery, err := discovery.NewEtcdDiscovery(cfg.Etcd.Endpoints, "user-service")
+	etcdDiscovery, err := retry.Do(context.Background(), connectRetry, logger, "etcd", func() (*discovery.EtcdDiscovery, error) {
+		return discovery.NewEtcdDiscovery(cfg.Etcd.Endpoints, "user-service")
+	})
 	if err != nil {
 		logger.Fatal("Failed to connect to etcd", "error", err)
 	}
-	defer etcdDiscovery.Close()
 
-	// 6. 创建gRPC服务器
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor(logger)),
-	)
-
-	// 7. 注册健康检查服务
+	// 6. 创建gRPC服务器，未开启TLS时使用明文连接
+	serverCreds, err := grpctls.ServerCredentials(grpctls.Options{
+		Enabled:    cfg.Server.TLS.Enabled,
+		CertFile:   cfg.Server.TLS.CertFile,
+		KeyFile:    cfg.Server.TLS.KeyFile,
+		CAFile:     cfg.Server.TLS.ClientCAFile,
+		ClientAuth: cfg.Server.TLS.ClientAuth,
+	})
+	if err != nil {
+		logger.Fatal("Failed to load gRPC server TLS credentials", "error", err)
+	}
+	if !cfg.Server.TLS.Enabled && cfg.Server.Mode == "release" {
+		logger.Warn("gRPC TLS is disabled, traffic between services is not encrypted")
+	}
+	// 拦截器按添加顺序执行，请求日志在最外层，panic恢复紧贴处理函数
+	chain := interceptors.Chain(
+		unaryInterceptor(logger),
+		interceptors.UnaryRecovery(logPanic(logger)),
+	).Stream(interceptors.StreamRecovery(logPanic(logger)))
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(serverCreds)}, chain.ServerOptions()...)...)
+
+	// 7. 注册健康检查服务，存活与就绪分开上报
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
-	healthServer.SetServingStatus("user_service", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthChecker := healthcheck.NewChecker(healthServer, "user_service", cfg.Health.CheckInterval, cfg.Health.CheckTimeout, logger)
+	healthChecker.AddCheck("database", healthcheck.DatabaseCheck(db))
+	healthChecker.AddCheck("redis", healthcheck.RedisCheck(redisClient))
+	healthChecker.AddCheck("etcd", etcdDiscovery.Ping)
+	healthCtx, cancelHealth := context.WithCancel(context.Background())
+	healthChecker.Start(healthCtx)
+
+	// HTTP探针（/livez、/readyz），供Kubernetes使用
+	var healthHTTPServer *http.Server
+	if cfg.Health.HTTPPort > 0 {
+		healthHTTPServer = &http.Server{
+			Addr:    fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Health.HTTPPort),
+			Handler: healthChecker.Handler(),
+		}
+		go func() {
+			logger.Info("Health HTTP server starting", "address", healthHTTPServer.Addr)
+			if err := healthHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Health HTTP server failed", "error", err)
+			}
+		}()
+	}
 
 	// 8. 注册用户服务
 	userHandler := handler.NewUserServiceHandler(cfg, logger, db, redisClient)
 	proto_gen.RegisterUserServiceServer(grpcServer, userHandler)
 	logger.Info("User service registered")
 
-	// 9. 注册反射服务（用于调试）
-	reflection.Register(grpcServer)
+	// 启动用户通知事件消费
+	notificationService := service.NewNotificationService(logger, repository.NewNotificationRepository(db, redisClient))
+	notificationConsumer := service.NewNotificationConsumer(redisClient, notificationService, cfg.Notification, logger)
+	notificationConsumer.Start()
+
+	// 9. 注册反射服务（用于调试，release模式默认关闭）
+	if cfg.Server.ReflectionEnabled() {
+		reflection.Register(grpcServer)
+		logger.Info("gRPC reflection registered")
+	}
 
 	// 10. 启动gRPC服务器
 	go func() {
@@ -122,20 +181,46 @@ func main() {
 	logger.Info("Service registered to etcd", "address", serviceAddr)
 
 	// 12. 等待中断信号
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-	<-sigChan
-
-	logger.Info("Shutting down server...")
-
-	// 13. 设置健康检查为不健康状态
-	healthServer.SetServingStatus("user_service", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	sig := lifecycle.WaitForSignal()
+	logger.Info("Shutting down server...", "signal", sig.String())
+
+	// 13. 按顺序退出：先停止接收流量并排空在途请求，再关闭依赖
+	shutdown := lifecycle.New(logger, lifecycle.DefaultStepTimeout)
+	shutdown.Add("health", func(ctx context.Context) error {
+		healthChecker.Shutdown()
+		return nil
+	})
+	shutdown.Add("grpc", lifecycle.GRPCServer(grpcServer))
+	shutdown.Add("notification_consumer", notificationConsumer.Stop)
+	shutdown.Add("redis", lifecycle.Closer(redisClient.Close))
+	shutdown.Add("database", lifecycle.Closer(func() error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	}))
+	shutdown.Add("etcd", lifecycle.Closer(etcdDiscovery.Close))
+	// 健康检查最后停止，排空请求和关闭依赖期间/readyz持续返回503
+	shutdown.Add("health_checker", func(ctx context.Context) error {
+		cancelHealth()
+		return nil
+	})
+	if healthHTTPServer != nil {
+		shutdown.Add("health_http", lifecycle.HTTPServer(healthHTTPServer))
+	}
+	shutdown.Run()
 
-	// 14. 停止gRPC服务器
-	grpcServer.GracefulStop()
 	logger.Info("Server stopped gracefully")
 }
 
+// logPanic 记录gRPC处理函数中的panic
+func logPanic(log logger.Logger) interceptors.PanicHandler {
+	return func(ctx context.Context, method string, p interface{}, stack []byte) {
+		log.Error("gRPC handler panic", "method", method, "panic", p, "stack", string(stack))
+	}
+}
+
 // unaryInterceptor gRPC一元拦截器
 func unaryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -91,6 +91,16 @@ func (d *EtcdDiscovery) Deregister() error {
 	return nil
 }
 
+// Ping 检查etcd连通性
+func (d *EtcdDiscovery) Ping(ctx context.Context) error {
+	endpoints := d.client.Endpoints()
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no etcd endpoints configured")
+	}
+	_, err := d.client.Status(ctx, endpoints[0])
+	return err
+}
+
 // Close 关闭etcd客户端
 func (d *EtcdDiscovery) Close() error {
 	if err := d.Deregister(); err != nil {
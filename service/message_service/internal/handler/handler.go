@@ -24,7 +24,7 @@ type UserServiceHandler struct {
 }
 
 // NewUserServiceHandler 创建用户服务处理器
-func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis *redis.Client) *UserServiceHandler {
+func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis redis.UniversalClient) *UserServiceHandler {
 	// 创建认证服务
 	refreshSecret := cfg.JWT.RefreshSecret
 	if refreshSecret == "" {
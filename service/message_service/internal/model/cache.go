@@ -27,6 +27,9 @@ const (
 	// 计数器相关
 	UserCounterKey   = "counter:user:%s:%d" // 用户计数器
 	GlobalCounterKey = "counter:global:%s"  // 全局计数器
+
+	// 通知推送频道，长连接网关按用户订阅后推送给在线客户端
+	NotificationPushChannel = "notification:push:%d"
 )
 
 // CacheTTL 缓存过期时间定义
@@ -180,6 +183,11 @@ func GetGlobalCounterKey(counterType string) string {
 	return fmt.Sprintf(GlobalCounterKey, counterType)
 }
 
+// GetNotificationPushChannel 获取用户通知推送频道
+func GetNotificationPushChannel(userID uint64) string {
+	return fmt.Sprintf(NotificationPushChannel, userID)
+}
+
 // ToJSON 转换为JSON字符串
 func (c *UserCache) ToJSON() (string, error) {
 	data, err := json.Marshal(c)
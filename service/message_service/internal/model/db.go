@@ -50,4 +50,5 @@ var (
 	_ UserTabler = (*UserFollow)(nil)
 	_ UserTabler = (*UserStats)(nil)
 	_ UserTabler = (*UserStatsDaily)(nil)
+	_ UserTabler = (*Notification)(nil)
 )
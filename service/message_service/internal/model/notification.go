@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// 通知类型，与各业务服务pkg/notify中定义的类型一致
+const (
+	NotificationTypeGift          = "gift"           // 收到礼物
+	NotificationTypeFollow        = "follow"         // 新增粉丝
+	NotificationTypeAuditRejected = "audit_rejected" // 内容审核未通过
+)
+
+// Notification 用户通知
+type Notification struct {
+	ID        uint64     `gorm:"primaryKey;autoIncrement;comment:通知ID" json:"id"`
+	UserID    uint64     `gorm:"not null;index:idx_notification_user_read,priority:1;comment:接收者" json:"user_id"`
+	Type      string     `gorm:"size:32;not null;comment:通知类型" json:"type"`
+	ActorID   uint64     `gorm:"default:0;comment:触发者,系统通知为0" json:"actor_id"`
+	TargetID  string     `gorm:"size:64;comment:关联对象ID" json:"target_id"`
+	Title     string     `gorm:"size:100;comment:标题" json:"title"`
+	Content   string     `gorm:"size:500;comment:内容" json:"content"`
+	IsRead    bool       `gorm:"default:false;index:idx_notification_user_read,priority:2;comment:是否已读" json:"is_read"`
+	ReadAt    *time.Time `gorm:"comment:阅读时间" json:"read_at"`
+	CreatedAt time.Time  `gorm:"comment:创建时间" json:"created_at"`
+}
+
+// TableName 设置表名
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// NotificationEvent 业务服务发布的通知事件，字段与pkg/notify.Notification一致
+type NotificationEvent struct {
+	Type      string `json:"type"`
+	UserID    uint64 `json:"user_id"`
+	ActorID   uint64 `json:"actor_id,omitempty"`
+	TargetID  string `json:"target_id,omitempty"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Timestamp int64  `json:"timestamp"` // 事件时间(毫秒)
+}
+
+// ToNotification 转换为待保存的通知，事件时间缺失时使用当前时间
+func (e *NotificationEvent) ToNotification() *Notification {
+	createdAt := time.Now()
+	if e.Timestamp > 0 {
+		createdAt = time.UnixMilli(e.Timestamp)
+	}
+	return &Notification{
+		UserID:    e.UserID,
+		Type:      e.Type,
+		ActorID:   e.ActorID,
+		TargetID:  e.TargetID,
+		Title:     e.Title,
+		Content:   e.Content,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"message_service/internal/model"
+)
+
+// NotificationRepository 用户通知数据访问接口
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, notification *model.Notification) error
+	ListNotifications(ctx context.Context, userID uint64, unreadOnly bool, page, pageSize int) ([]*model.Notification, int64, error)
+	CountUnreadNotifications(ctx context.Context, userID uint64) (int64, error)
+	MarkNotificationsRead(ctx context.Context, userID uint64, notificationIDs []uint64) (int64, error)
+
+	// PublishNotification 推送通知给在线的接收者
+	PublishNotification(ctx context.Context, notification *model.Notification) error
+}
+
+// notificationRepository 用户通知数据访问实现
+type notificationRepository struct {
+	db    *gorm.DB
+	redis redis.UniversalClient
+}
+
+// NewNotificationRepository 创建用户通知数据访问对象
+func NewNotificationRepository(db *gorm.DB, redis redis.UniversalClient) NotificationRepository {
+	return &notificationRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// CreateNotification 保存通知
+func (r *notificationRepository) CreateNotification(ctx context.Context, notification *model.Notification) error {
+	if err := r.db.WithContext(ctx).Create(notification).Error; err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+// ListNotifications 按时间倒序获取用户通知，unreadOnly为true时只返回未读通知
+func (r *notificationRepository) ListNotifications(ctx context.Context, userID uint64, unreadOnly bool, page, pageSize int) ([]*model.Notification, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var notifications []*model.Notification
+	if err := query.Order("id DESC").Scopes(model.Paginate(page, pageSize)).Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return notifications, total, nil
+}
+
+// CountUnreadNotifications 统计用户未读通知数
+func (r *notificationRepository) CountUnreadNotifications(ctx context.Context, userID uint64) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkNotificationsRead 将用户的通知标记为已读，notificationIDs为空时标记全部，返回实际标记的条数
+// 只更新属于该用户的通知，其他用户的通知ID会被忽略
+func (r *notificationRepository) MarkNotificationsRead(ctx context.Context, userID uint64, notificationIDs []uint64) (int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false)
+	if len(notificationIDs) > 0 {
+		query = query.Where("id IN ?", notificationIDs)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"is_read": true,
+		"read_at": time.Now(),
+	})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// PublishNotification 通过Redis发布通知，接收者不在线时消息直接丢弃，上线后通过通知列表获取
+func (r *notificationRepository) PublishNotification(ctx context.Context, notification *model.Notification) error {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+	if err := r.redis.Publish(ctx, model.GetNotificationPushChannel(notification.UserID), payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish notification: %w", err)
+	}
+	return nil
+}
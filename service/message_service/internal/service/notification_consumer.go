@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"message_service/internal/config"
+	"message_service/internal/model"
+	"message_service/pkg/logger"
+)
+
+// 通知事件消费默认参数
+const (
+	DefaultNotificationQueueKey = "notification:events" // 与直播、审核等服务约定的通知事件队列
+	notificationPopTimeout      = time.Second           // 阻塞读取超时，同时决定停止时的最长等待
+	notificationErrorBackoff    = time.Second           // 读取队列失败后的等待时间
+	notificationDeliverTimeout  = 5 * time.Second
+)
+
+// NotificationConsumer 通知事件消费者
+// 从Redis队列中读取各服务发布的通知事件，保存后推送给接收者；单个事件失败时记录日志后丢弃，不阻塞后续事件
+type NotificationConsumer struct {
+	redis    redis.UniversalClient
+	svc      NotificationService
+	logger   logger.Logger
+	queueKey string
+	workers  int
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewNotificationConsumer 创建通知事件消费者
+func NewNotificationConsumer(redisClient redis.UniversalClient, svc NotificationService, cfg config.NotificationConfig, log logger.Logger) *NotificationConsumer {
+	queueKey := cfg.QueueKey
+	if queueKey == "" {
+		queueKey = DefaultNotificationQueueKey
+	}
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	return &NotificationConsumer{
+		redis:    redisClient,
+		svc:      svc,
+		logger:   log,
+		queueKey: queueKey,
+		workers:  workers,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start 启动消费协程
+func (c *NotificationConsumer) Start() {
+	for i := 0; i < c.workers; i++ {
+		c.wg.Add(1)
+		go c.run()
+	}
+	c.logger.Info("Notification consumer started", "queue", c.queueKey, "workers", c.workers)
+}
+
+func (c *NotificationConsumer) run() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		default:
+		}
+
+		result, err := c.redis.BRPop(context.Background(), notificationPopTimeout, c.queueKey).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			c.logger.Warn("Failed to read notification events", "queue", c.queueKey, "error", err)
+			select {
+			case <-time.After(notificationErrorBackoff):
+			case <-c.stopCh:
+				return
+			}
+			continue
+		}
+
+		// BRPOP返回[队列名, 事件]
+		c.handle(result[1])
+	}
+}
+
+// handle 处理单个通知事件
+func (c *NotificationConsumer) handle(payload string) {
+	var event model.NotificationEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		c.logger.Warn("Dropping malformed notification event", "error", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), notificationDeliverTimeout)
+	defer cancel()
+	if _, err := c.svc.Deliver(ctx, &event); err != nil {
+		if errors.Is(err, ErrInvalidNotification) {
+			c.logger.Warn("Dropping invalid notification event", "type", event.Type, "userID", event.UserID)
+			return
+		}
+		c.logger.Error("Failed to deliver notification", "type", event.Type, "userID", event.UserID, "error", err)
+	}
+}
+
+// Stop 停止消费并等待正在处理的事件完成
+func (c *NotificationConsumer) Stop(ctx context.Context) error {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
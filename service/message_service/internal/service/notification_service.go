@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"message_service/internal/model"
+	"message_service/internal/repository"
+	"message_service/pkg/logger"
+)
+
+// ErrInvalidNotification 通知事件缺少接收者或类型
+var ErrInvalidNotification = errors.New("invalid notification")
+
+// NotificationList 用户通知列表
+type NotificationList struct {
+	Notifications []*model.Notification
+	Total         int64 // 符合筛选条件的通知总数
+	Unread        int64 // 未读通知总数
+}
+
+// NotificationService 用户通知服务接口
+type NotificationService interface {
+	// Deliver 保存业务服务发布的通知并推送给在线的接收者
+	Deliver(ctx context.Context, event *model.NotificationEvent) (*model.Notification, error)
+	ListNotifications(ctx context.Context, userID uint64, unreadOnly bool, page, pageSize int) (*NotificationList, error)
+	// MarkRead 将通知标记为已读，notificationIDs为空时标记全部，返回实际标记的条数
+	MarkRead(ctx context.Context, userID uint64, notificationIDs []uint64) (int64, error)
+}
+
+// notificationService 用户通知服务实现
+type notificationService struct {
+	logger logger.Logger
+	repo   repository.NotificationRepository
+}
+
+// NewNotificationService 创建用户通知服务
+func NewNotificationService(log logger.Logger, repo repository.NotificationRepository) NotificationService {
+	return &notificationService{
+		logger: log,
+		repo:   repo,
+	}
+}
+
+// Deliver 保存并推送通知，推送失败不影响保存结果，客户端可通过通知列表获取
+func (s *notificationService) Deliver(ctx context.Context, event *model.NotificationEvent) (*model.Notification, error) {
+	if event.UserID == 0 || event.Type == "" {
+		return nil, ErrInvalidNotification
+	}
+
+	notification := event.ToNotification()
+	if err := s.repo.CreateNotification(ctx, notification); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.PublishNotification(ctx, notification); err != nil {
+		s.logger.Warn("Failed to push notification", "notificationID", notification.ID, "userID", notification.UserID, "error", err)
+	}
+	return notification, nil
+}
+
+// ListNotifications 获取用户通知列表及未读数
+func (s *notificationService) ListNotifications(ctx context.Context, userID uint64, unreadOnly bool, page, pageSize int) (*NotificationList, error) {
+	notifications, total, err := s.repo.ListNotifications(ctx, userID, unreadOnly, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	unread := total
+	if !unreadOnly {
+		if unread, err = s.repo.CountUnreadNotifications(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &NotificationList{
+		Notifications: notifications,
+		Total:         total,
+		Unread:        unread,
+	}, nil
+}
+
+// MarkRead 将用户的通知标记为已读
+func (s *notificationService) MarkRead(ctx context.Context, userID uint64, notificationIDs []uint64) (int64, error) {
+	if userID == 0 {
+		return 0, fmt.Errorf("%w: user id is required", ErrInvalidNotification)
+	}
+	return s.repo.MarkNotificationsRead(ctx, userID, notificationIDs)
+}
@@ -0,0 +1,14 @@
+-- 用户通知表
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '接收者',
+    type VARCHAR(32) NOT NULL COMMENT '通知类型:gift,follow,audit_rejected',
+    actor_id BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '触发者,系统通知为0',
+    target_id VARCHAR(64) COMMENT '关联对象ID',
+    title VARCHAR(100) COMMENT '标题',
+    content VARCHAR(500) COMMENT '内容',
+    is_read BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否已读',
+    read_at DATETIME(3) NULL COMMENT '阅读时间',
+    created_at DATETIME(3) NOT NULL COMMENT '创建时间',
+    INDEX idx_notification_user_read (user_id, is_read)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户通知表';
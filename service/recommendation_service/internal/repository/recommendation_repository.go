@@ -34,11 +34,11 @@ type UserRepository interface {
 // userRepository 用户数据访问实现
 type userRepository struct {
 	db    *gorm.DB
-	redis *redis.Client
+	redis redis.UniversalClient
 }
 
 // NewUserRepository 创建用户数据访问对象
-func NewUserRepository(db *gorm.DB, redis *redis.Client) UserRepository {
+func NewUserRepository(db *gorm.DB, redis redis.UniversalClient) UserRepository {
 	return &userRepository{
 		db:    db,
 		redis: redis,
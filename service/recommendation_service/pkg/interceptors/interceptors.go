@@ -0,0 +1,152 @@
+// Package interceptors 按顺序组装gRPC服务端拦截器链
+// 先添加的拦截器位于外层：请求按添加顺序经过各拦截器，响应按相反顺序返回
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Builder 拦截器链构造器
+type Builder struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+// Chain 创建拦截器链，unary为按顺序执行的一元拦截器
+func Chain(unary ...grpc.UnaryServerInterceptor) *Builder {
+	return (&Builder{}).Unary(unary...)
+}
+
+// Unary 追加一元拦截器，nil会被忽略
+func (b *Builder) Unary(interceptors ...grpc.UnaryServerInterceptor) *Builder {
+	for _, i := range interceptors {
+		if i != nil {
+			b.unary = append(b.unary, i)
+		}
+	}
+	return b
+}
+
+// UnaryIf enabled为true时追加一元拦截器，用于按配置开启的拦截器
+func (b *Builder) UnaryIf(enabled bool, interceptors ...grpc.UnaryServerInterceptor) *Builder {
+	if !enabled {
+		return b
+	}
+	return b.Unary(interceptors...)
+}
+
+// Stream 追加流拦截器，nil会被忽略
+func (b *Builder) Stream(interceptors ...grpc.StreamServerInterceptor) *Builder {
+	for _, i := range interceptors {
+		if i != nil {
+			b.stream = append(b.stream, i)
+		}
+	}
+	return b
+}
+
+// StreamIf enabled为true时追加流拦截器
+func (b *Builder) StreamIf(enabled bool, interceptors ...grpc.StreamServerInterceptor) *Builder {
+	if !enabled {
+		return b
+	}
+	return b.Stream(interceptors...)
+}
+
+// UnaryInterceptor 将一元拦截器组合为一个，没有拦截器时返回nil
+func (b *Builder) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	interceptors := append([]grpc.UnaryServerInterceptor(nil), b.unary...)
+	switch len(interceptors) {
+	case 0:
+		return nil
+	case 1:
+		return interceptors[0]
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return interceptors[0](ctx, req, info, unaryNext(interceptors, 1, info, handler))
+	}
+}
+
+// unaryNext 构造从第i个拦截器开始的调用链
+func unaryNext(interceptors []grpc.UnaryServerInterceptor, i int, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	if i == len(interceptors) {
+		return handler
+	}
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		return interceptors[i](ctx, req, info, unaryNext(interceptors, i+1, info, handler))
+	}
+}
+
+// StreamInterceptor 将流拦截器组合为一个，没有拦截器时返回nil
+func (b *Builder) StreamInterceptor() grpc.StreamServerInterceptor {
+	interceptors := append([]grpc.StreamServerInterceptor(nil), b.stream...)
+	switch len(interceptors) {
+	case 0:
+		return nil
+	case 1:
+		return interceptors[0]
+	}
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return interceptors[0](srv, ss, info, streamNext(interceptors, 1, info, handler))
+	}
+}
+
+// streamNext 构造从第i个拦截器开始的调用链
+func streamNext(interceptors []grpc.StreamServerInterceptor, i int, info *grpc.StreamServerInfo, handler grpc.StreamHandler) grpc.StreamHandler {
+	if i == len(interceptors) {
+		return handler
+	}
+	return func(srv interface{}, ss grpc.ServerStream) error {
+		return interceptors[i](srv, ss, info, streamNext(interceptors, i+1, info, handler))
+	}
+}
+
+// ServerOptions 生成grpc.NewServer使用的拦截器选项
+func (b *Builder) ServerOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if unary := b.UnaryInterceptor(); unary != nil {
+		opts = append(opts, grpc.UnaryInterceptor(unary))
+	}
+	if stream := b.StreamInterceptor(); stream != nil {
+		opts = append(opts, grpc.StreamInterceptor(stream))
+	}
+	return opts
+}
+
+// PanicHandler 处理拦截到的panic，method为gRPC方法全名，stack为panic时的调用栈
+type PanicHandler func(ctx context.Context, method string, p interface{}, stack []byte)
+
+// UnaryRecovery 捕获处理函数中的panic并返回codes.Internal，避免单个请求导致服务退出
+func UnaryRecovery(onPanic PanicHandler) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				if onPanic != nil {
+					onPanic(ctx, info.FullMethod, p, debug.Stack())
+				}
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery 捕获流处理函数中的panic并返回codes.Internal
+func StreamRecovery(onPanic PanicHandler) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				if onPanic != nil {
+					onPanic(ss.Context(), info.FullMethod, p, debug.Stack())
+				}
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
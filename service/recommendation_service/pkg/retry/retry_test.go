@@ -0,0 +1,102 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+func TestBackoffDoublesUpToMax(t *testing.T) {
+	opts := Options{Interval: 100 * time.Millisecond, MaxInterval: time.Second}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for i, w := range want {
+		if got := opts.Backoff(i + 1); got != w {
+			t.Errorf("Backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	// 未配置时使用默认退避参数
+	if got := (Options{}).Backoff(1); got != DefaultInterval {
+		t.Errorf("default Backoff(1) = %v, want %v", got, DefaultInterval)
+	}
+	if got := (Options{}).Backoff(20); got != DefaultMaxInterval {
+		t.Errorf("default Backoff(20) = %v, want %v", got, DefaultMaxInterval)
+	}
+}
+
+func TestDoRetriesUntilSuccess(t *testing.T) {
+	calls := 0
+	got, err := Do(context.Background(), Options{Attempts: 5, Interval: time.Millisecond}, nopLogger{}, "mysql", func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errors.New("connection refused")
+		}
+		return "db", nil
+	})
+	if err != nil || got != "db" {
+		t.Fatalf("Do = (%q, %v), want db", got, err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestDoGivesUpAfterAttempts(t *testing.T) {
+	connErr := errors.New("connection refused")
+	calls := 0
+	_, err := Do(context.Background(), Options{Attempts: 3, Interval: time.Millisecond}, nopLogger{}, "redis", func() (int, error) {
+		calls++
+		return 0, connErr
+	})
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+	if !errors.Is(err, connErr) || !strings.Contains(err.Error(), "redis") || !strings.Contains(err.Error(), "3 attempts") {
+		t.Errorf("error = %v, want the last error wrapped with target and attempts", err)
+	}
+}
+
+func TestDoWithoutRetries(t *testing.T) {
+	for _, attempts := range []int{0, 1} {
+		calls := 0
+		_, err := Do(context.Background(), Options{Attempts: attempts, Interval: time.Hour}, nopLogger{}, "etcd", func() (int, error) {
+			calls++
+			return 0, errors.New("unavailable")
+		})
+		if err == nil || calls != 1 {
+			t.Errorf("attempts %d: calls = %d err = %v, want a single failed call", attempts, calls, err)
+		}
+	}
+}
+
+func TestDoStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	start := time.Now()
+	_, err := Do(ctx, Options{Attempts: 10, Interval: time.Hour}, nopLogger{}, "mysql", func() (int, error) {
+		calls++
+		return 0, errors.New("connection refused")
+	})
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("error = %v, want deadline exceeded with the last error", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 before the context ended", calls)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Do waited %v after the context ended", elapsed)
+	}
+}
@@ -5,20 +5,29 @@ import (
 	"fmt"
 	"log"
 	"net"
-	"os"
-	"os/signal"
+	"net/http"
 	"search_service/internal/config"
 	"search_service/internal/discovery"
 	"search_service/internal/handler"
+	"search_service/internal/repository"
+	"search_service/internal/service"
 	"search_service/pkg/database"
+	"search_service/pkg/grpctls"
+	"search_service/pkg/interceptors"
 	"search_service/pkg/logger"
-	"syscall"
+	"search_service/pkg/retry"
+	"search_service/proto/proto_gen"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"gorm.io/gorm"
+
+	healthcheck "common/health"
+	"common/lifecycle"
 )
 
 func main() {
@@ -48,51 +57,108 @@ func main() {
 	log.Printf("Attempting to connect to database")
 	log.Printf("Database config: Host=%s, Port=%d, Username=%s, Database=%s",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.Username, cfg.Database.Database)
-	db, err := database.NewMySQLConnection(cfg.Database)
+	connectRetry := retry.Options{
+		Attempts:    cfg.ConnectRetry.Attempts,
+		Interval:    cfg.ConnectRetry.Interval,
+		MaxInterval: cfg.ConnectRetry.MaxInterval,
+	}
+	db, err := retry.Do(context.Background(), connectRetry, logger, "mysql", func() (*gorm.DB, error) {
+		return database.NewMySQLConnection(cfg.Database)
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	logger.Info("Database connected successfully")
-	defer func() {
-		sqlDB, _ := db.DB()
-		if sqlDB != nil {
-			sqlDB.Close()
-		}
-	}()
 
 	// 4. 初始化Redis连接
-	redisClient, err := database.NewRedisClient(cfg.Redis)
+	redisClient, err := retry.Do(context.Background(), connectRetry, logger, "redis", func() (redis.UniversalClient, error) {
+		return database.NewRedisClient(cfg.Redis)
+	})
 	if err != nil {
 		logger.Fatal("Failed to connect to redis", "error", err)
 	}
 	logger.Info("Redis connected successfully")
-	defer redisClient.Close()
 
 	// 5. 初始化etcd服务注册
-	etcdDiscovery, err := discovery.NewEtcdDiscovery(cfg.Etcd.Endpoints, "search-service")
+	etcdDiscovery, err := retry.Do(context.Background(), connectRetry, logger, "etcd", func() (*discovery.EtcdDiscovery, error) {
+		return discovery.NewEtcdDiscovery(cfg.Etcd.Endpoints, "search-service")
+	})
 	if err != nil {
 		logger.Fatal("Failed to connect to etcd", "error", err)
 	}
-	defer etcdDiscovery.Close()
 
-	// 6. 创建gRPC服务器
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor(logger)),
-	)
-
-	// 7. 注册健康检查服务
+	// 6. 创建gRPC服务器，未开启TLS时使用明文连接
+	serverCreds, err := grpctls.ServerCredentials(grpctls.Options{
+		Enabled:    cfg.Server.TLS.Enabled,
+		CertFile:   cfg.Server.TLS.CertFile,
+		KeyFile:    cfg.Server.TLS.KeyFile,
+		CAFile:     cfg.Server.TLS.ClientCAFile,
+		ClientAuth: cfg.Server.TLS.ClientAuth,
+	})
+	if err != nil {
+		logger.Fatal("Failed to load gRPC server TLS credentials", "error", err)
+	}
+	if !cfg.Server.TLS.Enabled && cfg.Server.Mode == "release" {
+		logger.Warn("gRPC TLS is disabled, traffic between services is not encrypted")
+	}
+	// 拦截器按添加顺序执行，请求日志在最外层，panic恢复紧贴处理函数
+	chain := interceptors.Chain(
+		unaryInterceptor(logger),
+		interceptors.UnaryRecovery(logPanic(logger)),
+	).Stream(interceptors.StreamRecovery(logPanic(logger)))
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(serverCreds)}, chain.ServerOptions()...)...)
+
+	// 7. 注册健康检查服务，存活与就绪分开上报
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
-	healthServer.SetServingStatus("search_service", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthChecker := healthcheck.NewChecker(healthServer, "search_service", cfg.Health.CheckInterval, cfg.Health.CheckTimeout, logger)
+	healthChecker.AddCheck("database", healthcheck.DatabaseCheck(db))
+	healthChecker.AddCheck("redis", healthcheck.RedisCheck(redisClient))
+	healthChecker.AddCheck("etcd", etcdDiscovery.Ping)
+	healthCtx, cancelHealth := context.WithCancel(context.Background())
+	healthChecker.Start(healthCtx)
+
+	// HTTP探针（/livez、/readyz），供Kubernetes使用
+	var healthHTTPServer *http.Server
+	if cfg.Health.HTTPPort > 0 {
+		healthHTTPServer = &http.Server{
+			Addr:    fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Health.HTTPPort),
+			Handler: healthChecker.Handler(),
+		}
+		go func() {
+			logger.Info("Health HTTP server starting", "address", healthHTTPServer.Addr)
+			if err := healthHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Health HTTP server failed", "error", err)
+			}
+		}()
+	}
 
 	// 8. 注册搜索服务
-	searchHandler := handler.NewSearchServiceHandler(cfg, logger, db, redisClient)
-	// TODO: 注册搜索服务的gRPC服务
-	// proto_gen.RegisterSearchServiceServer(grpcServer, searchHandler)
+	// 开启Elasticsearch时搜索失败降级为MySQL LIKE查询，未开启时直接使用MySQL查询，搜索功能始终可用
+	searchRepo := repository.NewSearchRepository(db, redisClient, cfg.Search.Elasticsearch)
+	fallbackSvc := service.NewFallbackSearchService(db, cfg.Search.Search, logger)
+	var searchSvc service.SearchService
+	if cfg.Search.Elasticsearch.Enabled {
+		searchCache := service.NewSearchCache(redisClient, cfg.Search.Cache, logger)
+		searchSvc = service.NewFailoverSearchService(service.NewSearchService(searchRepo, searchCache, logger), fallbackSvc, logger)
+	} else {
+		logger.Warn("Elasticsearch is disabled, falling back to MySQL search")
+		searchSvc = fallbackSvc
+	}
+	bulkIndexer := service.NewBulkIndexer(searchRepo, cfg.Search.Indexing, logger)
+	searchHandler := handler.NewSearchServiceHandler(cfg, logger, searchSvc, bulkIndexer)
+	proto_gen.RegisterSearchServiceServer(grpcServer, searchHandler)
 	logger.Info("Search service registered")
 
-	// 9. 注册反射服务（用于调试）
-	reflection.Register(grpcServer)
+	// 消费视频、用户、直播服务发布的索引事件，保持索引与内容同步
+	indexConsumer := service.NewIndexConsumer(redisClient, searchSvc, cfg.Search.Indexing, logger)
+	indexConsumer.Start()
+
+	// 9. 注册反射服务（用于调试，release模式默认关闭）
+	if cfg.Server.ReflectionEnabled() {
+		reflection.Register(grpcServer)
+		logger.Info("gRPC reflection registered")
+	}
 
 	// 10. 启动gRPC服务器
 	go func() {
@@ -116,20 +182,46 @@ func main() {
 	logger.Info("Service registered to etcd", "address", serviceAddr)
 
 	// 12. 等待中断信号
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-	<-sigChan
-
-	logger.Info("Shutting down server...")
-
-	// 13. 设置健康检查为不健康状态
-	healthServer.SetServingStatus("search_service", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	sig := lifecycle.WaitForSignal()
+	logger.Info("Shutting down server...", "signal", sig.String())
+
+	// 13. 按顺序退出：先停止接收流量并排空在途请求，再关闭依赖
+	shutdown := lifecycle.New(logger, lifecycle.DefaultStepTimeout)
+	shutdown.Add("health", func(ctx context.Context) error {
+		healthChecker.Shutdown()
+		return nil
+	})
+	shutdown.Add("grpc", lifecycle.GRPCServer(grpcServer))
+	shutdown.Add("index_consumer", indexConsumer.Stop)
+	shutdown.Add("redis", lifecycle.Closer(redisClient.Close))
+	shutdown.Add("database", lifecycle.Closer(func() error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	}))
+	shutdown.Add("etcd", lifecycle.Closer(etcdDiscovery.Close))
+	// 健康检查最后停止，排空请求和关闭依赖期间/readyz持续返回503
+	shutdown.Add("health_checker", func(ctx context.Context) error {
+		cancelHealth()
+		return nil
+	})
+	if healthHTTPServer != nil {
+		shutdown.Add("health_http", lifecycle.HTTPServer(healthHTTPServer))
+	}
+	shutdown.Run()
 
-	// 14. 停止gRPC服务器
-	grpcServer.GracefulStop()
 	logger.Info("Server stopped gracefully")
 }
 
+// logPanic 记录gRPC处理函数中的panic
+func logPanic(log logger.Logger) interceptors.PanicHandler {
+	return func(ctx context.Context, method string, p interface{}, stack []byte) {
+		log.Error("gRPC handler panic", "method", method, "panic", p, "stack", string(stack))
+	}
+}
+
 // unaryInterceptor gRPC一元拦截器
 func unaryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -17,8 +17,11 @@ type Config struct {
 	Redis    RedisConfig    `mapstructure:"redis"`
 	Logger   LoggerConfig   `mapstructure:"logger"`
 	Etcd     EtcdConfig     `mapstructure:"etcd"`
+	Health   HealthConfig   `mapstructure:"health"`
 	Consul   ConsulConfig   `mapstructure:"consul"`
 	Search   SearchConfig   `mapstructure:"search"`
+
+	ConnectRetry ConnectRetryConfig `mapstructure:"connect_retry"`
 }
 
 // ServerConfig 服务器配置
@@ -28,6 +31,28 @@ type ServerConfig struct {
 	Mode         string        `mapstructure:"mode"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// EnableReflection 是否注册gRPC反射服务，未配置时仅debug模式开启
+	EnableReflection *bool `mapstructure:"enable_reflection"`
+	// TLS gRPC服务端TLS配置，未开启时使用明文连接
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// ReflectionEnabled 是否注册gRPC反射服务
+// 反射会暴露完整的接口定义，release模式下需显式配置enable_reflection: true才会开启
+func (c ServerConfig) ReflectionEnabled() bool {
+	if c.EnableReflection != nil {
+		return *c.EnableReflection
+	}
+	return c.Mode == "debug"
+}
+
+// TLSConfig gRPC服务端TLS配置，未开启时使用明文连接，仅适用于本地开发，跨主机部署必须开启
+type TLSConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	CertFile     string `mapstructure:"cert_file"`      // 服务端证书
+	KeyFile      string `mapstructure:"key_file"`       // 服务端私钥
+	ClientAuth   bool   `mapstructure:"client_auth"`    // 要求并校验客户端证书(mTLS)
+	ClientCAFile string `mapstructure:"client_ca_file"` // 校验客户端证书的CA，开启ClientAuth时必填
 }
 
 // DatabaseConfig 数据库配置
@@ -55,6 +80,13 @@ type RedisConfig struct {
 	DialTimeout  int    `mapstructure:"dial_timeout"`
 	ReadTimeout  int    `mapstructure:"read_timeout"`
 	WriteTimeout int    `mapstructure:"write_timeout"`
+
+	// 部署模式，single(默认)、sentinel或cluster
+	Mode             string   `mapstructure:"mode"`
+	MasterName       string   `mapstructure:"master_name"`       // sentinel模式的主节点名称
+	SentinelAddrs    []string `mapstructure:"sentinel_addrs"`    // sentinel模式的哨兵地址
+	SentinelPassword string   `mapstructure:"sentinel_password"` // 哨兵的认证密码，为空时不认证
+	ClusterNodes     []string `mapstructure:"cluster_nodes"`     // cluster模式的种子节点地址
 }
 
 // LoggerConfig 日志配置
@@ -64,6 +96,13 @@ type LoggerConfig struct {
 	OutputPath string `mapstructure:"output_path"`
 }
 
+// HealthConfig 健康检查配置
+type HealthConfig struct {
+	HTTPPort      int           `mapstructure:"http_port"`      // HTTP探针端口，0表示不启动
+	CheckInterval time.Duration `mapstructure:"check_interval"` // 依赖检查间隔
+	CheckTimeout  time.Duration `mapstructure:"check_timeout"`  // 单个依赖检查超时
+}
+
 // EtcdConfig etcd配置
 type EtcdConfig struct {
 	Endpoints   []string `mapstructure:"endpoints"`
@@ -72,6 +111,13 @@ type EtcdConfig struct {
 	Password    string   `mapstructure:"password"`
 }
 
+// ConnectRetryConfig 启动时连接MySQL、Redis、etcd的重试配置
+type ConnectRetryConfig struct {
+	Attempts    int           `mapstructure:"attempts"`     // 最大尝试次数(含首次)，<=1时不重试
+	Interval    time.Duration `mapstructure:"interval"`     // 首次重试间隔，之后按指数翻倍
+	MaxInterval time.Duration `mapstructure:"max_interval"` // 重试间隔上限
+}
+
 // ConsulConfig Consul配置
 type ConsulConfig struct {
 	Host      string `mapstructure:"host"`
@@ -120,6 +166,7 @@ type IndexingConfig struct {
 	MaxBulkSize       string        `mapstructure:"max_bulk_size"`
 	ConcurrentWorkers int           `mapstructure:"concurrent_workers"`
 	RetryAttempts     int           `mapstructure:"retry_attempts"`
+	QueueKey          string        `mapstructure:"queue_key"` // 索引事件队列，与各内容服务的search_index.queue_key一致
 }
 
 // AnalyzerConfig 分词配置
@@ -81,6 +81,16 @@ func (e *EtcdDiscovery) keepAlive(ttl int64) {
 	}
 }
 
+// Ping 检查etcd连通性
+func (e *EtcdDiscovery) Ping(ctx context.Context) error {
+	endpoints := e.client.Endpoints()
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no etcd endpoints configured")
+	}
+	_, err := e.client.Status(ctx, endpoints[0])
+	return err
+}
+
 // Close 关闭etcd连接
 func (e *EtcdDiscovery) Close() error {
 	if e.client != nil {
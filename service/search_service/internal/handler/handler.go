@@ -2,89 +2,147 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"search_service/internal/config"
 	"search_service/internal/model"
 	"search_service/internal/service"
 	"search_service/pkg/logger"
+	"search_service/proto/proto_gen"
 
-	"github.com/go-redis/redis/v8"
-	"gorm.io/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // SearchServiceHandler 搜索服务处理器
 type SearchServiceHandler struct {
+	proto_gen.UnimplementedSearchServiceServer
+
 	cfg         *config.Config
 	logger      logger.Logger
-	db          *gorm.DB
-	redisClient *redis.Client
 	searchSvc   service.SearchService
+	bulkIndexer *service.BulkIndexer
 }
 
 // NewSearchServiceHandler 创建新的搜索服务处理器
+// searchSvc开启Elasticsearch时为带MySQL降级的搜索服务，未开启时为MySQL降级搜索
 func NewSearchServiceHandler(
 	cfg *config.Config,
 	logger logger.Logger,
-	db *gorm.DB,
-	redisClient *redis.Client,
+	searchSvc service.SearchService,
+	bulkIndexer *service.BulkIndexer,
 ) *SearchServiceHandler {
-	// 创建repository
-	// repo := repository.NewSearchRepository(db, redisClient)
-
-	// 创建service
-	// searchSvc := service.NewSearchService(repo, logger)
-
 	return &SearchServiceHandler{
 		cfg:         cfg,
 		logger:      logger,
-		db:          db,
-		redisClient: redisClient,
-		// searchSvc:   searchSvc,
+		searchSvc:   searchSvc,
+		bulkIndexer: bulkIndexer,
 	}
 }
 
 // Search 执行搜索
-func (h *SearchServiceHandler) Search(ctx context.Context, req *model.SearchRequest) (*model.SearchResponse, error) {
-	h.logger.Info("Received search request", "query", req.Query, "page", req.Page, "size", req.Size)
-
-	// TODO: 调用service层执行搜索
-	// 暂时返回模拟数据用于测试
-	response := &model.SearchResponse{
-		Results: []model.SearchResult{
-			{
-				ID:     "1",
-				Score:  0.95,
-				Source: map[string]interface{}{"title": "测试视频1", "description": "这是一个测试视频"},
-				Type:   "video",
-			},
-			{
-				ID:     "2",
-				Score:  0.85,
-				Source: map[string]interface{}{"title": "测试视频2", "description": "这是另一个测试视频"},
-				Type:   "video",
-			},
-		},
-		Total:       2,
-		Page:        req.Page,
-		Size:        req.Size,
-		ElapsedTime: 10, // 毫秒
+func (h *SearchServiceHandler) Search(ctx context.Context, req *proto_gen.SearchRequest) (*proto_gen.SearchResponse, error) {
+	h.logger.Info("Received search request", "query", req.Query, "page", req.Page, "size", req.PageSize)
+
+	response, err := h.searchSvc.Search(ctx, model.SearchRequest{
+		Query:       req.Query,
+		Page:        int(req.Page),
+		Size:        int(req.PageSize),
+		SearchType:  req.SearchType,
+		Filter:      req.Filters,
+		SortBy:      req.SortBy,
+		SortOrder:   req.SortOrder,
+		FuzzySearch: req.FuzzySearch,
+	})
+	if err != nil {
+		h.logger.Error("Search failed", "query", req.Query, "error", err)
+		return nil, status.Error(codes.Internal, "search failed")
+	}
+
+	results := make([]*proto_gen.SearchResult, 0, len(response.Results))
+	for _, result := range response.Results {
+		source := make(map[string]string, len(result.Source))
+		for key, value := range result.Source {
+			if value != nil {
+				source[key] = fmt.Sprint(value)
+			}
+		}
+		results = append(results, &proto_gen.SearchResult{
+			Id:     result.ID,
+			Score:  result.Score,
+			Source: source,
+			Type:   result.Type,
+		})
 	}
 
 	h.logger.Info("Search completed", "total_results", response.Total)
-	return response, nil
+	return &proto_gen.SearchResponse{
+		Results:     results,
+		Total:       response.Total,
+		Page:        int32(response.Page),
+		PageSize:    int32(response.Size),
+		ElapsedTime: response.ElapsedTime,
+	}, nil
 }
 
 // GetSearchSuggestions 获取搜索建议
-func (h *SearchServiceHandler) GetSearchSuggestions(ctx context.Context, prefix string, limit int) ([]string, error) {
-	h.logger.Info("Received search suggestion request", "prefix", prefix, "limit", limit)
-
-	// TODO: 实现搜索建议逻辑
-	// 暂时返回模拟数据用于测试
-	suggestions := []string{
-		prefix + "教程",
-		prefix + "讲解",
-		prefix + "演示",
+func (h *SearchServiceHandler) GetSearchSuggestions(ctx context.Context, req *proto_gen.SuggestionRequest) (*proto_gen.SuggestionResponse, error) {
+	h.logger.Info("Received search suggestion request", "prefix", req.Prefix, "limit", req.Limit)
+
+	suggestions, err := h.searchSvc.GetSearchSuggestions(ctx, req.Prefix, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Search suggestions failed", "prefix", req.Prefix, "error", err)
+		return nil, status.Error(codes.Internal, "search suggestions failed")
 	}
 
 	h.logger.Info("Search suggestions completed", "count", len(suggestions))
-	return suggestions, nil
+	return &proto_gen.SuggestionResponse{Suggestions: suggestions}, nil
+}
+
+// BulkIndexStream 批量索引文档，调用方以客户端流逐个发送文档，发送完毕后返回成功与失败数及失败明细
+func (h *SearchServiceHandler) BulkIndexStream(stream proto_gen.SearchService_BulkIndexStreamServer) error {
+	h.logger.Info("Received bulk index stream")
+
+	result, err := h.bulkIndexer.Index(documentStream{stream})
+	if err != nil {
+		// 读取文档流出错时连接已中断，无法再返回结果
+		h.logger.Error("Bulk index stream failed", "indexed", result.Indexed, "failed", result.Failed, "error", err)
+		return err
+	}
+
+	errs := make([]*proto_gen.BulkIndexError, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		errs = append(errs, &proto_gen.BulkIndexError{Id: e.ID, Type: e.Type, Message: e.Message})
+	}
+	return stream.SendAndClose(&proto_gen.BulkIndexResponse{
+		Indexed: result.Indexed,
+		Failed:  result.Failed,
+		Batches: int32(result.Batches),
+		Errors:  errs,
+	})
+}
+
+// documentStream 将gRPC客户端流转换为待索引文档流
+type documentStream struct {
+	stream proto_gen.SearchService_BulkIndexStreamServer
+}
+
+func (s documentStream) Context() context.Context {
+	return s.stream.Context()
+}
+
+func (s documentStream) Recv() (*model.Document, error) {
+	req, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{}, len(req.Fields))
+	for key, value := range req.Fields {
+		fields[key] = value
+	}
+	return &model.Document{
+		ID:        req.Id,
+		Type:      req.Type,
+		Fields:    fields,
+		UpdatedAt: req.UpdatedAt,
+	}, nil
 }
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+	"search_service/internal/repository"
+	"search_service/internal/service"
+	"search_service/proto/proto_gen"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+func (nopLogger) Sync() error                  { return nil }
+
+// fakeSearchRepository 记录批量写入的文档，ID为"bad"的文档写入失败
+type fakeSearchRepository struct {
+	repository.SearchRepository
+	batches [][]string
+}
+
+func (r *fakeSearchRepository) BulkIndexDocuments(ctx context.Context, docs []*model.Document) ([]error, error) {
+	ids := make([]string, len(docs))
+	errs := make([]error, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc.ID
+		if doc.ID == "bad" {
+			errs[i] = errors.New("mapper_parsing_exception")
+		}
+	}
+	r.batches = append(r.batches, ids)
+	return errs, nil
+}
+
+func TestBulkIndexStreamOverGRPC(t *testing.T) {
+	repo := &fakeSearchRepository{}
+	bulkIndexer := service.NewBulkIndexer(repo, config.IndexingConfig{BatchSize: 2}, nopLogger{})
+	h := NewSearchServiceHandler(nil, nopLogger{}, nil, bulkIndexer)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	proto_gen.RegisterSearchServiceServer(server, h)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	stream, err := proto_gen.NewSearchServiceClient(conn).BulkIndexStream(context.Background())
+	if err != nil {
+		t.Fatalf("BulkIndexStream: %v", err)
+	}
+	docs := []*proto_gen.IndexDocumentRequest{
+		{Id: "1", Type: "video", Fields: map[string]string{"title": "a"}},
+		{Id: "bad", Type: "video"},
+		{Id: "", Type: "video"}, // 缺少ID，不会写入索引
+		{Id: "3", Type: "user"},
+	}
+	for _, doc := range docs {
+		if err := stream.Send(doc); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv: %v", err)
+	}
+
+	if resp.Indexed != 2 || resp.Failed != 2 || resp.Batches != 2 {
+		t.Fatalf("response = indexed %d failed %d batches %d, want 2/2/2", resp.Indexed, resp.Failed, resp.Batches)
+	}
+	failedIDs := map[string]bool{}
+	for _, e := range resp.Errors {
+		failedIDs[e.Id] = true
+	}
+	if !failedIDs["bad"] || !failedIDs[""] {
+		t.Errorf("errors = %v, want entries for bad and empty id", resp.Errors)
+	}
+	if len(repo.batches) != 2 || len(repo.batches[0]) != 2 {
+		t.Errorf("batches = %v, want [[1 bad] [3]]", repo.batches)
+	}
+}
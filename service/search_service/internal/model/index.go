@@ -0,0 +1,101 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidDocument 文档缺少ID或类型
+var ErrInvalidDocument = errors.New("invalid search document")
+
+// IndexOp 索引操作
+type IndexOp string
+
+const (
+	IndexOpUpsert IndexOp = "upsert" // 新增或更新文档，Fields中的字段覆盖已有字段
+	IndexOpDelete IndexOp = "delete" // 删除文档
+)
+
+// IndexEvent 索引事件，由视频、用户、直播服务在内容变更后写入索引队列
+type IndexEvent struct {
+	Op        IndexOp                `json:"op"`
+	DocType   string                 `json:"doc_type"`
+	ID        string                 `json:"id"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+	Timestamp int64                  `json:"timestamp"` // 变更时间(毫秒)
+}
+
+// Document 由索引事件构造的待索引文档
+type Document struct {
+	ID        string
+	Type      string
+	Fields    map[string]interface{}
+	UpdatedAt int64 // 变更时间(毫秒)，用于丢弃乱序到达的旧数据
+}
+
+// NewDocument 根据索引事件创建文档
+func NewDocument(event *IndexEvent) *Document {
+	return &Document{
+		ID:        event.ID,
+		Type:      event.DocType,
+		Fields:    event.Fields,
+		UpdatedAt: event.Timestamp,
+	}
+}
+
+// Index 校验文档是否可写入索引
+func (d *Document) Index() error {
+	if d.ID == "" || d.Type == "" {
+		return ErrInvalidDocument
+	}
+	return nil
+}
+
+// Search 文档的文本字段包含查询词时返回文档本身
+func (d *Document) Search(query string) ([]interface{}, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil, nil
+	}
+	for _, value := range d.Fields {
+		if strings.Contains(strings.ToLower(fmt.Sprint(value)), query) {
+			return []interface{}{d}, nil
+		}
+	}
+	return nil, nil
+}
+
+// Delete 文档删除由仓库按ID和类型完成，这里只做校验
+func (d *Document) Delete() error {
+	if d.ID == "" || d.Type == "" {
+		return ErrInvalidDocument
+	}
+	return nil
+}
+
+// MaxBulkIndexErrors 批量索引结果中最多保留的失败明细数
+const MaxBulkIndexErrors = 100
+
+// BulkIndexError 批量索引中单个文档的失败原因
+type BulkIndexError struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// BulkIndexResult 批量索引结果
+type BulkIndexResult struct {
+	Indexed int64            `json:"indexed"`
+	Failed  int64            `json:"failed"`
+	Batches int              `json:"batches"`
+	Errors  []BulkIndexError `json:"errors,omitempty"` // 最多保留前MaxBulkIndexErrors条
+}
+
+// AddFailure 记录一个索引失败的文档
+func (r *BulkIndexResult) AddFailure(doc *Document, err error) {
+	r.Failed++
+	if len(r.Errors) < MaxBulkIndexErrors {
+		r.Errors = append(r.Errors, BulkIndexError{ID: doc.ID, Type: doc.Type, Message: err.Error()})
+	}
+}
@@ -30,6 +30,7 @@ type SearchRequest struct {
 	SortBy      string            `json:"sort_by"`
 	SortOrder   string            `json:"sort_order"`
 	FuzzySearch bool              `json:"fuzzy_search"`
+	UserID      uint64            `json:"user_id"` // 非0表示按该用户个性化排序，结果不缓存
 }
 
 // SearchResponse 搜索响应
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+)
+
+// defaultESTimeout 未配置request_timeout时单次请求的超时时间
+const defaultESTimeout = 30 * time.Second
+
+// ErrElasticsearchDisabled 未配置Elasticsearch地址
+var ErrElasticsearchDisabled = errors.New("elasticsearch is not configured")
+
+// esClient Elasticsearch HTTP客户端
+// 多个地址时按请求轮询，文档按类型写入"<index_prefix>_<类型>"索引
+type esClient struct {
+	hosts       []string
+	username    string
+	password    string
+	indexPrefix string
+	http        *http.Client
+	next        uint32
+}
+
+// newESClient 根据配置创建客户端，未配置地址时返回nil
+func newESClient(cfg config.ElasticsearchConfig) *esClient {
+	hosts := make([]string, 0, len(cfg.Hosts))
+	for _, host := range cfg.Hosts {
+		if host = strings.TrimRight(strings.TrimSpace(host), "/"); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultESTimeout
+	}
+	return &esClient{
+		hosts:       hosts,
+		username:    cfg.Username,
+		password:    cfg.Password,
+		indexPrefix: cfg.IndexPrefix,
+		http:        &http.Client{Timeout: timeout},
+	}
+}
+
+// indexName 文档类型对应的索引名
+func (c *esClient) indexName(docType string) string {
+	if c.indexPrefix == "" {
+		return docType
+	}
+	return c.indexPrefix + "_" + docType
+}
+
+// bulkAction bulk请求中单个文档的操作行
+type bulkAction struct {
+	Index *bulkMeta `json:"index,omitempty"`
+}
+
+type bulkMeta struct {
+	Index       string `json:"_index"`
+	ID          string `json:"_id"`
+	Version     int64  `json:"version,omitempty"`
+	VersionType string `json:"version_type,omitempty"`
+}
+
+// bulkResponse bulk接口响应，items与请求中的文档一一对应
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// bulkIndex 调用_bulk接口写入整批文档，返回与docs一一对应的错误
+// 文档带有变更时间时作为外部版本号写入，乱序到达的旧数据会被Elasticsearch拒绝(version_conflict)，视为成功跳过
+func (c *esClient) bulkIndex(ctx context.Context, docs []*model.Document) ([]error, error) {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, doc := range docs {
+		meta := &bulkMeta{Index: c.indexName(doc.Type), ID: doc.ID}
+		if doc.UpdatedAt > 0 {
+			meta.Version = doc.UpdatedAt
+			meta.VersionType = "external_gte"
+		}
+		if err := enc.Encode(bulkAction{Index: meta}); err != nil {
+			return nil, fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		fields := doc.Fields
+		if fields == nil {
+			fields = map[string]interface{}{}
+		}
+		if err := enc.Encode(fields); err != nil {
+			return nil, fmt.Errorf("failed to encode document %s: %w", doc.ID, err)
+		}
+	}
+
+	var resp bulkResponse
+	if err := c.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", &body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Items) != len(docs) {
+		return nil, fmt.Errorf("bulk response has %d items for %d documents", len(resp.Items), len(docs))
+	}
+
+	errs := make([]error, len(docs))
+	if !resp.Errors {
+		return errs, nil
+	}
+	for i, item := range resp.Items {
+		for _, result := range item {
+			if result.Error == nil || result.Error.Type == "version_conflict_engine_exception" {
+				continue
+			}
+			errs[i] = fmt.Errorf("%s: %s", result.Error.Type, result.Error.Reason)
+		}
+	}
+	return errs, nil
+}
+
+// deleteDocument 删除文档，文档不存在时视为成功
+func (c *esClient) deleteDocument(ctx context.Context, id, docType string) error {
+	path := "/" + c.indexName(docType) + "/_doc/" + id
+	err := c.do(ctx, http.MethodDelete, path, "", nil, nil)
+	var statusErr *esStatusError
+	if errors.As(err, &statusErr) && statusErr.status == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// esStatusError Elasticsearch返回的非2xx响应
+type esStatusError struct {
+	status int
+	body   string
+}
+
+func (e *esStatusError) Error() string {
+	return fmt.Sprintf("elasticsearch returned %d: %s", e.status, e.body)
+}
+
+// do 发送请求并解析响应，out为nil时忽略响应内容
+func (c *esClient) do(ctx context.Context, method, path, contentType string, body io.Reader, out interface{}) error {
+	host := c.hosts[int(atomic.AddUint32(&c.next, 1)-1)%len(c.hosts)]
+	req, err := http.NewRequestWithContext(ctx, method, host+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create elasticsearch request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("elasticsearch request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &esStatusError{status: resp.StatusCode, body: string(data)}
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode elasticsearch response: %w", err)
+	}
+	return nil
+}
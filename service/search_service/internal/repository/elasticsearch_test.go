@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+)
+
+func TestBulkIndexDocumentsReportsPerItemErrors(t *testing.T) {
+	var lines []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/_bulk" {
+			t.Errorf("request = %s %s, want POST /_bulk", r.Method, r.URL.Path)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+			t.Errorf("content type = %q", ct)
+		}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				t.Errorf("invalid ndjson line %q: %v", scanner.Text(), err)
+			}
+			lines = append(lines, line)
+		}
+		w.Write([]byte(`{"errors":true,"items":[
+			{"index":{"status":201}},
+			{"index":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse field [duration]"}}},
+			{"index":{"status":409,"error":{"type":"version_conflict_engine_exception","reason":"stale"}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	repo := NewSearchRepository(nil, nil, config.ElasticsearchConfig{Hosts: []string{server.URL}, IndexPrefix: "vw"})
+	docs := []*model.Document{
+		{ID: "1", Type: "video", Fields: map[string]interface{}{"title": "a"}, UpdatedAt: 100},
+		{ID: "2", Type: "video", Fields: map[string]interface{}{"duration": "x"}},
+		{ID: "3", Type: "user", Fields: map[string]interface{}{"nickname": "b"}, UpdatedAt: 50},
+	}
+	errs, err := repo.BulkIndexDocuments(context.Background(), docs)
+	if err != nil {
+		t.Fatalf("BulkIndexDocuments: %v", err)
+	}
+
+	if len(lines) != 6 {
+		t.Fatalf("bulk body has %d lines, want 6", len(lines))
+	}
+	action := lines[0]["index"].(map[string]interface{})
+	if action["_index"] != "vw_video" || action["_id"] != "1" || action["version_type"] != "external_gte" || action["version"] != float64(100) {
+		t.Errorf("first action = %v", action)
+	}
+	if lines[1]["title"] != "a" {
+		t.Errorf("first document = %v", lines[1])
+	}
+	if _, ok := lines[2]["index"].(map[string]interface{})["version"]; ok {
+		t.Errorf("document without UpdatedAt should not be versioned: %v", lines[2])
+	}
+
+	if errs[0] != nil {
+		t.Errorf("doc 1 error = %v, want nil", errs[0])
+	}
+	if errs[1] == nil {
+		t.Error("doc 2 error = nil, want mapping failure")
+	}
+	if errs[2] != nil {
+		t.Errorf("stale doc 3 error = %v, want nil (skipped)", errs[2])
+	}
+}
+
+func TestBulkIndexDocumentsFailsWholeBatchOnHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "cluster unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	repo := NewSearchRepository(nil, nil, config.ElasticsearchConfig{Hosts: []string{server.URL}})
+	_, err := repo.BulkIndexDocuments(context.Background(), []*model.Document{{ID: "1", Type: "video"}})
+	if err == nil {
+		t.Fatal("BulkIndexDocuments succeeded, want error for 503")
+	}
+}
+
+func TestBulkIndexDocumentsWithoutElasticsearch(t *testing.T) {
+	repo := NewSearchRepository(nil, nil, config.ElasticsearchConfig{})
+	if _, err := repo.BulkIndexDocuments(context.Background(), []*model.Document{{ID: "1", Type: "video"}}); err != ErrElasticsearchDisabled {
+		t.Fatalf("error = %v, want ErrElasticsearchDisabled", err)
+	}
+}
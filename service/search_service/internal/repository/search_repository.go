@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"search_service/internal/config"
 	"search_service/internal/model"
 
 	"github.com/go-redis/redis/v8"
@@ -13,6 +15,10 @@ type SearchRepository interface {
 	// IndexDocument 索引文档
 	IndexDocument(ctx context.Context, doc model.SearchModel) error
 
+	// BulkIndexDocuments 批量索引文档，返回与docs一一对应的错误，nil表示该文档写入成功；
+	// 返回的error非nil时表示整批请求失败
+	BulkIndexDocuments(ctx context.Context, docs []*model.Document) ([]error, error)
+
 	// SearchDocuments 搜索文档
 	SearchDocuments(ctx context.Context, req model.SearchRequest) (*model.SearchResponse, error)
 
@@ -26,21 +32,41 @@ type SearchRepository interface {
 // searchRepository 搜索数据访问实现
 type searchRepository struct {
 	db          *gorm.DB
-	redisClient *redis.Client
+	redisClient redis.UniversalClient
+	es          *esClient // 未配置Elasticsearch地址时为nil
 }
 
 // NewSearchRepository 创建搜索数据访问实例
-func NewSearchRepository(db *gorm.DB, redisClient *redis.Client) SearchRepository {
+func NewSearchRepository(db *gorm.DB, redisClient redis.UniversalClient, esCfg config.ElasticsearchConfig) SearchRepository {
 	return &searchRepository{
 		db:          db,
 		redisClient: redisClient,
+		es:          newESClient(esCfg),
 	}
 }
 
-// IndexDocument 索引文档
+// IndexDocument 索引文档，只支持由索引事件构造的文档
 func (r *searchRepository) IndexDocument(ctx context.Context, doc model.SearchModel) error {
-	// TODO: 实现文档索引逻辑
-	return nil
+	document, ok := doc.(*model.Document)
+	if !ok {
+		return fmt.Errorf("unsupported search document %T", doc)
+	}
+	errs, err := r.BulkIndexDocuments(ctx, []*model.Document{document})
+	if err != nil {
+		return err
+	}
+	return errs[0]
+}
+
+// BulkIndexDocuments 使用Elasticsearch bulk接口一次写入整批文档
+func (r *searchRepository) BulkIndexDocuments(ctx context.Context, docs []*model.Document) ([]error, error) {
+	if r.es == nil {
+		return nil, ErrElasticsearchDisabled
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+	return r.es.bulkIndex(ctx, docs)
 }
 
 // SearchDocuments 搜索文档
@@ -49,10 +75,12 @@ func (r *searchRepository) SearchDocuments(ctx context.Context, req model.Search
 	return &model.SearchResponse{}, nil
 }
 
-// DeleteDocument 删除文档
+// DeleteDocument 删除文档，文档不存在时视为成功
 func (r *searchRepository) DeleteDocument(ctx context.Context, id string, docType string) error {
-	// TODO: 实现文档删除逻辑
-	return nil
+	if r.es == nil {
+		return ErrElasticsearchDisabled
+	}
+	return r.es.deleteDocument(ctx, id, docType)
 }
 
 // GetSearchSuggestions 获取搜索建议
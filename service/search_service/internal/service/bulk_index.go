@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+	"search_service/internal/repository"
+	"search_service/pkg/logger"
+)
+
+// defaultBulkIndexBatchSize 未配置indexing.batch_size时每批写入的文档数
+const defaultBulkIndexBatchSize = 500
+
+// DocumentStream 待索引文档流，与gRPC客户端流的服务端接口一致，文档发送完毕时Recv返回io.EOF
+type DocumentStream interface {
+	Context() context.Context
+	Recv() (*model.Document, error)
+}
+
+// BulkIndexer 批量索引
+// 从文档流中读取文档，攒够一批后写入索引；单个文档或整批写入失败只计入失败数，不中断文档流
+type BulkIndexer struct {
+	repo          repository.SearchRepository
+	logger        logger.Logger
+	batchSize     int
+	retryAttempts int
+}
+
+// NewBulkIndexer 创建批量索引
+func NewBulkIndexer(repo repository.SearchRepository, cfg config.IndexingConfig, log logger.Logger) *BulkIndexer {
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBulkIndexBatchSize
+	}
+	retryAttempts := cfg.RetryAttempts
+	if retryAttempts <= 0 {
+		retryAttempts = 1
+	}
+
+	return &BulkIndexer{
+		repo:          repo,
+		logger:        log,
+		batchSize:     batchSize,
+		retryAttempts: retryAttempts,
+	}
+}
+
+// Index 读取文档流直到结束并返回索引结果
+// 读取文档流出错时（如调用方取消）仍会写入已收到的文档，并同时返回已有结果和错误
+func (b *BulkIndexer) Index(stream DocumentStream) (*model.BulkIndexResult, error) {
+	ctx := stream.Context()
+	result := &model.BulkIndexResult{}
+	batch := make([]*model.Document, 0, b.batchSize)
+
+	for {
+		doc, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			b.flush(ctx, batch, result)
+			b.logger.Warn("Bulk index stream aborted", "indexed", result.Indexed, "failed", result.Failed, "error", err)
+			return result, err
+		}
+
+		if err := doc.Index(); err != nil {
+			result.AddFailure(doc, err)
+			continue
+		}
+		batch = append(batch, doc)
+		if len(batch) >= b.batchSize {
+			b.flush(ctx, batch, result)
+			batch = batch[:0]
+		}
+	}
+	b.flush(ctx, batch, result)
+
+	b.logger.Info("Bulk index completed", "indexed", result.Indexed, "failed", result.Failed, "batches", result.Batches)
+	return result, nil
+}
+
+// flush 写入一批文档，整批请求失败时按配置重试，仍失败则整批计为失败
+func (b *BulkIndexer) flush(ctx context.Context, batch []*model.Document, result *model.BulkIndexResult) {
+	if len(batch) == 0 {
+		return
+	}
+	result.Batches++
+
+	var errs []error
+	var err error
+	for attempt := 1; attempt <= b.retryAttempts; attempt++ {
+		errs, err = b.repo.BulkIndexDocuments(ctx, batch)
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		b.logger.Error("Failed to bulk index batch", "size", len(batch), "error", err)
+		for _, doc := range batch {
+			result.AddFailure(doc, err)
+		}
+		return
+	}
+
+	for i, doc := range batch {
+		if i < len(errs) && errs[i] != nil {
+			result.AddFailure(doc, errs[i])
+			continue
+		}
+		result.Indexed++
+	}
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// fakeRedisServer 基于RESP协议的内存Redis，只实现搜索缓存用到的命令
+type fakeRedisServer struct {
+	mu      sync.Mutex
+	values  map[string]string
+	expires map[string]time.Time
+	zsets   map[string]map[string]float64
+}
+
+// startFakeRedis 启动内存Redis并返回连接它的客户端
+func startFakeRedis(t *testing.T) (*redis.Client, *fakeRedisServer) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := &fakeRedisServer{
+		values:  make(map[string]string),
+		expires: make(map[string]time.Time),
+		zsets:   make(map[string]map[string]float64),
+	}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(conn)
+		}
+	}()
+
+	client := redis.NewClient(&redis.Options{Addr: lis.Addr().String(), MaxRetries: -1})
+	t.Cleanup(func() {
+		client.Close()
+		lis.Close()
+	})
+	return client, srv
+}
+
+// unreachableRedis 返回连接不上的客户端，模拟Redis不可用
+func unreachableRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+	client := redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func (s *fakeRedisServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	var queued [][]string
+	inMulti := false
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		var reply string
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "MULTI":
+			inMulti, queued, reply = true, nil, "+OK\r\n"
+		case cmd == "EXEC":
+			reply = fmt.Sprintf("*%d\r\n", len(queued))
+			for _, q := range queued {
+				reply += s.exec(q)
+			}
+			inMulti, queued = false, nil
+		case inMulti:
+			queued, reply = append(queued, args), "+QUEUED\r\n"
+		default:
+			reply = s.exec(args)
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+// readCommand 读取一条RESP数组格式的命令
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil || n <= 0 {
+		return nil, fmt.Errorf("invalid command header %q", line)
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err := r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+func bulk(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
+
+func parseScore(s string) float64 {
+	switch s {
+	case "-inf":
+		return -1 << 62
+	case "+inf", "inf":
+		return 1 << 62
+	}
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
+
+func (s *fakeRedisServer) exec(args []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "GET":
+		v, ok := s.values[args[1]]
+		if exp, has := s.expires[args[1]]; !ok || (has && time.Now().After(exp)) {
+			return "$-1\r\n"
+		}
+		return bulk(v)
+	case "SET":
+		s.values[args[1]] = args[2]
+		delete(s.expires, args[1])
+		if len(args) == 5 {
+			n, _ := strconv.Atoi(args[4])
+			unit := time.Second
+			if strings.EqualFold(args[3], "px") {
+				unit = time.Millisecond
+			}
+			s.expires[args[1]] = time.Now().Add(time.Duration(n) * unit)
+		}
+		return "+OK\r\n"
+	case "DEL":
+		n := 0
+		for _, key := range args[1:] {
+			if _, ok := s.values[key]; ok {
+				delete(s.values, key)
+				n++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", n)
+	case "ZADD":
+		zset := s.zsets[args[1]]
+		if zset == nil {
+			zset = make(map[string]float64)
+			s.zsets[args[1]] = zset
+		}
+		added := 0
+		for i := 2; i+1 < len(args); i += 2 {
+			if _, ok := zset[args[i+1]]; !ok {
+				added++
+			}
+			zset[args[i+1]] = parseScore(args[i])
+		}
+		return fmt.Sprintf(":%d\r\n", added)
+	case "ZCARD":
+		return fmt.Sprintf(":%d\r\n", len(s.zsets[args[1]]))
+	case "ZREMRANGEBYSCORE":
+		min, max := parseScore(args[2]), parseScore(args[3])
+		removed := 0
+		for member, score := range s.zsets[args[1]] {
+			if score >= min && score <= max {
+				delete(s.zsets[args[1]], member)
+				removed++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", removed)
+	case "ZPOPMIN":
+		zset := s.zsets[args[1]]
+		members := make([]string, 0, len(zset))
+		for member := range zset {
+			members = append(members, member)
+		}
+		sort.Slice(members, func(i, j int) bool {
+			if zset[members[i]] != zset[members[j]] {
+				return zset[members[i]] < zset[members[j]]
+			}
+			return members[i] < members[j]
+		})
+		count := 1
+		if len(args) > 2 {
+			count, _ = strconv.Atoi(args[2])
+		}
+		if count > len(members) {
+			count = len(members)
+		}
+		reply := fmt.Sprintf("*%d\r\n", 2*count)
+		for _, member := range members[:count] {
+			reply += bulk(member) + bulk(strconv.FormatFloat(zset[member], 'f', -1, 64))
+			delete(zset, member)
+		}
+		return reply
+	}
+	return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+}
+
+// cachedEntries 返回缓存中的搜索结果条目数
+func (s *fakeRedisServer) cachedEntries() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for key := range s.values {
+		if strings.HasPrefix(key, "search:result:") {
+			n++
+		}
+	}
+	return n
+}
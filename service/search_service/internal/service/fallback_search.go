@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+	"search_service/pkg/logger"
+)
+
+// 降级搜索支持的搜索类型，未指定时搜索视频
+const (
+	SearchTypeVideo = "video"
+	SearchTypeUser  = "user"
+	SearchTypeLive  = "live"
+)
+
+// 降级搜索默认分页
+const (
+	defaultFallbackPageSize = 20
+	maxFallbackPageSize     = 100
+)
+
+// likeEscaper 转义LIKE通配符，关键词中的%和_按普通字符匹配
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// fallbackTable 降级搜索的数据表定义
+type fallbackTable struct {
+	table        string
+	columns      []string          // 返回给调用方的字段
+	matchColumns []string          // LIKE匹配的字段
+	conditions   string            // 只搜索可公开展示的数据
+	args         []interface{}     // conditions的参数
+	filters      map[string]string // 过滤条件到字段的映射
+	sortColumns  map[string]string // 排序字段到字段的映射
+	defaultSort  string
+}
+
+// fallbackTables 各搜索类型对应的数据表，与视频、用户、直播服务的表结构一致
+var fallbackTables = map[string]fallbackTable{
+	SearchTypeVideo: {
+		table:        "videos",
+		columns:      []string{"id", "user_id", "title", "description", "cover_url", "duration", "tags", "category", "play_count", "like_count", "comment_count", "created_at"},
+		matchColumns: []string{"title", "description", "tags"},
+		conditions:   "deleted_at IS NULL AND status = ? AND is_public = ? AND audit_passed = ?",
+		args:         []interface{}{"normal", true, true},
+		filters:      map[string]string{"category": "category", "uploader_id": "user_id"},
+		sortColumns:  map[string]string{"play_count": "play_count", "like_count": "like_count", "upload_date": "created_at", "created_at": "created_at"},
+		defaultSort:  "created_at",
+	},
+	SearchTypeUser: {
+		table:        "users",
+		columns:      []string{"id", "username", "nickname", "avatar_url", "signature", "is_verified", "followers_count", "work_count"},
+		matchColumns: []string{"username", "nickname", "signature"},
+		conditions:   "deleted_at IS NULL AND status = ?",
+		args:         []interface{}{1},
+		sortColumns:  map[string]string{"followers_count": "followers_count", "work_count": "work_count"},
+		defaultSort:  "followers_count",
+	},
+	SearchTypeLive: {
+		table:        "live_streams",
+		columns:      []string{"id", "user_id", "room_id", "title", "description", "thumbnail_url", "category_id", "viewer_count", "started_at"},
+		matchColumns: []string{"title", "description"},
+		conditions:   "deleted_at IS NULL AND status = ? AND is_public = ?",
+		args:         []interface{}{1, true},
+		filters:      map[string]string{"category_id": "category_id"},
+		sortColumns:  map[string]string{"viewer_count": "viewer_count", "started_at": "started_at"},
+		defaultSort:  "viewer_count",
+	},
+}
+
+// fallbackSearchService 降级搜索服务实现
+// 未开启Elasticsearch时直接用LIKE查询MySQL中的视频、用户、直播表，结果没有相关度排序，
+// 只用于保证搜索功能可用
+type fallbackSearchService struct {
+	db       *gorm.DB
+	settings config.SearchSettings
+	logger   logger.Logger
+}
+
+// NewFallbackSearchService 创建基于MySQL的降级搜索服务
+func NewFallbackSearchService(db *gorm.DB, settings config.SearchSettings, logger logger.Logger) SearchService {
+	return &fallbackSearchService{
+		db:       db,
+		settings: settings,
+		logger:   logger,
+	}
+}
+
+// Search 执行搜索
+func (s *fallbackSearchService) Search(ctx context.Context, req model.SearchRequest) (*model.SearchResponse, error) {
+	start := time.Now()
+	s.logger.Info("Executing fallback search", "query", req.Query, "type", req.SearchType, "page", req.Page, "size", req.Size)
+
+	searchType := strings.ToLower(strings.TrimSpace(req.SearchType))
+	if searchType == "" {
+		searchType = SearchTypeVideo
+	}
+	table, ok := fallbackTables[searchType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported search type: %s", req.SearchType)
+	}
+
+	page, size := s.pagination(req.Page, req.Size)
+	resp := &model.SearchResponse{
+		Results: []model.SearchResult{},
+		Page:    page,
+		Size:    size,
+	}
+
+	query := strings.Join(strings.Fields(req.Query), " ")
+	if query == "" {
+		return resp, nil
+	}
+
+	tx := s.db.WithContext(ctx).Table(table.table).Where(table.conditions, table.args...)
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+	matches := make([]string, len(table.matchColumns))
+	matchArgs := make([]interface{}, len(table.matchColumns))
+	for i, column := range table.matchColumns {
+		matches[i] = column + " LIKE ?"
+		matchArgs[i] = pattern
+	}
+	tx = tx.Where(strings.Join(matches, " OR "), matchArgs...)
+	for key, value := range req.Filter {
+		if column, ok := table.filters[key]; ok && value != "" {
+			tx = tx.Where(column+" = ?", value)
+		}
+	}
+
+	// Count会修改查询语句，在独立会话上计数
+	if err := tx.Session(&gorm.Session{}).Count(&resp.Total).Error; err != nil {
+		s.logger.Error("Failed to count fallback search results", "error", err)
+		return nil, errors.New("database error")
+	}
+	if resp.Total == 0 {
+		resp.ElapsedTime = time.Since(start).Milliseconds()
+		return resp, nil
+	}
+
+	sortColumn, ok := table.sortColumns[req.SortBy]
+	if !ok {
+		sortColumn = table.defaultSort
+	}
+	sortOrder := "DESC"
+	if strings.EqualFold(req.SortOrder, "asc") {
+		sortOrder = "ASC"
+	}
+
+	var rows []map[string]interface{}
+	err := tx.Select(table.columns).
+		Order(sortColumn + " " + sortOrder).
+		Order("id DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&rows).Error
+	if err != nil {
+		s.logger.Error("Failed to run fallback search", "error", err)
+		return nil, errors.New("database error")
+	}
+
+	for _, row := range rows {
+		resp.Results = append(resp.Results, model.SearchResult{
+			ID:     fmt.Sprint(row["id"]),
+			Score:  1,
+			Source: row,
+			Type:   searchType,
+		})
+	}
+	resp.ElapsedTime = time.Since(start).Milliseconds()
+
+	s.logger.Info("Fallback search completed", "total_results", resp.Total)
+	return resp, nil
+}
+
+// pagination 规范化分页参数
+func (s *fallbackSearchService) pagination(page, size int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = s.settings.DefaultPageSize
+		if size <= 0 {
+			size = defaultFallbackPageSize
+		}
+	}
+	maxSize := s.settings.MaxPageSize
+	if maxSize <= 0 {
+		maxSize = maxFallbackPageSize
+	}
+	if size > maxSize {
+		size = maxSize
+	}
+	return page, size
+}
+
+// IndexDocument 降级搜索直接查询数据表，无需维护索引
+func (s *fallbackSearchService) IndexDocument(ctx context.Context, doc model.SearchModel) error {
+	s.logger.Debug("Skipping document indexing, Elasticsearch is disabled")
+	return nil
+}
+
+// DeleteDocument 降级搜索直接查询数据表，无需维护索引
+func (s *fallbackSearchService) DeleteDocument(ctx context.Context, id string, docType string) error {
+	s.logger.Debug("Skipping document deletion, Elasticsearch is disabled", "id", id, "type", docType)
+	return nil
+}
+
+// GetSearchSuggestions 获取以prefix开头的视频标题作为搜索建议
+func (s *fallbackSearchService) GetSearchSuggestions(ctx context.Context, prefix string, limit int) ([]string, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return []string{}, nil
+	}
+	if limit <= 0 || limit > maxFallbackPageSize {
+		limit = defaultFallbackPageSize
+	}
+
+	video := fallbackTables[SearchTypeVideo]
+	var suggestions []string
+	err := s.db.WithContext(ctx).
+		Table(video.table).
+		Where(video.conditions, video.args...).
+		Where("title LIKE ?", likeEscaper.Replace(prefix)+"%").
+		Distinct().
+		Order("title").
+		Limit(limit).
+		Pluck("title", &suggestions).Error
+	if err != nil {
+		s.logger.Error("Failed to get fallback search suggestions", "error", err)
+		return nil, errors.New("database error")
+	}
+	return suggestions, nil
+}
+
+// failoverSearchService Elasticsearch搜索失败时降级为MySQL搜索
+// 搜索和搜索建议先查Elasticsearch，出错时记录日志后改用降级搜索；索引维护只作用于Elasticsearch
+type failoverSearchService struct {
+	primary  SearchService
+	fallback SearchService
+	logger   logger.Logger
+}
+
+// NewFailoverSearchService 创建带降级的搜索服务，primary为Elasticsearch搜索，fallback为MySQL降级搜索
+func NewFailoverSearchService(primary, fallback SearchService, logger logger.Logger) SearchService {
+	return &failoverSearchService{
+		primary:  primary,
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+// Search 执行搜索，Elasticsearch出错时使用降级搜索，调用方取消请求时直接返回错误
+func (s *failoverSearchService) Search(ctx context.Context, req model.SearchRequest) (*model.SearchResponse, error) {
+	resp, err := s.primary.Search(ctx, req)
+	if err == nil || ctx.Err() != nil {
+		return resp, err
+	}
+	s.logger.Warn("Elasticsearch search failed, falling back to MySQL search", "query", req.Query, "type", req.SearchType, "error", err)
+	return s.fallback.Search(ctx, req)
+}
+
+// IndexDocument 索引文档
+func (s *failoverSearchService) IndexDocument(ctx context.Context, doc model.SearchModel) error {
+	return s.primary.IndexDocument(ctx, doc)
+}
+
+// DeleteDocument 删除文档
+func (s *failoverSearchService) DeleteDocument(ctx context.Context, id string, docType string) error {
+	return s.primary.DeleteDocument(ctx, id, docType)
+}
+
+// GetSearchSuggestions 获取搜索建议，Elasticsearch出错时使用降级搜索
+func (s *failoverSearchService) GetSearchSuggestions(ctx context.Context, prefix string, limit int) ([]string, error) {
+	suggestions, err := s.primary.GetSearchSuggestions(ctx, prefix, limit)
+	if err == nil || ctx.Err() != nil {
+		return suggestions, err
+	}
+	s.logger.Warn("Elasticsearch suggestions failed, falling back to MySQL search", "prefix", prefix, "error", err)
+	return s.fallback.GetSearchSuggestions(ctx, prefix, limit)
+}
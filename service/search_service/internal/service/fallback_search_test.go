@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"search_service/internal/model"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+func (nopLogger) Sync() error                  { return nil }
+
+// stubSearchService 返回固定结果的搜索服务，记录调用次数
+type stubSearchService struct {
+	SearchService
+	resp        *model.SearchResponse
+	suggestions []string
+	err         error
+	calls       int
+}
+
+func (s *stubSearchService) Search(ctx context.Context, req model.SearchRequest) (*model.SearchResponse, error) {
+	s.calls++
+	return s.resp, s.err
+}
+
+func (s *stubSearchService) GetSearchSuggestions(ctx context.Context, prefix string, limit int) ([]string, error) {
+	s.calls++
+	return s.suggestions, s.err
+}
+
+func TestFailoverSearchUsesPrimaryWhenHealthy(t *testing.T) {
+	primary := &stubSearchService{resp: &model.SearchResponse{Total: 3}}
+	fallback := &stubSearchService{resp: &model.SearchResponse{Total: 1}}
+	svc := NewFailoverSearchService(primary, fallback, nopLogger{})
+
+	resp, err := svc.Search(context.Background(), model.SearchRequest{Query: "cat"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if resp.Total != 3 || fallback.calls != 0 {
+		t.Fatalf("total = %d, fallback calls = %d; want primary result only", resp.Total, fallback.calls)
+	}
+}
+
+func TestFailoverSearchFallsBackWhenPrimaryFails(t *testing.T) {
+	primary := &stubSearchService{err: errors.New("elasticsearch unavailable")}
+	fallback := &stubSearchService{resp: &model.SearchResponse{Total: 1}, suggestions: []string{"cat video"}}
+	svc := NewFailoverSearchService(primary, fallback, nopLogger{})
+
+	resp, err := svc.Search(context.Background(), model.SearchRequest{Query: "cat"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if resp.Total != 1 {
+		t.Fatalf("total = %d, want fallback result 1", resp.Total)
+	}
+
+	suggestions, err := svc.GetSearchSuggestions(context.Background(), "cat", 5)
+	if err != nil {
+		t.Fatalf("GetSearchSuggestions: %v", err)
+	}
+	if len(suggestions) != 1 || suggestions[0] != "cat video" {
+		t.Fatalf("suggestions = %v, want fallback suggestions", suggestions)
+	}
+}
+
+func TestFailoverSearchDoesNotFallBackWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	primary := &stubSearchService{err: context.Canceled}
+	fallback := &stubSearchService{resp: &model.SearchResponse{}}
+	svc := NewFailoverSearchService(primary, fallback, nopLogger{})
+
+	if _, err := svc.Search(ctx, model.SearchRequest{Query: "cat"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Search error = %v, want context.Canceled", err)
+	}
+	if fallback.calls != 0 {
+		t.Fatalf("fallback calls = %d after cancellation, want 0", fallback.calls)
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+	"search_service/pkg/logger"
+)
+
+// 索引事件消费默认参数
+const (
+	DefaultIndexQueueKey = "search:index:events" // 与视频、用户、直播服务约定的索引事件队列
+	indexPopTimeout      = time.Second           // 阻塞读取超时，同时决定停止时的最长等待
+	indexErrorBackoff    = time.Second           // 读取队列失败后的等待时间
+	indexApplyTimeout    = 10 * time.Second
+)
+
+// errUnknownIndexOp 未知的索引操作，重试也无法成功
+var errUnknownIndexOp = errors.New("unknown index op")
+
+// IndexConsumer 索引事件消费者
+// 从Redis队列中读取各服务发布的索引事件并写入索引；单个事件写入失败时按配置重试，
+// 仍失败则记录日志后丢弃，不阻塞后续事件
+type IndexConsumer struct {
+	redis         redis.UniversalClient
+	searchSvc     SearchService
+	logger        logger.Logger
+	queueKey      string
+	workers       int
+	retryAttempts int
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewIndexConsumer 创建索引事件消费者
+func NewIndexConsumer(redisClient redis.UniversalClient, searchSvc SearchService, cfg config.IndexingConfig, log logger.Logger) *IndexConsumer {
+	queueKey := cfg.QueueKey
+	if queueKey == "" {
+		queueKey = DefaultIndexQueueKey
+	}
+	workers := cfg.ConcurrentWorkers
+	if workers <= 0 {
+		workers = 1
+	}
+	retryAttempts := cfg.RetryAttempts
+	if retryAttempts <= 0 {
+		retryAttempts = 1
+	}
+
+	return &IndexConsumer{
+		redis:         redisClient,
+		searchSvc:     searchSvc,
+		logger:        log,
+		queueKey:      queueKey,
+		workers:       workers,
+		retryAttempts: retryAttempts,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start 启动消费协程
+func (c *IndexConsumer) Start() {
+	for i := 0; i < c.workers; i++ {
+		c.wg.Add(1)
+		go c.run()
+	}
+	c.logger.Info("Index consumer started", "queue", c.queueKey, "workers", c.workers)
+}
+
+func (c *IndexConsumer) run() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		default:
+		}
+
+		result, err := c.redis.BRPop(context.Background(), indexPopTimeout, c.queueKey).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			c.logger.Warn("Failed to read index events", "queue", c.queueKey, "error", err)
+			select {
+			case <-time.After(indexErrorBackoff):
+			case <-c.stopCh:
+				return
+			}
+			continue
+		}
+
+		// BRPOP返回[队列名, 事件]
+		c.handle(result[1])
+	}
+}
+
+// handle 处理单个索引事件
+func (c *IndexConsumer) handle(payload string) {
+	var event model.IndexEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		c.logger.Warn("Dropping malformed index event", "error", err)
+		return
+	}
+
+	var err error
+	for attempt := 1; attempt <= c.retryAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), indexApplyTimeout)
+		err = c.apply(ctx, &event)
+		cancel()
+		if err == nil || errors.Is(err, errUnknownIndexOp) || errors.Is(err, model.ErrInvalidDocument) {
+			break
+		}
+	}
+	if err != nil {
+		c.logger.Error("Failed to apply index event",
+			"op", event.Op,
+			"docType", event.DocType,
+			"id", event.ID,
+			"error", err,
+		)
+	}
+}
+
+// apply 将索引事件写入索引
+func (c *IndexConsumer) apply(ctx context.Context, event *model.IndexEvent) error {
+	switch event.Op {
+	case model.IndexOpUpsert:
+		doc := model.NewDocument(event)
+		if err := doc.Index(); err != nil {
+			return err
+		}
+		return c.searchSvc.IndexDocument(ctx, doc)
+	case model.IndexOpDelete:
+		if event.ID == "" || event.DocType == "" {
+			return model.ErrInvalidDocument
+		}
+		return c.searchSvc.DeleteDocument(ctx, event.ID, event.DocType)
+	default:
+		return fmt.Errorf("%w: %q", errUnknownIndexOp, event.Op)
+	}
+}
+
+// Stop 停止消费并等待正在处理的事件完成
+func (c *IndexConsumer) Stop(ctx context.Context) error {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
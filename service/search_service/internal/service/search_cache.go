@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+	"search_service/pkg/logger"
+)
+
+// 搜索结果缓存键
+const (
+	searchResultCacheKey = "search:result:%s"  // 搜索结果，按规范化查询的摘要存储
+	searchResultLRUKey   = "search:result:lru" // 有序集合，分值为最近访问时间的毫秒时间戳，用于LRU淘汰
+)
+
+// 搜索结果缓存默认配置
+const (
+	defaultSearchCacheTTL        = 5 * time.Minute
+	defaultSearchCacheMaxEntries = 10000
+)
+
+// SearchCache 搜索结果缓存
+// 以规范化后的查询为键将搜索结果保存在Redis中，条目数超过上限时按最近访问时间淘汰最旧的条目
+type SearchCache struct {
+	redis      redis.UniversalClient
+	logger     logger.Logger
+	ttl        time.Duration
+	maxEntries int
+}
+
+// NewSearchCache 创建搜索结果缓存，未开启缓存时返回nil
+func NewSearchCache(redisClient redis.UniversalClient, cfg config.CacheConfig, log logger.Logger) *SearchCache {
+	if !cfg.Enabled || redisClient == nil {
+		return nil
+	}
+
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultSearchCacheTTL
+	}
+	maxEntries := cfg.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultSearchCacheMaxEntries
+	}
+
+	return &SearchCache{
+		redis:      redisClient,
+		logger:     log,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// NormalizeSearchQuery 生成搜索请求的规范化表示
+// 关键词转小写、去除首尾空白并合并连续空白，过滤条件按键排序，语义相同的请求得到相同结果
+func NormalizeSearchQuery(req model.SearchRequest) string {
+	values := url.Values{}
+	values.Set("q", strings.ToLower(strings.Join(strings.Fields(req.Query), " ")))
+	values.Set("type", strings.ToLower(strings.TrimSpace(req.SearchType)))
+	values.Set("page", strconv.Itoa(req.Page))
+	values.Set("size", strconv.Itoa(req.Size))
+	values.Set("sort_by", strings.ToLower(strings.TrimSpace(req.SortBy)))
+	values.Set("sort_order", strings.ToLower(strings.TrimSpace(req.SortOrder)))
+	values.Set("fuzzy", strconv.FormatBool(req.FuzzySearch))
+	for k, v := range req.Filter {
+		values.Set("filter."+strings.ToLower(strings.TrimSpace(k)), strings.TrimSpace(v))
+	}
+	// Encode按键排序，过滤条件的顺序不影响结果
+	return values.Encode()
+}
+
+// cacheMember 规范化查询在缓存中的标识，使用摘要避免键过长
+func cacheMember(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get 获取缓存的搜索结果，未命中时返回nil
+func (c *SearchCache) Get(ctx context.Context, query string) (*model.SearchResponse, error) {
+	member := cacheMember(query)
+	data, err := c.redis.Get(ctx, fmt.Sprintf(searchResultCacheKey, member)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var resp model.SearchResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cached search result: %w", err)
+	}
+
+	// 刷新最近访问时间，失败只影响淘汰顺序
+	if err := c.redis.ZAdd(ctx, searchResultLRUKey, &redis.Z{Score: float64(time.Now().UnixMilli()), Member: member}).Err(); err != nil {
+		c.logger.Warn("Failed to touch search cache entry", "error", err)
+	}
+	return &resp, nil
+}
+
+// Set 缓存搜索结果，条目数超过上限时淘汰最久未访问的条目
+func (c *SearchCache) Set(ctx context.Context, query string, resp *model.SearchResponse) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal search result: %w", err)
+	}
+
+	member := cacheMember(query)
+	now := time.Now()
+	pipe := c.redis.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf(searchResultCacheKey, member), data, c.ttl)
+	pipe.ZAdd(ctx, searchResultLRUKey, &redis.Z{Score: float64(now.UnixMilli()), Member: member})
+	// 超过TTL未访问的条目已自然过期，从LRU集合中移除
+	pipe.ZRemRangeByScore(ctx, searchResultLRUKey, "-inf", strconv.FormatInt(now.Add(-c.ttl).UnixMilli(), 10))
+	count := pipe.ZCard(ctx, searchResultLRUKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	if excess := count.Val() - int64(c.maxEntries); excess > 0 {
+		return c.evict(ctx, excess)
+	}
+	return nil
+}
+
+// evict 淘汰最久未访问的n个条目
+func (c *SearchCache) evict(ctx context.Context, n int64) error {
+	evicted, err := c.redis.ZPopMin(ctx, searchResultLRUKey, n).Result()
+	if err != nil {
+		return err
+	}
+	if len(evicted) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(evicted))
+	for _, z := range evicted {
+		if member, ok := z.Member.(string); ok {
+			keys = append(keys, fmt.Sprintf(searchResultCacheKey, member))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.redis.Del(ctx, keys...).Err()
+}
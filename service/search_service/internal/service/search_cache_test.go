@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"search_service/internal/config"
+	"search_service/internal/model"
+	"search_service/internal/repository"
+)
+
+func TestNormalizeSearchQueryEquivalentRequests(t *testing.T) {
+	base := model.SearchRequest{
+		Query:      "cat videos",
+		Page:       1,
+		Size:       20,
+		SearchType: "video",
+		Filter:     map[string]string{"category": "pets", "duration": "short"},
+		SortBy:     "views",
+		SortOrder:  "desc",
+	}
+	want := NormalizeSearchQuery(base)
+
+	equivalent := []model.SearchRequest{
+		// 关键词大小写和空白
+		{Query: "  Cat   VIDEOS\t", Page: 1, Size: 20, SearchType: "video", Filter: map[string]string{"category": "pets", "duration": "short"}, SortBy: "views", SortOrder: "desc"},
+		// 类型和排序的大小写与空白
+		{Query: "cat videos", Page: 1, Size: 20, SearchType: " Video", Filter: map[string]string{"category": "pets", "duration": "short"}, SortBy: "VIEWS ", SortOrder: "DESC"},
+		// 过滤条件键的大小写和值的首尾空白
+		{Query: "cat videos", Page: 1, Size: 20, SearchType: "video", Filter: map[string]string{" Duration": "short ", "CATEGORY": " pets"}, SortBy: "views", SortOrder: "desc"},
+		// 个性化用户不影响规范化结果，是否缓存由调用方判断
+		{Query: "cat videos", Page: 1, Size: 20, SearchType: "video", Filter: map[string]string{"category": "pets", "duration": "short"}, SortBy: "views", SortOrder: "desc", UserID: 7},
+	}
+	for _, req := range equivalent {
+		if got := NormalizeSearchQuery(req); got != want {
+			t.Errorf("NormalizeSearchQuery(%+v) = %q, want %q", req, got, want)
+		}
+	}
+}
+
+func TestNormalizeSearchQueryDistinctRequests(t *testing.T) {
+	base := model.SearchRequest{Query: "cat", Page: 1, Size: 20, Filter: map[string]string{"category": "pets"}}
+	modify := map[string]func(*model.SearchRequest){
+		"query":         func(r *model.SearchRequest) { r.Query = "cats" },
+		"word order":    func(r *model.SearchRequest) { r.Query = "cat video" },
+		"page":          func(r *model.SearchRequest) { r.Page = 2 },
+		"size":          func(r *model.SearchRequest) { r.Size = 10 },
+		"type":          func(r *model.SearchRequest) { r.SearchType = "user" },
+		"sort":          func(r *model.SearchRequest) { r.SortBy = "created_at" },
+		"order":         func(r *model.SearchRequest) { r.SortOrder = "asc" },
+		"fuzzy":         func(r *model.SearchRequest) { r.FuzzySearch = true },
+		"filter value":  func(r *model.SearchRequest) { r.Filter = map[string]string{"category": "Pets"} },
+		"extra filter":  func(r *model.SearchRequest) { r.Filter = map[string]string{"category": "pets", "tag": "cute"} },
+		"no filter":     func(r *model.SearchRequest) { r.Filter = nil },
+		"injected keys": func(r *model.SearchRequest) { r.Query = "cat&page=2" },
+	}
+	seen := map[string]string{NormalizeSearchQuery(base): "base"}
+	for name, fn := range modify {
+		req := base
+		req.Filter = map[string]string{"category": "pets"}
+		fn(&req)
+		got := NormalizeSearchQuery(req)
+		if other, ok := seen[got]; ok {
+			t.Errorf("%s normalizes to the same query as %s: %q", name, other, got)
+		}
+		seen[got] = name
+	}
+}
+
+func TestNewSearchCacheConfig(t *testing.T) {
+	client, _ := startFakeRedis(t)
+	if c := NewSearchCache(client, config.CacheConfig{}, nopLogger{}); c != nil {
+		t.Error("disabled cache was created")
+	}
+	if c := NewSearchCache(nil, config.CacheConfig{Enabled: true}, nopLogger{}); c != nil {
+		t.Error("cache was created without redis")
+	}
+	c := NewSearchCache(client, config.CacheConfig{Enabled: true}, nopLogger{})
+	if c == nil || c.ttl != defaultSearchCacheTTL || c.maxEntries != defaultSearchCacheMaxEntries {
+		t.Errorf("cache = %+v, want the defaults", c)
+	}
+}
+
+// countingSearchRepo 记录搜索次数的仓库
+type countingSearchRepo struct {
+	repository.SearchRepository
+	resp  *model.SearchResponse
+	err   error
+	calls int
+}
+
+func (r *countingSearchRepo) SearchDocuments(ctx context.Context, req model.SearchRequest) (*model.SearchResponse, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	resp := *r.resp
+	return &resp, nil
+}
+
+func newCachedSearchService(t *testing.T, maxEntries int) (*searchService, *countingSearchRepo, *fakeRedisServer) {
+	t.Helper()
+	client, srv := startFakeRedis(t)
+	repo := &countingSearchRepo{resp: &model.SearchResponse{
+		Results: []model.SearchResult{{ID: "v1", Type: "video", Score: 1.5, Source: map[string]interface{}{"title": "Cat"}}},
+		Total:   1,
+		Page:    1,
+		Size:    20,
+	}}
+	cache := NewSearchCache(client, config.CacheConfig{Enabled: true, TTL: time.Minute, MaxEntries: maxEntries}, nopLogger{})
+	return NewSearchService(repo, cache, nopLogger{}).(*searchService), repo, srv
+}
+
+func TestSearchServedFromCacheForEquivalentQuery(t *testing.T) {
+	s, repo, _ := newCachedSearchService(t, 10)
+	ctx := context.Background()
+
+	first, err := s.Search(ctx, model.SearchRequest{Query: "Cat", Page: 1, Size: 20, Filter: map[string]string{"a": "1", "b": "2"}})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	second, err := s.Search(ctx, model.SearchRequest{Query: " cat ", Page: 1, Size: 20, Filter: map[string]string{"B": "2", "a": "1"}})
+	if err != nil {
+		t.Fatalf("cached Search: %v", err)
+	}
+	if repo.calls != 1 {
+		t.Errorf("repository searches = %d, want 1", repo.calls)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("cached result = %+v, want %+v", second, first)
+	}
+
+	// 不同页不命中缓存
+	if _, err := s.Search(ctx, model.SearchRequest{Query: "cat", Page: 2, Size: 20, Filter: map[string]string{"a": "1", "b": "2"}}); err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if repo.calls != 2 {
+		t.Errorf("repository searches = %d after another page, want 2", repo.calls)
+	}
+}
+
+func TestSearchSkipsCache(t *testing.T) {
+	t.Run("personalized", func(t *testing.T) {
+		s, repo, srv := newCachedSearchService(t, 10)
+		req := model.SearchRequest{Query: "cat", UserID: 7}
+		for i := 0; i < 2; i++ {
+			if _, err := s.Search(context.Background(), req); err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+		}
+		if repo.calls != 2 || srv.cachedEntries() != 0 {
+			t.Errorf("searches = %d, cached = %d; want personalized results never cached", repo.calls, srv.cachedEntries())
+		}
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		s, repo, srv := newCachedSearchService(t, 10)
+		repo.err = errors.New("elasticsearch unavailable")
+		if _, err := s.Search(context.Background(), model.SearchRequest{Query: "cat"}); !errors.Is(err, repo.err) {
+			t.Fatalf("Search error = %v, want the repository error", err)
+		}
+		if srv.cachedEntries() != 0 {
+			t.Errorf("cached = %d, want failed searches not cached", srv.cachedEntries())
+		}
+	})
+
+	t.Run("redis unavailable", func(t *testing.T) {
+		repo := &countingSearchRepo{resp: &model.SearchResponse{Total: 1}}
+		cache := NewSearchCache(unreachableRedis(t), config.CacheConfig{Enabled: true}, nopLogger{})
+		s := NewSearchService(repo, cache, nopLogger{})
+
+		// 缓存不可用时直接搜索
+		resp, err := s.Search(context.Background(), model.SearchRequest{Query: "cat"})
+		if err != nil || resp.Total != 1 || repo.calls != 1 {
+			t.Errorf("Search = (%+v, %v) with %d searches, want the repository result", resp, err, repo.calls)
+		}
+	})
+}
+
+func TestSearchCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	s, repo, srv := newCachedSearchService(t, 2)
+	ctx := context.Background()
+	search := func(query string) {
+		t.Helper()
+		if _, err := s.Search(ctx, model.SearchRequest{Query: query}); err != nil {
+			t.Fatalf("Search(%q): %v", query, err)
+		}
+		// 访问时间精确到毫秒，间隔开保证淘汰顺序确定
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	search("a")
+	search("b")
+	search("a") // 命中并刷新a的访问时间
+	search("c") // 超过上限，淘汰最久未访问的b
+	if srv.cachedEntries() != 2 {
+		t.Errorf("cached = %d, want 2", srv.cachedEntries())
+	}
+
+	calls := repo.calls
+	search("a")
+	search("c")
+	if repo.calls != calls {
+		t.Errorf("recently used queries searched again: %d searches, want %d", repo.calls, calls)
+	}
+	search("b")
+	if repo.calls != calls+1 {
+		t.Errorf("evicted query served from cache")
+	}
+}
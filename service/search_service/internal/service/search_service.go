@@ -25,13 +25,15 @@ type SearchService interface {
 // searchService 搜索服务实现
 type searchService struct {
 	repo   repository.SearchRepository
+	cache  *SearchCache
 	logger logger.Logger
 }
 
-// NewSearchService 创建搜索服务实例
-func NewSearchService(repo repository.SearchRepository, logger logger.Logger) SearchService {
+// NewSearchService 创建搜索服务实例，cache为nil时不缓存搜索结果
+func NewSearchService(repo repository.SearchRepository, cache *SearchCache, logger logger.Logger) SearchService {
 	return &searchService{
 		repo:   repo,
+		cache:  cache,
 		logger: logger,
 	}
 }
@@ -41,6 +43,20 @@ func (s *searchService) Search(ctx context.Context, req model.SearchRequest) (*m
 	// 记录搜索日志
 	s.logger.Info("Executing search", "query", req.Query, "page", req.Page, "size", req.Size)
 
+	// 个性化搜索的结果因用户而异，不走缓存
+	useCache := s.cache != nil && req.UserID == 0
+	var cacheQuery string
+	if useCache {
+		cacheQuery = NormalizeSearchQuery(req)
+		cached, err := s.cache.Get(ctx, cacheQuery)
+		if err != nil {
+			s.logger.Warn("Failed to get cached search result", "error", err)
+		} else if cached != nil {
+			s.logger.Info("Search served from cache", "total_results", cached.Total)
+			return cached, nil
+		}
+	}
+
 	// 执行搜索
 	result, err := s.repo.SearchDocuments(ctx, req)
 	if err != nil {
@@ -48,6 +64,12 @@ func (s *searchService) Search(ctx context.Context, req model.SearchRequest) (*m
 		return nil, err
 	}
 
+	if useCache {
+		if err := s.cache.Set(ctx, cacheQuery, result); err != nil {
+			s.logger.Warn("Failed to cache search result", "error", err)
+		}
+	}
+
 	s.logger.Info("Search completed", "total_results", result.Total)
 	return result, nil
 }
@@ -58,10 +58,9 @@ func NewMySQLConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
-// NewRedisClient 创建Redis客户端
-func NewRedisClient(cfg config.RedisConfig) (*redis.Client, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:         fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+// NewRedisClient 创建Redis客户端，按mode配置使用单节点、哨兵或集群模式
+func NewRedisClient(cfg config.RedisConfig) (redis.UniversalClient, error) {
+	client, err := newRedisClient(cfg, &redis.Options{
 		Password:     cfg.Password,
 		DB:           cfg.DB,
 		MaxRetries:   cfg.MaxRetries,
@@ -69,13 +68,16 @@ func NewRedisClient(cfg config.RedisConfig) (*redis.Client, error) {
 		ReadTimeout:  time.Duration(cfg.ReadTimeout) * time.Second,
 		WriteTimeout: time.Duration(cfg.WriteTimeout) * time.Second,
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	// 测试连接
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := client.Ping(ctx).Result()
-	if err != nil {
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
 		return nil, fmt.Errorf("failed to connect to redis: %w", err)
 	}
 
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"search_service/internal/config"
+)
+
+// Redis部署模式
+const (
+	RedisModeSingle   = "single"   // 单节点，默认模式
+	RedisModeSentinel = "sentinel" // 哨兵模式，由哨兵发现主节点
+	RedisModeCluster  = "cluster"  // 集群模式
+)
+
+// newRedisClient 按部署模式创建Redis客户端
+// base为各模式共用的连接参数(密码、DB、重试、超时、连接池)，单节点模式使用host和port作为地址；
+// 集群模式不支持选择DB，db不为0时返回错误
+func newRedisClient(cfg config.RedisConfig, base *redis.Options) (redis.UniversalClient, error) {
+	switch mode := strings.ToLower(strings.TrimSpace(cfg.Mode)); mode {
+	case "", RedisModeSingle:
+		opts := *base
+		opts.Addr = fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+		return redis.NewClient(&opts), nil
+	case RedisModeSentinel:
+		if cfg.MasterName == "" || len(cfg.SentinelAddrs) == 0 {
+			return nil, fmt.Errorf("redis sentinel mode requires master_name and sentinel_addrs")
+		}
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       cfg.MasterName,
+			SentinelAddrs:    cfg.SentinelAddrs,
+			SentinelPassword: cfg.SentinelPassword,
+			Password:         base.Password,
+			DB:               base.DB,
+			MaxRetries:       base.MaxRetries,
+			DialTimeout:      base.DialTimeout,
+			ReadTimeout:      base.ReadTimeout,
+			WriteTimeout:     base.WriteTimeout,
+			PoolSize:         base.PoolSize,
+		}), nil
+	case RedisModeCluster:
+		if len(cfg.ClusterNodes) == 0 {
+			return nil, fmt.Errorf("redis cluster mode requires cluster_nodes")
+		}
+		if base.DB != 0 {
+			return nil, fmt.Errorf("redis cluster mode does not support db %d", base.DB)
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        cfg.ClusterNodes,
+			Password:     base.Password,
+			MaxRetries:   base.MaxRetries,
+			DialTimeout:  base.DialTimeout,
+			ReadTimeout:  base.ReadTimeout,
+			WriteTimeout: base.WriteTimeout,
+			PoolSize:     base.PoolSize,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported redis mode %q", mode)
+	}
+}
@@ -0,0 +1,110 @@
+// Package grpctls 根据配置构造gRPC服务端和客户端的传输层凭证
+package grpctls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	// ErrMissingCertificate 开启TLS的服务端未配置证书或私钥
+	ErrMissingCertificate = errors.New("tls certificate and key are required")
+	// ErrMissingClientCA 服务端要求客户端证书但未配置校验用的CA
+	ErrMissingClientCA = errors.New("client ca is required when client auth is enabled")
+)
+
+// Options TLS参数，Enabled为false时使用明文连接，仅适用于本地开发
+type Options struct {
+	Enabled bool
+
+	// CertFile、KeyFile 本端证书和私钥，服务端必填，客户端仅在对端要求客户端证书(mTLS)时填写
+	CertFile string
+	KeyFile  string
+
+	// CAFile 校验对端证书的CA；服务端开启ClientAuth时必填，客户端为空时使用系统根证书
+	CAFile string
+
+	// ClientAuth 服务端要求并校验客户端证书(mTLS)，仅服务端使用
+	ClientAuth bool
+
+	// ServerName 客户端校验服务端证书时使用的名称，为空时取拨号地址的主机名
+	ServerName string
+}
+
+// ServerCredentials 构造gRPC服务端凭证
+func ServerCredentials(opts Options) (credentials.TransportCredentials, error) {
+	if !opts.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	if opts.CertFile == "" || opts.KeyFile == "" {
+		return nil, ErrMissingCertificate
+	}
+
+	cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if opts.ClientAuth {
+		if opts.CAFile == "" {
+			return nil, ErrMissingClientCA
+		}
+		pool, err := loadCertPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// ClientCredentials 构造gRPC客户端凭证
+func ClientCredentials(opts Options) (credentials.TransportCredentials, error) {
+	if !opts.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{
+		ServerName: opts.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if opts.CAFile != "" {
+		pool, err := loadCertPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// loadCertPool 读取PEM格式的CA证书
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ca file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no valid certificates found in ca file %s", caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.20.1
+// source: proto/search.proto
+
+package proto_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SearchService_Search_FullMethodName               = "/search_service.SearchService/Search"
+	SearchService_GetSearchSuggestions_FullMethodName = "/search_service.SearchService/GetSearchSuggestions"
+	SearchService_BulkIndexStream_FullMethodName      = "/search_service.SearchService/BulkIndexStream"
+)
+
+// SearchServiceClient is the client API for SearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchServiceClient interface {
+	// 搜索视频、用户或内容
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// 获取搜索建议
+	GetSearchSuggestions(ctx context.Context, in *SuggestionRequest, opts ...grpc.CallOption) (*SuggestionResponse, error)
+	// 批量索引文档，调用方以客户端流逐个发送文档，服务端按配置的批大小写入索引
+	BulkIndexStream(ctx context.Context, opts ...grpc.CallOption) (SearchService_BulkIndexStreamClient, error)
+}
+
+type searchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
+	return &searchServiceClient{cc}
+}
+
+func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, SearchService_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) GetSearchSuggestions(ctx context.Context, in *SuggestionRequest, opts ...grpc.CallOption) (*SuggestionResponse, error) {
+	out := new(SuggestionResponse)
+	err := c.cc.Invoke(ctx, SearchService_GetSearchSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) BulkIndexStream(ctx context.Context, opts ...grpc.CallOption) (SearchService_BulkIndexStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SearchService_ServiceDesc.Streams[0], SearchService_BulkIndexStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &searchServiceBulkIndexStreamClient{stream}
+	return x, nil
+}
+
+type SearchService_BulkIndexStreamClient interface {
+	Send(*IndexDocumentRequest) error
+	CloseAndRecv() (*BulkIndexResponse, error)
+	grpc.ClientStream
+}
+
+type searchServiceBulkIndexStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *searchServiceBulkIndexStreamClient) Send(m *IndexDocumentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *searchServiceBulkIndexStreamClient) CloseAndRecv() (*BulkIndexResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BulkIndexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SearchServiceServer is the server API for SearchService service.
+// All implementations must embed UnimplementedSearchServiceServer
+// for forward compatibility
+type SearchServiceServer interface {
+	// 搜索视频、用户或内容
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// 获取搜索建议
+	GetSearchSuggestions(context.Context, *SuggestionRequest) (*SuggestionResponse, error)
+	// 批量索引文档，调用方以客户端流逐个发送文档，服务端按配置的批大小写入索引
+	BulkIndexStream(SearchService_BulkIndexStreamServer) error
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+// UnimplementedSearchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSearchServiceServer struct {
+}
+
+func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchServiceServer) GetSearchSuggestions(context.Context, *SuggestionRequest) (*SuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchSuggestions not implemented")
+}
+func (UnimplementedSearchServiceServer) BulkIndexStream(SearchService_BulkIndexStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BulkIndexStream not implemented")
+}
+func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
+
+// UnsafeSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServiceServer will
+// result in compilation errors.
+type UnsafeSearchServiceServer interface {
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+func RegisterSearchServiceServer(s grpc.ServiceRegistrar, srv SearchServiceServer) {
+	s.RegisterService(&SearchService_ServiceDesc, srv)
+}
+
+func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_GetSearchSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).GetSearchSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_GetSearchSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).GetSearchSuggestions(ctx, req.(*SuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_BulkIndexStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SearchServiceServer).BulkIndexStream(&searchServiceBulkIndexStreamServer{stream})
+}
+
+type SearchService_BulkIndexStreamServer interface {
+	SendAndClose(*BulkIndexResponse) error
+	Recv() (*IndexDocumentRequest, error)
+	grpc.ServerStream
+}
+
+type searchServiceBulkIndexStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *searchServiceBulkIndexStreamServer) SendAndClose(m *BulkIndexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *searchServiceBulkIndexStreamServer) Recv() (*IndexDocumentRequest, error) {
+	m := new(IndexDocumentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "search_service.SearchService",
+	HandlerType: (*SearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchService_Search_Handler,
+		},
+		{
+			MethodName: "GetSearchSuggestions",
+			Handler:    _SearchService_GetSearchSuggestions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BulkIndexStream",
+			Handler:       _SearchService_BulkIndexStream_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/search.proto",
+}
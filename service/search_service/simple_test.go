@@ -3,7 +3,7 @@ package main
 import (
 	"fmt"
 	"search_service/internal/handler"
-	"search_service/internal/model"
+	"search_service/proto/proto_gen"
 )
 
 // SimpleLogger 简单的日志记录器实现
@@ -43,12 +43,12 @@ func main() {
 	searchHandler := handler.NewSearchServiceHandler(nil, logger, nil, nil)
 
 	// 测试搜索功能
-	req := &model.SearchRequest{
+	req := &proto_gen.SearchRequest{
 		Query:       "测试",
 		Page:        1,
-		Size:        10,
+		PageSize:    10,
 		SearchType:  "video",
-		Filter:      make(map[string]string),
+		Filters:     make(map[string]string),
 		SortBy:      "relevance",
 		SortOrder:   "desc",
 		FuzzySearch: true,
@@ -63,23 +63,23 @@ func main() {
 	fmt.Printf("\nSearch Results:\n")
 	fmt.Printf("Total: %d\n", resp.Total)
 	fmt.Printf("Page: %d\n", resp.Page)
-	fmt.Printf("Size: %d\n", resp.Size)
+	fmt.Printf("Size: %d\n", resp.PageSize)
 	fmt.Printf("Elapsed Time: %d ms\n", resp.ElapsedTime)
 	fmt.Printf("Results:\n")
 	for i, result := range resp.Results {
-		fmt.Printf("  %d. ID: %s, Score: %.2f, Type: %s\n", i+1, result.ID, result.Score, result.Type)
+		fmt.Printf("  %d. ID: %s, Score: %.2f, Type: %s\n", i+1, result.Id, result.Score, result.Type)
 		fmt.Printf("     Source: %v\n", result.Source)
 	}
 
 	// 测试搜索建议功能
-	suggestions, err := searchHandler.GetSearchSuggestions(nil, "测试", 5)
+	suggestions, err := searchHandler.GetSearchSuggestions(nil, &proto_gen.SuggestionRequest{Prefix: "测试", Limit: 5})
 	if err != nil {
 		fmt.Printf("GetSearchSuggestions failed: %v\n", err)
 		return
 	}
 
 	fmt.Printf("\nSearch Suggestions:\n")
-	for i, suggestion := range suggestions {
+	for i, suggestion := range suggestions.Suggestions {
 		fmt.Printf("  %d. %s\n", i+1, suggestion)
 	}
 
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestFollowRateLimitWithDefaults(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           FollowRateLimitConfig
+		wantPerMinute int
+		wantPerDay    int
+	}{
+		{"unset", FollowRateLimitConfig{}, 20, 500},
+		{"configured", FollowRateLimitConfig{PerMinute: 5, PerDay: 100}, 5, 100},
+		{"negative", FollowRateLimitConfig{PerMinute: -1, PerDay: -1}, 20, 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cfg.WithDefaults()
+			if got.PerMinute != tt.wantPerMinute || got.PerDay != tt.wantPerDay {
+				t.Errorf("WithDefaults() = %+v, want %d per minute and %d per day", got, tt.wantPerMinute, tt.wantPerDay)
+			}
+		})
+	}
+}
+
+func TestFollowRateLimitFromYAML(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader("follow:\n  rate_limit:\n    per_minute: 5\n    per_day: 100\n")); err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("unmarshal config: %v", err)
+	}
+	if got := cfg.Follow.RateLimit; got.PerMinute != 5 || got.PerDay != 100 {
+		t.Errorf("rate limit = %+v, want 5 per minute and 100 per day", got)
+	}
+}
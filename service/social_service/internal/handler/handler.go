@@ -6,6 +6,7 @@ import (
 
 	"social_service/internal/config"
 	"social_service/internal/converter"
+	"social_service/internal/model"
 	"social_service/internal/repository"
 	"social_service/internal/service"
 	"social_service/pkg/logger"
@@ -17,14 +18,15 @@ import (
 // UserServiceHandler 用户服务处理器
 type UserServiceHandler struct {
 	proto_gen.UnimplementedUserServiceServer
-	config      *config.Config
-	logger      logger.Logger
-	userService service.UserService
-	converter   *converter.UserConverter
+	config        *config.Config
+	logger        logger.Logger
+	userService   service.UserService
+	followService service.FollowService
+	converter     *converter.UserConverter
 }
 
 // NewUserServiceHandler 创建用户服务处理器
-func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis *redis.Client) *UserServiceHandler {
+func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis redis.UniversalClient) *UserServiceHandler {
 	// 创建认证服务
 	refreshSecret := cfg.JWT.RefreshSecret
 	if refreshSecret == "" {
@@ -51,11 +53,15 @@ func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, r
 	// 创建用户服务
 	userService := service.NewUserService(cfg, log, userRepo, authService, smsService)
 
+	// 创建关注关系服务
+	followService := service.NewFollowService(cfg, log, repository.NewFollowRepository(db, redis))
+
 	return &UserServiceHandler{
-		config:      cfg,
-		logger:      log,
-		userService: userService,
-		converter:   converter.NewUserConverter(),
+		config:        cfg,
+		logger:        log,
+		userService:   userService,
+		followService: followService,
+		converter:     converter.NewUserConverter(),
 	}
 }
 
@@ -257,3 +263,15 @@ func (h *UserServiceHandler) GetUserExistInformation(ctx context.Context, req *p
 		//Exist:      exists,
 	}, nil
 }
+
+// GetFollowRecommendations 获取"可能认识的人"推荐
+func (h *UserServiceHandler) GetFollowRecommendations(ctx context.Context, userID uint32, limit int) ([]model.FollowRecommendation, error) {
+	h.logger.Info("GetFollowRecommendations called", "user_id", userID, "limit", limit)
+
+	recs, err := h.followService.GetFollowRecommendations(ctx, userID, limit)
+	if err != nil {
+		h.logger.Error("GetFollowRecommendations failed", "error", err, "user_id", userID)
+		return nil, err
+	}
+	return recs, nil
+}
@@ -14,6 +14,8 @@ const (
 	UserFollowCacheKey  = "user:follow:%d:%d"        // 用户关注列表缓存
 	UserFanCacheKey     = "user:fan:%d:%d"           // 用户粉丝列表缓存
 	UserFollowStatusKey = "user:follow:status:%d:%d" // 关注状态缓存
+	FollowRecommendKey  = "user:follow:recommend:%d" // 可能认识的人推荐缓存
+	FollowRateKey       = "rate:follow:{%d}:%s"      // 关注操作限频计数，按用户和时间窗口；用户ID作为哈希标签，同一用户的各窗口在Redis Cluster下落在同一个槽
 
 	// 统计相关
 	UserTrendCacheKey = "user:trend:%d:%s" // 用户趋势缓存
@@ -31,12 +33,13 @@ const (
 
 // CacheTTL 缓存过期时间定义
 const (
-	UserInfoTTL     = 30 * time.Minute // 用户信息缓存30分钟
-	UserStatsTTL    = 10 * time.Minute // 用户统计缓存10分钟
-	UserFollowTTL   = 15 * time.Minute // 关注列表缓存15分钟
-	UserTrendTTL    = 1 * time.Hour    // 趋势缓存1小时
-	HotUsersTTL     = 5 * time.Minute  // 热门用户缓存5分钟
-	FollowStatusTTL = 5 * time.Minute  // 关注状态缓存5分钟
+	UserInfoTTL        = 30 * time.Minute // 用户信息缓存30分钟
+	UserStatsTTL       = 10 * time.Minute // 用户统计缓存10分钟
+	UserFollowTTL      = 15 * time.Minute // 关注列表缓存15分钟
+	UserTrendTTL       = 1 * time.Hour    // 趋势缓存1小时
+	HotUsersTTL        = 5 * time.Minute  // 热门用户缓存5分钟
+	FollowStatusTTL    = 5 * time.Minute  // 关注状态缓存5分钟
+	FollowRecommendTTL = 5 * time.Minute  // 关注推荐缓存5分钟
 )
 
 // UserCache 用户缓存数据结构
@@ -140,6 +143,16 @@ func GetFollowStatusCacheKey(actorID, targetID uint64) string {
 	return fmt.Sprintf(UserFollowStatusKey, actorID, targetID)
 }
 
+// GetFollowRecommendCacheKey 获取关注推荐缓存键
+func GetFollowRecommendCacheKey(userID uint32) string {
+	return fmt.Sprintf(FollowRecommendKey, userID)
+}
+
+// GetFollowRateKey 获取关注操作限频计数键，window为时间窗口标识
+func GetFollowRateKey(userID uint32, window string) string {
+	return fmt.Sprintf(FollowRateKey, userID, window)
+}
+
 // GetUserTrendCacheKey 获取用户趋势缓存键
 func GetUserTrendCacheKey(userID uint64, period string) string {
 	return fmt.Sprintf(UserTrendCacheKey, userID, period)
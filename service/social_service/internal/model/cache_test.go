@@ -0,0 +1,28 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+// hashTag 返回键的Redis Cluster哈希标签，没有标签时返回整个键
+func hashTag(key string) string {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return key[start+1 : start+1+end]
+		}
+	}
+	return key
+}
+
+func TestFollowRateKeysShareHashSlot(t *testing.T) {
+	// incrFollowActionsScript同时操作同一用户的分钟和天两个窗口
+	minuteKey := GetFollowRateKey(7, "m:28000000")
+	dayKey := GetFollowRateKey(7, "d:20261016")
+	if a, b := hashTag(minuteKey), hashTag(dayKey); a != b {
+		t.Fatalf("minute key tag %q != day key tag %q", a, b)
+	}
+	if hashTag(GetFollowRateKey(8, "m:28000000")) == hashTag(minuteKey) {
+		t.Fatal("different users share a hash tag, want per-user slots")
+	}
+}
@@ -24,6 +24,12 @@ type FollowStats struct {
 	FollowersCount uint32 `json:"followers_count"`
 }
 
+// FollowRecommendation 关注推荐，MutualCount为用户关注的人中同样关注了该用户的人数
+type FollowRecommendation struct {
+	UserID      uint32 `json:"user_id"`
+	MutualCount uint32 `json:"mutual_count"`
+}
+
 // UserWithFollowStatus 用户信息和关注状态
 type UserWithFollowStatus struct {
 	User
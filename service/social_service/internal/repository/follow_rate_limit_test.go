@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// scriptRedis 按限频脚本的语义在内存中执行EvalSha，记录调用的键和参数
+type scriptRedis struct {
+	redis.UniversalClient
+	counts map[string]int64
+	ttls   map[string]time.Duration
+	keys   [][]string
+	err    error
+}
+
+func (c *scriptRedis) EvalSha(ctx context.Context, sha1 string, keys []string, args ...interface{}) *redis.Cmd {
+	if c.err != nil {
+		return redis.NewCmdResult(nil, c.err)
+	}
+	c.keys = append(c.keys, keys)
+	result := make([]interface{}, len(keys))
+	for i, key := range keys {
+		c.counts[key]++
+		if c.counts[key] == 1 {
+			c.ttls[key] = time.Duration(args[i].(int64)) * time.Millisecond
+		}
+		result[i] = c.counts[key]
+	}
+	return redis.NewCmdResult(result, nil)
+}
+
+func TestIncrFollowActions(t *testing.T) {
+	rdb := &scriptRedis{counts: make(map[string]int64), ttls: make(map[string]time.Duration)}
+	repo := &followRepository{redis: rdb}
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 30, 15, 0, time.UTC)
+
+	for i := int64(1); i <= 2; i++ {
+		perMinute, perDay, err := repo.IncrFollowActions(ctx, 7, now)
+		if err != nil {
+			t.Fatalf("IncrFollowActions: %v", err)
+		}
+		if perMinute != i || perDay != i {
+			t.Errorf("counts = (%d, %d), want (%d, %d)", perMinute, perDay, i, i)
+		}
+	}
+	// 两个键使用相同的hash tag，集群模式下落在同一个slot
+	minuteKey := "rate:follow:{7}:m:28576110" // 自Unix纪元起的分钟数
+	dayKey := "rate:follow:{7}:d:20240501"
+	if got := rdb.keys[0]; !reflect.DeepEqual(got, []string{minuteKey, dayKey}) {
+		t.Fatalf("keys = %v, want the minute and day windows of user 7", got)
+	}
+	if rdb.ttls[minuteKey] != time.Minute || rdb.ttls[dayKey] != 24*time.Hour {
+		t.Errorf("ttls = %v, want a minute and a day", rdb.ttls)
+	}
+
+	// 下一分钟重新计数，当天的计数继续累加
+	perMinute, perDay, err := repo.IncrFollowActions(ctx, 7, now.Add(time.Minute))
+	if err != nil || perMinute != 1 || perDay != 3 {
+		t.Errorf("next minute counts = (%d, %d, %v), want (1, 3)", perMinute, perDay, err)
+	}
+
+	rdb.err = errors.New("redis down")
+	if _, _, err := repo.IncrFollowActions(ctx, 7, now); !errors.Is(err, rdb.err) {
+		t.Errorf("error = %v, want the redis error", err)
+	}
+}
+
+func TestIsVerifiedUserQuery(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/social", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	var sql string
+	var vars []interface{}
+	var count int64
+	err = db.Callback().Query().After("gorm:query").Register("test:record", func(tx *gorm.DB) {
+		sql, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+		if dest, ok := tx.Statement.Dest.(*int64); ok {
+			*dest = count
+			tx.RowsAffected = 1
+		}
+	})
+	if err != nil {
+		t.Fatalf("register query callback: %v", err)
+	}
+	repo := &followRepository{db: db}
+
+	for _, tt := range []struct {
+		count int64
+		want  bool
+	}{{1, true}, {0, false}} {
+		count = tt.count
+		verified, err := repo.IsVerifiedUser(context.Background(), 7)
+		if err != nil || verified != tt.want {
+			t.Errorf("IsVerifiedUser with %d rows = (%v, %v), want %v", tt.count, verified, err, tt.want)
+		}
+	}
+	// 与model.User.IsVerifiedUser的判断一致
+	if !strings.Contains(sql, "id = ? AND deleted_at IS NULL AND (is_verified = ? OR user_type = ?)") {
+		t.Errorf("query = %s, want the verified user condition", sql)
+	}
+	if want := []interface{}{uint32(7), true, "verified"}; !reflect.DeepEqual(vars, want) {
+		t.Errorf("vars = %#v, want %#v", vars, want)
+	}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"social_service/internal/model"
+)
+
+// FollowRepository 关注关系数据访问接口
+type FollowRepository interface {
+	// ListFriendsOfFriends 获取用户关注的人所关注、而用户尚未关注的用户，按共同关注人数降序
+	ListFriendsOfFriends(ctx context.Context, userID uint32, limit int) ([]model.FollowRecommendation, error)
+
+	// 推荐缓存
+	GetRecommendationsFromCache(ctx context.Context, userID uint32) ([]model.FollowRecommendation, error)
+	SetRecommendationsCache(ctx context.Context, userID uint32, recs []model.FollowRecommendation, expiration time.Duration) error
+
+	// 关注限频
+	IsVerifiedUser(ctx context.Context, userID uint32) (bool, error)
+	IncrFollowActions(ctx context.Context, userID uint32, now time.Time) (perMinute, perDay int64, err error)
+}
+
+// followRepository 关注关系数据访问实现
+type followRepository struct {
+	db    *gorm.DB
+	redis redis.UniversalClient
+}
+
+// NewFollowRepository 创建关注关系数据访问对象
+func NewFollowRepository(db *gorm.DB, redis redis.UniversalClient) FollowRepository {
+	return &followRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ListFriendsOfFriends 获取二度关注推荐
+// 排除用户自己、已关注的用户和非正常状态的用户，共同关注人数相同时按用户ID升序，保证结果稳定
+func (r *followRepository) ListFriendsOfFriends(ctx context.Context, userID uint32, limit int) ([]model.FollowRecommendation, error) {
+	followTable := model.UserFollow{}.TableName()
+	userTable := model.User{}.TableName()
+
+	followed := r.db.Table(followTable).
+		Select("following_id").
+		Where("follower_id = ? AND deleted_at IS NULL", userID)
+
+	var recs []model.FollowRecommendation
+	err := r.db.WithContext(ctx).
+		Table(followTable+" AS f1").
+		Select("f2.following_id AS user_id, COUNT(DISTINCT f1.following_id) AS mutual_count").
+		Joins("JOIN "+followTable+" AS f2 ON f2.follower_id = f1.following_id AND f2.deleted_at IS NULL").
+		Joins("JOIN "+userTable+" AS u ON u.id = f2.following_id AND u.status = ? AND u.deleted_at IS NULL", model.UserStatusActive).
+		Where("f1.follower_id = ? AND f1.deleted_at IS NULL", userID).
+		Where("f2.following_id <> ?", userID).
+		Where("f2.following_id NOT IN (?)", followed).
+		Group("f2.following_id").
+		Order("mutual_count DESC, f2.following_id ASC").
+		Limit(limit).
+		Scan(&recs).Error
+	if err != nil {
+		return nil, err
+	}
+	return recs, nil
+}
+
+// GetRecommendationsFromCache 从缓存获取关注推荐
+func (r *followRepository) GetRecommendationsFromCache(ctx context.Context, userID uint32) ([]model.FollowRecommendation, error) {
+	cachedData, err := r.redis.Get(ctx, model.GetFollowRecommendCacheKey(userID)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, errors.New("cache not found")
+		}
+		return nil, err
+	}
+
+	var recs []model.FollowRecommendation
+	if err := json.Unmarshal(cachedData, &recs); err != nil {
+		return nil, errors.New("failed to parse cached data")
+	}
+	return recs, nil
+}
+
+// SetRecommendationsCache 设置关注推荐缓存
+func (r *followRepository) SetRecommendationsCache(ctx context.Context, userID uint32, recs []model.FollowRecommendation, expiration time.Duration) error {
+	cacheData, err := json.Marshal(recs)
+	if err != nil {
+		return errors.New("failed to serialize recommendations")
+	}
+
+	if err := r.redis.Set(ctx, model.GetFollowRecommendCacheKey(userID), cacheData, expiration).Err(); err != nil {
+		return errors.New("failed to set cache")
+	}
+	return nil
+}
+
+// incrFollowActionsScript 同时累加分钟和天两个窗口的关注次数，窗口内首次计数时设置过期时间
+var incrFollowActionsScript = redis.NewScript(`
+local minute = redis.call("INCR", KEYS[1])
+if minute == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+local day = redis.call("INCR", KEYS[2])
+if day == 1 then
+	redis.call("PEXPIRE", KEYS[2], ARGV[2])
+end
+return {minute, day}
+`)
+
+// IsVerifiedUser 用户是否为认证用户，与model.User.IsVerifiedUser的判断一致
+func (r *followRepository) IsVerifiedUser(ctx context.Context, userID uint32) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND deleted_at IS NULL AND (is_verified = ? OR user_type = ?)", userID, true, "verified").
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// IncrFollowActions 记录一次关注操作，返回当前分钟和当天的累计关注次数
+// 按自然分钟和自然日分窗口计数，窗口结束后计数自动过期
+func (r *followRepository) IncrFollowActions(ctx context.Context, userID uint32, now time.Time) (int64, int64, error) {
+	minuteKey := model.GetFollowRateKey(userID, "m:"+strconv.FormatInt(now.Unix()/60, 10))
+	dayKey := model.GetFollowRateKey(userID, "d:"+now.Format("20060102"))
+	counts, err := incrFollowActionsScript.Run(ctx, r.redis, []string{minuteKey, dayKey},
+		time.Minute.Milliseconds(), (24 * time.Hour).Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to incr follow actions: %w", err)
+	}
+	return counts[0], counts[1], nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"social_service/internal/model"
+)
+
+// newDryRunFollowRepository 只生成SQL不连接数据库的仓库，返回最后一次查询的SQL和参数
+func newDryRunFollowRepository(t *testing.T) (*followRepository, func() (string, []interface{})) {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/social", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	var sql string
+	var vars []interface{}
+	// Scan通过Row回调执行查询
+	err = db.Callback().Row().After("gorm:row").Register("test:record", func(tx *gorm.DB) {
+		sql, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+	})
+	if err != nil {
+		t.Fatalf("register row callback: %v", err)
+	}
+	return &followRepository{db: db}, func() (string, []interface{}) { return sql, vars }
+}
+
+func TestListFriendsOfFriendsQuery(t *testing.T) {
+	repo, last := newDryRunFollowRepository(t)
+
+	// DryRun模式下Scan不返回结果，只检查生成的SQL
+	if _, err := repo.ListFriendsOfFriends(context.Background(), 7, 50); !errors.Is(err, gorm.ErrDryRunModeUnsupported) {
+		t.Fatalf("ListFriendsOfFriends: %v", err)
+	}
+	sql, vars := last()
+	for _, want := range []string{
+		// 二度关注：用户关注的人(f1)所关注的人(f2)
+		"JOIN user_follows AS f2 ON f2.follower_id = f1.following_id AND f2.deleted_at IS NULL",
+		"u.status = ?",
+		"f1.follower_id = ? AND f1.deleted_at IS NULL",
+		// 排除自己和已关注的用户
+		"f2.following_id <> ?",
+		"f2.following_id NOT IN (SELECT following_id FROM `user_follows` WHERE follower_id = ? AND deleted_at IS NULL)",
+		"COUNT(DISTINCT f1.following_id) AS mutual_count",
+		"GROUP BY `f2`.`following_id` ORDER BY mutual_count DESC, f2.following_id ASC LIMIT ?",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("query missing %q:\n%s", want, sql)
+		}
+	}
+	want := []interface{}{model.UserStatusActive, uint32(7), uint32(7), uint32(7), 50}
+	if !reflect.DeepEqual(vars, want) {
+		t.Errorf("vars = %#v, want %#v", vars, want)
+	}
+}
+
+// stringRedis 内存中的字符串键值，只实现推荐缓存用到的Get和Set
+type stringRedis struct {
+	redis.UniversalClient
+	values map[string]string
+	ttls   map[string]time.Duration
+}
+
+func (c *stringRedis) Get(ctx context.Context, key string) *redis.StringCmd {
+	v, ok := c.values[key]
+	if !ok {
+		return redis.NewStringResult("", redis.Nil)
+	}
+	return redis.NewStringResult(v, nil)
+}
+
+func (c *stringRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
+	c.values[key] = string(value.([]byte))
+	c.ttls[key] = expiration
+	return redis.NewStatusResult("OK", nil)
+}
+
+func TestRecommendationsCacheRoundTrip(t *testing.T) {
+	rdb := &stringRedis{values: make(map[string]string), ttls: make(map[string]time.Duration)}
+	repo := &followRepository{redis: rdb}
+	ctx := context.Background()
+
+	if _, err := repo.GetRecommendationsFromCache(ctx, 7); err == nil {
+		t.Fatal("GetRecommendationsFromCache succeeded on an empty cache")
+	}
+
+	recs := []model.FollowRecommendation{{UserID: 3, MutualCount: 4}, {UserID: 9, MutualCount: 2}}
+	if err := repo.SetRecommendationsCache(ctx, 7, recs, model.FollowRecommendTTL); err != nil {
+		t.Fatalf("SetRecommendationsCache: %v", err)
+	}
+	if ttl := rdb.ttls[model.GetFollowRecommendCacheKey(7)]; ttl != model.FollowRecommendTTL {
+		t.Errorf("ttl = %v, want %v", ttl, model.FollowRecommendTTL)
+	}
+	got, err := repo.GetRecommendationsFromCache(ctx, 7)
+	if err != nil || !reflect.DeepEqual(got, recs) {
+		t.Errorf("GetRecommendationsFromCache = (%v, %v), want %v", got, err, recs)
+	}
+	// 缓存按用户隔离
+	if _, err := repo.GetRecommendationsFromCache(ctx, 8); err == nil {
+		t.Error("user 8 read user 7's recommendations")
+	}
+
+	// 没有推荐时也缓存空列表，避免重复查询
+	if err := repo.SetRecommendationsCache(ctx, 8, []model.FollowRecommendation{}, model.FollowRecommendTTL); err != nil {
+		t.Fatalf("SetRecommendationsCache: %v", err)
+	}
+	if got, err := repo.GetRecommendationsFromCache(ctx, 8); err != nil || len(got) != 0 {
+		t.Errorf("empty recommendations = (%v, %v), want a cache hit", got, err)
+	}
+
+	rdb.values[model.GetFollowRecommendCacheKey(9)] = "not json"
+	if _, err := repo.GetRecommendationsFromCache(ctx, 9); err == nil {
+		t.Error("GetRecommendationsFromCache accepted corrupt data")
+	}
+}
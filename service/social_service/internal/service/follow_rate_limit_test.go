@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"social_service/internal/config"
+)
+
+// rateLimitRepo 按用户计数的关注操作，测试在同一窗口内完成，分钟和天的计数相同
+type rateLimitRepo struct {
+	*fakeFollowRepo
+	verified    map[uint32]bool
+	verifiedErr error
+	incrErr     error
+	counts      map[uint32]int64
+	incrCalls   int
+}
+
+func newRateLimitRepo() *rateLimitRepo {
+	return &rateLimitRepo{
+		fakeFollowRepo: newFakeFollowRepo(0),
+		verified:       make(map[uint32]bool),
+		counts:         make(map[uint32]int64),
+	}
+}
+
+func (r *rateLimitRepo) IsVerifiedUser(ctx context.Context, userID uint32) (bool, error) {
+	if r.verifiedErr != nil {
+		return false, r.verifiedErr
+	}
+	return r.verified[userID], nil
+}
+
+func (r *rateLimitRepo) IncrFollowActions(ctx context.Context, userID uint32, now time.Time) (int64, int64, error) {
+	r.incrCalls++
+	if r.incrErr != nil {
+		return 0, 0, r.incrErr
+	}
+	r.counts[userID]++
+	return r.counts[userID], r.counts[userID], nil
+}
+
+func newRateLimitTestService(repo *rateLimitRepo, perMinute, perDay int) FollowService {
+	cfg := &config.Config{}
+	cfg.Follow.RateLimit = config.FollowRateLimitConfig{PerMinute: perMinute, PerDay: perDay}
+	return NewFollowService(cfg, nopLogger{}, repo)
+}
+
+// followUntilLimited 连续关注直到被限频，返回被允许的次数
+func followUntilLimited(t *testing.T, s FollowService, actorID uint32, max int) int {
+	t.Helper()
+	for i := 0; i < max; i++ {
+		err := s.CheckFollowRateLimit(context.Background(), actorID)
+		if errors.Is(err, ErrFollowRateLimited) {
+			return i
+		}
+		if err != nil {
+			t.Fatalf("follow %d: %v", i+1, err)
+		}
+	}
+	return max
+}
+
+func TestCheckFollowRateLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		perMinute int
+		perDay    int
+		want      int
+	}{
+		{"per minute", 3, 100, 3},
+		// 天窗口比分钟窗口先用完
+		{"per day", 10, 2, 2},
+		{"defaults", 0, 0, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newRateLimitRepo()
+			s := newRateLimitTestService(repo, tt.perMinute, tt.perDay)
+			if got := followUntilLimited(t, s, 7, 100); got != tt.want {
+				t.Errorf("allowed %d follows, want %d", got, tt.want)
+			}
+			// 其他用户的计数互不影响
+			if err := s.CheckFollowRateLimit(context.Background(), 8); err != nil {
+				t.Errorf("other user: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckFollowRateLimitCountsRejectedRetries(t *testing.T) {
+	repo := newRateLimitRepo()
+	s := newRateLimitTestService(repo, 2, 100)
+
+	followUntilLimited(t, s, 7, 10)
+	for i := 0; i < 3; i++ {
+		if err := s.CheckFollowRateLimit(context.Background(), 7); !errors.Is(err, ErrFollowRateLimited) {
+			t.Fatalf("retry %d error = %v, want ErrFollowRateLimited", i+1, err)
+		}
+	}
+	// 超限后的重试同样计入
+	if repo.incrCalls != 6 {
+		t.Errorf("counted %d follow actions, want 6", repo.incrCalls)
+	}
+}
+
+func TestCheckFollowRateLimitExemptsVerifiedUsers(t *testing.T) {
+	repo := newRateLimitRepo()
+	repo.verified[7] = true
+	s := newRateLimitTestService(repo, 1, 1)
+
+	if got := followUntilLimited(t, s, 7, 50); got != 50 {
+		t.Errorf("verified user allowed %d follows, want unlimited", got)
+	}
+	if repo.incrCalls != 0 {
+		t.Errorf("counted %d follow actions for a verified user, want 0", repo.incrCalls)
+	}
+}
+
+func TestCheckFollowRateLimitErrors(t *testing.T) {
+	t.Run("invalid user", func(t *testing.T) {
+		repo := newRateLimitRepo()
+		if err := newRateLimitTestService(repo, 1, 1).CheckFollowRateLimit(context.Background(), 0); err == nil || repo.incrCalls != 0 {
+			t.Errorf("error = %v with %d counts, want rejected before counting", err, repo.incrCalls)
+		}
+	})
+
+	t.Run("verified lookup error", func(t *testing.T) {
+		repo := newRateLimitRepo()
+		repo.verifiedErr = errors.New("connection refused")
+		err := newRateLimitTestService(repo, 1, 1).CheckFollowRateLimit(context.Background(), 7)
+		if err == nil || errors.Is(err, ErrFollowRateLimited) || repo.incrCalls != 0 {
+			t.Errorf("error = %v with %d counts, want a database error", err, repo.incrCalls)
+		}
+	})
+
+	t.Run("counter error", func(t *testing.T) {
+		repo := newRateLimitRepo()
+		repo.incrErr = errors.New("redis down")
+		if err := newRateLimitTestService(repo, 1, 1).CheckFollowRateLimit(context.Background(), 7); !errors.Is(err, repo.incrErr) {
+			t.Errorf("error = %v, want the counter error", err)
+		}
+	})
+}
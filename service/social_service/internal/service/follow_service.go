@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"social_service/internal/config"
+	"social_service/internal/model"
+	"social_service/internal/repository"
+	"social_service/pkg/logger"
+)
+
+// 关注推荐数量
+const (
+	defaultRecommendLimit = 20
+	maxRecommendLimit     = 100
+)
+
+// ErrFollowRateLimited 关注操作过于频繁
+var ErrFollowRateLimited = errors.New("关注操作过于频繁，请稍后再试")
+
+// FollowService 关注关系服务接口
+type FollowService interface {
+	// GetFollowRecommendations 获取"可能认识的人"推荐，limit<=0时使用默认数量
+	GetFollowRecommendations(ctx context.Context, userID uint32, limit int) ([]model.FollowRecommendation, error)
+	// CheckFollowRateLimit 关注前检查用户的关注频率，超出限制时返回ErrFollowRateLimited
+	CheckFollowRateLimit(ctx context.Context, actorID uint32) error
+}
+
+// followService 关注关系服务实现
+type followService struct {
+	config     *config.Config
+	logger     logger.Logger
+	followRepo repository.FollowRepository
+}
+
+// NewFollowService 创建关注关系服务
+func NewFollowService(cfg *config.Config, log logger.Logger, followRepo repository.FollowRepository) FollowService {
+	return &followService{
+		config:     cfg,
+		logger:     log,
+		followRepo: followRepo,
+	}
+}
+
+// GetFollowRecommendations 推荐用户关注的人所关注的用户，按共同关注人数排序
+// 结果按用户短暂缓存，缓存中保存最大数量的推荐，不同limit的请求共用同一份缓存
+func (s *followService) GetFollowRecommendations(ctx context.Context, userID uint32, limit int) ([]model.FollowRecommendation, error) {
+	if userID == 0 {
+		return nil, errors.New("invalid user id")
+	}
+	if limit <= 0 {
+		limit = defaultRecommendLimit
+	}
+	if limit > maxRecommendLimit {
+		limit = maxRecommendLimit
+	}
+
+	recs, err := s.followRepo.GetRecommendationsFromCache(ctx, userID)
+	if err != nil {
+		recs, err = s.followRepo.ListFriendsOfFriends(ctx, userID, maxRecommendLimit)
+		if err != nil {
+			s.logger.Error("Failed to list follow recommendations", "userID", userID, "error", err)
+			return nil, errors.New("database error")
+		}
+		if err := s.followRepo.SetRecommendationsCache(ctx, userID, recs, model.FollowRecommendTTL); err != nil {
+			s.logger.Warn("Failed to cache follow recommendations", "userID", userID, "error", err)
+		}
+	}
+
+	if len(recs) > limit {
+		recs = recs[:limit]
+	}
+	return recs, nil
+}
+
+// CheckFollowRateLimit 按用户限制每分钟和每天的关注次数，认证用户不受限制
+// 每次检查都计入一次关注操作，超限后的重试同样计数，持续刷量的用户需等窗口结束才能恢复
+func (s *followService) CheckFollowRateLimit(ctx context.Context, actorID uint32) error {
+	if actorID == 0 {
+		return errors.New("invalid user id")
+	}
+
+	verified, err := s.followRepo.IsVerifiedUser(ctx, actorID)
+	if err != nil {
+		s.logger.Error("Failed to check verified user", "userID", actorID, "error", err)
+		return errors.New("database error")
+	}
+	if verified {
+		return nil
+	}
+
+	cfg := s.config.Follow.RateLimit.WithDefaults()
+	perMinute, perDay, err := s.followRepo.IncrFollowActions(ctx, actorID, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to check follow rate limit", "userID", actorID, "error", err)
+		return err
+	}
+	if perMinute > int64(cfg.PerMinute) || perDay > int64(cfg.PerDay) {
+		s.logger.Warn("Follow rate limit exceeded", "userID", actorID, "perMinute", perMinute, "perDay", perDay)
+		return ErrFollowRateLimited
+	}
+	return nil
+}
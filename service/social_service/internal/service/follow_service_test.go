@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"social_service/internal/config"
+	"social_service/internal/model"
+	"social_service/internal/repository"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// fakeFollowRepo 内存中的推荐数据和推荐缓存
+type fakeFollowRepo struct {
+	repository.FollowRepository
+	recs     []model.FollowRecommendation
+	cache    map[uint32][]model.FollowRecommendation
+	listErr  error
+	cacheErr error
+	limits   []int // 每次查询数据库的limit
+}
+
+func newFakeFollowRepo(n int) *fakeFollowRepo {
+	repo := &fakeFollowRepo{cache: make(map[uint32][]model.FollowRecommendation)}
+	for i := 0; i < n; i++ {
+		repo.recs = append(repo.recs, model.FollowRecommendation{UserID: uint32(100 + i), MutualCount: uint32(n - i)})
+	}
+	return repo
+}
+
+func (r *fakeFollowRepo) ListFriendsOfFriends(ctx context.Context, userID uint32, limit int) ([]model.FollowRecommendation, error) {
+	r.limits = append(r.limits, limit)
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	if limit < len(r.recs) {
+		return append([]model.FollowRecommendation(nil), r.recs[:limit]...), nil
+	}
+	return append([]model.FollowRecommendation(nil), r.recs...), nil
+}
+
+func (r *fakeFollowRepo) GetRecommendationsFromCache(ctx context.Context, userID uint32) ([]model.FollowRecommendation, error) {
+	recs, ok := r.cache[userID]
+	if !ok {
+		return nil, errors.New("cache not found")
+	}
+	return recs, nil
+}
+
+func (r *fakeFollowRepo) SetRecommendationsCache(ctx context.Context, userID uint32, recs []model.FollowRecommendation, expiration time.Duration) error {
+	if r.cacheErr != nil {
+		return r.cacheErr
+	}
+	r.cache[userID] = recs
+	return nil
+}
+
+func newFollowTestService(repo *fakeFollowRepo) FollowService {
+	return NewFollowService(&config.Config{}, nopLogger{}, repo)
+}
+
+func TestGetFollowRecommendationsLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{"requested", 5, 5},
+		{"default", 0, defaultRecommendLimit},
+		{"negative", -1, defaultRecommendLimit},
+		{"capped", maxRecommendLimit + 50, maxRecommendLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeFollowRepo(maxRecommendLimit + 10)
+			recs, err := newFollowTestService(repo).GetFollowRecommendations(context.Background(), 7, tt.limit)
+			if err != nil {
+				t.Fatalf("GetFollowRecommendations: %v", err)
+			}
+			if len(recs) != tt.want {
+				t.Errorf("recommendations = %d, want %d", len(recs), tt.want)
+			}
+			// 按共同关注人数排序的顺序保持不变
+			if !reflect.DeepEqual(recs, repo.recs[:tt.want]) {
+				t.Errorf("recommendations reordered: %v", recs)
+			}
+		})
+	}
+}
+
+func TestGetFollowRecommendationsCachesFullList(t *testing.T) {
+	repo := newFakeFollowRepo(maxRecommendLimit + 10)
+	s := newFollowTestService(repo)
+	ctx := context.Background()
+
+	if _, err := s.GetFollowRecommendations(ctx, 7, 3); err != nil {
+		t.Fatalf("GetFollowRecommendations: %v", err)
+	}
+	// 缓存最大数量的推荐，不同limit的请求共用
+	if len(repo.limits) != 1 || repo.limits[0] != maxRecommendLimit || len(repo.cache[7]) != maxRecommendLimit {
+		t.Fatalf("queried limits %v, cached %d; want one query caching %d", repo.limits, len(repo.cache[7]), maxRecommendLimit)
+	}
+
+	recs, err := s.GetFollowRecommendations(ctx, 7, 10)
+	if err != nil {
+		t.Fatalf("cached GetFollowRecommendations: %v", err)
+	}
+	if len(recs) != 10 || len(repo.limits) != 1 {
+		t.Errorf("got %d recommendations with %d queries, want 10 from cache", len(recs), len(repo.limits))
+	}
+}
+
+func TestGetFollowRecommendationsFromCache(t *testing.T) {
+	repo := newFakeFollowRepo(5)
+	repo.cache[7] = []model.FollowRecommendation{{UserID: 42, MutualCount: 9}}
+
+	recs, err := newFollowTestService(repo).GetFollowRecommendations(context.Background(), 7, 0)
+	if err != nil {
+		t.Fatalf("GetFollowRecommendations: %v", err)
+	}
+	if !reflect.DeepEqual(recs, repo.cache[7]) || len(repo.limits) != 0 {
+		t.Errorf("recommendations = %v with %d queries, want the cached list", recs, len(repo.limits))
+	}
+
+	// 缓存的空列表同样命中，没有二度关注的用户不会反复查询
+	repo.cache[8] = []model.FollowRecommendation{}
+	if recs, err := newFollowTestService(repo).GetFollowRecommendations(context.Background(), 8, 0); err != nil || len(recs) != 0 || len(repo.limits) != 0 {
+		t.Errorf("GetFollowRecommendations = (%v, %v) with %d queries, want the cached empty list", recs, err, len(repo.limits))
+	}
+}
+
+func TestGetFollowRecommendationsErrors(t *testing.T) {
+	t.Run("invalid user", func(t *testing.T) {
+		repo := newFakeFollowRepo(5)
+		if _, err := newFollowTestService(repo).GetFollowRecommendations(context.Background(), 0, 10); err == nil || len(repo.limits) != 0 {
+			t.Errorf("error = %v with %d queries, want rejected before querying", err, len(repo.limits))
+		}
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		repo := newFakeFollowRepo(5)
+		repo.listErr = errors.New("connection refused")
+		if _, err := newFollowTestService(repo).GetFollowRecommendations(context.Background(), 7, 10); err == nil {
+			t.Error("GetFollowRecommendations succeeded although the query failed")
+		}
+		if _, ok := repo.cache[7]; ok {
+			t.Error("failed query was cached")
+		}
+	})
+
+	t.Run("cache write error", func(t *testing.T) {
+		repo := newFakeFollowRepo(5)
+		repo.cacheErr = errors.New("redis down")
+		// 写缓存失败不影响返回结果
+		recs, err := newFollowTestService(repo).GetFollowRecommendations(context.Background(), 7, 10)
+		if err != nil || len(recs) != 5 {
+			t.Errorf("GetFollowRecommendations = (%v, %v), want 5 recommendations", recs, err)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // Logger 日志接口
@@ -21,8 +22,21 @@ type Config struct {
 	Level      string `yaml:"level"`
 	Format     string `yaml:"format"`
 	OutputPath string `yaml:"output_path"`
+
+	// 日志轮转配置，仅在输出到文件时生效，未设置时使用默认值
+	MaxSize    int  `yaml:"max_size"`    // 单个文件最大大小(MB)
+	MaxAge     int  `yaml:"max_age"`     // 旧文件保留天数
+	MaxBackups int  `yaml:"max_backups"` // 旧文件最多保留个数
+	Compress   bool `yaml:"compress"`    // 是否压缩旧文件
 }
 
+// 日志轮转默认值
+const (
+	defaultMaxSize    = 100
+	defaultMaxAge     = 7
+	defaultMaxBackups = 10
+)
+
 // zapLogger zap日志实现
 type zapLogger struct {
 	logger *zap.Logger
@@ -57,14 +71,10 @@ func NewLogger(cfg Config) (Logger, error) {
 		encoder = zapcore.NewConsoleEncoder(encoderConfig)
 	}
 
-	// 设置输出
+	// 设置输出，输出到文件时按大小轮转，容器环境使用stdout
 	var writeSyncer zapcore.WriteSyncer
 	if cfg.OutputPath != "" && cfg.OutputPath != "stdout" {
-		file, err := os.OpenFile(cfg.OutputPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-		if err != nil {
-			return nil, err
-		}
-		writeSyncer = zapcore.AddSync(file)
+		writeSyncer = zapcore.AddSync(newRotateWriter(cfg))
 	} else {
 		writeSyncer = zapcore.AddSync(os.Stdout)
 	}
@@ -78,6 +88,31 @@ func NewLogger(cfg Config) (Logger, error) {
 	return &zapLogger{logger: logger}, nil
 }
 
+// newRotateWriter 创建按大小轮转的文件输出
+func newRotateWriter(cfg Config) *lumberjack.Logger {
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	maxAge := cfg.MaxAge
+	if maxAge <= 0 {
+		maxAge = defaultMaxAge
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = defaultMaxBackups
+	}
+
+	return &lumberjack.Logger{
+		Filename:   cfg.OutputPath,
+		MaxSize:    maxSize,
+		MaxAge:     maxAge,
+		MaxBackups: maxBackups,
+		LocalTime:  true,
+		Compress:   cfg.Compress,
+	}
+}
+
 // Debug 调试日志
 func (l *zapLogger) Debug(msg string, fields ...interface{}) {
 	l.logger.Debug(msg, l.convertFields(fields...)...)
@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"user_service/internal/model"
+	"user_service/pkg/logger"
+)
+
+// ErrCacheMiss 缓存不存在或已过期
+var ErrCacheMiss = errors.New("cache miss")
+
+// CacheService 用户服务使用的Redis缓存，包括频率限制、短信验证码和用户信息缓存
+type CacheService interface {
+	// CheckRateLimit 固定窗口频率限制，窗口内第limit+1次及之后的调用返回false
+	CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+	// GetSmsCode 获取手机号的短信验证码，不存在时返回ErrCacheMiss
+	GetSmsCode(ctx context.Context, phone string) (string, error)
+	// SetSmsCode 缓存手机号的短信验证码
+	SetSmsCode(ctx context.Context, phone, code string, ttl time.Duration) error
+	// DeleteSmsCode 删除已使用的短信验证码
+	DeleteSmsCode(ctx context.Context, phone string) error
+	// SetUser 缓存用户信息
+	SetUser(ctx context.Context, userID uint32, user *model.UserCache, ttl time.Duration) error
+}
+
+// rateLimitScript 计数加一，首次计数时设置窗口过期时间，返回窗口内的计数
+var rateLimitScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// cacheService 基于Redis的缓存服务实现
+type cacheService struct {
+	redis  redis.UniversalClient
+	logger logger.Logger
+}
+
+// NewCacheService 创建缓存服务
+func NewCacheService(redis redis.UniversalClient, log logger.Logger) CacheService {
+	return &cacheService{redis: redis, logger: log}
+}
+
+// CheckRateLimit 固定窗口频率限制
+func (c *cacheService) CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	count, err := rateLimitScript.Run(ctx, c.redis, []string{"rate_limit:" + key}, window.Milliseconds()).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	return count <= int64(limit), nil
+}
+
+// GetSmsCode 获取短信验证码
+func (c *cacheService) GetSmsCode(ctx context.Context, phone string) (string, error) {
+	code, err := c.redis.Get(ctx, model.GetSmsCodeCacheKey(phone)).Result()
+	if err == redis.Nil {
+		return "", ErrCacheMiss
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get sms code: %w", err)
+	}
+	return code, nil
+}
+
+// SetSmsCode 缓存短信验证码
+func (c *cacheService) SetSmsCode(ctx context.Context, phone, code string, ttl time.Duration) error {
+	if err := c.redis.Set(ctx, model.GetSmsCodeCacheKey(phone), code, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set sms code: %w", err)
+	}
+	return nil
+}
+
+// DeleteSmsCode 删除短信验证码
+func (c *cacheService) DeleteSmsCode(ctx context.Context, phone string) error {
+	if err := c.redis.Del(ctx, model.GetSmsCodeCacheKey(phone)).Err(); err != nil {
+		return fmt.Errorf("failed to delete sms code: %w", err)
+	}
+	return nil
+}
+
+// SetUser 缓存用户信息，与仓库层批量读取使用同一键和序列化格式
+func (c *cacheService) SetUser(ctx context.Context, userID uint32, user *model.UserCache, ttl time.Duration) error {
+	data, err := user.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize user cache: %w", err)
+	}
+	if err := c.redis.Set(ctx, model.GetUserCacheKey(userID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set user cache: %w", err)
+	}
+	return nil
+}
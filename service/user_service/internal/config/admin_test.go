@@ -0,0 +1,42 @@
+package config
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAdminConfigBanDuration(t *testing.T) {
+	cfg := AdminConfig{MaxBanDuration: 30 * 24 * time.Hour}
+	tests := []struct {
+		seconds int64
+		want    time.Duration
+		ok      bool
+	}{
+		{seconds: 0, want: 0, ok: true},
+		{seconds: -5, want: 0, ok: true},
+		{seconds: 3600, want: time.Hour, ok: true},
+		{seconds: 30 * 24 * 3600, want: 30 * 24 * time.Hour, ok: true},
+		{seconds: 30*24*3600 + 1, ok: false},
+		// 换算为time.Duration会溢出的秒数必须被拒绝，而不是变成负数或很短的封禁
+		{seconds: math.MaxInt64, ok: false},
+		{seconds: math.MaxInt64/int64(time.Second) + 1, ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := cfg.BanDuration(tt.seconds)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("BanDuration(%d) = %v, %v; want %v, %v", tt.seconds, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if _, ok := (AdminConfig{}).BanDuration(math.MaxInt64); ok {
+		t.Error("default limit accepted an overflowing duration")
+	}
+}
+
+func TestAdminConfigIsAdmin(t *testing.T) {
+	cfg := AdminConfig{UserIDs: []uint32{1, 42}}
+	if !cfg.IsAdmin(42) || cfg.IsAdmin(7) || (AdminConfig{}).IsAdmin(0) {
+		t.Fatal("IsAdmin did not match configured admin ids")
+	}
+}
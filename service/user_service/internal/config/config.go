@@ -16,9 +16,17 @@ type Config struct {
 	Redis    RedisConfig    `mapstructure:"redis"`
 	Logger   LoggerConfig   `mapstructure:"logger"`
 	Etcd     EtcdConfig     `mapstructure:"etcd"`
+	Health   HealthConfig   `mapstructure:"health"`
 	Consul   ConsulConfig   `mapstructure:"consul"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	SMS      SMSConfig      `mapstructure:"sms"`
+	Login    LoginConfig    `mapstructure:"login"`
+	Storage  StorageConfig  `mapstructure:"storage"`
+
+	ConnectRetry ConnectRetryConfig `mapstructure:"connect_retry"`
+	SearchIndex  SearchIndexConfig  `mapstructure:"search_index"`
+	CacheWarm    CacheWarmConfig    `mapstructure:"cache_warm"`
+	Admin        AdminConfig        `mapstructure:"admin"`
 }
 
 // ServerConfig 服务器配置
@@ -28,21 +36,45 @@ type ServerConfig struct {
 	Mode         string        `mapstructure:"mode"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// EnableReflection 是否注册gRPC反射服务，未配置时仅debug模式开启
+	EnableReflection *bool `mapstructure:"enable_reflection"`
+	// TLS gRPC服务端TLS配置，未开启时使用明文连接
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// ReflectionEnabled 是否注册gRPC反射服务
+// 反射会暴露完整的接口定义，release模式下需显式配置enable_reflection: true才会开启
+func (c ServerConfig) ReflectionEnabled() bool {
+	if c.EnableReflection != nil {
+		return *c.EnableReflection
+	}
+	return c.Mode == "debug"
+}
+
+// TLSConfig gRPC服务端TLS配置，未开启时使用明文连接，仅适用于本地开发，跨主机部署必须开启
+type TLSConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	CertFile     string `mapstructure:"cert_file"`      // 服务端证书
+	KeyFile      string `mapstructure:"key_file"`       // 服务端私钥
+	ClientAuth   bool   `mapstructure:"client_auth"`    // 要求并校验客户端证书(mTLS)
+	ClientCAFile string `mapstructure:"client_ca_file"` // 校验客户端证书的CA，开启ClientAuth时必填
 }
 
 // DatabaseConfig 数据库配置
 type DatabaseConfig struct {
-	Host            string `mapstructure:"host"`
-	Port            int    `mapstructure:"port"`
-	Username        string `mapstructure:"username"`
-	Password        string `mapstructure:"password"`
-	Database        string `mapstructure:"database"`
-	Charset         string `mapstructure:"charset"`
-	TablePrefix     string `mapstructure:"table_prefix"`
-	LogLevel        string `mapstructure:"log_level"`
-	MaxIdleConns    int    `mapstructure:"max_idle_conns"`
-	MaxOpenConns    int    `mapstructure:"max_open_conns"`
-	ConnMaxLifetime int    `mapstructure:"conn_max_lifetime"`
+	Host             string `mapstructure:"host"`
+	Port             int    `mapstructure:"port"`
+	Username         string `mapstructure:"username"`
+	Password         string `mapstructure:"password"`
+	Database         string `mapstructure:"database"`
+	Charset          string `mapstructure:"charset"`
+	TablePrefix      string `mapstructure:"table_prefix"`
+	LogLevel         string `mapstructure:"log_level"`
+	MaxIdleConns     int    `mapstructure:"max_idle_conns"`
+	MaxOpenConns     int    `mapstructure:"max_open_conns"`
+	ConnMaxLifetime  int    `mapstructure:"conn_max_lifetime"`
+	AutoMigrate      bool   `mapstructure:"auto_migrate"`      // 启动时自动迁移表结构
+	AllowDestructive bool   `mapstructure:"allow_destructive"` // 迁移时允许删除模型中已移除的列
 }
 
 // RedisConfig Redis配置
@@ -55,6 +87,13 @@ type RedisConfig struct {
 	DialTimeout  int    `mapstructure:"dial_timeout"`
 	ReadTimeout  int    `mapstructure:"read_timeout"`
 	WriteTimeout int    `mapstructure:"write_timeout"`
+
+	// 部署模式，single(默认)、sentinel或cluster
+	Mode             string   `mapstructure:"mode"`
+	MasterName       string   `mapstructure:"master_name"`       // sentinel模式的主节点名称
+	SentinelAddrs    []string `mapstructure:"sentinel_addrs"`    // sentinel模式的哨兵地址
+	SentinelPassword string   `mapstructure:"sentinel_password"` // 哨兵的认证密码，为空时不认证
+	ClusterNodes     []string `mapstructure:"cluster_nodes"`     // cluster模式的种子节点地址
 }
 
 // LoggerConfig 日志配置
@@ -62,6 +101,17 @@ type LoggerConfig struct {
 	Level      string `mapstructure:"level"`
 	Format     string `mapstructure:"format"`
 	OutputPath string `mapstructure:"output_path"`
+	MaxSize    int    `mapstructure:"max_size"`    // 单个日志文件最大大小(MB)
+	MaxAge     int    `mapstructure:"max_age"`     // 旧日志保留天数
+	MaxBackups int    `mapstructure:"max_backups"` // 旧日志最多保留个数
+	Compress   bool   `mapstructure:"compress"`    // 是否压缩旧日志
+}
+
+// HealthConfig 健康检查配置
+type HealthConfig struct {
+	HTTPPort      int           `mapstructure:"http_port"`      // HTTP探针端口，0表示不启动
+	CheckInterval time.Duration `mapstructure:"check_interval"` // 依赖检查间隔
+	CheckTimeout  time.Duration `mapstructure:"check_timeout"`  // 单个依赖检查超时
 }
 
 // EtcdConfig etcd配置
@@ -72,6 +122,13 @@ type EtcdConfig struct {
 	Password    string   `mapstructure:"password"`
 }
 
+// ConnectRetryConfig 启动时连接MySQL、Redis、etcd的重试配置
+type ConnectRetryConfig struct {
+	Attempts    int           `mapstructure:"attempts"`     // 最大尝试次数(含首次)，<=1时不重试
+	Interval    time.Duration `mapstructure:"interval"`     // 首次重试间隔，之后按指数翻倍
+	MaxInterval time.Duration `mapstructure:"max_interval"` // 重试间隔上限
+}
+
 // ConsulConfig Consul配置
 type ConsulConfig struct {
 	Host      string `mapstructure:"host"`
@@ -85,6 +142,18 @@ type JWTConfig struct {
 	RefreshSecret     string        `mapstructure:"refresh_secret"`
 	TokenExpiration   time.Duration `mapstructure:"token_expiration"`
 	RefreshExpiration time.Duration `mapstructure:"refresh_expiration"`
+	// KeyID 当前密钥的kid，写入签发token的header，为空时不写入
+	KeyID string `mapstructure:"key_id"`
+	// PreviousKeys 轮换下来的旧密钥，只用于校验宽限期内的旧token，不再用于签发
+	PreviousKeys []JWTKeyConfig `mapstructure:"previous_keys"`
+}
+
+// JWTKeyConfig 轮换下来的JWT密钥
+type JWTKeyConfig struct {
+	KeyID         string `mapstructure:"key_id"`         // 旧token header中的kid，轮换前未配置kid的密钥填空
+	Secret        string `mapstructure:"secret"`         // 访问token密钥
+	RefreshSecret string `mapstructure:"refresh_secret"` // 刷新token密钥，为空时与secret相同
+	ExpiresAt     string `mapstructure:"expires_at"`     // 宽限期截止时间(RFC3339)，之后不再接受该密钥签发的token
 }
 
 // SMSConfig 短信服务配置
@@ -93,6 +162,162 @@ type SMSConfig struct {
 	SecretKey    string `mapstructure:"secret_key"`
 	SignName     string `mapstructure:"sign_name"`
 	TemplateCode string `mapstructure:"template_code"`
+
+	CodeLength int           `mapstructure:"code_length"` // 验证码位数
+	CodeTTL    time.Duration `mapstructure:"code_ttl"`    // 验证码有效期
+
+	Abuse SmsAbuseConfig `mapstructure:"abuse"`
+}
+
+// 短信验证码默认配置
+const (
+	defaultSmsCodeLength = 6
+	maxSmsCodeLength     = 10
+	defaultSmsCodeTTL    = 5 * time.Minute
+)
+
+// WithDefaults 验证码位数和有效期未配置时使用默认值，位数超过上限时按上限处理
+func (c SMSConfig) WithDefaults() SMSConfig {
+	if c.CodeLength <= 0 {
+		c.CodeLength = defaultSmsCodeLength
+	}
+	if c.CodeLength > maxSmsCodeLength {
+		c.CodeLength = maxSmsCodeLength
+	}
+	if c.CodeTTL <= 0 {
+		c.CodeTTL = defaultSmsCodeTTL
+	}
+	return c
+}
+
+// SmsAbuseConfig 短信防刷配置，按客户端IP限制发送频率并识别同一IP轮换手机号的刷量行为
+type SmsAbuseConfig struct {
+	IPLimit        int           `mapstructure:"ip_limit"`          // 单个IP在ip_window内最多发送次数
+	IPWindow       time.Duration `mapstructure:"ip_window"`         // IP发送频率统计窗口
+	MaxPhonesPerIP int           `mapstructure:"max_phones_per_ip"` // 单个IP在phone_window内最多使用的不同手机号数，超出后封禁该IP
+	PhoneWindow    time.Duration `mapstructure:"phone_window"`      // 不同手机号统计窗口
+	BlockDuration  time.Duration `mapstructure:"block_duration"`    // IP封禁时长
+}
+
+// 短信防刷默认配置
+const (
+	defaultSmsIPLimit        = 10
+	defaultSmsIPWindow       = 10 * time.Minute
+	defaultSmsMaxPhonesPerIP = 5
+	defaultSmsPhoneWindow    = time.Hour
+	defaultSmsBlockDuration  = time.Hour
+)
+
+// WithDefaults 未配置的项使用默认值
+func (c SmsAbuseConfig) WithDefaults() SmsAbuseConfig {
+	if c.IPLimit <= 0 {
+		c.IPLimit = defaultSmsIPLimit
+	}
+	if c.IPWindow <= 0 {
+		c.IPWindow = defaultSmsIPWindow
+	}
+	if c.MaxPhonesPerIP <= 0 {
+		c.MaxPhonesPerIP = defaultSmsMaxPhonesPerIP
+	}
+	if c.PhoneWindow <= 0 {
+		c.PhoneWindow = defaultSmsPhoneWindow
+	}
+	if c.BlockDuration <= 0 {
+		c.BlockDuration = defaultSmsBlockDuration
+	}
+	return c
+}
+
+// LoginConfig 登录策略配置
+type LoginConfig struct {
+	// MultiLoginUserTypes 允许多端同时登录的用户类型，其余类型登录时会踢掉其他设备
+	MultiLoginUserTypes []string `mapstructure:"multi_login_user_types"`
+}
+
+// StorageConfig 图片存储配置
+type StorageConfig struct {
+	Driver       string             `mapstructure:"driver"`         // 存储后端: local, s3
+	MaxImageSize int64              `mapstructure:"max_image_size"` // 单张图片大小上限(字节)
+	Local        LocalStorageConfig `mapstructure:"local"`
+	S3           S3Config           `mapstructure:"s3"`
+}
+
+// LocalStorageConfig 本地存储配置
+type LocalStorageConfig struct {
+	BaseDir string `mapstructure:"base_dir"` // 文件保存目录
+	BaseURL string `mapstructure:"base_url"` // 对外访问的URL前缀
+}
+
+// S3Config S3兼容对象存储配置
+type S3Config struct {
+	Endpoint  string `mapstructure:"endpoint"` // 为空时使用AWS默认域名，MinIO等需填写
+	Region    string `mapstructure:"region"`
+	Bucket    string `mapstructure:"bucket"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	PublicURL string `mapstructure:"public_url"` // 对外访问的URL前缀(如CDN)，为空时使用bucket地址
+}
+
+// AllowMultiLogin 判断该用户类型是否允许多端同时登录
+func (c LoginConfig) AllowMultiLogin(userType string) bool {
+	for _, t := range c.MultiLoginUserTypes {
+		if t == userType {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchIndexConfig 搜索索引事件配置
+type SearchIndexConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`     // 是否在内容变更后发布索引事件
+	QueueKey   string `mapstructure:"queue_key"`   // 索引事件队列，需与搜索服务一致
+	BufferSize int    `mapstructure:"buffer_size"` // 内存队列长度，队列满时丢弃新事件
+}
+
+// CacheWarmConfig 启动时预热热点用户缓存，避免发布后缓存为空导致数据库压力突增
+type CacheWarmConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	UserIDs      []uint32      `mapstructure:"user_ids"`      // 固定预热的用户，如头部主播
+	TopFollowers int           `mapstructure:"top_followers"` // 另外预热粉丝数最多的前N个用户，0表示不按粉丝数选取
+	Timeout      time.Duration `mapstructure:"timeout"`       // 预热总超时，0表示不限制
+}
+
+// AdminConfig 用户管理接口配置
+type AdminConfig struct {
+	// UserIDs 平台管理员，只有这些用户的访问token可以调用封禁、解封和用户查询接口
+	UserIDs []uint32 `mapstructure:"user_ids"`
+	// MaxBanDuration 单次封禁的最长时长，超过时需改用永久封禁，为0时使用defaultMaxBanDuration
+	MaxBanDuration time.Duration `mapstructure:"max_ban_duration"`
+}
+
+// defaultMaxBanDuration 未配置max_ban_duration时单次封禁的最长时长
+const defaultMaxBanDuration = 10 * 365 * 24 * time.Hour
+
+// IsAdmin 判断用户是否为平台管理员
+func (c AdminConfig) IsAdmin(userID uint32) bool {
+	for _, id := range c.UserIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// BanDuration 将请求中的封禁秒数转换为时长，<=0表示永久封禁返回0
+// 超过封禁时长上限时返回false，先按秒比较，避免换算成time.Duration时溢出
+func (c AdminConfig) BanDuration(seconds int64) (time.Duration, bool) {
+	if seconds <= 0 {
+		return 0, true
+	}
+	maxBan := c.MaxBanDuration
+	if maxBan <= 0 {
+		maxBan = defaultMaxBanDuration
+	}
+	if seconds > int64(maxBan/time.Second) {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
 }
 
 // LoadConfig 加载配置
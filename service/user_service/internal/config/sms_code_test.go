@@ -0,0 +1,46 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestSMSConfigWithDefaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        SMSConfig
+		wantLength int
+		wantTTL    time.Duration
+	}{
+		{"unset", SMSConfig{}, 6, 5 * time.Minute},
+		{"configured", SMSConfig{CodeLength: 4, CodeTTL: 2 * time.Minute}, 4, 2 * time.Minute},
+		{"length over max", SMSConfig{CodeLength: 20, CodeTTL: time.Minute}, 10, time.Minute},
+		{"negative values", SMSConfig{CodeLength: -1, CodeTTL: -time.Minute}, 6, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cfg.WithDefaults()
+			if got.CodeLength != tt.wantLength || got.CodeTTL != tt.wantTTL {
+				t.Errorf("WithDefaults() = %d digits / %v, want %d digits / %v", got.CodeLength, got.CodeTTL, tt.wantLength, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestSMSConfigFromYAML(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader("sms:\n  code_length: 4\n  code_ttl: 2m\n")); err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("unmarshal config: %v", err)
+	}
+	if cfg.SMS.CodeLength != 4 || cfg.SMS.CodeTTL != 2*time.Minute {
+		t.Errorf("sms config = %d digits / %v, want 4 digits / 2m", cfg.SMS.CodeLength, cfg.SMS.CodeTTL)
+	}
+}
@@ -89,6 +89,45 @@ func (c *UserConverter) ModelListToProtoListWithFollow(users []*model.User, isFo
 	return protoUsers
 }
 
+// userStatusNames 用户状态对应的字符串
+var userStatusNames = map[uint8]string{
+	model.UserStatusDisabled: "disabled",
+	model.UserStatusActive:   "active",
+	model.UserStatusBanned:   "banned",
+}
+
+// UserStatusToString 用户状态转换为字符串，未知状态返回空字符串
+func UserStatusToString(status uint8) string {
+	return userStatusNames[status]
+}
+
+// UserStatusFromString 字符串转换为用户状态，未知状态返回false
+func UserStatusFromString(status string) (uint8, bool) {
+	for s, name := range userStatusNames {
+		if name == status {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// ModelToAdminProto 将数据库模型User转换为管理后台使用的protobuf AdminUser
+func (c *UserConverter) ModelToAdminProto(user *model.User) *proto_gen.AdminUser {
+	if user == nil {
+		return nil
+	}
+
+	adminUser := &proto_gen.AdminUser{
+		User:   c.ModelToProto(user),
+		Status: UserStatusToString(user.Status),
+	}
+	if user.Status == model.UserStatusBanned {
+		adminUser.BanReason = user.BanReason
+		adminUser.BannedUntil = c.getTimestampPtr(user.BannedUntil)
+	}
+	return adminUser
+}
+
 // maskPhone 手机号脱敏处理
 func (c *UserConverter) maskPhone(phone string) string {
 	if phone != "" && len(phone) >= 11 {
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"user_service/internal/config"
+	"user_service/internal/converter"
+	"user_service/internal/model"
+	"user_service/internal/repository"
+	"user_service/internal/service"
+	"user_service/proto/proto_gen"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// stubUserService 按token映射用户ID，并记录管理接口的调用
+type stubUserService struct {
+	service.UserService
+	tokens map[string]uint32
+	banned map[uint32]time.Duration
+	listed int
+	unbans int
+}
+
+func (s *stubUserService) VerifyToken(ctx context.Context, token string) (uint32, error) {
+	if id, ok := s.tokens[token]; ok {
+		return id, nil
+	}
+	return 0, errors.New("token verification failed")
+}
+
+func (s *stubUserService) BanUser(ctx context.Context, userID uint32, reason string, duration time.Duration) (int, error) {
+	s.banned[userID] = duration
+	return 1, nil
+}
+
+func (s *stubUserService) UnbanUser(ctx context.Context, userID uint32) error {
+	s.unbans++
+	return nil
+}
+
+func (s *stubUserService) ListUsers(ctx context.Context, filter repository.UserFilter, page, pageSize int) ([]*model.User, int64, error) {
+	s.listed++
+	return nil, 0, nil
+}
+
+const testAdminID = 1
+
+func newAdminTestHandler() (*UserServiceHandler, *stubUserService) {
+	svc := &stubUserService{
+		tokens: map[string]uint32{"admin-token": testAdminID, "user-token": 9},
+		banned: map[uint32]time.Duration{},
+	}
+	cfg := &config.Config{Admin: config.AdminConfig{UserIDs: []uint32{testAdminID}, MaxBanDuration: 24 * time.Hour}}
+	return &UserServiceHandler{config: cfg, logger: nopLogger{}, userService: svc, converter: converter.NewUserConverter()}, svc
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, "Bearer "+token))
+}
+
+func TestAdminRPCsRequireAdminToken(t *testing.T) {
+	h, svc := newAdminTestHandler()
+	ctxs := map[string]struct {
+		ctx  context.Context
+		code int32
+	}{
+		"no token":  {context.Background(), 401},
+		"bad token": {withToken("forged"), 401},
+		"non-admin": {withToken("user-token"), 403},
+	}
+	for name, c := range ctxs {
+		ban, _ := h.BanUser(c.ctx, &proto_gen.BanUserRequest{UserId: 5, DurationSeconds: 60})
+		unban, _ := h.UnbanUser(c.ctx, &proto_gen.UnbanUserRequest{UserId: 5})
+		list, _ := h.ListUsers(c.ctx, &proto_gen.ListUsersRequest{})
+		if ban.StatusCode != c.code || unban.StatusCode != c.code || list.StatusCode != c.code {
+			t.Errorf("%s: codes = %d/%d/%d, want %d", name, ban.StatusCode, unban.StatusCode, list.StatusCode, c.code)
+		}
+	}
+	if len(svc.banned) != 0 || svc.unbans != 0 || svc.listed != 0 {
+		t.Fatalf("service called without admin permission: %+v", svc)
+	}
+
+	ctx := withToken("admin-token")
+	if resp, _ := h.UnbanUser(ctx, &proto_gen.UnbanUserRequest{UserId: 5}); resp.StatusCode != 0 {
+		t.Errorf("admin UnbanUser code = %d", resp.StatusCode)
+	}
+	if resp, _ := h.ListUsers(ctx, &proto_gen.ListUsersRequest{}); resp.StatusCode != 0 {
+		t.Errorf("admin ListUsers code = %d", resp.StatusCode)
+	}
+}
+
+func TestBanUserBoundsDuration(t *testing.T) {
+	h, svc := newAdminTestHandler()
+	ctx := withToken("admin-token")
+
+	// 超出上限的秒数换算为time.Duration会溢出，必须拒绝而不是变成很短或永久的封禁
+	for _, seconds := range []int64{24*3600 + 1, 1 << 62} {
+		resp, _ := h.BanUser(ctx, &proto_gen.BanUserRequest{UserId: 5, DurationSeconds: seconds})
+		if resp.StatusCode != 400 {
+			t.Errorf("duration %d: code = %d, want 400", seconds, resp.StatusCode)
+		}
+	}
+	if len(svc.banned) != 0 {
+		t.Fatalf("out of range ban reached the service: %v", svc.banned)
+	}
+
+	resp, _ := h.BanUser(ctx, &proto_gen.BanUserRequest{UserId: 5, DurationSeconds: 3600})
+	if resp.StatusCode != 0 || svc.banned[5] != time.Hour {
+		t.Fatalf("BanUser = %d, duration %v; want success with 1h", resp.StatusCode, svc.banned[5])
+	}
+	if resp.BannedUntil <= time.Now().Unix() {
+		t.Errorf("BannedUntil = %d, want a future timestamp", resp.BannedUntil)
+	}
+}
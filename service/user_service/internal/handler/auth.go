@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizationMetadataKey 访问令牌所在的gRPC元数据，值为"Bearer <token>"
+const authorizationMetadataKey = "authorization"
+
+var (
+	// errUnauthenticated 请求未携带有效的访问令牌
+	errUnauthenticated = errors.New("missing or invalid access token")
+	// errNotAdmin 调用用户不是平台管理员
+	errNotAdmin = errors.New("admin permission required")
+)
+
+// authenticatedUserID 从请求元数据的访问令牌中解析调用用户ID
+// 令牌经VerifyToken校验签名、有效期和黑名单，已注销或被封禁用户的令牌不能通过
+func (h *UserServiceHandler) authenticatedUserID(ctx context.Context) (uint32, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, errUnauthenticated
+	}
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		return 0, errUnauthenticated
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+	if token == "" {
+		return 0, errUnauthenticated
+	}
+
+	userID, err := h.userService.VerifyToken(ctx, token)
+	if err != nil || userID == 0 {
+		return 0, errUnauthenticated
+	}
+	return userID, nil
+}
+
+// authorizeAdmin 校验调用用户为平台管理员，返回管理员用户ID
+// 返回的状态码用于填充响应：未登录401，非管理员403
+func (h *UserServiceHandler) authorizeAdmin(ctx context.Context) (uint32, int32, error) {
+	userID, err := h.authenticatedUserID(ctx)
+	if err != nil {
+		return 0, 401, err
+	}
+	if !h.config.Admin.IsAdmin(userID) {
+		return userID, 403, errNotAdmin
+	}
+	return userID, 0, nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"user_service/internal/config"
+	"user_service/internal/service"
+)
+
+// stubWarmService 记录预热的用户
+type stubWarmService struct {
+	service.UserService
+	hot      []uint32
+	hotErr   error
+	hotLimit int
+	warmed   [][]uint32
+	deadline bool // 预热时ctx是否带有截止时间
+}
+
+func (s *stubWarmService) GetHotUserIDs(ctx context.Context, limit int) ([]uint32, error) {
+	s.hotLimit = limit
+	return s.hot, s.hotErr
+}
+
+func (s *stubWarmService) WarmUserCache(ctx context.Context, userIDs []uint32) (int, error) {
+	_, s.deadline = ctx.Deadline()
+	s.warmed = append(s.warmed, append([]uint32(nil), userIDs...))
+	return len(userIDs), nil
+}
+
+func newWarmTestHandler(cfg config.CacheWarmConfig, svc service.UserService) *UserServiceHandler {
+	return &UserServiceHandler{config: &config.Config{CacheWarm: cfg}, logger: nopLogger{}, userService: svc}
+}
+
+func TestWarmUserCacheMergesFixedAndHotUsers(t *testing.T) {
+	svc := &stubWarmService{hot: []uint32{3, 8, 1}}
+	h := newWarmTestHandler(config.CacheWarmConfig{Enabled: true, UserIDs: []uint32{1, 2, 3}, TopFollowers: 3, Timeout: time.Minute}, svc)
+
+	h.WarmUserCache(context.Background())
+
+	if svc.hotLimit != 3 {
+		t.Errorf("hot user limit = %d, want 3", svc.hotLimit)
+	}
+	// 去重后保持固定列表在前
+	if want := [][]uint32{{1, 2, 3, 8}}; !reflect.DeepEqual(svc.warmed, want) {
+		t.Errorf("warmed = %v, want %v", svc.warmed, want)
+	}
+	if !svc.deadline {
+		t.Error("warming ran without the configured timeout")
+	}
+	// 不修改配置中的固定列表
+	if got := h.config.CacheWarm.UserIDs; !reflect.DeepEqual(got, []uint32{1, 2, 3}) {
+		t.Errorf("configured user ids = %v, want unchanged", got)
+	}
+}
+
+func TestWarmUserCacheHotUsersError(t *testing.T) {
+	svc := &stubWarmService{hotErr: errors.New("database error")}
+	h := newWarmTestHandler(config.CacheWarmConfig{Enabled: true, UserIDs: []uint32{1}, TopFollowers: 10}, svc)
+
+	h.WarmUserCache(context.Background())
+
+	// 热门用户查询失败时仍预热固定列表
+	if want := [][]uint32{{1}}; !reflect.DeepEqual(svc.warmed, want) {
+		t.Errorf("warmed = %v, want %v", svc.warmed, want)
+	}
+	if svc.deadline {
+		t.Error("warming had a deadline although no timeout is configured")
+	}
+}
+
+func TestWarmUserCacheSkipped(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.CacheWarmConfig
+	}{
+		{"disabled", config.CacheWarmConfig{UserIDs: []uint32{1}, TopFollowers: 10}},
+		{"no users", config.CacheWarmConfig{Enabled: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &stubWarmService{hot: []uint32{5}}
+			newWarmTestHandler(tt.cfg, svc).WarmUserCache(context.Background())
+			if len(svc.warmed) != 0 || svc.hotLimit != 0 {
+				t.Errorf("warmed = %v with hot limit %d, want nothing", svc.warmed, svc.hotLimit)
+			}
+		})
+	}
+}
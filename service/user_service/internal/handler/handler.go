@@ -2,7 +2,10 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"net"
 	"strings"
+	"time"
 	"user_service/proto/proto_gen"
 
 	"user_service/internal/cache"
@@ -10,12 +13,19 @@ import (
 	"user_service/internal/converter"
 	"user_service/internal/repository"
 	"user_service/internal/service"
+	"user_service/internal/storage"
 	"user_service/pkg/logger"
+	"user_service/pkg/searchindex"
 
 	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"gorm.io/gorm"
 )
 
+// clientIPMetadataKey 网关透传客户端IP使用的gRPC元数据键
+const clientIPMetadataKey = "x-client-ip"
+
 // UserServiceHandler 用户服务处理器
 type UserServiceHandler struct {
 	proto_gen.UnimplementedUserServiceServer
@@ -23,20 +33,25 @@ type UserServiceHandler struct {
 	logger      logger.Logger
 	userService service.UserService
 	converter   *converter.UserConverter
+	indexer     *searchindex.Publisher
 }
 
 // NewUserServiceHandler 创建用户服务处理器
-func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis *redis.Client) *UserServiceHandler {
-	// 创建认证服务
+func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, redis redis.UniversalClient) *UserServiceHandler {
+	// 创建用户仓库
+	userRepo := repository.NewUserRepository(db, redis)
+
+	// 创建认证服务，token黑名单保存在用户仓库的Redis中
 	refreshSecret := cfg.JWT.RefreshSecret
 	if refreshSecret == "" {
 		refreshSecret = cfg.JWT.Secret // 如果没有配置refresh_secret，使用secret作为替代
 	}
 	authService := service.NewAuthService(
-		cfg.JWT.Secret,
-		refreshSecret,
+		service.SigningKey{ID: cfg.JWT.KeyID, Secret: cfg.JWT.Secret, RefreshSecret: refreshSecret},
+		previousSigningKeys(cfg.JWT.PreviousKeys, log),
 		cfg.JWT.TokenExpiration,
 		cfg.JWT.RefreshExpiration,
+		userRepo,
 	)
 
 	// 创建短信服务
@@ -45,31 +60,121 @@ func NewUserServiceHandler(cfg *config.Config, log logger.Logger, db *gorm.DB, r
 		cfg.SMS.SecretKey,
 		cfg.SMS.SignName,
 		cfg.SMS.TemplateCode,
+		cfg.SMS.WithDefaults().CodeLength,
 	)
 
-	// 创建用户仓库
-	userRepo := repository.NewUserRepository(db, redis)
-
 	// 创建缓存服务
 	cacheService := cache.NewCacheService(redis, log)
 
+	// 创建图片存储
+	imageStorage, err := storage.NewStorage(cfg.Storage)
+	if err != nil {
+		log.Error("Failed to create image storage, fallback to local storage", "error", err)
+		imageStorage = storage.NewLocalStorage(cfg.Storage.Local.BaseDir, cfg.Storage.Local.BaseURL, cfg.Storage.MaxImageSize)
+	}
+
+	// 创建搜索索引事件发布器，事件经Redis队列投递给search_service
+	var indexer *searchindex.Publisher
+	if cfg.SearchIndex.Enabled {
+		indexer = searchindex.NewPublisher(searchindex.NewRedisSink(redis, cfg.SearchIndex.QueueKey), cfg.SearchIndex.BufferSize, log)
+		indexer.Start()
+	}
+
 	// 创建用户服务
-	userService := service.NewUserService(cfg, log, userRepo, cacheService, authService, smsService)
+	userService := service.NewUserService(cfg, log, userRepo, cacheService, authService, smsService, imageStorage, indexer)
 
 	return &UserServiceHandler{
 		config:      cfg,
 		logger:      log,
 		userService: userService,
 		converter:   converter.NewUserConverter(),
+		indexer:     indexer,
 	}
 }
 
+// previousSigningKeys 转换轮换下来的旧JWT密钥，expires_at无法解析或已过期的密钥不再使用
+func previousSigningKeys(keys []config.JWTKeyConfig, log logger.Logger) []service.SigningKey {
+	now := time.Now()
+	result := make([]service.SigningKey, 0, len(keys))
+	for _, k := range keys {
+		expiresAt, err := time.Parse(time.RFC3339, k.ExpiresAt)
+		if err != nil {
+			log.Error("Invalid expires_at for previous jwt key, key ignored", "key_id", k.KeyID, "expires_at", k.ExpiresAt, "error", err)
+			continue
+		}
+		if !now.Before(expiresAt) {
+			log.Info("Previous jwt key has expired, key ignored", "key_id", k.KeyID, "expires_at", k.ExpiresAt)
+			continue
+		}
+		refreshSecret := k.RefreshSecret
+		if refreshSecret == "" {
+			refreshSecret = k.Secret
+		}
+		result = append(result, service.SigningKey{
+			ID:            k.KeyID,
+			Secret:        k.Secret,
+			RefreshSecret: refreshSecret,
+			ExpiresAt:     expiresAt,
+		})
+	}
+	return result
+}
+
+// WarmUserCache 按配置预热热点用户缓存，耗时较长，启动时应在单独的goroutine中调用
+func (h *UserServiceHandler) WarmUserCache(ctx context.Context) {
+	cfg := h.config.CacheWarm
+	if !cfg.Enabled {
+		return
+	}
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	userIDs := append([]uint32{}, cfg.UserIDs...)
+	if cfg.TopFollowers > 0 {
+		hotIDs, err := h.userService.GetHotUserIDs(ctx, cfg.TopFollowers)
+		if err != nil {
+			h.logger.Warn("Failed to load hot users for cache warming", "error", err)
+		}
+		userIDs = append(userIDs, hotIDs...)
+	}
+
+	// 固定列表与热门用户可能重复，去重后再预热
+	seen := make(map[uint32]struct{}, len(userIDs))
+	unique := userIDs[:0]
+	for _, id := range userIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	if len(unique) == 0 {
+		return
+	}
+
+	start := time.Now()
+	warmed, err := h.userService.WarmUserCache(ctx, unique)
+	if err != nil {
+		h.logger.Warn("User cache warming stopped early", "warmed", warmed, "total", len(unique), "error", err)
+		return
+	}
+	h.logger.Info("User cache warmed", "warmed", warmed, "total", len(unique), "duration", time.Since(start))
+}
+
+// FlushSearchIndex 投递尚未发送的搜索索引事件，服务退出时调用
+func (h *UserServiceHandler) FlushSearchIndex(ctx context.Context) error {
+	return h.indexer.Stop(ctx)
+}
+
 // PhoneLogin 手机号登录
 func (h *UserServiceHandler) PhoneLogin(ctx context.Context, req *proto_gen.PhoneLoginRequest) (*proto_gen.LoginResponse, error) {
 	h.logger.Info("PhoneLogin called", "phone", req.Phone)
 
 	// 调用用户服务进行登录
-	user, token, err := h.userService.PhoneLogin(ctx, req.Phone, req.Password, req.DeviceId, req.OsType, req.AppVersion)
+	user, tokens, err := h.userService.PhoneLogin(ctx, req.Phone, req.Password, req.DeviceId, req.OsType, req.AppVersion)
 	if err != nil {
 		h.logger.Error("PhoneLogin failed", "error", err, "phone", req.Phone)
 		return &proto_gen.LoginResponse{
@@ -79,10 +184,12 @@ func (h *UserServiceHandler) PhoneLogin(ctx context.Context, req *proto_gen.Phon
 	}
 
 	return &proto_gen.LoginResponse{
-		StatusCode: 0,
-		StatusMsg:  "登录成功",
-		User:       h.converter.ModelToProto(user),
-		Token:      token,
+		StatusCode:   0,
+		StatusMsg:    "登录成功",
+		User:         h.converter.ModelToProto(user),
+		Token:        tokens.Token,
+		ExpireTime:   tokens.ExpiresAt.Unix(),
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
@@ -91,7 +198,7 @@ func (h *UserServiceHandler) CodeLogin(ctx context.Context, req *proto_gen.CodeL
 	h.logger.Info("CodeLogin called", "phone", req.Phone)
 
 	// 调用用户服务进行验证码登录
-	user, token, err := h.userService.CodeLogin(ctx, req.Phone, req.Code, req.DeviceId, req.OsType, req.AppVersion)
+	user, tokens, err := h.userService.CodeLogin(ctx, req.Phone, req.Code, req.DeviceId, req.OsType, req.AppVersion)
 	if err != nil {
 		h.logger.Error("CodeLogin failed", "error", err, "phone", req.Phone)
 		return &proto_gen.LoginResponse{
@@ -101,20 +208,23 @@ func (h *UserServiceHandler) CodeLogin(ctx context.Context, req *proto_gen.CodeL
 	}
 
 	return &proto_gen.LoginResponse{
-		StatusCode: 0,
-		StatusMsg:  "登录成功",
-		User:       h.converter.ModelToProto(user),
-		Token:      token,
+		StatusCode:   0,
+		StatusMsg:    "登录成功",
+		User:         h.converter.ModelToProto(user),
+		Token:        tokens.Token,
+		ExpireTime:   tokens.ExpiresAt.Unix(),
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
 // SendSmsCode 发送短信验证码
 func (h *UserServiceHandler) SendSmsCode(ctx context.Context, req *proto_gen.SendSmsRequest) (*proto_gen.SendSmsResponse, error) {
-	h.logger.Info("SendSmsCode called", "phone", req.Phone)
+	clientIP := clientIPFromContext(ctx)
+	h.logger.Info("SendSmsCode called", "phone", req.Phone, "ip", clientIP)
 
 	// 调用用户服务发送短信验证码
-	if err := h.userService.SendSmsCode(ctx, req.Phone); err != nil {
-		h.logger.Error("SendSmsCode failed", "error", err, "phone", req.Phone)
+	if err := h.userService.SendSmsCode(ctx, req.Phone, clientIP); err != nil {
+		h.logger.Error("SendSmsCode failed", "error", err, "phone", req.Phone, "ip", clientIP)
 		return &proto_gen.SendSmsResponse{
 			StatusCode: 400,
 			StatusMsg:  err.Error(),
@@ -127,6 +237,23 @@ func (h *UserServiceHandler) SendSmsCode(ctx context.Context, req *proto_gen.Sen
 	}, nil
 }
 
+// clientIPFromContext 获取客户端IP，优先使用网关透传的元数据，直连调用时取对端地址
+func clientIPFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(clientIPMetadataKey); len(values) > 0 && strings.TrimSpace(values[0]) != "" {
+			return strings.TrimSpace(values[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
+
 // VerifyToken 验证Token
 func (h *UserServiceHandler) VerifyToken(ctx context.Context, req *proto_gen.VerifyTokenRequest) (*proto_gen.VerifyTokenResponse, error) {
 	h.logger.Info("VerifyToken called", "token", req.Token)
@@ -149,12 +276,42 @@ func (h *UserServiceHandler) VerifyToken(ctx context.Context, req *proto_gen.Ver
 	}, nil
 }
 
+// IntrospectToken 查询Token的声明和可用状态
+// token签名无效、已过期或已加入黑名单时仍返回成功，通过active区分
+func (h *UserServiceHandler) IntrospectToken(ctx context.Context, req *proto_gen.IntrospectTokenRequest) (*proto_gen.IntrospectTokenResponse, error) {
+	h.logger.Info("IntrospectToken called")
+
+	result, err := h.userService.IntrospectToken(ctx, req.Token)
+	if err != nil {
+		h.logger.Error("IntrospectToken failed", "error", err)
+		return &proto_gen.IntrospectTokenResponse{
+			StatusCode: 400,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	resp := &proto_gen.IntrospectTokenResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Active:     result.Active,
+		UserId:     result.UserID,
+		TokenType:  result.TokenType,
+	}
+	if !result.IssuedAt.IsZero() {
+		resp.IssuedAt = result.IssuedAt.Unix()
+	}
+	if !result.ExpiresAt.IsZero() {
+		resp.ExpireTime = result.ExpiresAt.Unix()
+	}
+	return resp, nil
+}
+
 // RefreshToken 刷新Token
 func (h *UserServiceHandler) RefreshToken(ctx context.Context, req *proto_gen.RefreshTokenRequest) (*proto_gen.RefreshTokenResponse, error) {
-	h.logger.Info("RefreshToken called", "refresh_token", req.RefreshToken)
+	h.logger.Info("RefreshToken called")
 
 	// 调用用户服务刷新token
-	tokenResponse, err := h.userService.RefreshToken(ctx, req.RefreshToken)
+	tokenPair, err := h.userService.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
 		h.logger.Error("RefreshToken failed", "error", err)
 		return &proto_gen.RefreshTokenResponse{
@@ -164,25 +321,12 @@ func (h *UserServiceHandler) RefreshToken(ctx context.Context, req *proto_gen.Re
 		}, nil
 	}
 
-	// 解析返回的token和refresh_token
-	parts := strings.Split(tokenResponse, "|")
-	if len(parts) != 2 {
-		h.logger.Error("Invalid token response format", "response", tokenResponse)
-		return &proto_gen.RefreshTokenResponse{
-			StatusCode: 500,
-			StatusMsg:  "服务器内部错误",
-			Token:      "",
-		}, nil
-	}
-
-	newToken := parts[0]
-	newRefreshToken := parts[1]
-
 	return &proto_gen.RefreshTokenResponse{
 		StatusCode:   0,
 		StatusMsg:    "token刷新成功",
-		Token:        newToken,
-		RefreshToken: newRefreshToken,
+		Token:        tokenPair.Token,
+		ExpireTime:   tokenPair.ExpiresAt.Unix(),
+		RefreshToken: tokenPair.RefreshToken,
 	}, nil
 }
 
@@ -214,7 +358,7 @@ func (h *UserServiceHandler) GetUserInfos(ctx context.Context, req *proto_gen.Ge
 	h.logger.Info("GetUserInfos called", "user_ids", req.UserIds)
 
 	// 调用用户服务批量获取用户信息
-	_, err := h.userService.GetUserInfos(ctx, req.UserIds)
+	users, err := h.userService.GetUserInfos(ctx, req.UserIds)
 	if err != nil {
 		h.logger.Error("GetUserInfos failed", "error", err)
 		return &proto_gen.GetUserInfosResponse{
@@ -225,15 +369,15 @@ func (h *UserServiceHandler) GetUserInfos(ctx context.Context, req *proto_gen.Ge
 	}
 
 	// 转换用户列表到protobuf格式
-	//protoUsers := make([]*proto_gen.User, len(users))
-	//for i, user := range users {
-	//	//protoUsers[i] = user.ToProto()
-	//}
+	protoUsers := make([]*proto_gen.User, len(users))
+	for i, user := range users {
+		protoUsers[i] = h.converter.ModelToProto(user)
+	}
 
 	return &proto_gen.GetUserInfosResponse{
 		StatusCode: 0,
 		StatusMsg:  "success",
-		//Users:      protoUsers,
+		Users:      protoUsers,
 	}, nil
 }
 
@@ -256,6 +400,35 @@ func (h *UserServiceHandler) UpdateUserInfo(ctx context.Context, req *proto_gen.
 	}, nil
 }
 
+// UpdateAvatar 上传并更新用户头像
+func (h *UserServiceHandler) UpdateAvatar(ctx context.Context, req *proto_gen.UpdateAvatarRequest) (*proto_gen.UpdateAvatarResponse, error) {
+	h.logger.Info("UpdateAvatar called", "content_type", req.ContentType, "size", len(req.Image))
+
+	userID, err := h.userService.VerifyToken(ctx, req.Token)
+	if err != nil {
+		h.logger.Error("UpdateAvatar verify token failed", "error", err)
+		return &proto_gen.UpdateAvatarResponse{
+			StatusCode: 400,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	avatarURL, err := h.userService.UpdateAvatar(ctx, userID, req.ContentType, req.Image)
+	if err != nil {
+		h.logger.Error("UpdateAvatar failed", "error", err, "user_id", userID)
+		return &proto_gen.UpdateAvatarResponse{
+			StatusCode: 400,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	return &proto_gen.UpdateAvatarResponse{
+		StatusCode: 0,
+		StatusMsg:  "头像更新成功",
+		AvatarUrl:  avatarURL,
+	}, nil
+}
+
 // GetUserExistInformation 检查用户是否存在
 func (h *UserServiceHandler) GetUserExistInformation(ctx context.Context, req *proto_gen.UserExistRequest) (*proto_gen.UserExistResponse, error) {
 	h.logger.Info("GetUserExistInformation called", "user_id", req.UserId)
@@ -296,3 +469,115 @@ func (h *UserServiceHandler) Logout(ctx context.Context, req *proto_gen.LogoutRe
 		StatusMsg:  "退出登录成功",
 	}, nil
 }
+
+// BanUser 封禁用户
+func (h *UserServiceHandler) BanUser(ctx context.Context, req *proto_gen.BanUserRequest) (*proto_gen.BanUserResponse, error) {
+	h.logger.Info("BanUser called", "user_id", req.UserId, "duration_seconds", req.DurationSeconds)
+
+	adminID, code, err := h.authorizeAdmin(ctx)
+	if err != nil {
+		h.logger.Warn("BanUser rejected", "error", err, "caller", adminID, "user_id", req.UserId)
+		return &proto_gen.BanUserResponse{StatusCode: code, StatusMsg: err.Error()}, nil
+	}
+	duration, ok := h.config.Admin.BanDuration(req.DurationSeconds)
+	if !ok {
+		return &proto_gen.BanUserResponse{
+			StatusCode: 400,
+			StatusMsg:  "ban duration exceeds the limit, use a permanent ban instead",
+		}, nil
+	}
+
+	revoked, err := h.userService.BanUser(ctx, req.UserId, req.Reason, duration)
+	if err != nil {
+		h.logger.Error("BanUser failed", "error", err, "user_id", req.UserId)
+		statusCode := int32(400)
+		if errors.Is(err, service.ErrUserNotFound) {
+			statusCode = 404
+		}
+		return &proto_gen.BanUserResponse{
+			StatusCode: statusCode,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	resp := &proto_gen.BanUserResponse{
+		StatusCode:      0,
+		StatusMsg:       "用户已封禁",
+		RevokedSessions: int32(revoked),
+	}
+	if duration > 0 {
+		resp.BannedUntil = time.Now().Add(duration).Unix()
+	}
+	return resp, nil
+}
+
+// UnbanUser 解除用户封禁
+func (h *UserServiceHandler) UnbanUser(ctx context.Context, req *proto_gen.UnbanUserRequest) (*proto_gen.UnbanUserResponse, error) {
+	h.logger.Info("UnbanUser called", "user_id", req.UserId)
+
+	adminID, code, err := h.authorizeAdmin(ctx)
+	if err != nil {
+		h.logger.Warn("UnbanUser rejected", "error", err, "caller", adminID, "user_id", req.UserId)
+		return &proto_gen.UnbanUserResponse{StatusCode: code, StatusMsg: err.Error()}, nil
+	}
+
+	if err := h.userService.UnbanUser(ctx, req.UserId); err != nil {
+		h.logger.Error("UnbanUser failed", "error", err, "user_id", req.UserId)
+		statusCode := int32(400)
+		if errors.Is(err, service.ErrUserNotFound) {
+			statusCode = 404
+		}
+		return &proto_gen.UnbanUserResponse{
+			StatusCode: statusCode,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	return &proto_gen.UnbanUserResponse{
+		StatusCode: 0,
+		StatusMsg:  "用户已解封",
+	}, nil
+}
+
+// ListUsers 管理后台分页查询用户
+func (h *UserServiceHandler) ListUsers(ctx context.Context, req *proto_gen.ListUsersRequest) (*proto_gen.ListUsersResponse, error) {
+	h.logger.Info("ListUsers called", "status", req.Status, "keyword", req.Keyword, "page", req.Page)
+
+	adminID, code, err := h.authorizeAdmin(ctx)
+	if err != nil {
+		h.logger.Warn("ListUsers rejected", "error", err, "caller", adminID)
+		return &proto_gen.ListUsersResponse{StatusCode: code, StatusMsg: err.Error()}, nil
+	}
+
+	filter := repository.UserFilter{Keyword: strings.TrimSpace(req.Keyword)}
+	if req.Status != "" {
+		status, ok := converter.UserStatusFromString(req.Status)
+		if !ok {
+			return &proto_gen.ListUsersResponse{
+				StatusCode: 400,
+				StatusMsg:  "invalid status: " + req.Status,
+			}, nil
+		}
+		filter.Status = &status
+	}
+
+	users, total, err := h.userService.ListUsers(ctx, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("ListUsers failed", "error", err)
+		return &proto_gen.ListUsersResponse{
+			StatusCode: 500,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+
+	protoUsers := make([]*proto_gen.AdminUser, len(users))
+	for i, user := range users {
+		protoUsers[i] = h.converter.ModelToAdminProto(user)
+	}
+	return &proto_gen.ListUsersResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Users:      protoUsers,
+		Total:      total,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_service/internal/converter"
+	"user_service/internal/service"
+	"user_service/proto/proto_gen"
+)
+
+// stubIntrospectService 返回预设的查询结果或错误
+type stubIntrospectService struct {
+	service.UserService
+	result *service.TokenIntrospection
+	err    error
+}
+
+func (s *stubIntrospectService) IntrospectToken(ctx context.Context, token string) (*service.TokenIntrospection, error) {
+	return s.result, s.err
+}
+
+func introspect(t *testing.T, svc service.UserService) *proto_gen.IntrospectTokenResponse {
+	t.Helper()
+	h := &UserServiceHandler{logger: nopLogger{}, userService: svc, converter: converter.NewUserConverter()}
+	resp, err := h.IntrospectToken(context.Background(), &proto_gen.IntrospectTokenRequest{Token: "a.b.c"})
+	if err != nil {
+		t.Fatalf("IntrospectToken: %v", err)
+	}
+	return resp
+}
+
+func TestIntrospectTokenMapsResult(t *testing.T) {
+	issuedAt := time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)
+	expiresAt := issuedAt.Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		result service.TokenIntrospection
+	}{
+		{"valid", service.TokenIntrospection{Active: true, UserID: 7, TokenType: service.TokenTypeAccess, IssuedAt: issuedAt, ExpiresAt: expiresAt}},
+		// 过期或已加入黑名单的token仍返回声明
+		{"expired or blacklisted", service.TokenIntrospection{UserID: 7, TokenType: service.TokenTypeRefresh, IssuedAt: issuedAt, ExpiresAt: expiresAt}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			resp := introspect(t, &stubIntrospectService{result: &result})
+			if resp.StatusCode != 0 || resp.Active != result.Active || resp.UserId != 7 || resp.TokenType != result.TokenType {
+				t.Errorf("response = %+v, want %+v", resp, result)
+			}
+			if resp.IssuedAt != issuedAt.Unix() || resp.ExpireTime != expiresAt.Unix() {
+				t.Errorf("times = %d %d, want %d %d", resp.IssuedAt, resp.ExpireTime, issuedAt.Unix(), expiresAt.Unix())
+			}
+		})
+	}
+}
+
+func TestIntrospectTokenInvalidHasNoTimes(t *testing.T) {
+	resp := introspect(t, &stubIntrospectService{result: &service.TokenIntrospection{}})
+	// 零值时间不能转换为负数时间戳
+	if resp.StatusCode != 0 || resp.Active || resp.IssuedAt != 0 || resp.ExpireTime != 0 {
+		t.Errorf("response = %+v, want inactive without times", resp)
+	}
+}
+
+func TestIntrospectTokenError(t *testing.T) {
+	resp := introspect(t, &stubIntrospectService{err: errors.New("token cannot be empty")})
+	if resp.StatusCode != 400 || resp.StatusMsg == "" || resp.Active {
+		t.Errorf("response = %+v, want 400 with message", resp)
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_service/internal/converter"
+	"user_service/internal/service"
+	"user_service/proto/proto_gen"
+)
+
+// stubRefreshService 返回预设的token对或错误
+type stubRefreshService struct {
+	service.UserService
+	pair *service.TokenPair
+	err  error
+}
+
+func (s *stubRefreshService) RefreshToken(ctx context.Context, refreshToken string) (*service.TokenPair, error) {
+	return s.pair, s.err
+}
+
+func newRefreshTestHandler(svc service.UserService) *UserServiceHandler {
+	return &UserServiceHandler{logger: nopLogger{}, userService: svc, converter: converter.NewUserConverter()}
+}
+
+func TestRefreshTokenMapsTokenPair(t *testing.T) {
+	expiresAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	h := newRefreshTestHandler(&stubRefreshService{pair: &service.TokenPair{Token: "a.b.c", RefreshToken: "r.s.t", ExpiresAt: expiresAt}})
+
+	resp, err := h.RefreshToken(context.Background(), &proto_gen.RefreshTokenRequest{RefreshToken: "old.refresh.token"})
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if resp.StatusCode != 0 || resp.Token != "a.b.c" || resp.RefreshToken != "r.s.t" || resp.ExpireTime != expiresAt.Unix() {
+		t.Fatalf("response = %+v, want token pair with expire time %d", resp, expiresAt.Unix())
+	}
+}
+
+func TestRefreshTokenErrorReturnsNoTokens(t *testing.T) {
+	h := newRefreshTestHandler(&stubRefreshService{err: errors.New("invalid refresh token format: invalid token format")})
+
+	resp, err := h.RefreshToken(context.Background(), &proto_gen.RefreshTokenRequest{RefreshToken: "access|refresh"})
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if resp.StatusCode != 400 || resp.StatusMsg == "" {
+		t.Errorf("status = %d %q, want 400 with message", resp.StatusCode, resp.StatusMsg)
+	}
+	if resp.Token != "" || resp.RefreshToken != "" || resp.ExpireTime != 0 {
+		t.Errorf("failed refresh returned tokens: %+v", resp)
+	}
+}
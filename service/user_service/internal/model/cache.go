@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"common/jwtauth"
 )
 
 // RedisKey Redis键前缀定义
@@ -14,6 +16,7 @@ const (
 	UserFollowCacheKey  = "user:follow:%d:%d"        // 用户关注列表缓存
 	UserFanCacheKey     = "user:fan:%d:%d"           // 用户粉丝列表缓存
 	UserFollowStatusKey = "user:follow:status:%d:%d" // 关注状态缓存
+	UserSessionKey      = "user:session:%d"          // 用户登录会话(按设备存储访问token和刷新token)
 
 	// 统计相关
 	UserTrendCacheKey = "user:trend:%d:%s" // 用户趋势缓存
@@ -27,6 +30,10 @@ const (
 	// 计数器相关
 	UserCounterKey   = "counter:user:%s:%d" // 用户计数器
 	GlobalCounterKey = "counter:global:%s"  // 全局计数器
+
+	// 短信防刷相关
+	SmsIPPhonesKey = "sms:ip:phones:%s" // IP使用过的手机号(有序集合，分值为发送时间的毫秒时间戳)
+	SmsIPBlockKey  = "sms:ip:block:%s"  // IP封禁标记
 )
 
 // CacheTTL 缓存过期时间定义
@@ -51,6 +58,16 @@ type UserCache struct {
 	UserType        string    `json:"user_type"`
 	Status          uint8     `json:"status"`
 	UpdatedAt       time.Time `json:"updated_at"`
+
+	// 以下字段用于直接从缓存返回用户信息
+	Phone          string     `json:"phone,omitempty"`
+	FollowingCount uint32     `json:"following_count"`
+	FollowersCount uint32     `json:"followers_count"`
+	TotalFavorited uint64     `json:"total_favorited"`
+	WorkCount      uint32     `json:"work_count"`
+	FavoriteCount  uint32     `json:"favorite_count"`
+	LastLoginAt    *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
 }
 
 // UserStatsCache 用户统计缓存
@@ -113,6 +130,12 @@ type HotUserCache struct {
 	UpdatedAt time.Time   `json:"updated_at"`
 }
 
+// UserSession 用户在某设备上的登录会话，刷新token只有在会话仍存在且未被轮换时可用
+type UserSession struct {
+	Token        string `json:"token"`         // 访问token
+	RefreshToken string `json:"refresh_token"` // 刷新token
+}
+
 // CacheHelper 缓存辅助函数
 
 // GetUserInfoCacheKey 获取用户信息缓存键
@@ -170,16 +193,89 @@ func GetUserCacheKey(userID uint32) string {
 	return fmt.Sprintf(UserInfoCacheKey, uint64(userID))
 }
 
+// GetUserSessionKey 获取用户登录会话键
+func GetUserSessionKey(userID uint32) string {
+	return fmt.Sprintf(UserSessionKey, uint64(userID))
+}
+
 // GetSmsCodeCacheKey 获取短信验证码缓存键
 func GetSmsCodeCacheKey(phone string) string {
 	return fmt.Sprintf("sms:code:%s", phone)
 }
 
+// GetSmsIPPhonesKey 获取IP使用过的手机号键
+func GetSmsIPPhonesKey(ip string) string {
+	return fmt.Sprintf(SmsIPPhonesKey, ip)
+}
+
+// GetSmsIPBlockKey 获取IP封禁标记键
+func GetSmsIPBlockKey(ip string) string {
+	return fmt.Sprintf(SmsIPBlockKey, ip)
+}
+
+// GetTokenBlacklistKey 获取token黑名单键，与其他服务校验token时读取的键一致
+func GetTokenBlacklistKey(token string) string {
+	return jwtauth.BlacklistKey(token)
+}
+
 // GetGlobalCounterKey 获取全局计数器键
 func GetGlobalCounterKey(counterType string) string {
 	return fmt.Sprintf(GlobalCounterKey, counterType)
 }
 
+// NewUserCache 由用户模型生成缓存数据
+func NewUserCache(user *User) *UserCache {
+	return &UserCache{
+		UserID:          uint64(user.ID),
+		Username:        user.Username,
+		Nickname:        user.Nickname,
+		AvatarURL:       user.AvatarURL,
+		BackgroundImage: user.BackgroundImage,
+		Signature:       user.Signature,
+		IsVerified:      user.IsVerified,
+		UserType:        user.UserType,
+		Status:          user.Status,
+		UpdatedAt:       user.UpdatedAt,
+		Phone:           user.Phone,
+		FollowingCount:  user.FollowingCount,
+		FollowersCount:  user.FollowersCount,
+		TotalFavorited:  user.TotalFavorited,
+		WorkCount:       user.WorkCount,
+		FavoriteCount:   user.FavoriteCount,
+		LastLoginAt:     user.LastLoginAt,
+		CreatedAt:       user.CreatedAt,
+	}
+}
+
+// Complete 缓存是否包含完整的用户信息，旧版本写入的缓存缺少CreatedAt等字段，不能直接返回
+func (c *UserCache) Complete() bool {
+	return !c.CreatedAt.IsZero()
+}
+
+// ToUser 转换为用户模型，不包含密码等敏感字段
+func (c *UserCache) ToUser() *User {
+	return &User{
+		ID:              uint32(c.UserID),
+		Username:        c.Username,
+		Phone:           c.Phone,
+		Nickname:        c.Nickname,
+		AvatarURL:       c.AvatarURL,
+		BackgroundImage: c.BackgroundImage,
+		Signature:       c.Signature,
+		FollowingCount:  c.FollowingCount,
+		FollowersCount:  c.FollowersCount,
+		TotalFavorited:  c.TotalFavorited,
+		WorkCount:       c.WorkCount,
+		FavoriteCount:   c.FavoriteCount,
+		IsVerified:      c.IsVerified,
+		UserType:        c.UserType,
+		Status:          c.Status,
+		LastLoginAt:     c.LastLoginAt,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	}
+}
+
 // ToJSON 转换为JSON字符串
 func (c *UserCache) ToJSON() (string, error) {
 	data, err := json.Marshal(c)
@@ -199,6 +295,24 @@ func (c *UserCache) FromJSONBytes(data []byte) error {
 	return json.Unmarshal(data, c)
 }
 
+// ToJSON 转换为JSON字符串
+func (s *UserSession) ToJSON() (string, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ParseUserSession 解析登录会话，兼容旧版本只保存访问token的会话
+func ParseUserSession(data string) *UserSession {
+	session := &UserSession{}
+	if err := json.Unmarshal([]byte(data), session); err != nil || session.Token == "" {
+		return &UserSession{Token: data}
+	}
+	return session
+}
+
 // ToJSON 转换为JSON字符串
 func (s *UserStatsCache) ToJSON() (string, error) {
 	data, err := json.Marshal(s)
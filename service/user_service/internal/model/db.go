@@ -51,3 +51,13 @@ var (
 	_ UserTabler = (*UserStats)(nil)
 	_ UserTabler = (*UserStatsDaily)(nil)
 )
+
+// Models 需要迁移的全部模型
+func Models() []interface{} {
+	return []interface{}{
+		&User{},
+		&UserFollow{},
+		&UserStats{},
+		&UserStatsDaily{},
+	}
+}
@@ -28,7 +28,9 @@ type User struct {
 	// 状态信息
 	IsVerified  bool       `gorm:"default:false;comment:是否认证"`
 	UserType    string     `gorm:"size:20;default:'normal';comment:用户类型:normal,verified,official"`
-	Status      uint8      `gorm:"default:1;index;comment:状态:0-禁用,1-正常"`
+	Status      uint8      `gorm:"default:1;index;comment:状态:0-禁用,1-正常,2-封禁"`
+	BanReason   string     `gorm:"size:255;comment:封禁原因"`
+	BannedUntil *time.Time `gorm:"comment:封禁截止时间,为空表示永久封禁"`
 	LastLoginAt *time.Time `gorm:"comment:最后登录时间"`
 
 	// 时间戳
@@ -128,6 +130,7 @@ func (u *User) getLastLoginTimestamp() int64 {
 const (
 	UserStatusDisabled = 0 // 禁用
 	UserStatusActive   = 1 // 正常
+	UserStatusBanned   = 2 // 封禁
 )
 
 // IsActive 检查用户是否活跃
@@ -135,6 +138,11 @@ func (u *User) IsActive() bool {
 	return u.Status == UserStatusActive && u.DeletedAt == nil
 }
 
+// IsBanned 检查用户在now时刻是否处于封禁中，到期的封禁视为已解除
+func (u *User) IsBanned(now time.Time) bool {
+	return u.Status == UserStatusBanned && (u.BannedUntil == nil || now.Before(*u.BannedUntil))
+}
+
 // IsOfficial 检查是否为官方账号
 func (u *User) IsOfficial() bool {
 	return u.UserType == "official"
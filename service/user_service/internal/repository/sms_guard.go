@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"user_service/internal/model"
+)
+
+// recordIPPhoneScript 记录IP本次使用的手机号并返回窗口内不同手机号数
+// KEYS[1]=IP手机号集合 ARGV[1]=当前毫秒时间戳 ARGV[2]=窗口毫秒数 ARGV[3]=手机号
+var recordIPPhoneScript = redis.NewScript(`
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[3])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", tonumber(ARGV[1]) - tonumber(ARGV[2]))
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return redis.call("ZCARD", KEYS[1])
+`)
+
+// IsSmsIPBlocked IP是否已被封禁发送验证码
+func (r *userRepository) IsSmsIPBlocked(ctx context.Context, ip string) (bool, error) {
+	n, err := r.redis.Exists(ctx, model.GetSmsIPBlockKey(ip)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check sms ip block: %w", err)
+	}
+	return n > 0, nil
+}
+
+// BlockSmsIP 封禁IP发送验证码，到期自动解除
+func (r *userRepository) BlockSmsIP(ctx context.Context, ip string, duration time.Duration) error {
+	if err := r.redis.Set(ctx, model.GetSmsIPBlockKey(ip), time.Now().Unix(), duration).Err(); err != nil {
+		return fmt.Errorf("failed to block sms ip: %w", err)
+	}
+	return nil
+}
+
+// RecordSmsIPPhone 记录IP发送验证码使用的手机号，返回window内该IP使用过的不同手机号数
+func (r *userRepository) RecordSmsIPPhone(ctx context.Context, ip, phone string, window time.Duration) (int64, error) {
+	now := time.Now().UnixMilli()
+	count, err := recordIPPhoneScript.Run(ctx, r.redis, []string{model.GetSmsIPPhonesKey(ip)},
+		strconv.FormatInt(now, 10), strconv.FormatInt(window.Milliseconds(), 10), phone).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to record sms ip phone: %w", err)
+	}
+	return count, nil
+}
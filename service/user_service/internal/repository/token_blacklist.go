@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"user_service/internal/model"
+)
+
+// BlacklistToken 将token加入黑名单，ttl为token剩余有效时间，token过期后黑名单记录自动删除
+func (r *userRepository) BlacklistToken(ctx context.Context, token string, ttl time.Duration) error {
+	if err := r.redis.Set(ctx, model.GetTokenBlacklistKey(token), time.Now().Unix(), ttl).Err(); err != nil {
+		return fmt.Errorf("failed to blacklist token: %w", err)
+	}
+	return nil
+}
+
+// IsTokenBlacklisted token是否已被加入黑名单
+func (r *userRepository) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	n, err := r.redis.Exists(ctx, model.GetTokenBlacklistKey(token)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check token blacklist: %w", err)
+	}
+	return n > 0, nil
+}
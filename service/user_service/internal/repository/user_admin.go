@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"user_service/internal/model"
+)
+
+// ErrUserStatusConflict 用户当前状态与条件更新要求的状态不一致
+var ErrUserStatusConflict = errors.New("user status conflict")
+
+// UserFilter 管理后台查询用户的过滤条件
+type UserFilter struct {
+	Status  *uint8 // 为nil时不按状态过滤
+	Keyword string // 按用户名、昵称模糊匹配或按手机号精确匹配
+	Offset  int
+	Limit   int
+}
+
+// GetByIDAnyStatus 根据ID获取任意状态的用户，供管理操作使用，用户不存在时返回gorm.ErrRecordNotFound
+func (r *userRepository) GetByIDAnyStatus(ctx context.Context, userID uint32) (*model.User, error) {
+	var user model.User
+	if err := r.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetByPhoneAnyStatus 根据手机号获取任意状态的用户，用于登录前检查封禁状态，用户不存在时返回gorm.ErrRecordNotFound
+func (r *userRepository) GetByPhoneAnyStatus(ctx context.Context, phone string) (*model.User, error) {
+	var user model.User
+	if err := r.db.WithContext(ctx).Where("phone = ?", phone).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UpdateStatus 将用户状态从from改为to，并记录封禁原因和截止时间
+// 条件更新，用户当前状态不是from时返回ErrUserStatusConflict
+func (r *userRepository) UpdateStatus(ctx context.Context, userID uint32, from []uint8, to uint8, reason string, bannedUntil *time.Time) error {
+	result := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND status IN ?", userID, from).
+		Updates(map[string]interface{}{
+			"status":       to,
+			"ban_reason":   reason,
+			"banned_until": bannedUntil,
+			"updated_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserStatusConflict
+	}
+	return nil
+}
+
+// List 按条件分页查询用户，按ID倒序，返回当页用户和总数
+func (r *userRepository) List(ctx context.Context, filter UserFilter) ([]*model.User, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.User{})
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.Keyword != "" {
+		like := "%" + filter.Keyword + "%"
+		query = query.Where("username LIKE ? OR nickname LIKE ? OR phone = ?", like, like, filter.Keyword)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var users []*model.User
+	if err := query.Order("id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -19,26 +21,50 @@ type UserRepository interface {
 	GetByIDs(ctx context.Context, userIDs []uint32) ([]*model.User, error)
 	Update(ctx context.Context, userID uint32, updates map[string]interface{}) error
 	Exists(ctx context.Context, userID uint32) (bool, error)
+	ListHotUserIDs(ctx context.Context, limit int) ([]uint32, error)
+
+	// 用户管理
+	GetByIDAnyStatus(ctx context.Context, userID uint32) (*model.User, error)
+	GetByPhoneAnyStatus(ctx context.Context, phone string) (*model.User, error)
+	UpdateStatus(ctx context.Context, userID uint32, from []uint8, to uint8, reason string, bannedUntil *time.Time) error
+	List(ctx context.Context, filter UserFilter) ([]*model.User, int64, error)
 
 	// 缓存相关
 	GetUserFromCache(ctx context.Context, userID uint32) (*model.UserCache, error)
+	GetUsersFromCache(ctx context.Context, userIDs []uint32) (map[uint32]*model.UserCache, error)
 	SetUserCache(ctx context.Context, userID uint32, userCache *model.UserCache, expiration time.Duration) error
+	SetUsersCache(ctx context.Context, userCaches []*model.UserCache, expiration time.Duration) error
 	DeleteUserCache(ctx context.Context, userID uint32) error
 
 	// 短信验证码
 	SetSmsCode(ctx context.Context, phone, code string, expiration time.Duration) error
 	GetSmsCode(ctx context.Context, phone string) (string, error)
 	DeleteSmsCode(ctx context.Context, phone string) error
+
+	// 短信防刷
+	IsSmsIPBlocked(ctx context.Context, ip string) (bool, error)
+	BlockSmsIP(ctx context.Context, ip string, duration time.Duration) error
+	RecordSmsIPPhone(ctx context.Context, ip, phone string, window time.Duration) (int64, error)
+
+	// 登录会话
+	SetUserSession(ctx context.Context, userID uint32, deviceID string, session *model.UserSession, expiration time.Duration) error
+	GetUserSessions(ctx context.Context, userID uint32) (map[string]*model.UserSession, error)
+	RotateUserSession(ctx context.Context, userID uint32, refreshToken string, session *model.UserSession, expiration time.Duration) (string, *model.UserSession, error)
+	DeleteUserSessions(ctx context.Context, userID uint32, deviceIDs ...string) error
+
+	// token黑名单
+	BlacklistToken(ctx context.Context, token string, ttl time.Duration) error
+	IsTokenBlacklisted(ctx context.Context, token string) (bool, error)
 }
 
 // userRepository 用户数据访问实现
 type userRepository struct {
 	db    *gorm.DB
-	redis *redis.Client
+	redis redis.UniversalClient
 }
 
 // NewUserRepository 创建用户数据访问对象
-func NewUserRepository(db *gorm.DB, redis *redis.Client) UserRepository {
+func NewUserRepository(db *gorm.DB, redis redis.UniversalClient) UserRepository {
 	return &userRepository{
 		db:    db,
 		redis: redis,
@@ -120,6 +146,23 @@ func (r *userRepository) Exists(ctx context.Context, userID uint32) (bool, error
 	return count > 0, nil
 }
 
+// ListHotUserIDs 按粉丝数从高到低获取正常状态的用户ID
+func (r *userRepository) ListHotUserIDs(ctx context.Context, limit int) ([]uint32, error) {
+	if limit <= 0 {
+		return []uint32{}, nil
+	}
+
+	var userIDs []uint32
+	if err := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("status = ?", model.UserStatusActive).
+		Order("followers_count DESC").
+		Limit(limit).
+		Pluck("id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
 // GetUserFromCache 从缓存获取用户信息
 func (r *userRepository) GetUserFromCache(ctx context.Context, userID uint32) (*model.UserCache, error) {
 	cacheKey := model.GetUserCacheKey(userID)
@@ -139,6 +182,37 @@ func (r *userRepository) GetUserFromCache(ctx context.Context, userID uint32) (*
 	return &userCache, nil
 }
 
+// GetUsersFromCache 批量从缓存获取用户信息，返回命中的用户，未命中或无法解析的用户不在结果中
+// 使用pipeline逐个GET而不是MGET，集群模式下键分布在不同槽位时MGET会失败
+func (r *userRepository) GetUsersFromCache(ctx context.Context, userIDs []uint32) (map[uint32]*model.UserCache, error) {
+	result := make(map[uint32]*model.UserCache, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	pipe := r.redis.Pipeline()
+	cmds := make([]*redis.StringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.Get(ctx, model.GetUserCacheKey(userID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		data, err := cmd.Bytes()
+		if err != nil {
+			continue
+		}
+		var userCache model.UserCache
+		if err := userCache.FromJSONBytes(data); err != nil {
+			continue
+		}
+		result[userIDs[i]] = &userCache
+	}
+	return result, nil
+}
+
 // SetUserCache 设置用户缓存
 func (r *userRepository) SetUserCache(ctx context.Context, userID uint32, userCache *model.UserCache, expiration time.Duration) error {
 	cacheData, err := userCache.ToJSON()
@@ -154,6 +228,26 @@ func (r *userRepository) SetUserCache(ctx context.Context, userID uint32, userCa
 	return nil
 }
 
+// SetUsersCache 批量设置用户缓存，在一个pipeline中写入
+func (r *userRepository) SetUsersCache(ctx context.Context, userCaches []*model.UserCache, expiration time.Duration) error {
+	if len(userCaches) == 0 {
+		return nil
+	}
+
+	pipe := r.redis.Pipeline()
+	for _, userCache := range userCaches {
+		cacheData, err := userCache.ToJSON()
+		if err != nil {
+			return errors.New("failed to serialize user cache")
+		}
+		pipe.Set(ctx, model.GetUserCacheKey(uint32(userCache.UserID)), cacheData, expiration)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.New("failed to set cache")
+	}
+	return nil
+}
+
 // DeleteUserCache 删除用户缓存
 func (r *userRepository) DeleteUserCache(ctx context.Context, userID uint32) error {
 	cacheKey := model.GetUserCacheKey(userID)
@@ -193,3 +287,103 @@ func (r *userRepository) DeleteSmsCode(ctx context.Context, phone string) error
 	}
 	return nil
 }
+
+// SetUserSession 记录用户在某设备上的登录会话，覆盖该设备之前的会话
+func (r *userRepository) SetUserSession(ctx context.Context, userID uint32, deviceID string, session *model.UserSession, expiration time.Duration) error {
+	data, err := session.ToJSON()
+	if err != nil {
+		return errors.New("failed to serialize user session")
+	}
+	cacheKey := model.GetUserSessionKey(userID)
+	pipe := r.redis.TxPipeline()
+	pipe.HSet(ctx, cacheKey, deviceID, data)
+	pipe.Expire(ctx, cacheKey, expiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.New("failed to set user session")
+	}
+	return nil
+}
+
+// GetUserSessions 获取用户所有设备的登录会话，key为设备ID
+func (r *userRepository) GetUserSessions(ctx context.Context, userID uint32) (map[string]*model.UserSession, error) {
+	cacheKey := model.GetUserSessionKey(userID)
+	values, err := r.redis.HGetAll(ctx, cacheKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make(map[string]*model.UserSession, len(values))
+	for deviceID, data := range values {
+		sessions[deviceID] = model.ParseUserSession(data)
+	}
+	return sessions, nil
+}
+
+// ErrSessionNotFound 刷新token不属于用户当前任何设备的登录会话
+var ErrSessionNotFound = errors.New("user session not found")
+
+// rotateSessionAttempts 会话被并发修改时轮换的最大尝试次数
+const rotateSessionAttempts = 3
+
+// RotateUserSession 用新会话替换持有refreshToken的设备会话，返回设备ID和被替换的会话
+// 没有设备持有refreshToken(会话已被挤下线、注销或刷新token已被使用)时返回ErrSessionNotFound；
+// 查找和替换在WATCH事务中完成，同一个刷新token只能成功轮换一次
+func (r *userRepository) RotateUserSession(ctx context.Context, userID uint32, refreshToken string, session *model.UserSession, expiration time.Duration) (string, *model.UserSession, error) {
+	data, err := session.ToJSON()
+	if err != nil {
+		return "", nil, errors.New("failed to serialize user session")
+	}
+	cacheKey := model.GetUserSessionKey(userID)
+
+	var deviceID string
+	var previous *model.UserSession
+	rotate := func(tx *redis.Tx) error {
+		deviceID, previous = "", nil
+		values, err := tx.HGetAll(ctx, cacheKey).Result()
+		if err != nil {
+			return err
+		}
+		for device, value := range values {
+			current := model.ParseUserSession(value)
+			if current.RefreshToken != "" && subtle.ConstantTimeCompare([]byte(current.RefreshToken), []byte(refreshToken)) == 1 {
+				deviceID, previous = device, current
+				break
+			}
+		}
+		if previous == nil {
+			return ErrSessionNotFound
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, cacheKey, deviceID, data)
+			pipe.Expire(ctx, cacheKey, expiration)
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < rotateSessionAttempts; i++ {
+		err := r.redis.Watch(ctx, rotate, cacheKey)
+		if err == redis.TxFailedErr {
+			continue
+		}
+		if err != nil {
+			if errors.Is(err, ErrSessionNotFound) {
+				return "", nil, err
+			}
+			return "", nil, fmt.Errorf("failed to rotate user session: %w", err)
+		}
+		return deviceID, previous, nil
+	}
+	return "", nil, errors.New("failed to rotate user session: too many concurrent updates")
+}
+
+// DeleteUserSessions 删除用户指定设备的登录会话
+func (r *userRepository) DeleteUserSessions(ctx context.Context, userID uint32, deviceIDs ...string) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+	cacheKey := model.GetUserSessionKey(userID)
+	if err := r.redis.HDel(ctx, cacheKey, deviceIDs...).Err(); err != nil {
+		return errors.New("failed to delete user sessions")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// newDryRunRepository 创建只生成SQL、不连接数据库的仓库，返回执行过的查询语句
+func newDryRunRepository(t *testing.T) (*userRepository, *[]string) {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/test", SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+	var statements []string
+	if err := db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		statements = append(statements, tx.Statement.SQL.String())
+	}); err != nil {
+		t.Fatalf("register callback: %v", err)
+	}
+	return &userRepository{db: db}, &statements
+}
+
+func TestGetByIDsLoadsAllUsersInOneQuery(t *testing.T) {
+	repo, statements := newDryRunRepository(t)
+
+	if _, err := repo.GetByIDs(context.Background(), []uint32{3, 1, 2}); err != nil {
+		t.Fatalf("GetByIDs: %v", err)
+	}
+	if len(*statements) != 1 {
+		t.Fatalf("queries = %v, want exactly one", *statements)
+	}
+	if sql := (*statements)[0]; !strings.Contains(sql, "id IN (?,?,?)") {
+		t.Errorf("query = %q, want a single IN lookup", sql)
+	}
+}
+
+func TestGetByIDsEmptySkipsDatabase(t *testing.T) {
+	repo, statements := newDryRunRepository(t)
+
+	users, err := repo.GetByIDs(context.Background(), nil)
+	if err != nil || len(users) != 0 {
+		t.Fatalf("GetByIDs(nil) = %v, %v", users, err)
+	}
+	if len(*statements) != 0 {
+		t.Errorf("queries = %v, want none", *statements)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+
+	"common/jwtauth"
 )
 
 // TokenClaims JWT claims
@@ -15,6 +17,42 @@ type TokenClaims struct {
 	jwt.RegisteredClaims
 }
 
+// TokenPair 刷新后的token对
+type TokenPair struct {
+	Token        string    // 访问token
+	RefreshToken string    // 刷新token
+	ExpiresAt    time.Time // 访问token过期时间
+}
+
+// token类型
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// TokenIntrospection token内省结果
+type TokenIntrospection struct {
+	Active    bool      // token是否可用：签名有效、在有效期内且未加入黑名单
+	UserID    uint32    // 签名无效时为0
+	TokenType string    // access或refresh，签名无效时为空
+	IssuedAt  time.Time // 签发时间
+	ExpiresAt time.Time // 过期时间
+}
+
+// SigningKey JWT密钥
+type SigningKey struct {
+	ID            string    // kid，为空时签发的token不带kid
+	Secret        string    // 访问token密钥
+	RefreshSecret string    // 刷新token密钥
+	ExpiresAt     time.Time // 旧密钥的宽限期截止时间，当前密钥为零值
+}
+
+// TokenBlacklist token黑名单存储
+type TokenBlacklist interface {
+	BlacklistToken(ctx context.Context, token string, ttl time.Duration) error
+	IsTokenBlacklisted(ctx context.Context, token string) (bool, error)
+}
+
 // AuthService 认证服务接口
 type AuthService interface {
 	GenerateToken(ctx context.Context, userID uint32) (string, error)
@@ -24,29 +62,43 @@ type AuthService interface {
 	VerifyToken(tokenString string) (uint32, error)
 	VerifyRefreshToken(tokenString string) (uint32, error)
 	InvalidateToken(ctx context.Context, token string) error
+	IntrospectToken(ctx context.Context, tokenString string) (*TokenIntrospection, error)
 	GetTokenExpiration() time.Duration
 	GetRefreshTokenExpiration() time.Duration
 }
 
 // authService 认证服务实现
 type authService struct {
-	secretKey         string
-	refreshSecretKey  string
+	currentKey        SigningKey        // 签发和校验
+	accessVerifier    *jwtauth.Verifier // 校验访问token，包含宽限期内的旧密钥
+	refreshVerifier   *jwtauth.Verifier // 校验刷新token，包含宽限期内的旧密钥
 	tokenExpiration   time.Duration
 	refreshExpiration time.Duration
 	issuer            string
 	audience          string
+	blacklist         TokenBlacklist
 }
 
-// NewAuthService 创建认证服务
-func NewAuthService(secretKey, refreshSecretKey string, tokenExpiration, refreshExpiration time.Duration) AuthService {
+// NewAuthService 创建认证服务，blacklist保存已退出登录或被挤下线的token
+// 使用currentKey签发token；previousKeys为轮换下来的旧密钥，宽限期内旧密钥签发的token仍可校验通过
+func NewAuthService(currentKey SigningKey, previousKeys []SigningKey, tokenExpiration, refreshExpiration time.Duration, blacklist TokenBlacklist) AuthService {
+	accessPrevious := make([]jwtauth.Key, 0, len(previousKeys))
+	refreshPrevious := make([]jwtauth.Key, 0, len(previousKeys))
+	for _, key := range previousKeys {
+		accessPrevious = append(accessPrevious, jwtauth.Key{ID: key.ID, Secret: key.Secret, ExpiresAt: key.ExpiresAt})
+		refreshPrevious = append(refreshPrevious, jwtauth.Key{ID: key.ID, Secret: key.RefreshSecret, ExpiresAt: key.ExpiresAt})
+	}
+
+	// 黑名单由userService.VerifyToken和IntrospectToken检查，校验器只负责按kid选择密钥
 	return &authService{
-		secretKey:         secretKey,
-		refreshSecretKey:  refreshSecretKey,
+		currentKey:        currentKey,
+		accessVerifier:    jwtauth.NewVerifier(jwtauth.Key{ID: currentKey.ID, Secret: currentKey.Secret}, accessPrevious, nil),
+		refreshVerifier:   jwtauth.NewVerifier(jwtauth.Key{ID: currentKey.ID, Secret: currentKey.RefreshSecret}, refreshPrevious, nil),
 		tokenExpiration:   tokenExpiration,
 		refreshExpiration: refreshExpiration,
 		issuer:            "vision-world-user-service",
 		audience:          "vision-world-app",
+		blacklist:         blacklist,
 	}
 }
 
@@ -63,8 +115,7 @@ func (s *authService) GenerateToken(ctx context.Context, userID uint32) (string,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.secretKey))
+	tokenString, err := s.sign(claims, s.currentKey.Secret)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -85,8 +136,7 @@ func (s *authService) GenerateRefreshToken(ctx context.Context, userID uint32) (
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.refreshSecretKey))
+	tokenString, err := s.sign(claims, s.currentKey.RefreshSecret)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign refresh token: %w", err)
 	}
@@ -96,12 +146,7 @@ func (s *authService) GenerateRefreshToken(ctx context.Context, userID uint32) (
 
 // ParseToken 解析访问token
 func (s *authService) ParseToken(tokenString string) (uint32, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.secretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, s.keyFunc(false))
 
 	if err != nil {
 		return 0, fmt.Errorf("failed to parse token: %w", err)
@@ -116,12 +161,7 @@ func (s *authService) ParseToken(tokenString string) (uint32, error) {
 
 // ParseRefreshToken 解析刷新token
 func (s *authService) ParseRefreshToken(tokenString string) (uint32, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.refreshSecretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, s.keyFunc(true))
 
 	if err != nil {
 		return 0, fmt.Errorf("failed to parse refresh token: %w", err)
@@ -157,12 +197,7 @@ func (s *authService) GetRefreshTokenExpiration() time.Duration {
 // InvalidateToken 使token失效（加入黑名单）
 func (s *authService) InvalidateToken(ctx context.Context, token string) error {
 	// 解析token获取过期时间
-	tokenObj, err := jwt.ParseWithClaims(token, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.secretKey), nil
-	})
+	tokenObj, err := jwt.ParseWithClaims(token, &TokenClaims{}, s.keyFunc(false))
 
 	if err != nil {
 		return fmt.Errorf("failed to parse token for invalidation: %w", err)
@@ -179,15 +214,75 @@ func (s *authService) InvalidateToken(ctx context.Context, token string) error {
 			return nil
 		}
 
-		// TODO: 将token加入Redis黑名单
-		// 使用token的jti（JWT ID）作为key，设置过期时间为token剩余有效时间
-		// key格式: "blacklist:token:{jti}"
-		// 示例: redis.Set(ctx, fmt.Sprintf("blacklist:token:%s", claims.ID), "1", remainingTime)
-
-		// 暂时只打印日志，表示token已被标记为失效
-		fmt.Printf("Token invalidated for user %d, expires in %v\n", claims.UserID, remainingTime)
-		return nil
+		// 黑名单记录与token同时过期
+		return s.blacklist.BlacklistToken(ctx, token, remainingTime)
 	}
 
 	return errors.New("invalid token for invalidation")
 }
+
+// IntrospectToken 查询token的声明和可用状态
+// 签名无效或格式错误的token返回Active为false且不含任何声明；已过期或已加入黑名单的token返回Active为false并附带声明，
+// 便于调用方区分需要刷新和需要重新登录；只有查询黑名单失败时返回错误
+func (s *authService) IntrospectToken(ctx context.Context, tokenString string) (*TokenIntrospection, error) {
+	result := &TokenIntrospection{}
+
+	// refresh_secret未单独配置时与secret相同，两种token无法区分，按访问token处理
+	tokenType := TokenTypeAccess
+	claims, err := parseClaimsWithoutValidation(tokenString, s.keyFunc(false))
+	if err != nil {
+		tokenType = TokenTypeRefresh
+		if claims, err = parseClaimsWithoutValidation(tokenString, s.keyFunc(true)); err != nil {
+			return result, nil
+		}
+	}
+
+	result.UserID = claims.UserID
+	result.TokenType = tokenType
+	if claims.IssuedAt != nil {
+		result.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	// 校验有效期
+	if err := claims.Valid(); err != nil {
+		return result, nil
+	}
+
+	blacklisted, err := s.blacklist.IsTokenBlacklisted(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	result.Active = !blacklisted
+	return result, nil
+}
+
+// parseClaimsWithoutValidation 校验签名并解析声明，不校验有效期
+func parseClaimsWithoutValidation(tokenString string, keyFunc jwt.Keyfunc) (*TokenClaims, error) {
+	claims := &TokenClaims{}
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(tokenString, claims, keyFunc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	return claims, nil
+}
+
+// sign 使用当前密钥签发token，当前密钥配置了kid时写入header
+func (s *authService) sign(claims TokenClaims, secret string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if s.currentKey.ID != "" {
+		token.Header["kid"] = s.currentKey.ID
+	}
+	return token.SignedString([]byte(secret))
+}
+
+// keyFunc 按token header中的kid选择校验密钥，refresh为true时使用刷新token密钥
+func (s *authService) keyFunc(refresh bool) jwt.Keyfunc {
+	if refresh {
+		return s.refreshVerifier.KeyFunc()
+	}
+	return s.accessVerifier.KeyFunc()
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"user_service/internal/storage"
+)
+
+// 最小PNG文件头
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// failingStorage 模拟对象存储写入失败
+type failingStorage struct {
+	storage.Storage
+}
+
+func (failingStorage) UploadImage(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	return "", errors.New("s3: connection reset")
+}
+
+func newAvatarTestService(t *testing.T, imageStorage storage.Storage) (*userService, *fakeUserRepo) {
+	t.Helper()
+	svc, repo := newLoginTestService(t, activeUser(t), "")
+	svc.imageStorage = imageStorage
+	return svc, repo
+}
+
+func TestUpdateAvatarStoresURL(t *testing.T) {
+	svc, repo := newAvatarTestService(t, storage.NewLocalStorage(t.TempDir(), "https://img.example.com", 1024))
+
+	url, err := svc.UpdateAvatar(context.Background(), 7, "image/png", testPNG)
+	if err != nil {
+		t.Fatalf("UpdateAvatar: %v", err)
+	}
+	if len(repo.updates) != 1 || repo.updates[0]["avatar_url"] != url {
+		t.Fatalf("updates = %v, want avatar_url %s", repo.updates, url)
+	}
+}
+
+func TestUpdateAvatarRejectsInvalidImages(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+		want        error
+	}{
+		{"bad type", "image/png", []byte("<html></html>"), storage.ErrUnsupportedImageType},
+		{"declared mismatch", "image/gif", testPNG, storage.ErrUnsupportedImageType},
+		{"oversize", "image/png", append(append([]byte{}, testPNG...), make([]byte, 64)...), storage.ErrImageTooLarge},
+		{"empty", "image/png", nil, storage.ErrEmptyImage},
+	}
+	for _, tt := range tests {
+		svc, repo := newAvatarTestService(t, storage.NewLocalStorage(t.TempDir(), "https://img.example.com", 32))
+		if _, err := svc.UpdateAvatar(context.Background(), 7, tt.contentType, tt.data); !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+		if len(repo.updates) != 0 {
+			t.Errorf("%s: rejected image updated the user: %v", tt.name, repo.updates)
+		}
+	}
+}
+
+func TestUpdateAvatarStorageError(t *testing.T) {
+	svc, repo := newAvatarTestService(t, failingStorage{})
+
+	_, err := svc.UpdateAvatar(context.Background(), 7, "image/png", testPNG)
+	// 存储内部错误不透传给客户端
+	if err == nil || err.Error() != "upload failed" {
+		t.Fatalf("storage failure error = %v, want upload failed", err)
+	}
+	if len(repo.updates) != 0 {
+		t.Fatalf("failed upload updated the user: %v", repo.updates)
+	}
+}
+
+func TestUpdateAvatarUnknownUser(t *testing.T) {
+	svc, repo := newAvatarTestService(t, failingStorage{})
+	if _, err := svc.UpdateAvatar(context.Background(), 99, "image/png", testPNG); err == nil || err.Error() != "user not found" {
+		t.Fatalf("unknown user error = %v, want user not found", err)
+	}
+	if len(repo.updates) != 0 {
+		t.Fatalf("unknown user was updated: %v", repo.updates)
+	}
+}
+
+func TestUpdateUserInfoRejectsForeignAvatarURL(t *testing.T) {
+	svc, repo := newAvatarTestService(t, storage.NewLocalStorage(t.TempDir(), "https://img.example.com", 1024))
+	err := svc.UpdateUserInfo(context.Background(), 7, map[string]interface{}{"avatar_url": "https://evil.example.com/a.png"})
+	if err == nil {
+		t.Fatal("UpdateUserInfo accepted an avatar URL outside the image storage")
+	}
+	if len(repo.updates) != 0 {
+		t.Fatalf("foreign avatar URL was written: %v", repo.updates)
+	}
+}
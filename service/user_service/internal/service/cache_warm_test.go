@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"user_service/internal/model"
+)
+
+// warmUserRepo 记录预热写入的缓存，GetByIDs超过failAfter次后返回错误
+type warmUserRepo struct {
+	*batchUserRepo
+	hot       []uint32
+	hotErr    error
+	setErr    map[uint32]error
+	failAfter int
+	dbReads   int // GetByID调用次数
+}
+
+func newWarmUserRepo(ids ...uint32) *warmUserRepo {
+	repo := &warmUserRepo{
+		batchUserRepo: &batchUserRepo{db: make(map[uint32]*model.User), cache: make(map[uint32]*model.UserCache)},
+		setErr:        make(map[uint32]error),
+	}
+	for _, id := range ids {
+		repo.db[id] = testUser(id, model.UserStatusActive)
+	}
+	return repo
+}
+
+func (r *warmUserRepo) GetByIDs(ctx context.Context, userIDs []uint32) ([]*model.User, error) {
+	if r.failAfter > 0 && len(r.queries) >= r.failAfter {
+		return nil, errors.New("db down")
+	}
+	return r.batchUserRepo.GetByIDs(ctx, userIDs)
+}
+
+func (r *warmUserRepo) SetUserCache(ctx context.Context, userID uint32, userCache *model.UserCache, expiration time.Duration) error {
+	if err := r.setErr[userID]; err != nil {
+		return err
+	}
+	r.cache[userID] = userCache
+	return nil
+}
+
+func (r *warmUserRepo) GetUserFromCache(ctx context.Context, userID uint32) (*model.UserCache, error) {
+	if c, ok := r.cache[userID]; ok {
+		return c, nil
+	}
+	return nil, errors.New("cache not found")
+}
+
+func (r *warmUserRepo) GetByID(ctx context.Context, userID uint32) (*model.User, error) {
+	r.dbReads++
+	if user, ok := r.db[userID]; ok {
+		return user, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *warmUserRepo) ListHotUserIDs(ctx context.Context, limit int) ([]uint32, error) {
+	if r.hotErr != nil {
+		return nil, r.hotErr
+	}
+	if limit < len(r.hot) {
+		return r.hot[:limit], nil
+	}
+	return r.hot, nil
+}
+
+// seqIDs 返回1到n的用户ID
+func seqIDs(n int) []uint32 {
+	ids := make([]uint32, n)
+	for i := range ids {
+		ids[i] = uint32(i + 1)
+	}
+	return ids
+}
+
+func TestWarmUserCacheInBatches(t *testing.T) {
+	ids := seqIDs(2*warmBatchSize + 50)
+	repo := newWarmUserRepo(ids...)
+	s := &userService{logger: nopLogger{}, userRepo: repo}
+
+	warmed, err := s.WarmUserCache(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("WarmUserCache: %v", err)
+	}
+	if warmed != len(ids) || len(repo.cache) != len(ids) {
+		t.Errorf("warmed = %d with %d cached, want %d", warmed, len(repo.cache), len(ids))
+	}
+	var sizes []int
+	for _, q := range repo.queries {
+		sizes = append(sizes, len(q))
+	}
+	if want := []int{warmBatchSize, warmBatchSize, 50}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("batch sizes = %v, want %v", sizes, want)
+	}
+}
+
+func TestWarmUserCacheSkipsMissingAndFailedUsers(t *testing.T) {
+	repo := newWarmUserRepo(1, 2, 4)
+	// 已禁用的用户不会被数据库查询返回
+	repo.setErr[2] = errors.New("redis timeout")
+	s := &userService{logger: nopLogger{}, userRepo: repo}
+
+	warmed, err := s.WarmUserCache(context.Background(), []uint32{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("WarmUserCache: %v", err)
+	}
+	if warmed != 2 {
+		t.Errorf("warmed = %d, want 2", warmed)
+	}
+	for id, want := range map[uint32]bool{1: true, 2: false, 3: false, 4: true} {
+		if _, ok := repo.cache[id]; ok != want {
+			t.Errorf("user %d cached = %v, want %v", id, ok, want)
+		}
+	}
+}
+
+func TestWarmUserCacheStops(t *testing.T) {
+	t.Run("database error", func(t *testing.T) {
+		ids := seqIDs(warmBatchSize + 1)
+		repo := newWarmUserRepo(ids...)
+		repo.failAfter = 1
+		s := &userService{logger: nopLogger{}, userRepo: repo}
+
+		// 返回出错前已预热的用户数
+		warmed, err := s.WarmUserCache(context.Background(), ids)
+		if err == nil || warmed != warmBatchSize {
+			t.Errorf("WarmUserCache = (%d, %v), want the first batch warmed and an error", warmed, err)
+		}
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		repo := newWarmUserRepo(1)
+		s := &userService{logger: nopLogger{}, userRepo: repo}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		warmed, err := s.WarmUserCache(ctx, []uint32{1})
+		if !errors.Is(err, context.Canceled) || warmed != 0 || len(repo.queries) != 0 {
+			t.Errorf("WarmUserCache = (%d, %v) with %d queries, want canceled before querying", warmed, err, len(repo.queries))
+		}
+	})
+}
+
+func TestGetUserInfoServedFromWarmedCache(t *testing.T) {
+	repo := newWarmUserRepo(1)
+	s := &userService{logger: nopLogger{}, userRepo: repo}
+	if _, err := s.WarmUserCache(context.Background(), []uint32{1}); err != nil {
+		t.Fatalf("WarmUserCache: %v", err)
+	}
+
+	user, err := s.GetUserInfo(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetUserInfo: %v", err)
+	}
+	if user.ID != 1 || repo.dbReads != 0 {
+		t.Errorf("user %d with %d database reads, want user 1 from cache", user.ID, repo.dbReads)
+	}
+
+	// 旧版本写入的不完整缓存回源数据库并重新写入
+	repo.cache[1] = &model.UserCache{UserID: 1, Status: model.UserStatusActive}
+	if _, err := s.GetUserInfo(context.Background(), 1); err != nil {
+		t.Fatalf("GetUserInfo: %v", err)
+	}
+	if repo.dbReads != 1 || !repo.cache[1].Complete() {
+		t.Errorf("database reads = %d, cache complete %v; want the incomplete cache refreshed", repo.dbReads, repo.cache[1].Complete())
+	}
+}
+
+func TestGetHotUserIDs(t *testing.T) {
+	repo := newWarmUserRepo()
+	repo.hot = []uint32{5, 3, 9}
+	s := &userService{logger: nopLogger{}, userRepo: repo}
+
+	if got, err := s.GetHotUserIDs(context.Background(), 2); err != nil || !reflect.DeepEqual(got, []uint32{5, 3}) {
+		t.Errorf("GetHotUserIDs = (%v, %v), want [5 3]", got, err)
+	}
+	repo.hotErr = errors.New("db down")
+	if _, err := s.GetHotUserIDs(context.Background(), 2); err == nil {
+		t.Error("GetHotUserIDs succeeded although the query failed")
+	}
+}
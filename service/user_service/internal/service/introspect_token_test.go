@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeTokenBlacklist 内存中的token黑名单
+type fakeTokenBlacklist struct {
+	tokens map[string]time.Duration
+	err    error
+}
+
+func newFakeTokenBlacklist() *fakeTokenBlacklist {
+	return &fakeTokenBlacklist{tokens: make(map[string]time.Duration)}
+}
+
+func (b *fakeTokenBlacklist) BlacklistToken(ctx context.Context, token string, ttl time.Duration) error {
+	b.tokens[token] = ttl
+	return nil
+}
+
+func (b *fakeTokenBlacklist) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	if b.err != nil {
+		return false, b.err
+	}
+	_, ok := b.tokens[token]
+	return ok, nil
+}
+
+var introspectTestKey = SigningKey{ID: "k1", Secret: "access-secret", RefreshSecret: "refresh-secret"}
+
+// newIntrospectTestAuth 访问token有效期为tokenExpiration，刷新token有效期为一天
+func newIntrospectTestAuth(tokenExpiration time.Duration) (AuthService, *fakeTokenBlacklist) {
+	blacklist := newFakeTokenBlacklist()
+	return NewAuthService(introspectTestKey, nil, tokenExpiration, 24*time.Hour, blacklist), blacklist
+}
+
+func TestIntrospectValidToken(t *testing.T) {
+	auth, _ := newIntrospectTestAuth(time.Hour)
+	ctx := context.Background()
+	before := time.Now().Truncate(time.Second)
+
+	access, err := auth.GenerateToken(ctx, 7)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	refresh, err := auth.GenerateRefreshToken(ctx, 7)
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		wantType string
+		lifetime time.Duration
+	}{
+		{"access token", access, TokenTypeAccess, time.Hour},
+		{"refresh token", refresh, TokenTypeRefresh, 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := auth.IntrospectToken(ctx, tt.token)
+			if err != nil {
+				t.Fatalf("IntrospectToken: %v", err)
+			}
+			if !got.Active || got.UserID != 7 || got.TokenType != tt.wantType {
+				t.Errorf("introspection = %+v, want active %s token of user 7", got, tt.wantType)
+			}
+			if got.IssuedAt.Before(before) || got.ExpiresAt.Sub(got.IssuedAt) != tt.lifetime {
+				t.Errorf("issued %v expires %v, want a %v lifetime from now", got.IssuedAt, got.ExpiresAt, tt.lifetime)
+			}
+		})
+	}
+}
+
+func TestIntrospectExpiredToken(t *testing.T) {
+	auth, _ := newIntrospectTestAuth(-time.Minute)
+
+	token, err := auth.GenerateToken(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	got, err := auth.IntrospectToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("IntrospectToken: %v", err)
+	}
+	// 过期token仍返回声明，便于调用方判断需要刷新
+	if got.Active || got.UserID != 7 || got.TokenType != TokenTypeAccess || !got.ExpiresAt.Before(time.Now()) {
+		t.Errorf("introspection = %+v, want inactive access token of user 7 with its expiry", got)
+	}
+}
+
+func TestIntrospectBlacklistedToken(t *testing.T) {
+	auth, blacklist := newIntrospectTestAuth(time.Hour)
+	ctx := context.Background()
+
+	token, err := auth.GenerateToken(ctx, 7)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if err := auth.InvalidateToken(ctx, token); err != nil {
+		t.Fatalf("InvalidateToken: %v", err)
+	}
+	if ttl := blacklist.tokens[token]; ttl <= 0 || ttl > time.Hour {
+		t.Errorf("blacklist ttl = %v, want the token's remaining lifetime", ttl)
+	}
+
+	got, err := auth.IntrospectToken(ctx, token)
+	if err != nil {
+		t.Fatalf("IntrospectToken: %v", err)
+	}
+	if got.Active || got.UserID != 7 || got.TokenType != TokenTypeAccess {
+		t.Errorf("introspection = %+v, want inactive access token of user 7", got)
+	}
+}
+
+func TestIntrospectInvalidToken(t *testing.T) {
+	auth, _ := newIntrospectTestAuth(time.Hour)
+	other := NewAuthService(SigningKey{Secret: "other-secret", RefreshSecret: "other-refresh"}, nil, time.Hour, time.Hour, newFakeTokenBlacklist())
+	foreign, err := other.GenerateToken(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	for _, token := range []string{"not-a-jwt", "a.b.c", foreign} {
+		got, err := auth.IntrospectToken(context.Background(), token)
+		if err != nil {
+			t.Fatalf("IntrospectToken(%q): %v", token, err)
+		}
+		// 签名无效时不返回任何声明
+		if *got != (TokenIntrospection{}) {
+			t.Errorf("IntrospectToken(%q) = %+v, want an empty inactive result", token, got)
+		}
+	}
+}
+
+func TestIntrospectBlacklistError(t *testing.T) {
+	auth, blacklist := newIntrospectTestAuth(time.Hour)
+	blacklist.err = errors.New("redis down")
+
+	token, err := auth.GenerateToken(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if got, err := auth.IntrospectToken(context.Background(), token); !errors.Is(err, blacklist.err) || got != nil {
+		t.Errorf("IntrospectToken = (%+v, %v), want the blacklist error", got, err)
+	}
+}
+
+func TestUserServiceIntrospectEmptyToken(t *testing.T) {
+	svc := &userService{authService: &fakeAuthService{}}
+	if _, err := svc.IntrospectToken(context.Background(), ""); err == nil {
+		t.Error("IntrospectToken accepted an empty token")
+	}
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"user_service/internal/cache"
+	"user_service/internal/config"
+	"user_service/internal/model"
+	"user_service/internal/repository"
+)
+
+// nopLogger 测试用日志，丢弃所有输出
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// fakeUserRepo 按手机号保存用户的内存仓库，只实现登录流程用到的方法
+type fakeUserRepo struct {
+	repository.UserRepository
+	users    map[string]*model.User
+	sessions map[uint32]map[string]*model.UserSession
+	updates  []map[string]interface{}
+}
+
+func (r *fakeUserRepo) GetByID(ctx context.Context, userID uint32) (*model.User, error) {
+	for _, user := range r.users {
+		if user.ID == userID && user.Status == model.UserStatusActive {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeUserRepo) GetByPhoneAnyStatus(ctx context.Context, phone string) (*model.User, error) {
+	user, ok := r.users[phone]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepo) UpdateStatus(ctx context.Context, userID uint32, from []uint8, to uint8, reason string, bannedUntil *time.Time) error {
+	for _, user := range r.users {
+		if user.ID != userID {
+			continue
+		}
+		for _, status := range from {
+			if user.Status == status {
+				user.Status = to
+				user.BanReason = reason
+				user.BannedUntil = bannedUntil
+				return nil
+			}
+		}
+	}
+	return repository.ErrUserStatusConflict
+}
+
+func (r *fakeUserRepo) Update(ctx context.Context, userID uint32, updates map[string]interface{}) error {
+	r.updates = append(r.updates, updates)
+	return nil
+}
+
+func (r *fakeUserRepo) DeleteUserCache(ctx context.Context, userID uint32) error { return nil }
+
+func (r *fakeUserRepo) GetUserSessions(ctx context.Context, userID uint32) (map[string]*model.UserSession, error) {
+	sessions := make(map[string]*model.UserSession)
+	for deviceID, session := range r.sessions[userID] {
+		copied := *session
+		sessions[deviceID] = &copied
+	}
+	return sessions, nil
+}
+
+func (r *fakeUserRepo) DeleteUserSessions(ctx context.Context, userID uint32, deviceIDs ...string) error {
+	for _, deviceID := range deviceIDs {
+		delete(r.sessions[userID], deviceID)
+	}
+	return nil
+}
+
+func (r *fakeUserRepo) SetUserSession(ctx context.Context, userID uint32, deviceID string, session *model.UserSession, expiration time.Duration) error {
+	if r.sessions[userID] == nil {
+		r.sessions[userID] = make(map[string]*model.UserSession)
+	}
+	copied := *session
+	r.sessions[userID][deviceID] = &copied
+	return nil
+}
+
+func (r *fakeUserRepo) RotateUserSession(ctx context.Context, userID uint32, refreshToken string, session *model.UserSession, expiration time.Duration) (string, *model.UserSession, error) {
+	for deviceID, current := range r.sessions[userID] {
+		if current.RefreshToken == refreshToken {
+			copied := *session
+			r.sessions[userID][deviceID] = &copied
+			return deviceID, current, nil
+		}
+	}
+	return "", nil, repository.ErrSessionNotFound
+}
+
+// fakeCacheService 内存中的验证码缓存，不限制登录频率
+type fakeCacheService struct {
+	cache.CacheService
+	codes map[string]string
+}
+
+func (c *fakeCacheService) CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (c *fakeCacheService) GetSmsCode(ctx context.Context, phone string) (string, error) {
+	code, ok := c.codes[phone]
+	if !ok {
+		return "", errors.New("sms code not found")
+	}
+	return code, nil
+}
+
+func (c *fakeCacheService) DeleteSmsCode(ctx context.Context, phone string) error {
+	delete(c.codes, phone)
+	return nil
+}
+
+func (c *fakeCacheService) SetUser(ctx context.Context, userID uint32, user *model.UserCache, ttl time.Duration) error {
+	return nil
+}
+
+// fakeAuthService 签发带序号的三段式token，记录被作废的访问token
+type fakeAuthService struct {
+	AuthService
+	issued      int
+	invalidated map[string]bool
+}
+
+func (a *fakeAuthService) GenerateToken(ctx context.Context, userID uint32) (string, error) {
+	a.issued++
+	return fmt.Sprintf("access.%d.%d", userID, a.issued), nil
+}
+
+func (a *fakeAuthService) GenerateRefreshToken(ctx context.Context, userID uint32) (string, error) {
+	a.issued++
+	return fmt.Sprintf("refresh.%d.%d", userID, a.issued), nil
+}
+
+func (a *fakeAuthService) ParseRefreshToken(tokenString string) (uint32, error) {
+	var userID uint32
+	var n int
+	if _, err := fmt.Sscanf(tokenString, "refresh.%d.%d", &userID, &n); err != nil {
+		return 0, errors.New("invalid refresh token")
+	}
+	return userID, nil
+}
+
+func (a *fakeAuthService) VerifyToken(tokenString string) (uint32, error) {
+	var userID uint32
+	var n int
+	if _, err := fmt.Sscanf(tokenString, "access.%d.%d", &userID, &n); err != nil {
+		return 0, errors.New("invalid token")
+	}
+	return userID, nil
+}
+
+func (a *fakeAuthService) InvalidateToken(ctx context.Context, token string) error {
+	a.invalidated[token] = true
+	return nil
+}
+
+func (a *fakeAuthService) GetTokenExpiration() time.Duration { return time.Hour }
+
+func (a *fakeAuthService) GetRefreshTokenExpiration() time.Duration { return 24 * time.Hour }
+
+const testPhone = "13800138000"
+
+func newLoginTestService(t *testing.T, user *model.User, code string) (*userService, *fakeUserRepo) {
+	t.Helper()
+	repo := &fakeUserRepo{users: map[string]*model.User{}, sessions: map[uint32]map[string]*model.UserSession{}}
+	if user != nil {
+		repo.users[user.Phone] = user
+	}
+	codes := map[string]string{}
+	if code != "" {
+		codes[testPhone] = code
+	}
+	svc := &userService{
+		config:       &config.Config{},
+		logger:       nopLogger{},
+		userRepo:     repo,
+		cacheService: &fakeCacheService{codes: codes},
+		authService:  &fakeAuthService{invalidated: map[string]bool{}},
+	}
+	return svc, repo
+}
+
+func bannedUser(t *testing.T, until *time.Time) *model.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword: %v", err)
+	}
+	return &model.User{
+		ID:           7,
+		Phone:        testPhone,
+		PasswordHash: string(hash),
+		Status:       model.UserStatusBanned,
+		BanReason:    "spam",
+		BannedUntil:  until,
+	}
+}
+
+func TestCodeLoginChecksBanAfterCode(t *testing.T) {
+	svc, _ := newLoginTestService(t, bannedUser(t, nil), "123456")
+
+	// 验证码错误时不能暴露用户是否被封禁
+	if _, _, err := svc.CodeLogin(context.Background(), testPhone, "654321", "", "", ""); err == nil || errors.Is(err, ErrUserBanned) {
+		t.Fatalf("wrong code error = %v, want code mismatch", err)
+	}
+
+	if _, _, err := svc.CodeLogin(context.Background(), testPhone, "123456", "", "", ""); !errors.Is(err, ErrUserBanned) {
+		t.Fatalf("valid code error = %v, want ErrUserBanned", err)
+	}
+}
+
+func TestPhoneLoginChecksBanAfterPassword(t *testing.T) {
+	svc, _ := newLoginTestService(t, bannedUser(t, nil), "")
+
+	if _, _, err := svc.PhoneLogin(context.Background(), testPhone, "wrong123", "", "", ""); err == nil || errors.Is(err, ErrUserBanned) {
+		t.Fatalf("wrong password error = %v, want invalid password", err)
+	}
+
+	if _, _, err := svc.PhoneLogin(context.Background(), testPhone, "secret123", "", "", ""); !errors.Is(err, ErrUserBanned) {
+		t.Fatalf("valid password error = %v, want ErrUserBanned", err)
+	}
+}
+
+func TestCodeLoginLiftsExpiredBan(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	svc, repo := newLoginTestService(t, bannedUser(t, &expired), "123456")
+
+	user, tokens, err := svc.CodeLogin(context.Background(), testPhone, "123456", "", "", "")
+	if err != nil {
+		t.Fatalf("CodeLogin: %v", err)
+	}
+	if tokens.Token == "" || user.ID != 7 {
+		t.Fatalf("login = user %d token %q, want existing user with token", user.ID, tokens.Token)
+	}
+	if repo.users[testPhone].Status != model.UserStatusActive {
+		t.Fatalf("status = %d, want expired ban lifted", repo.users[testPhone].Status)
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRefreshTokenRejectsMalformedTokens(t *testing.T) {
+	svc, repo := newLoginTestService(t, activeUser(t), "")
+	auth := svc.authService.(*fakeAuthService)
+	login := loginOn(t, svc, "phone")
+	issued := auth.issued
+
+	malformed := []string{
+		"",
+		"not-a-jwt",
+		"header.payload",
+		"a.b.c.d",
+		"x.y.z",                                // 三段式但无法解析
+		login.Token,                            // 访问token不能用于刷新
+		login.Token + "|" + login.RefreshToken, // 旧的竖线拼接格式
+	}
+	for _, token := range malformed {
+		pair, err := svc.RefreshToken(context.Background(), token)
+		if err == nil || pair != nil {
+			t.Errorf("RefreshToken(%q) = %+v, %v; want error without tokens", token, pair, err)
+		}
+	}
+	if auth.issued != issued {
+		t.Errorf("malformed refresh tokens issued %d new tokens", auth.issued-issued)
+	}
+	if session := repo.sessions[7]["phone"]; session.RefreshToken != login.RefreshToken {
+		t.Errorf("malformed refresh rotated the session to %+v", session)
+	}
+}
+
+func TestRefreshTokenReturnsTypedPair(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	login := loginOn(t, svc, "phone")
+
+	before := time.Now()
+	pair, err := svc.RefreshToken(context.Background(), login.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if pair.Token == "" || pair.RefreshToken == "" || pair.Token == pair.RefreshToken {
+		t.Fatalf("pair = %+v, want distinct access and refresh tokens", pair)
+	}
+	// 过期时间按访问token有效期计算
+	if pair.ExpiresAt.Before(before.Add(time.Hour)) || pair.ExpiresAt.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expires at %v, want one hour after refresh", pair.ExpiresAt)
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"strconv"
+
+	"user_service/internal/model"
+	"user_service/pkg/searchindex"
+)
+
+// userIndexFields 参与搜索的用户字段，其余字段变更不发布索引事件
+var userIndexFields = []string{"username", "nickname", "avatar_url", "signature", "is_verified", "user_type", "status"}
+
+// indexUser 发布完整的用户索引事件
+func (s *userService) indexUser(user *model.User) {
+	s.indexer.Publish(searchindex.Event{
+		Op:      searchindex.OpUpsert,
+		DocType: searchindex.DocTypeUser,
+		ID:      strconv.FormatUint(uint64(user.ID), 10),
+		Fields: map[string]interface{}{
+			"username":    user.Username,
+			"nickname":    user.Nickname,
+			"avatar_url":  user.AvatarURL,
+			"signature":   user.Signature,
+			"is_verified": user.IsVerified,
+			"user_type":   user.UserType,
+			"status":      user.Status,
+			"created_at":  user.CreatedAt.Unix(),
+		},
+	})
+}
+
+// indexUserUpdates 发布用户部分字段的索引事件，只包含参与搜索的字段
+func (s *userService) indexUserUpdates(userID uint32, updates map[string]interface{}) {
+	fields := make(map[string]interface{})
+	for _, field := range userIndexFields {
+		if v, ok := updates[field]; ok {
+			fields[field] = v
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	s.indexer.Publish(searchindex.Event{
+		Op:      searchindex.OpUpsert,
+		DocType: searchindex.DocTypeUser,
+		ID:      strconv.FormatUint(uint64(userID), 10),
+		Fields:  fields,
+	})
+}
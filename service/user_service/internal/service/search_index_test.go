@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"user_service/internal/model"
+	"user_service/pkg/searchindex"
+)
+
+// recordingSink 记录投递的索引事件
+type recordingSink struct {
+	mu     sync.Mutex
+	events []searchindex.Event
+}
+
+func (s *recordingSink) Push(ctx context.Context, event *searchindex.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, *event)
+	return nil
+}
+
+// withIndexer 为服务设置索引发布器，返回的函数停止发布器并返回已投递的事件
+func withIndexer(t *testing.T, svc *userService) func() []searchindex.Event {
+	t.Helper()
+	sink := &recordingSink{}
+	svc.indexer = searchindex.NewPublisher(sink, 0, nopLogger{})
+	svc.indexer.Start()
+	return func() []searchindex.Event {
+		t.Helper()
+		if err := svc.indexer.Stop(context.Background()); err != nil {
+			t.Fatalf("stop indexer: %v", err)
+		}
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return sink.events
+	}
+}
+
+func TestUpdateUserInfoIndexesSearchableFields(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	events := withIndexer(t, svc)
+
+	err := svc.UpdateUserInfo(context.Background(), 7, map[string]interface{}{
+		"nickname":  "小明",
+		"signature": "hello",
+		"gender":    1,
+	})
+	if err != nil {
+		t.Fatalf("UpdateUserInfo: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 {
+		t.Fatalf("index events = %v, want 1", got)
+	}
+	event := got[0]
+	if event.Op != searchindex.OpUpsert || event.DocType != searchindex.DocTypeUser || event.ID != "7" {
+		t.Errorf("event = %s %s %s, want upsert user 7", event.Op, event.DocType, event.ID)
+	}
+	// 只发布参与搜索的字段
+	if len(event.Fields) != 2 || event.Fields["nickname"] != "小明" || event.Fields["signature"] != "hello" {
+		t.Errorf("fields = %v, want nickname and signature only", event.Fields)
+	}
+}
+
+func TestUpdateUserInfoSkipsIndexForUnsearchableFields(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	events := withIndexer(t, svc)
+
+	if err := svc.UpdateUserInfo(context.Background(), 7, map[string]interface{}{"gender": 2, "birthday": "2000-01-01"}); err != nil {
+		t.Fatalf("UpdateUserInfo: %v", err)
+	}
+	if got := events(); len(got) != 0 {
+		t.Errorf("index events = %v, want none", got)
+	}
+}
+
+func TestUpdateUserInfoUnknownUserDoesNotIndex(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	events := withIndexer(t, svc)
+
+	if err := svc.UpdateUserInfo(context.Background(), 99, map[string]interface{}{"nickname": "小明"}); err == nil {
+		t.Fatal("UpdateUserInfo succeeded for an unknown user")
+	}
+	if got := events(); len(got) != 0 {
+		t.Errorf("index events = %v, want none", got)
+	}
+}
+
+func TestLiftedBanIndexesActiveStatus(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	svc, _ := newLoginTestService(t, bannedUser(t, &expired), "123456")
+	events := withIndexer(t, svc)
+
+	if _, _, err := svc.CodeLogin(context.Background(), testPhone, "123456", "", "", ""); err != nil {
+		t.Fatalf("CodeLogin: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 || got[0].ID != "7" || got[0].Fields["status"] != model.UserStatusActive {
+		t.Errorf("index events = %v, want user 7 back to active", got)
+	}
+}
+
+func TestNilIndexerIsNoop(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	if err := svc.UpdateUserInfo(context.Background(), 7, map[string]interface{}{"nickname": "小明"}); err != nil {
+		t.Fatalf("UpdateUserInfo without indexer: %v", err)
+	}
+}
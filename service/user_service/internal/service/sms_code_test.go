@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user_service/internal/config"
+)
+
+// smsCodeCache 在windowLimiter基础上记录写入的验证码和有效期
+type smsCodeCache struct {
+	*windowLimiter
+	codes map[string]string
+	ttls  map[string]time.Duration
+}
+
+func (c *smsCodeCache) SetSmsCode(ctx context.Context, phone, code string, ttl time.Duration) error {
+	c.codes[phone], c.ttls[phone] = code, ttl
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGenerateCodeLength(t *testing.T) {
+	for _, length := range []int{4, 6, 10} {
+		sms := NewSmsService("", "", "", "", length)
+		for i := 0; i < 20; i++ {
+			if code := sms.GenerateCode(); len(code) != length || !isDigits(code) {
+				t.Fatalf("GenerateCode() = %q, want %d digits", code, length)
+			}
+		}
+	}
+}
+
+func TestValidateSmsCodeFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		length int
+		code   string
+		valid  bool
+	}{
+		{"default length", 0, "123456", true},
+		{"default length too short", 0, "1234", false},
+		{"configured length", 4, "1234", true},
+		{"configured length too long", 4, "123456", false},
+		{"non digits", 4, "12a4", false},
+		{"empty", 4, "", false},
+		{"clamped to max", 20, "1234567890", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.SMS.CodeLength = tt.length
+			svc := &userService{config: cfg}
+			if err := svc.validateSmsCodeFormat(tt.code); (err == nil) != tt.valid {
+				t.Errorf("validateSmsCodeFormat(%q) error = %v, want valid %v", tt.code, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestSendSmsCodeUsesConfiguredLengthAndTTL(t *testing.T) {
+	tests := []struct {
+		name       string
+		sms        config.SMSConfig
+		wantLength int
+		wantTTL    time.Duration
+	}{
+		{"configured", config.SMSConfig{CodeLength: 4, CodeTTL: 2 * time.Minute}, 4, 2 * time.Minute},
+		{"defaults", config.SMSConfig{}, 6, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, limiter, _ := newSmsGuardTestService(config.SmsAbuseConfig{})
+			svc.config.SMS = tt.sms
+			codes := &smsCodeCache{windowLimiter: limiter, codes: make(map[string]string), ttls: make(map[string]time.Duration)}
+			svc.cacheService = codes
+			svc.smsService = NewSmsService("", "", "", "", svc.config.SMS.WithDefaults().CodeLength)
+
+			if err := svc.SendSmsCode(context.Background(), testPhone, "1.2.3.4"); err != nil {
+				t.Fatalf("SendSmsCode: %v", err)
+			}
+			code := codes.codes[testPhone]
+			if len(code) != tt.wantLength || !isDigits(code) {
+				t.Errorf("stored code = %q, want %d digits", code, tt.wantLength)
+			}
+			if ttl := codes.ttls[testPhone]; ttl != tt.wantTTL {
+				t.Errorf("stored ttl = %v, want %v", ttl, tt.wantTTL)
+			}
+			// 发出的验证码能通过登录时的格式校验
+			if err := svc.validateSmsCodeFormat(code); err != nil {
+				t.Errorf("validateSmsCodeFormat(%q): %v", code, err)
+			}
+		})
+	}
+}
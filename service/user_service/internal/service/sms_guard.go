@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrSmsIPBlocked 客户端IP因发送异常被临时封禁
+var ErrSmsIPBlocked = errors.New("当前网络发送验证码过于频繁，请稍后再试")
+
+// checkSmsAbuse 按客户端IP检查短信发送是否异常
+// 同一IP频繁发送或短时间内使用过多不同手机号时拒绝发送，后者会临时封禁该IP；
+// 未获取到客户端IP时只做手机号维度的限制
+func (s *userService) checkSmsAbuse(ctx context.Context, phone, clientIP string) error {
+	if clientIP == "" {
+		return nil
+	}
+	cfg := s.config.SMS.Abuse.WithDefaults()
+
+	blocked, err := s.userRepo.IsSmsIPBlocked(ctx, clientIP)
+	if err != nil {
+		s.logger.Error("Failed to check sms ip block", "ip", clientIP, "error", err)
+		return err
+	}
+	if blocked {
+		s.logger.Warn("SMS send rejected, client ip is blocked", "phone", phone, "ip", clientIP)
+		return ErrSmsIPBlocked
+	}
+
+	allowed, err := s.cacheService.CheckRateLimit(ctx, fmt.Sprintf("sms_send_ip:%s", clientIP), cfg.IPLimit, cfg.IPWindow)
+	if err != nil {
+		s.logger.Error("Failed to check ip rate limit", "ip", clientIP, "error", err)
+		return fmt.Errorf("failed to check ip rate limit: %w", err)
+	}
+	if !allowed {
+		s.logger.Warn("SMS send ip rate limit exceeded", "phone", phone, "ip", clientIP)
+		return fmt.Errorf("发送过于频繁，请稍后再试")
+	}
+
+	phones, err := s.userRepo.RecordSmsIPPhone(ctx, clientIP, phone, cfg.PhoneWindow)
+	if err != nil {
+		s.logger.Error("Failed to record sms ip phone", "ip", clientIP, "error", err)
+		return err
+	}
+	if phones > int64(cfg.MaxPhonesPerIP) {
+		if err := s.userRepo.BlockSmsIP(ctx, clientIP, cfg.BlockDuration); err != nil {
+			s.logger.Error("Failed to block sms ip", "ip", clientIP, "error", err)
+		}
+		s.logger.Warn("Too many distinct phones from one ip, blocking", "ip", clientIP, "phones", phones, "blockDuration", cfg.BlockDuration)
+		return ErrSmsIPBlocked
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_service/internal/cache"
+	"user_service/internal/config"
+	"user_service/internal/repository"
+)
+
+// testClock 测试用可调时钟
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// smsGuardRepo 内存实现的IP封禁和IP手机号记录，按testClock判断过期
+type smsGuardRepo struct {
+	repository.UserRepository
+	clock *testClock
+
+	blockedUntil map[string]time.Time
+	phones       map[string]map[string]time.Time
+	// phoneWindows 每次记录手机号时传入的统计窗口
+	phoneWindows []time.Duration
+	blockErr     error
+}
+
+func newSmsGuardRepo(clock *testClock) *smsGuardRepo {
+	return &smsGuardRepo{
+		clock:        clock,
+		blockedUntil: make(map[string]time.Time),
+		phones:       make(map[string]map[string]time.Time),
+	}
+}
+
+func (r *smsGuardRepo) IsSmsIPBlocked(ctx context.Context, ip string) (bool, error) {
+	if r.blockErr != nil {
+		return false, r.blockErr
+	}
+	until, ok := r.blockedUntil[ip]
+	return ok && r.clock.now.Before(until), nil
+}
+
+func (r *smsGuardRepo) BlockSmsIP(ctx context.Context, ip string, duration time.Duration) error {
+	r.blockedUntil[ip] = r.clock.now.Add(duration)
+	return nil
+}
+
+func (r *smsGuardRepo) RecordSmsIPPhone(ctx context.Context, ip, phone string, window time.Duration) (int64, error) {
+	r.phoneWindows = append(r.phoneWindows, window)
+	phones := r.phones[ip]
+	if phones == nil {
+		phones = make(map[string]time.Time)
+		r.phones[ip] = phones
+	}
+	for p, at := range phones {
+		if !at.After(r.clock.now.Add(-window)) {
+			delete(phones, p)
+		}
+	}
+	phones[phone] = r.clock.now
+	return int64(len(phones)), nil
+}
+
+// windowLimiter 固定窗口计数的频率限制，记录每个键使用的次数上限和窗口
+type windowLimiter struct {
+	cache.CacheService
+	clock *testClock
+
+	windowStart map[string]time.Time
+	counts      map[string]int
+	limits      map[string]int
+	windows     map[string]time.Duration
+}
+
+func newWindowLimiter(clock *testClock) *windowLimiter {
+	return &windowLimiter{
+		clock:       clock,
+		windowStart: make(map[string]time.Time),
+		counts:      make(map[string]int),
+		limits:      make(map[string]int),
+		windows:     make(map[string]time.Duration),
+	}
+}
+
+func (l *windowLimiter) CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	l.limits[key], l.windows[key] = limit, window
+	if start, ok := l.windowStart[key]; !ok || !l.clock.now.Before(start.Add(window)) {
+		l.windowStart[key] = l.clock.now
+		l.counts[key] = 0
+	}
+	l.counts[key]++
+	return l.counts[key] <= limit, nil
+}
+
+func newSmsGuardTestService(abuse config.SmsAbuseConfig) (*userService, *smsGuardRepo, *windowLimiter, *testClock) {
+	clock := &testClock{now: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	repo := newSmsGuardRepo(clock)
+	limiter := newWindowLimiter(clock)
+	cfg := &config.Config{}
+	cfg.SMS.Abuse = abuse
+	return &userService{config: cfg, logger: nopLogger{}, userRepo: repo, cacheService: limiter}, repo, limiter, clock
+}
+
+func TestCheckSmsAbuseIPRateLimit(t *testing.T) {
+	svc, _, _, clock := newSmsGuardTestService(config.SmsAbuseConfig{IPLimit: 3, IPWindow: time.Minute, MaxPhonesPerIP: 10})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := svc.checkSmsAbuse(ctx, "13800138000", "1.2.3.4"); err != nil {
+			t.Fatalf("send %d: %v", i+1, err)
+		}
+	}
+	err := svc.checkSmsAbuse(ctx, "13800138000", "1.2.3.4")
+	if err == nil || errors.Is(err, ErrSmsIPBlocked) {
+		t.Fatalf("send over the ip limit error = %v, want rate limited without a block", err)
+	}
+	// 其他IP不受影响
+	if err := svc.checkSmsAbuse(ctx, "13800138000", "5.6.7.8"); err != nil {
+		t.Errorf("other ip: %v", err)
+	}
+
+	clock.advance(time.Minute)
+	if err := svc.checkSmsAbuse(ctx, "13800138000", "1.2.3.4"); err != nil {
+		t.Errorf("send after the ip window: %v", err)
+	}
+}
+
+func TestCheckSmsAbuseBlocksPhoneRotation(t *testing.T) {
+	svc, repo, _, clock := newSmsGuardTestService(config.SmsAbuseConfig{
+		IPLimit:        100,
+		MaxPhonesPerIP: 3,
+		PhoneWindow:    time.Hour,
+		BlockDuration:  30 * time.Minute,
+	})
+	ctx := context.Background()
+
+	for _, phone := range []string{"13800000001", "13800000002", "13800000003", "13800000001"} {
+		if err := svc.checkSmsAbuse(ctx, phone, "1.2.3.4"); err != nil {
+			t.Fatalf("phone %s within the limit: %v", phone, err)
+		}
+	}
+	if err := svc.checkSmsAbuse(ctx, "13800000004", "1.2.3.4"); !errors.Is(err, ErrSmsIPBlocked) {
+		t.Fatalf("fourth distinct phone error = %v, want ErrSmsIPBlocked", err)
+	}
+	if until := repo.blockedUntil["1.2.3.4"]; !until.Equal(clock.now.Add(30 * time.Minute)) {
+		t.Errorf("blocked until %v, want the configured block duration", until)
+	}
+
+	// 封禁期内已用过的手机号也被拒绝
+	clock.advance(29 * time.Minute)
+	if err := svc.checkSmsAbuse(ctx, "13800000001", "1.2.3.4"); !errors.Is(err, ErrSmsIPBlocked) {
+		t.Errorf("send during block error = %v, want ErrSmsIPBlocked", err)
+	}
+	if err := svc.checkSmsAbuse(ctx, "13800000005", "5.6.7.8"); err != nil {
+		t.Errorf("other ip during block: %v", err)
+	}
+}
+
+func TestCheckSmsAbusePhoneWindowExpires(t *testing.T) {
+	svc, _, _, clock := newSmsGuardTestService(config.SmsAbuseConfig{IPLimit: 100, MaxPhonesPerIP: 2, PhoneWindow: 10 * time.Minute})
+	ctx := context.Background()
+
+	for _, phone := range []string{"13800000001", "13800000002"} {
+		if err := svc.checkSmsAbuse(ctx, phone, "1.2.3.4"); err != nil {
+			t.Fatalf("phone %s: %v", phone, err)
+		}
+	}
+	// 窗口外的手机号不再计入
+	clock.advance(10 * time.Minute)
+	for _, phone := range []string{"13800000003", "13800000004"} {
+		if err := svc.checkSmsAbuse(ctx, phone, "1.2.3.4"); err != nil {
+			t.Fatalf("phone %s after the window: %v", phone, err)
+		}
+	}
+}
+
+func TestCheckSmsAbuseDefaults(t *testing.T) {
+	svc, repo, limiter, _ := newSmsGuardTestService(config.SmsAbuseConfig{})
+
+	if err := svc.checkSmsAbuse(context.Background(), "13800138000", "1.2.3.4"); err != nil {
+		t.Fatalf("checkSmsAbuse: %v", err)
+	}
+	key := "sms_send_ip:1.2.3.4"
+	if limiter.limits[key] != 10 || limiter.windows[key] != 10*time.Minute {
+		t.Errorf("ip limit = %d per %v, want 10 per 10m", limiter.limits[key], limiter.windows[key])
+	}
+	if len(repo.phoneWindows) != 1 || repo.phoneWindows[0] != time.Hour {
+		t.Errorf("phone windows = %v, want 1h", repo.phoneWindows)
+	}
+}
+
+func TestCheckSmsAbuseWithoutClientIP(t *testing.T) {
+	svc, repo, limiter, _ := newSmsGuardTestService(config.SmsAbuseConfig{})
+	repo.blockErr = errors.New("redis down")
+
+	if err := svc.checkSmsAbuse(context.Background(), "13800138000", ""); err != nil {
+		t.Fatalf("checkSmsAbuse without ip: %v", err)
+	}
+	if len(limiter.counts) != 0 || len(repo.phoneWindows) != 0 {
+		t.Errorf("ip checks ran without a client ip: limits %v phones %v", limiter.counts, repo.phoneWindows)
+	}
+}
+
+func TestCheckSmsAbuseBlockCheckError(t *testing.T) {
+	svc, repo, _, _ := newSmsGuardTestService(config.SmsAbuseConfig{})
+	repo.blockErr = errors.New("redis down")
+
+	if err := svc.checkSmsAbuse(context.Background(), "13800138000", "1.2.3.4"); err == nil {
+		t.Fatal("checkSmsAbuse succeeded when the block check failed")
+	}
+}
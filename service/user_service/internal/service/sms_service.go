@@ -21,15 +21,18 @@ type smsService struct {
 	secretKey    string
 	signName     string
 	templateCode string
+	codeLength   int
 }
 
 // NewSmsService 创建短信服务
-func NewSmsService(accessKey, secretKey, signName, templateCode string) SmsService {
+// codeLength为验证码位数
+func NewSmsService(accessKey, secretKey, signName, templateCode string, codeLength int) SmsService {
 	return &smsService{
 		accessKey:    accessKey,
 		secretKey:    secretKey,
 		signName:     signName,
 		templateCode: templateCode,
+		codeLength:   codeLength,
 	}
 }
 
@@ -77,9 +80,13 @@ func (s *smsService) SendCode(ctx context.Context, phone, code string) error {
 	return nil
 }
 
-// GenerateCode 生成6位随机验证码
+// GenerateCode 生成codeLength位随机数字验证码
 func (s *smsService) GenerateCode() string {
 	// 使用更安全的随机数生成方式
 	rand.Seed(time.Now().UnixNano() + int64(rand.Intn(1000)))
-	return fmt.Sprintf("%06d", rand.Intn(1000000))
+	code := make([]byte, s.codeLength)
+	for i := range code {
+		code[i] = byte('0' + rand.Intn(10))
+	}
+	return string(code)
 }
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"user_service/internal/model"
+	"user_service/internal/repository"
+)
+
+var (
+	// ErrUserNotFound 用户不存在
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserBanned 用户已被封禁
+	ErrUserBanned = errors.New("user account is banned")
+	// ErrUserNotBanned 用户不是封禁状态，无需解封
+	ErrUserNotBanned = errors.New("user is not banned")
+)
+
+// 管理后台查询用户的分页大小
+const (
+	defaultListUsersPageSize = 20
+	maxListUsersPageSize     = 100
+)
+
+// BanUser 封禁用户，duration<=0表示永久封禁，返回被注销的登录会话数
+// 封禁后用户所有设备上的token加入黑名单，并清除用户缓存，VerifyToken和登录立即失败
+func (s *userService) BanUser(ctx context.Context, userID uint32, reason string, duration time.Duration) (int, error) {
+	s.logger.Info("BanUser service called", "userID", userID, "duration", duration)
+
+	if _, err := s.getUserAnyStatus(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	var bannedUntil *time.Time
+	if duration > 0 {
+		until := time.Now().Add(duration)
+		bannedUntil = &until
+	}
+	// 已封禁的用户允许重复封禁，用于修改封禁原因和期限
+	from := []uint8{model.UserStatusActive, model.UserStatusBanned}
+	if err := s.userRepo.UpdateStatus(ctx, userID, from, model.UserStatusBanned, reason, bannedUntil); err != nil {
+		if errors.Is(err, repository.ErrUserStatusConflict) {
+			return 0, errors.New("user account is disabled")
+		}
+		s.logger.Error("Failed to ban user", "userID", userID, "error", err)
+		return 0, errors.New("database error")
+	}
+	s.indexUserUpdates(userID, map[string]interface{}{"status": model.UserStatusBanned})
+
+	revoked, err := s.revokeUserSessions(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to revoke user sessions", "userID", userID, "error", err)
+	}
+	if err := s.userRepo.DeleteUserCache(ctx, userID); err != nil {
+		s.logger.Error("Failed to clear user cache", "error", err)
+	}
+
+	s.logger.Info("User banned", "userID", userID, "reason", reason, "bannedUntil", bannedUntil, "revokedSessions", revoked)
+	return revoked, nil
+}
+
+// UnbanUser 解除用户封禁，用户需要重新登录
+func (s *userService) UnbanUser(ctx context.Context, userID uint32) error {
+	s.logger.Info("UnbanUser service called", "userID", userID)
+
+	if _, err := s.getUserAnyStatus(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.liftBan(ctx, userID); err != nil {
+		return err
+	}
+
+	s.logger.Info("User unbanned", "userID", userID)
+	return nil
+}
+
+// ListUsers 按条件分页查询用户，包含任意状态的用户，page从1开始
+func (s *userService) ListUsers(ctx context.Context, filter repository.UserFilter, page, pageSize int) ([]*model.User, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultListUsersPageSize
+	}
+	if pageSize > maxListUsersPageSize {
+		pageSize = maxListUsersPageSize
+	}
+	filter.Offset = (page - 1) * pageSize
+	filter.Limit = pageSize
+
+	users, total, err := s.userRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list users", "error", err)
+		return nil, 0, errors.New("database error")
+	}
+	return users, total, nil
+}
+
+// checkLoginBan 凭证校验通过后检查用户的封禁状态，必须在校验密码或验证码之后调用，避免未持有凭证的调用方探测封禁状态
+// 封禁中的用户拒绝登录；封禁已到期的用户自动解封后继续登录
+func (s *userService) checkLoginBan(ctx context.Context, user *model.User) error {
+	if user.Status != model.UserStatusBanned {
+		return nil
+	}
+	if user.IsBanned(time.Now()) {
+		s.logger.Warn("Login rejected, user is banned", "userID", user.ID)
+		return ErrUserBanned
+	}
+
+	if err := s.liftBan(ctx, user.ID); err != nil && !errors.Is(err, ErrUserNotBanned) {
+		return err
+	}
+	user.Status = model.UserStatusActive
+	user.BanReason = ""
+	user.BannedUntil = nil
+	s.logger.Info("Expired ban lifted", "userID", user.ID)
+	return nil
+}
+
+// liftBan 将封禁状态的用户恢复为正常状态并清除用户缓存
+func (s *userService) liftBan(ctx context.Context, userID uint32) error {
+	from := []uint8{model.UserStatusBanned}
+	if err := s.userRepo.UpdateStatus(ctx, userID, from, model.UserStatusActive, "", nil); err != nil {
+		if errors.Is(err, repository.ErrUserStatusConflict) {
+			return ErrUserNotBanned
+		}
+		s.logger.Error("Failed to unban user", "userID", userID, "error", err)
+		return errors.New("database error")
+	}
+	s.indexUserUpdates(userID, map[string]interface{}{"status": model.UserStatusActive})
+
+	if err := s.userRepo.DeleteUserCache(ctx, userID); err != nil {
+		s.logger.Error("Failed to clear user cache", "error", err)
+	}
+	return nil
+}
+
+// revokeUserSessions 作废用户所有设备上的登录token并删除登录会话，返回被注销的会话数
+func (s *userService) revokeUserSessions(ctx context.Context, userID uint32) (int, error) {
+	sessions, err := s.userRepo.GetUserSessions(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user sessions: %w", err)
+	}
+
+	devices := make([]string, 0, len(sessions))
+	for deviceID, session := range sessions {
+		if err := s.authService.InvalidateToken(ctx, session.Token); err != nil {
+			s.logger.Warn("Failed to invalidate token", "userID", userID, "deviceID", deviceID, "error", err)
+		}
+		devices = append(devices, deviceID)
+	}
+
+	if err := s.userRepo.DeleteUserSessions(ctx, userID, devices...); err != nil {
+		return 0, fmt.Errorf("failed to delete user sessions: %w", err)
+	}
+	return len(devices), nil
+}
+
+// getUserAnyStatus 获取任意状态的用户
+func (s *userService) getUserAnyStatus(ctx context.Context, userID uint32) (*model.User, error) {
+	user, err := s.userRepo.GetByIDAnyStatus(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		s.logger.Error("Failed to get user", "userID", userID, "error", err)
+		return nil, errors.New("database error")
+	}
+	return user, nil
+}
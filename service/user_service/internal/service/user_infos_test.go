@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"user_service/internal/config"
+	"user_service/internal/model"
+	"user_service/internal/repository"
+)
+
+// batchUserRepo 记录批量查询的内存仓库，db为数据库中的正常用户，cache为用户缓存
+type batchUserRepo struct {
+	repository.UserRepository
+	db    map[uint32]*model.User
+	cache map[uint32]*model.UserCache
+
+	cacheErr error
+	queries  [][]uint32 // 每次GetByIDs查询的用户ID
+	backfill []uint64   // 回填缓存的用户ID
+}
+
+func (r *batchUserRepo) GetUsersFromCache(ctx context.Context, userIDs []uint32) (map[uint32]*model.UserCache, error) {
+	if r.cacheErr != nil {
+		return nil, r.cacheErr
+	}
+	result := make(map[uint32]*model.UserCache)
+	for _, id := range userIDs {
+		if c, ok := r.cache[id]; ok {
+			result[id] = c
+		}
+	}
+	return result, nil
+}
+
+func (r *batchUserRepo) GetByIDs(ctx context.Context, userIDs []uint32) ([]*model.User, error) {
+	r.queries = append(r.queries, append([]uint32(nil), userIDs...))
+	var users []*model.User
+	for _, id := range userIDs {
+		if user, ok := r.db[id]; ok {
+			copied := *user
+			users = append(users, &copied)
+		}
+	}
+	return users, nil
+}
+
+func (r *batchUserRepo) SetUsersCache(ctx context.Context, userCaches []*model.UserCache, expiration time.Duration) error {
+	for _, c := range userCaches {
+		r.backfill = append(r.backfill, c.UserID)
+	}
+	return nil
+}
+
+func testUser(id uint32, status uint8) *model.User {
+	return &model.User{ID: id, Nickname: "user", Status: status, CreatedAt: time.Unix(1700000000, 0)}
+}
+
+func newUserInfosTestService(repo *batchUserRepo) *userService {
+	return &userService{config: &config.Config{}, logger: nopLogger{}, userRepo: repo}
+}
+
+// userIDs 返回用户ID列表
+func userIDs(users []*model.User) []uint32 {
+	ids := make([]uint32, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids
+}
+
+func TestGetUserInfosQueriesOnlyCacheMissesOnce(t *testing.T) {
+	repo := &batchUserRepo{
+		db: map[uint32]*model.User{
+			1: testUser(1, model.UserStatusActive),
+			2: testUser(2, model.UserStatusActive),
+			3: testUser(3, model.UserStatusActive),
+			4: testUser(4, model.UserStatusActive),
+		},
+		cache: map[uint32]*model.UserCache{
+			2: model.NewUserCache(testUser(2, model.UserStatusActive)),
+			4: model.NewUserCache(testUser(4, model.UserStatusActive)),
+		},
+	}
+	s := newUserInfosTestService(repo)
+
+	users, err := s.GetUserInfos(context.Background(), []uint32{3, 2, 1, 4})
+	if err != nil {
+		t.Fatalf("GetUserInfos: %v", err)
+	}
+
+	if got := userIDs(users); !reflect.DeepEqual(got, []uint32{3, 2, 1, 4}) {
+		t.Errorf("user ids = %v, want request order [3 2 1 4]", got)
+	}
+	if len(repo.queries) != 1 || !reflect.DeepEqual(repo.queries[0], []uint32{3, 1}) {
+		t.Errorf("database queries = %v, want one query for misses [3 1]", repo.queries)
+	}
+	if !reflect.DeepEqual(repo.backfill, []uint64{3, 1}) {
+		t.Errorf("cache backfill = %v, want [3 1]", repo.backfill)
+	}
+}
+
+func TestGetUserInfosAllCachedSkipsDatabase(t *testing.T) {
+	repo := &batchUserRepo{
+		cache: map[uint32]*model.UserCache{
+			1: model.NewUserCache(testUser(1, model.UserStatusActive)),
+			2: model.NewUserCache(testUser(2, model.UserStatusActive)),
+		},
+	}
+	s := newUserInfosTestService(repo)
+
+	users, err := s.GetUserInfos(context.Background(), []uint32{2, 1, 2})
+	if err != nil {
+		t.Fatalf("GetUserInfos: %v", err)
+	}
+	if got := userIDs(users); !reflect.DeepEqual(got, []uint32{2, 1, 2}) {
+		t.Errorf("user ids = %v, want [2 1 2]", got)
+	}
+	if len(repo.queries) != 0 {
+		t.Errorf("database queries = %v, want none", repo.queries)
+	}
+}
+
+func TestGetUserInfosReloadsStaleCacheAndSkipsMissingUsers(t *testing.T) {
+	incomplete := model.NewUserCache(testUser(2, model.UserStatusActive))
+	incomplete.CreatedAt = time.Time{}
+	repo := &batchUserRepo{
+		db: map[uint32]*model.User{
+			2: testUser(2, model.UserStatusActive),
+		},
+		cache: map[uint32]*model.UserCache{
+			1: model.NewUserCache(testUser(1, model.UserStatusBanned)), // 封禁前写入的缓存
+			2: incomplete,                                              // 旧版本写入的不完整缓存
+		},
+	}
+	s := newUserInfosTestService(repo)
+
+	users, err := s.GetUserInfos(context.Background(), []uint32{1, 2, 9})
+	if err != nil {
+		t.Fatalf("GetUserInfos: %v", err)
+	}
+	if got := userIDs(users); !reflect.DeepEqual(got, []uint32{2}) {
+		t.Errorf("user ids = %v, want only active user [2]", got)
+	}
+	if len(repo.queries) != 1 || !reflect.DeepEqual(repo.queries[0], []uint32{1, 2, 9}) {
+		t.Errorf("database queries = %v, want one query [1 2 9]", repo.queries)
+	}
+}
+
+func TestGetUserInfosFallsBackToDatabaseWhenCacheFails(t *testing.T) {
+	repo := &batchUserRepo{
+		db: map[uint32]*model.User{
+			1: testUser(1, model.UserStatusActive),
+			2: testUser(2, model.UserStatusActive),
+		},
+		cacheErr: errors.New("redis unavailable"),
+	}
+	s := newUserInfosTestService(repo)
+
+	users, err := s.GetUserInfos(context.Background(), []uint32{2, 1})
+	if err != nil {
+		t.Fatalf("GetUserInfos: %v", err)
+	}
+	if got := userIDs(users); !reflect.DeepEqual(got, []uint32{2, 1}) {
+		t.Errorf("user ids = %v, want [2 1]", got)
+	}
+	if len(repo.queries) != 1 {
+		t.Errorf("database queries = %v, want one", repo.queries)
+	}
+}
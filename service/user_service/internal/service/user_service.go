@@ -14,24 +14,46 @@ import (
 	"user_service/internal/config"
 	"user_service/internal/model"
 	"user_service/internal/repository"
+	"user_service/internal/storage"
 	"user_service/pkg/logger"
+	"user_service/pkg/searchindex"
 ) // UserService 用户服务接口
 
 type UserService interface {
 	// 用户认证相关
-	PhoneLogin(ctx context.Context, phone, password, deviceID, osType, appVersion string) (*model.User, string, error)
-	CodeLogin(ctx context.Context, phone, code, deviceID, osType, appVersion string) (*model.User, string, error)
-	SendSmsCode(ctx context.Context, phone string) error
+	PhoneLogin(ctx context.Context, phone, password, deviceID, osType, appVersion string) (*model.User, *TokenPair, error)
+	CodeLogin(ctx context.Context, phone, code, deviceID, osType, appVersion string) (*model.User, *TokenPair, error)
+	SendSmsCode(ctx context.Context, phone, clientIP string) error
 	VerifyToken(ctx context.Context, token string) (uint32, error)
-	RefreshToken(ctx context.Context, refreshToken string) (string, error)
+	IntrospectToken(ctx context.Context, token string) (*TokenIntrospection, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
 	Logout(ctx context.Context, token string) error
 
 	// 用户信息相关
 	GetUserInfo(ctx context.Context, userID uint32) (*model.User, error)
 	GetUserInfos(ctx context.Context, userIDs []uint32) ([]*model.User, error)
 	UpdateUserInfo(ctx context.Context, userID uint32, updates map[string]interface{}) error
+	UpdateAvatar(ctx context.Context, userID uint32, contentType string, data []byte) (string, error)
+
+	// 用户管理
+	BanUser(ctx context.Context, userID uint32, reason string, duration time.Duration) (int, error)
+	UnbanUser(ctx context.Context, userID uint32) error
+	ListUsers(ctx context.Context, filter repository.UserFilter, page, pageSize int) ([]*model.User, int64, error)
+
+	// 缓存预热
+	GetHotUserIDs(ctx context.Context, limit int) ([]uint32, error)
+	WarmUserCache(ctx context.Context, userIDs []uint32) (int, error)
 }
 
+// ErrRefreshTokenRevoked 刷新token所属的会话已被挤下线、退出登录，或刷新token已被使用过
+var ErrRefreshTokenRevoked = errors.New("refresh token has been revoked")
+
+// defaultDeviceID 客户端未上报设备ID时使用的默认设备
+const defaultDeviceID = "default"
+
+// warmBatchSize 预热用户缓存时每批从数据库读取的用户数
+const warmBatchSize = 100
+
 // userService 用户服务实现
 type userService struct {
 	config       *config.Config
@@ -40,10 +62,12 @@ type userService struct {
 	cacheService cache.CacheService
 	authService  AuthService
 	smsService   SmsService
+	imageStorage storage.Storage
+	indexer      *searchindex.Publisher
 }
 
-// NewUserService 创建用户服务
-func NewUserService(cfg *config.Config, log logger.Logger, userRepo repository.UserRepository, cacheService cache.CacheService, authService AuthService, smsService SmsService) UserService {
+// NewUserService 创建用户服务，indexer为nil时用户变更不发布搜索索引事件
+func NewUserService(cfg *config.Config, log logger.Logger, userRepo repository.UserRepository, cacheService cache.CacheService, authService AuthService, smsService SmsService, imageStorage storage.Storage, indexer *searchindex.Publisher) UserService {
 	return &userService{
 		config:       cfg,
 		logger:       log,
@@ -51,21 +75,23 @@ func NewUserService(cfg *config.Config, log logger.Logger, userRepo repository.U
 		cacheService: cacheService,
 		authService:  authService,
 		smsService:   smsService,
+		imageStorage: imageStorage,
+		indexer:      indexer,
 	}
 }
 
 // PhoneLogin 手机号登录
-func (s *userService) PhoneLogin(ctx context.Context, phone, password, deviceID, osType, appVersion string) (*model.User, string, error) {
+func (s *userService) PhoneLogin(ctx context.Context, phone, password, deviceID, osType, appVersion string) (*model.User, *TokenPair, error) {
 	s.logger.Info("PhoneLogin service called", "phone", phone)
 
 	// 验证手机号格式
 	if err := s.validatePhoneNumber(phone); err != nil {
-		return nil, "", fmt.Errorf("phone validation failed: %w", err)
+		return nil, nil, fmt.Errorf("phone validation failed: %w", err)
 	}
 
 	// 验证密码格式
 	if err := s.validatePassword(password); err != nil {
-		return nil, "", fmt.Errorf("password validation failed: %w", err)
+		return nil, nil, fmt.Errorf("password validation failed: %w", err)
 	}
 
 	// 检查登录频率限制
@@ -73,56 +99,55 @@ func (s *userService) PhoneLogin(ctx context.Context, phone, password, deviceID,
 	allowed, err := s.cacheService.CheckRateLimit(ctx, rateLimitKey, 5, time.Minute)
 	if err != nil {
 		s.logger.Error("Failed to check login rate limit", "phone", phone, "error", err)
-		return nil, "", fmt.Errorf("failed to check rate limit: %w", err)
+		return nil, nil, fmt.Errorf("failed to check rate limit: %w", err)
 	}
 
 	if !allowed {
 		s.logger.Warn("Login attempt rate limit exceeded", "phone", phone)
-		return nil, "", fmt.Errorf("登录尝试过于频繁，请稍后再试")
+		return nil, nil, fmt.Errorf("登录尝试过于频繁，请稍后再试")
 	}
 
-	// 从数据库获取用户
-	user, err := s.userRepo.GetByPhone(ctx, phone)
+	// 从数据库获取任意状态的用户，封禁状态在密码校验通过后再检查
+	user, err := s.userRepo.GetByPhoneAnyStatus(ctx, phone)
 	if err != nil {
 		s.logger.Error("Failed to query user", "error", err)
-		return nil, "", errors.New("user not found")
+		return nil, nil, errors.New("user not found")
 	}
 
-	// 将用户信息转换为缓存格式并存储到Redis
-	userCache := &model.UserCache{
-		UserID:          uint64(user.ID),
-		Username:        user.Username,
-		Nickname:        user.Nickname,
-		AvatarURL:       user.AvatarURL,
-		BackgroundImage: user.BackgroundImage,
-		Signature:       user.Signature,
-		IsVerified:      user.IsVerified,
-		UserType:        user.UserType,
-		Status:          user.Status,
-		UpdatedAt:       user.UpdatedAt,
+	// 验证密码（使用bcrypt加密比较）
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		s.logger.Error("Password verification failed", "error", err)
+		return nil, nil, errors.New("invalid password")
 	}
 
-	if cacheErr := s.cacheService.SetUser(ctx, user.ID, userCache, 30*time.Minute); cacheErr != nil {
-		s.logger.Warn("Failed to cache user", "phone", phone, "error", cacheErr)
-		// 不影响主流程，只记录警告
+	// 封禁中的用户不允许登录
+	if err := s.checkLoginBan(ctx, user); err != nil {
+		return nil, nil, err
 	}
 
 	// 检查用户状态
-	if user.Status != model.UserStatusActive {
-		return nil, "", errors.New("user account is disabled")
+	if !user.IsActive() {
+		return nil, nil, errors.New("user account is disabled")
 	}
 
-	// 验证密码（使用bcrypt加密比较）
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
-		s.logger.Error("Password verification failed", "error", err)
-		return nil, "", errors.New("invalid password")
+	// 将用户信息转换为缓存格式并存储到Redis
+	userCache := model.NewUserCache(user)
+
+	if cacheErr := s.cacheService.SetUser(ctx, user.ID, userCache, 30*time.Minute); cacheErr != nil {
+		s.logger.Warn("Failed to cache user", "phone", phone, "error", cacheErr)
+		// 不影响主流程，只记录警告
 	}
 
 	// 生成token
-	token, err := s.authService.GenerateToken(ctx, user.ID)
+	tokens, err := s.generateTokenPair(ctx, user.ID)
 	if err != nil {
 		s.logger.Error("Failed to generate token", "error", err)
-		return nil, "", fmt.Errorf("access token generation failed: %w", err)
+		return nil, nil, fmt.Errorf("access token generation failed: %w", err)
+	}
+
+	// 执行多端登录策略
+	if err := s.applyLoginPolicy(ctx, user, deviceID, tokens); err != nil {
+		s.logger.Error("Failed to apply login policy", "userID", user.ID, "error", err)
 	}
 
 	// 更新用户信息（如最后登录时间等）
@@ -139,21 +164,21 @@ func (s *userService) PhoneLogin(ctx context.Context, phone, password, deviceID,
 		s.logger.Error("Failed to clear user cache", "error", err)
 	}
 
-	return user, token, nil
+	return user, tokens, nil
 }
 
 // CodeLogin 验证码登录
-func (s *userService) CodeLogin(ctx context.Context, phone, code, deviceID, osType, appVersion string) (*model.User, string, error) {
+func (s *userService) CodeLogin(ctx context.Context, phone, code, deviceID, osType, appVersion string) (*model.User, *TokenPair, error) {
 	s.logger.Info("CodeLogin service called", "phone", phone)
 
 	// 验证手机号格式
 	if err := s.validatePhoneNumber(phone); err != nil {
-		return nil, "", fmt.Errorf("phone validation failed: %w", err)
+		return nil, nil, fmt.Errorf("phone validation failed: %w", err)
 	}
 
 	// 验证验证码格式
 	if err := s.validateSmsCodeFormat(code); err != nil {
-		return nil, "", fmt.Errorf("sms code validation failed: %w", err)
+		return nil, nil, fmt.Errorf("sms code validation failed: %w", err)
 	}
 
 	// 检查登录频率限制
@@ -161,25 +186,25 @@ func (s *userService) CodeLogin(ctx context.Context, phone, code, deviceID, osTy
 	allowed, err := s.cacheService.CheckRateLimit(ctx, rateLimitKey, 5, time.Minute)
 	if err != nil {
 		s.logger.Error("Failed to check login rate limit", "phone", phone, "error", err)
-		return nil, "", fmt.Errorf("failed to check rate limit: %w", err)
+		return nil, nil, fmt.Errorf("failed to check rate limit: %w", err)
 	}
 
 	if !allowed {
 		s.logger.Warn("Login attempt rate limit exceeded", "phone", phone)
-		return nil, "", fmt.Errorf("登录尝试过于频繁，请稍后再试")
+		return nil, nil, fmt.Errorf("登录尝试过于频繁，请稍后再试")
 	}
 
 	// 从缓存获取验证码
 	cachedCode, err := s.cacheService.GetSmsCode(ctx, phone)
 	if err != nil {
 		s.logger.Error("Failed to get SMS code", "phone", phone, "error", err)
-		return nil, "", fmt.Errorf("验证码不存在或已过期")
+		return nil, nil, fmt.Errorf("验证码不存在或已过期")
 	}
 
 	// 验证验证码
 	if cachedCode != code {
 		s.logger.Error("SMS code mismatch", "phone", phone, "cachedCode", cachedCode, "inputCode", code)
-		return nil, "", fmt.Errorf("验证码错误")
+		return nil, nil, fmt.Errorf("验证码错误")
 	}
 
 	// 删除已使用的验证码
@@ -188,10 +213,19 @@ func (s *userService) CodeLogin(ctx context.Context, phone, code, deviceID, osTy
 		// 不影响主流程，只记录警告
 	}
 
-	// 从数据库获取用户
-	user, err := s.userRepo.GetByPhone(ctx, phone)
-	if err != nil {
-		s.logger.Error("没有注册过的用户，直接注册成功", "error", err)
+	// 从数据库获取任意状态的用户，验证码校验通过后才检查封禁状态
+	user, err := s.userRepo.GetByPhoneAnyStatus(ctx, phone)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to query user", "error", err)
+		return nil, nil, errors.New("database error")
+	}
+	if err == nil {
+		// 封禁中的用户不允许登录
+		if err := s.checkLoginBan(ctx, user); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		s.logger.Info("没有注册过的用户，直接注册成功", "phone", phone)
 		// 新用户，创建用户
 		newUser := &model.User{
 			Username:  "user_" + phone[7:], // 默认用户名
@@ -203,40 +237,35 @@ func (s *userService) CodeLogin(ctx context.Context, phone, code, deviceID, osTy
 		}
 		if err := s.userRepo.Create(ctx, newUser); err != nil {
 			s.logger.Error("Failed to create user", "error", err)
-			return nil, "", errors.New("user creation failed")
+			return nil, nil, errors.New("user creation failed")
 		}
+		s.indexUser(newUser)
 		user = newUser
 	}
 
-	// 将用户信息转换为缓存格式并存储到Redis
-	userCache := &model.UserCache{
-		UserID:          uint64(user.ID),
-		Username:        user.Username,
-		Nickname:        user.Nickname,
-		AvatarURL:       user.AvatarURL,
-		BackgroundImage: user.BackgroundImage,
-		Signature:       user.Signature,
-		IsVerified:      user.IsVerified,
-		UserType:        user.UserType,
-		Status:          user.Status,
-		UpdatedAt:       user.UpdatedAt,
+	// 验证用户状态
+	if !user.IsActive() {
+		return nil, nil, errors.New("user account is disabled")
 	}
 
+	// 将用户信息转换为缓存格式并存储到Redis
+	userCache := model.NewUserCache(user)
+
 	if cacheErr := s.cacheService.SetUser(ctx, user.ID, userCache, 30*time.Minute); cacheErr != nil {
 		s.logger.Warn("Failed to cache user", "phone", phone, "error", cacheErr)
 		// 不影响主流程，只记录警告
-	} else {
-		// 验证用户状态
-		if !user.IsActive() {
-			return nil, "", errors.New("user account is disabled")
-		}
 	}
 
 	// 生成token
-	token, err := s.authService.GenerateToken(ctx, user.ID)
+	tokens, err := s.generateTokenPair(ctx, user.ID)
 	if err != nil {
 		s.logger.Error("Failed to generate token", "error", err)
-		return nil, "", errors.New("token generation failed")
+		return nil, nil, errors.New("token generation failed")
+	}
+
+	// 执行多端登录策略
+	if err := s.applyLoginPolicy(ctx, user, deviceID, tokens); err != nil {
+		s.logger.Error("Failed to apply login policy", "userID", user.ID, "error", err)
 	}
 
 	// 更新用户信息
@@ -253,18 +282,80 @@ func (s *userService) CodeLogin(ctx context.Context, phone, code, deviceID, osTy
 		s.logger.Error("Failed to clear user cache", "error", err)
 	}
 
-	return user, token, nil
+	return user, tokens, nil
 }
 
-// SendSmsCode 发送短信验证码
-func (s *userService) SendSmsCode(ctx context.Context, phone string) error {
-	s.logger.Info("SendSmsCode service called", "phone", phone)
+// applyLoginPolicy 根据用户类型执行多端登录策略
+// 同一设备重复登录时作废该设备之前的token；不允许多端登录的用户，登录成功后其他设备上的会话会被作废，
+// 被作废会话的访问token加入黑名单，刷新token随会话删除后不能再刷新
+func (s *userService) applyLoginPolicy(ctx context.Context, user *model.User, deviceID string, tokens *TokenPair) error {
+	if deviceID == "" {
+		deviceID = defaultDeviceID
+	}
+
+	sessions, err := s.userRepo.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get user sessions: %w", err)
+	}
+
+	allowMulti := s.config.Login.AllowMultiLogin(user.UserType)
+	evicted := make([]string, 0, len(sessions))
+	for otherDevice, session := range sessions {
+		if otherDevice != deviceID && allowMulti {
+			continue
+		}
+		if err := s.authService.InvalidateToken(ctx, session.Token); err != nil {
+			s.logger.Warn("Failed to invalidate token", "userID", user.ID, "deviceID", otherDevice, "error", err)
+		}
+		// 同一设备的会话由新会话覆盖
+		if otherDevice != deviceID {
+			evicted = append(evicted, otherDevice)
+		}
+	}
+
+	if err := s.userRepo.DeleteUserSessions(ctx, user.ID, evicted...); err != nil {
+		return fmt.Errorf("failed to delete user sessions: %w", err)
+	}
+	if len(evicted) > 0 {
+		s.logger.Info("Evicted other sessions", "userID", user.ID, "userType", user.UserType, "devices", evicted)
+	}
+
+	// 会话与刷新token同时过期
+	session := &model.UserSession{Token: tokens.Token, RefreshToken: tokens.RefreshToken}
+	if err := s.userRepo.SetUserSession(ctx, user.ID, deviceID, session, s.authService.GetRefreshTokenExpiration()); err != nil {
+		return fmt.Errorf("failed to set user session: %w", err)
+	}
+	return nil
+}
+
+// generateTokenPair 为用户签发访问token和刷新token
+func (s *userService) generateTokenPair(ctx context.Context, userID uint32) (*TokenPair, error) {
+	expiresAt := time.Now().Add(s.authService.GetTokenExpiration())
+	token, err := s.authService.GenerateToken(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := s.authService.GenerateRefreshToken(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{Token: token, RefreshToken: refreshToken, ExpiresAt: expiresAt}, nil
+}
+
+// SendSmsCode 发送短信验证码，clientIP为网关透传的客户端IP，用于识别刷量行为
+func (s *userService) SendSmsCode(ctx context.Context, phone, clientIP string) error {
+	s.logger.Info("SendSmsCode service called", "phone", phone, "ip", clientIP)
 
 	// 验证手机号格式
 	if err := s.validatePhoneNumber(phone); err != nil {
 		return fmt.Errorf("phone validation failed: %w", err)
 	}
 
+	// 检查客户端IP是否存在刷量行为
+	if err := s.checkSmsAbuse(ctx, phone, clientIP); err != nil {
+		return err
+	}
+
 	// 检查发送频率限制
 	rateLimitKey := fmt.Sprintf("sms_send:%s", phone)
 	allowed, err := s.cacheService.CheckRateLimit(ctx, rateLimitKey, 1, time.Minute)
@@ -278,7 +369,7 @@ func (s *userService) SendSmsCode(ctx context.Context, phone string) error {
 		return fmt.Errorf("发送过于频繁，请稍后再试")
 	}
 
-	// 生成6位验证码
+	// 生成验证码，位数由配置决定
 	code := s.smsService.GenerateCode()
 
 	// 发送验证码
@@ -287,8 +378,8 @@ func (s *userService) SendSmsCode(ctx context.Context, phone string) error {
 		return fmt.Errorf("sms send failed: %w", err)
 	}
 
-	// 使用缓存服务存储验证码，5分钟有效
-	if err := s.cacheService.SetSmsCode(ctx, phone, code, 5*time.Minute); err != nil {
+	// 使用缓存服务存储验证码，有效期由配置决定
+	if err := s.cacheService.SetSmsCode(ctx, phone, code, s.config.SMS.WithDefaults().CodeTTL); err != nil {
 		s.logger.Error("Failed to cache SMS code", "error", err)
 		return fmt.Errorf("cache set failed: %w", err)
 	}
@@ -313,7 +404,26 @@ func (s *userService) VerifyToken(ctx context.Context, token string) (uint32, er
 		return 0, fmt.Errorf("token verification failed: %w", err)
 	}
 
-	// 从数据库获取用户
+	// 已退出登录或被挤下线的token不再可用，黑名单不可用时不阻断验证
+	blacklisted, err := s.userRepo.IsTokenBlacklisted(ctx, token)
+	if err != nil {
+		s.logger.Warn("Failed to check token blacklist", "userID", userID, "error", err)
+	} else if blacklisted {
+		return 0, errors.New("token has been revoked")
+	}
+
+	// 优先用缓存中的用户状态校验，退出登录和修改用户信息(包括禁用)时会清除缓存
+	if cached, err := s.userRepo.GetUserFromCache(ctx, userID); err == nil {
+		if cached.Status == model.UserStatusBanned {
+			return 0, ErrUserBanned
+		}
+		if cached.Status != model.UserStatusActive {
+			return 0, errors.New("user account is disabled")
+		}
+		return userID, nil
+	}
+
+	// 缓存未命中时回源数据库，只能查到正常状态的用户
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -323,23 +433,8 @@ func (s *userService) VerifyToken(ctx context.Context, token string) (uint32, er
 		return 0, errors.New("database error")
 	}
 
-	// 将用户信息转换为缓存格式并存储到Redis
-	userCache := &model.UserCache{
-		UserID:          uint64(user.ID),
-		Username:        user.Username,
-		Nickname:        user.Nickname,
-		AvatarURL:       user.AvatarURL,
-		BackgroundImage: user.BackgroundImage,
-		Signature:       user.Signature,
-		IsVerified:      user.IsVerified,
-		UserType:        user.UserType,
-		Status:          user.Status,
-		UpdatedAt:       user.UpdatedAt,
-	}
-
-	if cacheErr := s.cacheService.SetUser(ctx, user.ID, userCache, 30*time.Minute); cacheErr != nil {
-		s.logger.Warn("Failed to cache user", "userID", userID, "error", cacheErr)
-		// 不影响主流程，只记录警告
+	if err := s.userRepo.SetUserCache(ctx, userID, model.NewUserCache(user), model.UserInfoTTL); err != nil {
+		s.logger.Warn("Failed to cache user", "userID", userID, "error", err)
 	}
 
 	// 检查用户状态
@@ -350,41 +445,44 @@ func (s *userService) VerifyToken(ctx context.Context, token string) (uint32, er
 	return userID, nil
 }
 
+// IntrospectToken 查询token的声明、有效期和可用状态，不校验用户账号状态
+func (s *userService) IntrospectToken(ctx context.Context, token string) (*TokenIntrospection, error) {
+	if token == "" {
+		return nil, errors.New("token cannot be empty")
+	}
+
+	result, err := s.authService.IntrospectToken(ctx, token)
+	if err != nil {
+		s.logger.Error("Failed to introspect token", "error", err)
+		return nil, fmt.Errorf("failed to introspect token: %w", err)
+	}
+	return result, nil
+}
+
 // RefreshToken 刷新token
-func (s *userService) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+func (s *userService) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
 	// 验证refresh token格式
 	if err := s.validateToken(refreshToken); err != nil {
 		s.logger.Error("Invalid refresh token format", "error", err)
-		return "", fmt.Errorf("invalid refresh token format: %w", err)
+		return nil, fmt.Errorf("invalid refresh token format: %w", err)
 	}
 
 	// 解析refresh token
 	userID, err := s.authService.ParseRefreshToken(refreshToken)
 	if err != nil {
 		s.logger.Error("Failed to parse refresh token", "error", err)
-		return "", fmt.Errorf("failed to parse refresh token: %w", err)
+		return nil, fmt.Errorf("failed to parse refresh token: %w", err)
 	}
 
 	// 从数据库获取用户
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		s.logger.Error("Failed to get user by ID", "userID", userID, "error", err)
-		return "", fmt.Errorf("failed to get user: %w", err)
+		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
 	// 将用户信息转换为缓存格式并存储到Redis
-	userCache := &model.UserCache{
-		UserID:          uint64(user.ID),
-		Username:        user.Username,
-		Nickname:        user.Nickname,
-		AvatarURL:       user.AvatarURL,
-		BackgroundImage: user.BackgroundImage,
-		Signature:       user.Signature,
-		IsVerified:      user.IsVerified,
-		UserType:        user.UserType,
-		Status:          user.Status,
-		UpdatedAt:       user.UpdatedAt,
-	}
+	userCache := model.NewUserCache(user)
 
 	if cacheErr := s.cacheService.SetUser(ctx, user.ID, userCache, 30*time.Minute); cacheErr != nil {
 		s.logger.Warn("Failed to cache user", "userID", userID, "error", cacheErr)
@@ -394,38 +492,44 @@ func (s *userService) RefreshToken(ctx context.Context, refreshToken string) (st
 	// 检查用户状态
 	if user.Status != model.UserStatusActive {
 		s.logger.Error("User account is not active", "userID", userID, "status", user.Status)
-		return "", fmt.Errorf("account is not active")
+		return nil, fmt.Errorf("account is not active")
 	}
 
-	// 生成新的token
-	newToken, err := s.authService.GenerateToken(ctx, user.ID)
+	// 生成新的token对
+	tokens, err := s.generateTokenPair(ctx, user.ID)
 	if err != nil {
 		s.logger.Error("Failed to generate token", "userID", user.ID, "error", err)
-		return "", fmt.Errorf("failed to generate token: %w", err)
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	// 生成新的refresh token
-	newRefreshToken, err := s.authService.GenerateRefreshToken(ctx, user.ID)
+	// 刷新token必须属于用户当前的某个设备会话，被挤下线、退出登录或已使用过的刷新token不能再刷新；
+	// 轮换后旧的刷新token失效，旧的访问token加入黑名单
+	session := &model.UserSession{Token: tokens.Token, RefreshToken: tokens.RefreshToken}
+	deviceID, previous, err := s.userRepo.RotateUserSession(ctx, user.ID, refreshToken, session, s.authService.GetRefreshTokenExpiration())
 	if err != nil {
-		s.logger.Error("Failed to generate refresh token", "userID", user.ID, "error", err)
-		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			s.logger.Warn("Refresh token does not belong to an active session", "userID", user.ID)
+			return nil, ErrRefreshTokenRevoked
+		}
+		s.logger.Error("Failed to rotate user session", "userID", user.ID, "error", err)
+		return nil, fmt.Errorf("failed to rotate user session: %w", err)
 	}
-
-	// 将新的refresh token存储在缓存中，以便后续验证
-	refreshTokenKey := fmt.Sprintf("refresh_token:%d", user.ID)
-	if err := s.cacheService.Set(ctx, refreshTokenKey, newRefreshToken, 7*24*time.Hour); err != nil {
-		s.logger.Warn("Failed to cache refresh token", "userID", user.ID, "error", err)
-		// 不影响主流程，只记录警告
+	if err := s.authService.InvalidateToken(ctx, previous.Token); err != nil {
+		s.logger.Warn("Failed to invalidate token", "userID", user.ID, "deviceID", deviceID, "error", err)
 	}
 
-	// 返回新的token和refresh token，用特殊分隔符分隔
-	return fmt.Sprintf("%s|%s", newToken, newRefreshToken), nil
+	return tokens, nil
 }
 
 // GetUserInfo 获取用户信息
 func (s *userService) GetUserInfo(ctx context.Context, userID uint32) (*model.User, error) {
 	s.logger.Info("GetUserInfo service called", "userID", userID)
 
+	// 优先从缓存读取，缓存不可用时回源数据库
+	if cached, err := s.userRepo.GetUserFromCache(ctx, userID); err == nil && cached.Complete() && cached.Status == model.UserStatusActive {
+		return cached.ToUser(), nil
+	}
+
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -435,28 +539,102 @@ func (s *userService) GetUserInfo(ctx context.Context, userID uint32) (*model.Us
 		return nil, errors.New("database error")
 	}
 
+	if err := s.userRepo.SetUserCache(ctx, userID, model.NewUserCache(user), model.UserInfoTTL); err != nil {
+		s.logger.Warn("Failed to cache user", "userID", userID, "error", err)
+	}
+
 	return user, nil
 }
 
-// GetUserInfos 批量获取用户信息
+// GetUserInfos 批量获取用户信息，按userIDs的顺序返回，不存在或已禁用的用户会被跳过
+// 先批量读取缓存，未命中的用户通过一次数据库查询获取并回填缓存；缓存不可用时全部回源数据库
 func (s *userService) GetUserInfos(ctx context.Context, userIDs []uint32) ([]*model.User, error) {
 	s.logger.Info("GetUserInfos service called", "count", len(userIDs))
 
-	users, err := s.userRepo.GetByIDs(ctx, userIDs)
+	users := make(map[uint32]*model.User, len(userIDs))
+	cached, err := s.userRepo.GetUsersFromCache(ctx, userIDs)
 	if err != nil {
-		s.logger.Error("Failed to get users", "error", err)
-		return nil, errors.New("database error")
+		s.logger.Warn("Failed to get users from cache", "error", err)
 	}
+	var missIDs []uint32
+	for _, userID := range userIDs {
+		if _, ok := users[userID]; ok {
+			continue
+		}
+		if c, ok := cached[userID]; ok && c.Complete() && c.Status == model.UserStatusActive {
+			users[userID] = c.ToUser()
+			continue
+		}
+		users[userID] = nil
+		missIDs = append(missIDs, userID)
+	}
+
+	if len(missIDs) > 0 {
+		loaded, err := s.userRepo.GetByIDs(ctx, missIDs)
+		if err != nil {
+			s.logger.Error("Failed to get users", "error", err)
+			return nil, errors.New("database error")
+		}
 
-	// 将map转换为slice
-	result := make([]*model.User, 0, len(users))
-	for _, user := range users {
-		result = append(result, user)
+		userCaches := make([]*model.UserCache, 0, len(loaded))
+		for _, user := range loaded {
+			users[user.ID] = user
+			userCaches = append(userCaches, model.NewUserCache(user))
+		}
+		if err := s.userRepo.SetUsersCache(ctx, userCaches, model.UserInfoTTL); err != nil {
+			s.logger.Warn("Failed to cache users", "count", len(userCaches), "error", err)
+		}
 	}
 
+	result := make([]*model.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if user := users[userID]; user != nil {
+			result = append(result, user)
+		}
+	}
 	return result, nil
 }
 
+// GetHotUserIDs 获取粉丝数最多的前limit个用户ID
+func (s *userService) GetHotUserIDs(ctx context.Context, limit int) ([]uint32, error) {
+	userIDs, err := s.userRepo.ListHotUserIDs(ctx, limit)
+	if err != nil {
+		s.logger.Error("Failed to list hot users", "error", err)
+		return nil, errors.New("database error")
+	}
+	return userIDs, nil
+}
+
+// WarmUserCache 分批从数据库加载用户并写入缓存，返回成功写入缓存的用户数
+// 不存在或已禁用的用户会被跳过，单个用户写缓存失败不影响其他用户
+func (s *userService) WarmUserCache(ctx context.Context, userIDs []uint32) (int, error) {
+	warmed := 0
+	for start := 0; start < len(userIDs); start += warmBatchSize {
+		if err := ctx.Err(); err != nil {
+			return warmed, err
+		}
+
+		end := start + warmBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		users, err := s.userRepo.GetByIDs(ctx, userIDs[start:end])
+		if err != nil {
+			s.logger.Error("Failed to load users for cache warming", "error", err)
+			return warmed, errors.New("database error")
+		}
+
+		for _, user := range users {
+			if err := s.userRepo.SetUserCache(ctx, user.ID, model.NewUserCache(user), model.UserInfoTTL); err != nil {
+				s.logger.Warn("Failed to warm user cache", "userID", user.ID, "error", err)
+				continue
+			}
+			warmed++
+		}
+	}
+	return warmed, nil
+}
+
 // UpdateUserInfo 更新用户信息
 func (s *userService) UpdateUserInfo(ctx context.Context, userID uint32, updates map[string]interface{}) error {
 	s.logger.Info("UpdateUserInfo service called", "userID", userID)
@@ -471,12 +649,23 @@ func (s *userService) UpdateUserInfo(ctx context.Context, userID uint32, updates
 		return errors.New("database error")
 	}
 
+	// 图片字段只允许使用本服务存储生成的URL，防止写入任意外链
+	for _, field := range []string{"avatar_url", "background_image"} {
+		if v, ok := updates[field]; ok {
+			url, _ := v.(string)
+			if url != "" && !s.imageStorage.OwnsURL(url) {
+				return fmt.Errorf("invalid %s: image must be uploaded first", field)
+			}
+		}
+	}
+
 	// 更新用户信息
 	updates["updated_at"] = time.Now()
 	if err := s.userRepo.Update(ctx, uint32(userID), updates); err != nil {
 		s.logger.Error("Failed to update user", "error", err)
 		return errors.New("update failed")
 	}
+	s.indexUserUpdates(userID, updates)
 
 	// 清除用户缓存
 	if err := s.userRepo.DeleteUserCache(ctx, userID); err != nil {
@@ -486,6 +675,36 @@ func (s *userService) UpdateUserInfo(ctx context.Context, userID uint32, updates
 	return nil
 }
 
+// UpdateAvatar 上传并更新用户头像，返回头像URL
+func (s *userService) UpdateAvatar(ctx context.Context, userID uint32, contentType string, data []byte) (string, error) {
+	s.logger.Info("UpdateAvatar service called", "userID", userID, "size", len(data))
+
+	// 上传前确认用户存在，避免产生无主文件
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return "", errors.New("database error")
+	}
+
+	key := fmt.Sprintf("avatars/%d/%d", userID, time.Now().UnixNano())
+	avatarURL, err := s.imageStorage.UploadImage(ctx, key, contentType, data)
+	if err != nil {
+		if errors.Is(err, storage.ErrEmptyImage) || errors.Is(err, storage.ErrImageTooLarge) || errors.Is(err, storage.ErrUnsupportedImageType) {
+			return "", err
+		}
+		s.logger.Error("Failed to upload avatar", "userID", userID, "error", err)
+		return "", errors.New("upload failed")
+	}
+
+	if err := s.UpdateUserInfo(ctx, userID, map[string]interface{}{"avatar_url": avatarURL}); err != nil {
+		return "", err
+	}
+
+	return avatarURL, nil
+}
+
 // GetUserExistInformation 检查用户是否存在
 func (s *userService) GetUserExistInformation(ctx context.Context, userID uint32) (bool, error) {
 	s.logger.Info("GetUserExistInformation service called", "userID", userID)
@@ -525,6 +744,11 @@ func (s *userService) Logout(ctx context.Context, token string) error {
 		// 不阻断流程，继续执行
 	}
 
+	// 删除token所在的设备会话，会话中的刷新token随之失效
+	if err := s.deleteSessionOfToken(ctx, userID, token); err != nil {
+		s.logger.Error("Failed to delete user session", "userID", userID, "error", err)
+	}
+
 	// 清除用户缓存
 	if err := s.userRepo.DeleteUserCache(ctx, userID); err != nil {
 		s.logger.Error("Failed to clear user cache", "error", err)
@@ -537,6 +761,20 @@ func (s *userService) Logout(ctx context.Context, token string) error {
 
 // 辅助方法
 
+// deleteSessionOfToken 删除访问token所在的设备会话
+func (s *userService) deleteSessionOfToken(ctx context.Context, userID uint32, token string) error {
+	sessions, err := s.userRepo.GetUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for deviceID, session := range sessions {
+		if session.Token == token {
+			return s.userRepo.DeleteUserSessions(ctx, userID, deviceID)
+		}
+	}
+	return nil
+}
+
 func (s *userService) isValidPhone(phone string) bool {
 	// 简单的手机号格式验证（中国大陆手机号）
 	if len(phone) != 11 {
@@ -633,11 +871,12 @@ func (s *userService) validateSmsCodeFormat(code string) error {
 		return errors.New("verification code cannot be empty")
 	}
 
-	if len(code) != 6 {
-		return errors.New("verification code must be 6 digits")
+	codeLength := s.config.SMS.WithDefaults().CodeLength
+	if len(code) != codeLength {
+		return fmt.Errorf("verification code must be %d digits", codeLength)
 	}
 
-	pattern := `^\d{6}$`
+	pattern := fmt.Sprintf(`^\d{%d}$`, codeLength)
 	matched, err := regexp.MatchString(pattern, code)
 	if err != nil {
 		return fmt.Errorf("code validation regex error: %w", err)
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"user_service/internal/model"
+)
+
+// activeUser 可以登录的普通用户，密码为secret123
+func activeUser(t *testing.T) *model.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword: %v", err)
+	}
+	return &model.User{ID: 7, Phone: testPhone, PasswordHash: string(hash), Status: model.UserStatusActive}
+}
+
+// loginOn 在设备上用密码登录
+func loginOn(t *testing.T, svc *userService, deviceID string) *TokenPair {
+	t.Helper()
+	_, tokens, err := svc.PhoneLogin(context.Background(), testPhone, "secret123", deviceID, "", "")
+	if err != nil {
+		t.Fatalf("PhoneLogin(%s): %v", deviceID, err)
+	}
+	if tokens.RefreshToken == "" {
+		t.Fatalf("PhoneLogin(%s) returned no refresh token", deviceID)
+	}
+	return tokens
+}
+
+func TestEvictedSessionCannotRefresh(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	auth := svc.authService.(*fakeAuthService)
+
+	phone := loginOn(t, svc, "phone")
+	loginOn(t, svc, "tablet")
+
+	// 不允许多端登录，平板登录后手机的访问token和刷新token都失效
+	if !auth.invalidated[phone.Token] {
+		t.Errorf("evicted access token was not invalidated")
+	}
+	if _, err := svc.RefreshToken(context.Background(), phone.RefreshToken); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Fatalf("refresh with evicted session error = %v, want ErrRefreshTokenRevoked", err)
+	}
+}
+
+func TestReloginOnSameDeviceRevokesPreviousTokens(t *testing.T) {
+	svc, repo := newLoginTestService(t, activeUser(t), "")
+	svc.config.Login.MultiLoginUserTypes = []string{""}
+	auth := svc.authService.(*fakeAuthService)
+
+	first := loginOn(t, svc, "phone")
+	second := loginOn(t, svc, "phone")
+
+	if !auth.invalidated[first.Token] {
+		t.Errorf("previous access token on the same device was not invalidated")
+	}
+	if auth.invalidated[second.Token] {
+		t.Errorf("new access token was invalidated")
+	}
+	if _, err := svc.RefreshToken(context.Background(), first.RefreshToken); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Fatalf("refresh with replaced session error = %v, want ErrRefreshTokenRevoked", err)
+	}
+	if got := len(repo.sessions[7]); got != 1 {
+		t.Errorf("sessions = %d, want 1", got)
+	}
+}
+
+func TestMultiLoginKeepsOtherDevices(t *testing.T) {
+	svc, _ := newLoginTestService(t, activeUser(t), "")
+	svc.config.Login.MultiLoginUserTypes = []string{""}
+	auth := svc.authService.(*fakeAuthService)
+
+	phone := loginOn(t, svc, "phone")
+	loginOn(t, svc, "tablet")
+
+	if auth.invalidated[phone.Token] {
+		t.Errorf("access token on another device was invalidated for a multi-login user")
+	}
+	if _, err := svc.RefreshToken(context.Background(), phone.RefreshToken); err != nil {
+		t.Fatalf("refresh on another device: %v", err)
+	}
+}
+
+func TestRefreshTokenRotatesAndRejectsReuse(t *testing.T) {
+	svc, repo := newLoginTestService(t, activeUser(t), "")
+	auth := svc.authService.(*fakeAuthService)
+	login := loginOn(t, svc, "phone")
+
+	refreshed, err := svc.RefreshToken(context.Background(), login.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if refreshed.RefreshToken == login.RefreshToken || refreshed.Token == login.Token {
+		t.Fatalf("RefreshToken did not issue new tokens")
+	}
+	if session := repo.sessions[7]["phone"]; session.Token != refreshed.Token || session.RefreshToken != refreshed.RefreshToken {
+		t.Errorf("session = %+v, want refreshed tokens", session)
+	}
+	if !auth.invalidated[login.Token] {
+		t.Errorf("access token replaced by refresh was not invalidated")
+	}
+
+	// 已使用过的刷新token不能再次刷新
+	if _, err := svc.RefreshToken(context.Background(), login.RefreshToken); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Fatalf("reused refresh token error = %v, want ErrRefreshTokenRevoked", err)
+	}
+	if _, err := svc.RefreshToken(context.Background(), refreshed.RefreshToken); err != nil {
+		t.Fatalf("refresh with rotated token: %v", err)
+	}
+}
+
+func TestLogoutDeletesSessionRefreshToken(t *testing.T) {
+	svc, repo := newLoginTestService(t, activeUser(t), "")
+	svc.config.Login.MultiLoginUserTypes = []string{""}
+	phone := loginOn(t, svc, "phone")
+	tablet := loginOn(t, svc, "tablet")
+
+	if err := svc.Logout(context.Background(), phone.Token); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, ok := repo.sessions[7]["phone"]; ok {
+		t.Errorf("logged out session was not deleted")
+	}
+	if _, err := svc.RefreshToken(context.Background(), phone.RefreshToken); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Fatalf("refresh after logout error = %v, want ErrRefreshTokenRevoked", err)
+	}
+	if _, err := svc.RefreshToken(context.Background(), tablet.RefreshToken); err != nil {
+		t.Fatalf("refresh on the other device: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_service/internal/config"
+	"user_service/internal/model"
+)
+
+// verifyUserRepo 在预热测试仓库的基础上共享token黑名单，并记录缓存有效期
+type verifyUserRepo struct {
+	*warmUserRepo
+	blacklist *fakeTokenBlacklist
+	cacheTTL  time.Duration
+}
+
+func (r *verifyUserRepo) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	return r.blacklist.IsTokenBlacklisted(ctx, token)
+}
+
+func (r *verifyUserRepo) SetUserCache(ctx context.Context, userID uint32, userCache *model.UserCache, expiration time.Duration) error {
+	r.cacheTTL = expiration
+	return r.warmUserRepo.SetUserCache(ctx, userID, userCache, expiration)
+}
+
+func (r *verifyUserRepo) DeleteUserCache(ctx context.Context, userID uint32) error {
+	delete(r.cache, userID)
+	return nil
+}
+
+func (r *verifyUserRepo) GetUserSessions(ctx context.Context, userID uint32) (map[string]*model.UserSession, error) {
+	return nil, nil
+}
+
+// newVerifyTestService 使用真实token签发，黑名单由认证服务和仓库共享
+func newVerifyTestService(t *testing.T, users ...*model.User) (*userService, *verifyUserRepo, AuthService) {
+	t.Helper()
+	auth, blacklist := newIntrospectTestAuth(time.Hour)
+	repo := &verifyUserRepo{warmUserRepo: newWarmUserRepo(), blacklist: blacklist}
+	for _, user := range users {
+		repo.db[user.ID] = user
+	}
+	svc := &userService{config: &config.Config{}, logger: nopLogger{}, userRepo: repo, authService: auth}
+	return svc, repo, auth
+}
+
+// issueToken 为用户签发访问token
+func issueToken(t *testing.T, auth AuthService, userID uint32) string {
+	t.Helper()
+	token, err := auth.GenerateToken(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	return token
+}
+
+func TestVerifyTokenCacheHit(t *testing.T) {
+	svc, repo, auth := newVerifyTestService(t)
+	repo.cache[7] = model.NewUserCache(testUser(7, model.UserStatusActive))
+
+	userID, err := svc.VerifyToken(context.Background(), issueToken(t, auth, 7))
+	if err != nil || userID != 7 {
+		t.Fatalf("VerifyToken = (%d, %v), want user 7", userID, err)
+	}
+	if repo.dbReads != 0 {
+		t.Errorf("database reads = %d, want the cached status used", repo.dbReads)
+	}
+}
+
+func TestVerifyTokenCachedStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  uint8
+		wantErr error
+	}{
+		{"banned", model.UserStatusBanned, ErrUserBanned},
+		{"disabled", model.UserStatusDisabled, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, auth := newVerifyTestService(t)
+			repo.cache[7] = model.NewUserCache(testUser(7, tt.status))
+
+			_, err := svc.VerifyToken(context.Background(), issueToken(t, auth, 7))
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("error = %v, want the %s account rejected", err, tt.name)
+			}
+			if repo.dbReads != 0 {
+				t.Errorf("database reads = %d, want rejected from cache", repo.dbReads)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenCacheMiss(t *testing.T) {
+	svc, repo, auth := newVerifyTestService(t, testUser(7, model.UserStatusActive))
+	token := issueToken(t, auth, 7)
+
+	// 缓存未命中时回源数据库并写入缓存，之后的验证直接使用缓存
+	for i := 0; i < 2; i++ {
+		if userID, err := svc.VerifyToken(context.Background(), token); err != nil || userID != 7 {
+			t.Fatalf("VerifyToken #%d = (%d, %v), want user 7", i+1, userID, err)
+		}
+	}
+	if repo.dbReads != 1 {
+		t.Errorf("database reads = %d, want 1", repo.dbReads)
+	}
+	if cached := repo.cache[7]; cached == nil || cached.Status != model.UserStatusActive || repo.cacheTTL != model.UserInfoTTL {
+		t.Errorf("cache = %+v with ttl %v, want the active user for %v", cached, repo.cacheTTL, model.UserInfoTTL)
+	}
+}
+
+func TestVerifyTokenCacheMissErrors(t *testing.T) {
+	t.Run("user not found", func(t *testing.T) {
+		svc, repo, auth := newVerifyTestService(t)
+		if _, err := svc.VerifyToken(context.Background(), issueToken(t, auth, 7)); err == nil {
+			t.Error("VerifyToken accepted a token of an unknown user")
+		}
+		if len(repo.cache) != 0 {
+			t.Errorf("cache = %v, want nothing cached", repo.cache)
+		}
+	})
+
+	t.Run("cache write failure", func(t *testing.T) {
+		svc, repo, auth := newVerifyTestService(t, testUser(7, model.UserStatusActive))
+		repo.setErr[7] = errors.New("redis down")
+		// 写缓存失败不影响验证结果
+		if userID, err := svc.VerifyToken(context.Background(), issueToken(t, auth, 7)); err != nil || userID != 7 {
+			t.Errorf("VerifyToken = (%d, %v), want user 7", userID, err)
+		}
+	})
+}
+
+func TestVerifyTokenRejectsLoggedOutToken(t *testing.T) {
+	svc, repo, auth := newVerifyTestService(t, testUser(7, model.UserStatusActive), testUser(8, model.UserStatusActive))
+	ctx := context.Background()
+	token := issueToken(t, auth, 7)
+	other := issueToken(t, auth, 8)
+
+	if _, err := svc.VerifyToken(ctx, token); err != nil {
+		t.Fatalf("VerifyToken: %v", err)
+	}
+	if err := svc.Logout(ctx, token); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, ok := repo.cache[7]; ok {
+		t.Error("logout left the user cache in place")
+	}
+
+	// 退出登录的token在黑名单中，即使缓存重新写入也不能通过验证
+	repo.cache[7] = model.NewUserCache(testUser(7, model.UserStatusActive))
+	if _, err := svc.VerifyToken(ctx, token); err == nil {
+		t.Error("VerifyToken accepted a logged out token")
+	}
+	// 其他用户的token不受影响
+	if userID, err := svc.VerifyToken(ctx, other); err != nil || userID != 8 {
+		t.Errorf("VerifyToken(other) = (%d, %v), want user 8", userID, err)
+	}
+}
+
+func TestVerifyTokenBlacklistUnavailable(t *testing.T) {
+	svc, repo, auth := newVerifyTestService(t)
+	repo.cache[7] = model.NewUserCache(testUser(7, model.UserStatusActive))
+	repo.blacklist.err = errors.New("redis down")
+
+	// 黑名单不可用时不阻断验证，仍按用户状态判断
+	if userID, err := svc.VerifyToken(context.Background(), issueToken(t, auth, 7)); err != nil || userID != 7 {
+		t.Errorf("VerifyToken = (%d, %v), want user 7", userID, err)
+	}
+}
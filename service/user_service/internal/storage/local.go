@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localStorage 本地磁盘存储，文件通过BaseURL对应的静态服务对外访问
+type localStorage struct {
+	baseDir      string
+	baseURL      string
+	maxImageSize int64
+}
+
+// NewLocalStorage 创建本地磁盘存储
+func NewLocalStorage(baseDir, baseURL string, maxImageSize int64) Storage {
+	if baseDir == "" {
+		baseDir = "./uploads"
+	}
+	return &localStorage{
+		baseDir:      baseDir,
+		baseURL:      baseURL,
+		maxImageSize: maxImageSize,
+	}
+}
+
+// UploadImage 校验图片并写入本地目录
+func (s *localStorage) UploadImage(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	_, ext, err := ValidateImage(contentType, data, s.maxImageSize)
+	if err != nil {
+		return "", err
+	}
+
+	objectKey := filepath.ToSlash(filepath.Clean("/" + key + ext))[1:]
+	path := filepath.Join(s.baseDir, filepath.FromSlash(objectKey))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create upload dir: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write image: %w", err)
+	}
+
+	return joinURL(s.baseURL, objectKey), nil
+}
+
+// OwnsURL 判断URL是否位于本地存储的BaseURL下
+func (s *localStorage) OwnsURL(url string) bool {
+	return strings.HasPrefix(url, strings.TrimRight(s.baseURL, "/")+"/")
+}
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"user_service/internal/config"
+)
+
+// s3Storage S3兼容对象存储，直接使用SigV4签名的PUT请求上传，兼容AWS S3与MinIO
+type s3Storage struct {
+	cfg          config.S3Config
+	endpoint     *url.URL
+	maxImageSize int64
+	client       *http.Client
+}
+
+// NewS3Storage 创建S3兼容存储
+func NewS3Storage(cfg config.S3Config, maxImageSize int64) (Storage, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("s3 credentials are required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+
+	// 未配置endpoint时使用AWS虚拟主机风格域名，否则使用path风格(MinIO等)
+	rawEndpoint := cfg.Endpoint
+	if rawEndpoint == "" {
+		rawEndpoint = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.Bucket, cfg.Region)
+	} else {
+		rawEndpoint = strings.TrimRight(rawEndpoint, "/") + "/" + cfg.Bucket
+	}
+	endpoint, err := url.Parse(rawEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+
+	return &s3Storage{
+		cfg:          cfg,
+		endpoint:     endpoint,
+		maxImageSize: maxImageSize,
+		client:       &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// UploadImage 校验图片并上传到S3
+func (s *s3Storage) UploadImage(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	detected, ext, err := ValidateImage(contentType, data, s.maxImageSize)
+	if err != nil {
+		return "", err
+	}
+
+	objectKey := strings.TrimLeft(key, "/") + ext
+	objectURL := *s.endpoint
+	objectURL.Path = strings.TrimRight(objectURL.Path, "/") + "/" + objectKey
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL.String(), bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to build s3 request: %w", err)
+	}
+	req.Header.Set("Content-Type", detected)
+	s.sign(req, data, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload to s3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("s3 upload failed: status=%d body=%s", resp.StatusCode, string(body))
+	}
+
+	return joinURL(s.publicBaseURL(), objectKey), nil
+}
+
+// OwnsURL 判断URL是否位于当前bucket的访问地址下
+func (s *s3Storage) OwnsURL(url string) bool {
+	return strings.HasPrefix(url, strings.TrimRight(s.publicBaseURL(), "/")+"/")
+}
+
+// publicBaseURL 对外访问地址，优先使用配置的CDN域名
+func (s *s3Storage) publicBaseURL() string {
+	if s.cfg.PublicURL != "" {
+		return s.cfg.PublicURL
+	}
+	return s.endpoint.String()
+}
+
+// sign 按AWS Signature Version 4为请求签名
+func (s *s3Storage) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
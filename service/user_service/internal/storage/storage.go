@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"user_service/internal/config"
+)
+
+// 存储后端类型
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// DefaultMaxImageSize 未配置时图片上传的默认大小上限(5MB)
+const DefaultMaxImageSize int64 = 5 << 20
+
+var (
+	ErrEmptyImage           = errors.New("image data is empty")
+	ErrImageTooLarge        = errors.New("image exceeds size limit")
+	ErrUnsupportedImageType = errors.New("unsupported image type")
+)
+
+// allowedImageTypes 允许上传的图片类型及对应的文件扩展名
+var allowedImageTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Storage 对象存储接口
+type Storage interface {
+	// UploadImage 校验并保存图片，返回可对外访问的规范URL
+	UploadImage(ctx context.Context, key string, contentType string, data []byte) (string, error)
+	// OwnsURL 判断URL是否由当前存储生成
+	OwnsURL(url string) bool
+}
+
+// NewStorage 根据配置创建存储后端
+func NewStorage(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		return NewLocalStorage(cfg.Local.BaseDir, cfg.Local.BaseURL, cfg.MaxImageSize), nil
+	case DriverS3:
+		return NewS3Storage(cfg.S3, cfg.MaxImageSize)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.Driver)
+	}
+}
+
+// ValidateImage 校验图片大小与真实类型，返回规范化的content type和扩展名
+// 类型以文件内容嗅探结果为准，客户端声明的类型与实际不符时拒绝
+func ValidateImage(contentType string, data []byte, maxSize int64) (string, string, error) {
+	if len(data) == 0 {
+		return "", "", ErrEmptyImage
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxImageSize
+	}
+	if int64(len(data)) > maxSize {
+		return "", "", fmt.Errorf("%w: %d > %d bytes", ErrImageTooLarge, len(data), maxSize)
+	}
+
+	detected := http.DetectContentType(data)
+	ext, ok := allowedImageTypes[detected]
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", ErrUnsupportedImageType, detected)
+	}
+
+	if declared := normalizeContentType(contentType); declared != "" && declared != detected {
+		return "", "", fmt.Errorf("%w: declared %s, detected %s", ErrUnsupportedImageType, declared, detected)
+	}
+
+	return detected, ext, nil
+}
+
+// normalizeContentType 去掉参数部分并统一小写，image/jpg视为image/jpeg
+func normalizeContentType(contentType string) string {
+	ct := strings.ToLower(strings.TrimSpace(contentType))
+	if idx := strings.Index(ct, ";"); idx >= 0 {
+		ct = strings.TrimSpace(ct[:idx])
+	}
+	if ct == "image/jpg" {
+		ct = "image/jpeg"
+	}
+	return ct
+}
+
+// joinURL 拼接基础URL与对象key
+func joinURL(baseURL, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + strings.TrimLeft(key, "/")
+}
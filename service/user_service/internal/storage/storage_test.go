@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// 各类型文件的最小文件头，足以让http.DetectContentType识别
+var (
+	pngData  = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	jpegData = []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+	gifData  = []byte("GIF89a\x01\x00\x01\x00")
+	htmlData = []byte("<html><script>alert(1)</script></html>")
+)
+
+func TestValidateImage(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+		wantType    string
+		wantExt     string
+		wantErr     error
+	}{
+		{"png", "image/png", pngData, "image/png", ".png", nil},
+		{"jpeg alias", "image/jpg", jpegData, "image/jpeg", ".jpg", nil},
+		{"undeclared type", "", gifData, "image/gif", ".gif", nil},
+		{"declared with params", "Image/PNG; charset=binary", pngData, "image/png", ".png", nil},
+		{"empty", "image/png", nil, "", "", ErrEmptyImage},
+		{"html", "image/png", htmlData, "", "", ErrUnsupportedImageType},
+		{"declared type mismatch", "image/jpeg", pngData, "", "", ErrUnsupportedImageType},
+		{"text", "text/plain", []byte("hello"), "", "", ErrUnsupportedImageType},
+	}
+	for _, tt := range tests {
+		gotType, gotExt, err := ValidateImage(tt.contentType, tt.data, 1024)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if gotType != tt.wantType || gotExt != tt.wantExt {
+			t.Errorf("%s: = %q, %q; want %q, %q", tt.name, gotType, gotExt, tt.wantType, tt.wantExt)
+		}
+	}
+}
+
+func TestValidateImageSizeLimit(t *testing.T) {
+	data := append(append([]byte{}, pngData...), bytes.Repeat([]byte{0}, 100)...)
+	if _, _, err := ValidateImage("image/png", data, int64(len(data))); err != nil {
+		t.Fatalf("image at the size limit: %v", err)
+	}
+	if _, _, err := ValidateImage("image/png", data, int64(len(data)-1)); !errors.Is(err, ErrImageTooLarge) {
+		t.Fatalf("image over the size limit error = %v, want ErrImageTooLarge", err)
+	}
+
+	// 未配置上限时使用默认上限
+	big := append(append([]byte{}, pngData...), make([]byte, DefaultMaxImageSize)...)
+	if _, _, err := ValidateImage("image/png", big, 0); !errors.Is(err, ErrImageTooLarge) {
+		t.Fatalf("image over the default limit error = %v, want ErrImageTooLarge", err)
+	}
+}
+
+func TestLocalStorageUploadImage(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir, "https://img.example.com/", 1024)
+
+	url, err := s.UploadImage(context.Background(), "avatars/7/1", "image/png", pngData)
+	if err != nil {
+		t.Fatalf("UploadImage: %v", err)
+	}
+	if url != "https://img.example.com/avatars/7/1.png" {
+		t.Errorf("url = %s, want https://img.example.com/avatars/7/1.png", url)
+	}
+	if !s.OwnsURL(url) || s.OwnsURL("https://evil.example.com/avatars/7/1.png") {
+		t.Errorf("OwnsURL does not distinguish own and foreign URLs")
+	}
+	written, err := os.ReadFile(filepath.Join(dir, "avatars", "7", "1.png"))
+	if err != nil || !bytes.Equal(written, pngData) {
+		t.Fatalf("stored file = %q, %v; want the uploaded image", written, err)
+	}
+
+	// key中的..不能写到存储目录之外
+	url, err = s.UploadImage(context.Background(), "../../escape", "image/png", pngData)
+	if err != nil {
+		t.Fatalf("UploadImage: %v", err)
+	}
+	if strings.Contains(url, "..") {
+		t.Errorf("url = %s, want traversal removed", url)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escape.png")); err != nil {
+		t.Errorf("traversal key was not kept inside the storage dir: %v", err)
+	}
+}
+
+func TestLocalStorageRejectsInvalidImage(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewLocalStorage(dir, "https://img.example.com", 1024).UploadImage(context.Background(), "avatars/7/1", "image/png", htmlData); !errors.Is(err, ErrUnsupportedImageType) {
+		t.Errorf("html upload error = %v, want ErrUnsupportedImageType", err)
+	}
+	if _, err := NewLocalStorage(dir, "https://img.example.com", 8).UploadImage(context.Background(), "avatars/7/2", "image/png", pngData); !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("oversize upload error = %v, want ErrImageTooLarge", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("rejected uploads wrote %d entries", len(entries))
+	}
+}
@@ -0,0 +1,189 @@
+package searchindex
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"user_service/pkg/logger"
+)
+
+// 默认参数
+const (
+	DefaultQueueKey   = "search:index:events" // 与搜索服务约定的索引事件队列
+	DefaultBufferSize = 1024
+	pushTimeout       = 3 * time.Second
+)
+
+// Op 索引操作
+type Op string
+
+const (
+	OpUpsert Op = "upsert" // 新增或更新文档，Fields中的字段覆盖已有字段
+	OpDelete Op = "delete" // 删除文档
+)
+
+// 文档类型
+const (
+	DocTypeVideo = "video"
+	DocTypeUser  = "user"
+	DocTypeLive  = "live"
+)
+
+// Event 搜索索引事件，内容所属服务在数据变更后发布，搜索服务消费后更新索引
+type Event struct {
+	Op        Op                     `json:"op"`
+	DocType   string                 `json:"doc_type"`
+	ID        string                 `json:"id"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+	Timestamp int64                  `json:"timestamp"` // 变更时间(毫秒)
+}
+
+// Sink 索引事件投递目标
+type Sink interface {
+	Push(ctx context.Context, event *Event) error
+}
+
+// redisSink 将事件写入Redis列表，搜索服务从列表另一端消费
+type redisSink struct {
+	client redis.UniversalClient
+	key    string
+}
+
+// NewRedisSink 创建Redis事件投递目标，key为空时使用默认队列
+func NewRedisSink(client redis.UniversalClient, key string) Sink {
+	if key == "" {
+		key = DefaultQueueKey
+	}
+	return &redisSink{client: client, key: key}
+}
+
+// Push 投递事件
+func (s *redisSink) Push(ctx context.Context, event *Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return s.client.LPush(ctx, s.key, payload).Err()
+}
+
+// Publisher 索引事件异步发布器
+// Publish只把事件放入内存队列，由后台协程投递；队列已满或投递失败时丢弃事件并记录日志。
+// 索引是尽力而为的，不会阻塞或影响内容写入，丢失的变更在下次变更或全量重建时补齐。
+// nil可安全使用，此时不发布任何事件。
+type Publisher struct {
+	sink   Sink
+	logger logger.Logger
+	events chan *Event
+
+	mu        sync.Mutex
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	started   bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// NewPublisher 创建索引事件发布器，bufferSize<=0时使用默认队列长度
+func NewPublisher(sink Sink, bufferSize int, log logger.Logger) *Publisher {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &Publisher{
+		sink:   sink,
+		logger: log,
+		events: make(chan *Event, bufferSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+// Publish 发布索引事件，不阻塞调用方
+func (p *Publisher) Publish(event Event) {
+	if p == nil {
+		return
+	}
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixMilli()
+	}
+
+	select {
+	case <-p.stopCh:
+		p.logger.Warn("Search index publisher stopped, dropping event", "docType", event.DocType, "id", event.ID)
+	case p.events <- &event:
+	default:
+		p.logger.Warn("Search index queue full, dropping event", "docType", event.DocType, "id", event.ID)
+	}
+}
+
+// Start 启动后台投递
+func (p *Publisher) Start() {
+	if p == nil {
+		return
+	}
+	p.startOnce.Do(func() {
+		p.mu.Lock()
+		p.started = true
+		p.mu.Unlock()
+		go p.run()
+	})
+}
+
+func (p *Publisher) run() {
+	defer close(p.doneCh)
+
+	for {
+		select {
+		case event := <-p.events:
+			p.push(event)
+		case <-p.stopCh:
+			// 退出前投递已入队的事件
+			for {
+				select {
+				case event := <-p.events:
+					p.push(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *Publisher) push(event *Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+	defer cancel()
+	if err := p.sink.Push(ctx, event); err != nil {
+		p.logger.Warn("Failed to publish search index event",
+			"op", event.Op,
+			"docType", event.DocType,
+			"id", event.ID,
+			"error", err,
+		)
+	}
+}
+
+// Stop 停止后台投递并等待已入队的事件投递完成
+func (p *Publisher) Stop(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+	p.mu.Lock()
+	started := p.started
+	p.mu.Unlock()
+	if !started {
+		return nil
+	}
+	select {
+	case <-p.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
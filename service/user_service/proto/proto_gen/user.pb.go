@@ -290,12 +290,13 @@ func (x *CodeLoginRequest) GetAppVersion() string {
 // 登录响应
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
-	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                              // 用户认证token
-	ExpireTime    int64                  `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // token过期时间戳 (秒)
-	User          *User                  `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`                                // 用户信息
-	IsNewUser     bool                   `protobuf:"varint,6,opt,name=is_new_user,json=isNewUser,proto3" json:"is_new_user,omitempty"`  // 是否为新用户注册
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`      // 状态码，0-成功，其他值-失败
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`          // 返回状态描述
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                                   // 用户认证token
+	ExpireTime    int64                  `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`      // token过期时间戳 (秒)
+	User          *User                  `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`                                     // 用户信息
+	IsNewUser     bool                   `protobuf:"varint,6,opt,name=is_new_user,json=isNewUser,proto3" json:"is_new_user,omitempty"`       // 是否为新用户注册
+	RefreshToken  string                 `protobuf:"bytes,7,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 刷新token，与本次登录的设备会话绑定
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -372,6 +373,13 @@ func (x *LoginResponse) GetIsNewUser() bool {
 	return false
 }
 
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 // 发送短信验证码请求
 type SendSmsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -606,6 +614,143 @@ func (x *VerifyTokenResponse) GetExpireTime() int64 {
 	return 0
 }
 
+// token内省请求
+type IntrospectTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 访问token或刷新token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenRequest) Reset() {
+	*x = IntrospectTokenRequest{}
+	mi := &file_idl_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenRequest) ProtoMessage() {}
+
+func (x *IntrospectTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IntrospectTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type IntrospectTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`                           // token是否可用：签名有效、在有效期内且未加入黑名单
+	UserId        uint32                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID (签名无效时为0)
+	TokenType     string                 `protobuf:"bytes,5,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`     // token类型：access、refresh，签名无效时为空
+	IssuedAt      int64                  `protobuf:"varint,6,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`       // 签发时间戳 (秒)
+	ExpireTime    int64                  `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 过期时间戳 (秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenResponse) Reset() {
+	*x = IntrospectTokenResponse{}
+	mi := &file_idl_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenResponse) ProtoMessage() {}
+
+func (x *IntrospectTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IntrospectTokenResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *IntrospectTokenResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectTokenResponse) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IntrospectTokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *IntrospectTokenResponse) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
 // 刷新token请求
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -616,7 +761,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_idl_user_proto_msgTypes[9]
+	mi := &file_idl_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +773,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[9]
+	mi := &file_idl_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +786,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{9}
+	return file_idl_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -664,7 +809,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_idl_user_proto_msgTypes[10]
+	mi := &file_idl_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -676,7 +821,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[10]
+	mi := &file_idl_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +834,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{10}
+	return file_idl_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RefreshTokenResponse) GetStatusCode() int32 {
@@ -737,7 +882,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_idl_user_proto_msgTypes[11]
+	mi := &file_idl_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +894,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[11]
+	mi := &file_idl_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +907,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{11}
+	return file_idl_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LogoutRequest) GetToken() string {
@@ -782,7 +927,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_idl_user_proto_msgTypes[12]
+	mi := &file_idl_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -794,7 +939,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[12]
+	mi := &file_idl_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +952,7 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{12}
+	return file_idl_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LogoutResponse) GetStatusCode() int32 {
@@ -835,7 +980,7 @@ type GetUserInfoRequest struct {
 
 func (x *GetUserInfoRequest) Reset() {
 	*x = GetUserInfoRequest{}
-	mi := &file_idl_user_proto_msgTypes[13]
+	mi := &file_idl_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -847,7 +992,7 @@ func (x *GetUserInfoRequest) String() string {
 func (*GetUserInfoRequest) ProtoMessage() {}
 
 func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[13]
+	mi := &file_idl_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1005,7 @@ func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{13}
+	return file_idl_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserInfoRequest) GetUserId() uint32 {
@@ -888,7 +1033,7 @@ type GetUserInfosRequest struct {
 
 func (x *GetUserInfosRequest) Reset() {
 	*x = GetUserInfosRequest{}
-	mi := &file_idl_user_proto_msgTypes[14]
+	mi := &file_idl_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -900,7 +1045,7 @@ func (x *GetUserInfosRequest) String() string {
 func (*GetUserInfosRequest) ProtoMessage() {}
 
 func (x *GetUserInfosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[14]
+	mi := &file_idl_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1058,7 @@ func (x *GetUserInfosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserInfosRequest.ProtoReflect.Descriptor instead.
 func (*GetUserInfosRequest) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{14}
+	return file_idl_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUserInfosRequest) GetUserIds() []uint32 {
@@ -941,7 +1086,7 @@ type GetUserInfosResponse struct {
 
 func (x *GetUserInfosResponse) Reset() {
 	*x = GetUserInfosResponse{}
-	mi := &file_idl_user_proto_msgTypes[15]
+	mi := &file_idl_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1098,7 @@ func (x *GetUserInfosResponse) String() string {
 func (*GetUserInfosResponse) ProtoMessage() {}
 
 func (x *GetUserInfosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[15]
+	mi := &file_idl_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1111,7 @@ func (x *GetUserInfosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserInfosResponse.ProtoReflect.Descriptor instead.
 func (*GetUserInfosResponse) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{15}
+	return file_idl_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserInfosResponse) GetStatusCode() int32 {
@@ -1004,7 +1149,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_idl_user_proto_msgTypes[16]
+	mi := &file_idl_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1016,7 +1161,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[16]
+	mi := &file_idl_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1174,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{16}
+	return file_idl_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateUserRequest) GetToken() string {
@@ -1078,7 +1223,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_idl_user_proto_msgTypes[17]
+	mi := &file_idl_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1090,7 +1235,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[17]
+	mi := &file_idl_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1248,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{17}
+	return file_idl_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateUserResponse) GetStatusCode() int32 {
@@ -1127,29 +1272,31 @@ func (x *UpdateUserResponse) GetUser() *User {
 	return nil
 }
 
-// 用户存在性检查请求
-type UserExistRequest struct {
+// 上传头像请求
+type UpdateAvatarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户id
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // 用户token
+	Image         []byte                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                                // 图片内容，仅支持jpeg/png/gif/webp
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 客户端声明的图片类型，可为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserExistRequest) Reset() {
-	*x = UserExistRequest{}
-	mi := &file_idl_user_proto_msgTypes[18]
+func (x *UpdateAvatarRequest) Reset() {
+	*x = UpdateAvatarRequest{}
+	mi := &file_idl_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserExistRequest) String() string {
+func (x *UpdateAvatarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserExistRequest) ProtoMessage() {}
+func (*UpdateAvatarRequest) ProtoMessage() {}
 
-func (x *UserExistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[18]
+func (x *UpdateAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,42 +1307,56 @@ func (x *UserExistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserExistRequest.ProtoReflect.Descriptor instead.
-func (*UserExistRequest) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UpdateAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UserExistRequest) GetUserId() uint32 {
+func (x *UpdateAvatarRequest) GetToken() string {
 	if x != nil {
-		return x.UserId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type UserExistResponse struct {
+func (x *UpdateAvatarRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *UpdateAvatarRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UpdateAvatarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
 	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
-	Existed       bool                   `protobuf:"varint,3,opt,name=existed,proto3" json:"existed,omitempty"`                         // 用户是否存在
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`     // 新头像URL
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserExistResponse) Reset() {
-	*x = UserExistResponse{}
-	mi := &file_idl_user_proto_msgTypes[19]
+func (x *UpdateAvatarResponse) Reset() {
+	*x = UpdateAvatarResponse{}
+	mi := &file_idl_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserExistResponse) String() string {
+func (x *UpdateAvatarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserExistResponse) ProtoMessage() {}
+func (*UpdateAvatarResponse) ProtoMessage() {}
 
-func (x *UserExistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[19]
+func (x *UpdateAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,69 +1367,55 @@ func (x *UserExistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserExistResponse.ProtoReflect.Descriptor instead.
-func (*UserExistResponse) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UpdateAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UserExistResponse) GetStatusCode() int32 {
+func (x *UpdateAvatarResponse) GetStatusCode() int32 {
 	if x != nil {
 		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *UserExistResponse) GetStatusMsg() string {
+func (x *UpdateAvatarResponse) GetStatusMsg() string {
 	if x != nil {
 		return x.StatusMsg
 	}
 	return ""
 }
 
-func (x *UserExistResponse) GetExisted() bool {
+func (x *UpdateAvatarResponse) GetAvatarUrl() string {
 	if x != nil {
-		return x.Existed
+		return x.AvatarUrl
 	}
-	return false
+	return ""
 }
 
-type User struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 用户id
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                    // 用户名称
-	Phone           string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`                                                  // 手机号 (脱敏显示)
-	FollowCount     *uint32                `protobuf:"varint,4,opt,name=follow_count,json=followCount,proto3,oneof" json:"follow_count,omitempty"`            // 关注总数
-	FollowerCount   *uint32                `protobuf:"varint,5,opt,name=follower_count,json=followerCount,proto3,oneof" json:"follower_count,omitempty"`      // 粉丝总数
-	IsFollow        bool                   `protobuf:"varint,6,opt,name=is_follow,json=isFollow,proto3" json:"is_follow,omitempty"`                           // true-已关注，false-未关注
-	Avatar          *string                `protobuf:"bytes,7,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`                                          // 用户头像URL
-	BackgroundImage *string                `protobuf:"bytes,8,opt,name=background_image,json=backgroundImage,proto3,oneof" json:"background_image,omitempty"` // 用户个人页顶部大图
-	Signature       *string                `protobuf:"bytes,9,opt,name=signature,proto3,oneof" json:"signature,omitempty"`                                    // 个人简介
-	TotalFavorited  *uint32                `protobuf:"varint,10,opt,name=total_favorited,json=totalFavorited,proto3,oneof" json:"total_favorited,omitempty"`  // 获赞数量
-	WorkCount       *uint32                `protobuf:"varint,11,opt,name=work_count,json=workCount,proto3,oneof" json:"work_count,omitempty"`                 // 作品数量
-	FavoriteCount   *uint32                `protobuf:"varint,12,opt,name=favorite_count,json=favoriteCount,proto3,oneof" json:"favorite_count,omitempty"`     // 点赞数量
-	CreateTime      int64                  `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                    // 用户注册时间戳
-	LastLoginTime   int64                  `protobuf:"varint,14,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`         // 最后登录时间戳
-	IsVerified      bool                   `protobuf:"varint,15,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`                    // 是否认证用户
-	UserType        string                 `protobuf:"bytes,16,opt,name=user_type,json=userType,proto3" json:"user_type,omitempty"`                           // 用户类型: normal, verified, official
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 用户存在性检查请求
+type UserExistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_idl_user_proto_msgTypes[20]
+func (x *UserExistRequest) Reset() {
+	*x = UserExistRequest{}
+	mi := &file_idl_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *UserExistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*UserExistRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_user_proto_msgTypes[20]
+func (x *UserExistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,9 +1426,128 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
+// Deprecated: Use UserExistRequest.ProtoReflect.Descriptor instead.
+func (*UserExistRequest) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserExistRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UserExistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
+	Existed       bool                   `protobuf:"varint,3,opt,name=existed,proto3" json:"existed,omitempty"`                         // 用户是否存在
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExistResponse) Reset() {
+	*x = UserExistResponse{}
+	mi := &file_idl_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExistResponse) ProtoMessage() {}
+
+func (x *UserExistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExistResponse.ProtoReflect.Descriptor instead.
+func (*UserExistResponse) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserExistResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UserExistResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *UserExistResponse) GetExisted() bool {
+	if x != nil {
+		return x.Existed
+	}
+	return false
+}
+
+type User struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 用户id
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                    // 用户名称
+	Phone           string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`                                                  // 手机号 (脱敏显示)
+	FollowCount     *uint32                `protobuf:"varint,4,opt,name=follow_count,json=followCount,proto3,oneof" json:"follow_count,omitempty"`            // 关注总数
+	FollowerCount   *uint32                `protobuf:"varint,5,opt,name=follower_count,json=followerCount,proto3,oneof" json:"follower_count,omitempty"`      // 粉丝总数
+	IsFollow        bool                   `protobuf:"varint,6,opt,name=is_follow,json=isFollow,proto3" json:"is_follow,omitempty"`                           // true-已关注，false-未关注
+	Avatar          *string                `protobuf:"bytes,7,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`                                          // 用户头像URL
+	BackgroundImage *string                `protobuf:"bytes,8,opt,name=background_image,json=backgroundImage,proto3,oneof" json:"background_image,omitempty"` // 用户个人页顶部大图
+	Signature       *string                `protobuf:"bytes,9,opt,name=signature,proto3,oneof" json:"signature,omitempty"`                                    // 个人简介
+	TotalFavorited  *uint32                `protobuf:"varint,10,opt,name=total_favorited,json=totalFavorited,proto3,oneof" json:"total_favorited,omitempty"`  // 获赞数量
+	WorkCount       *uint32                `protobuf:"varint,11,opt,name=work_count,json=workCount,proto3,oneof" json:"work_count,omitempty"`                 // 作品数量
+	FavoriteCount   *uint32                `protobuf:"varint,12,opt,name=favorite_count,json=favoriteCount,proto3,oneof" json:"favorite_count,omitempty"`     // 点赞数量
+	CreateTime      int64                  `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                    // 用户注册时间戳
+	LastLoginTime   int64                  `protobuf:"varint,14,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`         // 最后登录时间戳
+	IsVerified      bool                   `protobuf:"varint,15,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`                    // 是否认证用户
+	UserType        string                 `protobuf:"bytes,16,opt,name=user_type,json=userType,proto3" json:"user_type,omitempty"`                           // 用户类型: normal, verified, official
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_idl_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_idl_user_proto_rawDescGZIP(), []int{20}
+	return file_idl_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *User) GetId() uint32 {
@@ -1396,6 +1662,441 @@ func (x *User) GetUserType() string {
 	return ""
 }
 
+// 管理后台用户信息
+type AdminUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`                                   // 用户信息
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                               // 用户状态：active、disabled、banned
+	BanReason     string                 `protobuf:"bytes,3,opt,name=ban_reason,json=banReason,proto3" json:"ban_reason,omitempty"`        // 封禁原因
+	BannedUntil   int64                  `protobuf:"varint,4,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"` // 封禁截止时间戳 (秒)，0表示永久封禁或未封禁
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_idl_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AdminUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AdminUser) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminUser) GetBanReason() string {
+	if x != nil {
+		return x.BanReason
+	}
+	return ""
+}
+
+func (x *AdminUser) GetBannedUntil() int64 {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return 0
+}
+
+// 封禁用户请求
+type BanUserRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // 用户ID
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                           // 封禁原因
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 封禁时长 (秒)，小于等于0表示永久封禁
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_idl_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BanUserRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// 封禁用户响应
+type BanUserResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode      int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`                // 状态码，0-成功，其他值-失败
+	StatusMsg       string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`                    // 返回状态描述
+	RevokedSessions int32                  `protobuf:"varint,3,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"` // 被注销的登录会话数
+	BannedUntil     int64                  `protobuf:"varint,4,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`             // 封禁截止时间戳 (秒)，0表示永久封禁
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	mi := &file_idl_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserResponse) ProtoMessage() {}
+
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BanUserResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *BanUserResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *BanUserResponse) GetRevokedSessions() int32 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
+func (x *BanUserResponse) GetBannedUntil() int64 {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return 0
+}
+
+// 解封用户请求
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	mi := &file_idl_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserRequest) ProtoMessage() {}
+
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnbanUserRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 解封用户响应
+type UnbanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserResponse) Reset() {
+	*x = UnbanUserResponse{}
+	mi := &file_idl_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserResponse) ProtoMessage() {}
+
+func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnbanUserResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UnbanUserResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+// 管理后台查询用户列表请求
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                      // 按状态过滤：active、disabled、banned，为空时不过滤
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`                    // 按用户名、昵称模糊匹配或按手机号精确匹配
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_idl_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUsersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 管理后台查询用户列表响应
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
+	Users         []*AdminUser           `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`                              // 用户列表
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                             // 符合条件的用户总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_idl_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_idl_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListUsersResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_idl_user_proto protoreflect.FileDescriptor
 
 const file_idl_user_proto_rawDesc = "" +
@@ -1423,7 +2124,7 @@ const file_idl_user_proto_rawDesc = "" +
 	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12\x17\n" +
 	"\aos_type\x18\x04 \x01(\tR\x06osType\x12\x1f\n" +
 	"\vapp_version\x18\x05 \x01(\tR\n" +
-	"appVersion\"\xca\x01\n" +
+	"appVersion\"\xef\x01\n" +
 	"\rLoginResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
@@ -1433,7 +2134,8 @@ const file_idl_user_proto_rawDesc = "" +
 	"\vexpire_time\x18\x04 \x01(\x03R\n" +
 	"expireTime\x12\"\n" +
 	"\x04user\x18\x05 \x01(\v2\x0e.rpc.user.UserR\x04user\x12\x1e\n" +
-	"\vis_new_user\x18\x06 \x01(\bR\tisNewUser\"A\n" +
+	"\vis_new_user\x18\x06 \x01(\bR\tisNewUser\x12#\n" +
+	"\rrefresh_token\x18\a \x01(\tR\frefreshToken\"A\n" +
 	"\x0eSendSmsRequest\x12\x14\n" +
 	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x19\n" +
 	"\bsms_type\x18\x02 \x01(\tR\asmsType\"x\n" +
@@ -1453,6 +2155,20 @@ const file_idl_user_proto_rawDesc = "" +
 	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x17\n" +
 	"\auser_id\x18\x04 \x01(\rR\x06userId\x12\x1f\n" +
 	"\vexpire_time\x18\x05 \x01(\x03R\n" +
+	"expireTime\".\n" +
+	"\x16IntrospectTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xe7\x01\n" +
+	"\x17IntrospectTokenResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x05 \x01(\tR\ttokenType\x12\x1b\n" +
+	"\tissued_at\x18\x06 \x01(\x03R\bissuedAt\x12\x1f\n" +
+	"\vexpire_time\x18\a \x01(\x03R\n" +
 	"expireTime\":\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xb2\x01\n" +
@@ -1500,7 +2216,18 @@ const file_idl_user_proto_rawDesc = "" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\"\n" +
-	"\x04user\x18\x03 \x01(\v2\x0e.rpc.user.UserR\x04user\"+\n" +
+	"\x04user\x18\x03 \x01(\v2\x0e.rpc.user.UserR\x04user\"d\n" +
+	"\x13UpdateAvatarRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\fR\x05image\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"u\n" +
+	"\x14UpdateAvatarResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\"+\n" +
 	"\x10UserExistRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\"m\n" +
 	"\x11UserExistResponse\x12\x1f\n" +
@@ -1538,19 +2265,60 @@ const file_idl_user_proto_rawDesc = "" +
 	"_signatureB\x12\n" +
 	"\x10_total_favoritedB\r\n" +
 	"\v_work_countB\x11\n" +
-	"\x0f_favorite_count2\xe4\x05\n" +
+	"\x0f_favorite_count\"\x89\x01\n" +
+	"\tAdminUser\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.rpc.user.UserR\x04user\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"ban_reason\x18\x03 \x01(\tR\tbanReason\x12!\n" +
+	"\fbanned_until\x18\x04 \x01(\x03R\vbannedUntil\"l\n" +
+	"\x0eBanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"\x9f\x01\n" +
+	"\x0fBanUserResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12)\n" +
+	"\x10revoked_sessions\x18\x03 \x01(\x05R\x0frevokedSessions\x12!\n" +
+	"\fbanned_until\x18\x04 \x01(\x03R\vbannedUntil\"+\n" +
+	"\x10UnbanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"S\n" +
+	"\x11UnbanUserResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"u\n" +
+	"\x10ListUsersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x94\x01\n" +
+	"\x11ListUsersResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12)\n" +
+	"\x05users\x18\x03 \x03(\v2\x13.rpc.user.AdminUserR\x05users\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total2\xd7\b\n" +
 	"\vUserService\x12B\n" +
 	"\n" +
 	"PhoneLogin\x12\x1b.rpc.user.PhoneLoginRequest\x1a\x17.rpc.user.LoginResponse\x12@\n" +
 	"\tCodeLogin\x12\x1a.rpc.user.CodeLoginRequest\x1a\x17.rpc.user.LoginResponse\x12B\n" +
 	"\vSendSmsCode\x12\x18.rpc.user.SendSmsRequest\x1a\x19.rpc.user.SendSmsResponse\x12J\n" +
-	"\vVerifyToken\x12\x1c.rpc.user.VerifyTokenRequest\x1a\x1d.rpc.user.VerifyTokenResponse\x12M\n" +
+	"\vVerifyToken\x12\x1c.rpc.user.VerifyTokenRequest\x1a\x1d.rpc.user.VerifyTokenResponse\x12V\n" +
+	"\x0fIntrospectToken\x12 .rpc.user.IntrospectTokenRequest\x1a!.rpc.user.IntrospectTokenResponse\x12M\n" +
 	"\fRefreshToken\x12\x1d.rpc.user.RefreshTokenRequest\x1a\x1e.rpc.user.RefreshTokenResponse\x12;\n" +
 	"\x06Logout\x12\x17.rpc.user.LogoutRequest\x1a\x18.rpc.user.LogoutResponse\x12C\n" +
 	"\vGetUserInfo\x12\x1c.rpc.user.GetUserInfoRequest\x1a\x16.rpc.user.UserResponse\x12M\n" +
 	"\fGetUserInfos\x12\x1d.rpc.user.GetUserInfosRequest\x1a\x1e.rpc.user.GetUserInfosResponse\x12K\n" +
-	"\x0eUpdateUserInfo\x12\x1b.rpc.user.UpdateUserRequest\x1a\x1c.rpc.user.UpdateUserResponse\x12R\n" +
-	"\x17GetUserExistInformation\x12\x1a.rpc.user.UserExistRequest\x1a\x1b.rpc.user.UserExistResponseB\x14Z\x12rpc/user/proto_genb\x06proto3"
+	"\x0eUpdateUserInfo\x12\x1b.rpc.user.UpdateUserRequest\x1a\x1c.rpc.user.UpdateUserResponse\x12M\n" +
+	"\fUpdateAvatar\x12\x1d.rpc.user.UpdateAvatarRequest\x1a\x1e.rpc.user.UpdateAvatarResponse\x12R\n" +
+	"\x17GetUserExistInformation\x12\x1a.rpc.user.UserExistRequest\x1a\x1b.rpc.user.UserExistResponse\x12>\n" +
+	"\aBanUser\x12\x18.rpc.user.BanUserRequest\x1a\x19.rpc.user.BanUserResponse\x12D\n" +
+	"\tUnbanUser\x12\x1a.rpc.user.UnbanUserRequest\x1a\x1b.rpc.user.UnbanUserResponse\x12D\n" +
+	"\tListUsers\x12\x1a.rpc.user.ListUsersRequest\x1a\x1b.rpc.user.ListUsersResponseB\x14Z\x12rpc/user/proto_genb\x06proto3"
 
 var (
 	file_idl_user_proto_rawDescOnce sync.Once
@@ -1564,60 +2332,83 @@ func file_idl_user_proto_rawDescGZIP() []byte {
 	return file_idl_user_proto_rawDescData
 }
 
-var file_idl_user_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_idl_user_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_idl_user_proto_goTypes = []any{
-	(*UserRequest)(nil),          // 0: rpc.user.UserRequest
-	(*UserResponse)(nil),         // 1: rpc.user.UserResponse
-	(*PhoneLoginRequest)(nil),    // 2: rpc.user.PhoneLoginRequest
-	(*CodeLoginRequest)(nil),     // 3: rpc.user.CodeLoginRequest
-	(*LoginResponse)(nil),        // 4: rpc.user.LoginResponse
-	(*SendSmsRequest)(nil),       // 5: rpc.user.SendSmsRequest
-	(*SendSmsResponse)(nil),      // 6: rpc.user.SendSmsResponse
-	(*VerifyTokenRequest)(nil),   // 7: rpc.user.VerifyTokenRequest
-	(*VerifyTokenResponse)(nil),  // 8: rpc.user.VerifyTokenResponse
-	(*RefreshTokenRequest)(nil),  // 9: rpc.user.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil), // 10: rpc.user.RefreshTokenResponse
-	(*LogoutRequest)(nil),        // 11: rpc.user.LogoutRequest
-	(*LogoutResponse)(nil),       // 12: rpc.user.LogoutResponse
-	(*GetUserInfoRequest)(nil),   // 13: rpc.user.GetUserInfoRequest
-	(*GetUserInfosRequest)(nil),  // 14: rpc.user.GetUserInfosRequest
-	(*GetUserInfosResponse)(nil), // 15: rpc.user.GetUserInfosResponse
-	(*UpdateUserRequest)(nil),    // 16: rpc.user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),   // 17: rpc.user.UpdateUserResponse
-	(*UserExistRequest)(nil),     // 18: rpc.user.UserExistRequest
-	(*UserExistResponse)(nil),    // 19: rpc.user.UserExistResponse
-	(*User)(nil),                 // 20: rpc.user.User
+	(*UserRequest)(nil),             // 0: rpc.user.UserRequest
+	(*UserResponse)(nil),            // 1: rpc.user.UserResponse
+	(*PhoneLoginRequest)(nil),       // 2: rpc.user.PhoneLoginRequest
+	(*CodeLoginRequest)(nil),        // 3: rpc.user.CodeLoginRequest
+	(*LoginResponse)(nil),           // 4: rpc.user.LoginResponse
+	(*SendSmsRequest)(nil),          // 5: rpc.user.SendSmsRequest
+	(*SendSmsResponse)(nil),         // 6: rpc.user.SendSmsResponse
+	(*VerifyTokenRequest)(nil),      // 7: rpc.user.VerifyTokenRequest
+	(*VerifyTokenResponse)(nil),     // 8: rpc.user.VerifyTokenResponse
+	(*IntrospectTokenRequest)(nil),  // 9: rpc.user.IntrospectTokenRequest
+	(*IntrospectTokenResponse)(nil), // 10: rpc.user.IntrospectTokenResponse
+	(*RefreshTokenRequest)(nil),     // 11: rpc.user.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),    // 12: rpc.user.RefreshTokenResponse
+	(*LogoutRequest)(nil),           // 13: rpc.user.LogoutRequest
+	(*LogoutResponse)(nil),          // 14: rpc.user.LogoutResponse
+	(*GetUserInfoRequest)(nil),      // 15: rpc.user.GetUserInfoRequest
+	(*GetUserInfosRequest)(nil),     // 16: rpc.user.GetUserInfosRequest
+	(*GetUserInfosResponse)(nil),    // 17: rpc.user.GetUserInfosResponse
+	(*UpdateUserRequest)(nil),       // 18: rpc.user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),      // 19: rpc.user.UpdateUserResponse
+	(*UpdateAvatarRequest)(nil),     // 20: rpc.user.UpdateAvatarRequest
+	(*UpdateAvatarResponse)(nil),    // 21: rpc.user.UpdateAvatarResponse
+	(*UserExistRequest)(nil),        // 22: rpc.user.UserExistRequest
+	(*UserExistResponse)(nil),       // 23: rpc.user.UserExistResponse
+	(*User)(nil),                    // 24: rpc.user.User
+	(*AdminUser)(nil),               // 25: rpc.user.AdminUser
+	(*BanUserRequest)(nil),          // 26: rpc.user.BanUserRequest
+	(*BanUserResponse)(nil),         // 27: rpc.user.BanUserResponse
+	(*UnbanUserRequest)(nil),        // 28: rpc.user.UnbanUserRequest
+	(*UnbanUserResponse)(nil),       // 29: rpc.user.UnbanUserResponse
+	(*ListUsersRequest)(nil),        // 30: rpc.user.ListUsersRequest
+	(*ListUsersResponse)(nil),       // 31: rpc.user.ListUsersResponse
 }
 var file_idl_user_proto_depIdxs = []int32{
-	20, // 0: rpc.user.UserResponse.user:type_name -> rpc.user.User
-	20, // 1: rpc.user.LoginResponse.user:type_name -> rpc.user.User
-	20, // 2: rpc.user.GetUserInfosResponse.users:type_name -> rpc.user.User
-	20, // 3: rpc.user.UpdateUserResponse.user:type_name -> rpc.user.User
-	2,  // 4: rpc.user.UserService.PhoneLogin:input_type -> rpc.user.PhoneLoginRequest
-	3,  // 5: rpc.user.UserService.CodeLogin:input_type -> rpc.user.CodeLoginRequest
-	5,  // 6: rpc.user.UserService.SendSmsCode:input_type -> rpc.user.SendSmsRequest
-	7,  // 7: rpc.user.UserService.VerifyToken:input_type -> rpc.user.VerifyTokenRequest
-	9,  // 8: rpc.user.UserService.RefreshToken:input_type -> rpc.user.RefreshTokenRequest
-	11, // 9: rpc.user.UserService.Logout:input_type -> rpc.user.LogoutRequest
-	13, // 10: rpc.user.UserService.GetUserInfo:input_type -> rpc.user.GetUserInfoRequest
-	14, // 11: rpc.user.UserService.GetUserInfos:input_type -> rpc.user.GetUserInfosRequest
-	16, // 12: rpc.user.UserService.UpdateUserInfo:input_type -> rpc.user.UpdateUserRequest
-	18, // 13: rpc.user.UserService.GetUserExistInformation:input_type -> rpc.user.UserExistRequest
-	4,  // 14: rpc.user.UserService.PhoneLogin:output_type -> rpc.user.LoginResponse
-	4,  // 15: rpc.user.UserService.CodeLogin:output_type -> rpc.user.LoginResponse
-	6,  // 16: rpc.user.UserService.SendSmsCode:output_type -> rpc.user.SendSmsResponse
-	8,  // 17: rpc.user.UserService.VerifyToken:output_type -> rpc.user.VerifyTokenResponse
-	10, // 18: rpc.user.UserService.RefreshToken:output_type -> rpc.user.RefreshTokenResponse
-	12, // 19: rpc.user.UserService.Logout:output_type -> rpc.user.LogoutResponse
-	1,  // 20: rpc.user.UserService.GetUserInfo:output_type -> rpc.user.UserResponse
-	15, // 21: rpc.user.UserService.GetUserInfos:output_type -> rpc.user.GetUserInfosResponse
-	17, // 22: rpc.user.UserService.UpdateUserInfo:output_type -> rpc.user.UpdateUserResponse
-	19, // 23: rpc.user.UserService.GetUserExistInformation:output_type -> rpc.user.UserExistResponse
-	14, // [14:24] is the sub-list for method output_type
-	4,  // [4:14] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	24, // 0: rpc.user.UserResponse.user:type_name -> rpc.user.User
+	24, // 1: rpc.user.LoginResponse.user:type_name -> rpc.user.User
+	24, // 2: rpc.user.GetUserInfosResponse.users:type_name -> rpc.user.User
+	24, // 3: rpc.user.UpdateUserResponse.user:type_name -> rpc.user.User
+	24, // 4: rpc.user.AdminUser.user:type_name -> rpc.user.User
+	25, // 5: rpc.user.ListUsersResponse.users:type_name -> rpc.user.AdminUser
+	2,  // 6: rpc.user.UserService.PhoneLogin:input_type -> rpc.user.PhoneLoginRequest
+	3,  // 7: rpc.user.UserService.CodeLogin:input_type -> rpc.user.CodeLoginRequest
+	5,  // 8: rpc.user.UserService.SendSmsCode:input_type -> rpc.user.SendSmsRequest
+	7,  // 9: rpc.user.UserService.VerifyToken:input_type -> rpc.user.VerifyTokenRequest
+	9,  // 10: rpc.user.UserService.IntrospectToken:input_type -> rpc.user.IntrospectTokenRequest
+	11, // 11: rpc.user.UserService.RefreshToken:input_type -> rpc.user.RefreshTokenRequest
+	13, // 12: rpc.user.UserService.Logout:input_type -> rpc.user.LogoutRequest
+	15, // 13: rpc.user.UserService.GetUserInfo:input_type -> rpc.user.GetUserInfoRequest
+	16, // 14: rpc.user.UserService.GetUserInfos:input_type -> rpc.user.GetUserInfosRequest
+	18, // 15: rpc.user.UserService.UpdateUserInfo:input_type -> rpc.user.UpdateUserRequest
+	20, // 16: rpc.user.UserService.UpdateAvatar:input_type -> rpc.user.UpdateAvatarRequest
+	22, // 17: rpc.user.UserService.GetUserExistInformation:input_type -> rpc.user.UserExistRequest
+	26, // 18: rpc.user.UserService.BanUser:input_type -> rpc.user.BanUserRequest
+	28, // 19: rpc.user.UserService.UnbanUser:input_type -> rpc.user.UnbanUserRequest
+	30, // 20: rpc.user.UserService.ListUsers:input_type -> rpc.user.ListUsersRequest
+	4,  // 21: rpc.user.UserService.PhoneLogin:output_type -> rpc.user.LoginResponse
+	4,  // 22: rpc.user.UserService.CodeLogin:output_type -> rpc.user.LoginResponse
+	6,  // 23: rpc.user.UserService.SendSmsCode:output_type -> rpc.user.SendSmsResponse
+	8,  // 24: rpc.user.UserService.VerifyToken:output_type -> rpc.user.VerifyTokenResponse
+	10, // 25: rpc.user.UserService.IntrospectToken:output_type -> rpc.user.IntrospectTokenResponse
+	12, // 26: rpc.user.UserService.RefreshToken:output_type -> rpc.user.RefreshTokenResponse
+	14, // 27: rpc.user.UserService.Logout:output_type -> rpc.user.LogoutResponse
+	1,  // 28: rpc.user.UserService.GetUserInfo:output_type -> rpc.user.UserResponse
+	17, // 29: rpc.user.UserService.GetUserInfos:output_type -> rpc.user.GetUserInfosResponse
+	19, // 30: rpc.user.UserService.UpdateUserInfo:output_type -> rpc.user.UpdateUserResponse
+	21, // 31: rpc.user.UserService.UpdateAvatar:output_type -> rpc.user.UpdateAvatarResponse
+	23, // 32: rpc.user.UserService.GetUserExistInformation:output_type -> rpc.user.UserExistResponse
+	27, // 33: rpc.user.UserService.BanUser:output_type -> rpc.user.BanUserResponse
+	29, // 34: rpc.user.UserService.UnbanUser:output_type -> rpc.user.UnbanUserResponse
+	31, // 35: rpc.user.UserService.ListUsers:output_type -> rpc.user.ListUsersResponse
+	21, // [21:36] is the sub-list for method output_type
+	6,  // [6:21] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_idl_user_proto_init() }
@@ -1625,15 +2416,15 @@ func file_idl_user_proto_init() {
 	if File_idl_user_proto != nil {
 		return
 	}
-	file_idl_user_proto_msgTypes[16].OneofWrappers = []any{}
-	file_idl_user_proto_msgTypes[20].OneofWrappers = []any{}
+	file_idl_user_proto_msgTypes[18].OneofWrappers = []any{}
+	file_idl_user_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_user_proto_rawDesc), len(file_idl_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,20 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
 
 	"github.com/vision_world/video_service/internal/config"
 	"github.com/vision_world/video_service/internal/handler"
+	"github.com/vision_world/video_service/pkg/grpctls"
+	"github.com/vision_world/video_service/pkg/interceptors"
 	"github.com/vision_world/video_service/pkg/logger"
 	pb "github.com/vision_world/video_service/proto/proto_gen/video"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"common/lifecycle"
 )
 
 func main() {
@@ -32,7 +34,22 @@ func main() {
 	logger.InitLogger(cfg.Log.Level, cfg.Log.File)
 
 	// 创建gRPC服务器
-	grpcServer := grpc.NewServer()
+	serverCreds, err := grpctls.ServerCredentials(grpctls.Options{
+		Enabled:    cfg.Server.TLS.Enabled,
+		CertFile:   cfg.Server.TLS.CertFile,
+		KeyFile:    cfg.Server.TLS.KeyFile,
+		CAFile:     cfg.Server.TLS.ClientCAFile,
+		ClientAuth: cfg.Server.TLS.ClientAuth,
+	})
+	if err != nil {
+		logger.Fatal("Failed to load gRPC TLS credentials", zap.Error(err))
+	}
+	if !cfg.Server.TLS.Enabled && cfg.Server.Environment == "production" {
+		logger.Warn("gRPC TLS is disabled, traffic between services is not encrypted")
+	}
+	// 拦截器按添加顺序执行，panic恢复紧贴处理函数
+	chain := interceptors.Chain(interceptors.UnaryRecovery(logPanic)).Stream(interceptors.StreamRecovery(logPanic))
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(serverCreds)}, chain.ServerOptions()...)...)
 
 	// 注册健康检查服务
 	healthServer := health.NewServer()
@@ -59,20 +76,35 @@ func main() {
 		logger.Fatal("Failed to register service", zap.Error(err))
 	}
 
-	// 优雅关闭
+	// 优雅关闭：先停止接收流量并排空在途请求，再关闭数据库、Redis等依赖
+	shutdownDone := make(chan struct{})
 	go func() {
-		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-		<-sigChan
-
-		logger.Info("Shutting down server...")
-		healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
-		videoHandler.Close()
-		grpcServer.GracefulStop()
+		defer close(shutdownDone)
+
+		sig := lifecycle.WaitForSignal()
+		logger.Info("Shutting down server...", zap.String("signal", sig.String()))
+
+		shutdown := lifecycle.New(logger.KeyValue{}, lifecycle.DefaultStepTimeout)
+		shutdown.Add("health", func(ctx context.Context) error {
+			healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			return nil
+		})
+		shutdown.Add("grpc", lifecycle.GRPCServer(grpcServer))
+		shutdown.Add("video_handler", lifecycle.Closer(videoHandler.Close))
+		shutdown.Run()
 	}()
 
 	logger.Info("Video service starting", zap.String("address", cfg.Server.Address))
 	if err := grpcServer.Serve(lis); err != nil {
 		logger.Fatal("Failed to serve", zap.Error(err))
 	}
+
+	// GracefulStop开始后Serve立即返回，需等待退出流程执行完毕
+	<-shutdownDone
+	logger.Info("Server stopped gracefully")
+}
+
+// logPanic 记录gRPC处理函数中的panic
+func logPanic(ctx context.Context, method string, p interface{}, stack []byte) {
+	logger.Error("gRPC handler panic", zap.String("method", method), zap.Any("panic", p), zap.ByteString("stack", stack))
 }
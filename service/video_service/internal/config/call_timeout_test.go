@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAuditServiceCallTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want time.Duration
+	}{
+		{"configured", "services:\n  audit_service:\n    call_timeout: 500ms\n", 500 * time.Millisecond},
+		{"default", "services:\n  audit_service:\n    timeout: 5\n", defaultCallTimeout},
+		{"non-positive", "services:\n  audit_service:\n    call_timeout: -1s\n", defaultCallTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadConfig(t, tt.yaml)
+			if got := cfg.Services.AuditService.GetCallTimeout(); got != tt.want {
+				t.Errorf("call timeout = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -15,6 +16,13 @@ type Config struct {
 	Discovery DiscoveryConfig `mapstructure:"discovery"`
 	Log       LogConfig       `mapstructure:"log"`
 	Services  ServicesConfig  `mapstructure:"services"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	Share     ShareConfig     `mapstructure:"share"`
+	Publish   PublishConfig   `mapstructure:"publish"`
+	Recommend RecommendConfig `mapstructure:"recommend"`
+
+	FeatureFlags FeatureFlagsConfig `mapstructure:"feature_flags"`
+	SearchIndex  SearchIndexConfig  `mapstructure:"search_index"`
 }
 
 type ServerConfig struct {
@@ -22,6 +30,17 @@ type ServerConfig struct {
 	Name        string `mapstructure:"name"`
 	Version     string `mapstructure:"version"`
 	Environment string `mapstructure:"environment"`
+
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// TLSConfig gRPC服务端TLS配置，未开启时使用明文连接，仅适用于本地开发，跨主机部署必须开启
+type TLSConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	CertFile     string `mapstructure:"cert_file"`      // 服务端证书
+	KeyFile      string `mapstructure:"key_file"`       // 服务端私钥
+	ClientAuth   bool   `mapstructure:"client_auth"`    // 要求并校验客户端证书(mTLS)
+	ClientCAFile string `mapstructure:"client_ca_file"` // 校验客户端证书的CA，开启ClientAuth时必填
 }
 
 type DatabaseConfig struct {
@@ -40,6 +59,13 @@ type RedisConfig struct {
 	Password string `mapstructure:"password"`
 	DB       int    `mapstructure:"db"`
 	PoolSize int    `mapstructure:"pool_size"`
+
+	// 部署模式，single(默认)、sentinel或cluster
+	Mode             string   `mapstructure:"mode"`
+	MasterName       string   `mapstructure:"master_name"`       // sentinel模式的主节点名称
+	SentinelAddrs    []string `mapstructure:"sentinel_addrs"`    // sentinel模式的哨兵地址
+	SentinelPassword string   `mapstructure:"sentinel_password"` // 哨兵的认证密码，为空时不认证
+	ClusterNodes     []string `mapstructure:"cluster_nodes"`     // cluster模式的种子节点地址
 }
 
 type KafkaConfig struct {
@@ -65,7 +91,120 @@ type ServicesConfig struct {
 type ServiceConfig struct {
 	Name    string `mapstructure:"name"`
 	Address string `mapstructure:"address"`
-	Timeout int    `mapstructure:"timeout"`
+	Timeout int    `mapstructure:"timeout"` // 建立连接的超时时间（秒）
+
+	// CallTimeout 单次调用的超时时间，下游无响应时不会一直阻塞请求
+	CallTimeout time.Duration `mapstructure:"call_timeout"`
+
+	// 连接断开后在下一次调用时重新建立连接，连续失败时重连间隔从redial_interval开始翻倍，不超过max_redial_interval
+	RedialInterval    time.Duration `mapstructure:"redial_interval"`
+	MaxRedialInterval time.Duration `mapstructure:"max_redial_interval"`
+
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+
+	TLS ClientTLSConfig `mapstructure:"tls"`
+}
+
+// CircuitBreakerConfig 下游服务熔断配置
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"` // 连续失败多少次后开启熔断
+	Cooldown         time.Duration `mapstructure:"cooldown"`          // 开启后多久进入半开状态
+}
+
+// defaultCallTimeout 未配置call_timeout时单次调用的超时时间
+const defaultCallTimeout = 3 * time.Second
+
+// GetCallTimeout 获取单次调用的超时时间
+func (c ServiceConfig) GetCallTimeout() time.Duration {
+	if c.CallTimeout <= 0 {
+		return defaultCallTimeout
+	}
+	return c.CallTimeout
+}
+
+// 未配置时的重连间隔
+const (
+	defaultRedialInterval    = time.Second
+	defaultMaxRedialInterval = 30 * time.Second
+)
+
+// GetDialTimeout 获取建立连接的超时时间
+func (c ServiceConfig) GetDialTimeout() time.Duration {
+	return time.Duration(c.Timeout) * time.Second
+}
+
+// RedialBackoff 连续第failures次重连失败后距下次重连的等待时间，failures从1开始
+func (c ServiceConfig) RedialBackoff(failures int) time.Duration {
+	interval := c.RedialInterval
+	if interval <= 0 {
+		interval = defaultRedialInterval
+	}
+	maxInterval := c.MaxRedialInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxRedialInterval
+	}
+
+	for i := 1; i < failures && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return interval
+}
+
+// ClientTLSConfig 调用下游gRPC服务的TLS配置，需与下游服务端的server.tls配置对应
+type ClientTLSConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	CAFile     string `mapstructure:"ca_file"`     // 校验服务端证书的CA，为空时使用系统根证书
+	CertFile   string `mapstructure:"cert_file"`   // 客户端证书，下游开启client_auth时必填
+	KeyFile    string `mapstructure:"key_file"`    // 客户端私钥
+	ServerName string `mapstructure:"server_name"` // 校验服务端证书使用的名称，为空时取address的主机名
+}
+
+// JWTConfig 用于解析user_service签发的token，需与user_service保持一致
+type JWTConfig struct {
+	Secret       string         `mapstructure:"secret"`
+	KeyID        string         `mapstructure:"key_id"`        // 当前密钥的kid
+	PreviousKeys []JWTKeyConfig `mapstructure:"previous_keys"` // 轮换下来的旧密钥，宽限期内仍可校验
+}
+
+// JWTKeyConfig 轮换下来的JWT密钥
+type JWTKeyConfig struct {
+	KeyID     string `mapstructure:"key_id"`     // 旧token header中的kid，轮换前未配置kid的密钥填空
+	Secret    string `mapstructure:"secret"`     // 访问token密钥
+	ExpiresAt string `mapstructure:"expires_at"` // 宽限期截止时间(RFC3339)，之后不再接受该密钥签发的token
+}
+
+// ShareConfig 分享链接配置
+type ShareConfig struct {
+	BaseURL string `mapstructure:"base_url"` // 短链接前缀，分享码拼接在其后
+}
+
+// PublishConfig 视频发布配置
+type PublishConfig struct {
+	ScheduleInterval time.Duration `mapstructure:"schedule_interval"`  // 定时发布扫描间隔
+	MaxScheduleAhead time.Duration `mapstructure:"max_schedule_ahead"` // 定时发布时间最多可设置到多久之后，0表示不限制
+}
+
+// RecommendConfig 推荐视频配置
+type RecommendConfig struct {
+	MaxPerCreator  int `mapstructure:"max_per_creator"` // 每页同一作者最多出现的视频数，0表示不限制
+	CandidateLimit int `mapstructure:"candidate_limit"` // 参与排序和打散的候选视频数上限，0时使用默认值
+}
+
+// FeatureFlagsConfig 特性开关配置
+type FeatureFlagsConfig struct {
+	RedisKey        string            `mapstructure:"redis_key"`        // Redis中覆盖值所在的hash键
+	RefreshInterval time.Duration     `mapstructure:"refresh_interval"` // 覆盖值刷新间隔
+	Defaults        map[string]string `mapstructure:"defaults"`         // 开关默认值，Redis中未覆盖时生效
+}
+
+// SearchIndexConfig 搜索索引事件配置
+type SearchIndexConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`     // 是否在内容变更后发布索引事件
+	QueueKey   string `mapstructure:"queue_key"`   // 索引事件队列，需与搜索服务一致
+	BufferSize int    `mapstructure:"buffer_size"` // 内存队列长度，队列满时丢弃新事件
 }
 
 func LoadConfig() (*Config, error) {
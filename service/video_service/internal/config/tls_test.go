@@ -0,0 +1,69 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// loadConfig 按LoadConfig的方式用viper解析配置
+func loadConfig(t *testing.T, yaml string) Config {
+	t.Helper()
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("unmarshal config: %v", err)
+	}
+	return cfg
+}
+
+func TestTLSConfig(t *testing.T) {
+	cfg := loadConfig(t, `server:
+  tls:
+    enabled: true
+    cert_file: /etc/tls/server.pem
+    key_file: /etc/tls/server-key.pem
+    client_auth: true
+    client_ca_file: /etc/tls/ca.pem
+services:
+  audit_service:
+    address: audit-service:50053
+    tls:
+      enabled: true
+      ca_file: /etc/tls/ca.pem
+      cert_file: /etc/tls/client.pem
+      key_file: /etc/tls/client-key.pem
+      server_name: audit-service
+`)
+	wantServer := TLSConfig{
+		Enabled:      true,
+		CertFile:     "/etc/tls/server.pem",
+		KeyFile:      "/etc/tls/server-key.pem",
+		ClientAuth:   true,
+		ClientCAFile: "/etc/tls/ca.pem",
+	}
+	if cfg.Server.TLS != wantServer {
+		t.Errorf("server tls = %+v, want %+v", cfg.Server.TLS, wantServer)
+	}
+	wantClient := ClientTLSConfig{
+		Enabled:    true,
+		CAFile:     "/etc/tls/ca.pem",
+		CertFile:   "/etc/tls/client.pem",
+		KeyFile:    "/etc/tls/client-key.pem",
+		ServerName: "audit-service",
+	}
+	if got := cfg.Services.AuditService.TLS; got != wantClient {
+		t.Errorf("audit service tls = %+v, want %+v", got, wantClient)
+	}
+
+	// 未配置时使用明文连接
+	cfg = loadConfig(t, "services:\n  audit_service:\n    address: audit-service:50053\n")
+	if cfg.Server.TLS != (TLSConfig{}) || cfg.Services.AuditService.TLS != (ClientTLSConfig{}) {
+		t.Errorf("default tls = %+v / %+v, want disabled", cfg.Server.TLS, cfg.Services.AuditService.TLS)
+	}
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vision_world/video_service/internal/config"
+	"github.com/vision_world/video_service/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	auditpb "audit_service/proto_gen/audit/v1"
+)
+
+// errAuditCircuitOpen 审核服务熔断期间直接返回的错误
+var errAuditCircuitOpen = status.Error(codes.Unavailable, "audit service circuit breaker is open")
+
+// auditConnector 审核服务连接
+// 连接断开(TRANSIENT_FAILURE或已关闭)后在下一次调用时关闭旧连接并重新建立，
+// 连续重连失败时按退避间隔等待，期间的调用直接失败；调用经熔断器保护，审核服务持续不可用时快速失败
+// 建连在锁外进行，同一时间只有一个调用建连，其他调用等待其结果，锁只保护连接状态
+type auditConnector struct {
+	cfg     config.ServiceConfig
+	creds   credentials.TransportCredentials
+	breaker *CircuitBreaker
+	dial    func(ctx context.Context) (*grpc.ClientConn, error) // 建立连接，测试时可替换
+
+	mu       sync.Mutex
+	conn     *grpc.ClientConn
+	client   auditpb.AuditServiceClient
+	dialing  chan struct{} // 正在建连时非nil，建连结束后关闭
+	closed   bool
+	failures int       // 连续重连失败次数
+	nextDial time.Time // 下次允许重连的时间
+}
+
+// newAuditConnector 创建审核服务连接，需调用connect建立首次连接
+func newAuditConnector(cfg config.ServiceConfig, creds credentials.TransportCredentials) *auditConnector {
+	c := &auditConnector{
+		cfg:     cfg,
+		creds:   creds,
+		breaker: NewCircuitBreaker(cfg.Name, cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.Cooldown),
+	}
+	c.dial = c.dialAudit
+	return c
+}
+
+// connect 建立连接，阻塞直到连接就绪、超过连接超时或ctx结束
+func (c *auditConnector) connect(ctx context.Context) error {
+	_, err := c.getClient(ctx)
+	return err
+}
+
+// SubmitContent 提交内容审核，连接断开时先重连
+// 审核服务不可用或调用超时计为熔断器失败，其他错误说明审核服务可达，不影响熔断
+func (c *auditConnector) SubmitContent(ctx context.Context, req *auditpb.SubmitContentRequest) (*auditpb.SubmitContentResponse, error) {
+	if !c.breaker.CanExecute() {
+		return nil, errAuditCircuitOpen
+	}
+
+	client, err := c.getClient(ctx)
+	if err != nil {
+		c.breaker.RecordFailure()
+		return nil, err
+	}
+
+	resp, err := client.SubmitContent(ctx, req)
+	if isUnavailable(err) {
+		c.breaker.RecordFailure()
+	} else {
+		c.breaker.RecordSuccess()
+	}
+	return resp, err
+}
+
+// Close 关闭连接，正在进行的建连结束后其连接也会被关闭
+func (c *auditConnector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	c.client = nil
+	return err
+}
+
+// getClient 返回可用的客户端，连接已断开时关闭旧连接并重新建立
+// 空闲和连接中的连接由gRPC在调用时自动恢复，不需要重连
+// 已有调用在建连时等待其结果，等待受ctx约束，不会在锁上阻塞
+func (c *auditConnector) getClient(ctx context.Context) (auditpb.AuditServiceClient, error) {
+	for {
+		c.mu.Lock()
+		if c.closed {
+			c.mu.Unlock()
+			return nil, status.Error(codes.Unavailable, "audit service connection closed")
+		}
+		if c.conn != nil {
+			state := c.conn.GetState()
+			if state != connectivity.TransientFailure && state != connectivity.Shutdown {
+				client := c.client
+				c.mu.Unlock()
+				return client, nil
+			}
+			logger.Warn("Audit service connection broken, redialing",
+				zap.String("address", c.cfg.Address),
+				zap.String("state", state.String()))
+			if err := c.conn.Close(); err != nil && state != connectivity.Shutdown {
+				logger.Warn("Failed to close broken audit service connection", zap.Error(err))
+			}
+			c.conn = nil
+			c.client = nil
+		}
+
+		if dialing := c.dialing; dialing != nil {
+			c.mu.Unlock()
+			select {
+			case <-dialing:
+				continue
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+
+		if wait := time.Until(c.nextDial); wait > 0 {
+			c.mu.Unlock()
+			return nil, status.Errorf(codes.Unavailable, "audit service unavailable, next redial in %v", wait.Round(time.Millisecond))
+		}
+		dialing := make(chan struct{})
+		c.dialing = dialing
+		c.mu.Unlock()
+
+		conn, err := c.dial(ctx)
+
+		c.mu.Lock()
+		c.dialing = nil
+		close(dialing)
+		client, err := c.finishDialLocked(conn, err)
+		c.mu.Unlock()
+		return client, err
+	}
+}
+
+// finishDialLocked 记录建连结果和重连退避，调用方需持有锁
+func (c *auditConnector) finishDialLocked(conn *grpc.ClientConn, err error) (auditpb.AuditServiceClient, error) {
+	if err != nil {
+		c.failures++
+		backoff := c.cfg.RedialBackoff(c.failures)
+		c.nextDial = time.Now().Add(backoff)
+		logger.Warn("Failed to connect to audit service",
+			zap.String("address", c.cfg.Address),
+			zap.Int("consecutive_failures", c.failures),
+			zap.Duration("retry_in", backoff),
+			zap.Error(err))
+		return nil, status.Errorf(codes.Unavailable, "failed to connect to audit service: %v", err)
+	}
+	if c.closed {
+		conn.Close()
+		return nil, status.Error(codes.Unavailable, "audit service connection closed")
+	}
+
+	c.conn = conn
+	c.client = auditpb.NewAuditServiceClient(conn)
+	c.failures = 0
+	c.nextDial = time.Time{}
+	logger.Info("Connected to audit service", zap.String("address", c.cfg.Address))
+	return c.client, nil
+}
+
+// dialAudit 阻塞建立到审核服务的连接，超过连接超时返回错误
+func (c *auditConnector) dialAudit(ctx context.Context) (*grpc.ClientConn, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, c.cfg.GetDialTimeout())
+	defer cancel()
+
+	return grpc.DialContext(dialCtx, c.cfg.Address,
+		grpc.WithTransportCredentials(c.creds),
+		grpc.WithBlock(),
+	)
+}
+
+// isUnavailable 判断调用是否因下游不可用或超时失败
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded)
+}
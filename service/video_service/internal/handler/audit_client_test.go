@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vision_world/video_service/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestAuditConnector 创建使用指定建连函数的审核服务连接
+func newTestAuditConnector(dial func(ctx context.Context) (*grpc.ClientConn, error)) *auditConnector {
+	c := newAuditConnector(config.ServiceConfig{
+		Name:           "audit_service",
+		Address:        "passthrough:///audit",
+		RedialInterval: time.Minute,
+		CircuitBreaker: config.CircuitBreakerConfig{FailureThreshold: 1, Cooldown: time.Minute},
+	}, insecure.NewCredentials())
+	c.dial = dial
+	return c
+}
+
+// bufConn 返回连接到内存gRPC服务的连接
+func bufConn(t *testing.T) *grpc.ClientConn {
+	return serveBufConn(t, grpc.NewServer())
+}
+
+// serveBufConn 在内存中启动server并返回连接到它的连接
+func serveBufConn(t *testing.T, server *grpc.Server) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 16)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("passthrough:///audit",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.Dial: %v", err)
+	}
+	return conn
+}
+
+func TestGetClientDialsOnceWithoutHoldingLock(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var dials int32
+	c := newTestAuditConnector(func(ctx context.Context) (*grpc.ClientConn, error) {
+		if atomic.AddInt32(&dials, 1) == 1 {
+			close(started)
+		}
+		<-release
+		return bufConn(t), nil
+	})
+	defer c.Close()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.getClient(context.Background())
+			errs <- err
+		}()
+	}
+	<-started
+
+	// 建连期间其他调用受自身ctx约束，不会阻塞在锁上
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	_, err := c.getClient(ctx)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("waiting caller error = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Fatalf("waiting caller blocked for %v", elapsed)
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("getClient error = %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(&dials); n != 1 {
+		t.Errorf("dial count = %d, want 1", n)
+	}
+}
+
+func TestGetClientBacksOffAfterDialFailure(t *testing.T) {
+	var dials int32
+	c := newTestAuditConnector(func(ctx context.Context) (*grpc.ClientConn, error) {
+		atomic.AddInt32(&dials, 1)
+		return nil, errors.New("connection refused")
+	})
+
+	if _, err := c.getClient(context.Background()); status.Code(err) != codes.Unavailable {
+		t.Fatalf("first getClient error = %v, want Unavailable", err)
+	}
+	if _, err := c.getClient(context.Background()); status.Code(err) != codes.Unavailable {
+		t.Fatalf("second getClient error = %v, want Unavailable", err)
+	}
+	if n := atomic.LoadInt32(&dials); n != 1 {
+		t.Errorf("dial count = %d, want 1 (second call within backoff)", n)
+	}
+}
+
+func TestSubmitContentFailsFastWhenCircuitOpen(t *testing.T) {
+	var dials int32
+	c := newTestAuditConnector(func(ctx context.Context) (*grpc.ClientConn, error) {
+		atomic.AddInt32(&dials, 1)
+		return nil, errors.New("connection refused")
+	})
+
+	// 首次建连失败开启熔断，之后的调用不再建连
+	if _, err := c.SubmitContent(context.Background(), nil); status.Code(err) != codes.Unavailable {
+		t.Fatalf("first SubmitContent error = %v, want Unavailable", err)
+	}
+	if _, err := c.SubmitContent(context.Background(), nil); err != errAuditCircuitOpen {
+		t.Fatalf("second SubmitContent error = %v, want errAuditCircuitOpen", err)
+	}
+	if n := atomic.LoadInt32(&dials); n != 1 {
+		t.Errorf("dial count = %d, want 1", n)
+	}
+}
+
+func TestCloseDuringDialClosesNewConnection(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var conn *grpc.ClientConn
+	c := newTestAuditConnector(func(ctx context.Context) (*grpc.ClientConn, error) {
+		close(started)
+		<-release
+		conn = bufConn(t)
+		return conn, nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.getClient(context.Background())
+		done <- err
+	}()
+	<-started
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	close(release)
+
+	if err := <-done; status.Code(err) != codes.Unavailable {
+		t.Fatalf("getClient error = %v, want Unavailable after Close", err)
+	}
+	if state := conn.GetState().String(); state != "SHUTDOWN" {
+		t.Errorf("dialed connection state = %s, want SHUTDOWN", state)
+	}
+}
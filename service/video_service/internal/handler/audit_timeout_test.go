@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vision_world/video_service/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	auditpb "audit_service/proto_gen/audit/v1"
+)
+
+// slowAuditServer 在delay后返回待审核结果，调用方超时时提前结束
+type slowAuditServer struct {
+	auditpb.UnimplementedAuditServiceServer
+	delay time.Duration
+}
+
+func (s *slowAuditServer) SubmitContent(ctx context.Context, req *auditpb.SubmitContentRequest) (*auditpb.SubmitContentResponse, error) {
+	select {
+	case <-time.After(s.delay):
+		return &auditpb.SubmitContentResponse{AuditId: 9, Status: auditpb.AuditStatus_AUDIT_STATUS_PASSED}, nil
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// newAuditTimeoutHandler 创建连接到slowAuditServer的处理器
+func newAuditTimeoutHandler(t *testing.T, callTimeout, delay time.Duration) *VideoHandler {
+	server := grpc.NewServer()
+	auditpb.RegisterAuditServiceServer(server, &slowAuditServer{delay: delay})
+	conn := serveBufConn(t, server)
+
+	audit := newTestAuditConnector(func(ctx context.Context) (*grpc.ClientConn, error) { return conn, nil })
+	t.Cleanup(func() { audit.Close() })
+
+	cfg := &config.Config{}
+	cfg.Services.AuditService.CallTimeout = callTimeout
+	return &VideoHandler{config: cfg, audit: audit}
+}
+
+func TestSubmitAuditAppliesCallTimeout(t *testing.T) {
+	h := newAuditTimeoutHandler(t, 30*time.Millisecond, time.Minute)
+
+	begin := time.Now()
+	_, err := h.submitAudit(context.Background(), &auditpb.SubmitContentRequest{ContentId: "video_1"})
+	if !isDeadlineExceeded(err) {
+		t.Fatalf("submitAudit error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Errorf("submitAudit returned after %v, want about the call timeout", elapsed)
+	}
+}
+
+func TestSubmitAuditWithinCallTimeout(t *testing.T) {
+	h := newAuditTimeoutHandler(t, time.Second, 0)
+
+	resp, err := h.submitAudit(context.Background(), &auditpb.SubmitContentRequest{ContentId: "video_1"})
+	if err != nil || resp.AuditId != 9 || resp.Status != auditpb.AuditStatus_AUDIT_STATUS_PASSED {
+		t.Errorf("submitAudit = (%v, %v), want the audit result", resp, err)
+	}
+}
+
+func TestIsDeadlineExceeded(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"context", context.DeadlineExceeded, true},
+		{"wrapped context", fmt.Errorf("submit: %w", context.DeadlineExceeded), true},
+		{"grpc status", status.Error(codes.DeadlineExceeded, "deadline"), true},
+		{"canceled", status.Error(codes.Canceled, "canceled"), false},
+		{"unavailable", errAuditCircuitOpen, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDeadlineExceeded(tt.err); got != tt.want {
+				t.Errorf("isDeadlineExceeded(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
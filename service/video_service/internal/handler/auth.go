@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/vision_world/video_service/internal/config"
+	"github.com/vision_world/video_service/pkg/logger"
+	"go.uber.org/zap"
+
+	"common/jwtauth"
+)
+
+// newTokenVerifier 按JWT配置创建token校验器，expires_at无法解析的旧密钥不再使用
+// client不为nil时检查user_service写入的token黑名单
+func newTokenVerifier(cfg config.JWTConfig, client redis.UniversalClient) *jwtauth.Verifier {
+	previous := make([]jwtauth.Key, 0, len(cfg.PreviousKeys))
+	for _, k := range cfg.PreviousKeys {
+		key, err := jwtauth.ParseKey(k.KeyID, k.Secret, k.ExpiresAt)
+		if err != nil {
+			logger.Error("Invalid previous jwt key, key ignored", zap.String("key_id", k.KeyID), zap.Error(err))
+			continue
+		}
+		previous = append(previous, key)
+	}
+
+	var blacklist jwtauth.Blacklist
+	if client != nil {
+		blacklist = jwtauth.NewRedisBlacklist(client)
+	}
+	return jwtauth.NewVerifier(jwtauth.Key{ID: cfg.KeyID, Secret: cfg.Secret}, previous, blacklist)
+}
+
+// parseRequesterID 从token中解析请求用户ID，token为空时返回0
+// token按kid校验签名和有效期并检查黑名单，已退出登录或被挤下线的token返回错误
+func (h *VideoHandler) parseRequesterID(ctx context.Context, tokenString string) (uint32, error) {
+	if tokenString == "" {
+		return 0, nil
+	}
+	return h.verifier.Verify(ctx, tokenString)
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/vision_world/video_service/internal/config"
+
+	"common/jwtauth"
+)
+
+// signTestToken 签发与user_service结构一致的token，kid为空时header不带kid
+func signTestToken(t *testing.T, userID uint32, kid, secret string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtauth.Claims{
+		UserID:           userID,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestParseRequesterIDHonorsKeyRotation(t *testing.T) {
+	h := &VideoHandler{verifier: newTokenVerifier(config.JWTConfig{
+		Secret: "new-secret",
+		KeyID:  "k2",
+		PreviousKeys: []config.JWTKeyConfig{
+			{KeyID: "k1", Secret: "old-secret", ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339)},
+			{KeyID: "k0", Secret: "older-secret", ExpiresAt: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+		},
+	}, nil)}
+
+	cases := []struct {
+		name        string
+		kid, secret string
+		ok          bool
+	}{
+		{"current key", "k2", "new-secret", true},
+		{"previous key inside grace window", "k1", "old-secret", true},
+		{"previous key after grace window", "k0", "older-secret", false},
+		{"unknown kid", "k9", "new-secret", false},
+	}
+	for _, tc := range cases {
+		got, err := h.parseRequesterID(context.Background(), signTestToken(t, 42, tc.kid, tc.secret))
+		if (err == nil) != tc.ok || (tc.ok && got != 42) {
+			t.Errorf("%s: got (%d, %v), want ok=%v", tc.name, got, err, tc.ok)
+		}
+	}
+
+	if got, err := h.parseRequesterID(context.Background(), ""); got != 0 || err != nil {
+		t.Errorf("empty token = (%d, %v), want anonymous", got, err)
+	}
+}
+
+// memoryBlacklist 内存中的token黑名单
+type memoryBlacklist map[string]bool
+
+func (b memoryBlacklist) IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
+	return b[token], nil
+}
+
+func TestParseRequesterIDRejectsBlacklistedToken(t *testing.T) {
+	revoked := signTestToken(t, 42, "", "secret")
+	h := &VideoHandler{verifier: jwtauth.NewVerifier(jwtauth.Key{Secret: "secret"}, nil, memoryBlacklist{revoked: true})}
+
+	if _, err := h.parseRequesterID(context.Background(), revoked); err == nil {
+		t.Fatal("blacklisted token was accepted")
+	}
+	if got, err := h.parseRequesterID(context.Background(), signTestToken(t, 43, "", "secret")); err != nil || got != 43 {
+		t.Fatalf("parseRequesterID = (%d, %v), want 43", got, err)
+	}
+}
@@ -2,18 +2,34 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/vision_world/video_service/internal/config"
+	"github.com/vision_world/video_service/internal/model"
 	"github.com/vision_world/video_service/internal/service"
+	"github.com/vision_world/video_service/pkg/database"
+	"github.com/vision_world/video_service/pkg/featureflags"
+	"github.com/vision_world/video_service/pkg/grpctls"
 	"github.com/vision_world/video_service/pkg/logger"
+	"github.com/vision_world/video_service/pkg/paginate"
+	"github.com/vision_world/video_service/pkg/searchindex"
 	pb "github.com/vision_world/video_service/proto/proto_gen/video"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	auditpb "audit_service/proto_gen/audit/v1"
+	"common/jwtauth"
+)
+
+// 特性开关
+const (
+	// flagNewRecommender 推荐列表使用基于数据库的推荐逻辑，支持按请求用户灰度
+	flagNewRecommender = "video_new_recommender"
 )
 
 // VideoHandler 视频服务处理器
@@ -21,8 +37,11 @@ type VideoHandler struct {
 	pb.UnimplementedVideoServiceServer
 	config       *config.Config
 	videoService *service.VideoService
-	auditClient  auditpb.AuditServiceClient
-	auditConn    *grpc.ClientConn
+	audit        *auditConnector
+	redisClient  redis.UniversalClient
+	verifier     *jwtauth.Verifier
+	flags        *featureflags.Flags
+	indexer      *searchindex.Publisher
 }
 
 // NewVideoHandler 创建视频处理器
@@ -33,27 +52,53 @@ func NewVideoHandler(cfg *config.Config) (*VideoHandler, error) {
 	}
 
 	// 创建audit_service客户端连接
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Services.AuditService.Timeout)*time.Second)
-	defer cancel()
-
-	conn, err := grpc.DialContext(ctx, cfg.Services.AuditService.Address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-	)
+	auditTLS := cfg.Services.AuditService.TLS
+	auditCreds, err := grpctls.ClientCredentials(grpctls.Options{
+		Enabled:    auditTLS.Enabled,
+		CAFile:     auditTLS.CAFile,
+		CertFile:   auditTLS.CertFile,
+		KeyFile:    auditTLS.KeyFile,
+		ServerName: auditTLS.ServerName,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to audit service: %w", err)
+		return nil, fmt.Errorf("failed to load audit service tls credentials: %w", err)
 	}
 
-	auditClient := auditpb.NewAuditServiceClient(conn)
+	// 首次连接失败时启动失败，之后连接断开在下一次调用时重连
+	audit := newAuditConnector(cfg.Services.AuditService, auditCreds)
+	if err := audit.connect(context.Background()); err != nil {
+		return nil, err
+	}
+
+	// 创建特性开关，Redis不可用时只使用配置文件中的默认值
+	var flagSource featureflags.Source
+	redisClient, err := database.NewRedisClient(&cfg.Redis)
+	if err != nil {
+		logger.Warn("Redis unavailable, feature flags fall back to config defaults", zap.Error(err))
+	} else {
+		flagSource = featureflags.NewRedisSource(redisClient, cfg.FeatureFlags.RedisKey)
+	}
+	flags := featureflags.New(flagSource, cfg.FeatureFlags.Defaults, cfg.FeatureFlags.RefreshInterval)
+	flags.Start()
+
+	// 搜索索引事件通过Redis队列投递给search_service，Redis不可用时不发布
+	var indexer *searchindex.Publisher
+	if cfg.SearchIndex.Enabled && redisClient != nil {
+		indexer = searchindex.NewPublisher(searchindex.NewRedisSink(redisClient, cfg.SearchIndex.QueueKey), cfg.SearchIndex.BufferSize)
+		indexer.Start()
+		videoService.SetSearchIndexer(indexer)
+	}
 
-	logger.Info("Connected to audit service",
-		zap.String("address", cfg.Services.AuditService.Address))
+	videoService.StartPublishScheduler()
 
 	return &VideoHandler{
 		config:       cfg,
 		videoService: videoService,
-		auditClient:  auditClient,
-		auditConn:    conn,
+		audit:        audit,
+		redisClient:  redisClient,
+		verifier:     newTokenVerifier(cfg.JWT, redisClient),
+		flags:        flags,
+		indexer:      indexer,
 	}, nil
 }
 
@@ -69,12 +114,27 @@ func (h *VideoHandler) RegisterService() error {
 // Close 关闭处理器
 func (h *VideoHandler) Close() error {
 	// 关闭audit_service连接
-	if h.auditConn != nil {
-		if err := h.auditConn.Close(); err != nil {
+	if h.audit != nil {
+		if err := h.audit.Close(); err != nil {
 			logger.Error("Failed to close audit service connection", zap.Error(err))
 		}
 	}
 
+	h.flags.Stop()
+
+	// 投递剩余的索引事件，需在Redis关闭前完成
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := h.indexer.Stop(ctx); err != nil {
+		logger.Warn("Failed to flush search index events", zap.Error(err))
+	}
+	cancel()
+
+	if h.redisClient != nil {
+		if err := h.redisClient.Close(); err != nil {
+			logger.Error("Failed to close redis connection", zap.Error(err))
+		}
+	}
+
 	if h.videoService != nil {
 		return h.videoService.Close()
 	}
@@ -84,63 +144,198 @@ func (h *VideoHandler) Close() error {
 // ==================== 视频发布相关接口 ====================
 
 // PublishVideo 发布视频
+// 视频先落库再提交审核，设置了未来的publish_at时作为定时发布保存，到点且审核通过后自动公开
 func (h *VideoHandler) PublishVideo(ctx context.Context, req *pb.PublishVideoRequest) (*pb.PublishVideoResponse, error) {
-	logger.Info("PublishVideo called", zap.String("title", req.Title), zap.String("user_id", req.UserId))
+	logger.Info("PublishVideo called", zap.String("title", req.Title))
 
-	// TODO: 验证用户token
-	// TODO: 实现视频发布逻辑
+	userID, err := h.parseRequesterID(ctx, req.Token)
+	if err != nil || userID == 0 {
+		return &pb.PublishVideoResponse{
+			StatusCode: 401,
+			StatusMsg:  "用户未登录",
+		}, nil
+	}
+
+	video := &model.Video{
+		UserID:      userID,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverURL:    req.CoverUrl,
+		VideoURL:    req.VideoUrl,
+		Tags:        strings.Join(req.Tags, ","),
+		Location:    req.GetLocation(),
+		IsPublic:    true,
+	}
+	if req.IsPublic != nil {
+		video.IsPublic = *req.IsPublic
+	}
+
+	var publishAt *time.Time
+	if req.PublishAt != nil {
+		at := time.Unix(*req.PublishAt, 0)
+		publishAt = &at
+	}
+
+	if err := h.videoService.PublishVideo(ctx, video, publishAt); err != nil {
+		if errors.Is(err, service.ErrInvalidPublishTime) {
+			return &pb.PublishVideoResponse{
+				StatusCode: 400,
+				StatusMsg:  "定时发布时间超出允许范围",
+			}, nil
+		}
+		logger.Error("Failed to publish video", zap.Uint32("user_id", userID), zap.Error(err))
+		return &pb.PublishVideoResponse{
+			StatusCode: 500,
+			StatusMsg:  "视频发布失败",
+		}, nil
+	}
 
-	// 生成视频ID (这里简化处理，实际应该从数据库获取)
-	videoID := uint32(time.Now().Unix())
+	scheduled := video.Status == model.VideoStatusScheduled
+	var scheduledAt int64
+	if scheduled {
+		scheduledAt = video.PublishAt.Unix()
+	}
 
 	// 调用审核服务进行内容审核
 	auditReq := &auditpb.SubmitContentRequest{
-		ContentId:   fmt.Sprintf("video_%d", videoID),
-		ContentType: auditpb.ContentType_CONTENT_TYPE_VIDEO,
-		UploaderId:  req.UserId,
-		Title:       req.Title,
-		Content:     req.Description,
-		CreateTime:  time.Now().Format(time.RFC3339),
+		ContentId:    fmt.Sprintf("video_%d", video.ID),
+		ContentType:  auditpb.ContentType_CONTENT_TYPE_VIDEO,
+		ContentTitle: req.Title,
+		ContentUrl:   req.VideoUrl,
+		UploaderId:   uint64(userID),
+		Content:      req.Description,
+		Metadata: map[string]string{
+			"title":       req.Title,
+			"video_url":   req.VideoUrl,
+			"create_time": time.Now().Format(time.RFC3339),
+		},
 	}
 
-	auditResp, err := h.auditClient.SubmitContent(ctx, auditReq)
-	if err != nil {
-		logger.Error("Failed to submit content for audit", zap.Error(err))
+	// 审核超时按待审核处理，视频保持审核中状态，由审核服务完成后回调更新
+	auditStatus := auditpb.AuditStatus_AUDIT_STATUS_PENDING
+	auditResp, err := h.submitAudit(ctx, auditReq)
+	switch {
+	case isDeadlineExceeded(err):
+		logger.Warn("Audit call timed out, keep video pending",
+			zap.Uint32("video_id", video.ID),
+			zap.Duration("timeout", h.config.Services.AuditService.GetCallTimeout()))
+	case err != nil:
+		// 视频已保存为审核中或定时状态，审核完成前不会公开
+		logger.Error("Failed to submit content for audit", zap.Uint32("video_id", video.ID), zap.Error(err))
 		return &pb.PublishVideoResponse{
 			StatusCode: 500,
 			StatusMsg:  "审核服务调用失败",
-			VideoId:    0,
+			VideoId:    video.ID,
+			PublishAt:  scheduledAt,
 		}, nil
+	default:
+		auditStatus = auditResp.Status
+		logger.Info("Content submitted for audit",
+			zap.String("content_id", auditReq.ContentId),
+			zap.Uint64("audit_id", auditResp.AuditId),
+			zap.String("status", auditResp.Status.String()),
+			zap.Bool("scheduled", scheduled))
 	}
 
-	logger.Info("Content submitted for audit",
-		zap.String("content_id", auditReq.ContentId),
-		zap.String("audit_id", auditResp.AuditId),
-		zap.String("status", auditResp.Status.String()))
-
 	// 根据审核结果决定视频状态
+	var outcome service.AuditOutcome
 	var statusMsg string
 	var statusCode int32
 
-	switch auditResp.Status {
+	switch auditStatus {
 	case auditpb.AuditStatus_AUDIT_STATUS_PASSED:
+		outcome = service.AuditOutcomePassed
 		statusCode = 0
 		statusMsg = "视频发布成功"
-	case auditpb.AuditStatus_AUDIT_STATUS_PENDING, auditpb.AuditStatus_AUDIT_STATUS_UNDER_REVIEW:
-		statusCode = 202
-		statusMsg = "视频发布成功，正在审核中"
+		if scheduled {
+			statusMsg = "视频已设置定时发布"
+		}
 	case auditpb.AuditStatus_AUDIT_STATUS_REJECTED:
+		outcome = service.AuditOutcomeRejected
 		statusCode = 403
 		statusMsg = "视频内容违规，发布失败"
 	default:
+		outcome = service.AuditOutcomePending
 		statusCode = 202
-		statusMsg = "视频发布成功，等待审核"
+		statusMsg = "视频发布成功，正在审核中"
+		if scheduled {
+			statusMsg = "视频已设置定时发布，正在审核中"
+		}
+	}
+
+	if err := h.videoService.ApplyAuditResult(ctx, video.ID, outcome); err != nil {
+		logger.Error("Failed to apply audit result", zap.Uint32("video_id", video.ID), zap.Error(err))
+		return &pb.PublishVideoResponse{
+			StatusCode: 500,
+			StatusMsg:  "视频状态更新失败",
+			VideoId:    video.ID,
+			PublishAt:  scheduledAt,
+		}, nil
 	}
 
 	return &pb.PublishVideoResponse{
 		StatusCode: statusCode,
 		StatusMsg:  statusMsg,
-		VideoId:    videoID,
+		VideoId:    video.ID,
+		PublishAt:  scheduledAt,
+	}, nil
+}
+
+// submitAudit 提交内容审核，每次调用单独设置超时，避免审核服务无响应时阻塞发布
+func (h *VideoHandler) submitAudit(ctx context.Context, req *auditpb.SubmitContentRequest) (*auditpb.SubmitContentResponse, error) {
+	callCtx, cancel := context.WithTimeout(ctx, h.config.Services.AuditService.GetCallTimeout())
+	defer cancel()
+	return h.audit.SubmitContent(callCtx, req)
+}
+
+// isDeadlineExceeded 判断调用是否因超时失败
+func isDeadlineExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	return status.Code(err) == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded)
+}
+
+// CancelScheduledPublish 取消定时发布，视频转为仅作者可见的草稿
+func (h *VideoHandler) CancelScheduledPublish(ctx context.Context, req *pb.CancelScheduledPublishRequest) (*pb.CancelScheduledPublishResponse, error) {
+	logger.Info("CancelScheduledPublish called", zap.Uint32("video_id", req.VideoId))
+
+	userID, err := h.parseRequesterID(ctx, req.Token)
+	if err != nil || userID == 0 {
+		return &pb.CancelScheduledPublishResponse{
+			StatusCode: 401,
+			StatusMsg:  "用户未登录",
+		}, nil
+	}
+
+	if err := h.videoService.CancelScheduledPublish(ctx, req.VideoId, userID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrVideoNotFound):
+			return &pb.CancelScheduledPublishResponse{
+				StatusCode: 404,
+				StatusMsg:  "视频不存在",
+			}, nil
+		case errors.Is(err, service.ErrVideoPermissionDenied):
+			return &pb.CancelScheduledPublishResponse{
+				StatusCode: 403,
+				StatusMsg:  "无权操作该视频",
+			}, nil
+		case errors.Is(err, service.ErrVideoNotScheduled):
+			return &pb.CancelScheduledPublishResponse{
+				StatusCode: 400,
+				StatusMsg:  "视频不在定时发布中",
+			}, nil
+		}
+		logger.Error("Failed to cancel scheduled publish", zap.Uint32("video_id", req.VideoId), zap.Error(err))
+		return &pb.CancelScheduledPublishResponse{
+			StatusCode: 500,
+			StatusMsg:  "取消定时发布失败",
+		}, nil
+	}
+
+	return &pb.CancelScheduledPublishResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
 	}, nil
 }
 
@@ -219,27 +414,39 @@ func (h *VideoHandler) GetVideoInfos(ctx context.Context, req *pb.GetVideoInfosR
 func (h *VideoHandler) GetUserVideos(ctx context.Context, req *pb.GetUserVideosRequest) (*pb.GetUserVideosResponse, error) {
 	logger.Info("GetUserVideos called", zap.Uint32("user_id", req.UserId), zap.Uint32("page", req.Page))
 
-	// TODO: 实现获取用户视频列表逻辑
+	// token可选，解析失败按未登录处理
+	requesterID, err := h.parseRequesterID(ctx, req.Token)
+	if err != nil {
+		logger.Warn("Failed to parse requester token", zap.Error(err))
+	}
 
-	videos := make([]*pb.Video, 0)
-	for i := uint32(0); i < req.PageSize; i++ {
-		videos = append(videos, &pb.Video{
-			Id:         uint32(i + 1),
-			Title:      "TODO: User Video Title",
-			CoverUrl:   "TODO: Cover URL",
-			VideoUrl:   "TODO: Video URL",
-			PlayCount:  100,
-			LikeCount:  50,
-			CreateTime: time.Now().Unix(),
-		})
+	videos, total, hasMore, nextCursor, err := h.videoService.GetUserVideos(ctx, req.UserId, requesterID, req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		if errors.Is(err, paginate.ErrInvalidCursor) {
+			return &pb.GetUserVideosResponse{
+				StatusCode: 400,
+				StatusMsg:  "分页游标无效",
+			}, nil
+		}
+		logger.Error("Failed to get user videos", zap.Uint32("user_id", req.UserId), zap.Error(err))
+		return &pb.GetUserVideosResponse{
+			StatusCode: 500,
+			StatusMsg:  "获取用户视频列表失败",
+		}, nil
+	}
+
+	pbVideos := make([]*pb.Video, 0, len(videos))
+	for _, video := range videos {
+		pbVideos = append(pbVideos, videoToProto(video))
 	}
 
 	return &pb.GetUserVideosResponse{
 		StatusCode: 0,
 		StatusMsg:  "success",
-		Videos:     videos,
-		Total:      100, // TODO: 真实的总数
-		HasMore:    true,
+		Videos:     pbVideos,
+		Total:      uint32(total),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -251,6 +458,35 @@ func (h *VideoHandler) GetRecommendVideos(ctx context.Context, req *pb.GetRecomm
 	}
 	logger.Info("GetRecommendVideos called", zap.Uint32("page", req.Page), zap.String("category", category))
 
+	// token可选，解析失败按未登录处理，未登录用户统一落在用户ID为0的灰度分桶
+	requesterID, err := h.parseRequesterID(ctx, req.Token)
+	if err != nil {
+		logger.Warn("Failed to parse requester token", zap.Error(err))
+	}
+
+	if h.flags.EnabledFor(flagNewRecommender, uint64(requesterID)) {
+		videos, hasMore, err := h.videoService.GetRecommendVideos(ctx, category, req.Page, req.PageSize)
+		if err != nil {
+			logger.Error("Failed to get recommend videos", zap.String("category", category), zap.Error(err))
+			return &pb.GetRecommendVideosResponse{
+				StatusCode: 500,
+				StatusMsg:  "获取推荐视频列表失败",
+			}, nil
+		}
+
+		pbVideos := make([]*pb.Video, 0, len(videos))
+		for _, video := range videos {
+			pbVideos = append(pbVideos, videoToProto(video))
+		}
+
+		return &pb.GetRecommendVideosResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+			Videos:     pbVideos,
+			HasMore:    hasMore,
+		}, nil
+	}
+
 	// TODO: 实现推荐算法逻辑
 
 	videos := make([]*pb.Video, 0)
@@ -354,13 +590,73 @@ func (h *VideoHandler) GetUserLikedVideos(ctx context.Context, req *pb.GetUserLi
 func (h *VideoHandler) ShareVideo(ctx context.Context, req *pb.ShareVideoRequest) (*pb.ShareVideoResponse, error) {
 	logger.Info("ShareVideo called", zap.Uint32("video_id", req.VideoId), zap.String("share_type", req.ShareType))
 
-	// TODO: 验证用户token
-	// TODO: 实现分享逻辑
+	userID, err := h.parseRequesterID(ctx, req.Token)
+	if err != nil || userID == 0 {
+		return &pb.ShareVideoResponse{
+			StatusCode: 401,
+			StatusMsg:  "用户未登录",
+		}, nil
+	}
+
+	share, err := h.videoService.ShareVideo(ctx, req.VideoId, userID, req.ShareType)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidShareType):
+			return &pb.ShareVideoResponse{
+				StatusCode: 400,
+				StatusMsg:  "不支持的分享类型",
+			}, nil
+		case errors.Is(err, service.ErrVideoNotFound):
+			return &pb.ShareVideoResponse{
+				StatusCode: 404,
+				StatusMsg:  "视频不存在",
+			}, nil
+		}
+		logger.Error("Failed to share video", zap.Uint32("video_id", req.VideoId), zap.Error(err))
+		return &pb.ShareVideoResponse{
+			StatusCode: 500,
+			StatusMsg:  "分享视频失败",
+		}, nil
+	}
 
 	return &pb.ShareVideoResponse{
 		StatusCode: 0,
 		StatusMsg:  "success",
-		ShareUrl:   "TODO: Generated share URL",
+		ShareUrl:   share.ShareURL,
+	}, nil
+}
+
+// ResolveShareLink 解析分享链接，记录点击并返回跳转的视频
+func (h *VideoHandler) ResolveShareLink(ctx context.Context, req *pb.ResolveShareLinkRequest) (*pb.ResolveShareLinkResponse, error) {
+	logger.Info("ResolveShareLink called", zap.String("share_code", req.ShareCode))
+
+	if req.ShareCode == "" {
+		return &pb.ResolveShareLinkResponse{
+			StatusCode: 400,
+			StatusMsg:  "分享码不能为空",
+		}, nil
+	}
+
+	video, err := h.videoService.ResolveShareLink(ctx, req.ShareCode)
+	if err != nil {
+		if errors.Is(err, service.ErrShareLinkNotFound) || errors.Is(err, service.ErrVideoNotFound) {
+			return &pb.ResolveShareLinkResponse{
+				StatusCode: 404,
+				StatusMsg:  "分享链接已失效",
+			}, nil
+		}
+		logger.Error("Failed to resolve share link", zap.String("share_code", req.ShareCode), zap.Error(err))
+		return &pb.ResolveShareLinkResponse{
+			StatusCode: 500,
+			StatusMsg:  "解析分享链接失败",
+		}, nil
+	}
+
+	return &pb.ResolveShareLinkResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		VideoId:    video.ID,
+		VideoUrl:   video.VideoURL,
 	}, nil
 }
 
@@ -425,3 +721,33 @@ func (h *VideoHandler) GetVideoComments(ctx context.Context, req *pb.GetVideoCom
 		HasMore:    true,
 	}, nil
 }
+
+// videoToProto 将视频模型转换为proto结构
+func videoToProto(video *model.Video) *pb.Video {
+	var tags []string
+	if video.Tags != "" {
+		tags = strings.Split(video.Tags, ",")
+	}
+
+	return &pb.Video{
+		Id:            video.ID,
+		AuthorId:      video.UserID,
+		Title:         video.Title,
+		Description:   video.Description,
+		CoverUrl:      video.CoverURL,
+		VideoUrl:      video.VideoURL,
+		PlayCount:     video.PlayCount,
+		LikeCount:     video.LikeCount,
+		CommentCount:  video.CommentCount,
+		ShareCount:    video.ShareCount,
+		FavoriteCount: video.FavoriteCount,
+		Tags:          tags,
+		Category:      video.Category,
+		CreateTime:    video.CreatedAt.Unix(),
+		UpdateTime:    video.UpdatedAt.Unix(),
+		Duration:      video.Duration,
+		Resolution:    video.Resolution,
+		IsPublic:      video.IsPublic,
+		Status:        video.Status,
+	}
+}
@@ -29,7 +29,9 @@ type Video struct {
 	ShareCount    uint32         `gorm:"default:0;comment:分享数" json:"share_count"`
 	FavoriteCount uint32         `gorm:"default:0;comment:收藏数" json:"favorite_count"`
 	IsPublic      bool           `gorm:"default:true;comment:是否公开" json:"is_public"`
-	Status        string         `gorm:"size:20;default:normal;comment:状态" json:"status"` // normal, deleted, banned, reviewing
+	Status        string         `gorm:"size:20;default:normal;comment:状态" json:"status"` // normal, deleted, banned, reviewing, scheduled, draft
+	PublishAt     *time.Time     `gorm:"index;comment:定时发布时间" json:"publish_at"`
+	AuditPassed   bool           `gorm:"default:false;comment:审核是否通过" json:"audit_passed"`
 	ExtraData     string         `gorm:"type:text;comment:扩展数据" json:"extra_data"`
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
@@ -40,6 +42,16 @@ func (Video) TableName() string {
 	return "videos"
 }
 
+// 视频状态
+const (
+	VideoStatusNormal    = "normal"
+	VideoStatusDeleted   = "deleted"
+	VideoStatusBanned    = "banned"
+	VideoStatusReviewing = "reviewing"
+	VideoStatusScheduled = "scheduled" // 定时发布，到达发布时间前仅作者可见
+	VideoStatusDraft     = "draft"     // 取消定时发布后的草稿，仅作者可见
+)
+
 // VideoLike 视频点赞表
 type VideoLike struct {
 	ID        uint32    `gorm:"primaryKey;autoIncrement" json:"id"`
@@ -72,18 +84,49 @@ func (VideoComment) TableName() string {
 
 // VideoShare 视频分享表
 type VideoShare struct {
-	ID        uint32    `gorm:"primaryKey;autoIncrement" json:"id"`
-	VideoID   uint32    `gorm:"index;not null;comment:视频ID" json:"video_id"`
-	UserID    uint32    `gorm:"index;not null;comment:用户ID" json:"user_id"`
-	ShareType string    `gorm:"size:20;comment:分享类型" json:"share_type"`
-	ShareURL  string    `gorm:"size:500;comment:分享链接" json:"share_url"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         uint32    `gorm:"primaryKey;autoIncrement" json:"id"`
+	VideoID    uint32    `gorm:"index;not null;comment:视频ID" json:"video_id"`
+	UserID     uint32    `gorm:"index;not null;comment:用户ID" json:"user_id"`
+	ShareType  string    `gorm:"size:20;comment:分享类型" json:"share_type"`
+	ShareCode  string    `gorm:"size:16;uniqueIndex;comment:分享码" json:"share_code"`
+	ShareURL   string    `gorm:"size:500;comment:分享链接" json:"share_url"`
+	ClickCount uint32    `gorm:"default:0;comment:点击次数" json:"click_count"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 func (VideoShare) TableName() string {
 	return "video_shares"
 }
 
+// 分享类型
+const (
+	ShareTypeWechat        = "wechat"
+	ShareTypeWechatMoments = "wechat_moments"
+	ShareTypeQQ            = "qq"
+	ShareTypeWeibo         = "weibo"
+	ShareTypeCopyLink      = "copy_link"
+	// ShareTypeLink copy_link的别名，存储时统一为copy_link
+	ShareTypeLink = "link"
+)
+
+// NormalizeShareType 将分享类型统一为存储值：空值和link按复制链接处理
+func NormalizeShareType(shareType string) string {
+	switch shareType {
+	case "", ShareTypeLink:
+		return ShareTypeCopyLink
+	}
+	return shareType
+}
+
+// IsValidShareType 检查分享类型是否合法
+func IsValidShareType(shareType string) bool {
+	switch shareType {
+	case ShareTypeWechat, ShareTypeWechatMoments, ShareTypeQQ, ShareTypeWeibo, ShareTypeCopyLink:
+		return true
+	}
+	return false
+}
+
 // VideoFavorite 视频收藏表
 type VideoFavorite struct {
 	ID        uint32    `gorm:"primaryKey;autoIncrement" json:"id"`
@@ -0,0 +1,23 @@
+package model
+
+import "testing"
+
+func TestNormalizeShareType(t *testing.T) {
+	tests := map[string]string{
+		"":                     ShareTypeCopyLink,
+		ShareTypeLink:          ShareTypeCopyLink,
+		ShareTypeCopyLink:      ShareTypeCopyLink,
+		ShareTypeWechat:        ShareTypeWechat,
+		ShareTypeWechatMoments: ShareTypeWechatMoments,
+		"telegram":             "telegram",
+	}
+	for in, want := range tests {
+		got := NormalizeShareType(in)
+		if got != want {
+			t.Errorf("NormalizeShareType(%q) = %q, want %q", in, got, want)
+		}
+		if in != "telegram" && !IsValidShareType(got) {
+			t.Errorf("normalized share type %q is not valid", got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/vision_world/video_service/internal/model"
+)
+
+// recommendQuery 推荐视频的查询语句和参数
+type recommendQuery struct {
+	sql  string
+	vars []interface{}
+}
+
+// newDryRunRecommendRepository 只生成SQL不连接数据库的仓库，统计查询返回total
+func newDryRunRecommendRepository(t *testing.T, total int64) (*VideoRepository, *[]recommendQuery) {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:3306)/video", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	var queries []recommendQuery
+	err = db.Callback().Query().After("gorm:query").Register("test:record", func(tx *gorm.DB) {
+		queries = append(queries, recommendQuery{sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars})
+		// DryRun模式不会清空已生成的SQL，同一语句的下一次查询需要重新生成
+		tx.Statement.SQL.Reset()
+		tx.Statement.Vars = nil
+		if dest, ok := tx.Statement.Dest.(*int64); ok {
+			*dest = total
+			tx.RowsAffected = 1
+		}
+	})
+	if err != nil {
+		t.Fatalf("register query callback: %v", err)
+	}
+	return &VideoRepository{db: model.NewDB(db)}, &queries
+}
+
+func TestGetRecommendVideosQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		category  string
+		wantWhere string
+		wantVars  []interface{}
+	}{
+		{"all categories", "", "WHERE (is_public = ? AND status = ?) AND `videos`.`deleted_at` IS NULL", []interface{}{true, model.VideoStatusNormal}},
+		{"category", "music", "WHERE (is_public = ? AND status = ?) AND category = ? AND `videos`.`deleted_at` IS NULL", []interface{}{true, model.VideoStatusNormal, "music"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, queries := newDryRunRecommendRepository(t, 30)
+
+			_, total, err := repo.GetRecommendVideos(context.Background(), tt.category, 20, 10)
+			if err != nil {
+				t.Fatalf("GetRecommendVideos: %v", err)
+			}
+			if total != 30 {
+				t.Errorf("total = %d, want 30", total)
+			}
+			if len(*queries) != 2 {
+				t.Fatalf("executed %d queries, want count and page", len(*queries))
+			}
+			count, page := (*queries)[0], (*queries)[1]
+			if want := "SELECT count(*) FROM `videos` " + tt.wantWhere; count.sql != want || !reflect.DeepEqual(count.vars, tt.wantVars) {
+				t.Errorf("count query = %s %v, want %s %v", count.sql, count.vars, want, tt.wantVars)
+			}
+			// 分页查询与统计查询使用相同的过滤条件
+			want := "SELECT * FROM `videos` " + tt.wantWhere + " ORDER BY like_count DESC, play_count DESC, created_at DESC LIMIT ? OFFSET ?"
+			wantVars := append(append([]interface{}{}, tt.wantVars...), 10, 20)
+			if page.sql != want || !reflect.DeepEqual(page.vars, wantVars) {
+				t.Errorf("page query = %s %v, want %s %v", page.sql, page.vars, want, wantVars)
+			}
+		})
+	}
+}
@@ -1,12 +1,18 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/vision_world/video_service/internal/config"
 	"github.com/vision_world/video_service/internal/model"
 	"github.com/vision_world/video_service/pkg/database"
 	"github.com/vision_world/video_service/pkg/logger"
+	"github.com/vision_world/video_service/pkg/paginate"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // VideoRepository 视频数据访问层
@@ -48,13 +54,203 @@ func (r *VideoRepository) GetDB() *model.DB {
 	return r.db
 }
 
+// GetUserVideos 分页获取用户发布的视频，按发布时间倒序
+// onlyVisible 为true时只返回公开且审核通过的视频，用于非作者本人查看
+// after不为nil时从游标位置之后查询并忽略offset，游标的排序值为created_at的纳秒时间戳
+func (r *VideoRepository) GetUserVideos(ctx context.Context, userID uint32, onlyVisible bool, after *paginate.Cursor, offset, limit int) ([]*model.Video, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("user_id = ? AND status <> ?", userID, model.VideoStatusDeleted)
+	if onlyVisible {
+		query = query.Where("is_public = ? AND status = ?", true, model.VideoStatusNormal)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count user videos: %w", err)
+	}
+
+	page := query.Session(&gorm.Session{}).Order("created_at DESC").Order("id DESC")
+	if after != nil {
+		createdAt := time.Unix(0, after.Value)
+		page = page.Where("created_at < ? OR (created_at = ? AND id < ?)", createdAt, createdAt, after.ID)
+	} else {
+		page = page.Offset(offset)
+	}
+
+	var videos []*model.Video
+	if err := page.Limit(limit).Find(&videos).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get user videos: %w", err)
+	}
+
+	return videos, total, nil
+}
+
+// GetRecommendVideos 分页获取推荐视频，只包含公开且审核通过的视频
+// 按点赞数、播放数、发布时间依次降序，category为空时不限分类
+func (r *VideoRepository) GetRecommendVideos(ctx context.Context, category string, offset, limit int) ([]*model.Video, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("is_public = ? AND status = ?", true, model.VideoStatusNormal)
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count recommend videos: %w", err)
+	}
+
+	var videos []*model.Video
+	if err := query.Order("like_count DESC, play_count DESC, created_at DESC").
+		Offset(offset).Limit(limit).Find(&videos).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get recommend videos: %w", err)
+	}
+
+	return videos, total, nil
+}
+
+// GetVideoByID 根据ID获取视频
+func (r *VideoRepository) GetVideoByID(ctx context.Context, videoID uint32) (*model.Video, error) {
+	var video model.Video
+	if err := r.db.WithContext(ctx).First(&video, videoID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get video: %w", err)
+	}
+	return &video, nil
+}
+
+// CreateVideo 创建视频记录
+func (r *VideoRepository) CreateVideo(ctx context.Context, video *model.Video) error {
+	if err := r.db.WithContext(ctx).Create(video).Error; err != nil {
+		return fmt.Errorf("failed to create video: %w", err)
+	}
+	return nil
+}
+
+// MarkVideoAuditPassed 标记视频审核通过
+// 审核中的视频直接上线，定时发布的视频保持定时状态，到点后由定时任务上线
+func (r *VideoRepository) MarkVideoAuditPassed(ctx context.Context, videoID uint32) error {
+	if err := r.db.WithContext(ctx).Model(&model.Video{}).
+		Where("id = ?", videoID).
+		Updates(map[string]interface{}{
+			"audit_passed": true,
+			"status": gorm.Expr("CASE WHEN status = ? THEN ? ELSE status END",
+				model.VideoStatusReviewing, model.VideoStatusNormal),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to mark video audit passed: %w", err)
+	}
+	return nil
+}
+
+// UpdateVideoStatus 更新视频状态
+func (r *VideoRepository) UpdateVideoStatus(ctx context.Context, videoID uint32, status string) error {
+	if err := r.db.WithContext(ctx).Model(&model.Video{}).
+		Where("id = ?", videoID).
+		Update("status", status).Error; err != nil {
+		return fmt.Errorf("failed to update video status: %w", err)
+	}
+	return nil
+}
+
+// PublishDueVideos 上线已到发布时间的定时视频，返回本次上线的视频(状态为上线后的状态)
+// 审核已通过的视频直接公开，尚未通过的进入审核中状态，等审核通过后再公开
+func (r *VideoRepository) PublishDueVideos(ctx context.Context, now time.Time) ([]*model.Video, error) {
+	var due []*model.Video
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ? AND publish_at <= ?", model.VideoStatusScheduled, now).
+			Find(&due).Error; err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uint32, 0, len(due))
+		for _, video := range due {
+			ids = append(ids, video.ID)
+		}
+		if err := tx.Model(&model.Video{}).
+			Where("id IN ?", ids).
+			Update("status", gorm.Expr("CASE WHEN audit_passed THEN ? ELSE ? END",
+				model.VideoStatusNormal, model.VideoStatusReviewing)).Error; err != nil {
+			return err
+		}
+
+		for _, video := range due {
+			video.Status = model.VideoStatusReviewing
+			if video.AuditPassed {
+				video.Status = model.VideoStatusNormal
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish due videos: %w", err)
+	}
+	return due, nil
+}
+
+// CancelScheduledVideo 取消作者的定时发布，视频转为草稿
+// 仅定时状态的视频可取消，返回是否取消成功；已到点上线的视频不受影响
+func (r *VideoRepository) CancelScheduledVideo(ctx context.Context, videoID, userID uint32) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.Video{}).
+		Where("id = ? AND user_id = ? AND status = ?", videoID, userID, model.VideoStatusScheduled).
+		Updates(map[string]interface{}{
+			"status":     model.VideoStatusDraft,
+			"publish_at": nil,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to cancel scheduled video: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateVideoShare 创建分享记录并增加视频分享数
+func (r *VideoRepository) CreateVideoShare(ctx context.Context, share *model.VideoShare) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(share).Error; err != nil {
+			return fmt.Errorf("failed to create video share: %w", err)
+		}
+
+		if err := tx.Model(&model.Video{}).
+			Where("id = ?", share.VideoID).
+			UpdateColumn("share_count", gorm.Expr("share_count + ?", 1)).Error; err != nil {
+			return fmt.Errorf("failed to increment share count: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetVideoShareByCode 根据分享码获取分享记录
+func (r *VideoRepository) GetVideoShareByCode(ctx context.Context, shareCode string) (*model.VideoShare, error) {
+	var share model.VideoShare
+	if err := r.db.WithContext(ctx).Where("share_code = ?", shareCode).First(&share).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get video share: %w", err)
+	}
+	return &share, nil
+}
+
+// IncrementShareClickCount 增加分享链接点击次数
+func (r *VideoRepository) IncrementShareClickCount(ctx context.Context, shareID uint32) error {
+	if err := r.db.WithContext(ctx).Model(&model.VideoShare{}).
+		Where("id = ?", shareID).
+		UpdateColumn("click_count", gorm.Expr("click_count + ?", 1)).Error; err != nil {
+		return fmt.Errorf("failed to increment share click count: %w", err)
+	}
+	return nil
+}
+
 // TODO: 实现具体的数据访问方法
 // 这些方法将被service层调用，具体实现由你后续完成
 // 例如：
-// - CreateVideo()
-// - GetVideoByID()
 // - GetVideosByIDs()
-// - GetUserVideos()
 // - GetRecommendVideos()
 // - GetFollowVideos()
 // - LikeVideo()
@@ -63,7 +259,6 @@ func (r *VideoRepository) GetDB() *model.DB {
 // - CommentVideo()
 // - DeleteComment()
 // - GetVideoComments()
-// - ShareVideo()
 // - UpdateVideoStats()
 // - GetVideoByCategory()
 // - SearchVideos()
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/vision_world/video_service/internal/model"
+)
+
+// recordingConnPool 记录执行的SQL，不连接真实数据库
+type recordingConnPool struct {
+	statements []string
+	committed  bool
+	rolledBack bool
+}
+
+type recordingResult struct{}
+
+func (recordingResult) LastInsertId() (int64, error) { return 1, nil }
+func (recordingResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (p *recordingConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (p *recordingConnPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.statements = append(p.statements, query)
+	return recordingResult{}, nil
+}
+
+func (p *recordingConnPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("query not supported")
+}
+
+func (p *recordingConnPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func (p *recordingConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &recordingTx{p}, nil
+}
+
+// recordingTx 事务内的语句仍记录到同一个recordingConnPool
+type recordingTx struct {
+	*recordingConnPool
+}
+
+func (tx *recordingTx) Commit() error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *recordingTx) Rollback() error {
+	tx.rolledBack = true
+	return nil
+}
+
+func newRecordingRepository(t *testing.T) (*VideoRepository, *recordingConnPool) {
+	t.Helper()
+	pool := &recordingConnPool{}
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: pool, SkipInitializeWithVersion: true}),
+		&gorm.Config{DisableAutomaticPing: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open recording db: %v", err)
+	}
+	return &VideoRepository{db: model.NewDB(db)}, pool
+}
+
+func TestCreateVideoShareIncrementsShareCountInTransaction(t *testing.T) {
+	repo, pool := newRecordingRepository(t)
+	share := &model.VideoShare{VideoID: 7, UserID: 9, ShareType: model.ShareTypeCopyLink, ShareCode: "abcd1234"}
+	if err := repo.CreateVideoShare(context.Background(), share); err != nil {
+		t.Fatalf("CreateVideoShare: %v", err)
+	}
+
+	if len(pool.statements) != 2 {
+		t.Fatalf("executed %d statements, want 2: %v", len(pool.statements), pool.statements)
+	}
+	if !strings.HasPrefix(pool.statements[0], "INSERT INTO `video_shares`") {
+		t.Errorf("first statement = %s, want insert into video_shares", pool.statements[0])
+	}
+	if !strings.Contains(pool.statements[1], "`share_count`=share_count + ?") {
+		t.Errorf("second statement = %s, want share_count increment", pool.statements[1])
+	}
+	if !pool.committed || pool.rolledBack {
+		t.Errorf("transaction committed=%v rolledBack=%v, want committed only", pool.committed, pool.rolledBack)
+	}
+}
+
+func TestIncrementShareClickCount(t *testing.T) {
+	repo, pool := newRecordingRepository(t)
+	if err := repo.IncrementShareClickCount(context.Background(), 3); err != nil {
+		t.Fatalf("IncrementShareClickCount: %v", err)
+	}
+
+	if len(pool.statements) != 1 || !strings.Contains(pool.statements[0], "`click_count`=click_count + ?") {
+		t.Fatalf("statements = %v, want one click_count increment", pool.statements)
+	}
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vision_world/video_service/internal/config"
+	"github.com/vision_world/video_service/internal/model"
+	"github.com/vision_world/video_service/pkg/paginate"
+)
+
+// fakeVideoRepository 内存实现的视频数据访问，行为与repository.VideoRepository保持一致
+type fakeVideoRepository struct {
+	mu     sync.Mutex
+	videos map[uint32]*model.Video
+	shares map[string]*model.VideoShare
+	nextID uint32
+
+	// recommendCalls 每次查询推荐候选视频的参数
+	recommendCalls []recommendCall
+}
+
+// recommendCall 查询推荐候选视频的分类和分页
+type recommendCall struct {
+	category      string
+	offset, limit int
+}
+
+func newFakeVideoRepository(videos ...*model.Video) *fakeVideoRepository {
+	repo := &fakeVideoRepository{
+		videos: make(map[uint32]*model.Video),
+		shares: make(map[string]*model.VideoShare),
+	}
+	for _, video := range videos {
+		repo.videos[video.ID] = video
+	}
+	return repo
+}
+
+func newTestVideoService(repo *fakeVideoRepository) *VideoService {
+	return &VideoService{
+		config: &config.Config{Share: config.ShareConfig{BaseURL: "https://v.example.com/s/"}},
+		repo:   repo,
+		ranker: PopularityRanker{},
+	}
+}
+
+func (r *fakeVideoRepository) Close() error { return nil }
+
+func (r *fakeVideoRepository) GetUserVideos(ctx context.Context, userID uint32, onlyVisible bool, after *paginate.Cursor, offset, limit int) ([]*model.Video, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var matched []*model.Video
+	for _, video := range r.videos {
+		if video.UserID != userID || video.Status == model.VideoStatusDeleted {
+			continue
+		}
+		if onlyVisible && !(video.IsPublic && video.Status == model.VideoStatusNormal) {
+			continue
+		}
+		copied := *video
+		matched = append(matched, &copied)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.After(matched[j].CreatedAt)
+		}
+		return matched[i].ID > matched[j].ID
+	})
+	total := int64(len(matched))
+
+	if after != nil {
+		createdAt := time.Unix(0, after.Value)
+		start := len(matched)
+		for i, video := range matched {
+			if video.CreatedAt.Before(createdAt) || (video.CreatedAt.Equal(createdAt) && uint64(video.ID) < after.ID) {
+				start = i
+				break
+			}
+		}
+		matched = matched[start:]
+	} else if offset < len(matched) {
+		matched = matched[offset:]
+	} else {
+		matched = nil
+	}
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, total, nil
+}
+
+func (r *fakeVideoRepository) GetRecommendVideos(ctx context.Context, category string, offset, limit int) ([]*model.Video, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recommendCalls = append(r.recommendCalls, recommendCall{category: category, offset: offset, limit: limit})
+	var matched []*model.Video
+	for _, video := range r.videos {
+		if !video.IsPublic || video.Status != model.VideoStatusNormal {
+			continue
+		}
+		if category != "" && video.Category != category {
+			continue
+		}
+		copied := *video
+		matched = append(matched, &copied)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if a.LikeCount != b.LikeCount {
+			return a.LikeCount > b.LikeCount
+		}
+		if a.PlayCount != b.PlayCount {
+			return a.PlayCount > b.PlayCount
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	matched = matched[offset:]
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, total, nil
+}
+
+func (r *fakeVideoRepository) GetVideoByID(ctx context.Context, videoID uint32) (*model.Video, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	video, ok := r.videos[videoID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *video
+	return &copied, nil
+}
+
+func (r *fakeVideoRepository) CreateVideo(ctx context.Context, video *model.Video) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if video.ID == 0 {
+		r.nextID++
+		video.ID = r.nextID
+	}
+	copied := *video
+	r.videos[video.ID] = &copied
+	return nil
+}
+
+func (r *fakeVideoRepository) MarkVideoAuditPassed(ctx context.Context, videoID uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if video, ok := r.videos[videoID]; ok {
+		video.AuditPassed = true
+		if video.Status == model.VideoStatusReviewing {
+			video.Status = model.VideoStatusNormal
+		}
+	}
+	return nil
+}
+
+func (r *fakeVideoRepository) UpdateVideoStatus(ctx context.Context, videoID uint32, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if video, ok := r.videos[videoID]; ok {
+		video.Status = status
+	}
+	return nil
+}
+
+func (r *fakeVideoRepository) PublishDueVideos(ctx context.Context, now time.Time) ([]*model.Video, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*model.Video
+	for _, video := range r.videos {
+		if video.Status != model.VideoStatusScheduled || video.PublishAt == nil || video.PublishAt.After(now) {
+			continue
+		}
+		video.Status = model.VideoStatusReviewing
+		if video.AuditPassed {
+			video.Status = model.VideoStatusNormal
+		}
+		copied := *video
+		due = append(due, &copied)
+	}
+	return due, nil
+}
+
+func (r *fakeVideoRepository) CancelScheduledVideo(ctx context.Context, videoID, userID uint32) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	video, ok := r.videos[videoID]
+	if !ok || video.UserID != userID || video.Status != model.VideoStatusScheduled {
+		return false, nil
+	}
+	video.Status = model.VideoStatusDraft
+	video.PublishAt = nil
+	return true, nil
+}
+
+func (r *fakeVideoRepository) CreateVideoShare(ctx context.Context, share *model.VideoShare) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	share.ID = r.nextID
+	copied := *share
+	r.shares[share.ShareCode] = &copied
+	if video, ok := r.videos[share.VideoID]; ok {
+		video.ShareCount++
+	}
+	return nil
+}
+
+func (r *fakeVideoRepository) GetVideoShareByCode(ctx context.Context, shareCode string) (*model.VideoShare, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	share, ok := r.shares[shareCode]
+	if !ok {
+		return nil, nil
+	}
+	copied := *share
+	return &copied, nil
+}
+
+func (r *fakeVideoRepository) IncrementShareClickCount(ctx context.Context, shareID uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, share := range r.shares {
+		if share.ID == shareID {
+			share.ClickCount++
+		}
+	}
+	return nil
+}
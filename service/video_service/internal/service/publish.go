@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vision_world/video_service/internal/model"
+	"github.com/vision_world/video_service/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// defaultScheduleInterval 定时发布默认扫描间隔
+const defaultScheduleInterval = 30 * time.Second
+
+var (
+	// ErrInvalidPublishTime 定时发布时间超出允许范围
+	ErrInvalidPublishTime = errors.New("invalid publish time")
+	// ErrVideoNotScheduled 视频不是定时发布状态
+	ErrVideoNotScheduled = errors.New("video is not scheduled")
+	// ErrVideoPermissionDenied 无权操作该视频
+	ErrVideoPermissionDenied = errors.New("no permission to operate video")
+)
+
+// AuditOutcome 发布时的内容审核结果
+type AuditOutcome int
+
+const (
+	AuditOutcomePending  AuditOutcome = iota // 待审核或人工审核中
+	AuditOutcomePassed                       // 审核通过
+	AuditOutcomeRejected                     // 审核拒绝
+)
+
+// PublishVideo 创建视频记录
+// publishAt为空或不晚于当前时间时立即发布，视频先进入审核中状态；
+// 否则作为定时发布保存，到达发布时间前仅作者可见，由定时任务负责上线
+func (s *VideoService) PublishVideo(ctx context.Context, video *model.Video, publishAt *time.Time) error {
+	now := time.Now()
+	if publishAt != nil && publishAt.After(now) {
+		if limit := s.config.Publish.MaxScheduleAhead; limit > 0 && publishAt.Sub(now) > limit {
+			return ErrInvalidPublishTime
+		}
+		at := *publishAt
+		video.Status = model.VideoStatusScheduled
+		video.PublishAt = &at
+	} else {
+		video.Status = model.VideoStatusReviewing
+		video.PublishAt = nil
+	}
+
+	if err := s.repo.CreateVideo(ctx, video); err != nil {
+		return err
+	}
+	s.indexVideo(video)
+	return nil
+}
+
+// ApplyAuditResult 根据审核结果更新视频状态
+// 审核通过时立即发布的视频上线、定时发布的视频等待到点；审核拒绝时视频封禁，定时发布随之作废
+func (s *VideoService) ApplyAuditResult(ctx context.Context, videoID uint32, outcome AuditOutcome) error {
+	var err error
+	switch outcome {
+	case AuditOutcomePassed:
+		err = s.repo.MarkVideoAuditPassed(ctx, videoID)
+	case AuditOutcomeRejected:
+		err = s.repo.UpdateVideoStatus(ctx, videoID, model.VideoStatusBanned)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.reindexVideo(ctx, videoID)
+	return nil
+}
+
+// CancelScheduledPublish 取消定时发布，视频转为仅作者可见的草稿
+func (s *VideoService) CancelScheduledPublish(ctx context.Context, videoID, userID uint32) error {
+	cancelled, err := s.repo.CancelScheduledVideo(ctx, videoID, userID)
+	if err != nil {
+		return err
+	}
+	if cancelled {
+		s.reindexVideo(ctx, videoID)
+		return nil
+	}
+
+	// 未取消时区分视频不存在、非作者和已不在定时状态
+	video, err := s.repo.GetVideoByID(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if video == nil || video.Status == model.VideoStatusDeleted {
+		return ErrVideoNotFound
+	}
+	if video.UserID != userID {
+		return ErrVideoPermissionDenied
+	}
+	return ErrVideoNotScheduled
+}
+
+// PublishDueVideos 上线已到发布时间的定时视频
+func (s *VideoService) PublishDueVideos(ctx context.Context) (int64, error) {
+	videos, err := s.repo.PublishDueVideos(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	for _, video := range videos {
+		s.indexVideo(video)
+	}
+	return int64(len(videos)), nil
+}
+
+// StartPublishScheduler 启动定时发布后台任务，按配置的间隔扫描到点的视频
+func (s *VideoService) StartPublishScheduler() {
+	s.schedulerOnce.Do(func() {
+		interval := s.config.Publish.ScheduleInterval
+		if interval <= 0 {
+			interval = defaultScheduleInterval
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s.schedulerCancel = cancel
+		s.schedulerDone = make(chan struct{})
+		go s.runPublishScheduler(ctx, interval)
+	})
+}
+
+func (s *VideoService) runPublishScheduler(ctx context.Context, interval time.Duration) {
+	defer close(s.schedulerDone)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			published, err := s.PublishDueVideos(ctx)
+			if err != nil {
+				logger.Warn("Failed to publish scheduled videos", zap.Error(err))
+				continue
+			}
+			if published > 0 {
+				logger.Info("Scheduled videos published", zap.Int64("count", published))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// stopPublishScheduler 停止定时发布后台任务并等待退出
+func (s *VideoService) stopPublishScheduler() {
+	if s.schedulerCancel == nil {
+		return
+	}
+	s.schedulerCancel()
+	<-s.schedulerDone
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vision_world/video_service/internal/model"
+)
+
+func TestPublishVideoSchedulesFutureTime(t *testing.T) {
+	repo := newFakeVideoRepository()
+	s := newTestVideoService(repo)
+	publishAt := time.Now().Add(time.Hour)
+
+	video := &model.Video{UserID: 7, Title: "定时视频", IsPublic: true}
+	if err := s.PublishVideo(context.Background(), video, &publishAt); err != nil {
+		t.Fatalf("PublishVideo: %v", err)
+	}
+	stored := repo.videos[video.ID]
+	if stored.Status != model.VideoStatusScheduled || stored.PublishAt == nil || !stored.PublishAt.Equal(publishAt) {
+		t.Fatalf("stored video = status %s publish_at %v, want scheduled at %v", stored.Status, stored.PublishAt, publishAt)
+	}
+
+	// 定时视频到点前只对作者可见
+	if videos, _, _ := repo.GetUserVideos(context.Background(), 7, true, nil, 0, 10); len(videos) != 0 {
+		t.Errorf("scheduled video visible to others: %v", videos)
+	}
+}
+
+func TestPublishVideoPublishesPastAndCurrentTimeImmediately(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	now := time.Now()
+	tests := []struct {
+		name      string
+		publishAt *time.Time
+	}{
+		{"no publish time", nil},
+		{"past", &past},
+		{"now", &now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeVideoRepository()
+			s := newTestVideoService(repo)
+
+			video := &model.Video{UserID: 7, IsPublic: true}
+			if err := s.PublishVideo(context.Background(), video, tt.publishAt); err != nil {
+				t.Fatalf("PublishVideo: %v", err)
+			}
+			stored := repo.videos[video.ID]
+			if stored.Status != model.VideoStatusReviewing || stored.PublishAt != nil {
+				t.Errorf("stored video = status %s publish_at %v, want reviewing without a schedule", stored.Status, stored.PublishAt)
+			}
+		})
+	}
+}
+
+func TestPublishVideoRejectsScheduleTooFarAhead(t *testing.T) {
+	repo := newFakeVideoRepository()
+	s := newTestVideoService(repo)
+	s.config.Publish.MaxScheduleAhead = 24 * time.Hour
+
+	tooFar := time.Now().Add(48 * time.Hour)
+	if err := s.PublishVideo(context.Background(), &model.Video{UserID: 7}, &tooFar); !errors.Is(err, ErrInvalidPublishTime) {
+		t.Fatalf("PublishVideo error = %v, want ErrInvalidPublishTime", err)
+	}
+	if len(repo.videos) != 0 {
+		t.Errorf("rejected schedule created %d videos", len(repo.videos))
+	}
+
+	withinLimit := time.Now().Add(12 * time.Hour)
+	if err := s.PublishVideo(context.Background(), &model.Video{UserID: 7}, &withinLimit); err != nil {
+		t.Errorf("PublishVideo within limit: %v", err)
+	}
+}
+
+func TestPublishDueVideos(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	repo := newFakeVideoRepository(
+		&model.Video{ID: 1, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &past, AuditPassed: true},
+		&model.Video{ID: 2, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &past},
+		&model.Video{ID: 3, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &future, AuditPassed: true},
+	)
+	s := newTestVideoService(repo)
+
+	published, err := s.PublishDueVideos(context.Background())
+	if err != nil {
+		t.Fatalf("PublishDueVideos: %v", err)
+	}
+	if published != 2 {
+		t.Errorf("published = %d, want 2", published)
+	}
+	// 审核已通过的直接公开，未通过的进入审核中，未到点的保持定时
+	want := map[uint32]string{1: model.VideoStatusNormal, 2: model.VideoStatusReviewing, 3: model.VideoStatusScheduled}
+	for id, status := range want {
+		if got := repo.videos[id].Status; got != status {
+			t.Errorf("video %d status = %s, want %s", id, got, status)
+		}
+	}
+
+	if published, _ := s.PublishDueVideos(context.Background()); published != 0 {
+		t.Errorf("second run published %d, want 0", published)
+	}
+}
+
+func TestApplyAuditResultKeepsScheduledVideoWaiting(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	repo := newFakeVideoRepository(&model.Video{ID: 1, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &future})
+	s := newTestVideoService(repo)
+
+	if err := s.ApplyAuditResult(context.Background(), 1, AuditOutcomePassed); err != nil {
+		t.Fatalf("ApplyAuditResult: %v", err)
+	}
+	if got := repo.videos[1]; got.Status != model.VideoStatusScheduled || !got.AuditPassed {
+		t.Errorf("video = status %s audit_passed %v, want still scheduled with audit passed", got.Status, got.AuditPassed)
+	}
+}
+
+func TestCancelScheduledPublish(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name       string
+		video      *model.Video
+		userID     uint32
+		wantErr    error
+		wantStatus string
+	}{
+		{"author cancels", &model.Video{ID: 1, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &future}, 7, nil, model.VideoStatusDraft},
+		{"other user", &model.Video{ID: 1, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &future}, 8, ErrVideoPermissionDenied, model.VideoStatusScheduled},
+		{"already published", &model.Video{ID: 1, UserID: 7, Status: model.VideoStatusNormal}, 7, ErrVideoNotScheduled, model.VideoStatusNormal},
+		{"deleted", &model.Video{ID: 1, UserID: 7, Status: model.VideoStatusDeleted}, 7, ErrVideoNotFound, model.VideoStatusDeleted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeVideoRepository(tt.video)
+			s := newTestVideoService(repo)
+
+			if err := s.CancelScheduledPublish(context.Background(), 1, tt.userID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CancelScheduledPublish error = %v, want %v", err, tt.wantErr)
+			}
+			got := repo.videos[1]
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", got.Status, tt.wantStatus)
+			}
+			if tt.wantErr == nil && got.PublishAt != nil {
+				t.Errorf("cancelled video still has publish_at %v", got.PublishAt)
+			}
+		})
+	}
+
+	s := newTestVideoService(newFakeVideoRepository())
+	if err := s.CancelScheduledPublish(context.Background(), 99, 7); !errors.Is(err, ErrVideoNotFound) {
+		t.Errorf("missing video error = %v, want ErrVideoNotFound", err)
+	}
+}
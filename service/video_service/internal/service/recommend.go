@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/vision_world/video_service/internal/model"
+)
+
+// 推荐候选参数
+const (
+	// recommendCandidateFactor 候选视频数为所需视频数的倍数，为按作者打散后顺延的视频留出余量
+	recommendCandidateFactor = 2
+	// defaultRecommendCandidateLimit 未配置时参与整体打散分页的候选视频数上限
+	defaultRecommendCandidateLimit = 500
+)
+
+// VideoRanker 推荐视频排序策略，返回按推荐优先级排序后的视频
+type VideoRanker interface {
+	Rank(ctx context.Context, videos []*model.Video) []*model.Video
+}
+
+// PopularityRanker 按点赞数、播放数、发布时间依次降序排序
+type PopularityRanker struct{}
+
+// Rank 按热度排序
+func (PopularityRanker) Rank(ctx context.Context, videos []*model.Video) []*model.Video {
+	sort.SliceStable(videos, func(i, j int) bool {
+		a, b := videos[i], videos[j]
+		if a.LikeCount != b.LikeCount {
+			return a.LikeCount > b.LikeCount
+		}
+		if a.PlayCount != b.PlayCount {
+			return a.PlayCount > b.PlayCount
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+	return videos
+}
+
+// SetRecommendRanker 替换推荐视频排序策略
+func (s *VideoService) SetRecommendRanker(ranker VideoRanker) {
+	s.ranker = ranker
+}
+
+// GetRecommendVideos 获取推荐视频列表，返回当前页视频以及是否还有下一页
+// category不为空时只推荐该分类的视频；候选视频经排序后按作者打散，每页同一作者最多max_per_creator个，超出的顺延到后续页。
+// 前candidate_limit个候选视频整体打散后分页，翻页不会重复或遗漏；更深的翻页只在页内限制，超出的视频不再展示
+func (s *VideoService) GetRecommendVideos(ctx context.Context, category string, page, pageSize uint32) ([]*model.Video, bool, error) {
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	candidateLimit := s.config.Recommend.CandidateLimit
+	if candidateLimit <= 0 {
+		candidateLimit = defaultRecommendCandidateLimit
+	}
+	maxPerCreator := s.config.Recommend.MaxPerCreator
+
+	// 所需候选超出上限时只取当前页附近的视频，在页内打散
+	offset, targetPage := 0, page
+	limit := int(page*pageSize) * recommendCandidateFactor
+	if limit > candidateLimit {
+		offset = int((page - 1) * pageSize)
+		limit = int(pageSize) * recommendCandidateFactor
+		targetPage = 1
+	}
+
+	candidates, total, err := s.repo.GetRecommendVideos(ctx, category, offset, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	videos, remaining := diversifyPage(s.ranker.Rank(ctx, candidates), targetPage, pageSize, maxPerCreator)
+	if targetPage != page {
+		remaining = 0
+	}
+	hasMore := remaining > 0 || int64(offset+len(candidates)) < total
+	return videos, hasMore, nil
+}
+
+// diversifyPage 将排序后的视频依次分页并返回第page页，以及之后还剩余的视频数
+// 每页同一作者最多maxPerCreator个，超出的视频保持原有顺序顺延到后续页；maxPerCreator<=0时不限制
+func diversifyPage(videos []*model.Video, page, pageSize uint32, maxPerCreator int) ([]*model.Video, int) {
+	if maxPerCreator <= 0 {
+		start := int((page - 1) * pageSize)
+		if start >= len(videos) {
+			return nil, 0
+		}
+		end := start + int(pageSize)
+		if end > len(videos) {
+			end = len(videos)
+		}
+		return videos[start:end], len(videos) - end
+	}
+
+	remaining := videos
+	for p := uint32(1); ; p++ {
+		current := make([]*model.Video, 0, pageSize)
+		var deferred []*model.Video
+		perCreator := make(map[uint32]int)
+		for i, video := range remaining {
+			if len(current) == int(pageSize) {
+				deferred = append(deferred, remaining[i:]...)
+				break
+			}
+			if perCreator[video.UserID] >= maxPerCreator {
+				deferred = append(deferred, video)
+				continue
+			}
+			perCreator[video.UserID]++
+			current = append(current, video)
+		}
+		if p == page || len(deferred) == 0 {
+			if p != page {
+				return nil, 0
+			}
+			return current, len(deferred)
+		}
+		remaining = deferred
+	}
+}
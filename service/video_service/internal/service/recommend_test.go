@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/vision_world/video_service/internal/config"
+	"github.com/vision_world/video_service/internal/model"
+)
+
+// newRecommendVideos 作者100有5个视频、作者200和300各2个，ID越小点赞越多
+func newRecommendVideos() []*model.Video {
+	authors := []uint32{100, 100, 100, 100, 100, 200, 200, 300, 300}
+	videos := make([]*model.Video, 0, len(authors))
+	for i, author := range authors {
+		video := publicVideo(uint32(i+1), author)
+		video.LikeCount = uint32(100 - i)
+		videos = append(videos, video)
+	}
+	return videos
+}
+
+func newRecommendTestService(repo *fakeVideoRepository, recommend config.RecommendConfig) *VideoService {
+	svc := newTestVideoService(repo)
+	svc.config.Recommend = recommend
+	return svc
+}
+
+func TestGetRecommendVideosSpreadsCreatorsAcrossPages(t *testing.T) {
+	svc := newRecommendTestService(newFakeVideoRepository(newRecommendVideos()...), config.RecommendConfig{MaxPerCreator: 2})
+
+	// 作者100超出每页上限的视频顺延到后续页，其余视频保持热度顺序
+	tests := []struct {
+		page        uint32
+		want        []uint32
+		wantHasMore bool
+	}{
+		{1, []uint32{1, 2, 6}, true},
+		{2, []uint32{3, 4, 7}, true},
+		{3, []uint32{5, 8, 9}, false},
+		{4, nil, false},
+	}
+	seen := make(map[uint32]bool)
+	for _, tt := range tests {
+		videos, hasMore, err := svc.GetRecommendVideos(context.Background(), "", tt.page, 3)
+		if err != nil {
+			t.Fatalf("page %d: GetRecommendVideos: %v", tt.page, err)
+		}
+		if got := videoIDs(videos); !equalIDs(got, tt.want) || hasMore != tt.wantHasMore {
+			t.Errorf("page %d = %v (more %v), want %v (more %v)", tt.page, got, hasMore, tt.want, tt.wantHasMore)
+		}
+		for _, video := range videos {
+			if seen[video.ID] {
+				t.Errorf("video %d repeated on page %d", video.ID, tt.page)
+			}
+			seen[video.ID] = true
+		}
+	}
+	if len(seen) != 9 {
+		t.Errorf("pages showed %d videos, want all 9", len(seen))
+	}
+}
+
+func TestGetRecommendVideosWithoutCreatorLimit(t *testing.T) {
+	svc := newRecommendTestService(newFakeVideoRepository(newRecommendVideos()...), config.RecommendConfig{})
+
+	videos, hasMore, err := svc.GetRecommendVideos(context.Background(), "", 1, 3)
+	if err != nil {
+		t.Fatalf("GetRecommendVideos: %v", err)
+	}
+	if got := videoIDs(videos); !equalIDs(got, []uint32{1, 2, 3}) || !hasMore {
+		t.Errorf("page = %v (more %v), want [1 2 3] with more", got, hasMore)
+	}
+}
+
+func TestGetRecommendVideosFiltersCategory(t *testing.T) {
+	videos := newRecommendVideos()
+	for _, video := range videos {
+		video.Category = "game"
+	}
+	videos[1].Category = "music"
+	videos[6].Category = "music"
+	repo := newFakeVideoRepository(videos...)
+	svc := newRecommendTestService(repo, config.RecommendConfig{MaxPerCreator: 1})
+
+	got, hasMore, err := svc.GetRecommendVideos(context.Background(), "music", 1, 10)
+	if err != nil {
+		t.Fatalf("GetRecommendVideos: %v", err)
+	}
+	if ids := videoIDs(got); !equalIDs(ids, []uint32{2, 7}) || hasMore {
+		t.Errorf("music videos = %v (more %v), want [2 7]", ids, hasMore)
+	}
+	if call := repo.recommendCalls[0]; call.category != "music" {
+		t.Errorf("queried category %q, want music", call.category)
+	}
+}
+
+func TestGetRecommendVideosCandidateWindow(t *testing.T) {
+	repo := newFakeVideoRepository(newRecommendVideos()...)
+	svc := newRecommendTestService(repo, config.RecommendConfig{MaxPerCreator: 2, CandidateLimit: 4})
+
+	// 第1页需要4个候选，未超出上限，从头整体打散
+	if _, _, err := svc.GetRecommendVideos(context.Background(), "", 1, 2); err != nil {
+		t.Fatalf("page 1: %v", err)
+	}
+	// 第2页需要8个候选，超出上限后只取当前页附近的视频在页内打散
+	videos, hasMore, err := svc.GetRecommendVideos(context.Background(), "", 2, 2)
+	if err != nil {
+		t.Fatalf("page 2: %v", err)
+	}
+	want := []recommendCall{{offset: 0, limit: 4}, {offset: 2, limit: 4}}
+	for i, call := range repo.recommendCalls {
+		if call != want[i] {
+			t.Errorf("query %d = %+v, want %+v", i+1, call, want[i])
+		}
+	}
+	if got := videoIDs(videos); !equalIDs(got, []uint32{3, 4}) || !hasMore {
+		t.Errorf("page 2 = %v (more %v), want [3 4] with more", got, hasMore)
+	}
+}
+
+// idRanker 按视频ID升序排序
+type idRanker struct{}
+
+func (idRanker) Rank(ctx context.Context, videos []*model.Video) []*model.Video {
+	sort.Slice(videos, func(i, j int) bool { return videos[i].ID < videos[j].ID })
+	return videos
+}
+
+func TestGetRecommendVideosUsesRanker(t *testing.T) {
+	videos := newRecommendVideos()
+	// 点赞数倒置后热度排序与ID顺序相反
+	for i, video := range videos {
+		video.LikeCount = uint32(i)
+	}
+	svc := newRecommendTestService(newFakeVideoRepository(videos...), config.RecommendConfig{MaxPerCreator: 2})
+
+	got, _, err := svc.GetRecommendVideos(context.Background(), "", 1, 3)
+	if err != nil {
+		t.Fatalf("GetRecommendVideos: %v", err)
+	}
+	if ids := videoIDs(got); !equalIDs(ids, []uint32{9, 8, 7}) {
+		t.Errorf("popularity page = %v, want [9 8 7]", ids)
+	}
+
+	// 排序策略只调整候选视频的顺序，候选仍是热度最高的视频
+	svc.SetRecommendRanker(idRanker{})
+	got, _, err = svc.GetRecommendVideos(context.Background(), "", 1, 3)
+	if err != nil {
+		t.Fatalf("GetRecommendVideos: %v", err)
+	}
+	if ids := videoIDs(got); !equalIDs(ids, []uint32{4, 5, 6}) {
+		t.Errorf("ranked page = %v, want [4 5 6]", ids)
+	}
+}
+
+func TestDiversifyPage(t *testing.T) {
+	videos := newRecommendVideos()
+	tests := []struct {
+		name          string
+		page          uint32
+		pageSize      uint32
+		maxPerCreator int
+		want          []uint32
+		wantRemaining int
+	}{
+		{"no limit", 2, 4, 0, []uint32{5, 6, 7, 8}, 1},
+		{"no limit past end", 4, 4, 0, nil, 0},
+		{"one per creator", 1, 3, 1, []uint32{1, 6, 8}, 6},
+		{"one per creator last page", 5, 3, 1, []uint32{5}, 0},
+		{"short page", 1, 10, 2, []uint32{1, 2, 6, 7, 8, 9}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, remaining := diversifyPage(videos, tt.page, tt.pageSize, tt.maxPerCreator)
+			if ids := videoIDs(got); !equalIDs(ids, tt.want) || remaining != tt.wantRemaining {
+				t.Errorf("diversifyPage = %v (remaining %d), want %v (remaining %d)", ids, remaining, tt.want, tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/vision_world/video_service/internal/model"
+	"github.com/vision_world/video_service/pkg/logger"
+	"github.com/vision_world/video_service/pkg/searchindex"
+	"go.uber.org/zap"
+)
+
+// SetSearchIndexer 设置搜索索引事件发布器，未设置时视频变更不发布索引事件
+func (s *VideoService) SetSearchIndexer(indexer *searchindex.Publisher) {
+	s.indexer = indexer
+}
+
+// indexVideo 发布视频索引事件，搜索服务根据status和is_public决定是否可被搜索
+func (s *VideoService) indexVideo(video *model.Video) {
+	if s.indexer == nil || video == nil {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"user_id":     video.UserID,
+		"title":       video.Title,
+		"description": video.Description,
+		"cover_url":   video.CoverURL,
+		"duration":    video.Duration,
+		"category":    video.Category,
+		"tags":        splitTags(video.Tags),
+		"is_public":   video.IsPublic,
+		"status":      video.Status,
+		"created_at":  video.CreatedAt.Unix(),
+	}
+	if video.PublishAt != nil {
+		fields["publish_at"] = video.PublishAt.Unix()
+	}
+
+	s.indexer.Publish(searchindex.Event{
+		Op:      searchindex.OpUpsert,
+		DocType: searchindex.DocTypeVideo,
+		ID:      strconv.FormatUint(uint64(video.ID), 10),
+		Fields:  fields,
+	})
+}
+
+// reindexVideo 读取视频最新状态后发布索引事件，用于只更新了部分字段的变更
+// 读取失败只记录日志，不影响调用方
+func (s *VideoService) reindexVideo(ctx context.Context, videoID uint32) {
+	if s.indexer == nil {
+		return
+	}
+	video, err := s.repo.GetVideoByID(ctx, videoID)
+	if err != nil {
+		logger.Warn("Failed to load video for search index", zap.Uint32("video_id", videoID), zap.Error(err))
+		return
+	}
+	if video == nil {
+		s.indexer.Publish(searchindex.Event{
+			Op:      searchindex.OpDelete,
+			DocType: searchindex.DocTypeVideo,
+			ID:      strconv.FormatUint(uint64(videoID), 10),
+		})
+		return
+	}
+	s.indexVideo(video)
+}
+
+// splitTags 拆分逗号分隔的标签
+func splitTags(tags string) []string {
+	var result []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
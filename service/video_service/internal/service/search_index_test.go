@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vision_world/video_service/internal/model"
+	"github.com/vision_world/video_service/pkg/searchindex"
+)
+
+// recordingSink 记录投递的索引事件
+type recordingSink struct {
+	mu     sync.Mutex
+	events []searchindex.Event
+}
+
+func (s *recordingSink) Push(ctx context.Context, event *searchindex.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, *event)
+	return nil
+}
+
+// withIndexer 为服务设置索引发布器，返回的函数停止发布器并返回已投递的事件
+func withIndexer(t *testing.T, s *VideoService) func() []searchindex.Event {
+	t.Helper()
+	sink := &recordingSink{}
+	indexer := searchindex.NewPublisher(sink, 0)
+	indexer.Start()
+	s.SetSearchIndexer(indexer)
+	return func() []searchindex.Event {
+		t.Helper()
+		if err := indexer.Stop(context.Background()); err != nil {
+			t.Fatalf("stop indexer: %v", err)
+		}
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return sink.events
+	}
+}
+
+func TestPublishVideoIndexesVideo(t *testing.T) {
+	s := newTestVideoService(newFakeVideoRepository())
+	events := withIndexer(t, s)
+	publishAt := time.Now().Add(time.Hour)
+
+	video := &model.Video{UserID: 7, Title: "猫", Tags: "宠物, 日常,,", IsPublic: true}
+	if err := s.PublishVideo(context.Background(), video, &publishAt); err != nil {
+		t.Fatalf("PublishVideo: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 {
+		t.Fatalf("index events = %v, want 1", got)
+	}
+	event := got[0]
+	if event.Op != searchindex.OpUpsert || event.DocType != searchindex.DocTypeVideo || event.ID != "1" {
+		t.Errorf("event = %s %s %s, want upsert video 1", event.Op, event.DocType, event.ID)
+	}
+	if event.Fields["title"] != "猫" || event.Fields["status"] != model.VideoStatusScheduled || event.Fields["publish_at"] != publishAt.Unix() {
+		t.Errorf("fields = %v, want the scheduled title and publish time", event.Fields)
+	}
+	if tags := event.Fields["tags"]; !reflect.DeepEqual(tags, []string{"宠物", "日常"}) {
+		t.Errorf("tags = %v, want trimmed non-empty tags", tags)
+	}
+}
+
+func TestApplyAuditResultReindexesLatestStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		outcome AuditOutcome
+		want    string
+	}{
+		{"passed", AuditOutcomePassed, model.VideoStatusNormal},
+		{"rejected", AuditOutcomeRejected, model.VideoStatusBanned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestVideoService(newFakeVideoRepository(&model.Video{ID: 1, UserID: 7, Status: model.VideoStatusReviewing}))
+			events := withIndexer(t, s)
+
+			if err := s.ApplyAuditResult(context.Background(), 1, tt.outcome); err != nil {
+				t.Fatalf("ApplyAuditResult: %v", err)
+			}
+			got := events()
+			if len(got) != 1 || got[0].Op != searchindex.OpUpsert || got[0].Fields["status"] != tt.want {
+				t.Errorf("index events = %v, want upsert with status %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReindexMissingVideoDeletesDocument(t *testing.T) {
+	s := newTestVideoService(newFakeVideoRepository())
+	events := withIndexer(t, s)
+
+	if err := s.ApplyAuditResult(context.Background(), 42, AuditOutcomeRejected); err != nil {
+		t.Fatalf("ApplyAuditResult: %v", err)
+	}
+	got := events()
+	if len(got) != 1 || got[0].Op != searchindex.OpDelete || got[0].ID != "42" {
+		t.Errorf("index events = %v, want delete video 42", got)
+	}
+}
+
+func TestCancelScheduledPublishIndexing(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	repo := newFakeVideoRepository(&model.Video{ID: 1, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &future})
+	s := newTestVideoService(repo)
+	events := withIndexer(t, s)
+
+	// 非作者取消失败，不发布事件
+	if err := s.CancelScheduledPublish(context.Background(), 1, 8); err == nil {
+		t.Fatal("CancelScheduledPublish by another user succeeded")
+	}
+	if err := s.CancelScheduledPublish(context.Background(), 1, 7); err != nil {
+		t.Fatalf("CancelScheduledPublish: %v", err)
+	}
+
+	got := events()
+	if len(got) != 1 || got[0].Fields["status"] != model.VideoStatusDraft {
+		t.Fatalf("index events = %v, want a single draft upsert", got)
+	}
+	if _, ok := got[0].Fields["publish_at"]; ok {
+		t.Errorf("cancelled video still indexed with publish_at: %v", got[0].Fields)
+	}
+}
+
+func TestPublishDueVideosIndexesEachVideo(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	s := newTestVideoService(newFakeVideoRepository(
+		&model.Video{ID: 1, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &past, AuditPassed: true},
+		&model.Video{ID: 2, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &past},
+		&model.Video{ID: 3, UserID: 7, Status: model.VideoStatusScheduled, PublishAt: &future},
+	))
+	events := withIndexer(t, s)
+
+	if _, err := s.PublishDueVideos(context.Background()); err != nil {
+		t.Fatalf("PublishDueVideos: %v", err)
+	}
+
+	statuses := make(map[string]interface{})
+	for _, event := range events() {
+		statuses[event.ID] = event.Fields["status"]
+	}
+	want := map[string]interface{}{"1": model.VideoStatusNormal, "2": model.VideoStatusReviewing}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("indexed statuses = %v, want %v", statuses, want)
+	}
+}
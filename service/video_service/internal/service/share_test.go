@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vision_world/video_service/internal/model"
+)
+
+func publicVideo(id, authorID uint32) *model.Video {
+	return &model.Video{ID: id, UserID: authorID, IsPublic: true, Status: model.VideoStatusNormal}
+}
+
+func TestGenerateShareCodeUsesAlphabetAndIsUnique(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		code, err := generateShareCode()
+		if err != nil {
+			t.Fatalf("generateShareCode: %v", err)
+		}
+		if len(code) != shareCodeLength {
+			t.Fatalf("share code %q has length %d, want %d", code, len(code), shareCodeLength)
+		}
+		if strings.Trim(code, shareCodeAlphabet) != "" {
+			t.Fatalf("share code %q contains characters outside the alphabet", code)
+		}
+		if seen[code] {
+			t.Fatalf("share code %q generated twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestShareVideoAcceptsShareTypes(t *testing.T) {
+	tests := []struct {
+		shareType string
+		want      string
+	}{
+		{model.ShareTypeWechat, model.ShareTypeWechat},
+		{model.ShareTypeWeibo, model.ShareTypeWeibo},
+		{model.ShareTypeCopyLink, model.ShareTypeCopyLink},
+		{"link", model.ShareTypeCopyLink},
+		{"", model.ShareTypeCopyLink},
+	}
+	for _, tt := range tests {
+		repo := newFakeVideoRepository(publicVideo(1, 100))
+		share, err := newTestVideoService(repo).ShareVideo(context.Background(), 1, 200, tt.shareType)
+		if err != nil {
+			t.Fatalf("ShareVideo(%q): %v", tt.shareType, err)
+		}
+		if share.ShareType != tt.want {
+			t.Errorf("ShareVideo(%q) stored share type %q, want %q", tt.shareType, share.ShareType, tt.want)
+		}
+	}
+}
+
+func TestShareVideoRejectsUnknownShareType(t *testing.T) {
+	repo := newFakeVideoRepository(publicVideo(1, 100))
+	if _, err := newTestVideoService(repo).ShareVideo(context.Background(), 1, 200, "telegram"); !errors.Is(err, ErrInvalidShareType) {
+		t.Fatalf("ShareVideo(telegram) error = %v, want ErrInvalidShareType", err)
+	}
+	if len(repo.shares) != 0 {
+		t.Fatalf("rejected share type created %d share records", len(repo.shares))
+	}
+}
+
+func TestShareVideoRecordsSharerAndIncrementsShareCount(t *testing.T) {
+	repo := newFakeVideoRepository(publicVideo(1, 100))
+	svc := newTestVideoService(repo)
+
+	first, err := svc.ShareVideo(context.Background(), 1, 200, model.ShareTypeWechat)
+	if err != nil {
+		t.Fatalf("ShareVideo: %v", err)
+	}
+	second, err := svc.ShareVideo(context.Background(), 1, 300, "link")
+	if err != nil {
+		t.Fatalf("ShareVideo: %v", err)
+	}
+
+	if first.ShareCode == second.ShareCode {
+		t.Fatalf("two shares got the same code %q", first.ShareCode)
+	}
+	if first.VideoID != 1 || first.UserID != 200 {
+		t.Errorf("share recorded video %d sharer %d, want video 1 sharer 200", first.VideoID, first.UserID)
+	}
+	if want := "https://v.example.com/s/" + first.ShareCode; first.ShareURL != want {
+		t.Errorf("share URL = %q, want %q", first.ShareURL, want)
+	}
+	if video, _ := repo.GetVideoByID(context.Background(), 1); video.ShareCount != 2 {
+		t.Errorf("share count = %d, want 2", video.ShareCount)
+	}
+}
+
+func TestShareVideoHidesInvisibleVideos(t *testing.T) {
+	private := publicVideo(1, 100)
+	private.IsPublic = false
+	repo := newFakeVideoRepository(private)
+	svc := newTestVideoService(repo)
+
+	if _, err := svc.ShareVideo(context.Background(), 1, 200, ""); !errors.Is(err, ErrVideoNotFound) {
+		t.Fatalf("sharing another user's private video error = %v, want ErrVideoNotFound", err)
+	}
+	if _, err := svc.ShareVideo(context.Background(), 1, 100, ""); err != nil {
+		t.Fatalf("author sharing own private video: %v", err)
+	}
+}
+
+func TestResolveShareLinkTracksClicks(t *testing.T) {
+	repo := newFakeVideoRepository(publicVideo(1, 100))
+	svc := newTestVideoService(repo)
+	share, err := svc.ShareVideo(context.Background(), 1, 200, "")
+	if err != nil {
+		t.Fatalf("ShareVideo: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		video, err := svc.ResolveShareLink(context.Background(), share.ShareCode)
+		if err != nil {
+			t.Fatalf("ResolveShareLink: %v", err)
+		}
+		if video.ID != 1 {
+			t.Fatalf("ResolveShareLink returned video %d, want 1", video.ID)
+		}
+	}
+	if got := repo.shares[share.ShareCode].ClickCount; got != 3 {
+		t.Errorf("click count = %d, want 3", got)
+	}
+}
+
+func TestResolveShareLinkErrors(t *testing.T) {
+	repo := newFakeVideoRepository(publicVideo(1, 100))
+	svc := newTestVideoService(repo)
+	if _, err := svc.ResolveShareLink(context.Background(), "missing"); !errors.Is(err, ErrShareLinkNotFound) {
+		t.Fatalf("unknown share code error = %v, want ErrShareLinkNotFound", err)
+	}
+
+	share, err := svc.ShareVideo(context.Background(), 1, 200, "")
+	if err != nil {
+		t.Fatalf("ShareVideo: %v", err)
+	}
+	repo.videos[1].Status = model.VideoStatusDeleted
+	if _, err := svc.ResolveShareLink(context.Background(), share.ShareCode); !errors.Is(err, ErrVideoNotFound) {
+		t.Fatalf("share of deleted video error = %v, want ErrVideoNotFound", err)
+	}
+	// 视频已不可见时不记录点击
+	if got := repo.shares[share.ShareCode].ClickCount; got != 0 {
+		t.Errorf("click count = %d, want 0", got)
+	}
+}
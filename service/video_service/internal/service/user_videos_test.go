@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vision_world/video_service/internal/model"
+	"github.com/vision_world/video_service/pkg/paginate"
+)
+
+// newUserVideos 生成作者的n个公开视频，ID越大发布越晚
+func newUserVideos(authorID uint32, n int) []*model.Video {
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	videos := make([]*model.Video, 0, n)
+	for i := 1; i <= n; i++ {
+		video := publicVideo(uint32(i), authorID)
+		video.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		videos = append(videos, video)
+	}
+	return videos
+}
+
+func videoIDs(videos []*model.Video) []uint32 {
+	ids := make([]uint32, 0, len(videos))
+	for _, video := range videos {
+		ids = append(ids, video.ID)
+	}
+	return ids
+}
+
+func equalIDs(got, want []uint32) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGetUserVideosVisibilityDependsOnRequester(t *testing.T) {
+	videos := newUserVideos(100, 5)
+	videos[0].IsPublic = false                    // 1 私密
+	videos[1].Status = model.VideoStatusReviewing // 2 审核中
+	videos[2].Status = model.VideoStatusScheduled // 3 定时发布
+	videos[3].Status = model.VideoStatusDeleted   // 4 已删除
+	other := publicVideo(6, 200)
+	svc := newTestVideoService(newFakeVideoRepository(append(videos, other)...))
+
+	tests := []struct {
+		name        string
+		requesterID uint32
+		want        []uint32
+	}{
+		{"owner", 100, []uint32{5, 3, 2, 1}},
+		{"stranger", 200, []uint32{5}},
+		{"anonymous", 0, []uint32{5}},
+	}
+	for _, tt := range tests {
+		got, total, hasMore, _, err := svc.GetUserVideos(context.Background(), 100, tt.requesterID, 1, 10, "")
+		if err != nil {
+			t.Fatalf("%s: GetUserVideos: %v", tt.name, err)
+		}
+		if !equalIDs(videoIDs(got), tt.want) {
+			t.Errorf("%s: videos = %v, want %v", tt.name, videoIDs(got), tt.want)
+		}
+		if total != int64(len(tt.want)) || hasMore {
+			t.Errorf("%s: total=%d hasMore=%v, want total %d without more", tt.name, total, hasMore, len(tt.want))
+		}
+	}
+}
+
+func TestGetUserVideosPageBoundaries(t *testing.T) {
+	svc := newTestVideoService(newFakeVideoRepository(newUserVideos(100, 5)...))
+
+	tests := []struct {
+		page, pageSize uint32
+		want           []uint32
+		hasMore        bool
+	}{
+		{1, 2, []uint32{5, 4}, true},
+		{2, 2, []uint32{3, 2}, true},
+		{3, 2, []uint32{1}, false},
+		{4, 2, nil, false},
+		{1, 5, []uint32{5, 4, 3, 2, 1}, false}, // 恰好一页时没有下一页
+		{0, 0, []uint32{5, 4, 3, 2, 1}, false}, // 默认第一页、默认页大小
+	}
+	for _, tt := range tests {
+		got, total, hasMore, nextCursor, err := svc.GetUserVideos(context.Background(), 100, 100, tt.page, tt.pageSize, "")
+		if err != nil {
+			t.Fatalf("page %d size %d: %v", tt.page, tt.pageSize, err)
+		}
+		if !equalIDs(videoIDs(got), tt.want) || hasMore != tt.hasMore {
+			t.Errorf("page %d size %d = %v hasMore=%v, want %v hasMore=%v", tt.page, tt.pageSize, videoIDs(got), hasMore, tt.want, tt.hasMore)
+		}
+		if total != 5 {
+			t.Errorf("page %d size %d total = %d, want 5", tt.page, tt.pageSize, total)
+		}
+		if hasMore != (nextCursor != "") {
+			t.Errorf("page %d size %d next cursor %q does not match hasMore=%v", tt.page, tt.pageSize, nextCursor, hasMore)
+		}
+	}
+}
+
+func TestGetUserVideosClampsPageSize(t *testing.T) {
+	svc := newTestVideoService(newFakeVideoRepository(newUserVideos(100, maxPageSize+5)...))
+	got, _, hasMore, _, err := svc.GetUserVideos(context.Background(), 100, 100, 1, maxPageSize*2, "")
+	if err != nil {
+		t.Fatalf("GetUserVideos: %v", err)
+	}
+	if len(got) != maxPageSize || !hasMore {
+		t.Fatalf("got %d videos hasMore=%v, want %d with more", len(got), hasMore, maxPageSize)
+	}
+}
+
+func TestGetUserVideosCursorWalksAllPages(t *testing.T) {
+	svc := newTestVideoService(newFakeVideoRepository(newUserVideos(100, 5)...))
+
+	var all []uint32
+	cursor := ""
+	for i := 0; i < 5; i++ {
+		got, _, hasMore, next, err := svc.GetUserVideos(context.Background(), 100, 200, 1, 2, cursor)
+		if err != nil {
+			t.Fatalf("GetUserVideos: %v", err)
+		}
+		all = append(all, videoIDs(got)...)
+		if !hasMore {
+			break
+		}
+		cursor = next
+	}
+	if want := []uint32{5, 4, 3, 2, 1}; !equalIDs(all, want) {
+		t.Fatalf("cursor pages = %v, want %v", all, want)
+	}
+
+	if _, _, _, _, err := svc.GetUserVideos(context.Background(), 100, 200, 1, 2, "not-a-cursor"); !errors.Is(err, paginate.ErrInvalidCursor) {
+		t.Fatalf("invalid cursor error = %v, want ErrInvalidCursor", err)
+	}
+}
@@ -1,14 +1,73 @@
 package service
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/vision_world/video_service/internal/config"
+	"github.com/vision_world/video_service/internal/model"
 	"github.com/vision_world/video_service/internal/repository"
+	"github.com/vision_world/video_service/pkg/paginate"
+	"github.com/vision_world/video_service/pkg/searchindex"
+)
+
+// 分页参数
+const (
+	defaultPageSize = 10
+	maxPageSize     = 50
+)
+
+// 游标分页的排序方式
+const userVideosSort = "created_at_desc"
+
+// 分享码参数
+const (
+	shareCodeLength   = 8
+	shareCodeAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+var (
+	// ErrVideoNotFound 视频不存在或不可见
+	ErrVideoNotFound = errors.New("video not found")
+	// ErrInvalidShareType 分享类型不合法
+	ErrInvalidShareType = errors.New("invalid share type")
+	// ErrShareLinkNotFound 分享链接不存在
+	ErrShareLinkNotFound = errors.New("share link not found")
 )
 
+// videoRepository 业务层依赖的视频数据访问，由repository.VideoRepository实现
+type videoRepository interface {
+	Close() error
+	GetUserVideos(ctx context.Context, userID uint32, onlyVisible bool, after *paginate.Cursor, offset, limit int) ([]*model.Video, int64, error)
+	GetRecommendVideos(ctx context.Context, category string, offset, limit int) ([]*model.Video, int64, error)
+	GetVideoByID(ctx context.Context, videoID uint32) (*model.Video, error)
+	CreateVideo(ctx context.Context, video *model.Video) error
+	MarkVideoAuditPassed(ctx context.Context, videoID uint32) error
+	UpdateVideoStatus(ctx context.Context, videoID uint32, status string) error
+	PublishDueVideos(ctx context.Context, now time.Time) ([]*model.Video, error)
+	CancelScheduledVideo(ctx context.Context, videoID, userID uint32) (bool, error)
+	CreateVideoShare(ctx context.Context, share *model.VideoShare) error
+	GetVideoShareByCode(ctx context.Context, shareCode string) (*model.VideoShare, error)
+	IncrementShareClickCount(ctx context.Context, shareID uint32) error
+}
+
 // VideoService 视频服务业务逻辑层
 type VideoService struct {
 	config *config.Config
-	repo   *repository.VideoRepository
+	repo   videoRepository
+
+	indexer *searchindex.Publisher
+	ranker  VideoRanker
+
+	schedulerOnce   sync.Once
+	schedulerCancel context.CancelFunc
+	schedulerDone   chan struct{}
 }
 
 // NewVideoService 创建视频服务
@@ -21,30 +80,152 @@ func NewVideoService(cfg *config.Config) (*VideoService, error) {
 	return &VideoService{
 		config: cfg,
 		repo:   repo,
+		ranker: PopularityRanker{},
 	}, nil
 }
 
 // Close 关闭服务
 func (s *VideoService) Close() error {
+	s.stopPublishScheduler()
 	if s.repo != nil {
 		return s.repo.Close()
 	}
 	return nil
 }
 
+// GetUserVideos 获取用户发布的视频列表
+// requesterID 为当前请求用户，0表示未登录；非作者本人只能看到公开且审核通过的视频
+// cursor为上一页返回的游标，不为空时忽略page；游标无效时返回paginate.ErrInvalidCursor
+// 返回当前页视频、总数、是否还有下一页以及下一页游标
+func (s *VideoService) GetUserVideos(ctx context.Context, userID, requesterID uint32, page, pageSize uint32, cursor string) ([]*model.Video, int64, bool, string, error) {
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	after, err := paginate.Decode(cursor, userVideosSort)
+	if err != nil {
+		return nil, 0, false, "", err
+	}
+
+	// 多取一条判断是否还有下一页
+	offset := int((page - 1) * pageSize)
+	onlyVisible := requesterID != userID
+	videos, total, err := s.repo.GetUserVideos(ctx, userID, onlyVisible, after, offset, int(pageSize)+1)
+	if err != nil {
+		return nil, 0, false, "", err
+	}
+
+	hasMore := len(videos) > int(pageSize)
+	if !hasMore {
+		return videos, total, false, "", nil
+	}
+	videos = videos[:pageSize]
+	last := videos[len(videos)-1]
+	nextCursor := paginate.Encode(paginate.Cursor{Sort: userVideosSort, Value: last.CreatedAt.UnixNano(), ID: uint64(last.ID)})
+	return videos, total, true, nextCursor, nil
+}
+
+// ShareVideo 生成视频分享链接
+// 每次分享生成唯一分享码并记录分享者，同时增加视频分享数；share_type为空或link时按复制链接处理
+func (s *VideoService) ShareVideo(ctx context.Context, videoID, userID uint32, shareType string) (*model.VideoShare, error) {
+	shareType = model.NormalizeShareType(shareType)
+	if !model.IsValidShareType(shareType) {
+		return nil, ErrInvalidShareType
+	}
+
+	video, err := s.repo.GetVideoByID(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if !isVideoVisible(video, userID) {
+		return nil, ErrVideoNotFound
+	}
+
+	shareCode, err := generateShareCode()
+	if err != nil {
+		return nil, err
+	}
+
+	share := &model.VideoShare{
+		VideoID:   videoID,
+		UserID:    userID,
+		ShareType: shareType,
+		ShareCode: shareCode,
+		ShareURL:  fmt.Sprintf("%s/%s", strings.TrimRight(s.config.Share.BaseURL, "/"), shareCode),
+	}
+	if err := s.repo.CreateVideoShare(ctx, share); err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// ResolveShareLink 解析分享码，记录一次点击并返回对应视频
+func (s *VideoService) ResolveShareLink(ctx context.Context, shareCode string) (*model.Video, error) {
+	share, err := s.repo.GetVideoShareByCode(ctx, shareCode)
+	if err != nil {
+		return nil, err
+	}
+	if share == nil {
+		return nil, ErrShareLinkNotFound
+	}
+
+	video, err := s.repo.GetVideoByID(ctx, share.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	// 点击者身份未知，按非作者处理
+	if !isVideoVisible(video, 0) {
+		return nil, ErrVideoNotFound
+	}
+
+	if err := s.repo.IncrementShareClickCount(ctx, share.ID); err != nil {
+		return nil, err
+	}
+
+	return video, nil
+}
+
+// isVideoVisible 判断视频对请求用户是否可见，作者本人可见自己未删除的视频
+func isVideoVisible(video *model.Video, requesterID uint32) bool {
+	if video == nil || video.Status == model.VideoStatusDeleted {
+		return false
+	}
+	if requesterID != 0 && video.UserID == requesterID {
+		return true
+	}
+	return video.IsPublic && video.Status == model.VideoStatusNormal
+}
+
+// generateShareCode 生成随机分享码
+func generateShareCode() (string, error) {
+	max := big.NewInt(int64(len(shareCodeAlphabet)))
+	code := make([]byte, shareCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate share code: %w", err)
+		}
+		code[i] = shareCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
 // TODO: 实现具体的业务逻辑方法
 // 这些方法将被handler层调用，具体实现由你后续完成
 // 例如：
-// - PublishVideo()
 // - DeleteVideo()
 // - GetVideoInfo()
 // - GetVideoInfos()
-// - GetUserVideos()
-// - GetRecommendVideos()
 // - GetFollowVideos()
 // - LikeVideo()
 // - GetUserLikedVideos()
-// - ShareVideo()
 // - CommentVideo()
 // - DeleteComment()
 // - GetVideoComments()
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/vision_world/video_service/internal/config"
+)
+
+// NewRedisClient 创建Redis客户端并检查连通性，按mode配置使用单节点、哨兵或集群模式
+func NewRedisClient(cfg *config.RedisConfig) (redis.UniversalClient, error) {
+	client, err := newRedisClient(*cfg, &redis.Options{
+		Password: cfg.Password,
+		DB:       cfg.DB,
+		PoolSize: cfg.PoolSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,30 @@
+package featureflags
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// DefaultRedisKey 覆盖值默认存放的hash键，各服务共用，开关名按服务加前缀区分
+const DefaultRedisKey = "feature:flags"
+
+// redisSource 从Redis hash读取覆盖值，字段为开关名，值为开关原始值
+// 运维通过 HSET feature:flags <name> <value> 调整开关，下一个刷新周期内生效
+type redisSource struct {
+	client redis.UniversalClient
+	key    string
+}
+
+// NewRedisSource 创建Redis数据源，key为空时使用默认键
+func NewRedisSource(client redis.UniversalClient, key string) Source {
+	if key == "" {
+		key = DefaultRedisKey
+	}
+	return &redisSource{client: client, key: key}
+}
+
+// Load 读取全部覆盖值
+func (s *redisSource) Load(ctx context.Context) (map[string]string, error) {
+	return s.client.HGetAll(ctx, s.key).Result()
+}
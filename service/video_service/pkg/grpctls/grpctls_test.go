@@ -0,0 +1,257 @@
+package grpctls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// testCA 测试用CA，签发的证书写入临时目录
+type testCA struct {
+	dir    string
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	caFile string
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	ca := &testCA{dir: t.TempDir()}
+	ca.cert, ca.key = ca.issue(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+	ca.caFile = ca.writePEM(t, name+"-ca.pem", "CERTIFICATE", ca.cert.Raw)
+	return ca
+}
+
+// issue 签发证书，parent为nil时自签名
+func (ca *testCA) issue(t *testing.T, tmpl, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert, key
+}
+
+// issueFiles 签发证书并写入PEM文件，返回证书和私钥路径
+func (ca *testCA) issueFiles(t *testing.T, name string, usage x509.ExtKeyUsage) (string, string) {
+	t.Helper()
+	cert, key := ca.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		DNSNames:    []string{name},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{usage},
+	}, ca.cert, ca.key)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	return ca.writePEM(t, name+".pem", "CERTIFICATE", cert.Raw), ca.writePEM(t, name+"-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func (ca *testCA) writePEM(t *testing.T, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(ca.dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+// handshake 通过本地TCP连接完成一次TLS握手，返回服务端和客户端的握手错误
+// 与grpc.Dial一致，客户端配置了ServerName时以其作为authority，否则使用拨号地址的主机名
+func handshake(t *testing.T, server, client credentials.TransportCredentials) (serverErr, clientErr error) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer lis.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer conn.Close()
+		_, _, err = server.ServerHandshake(conn)
+		done <- err
+	}()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	authority := "127.0.0.1"
+	if serverName := client.Info().ServerName; serverName != "" {
+		authority = serverName
+	}
+	_, _, clientErr = client.ClientHandshake(ctx, authority, conn)
+	if clientErr != nil {
+		conn.Close()
+	}
+	return <-done, clientErr
+}
+
+func TestCredentialsDisabled(t *testing.T) {
+	// 未开启时忽略其他参数，使用明文连接
+	opts := Options{CertFile: "missing.pem", KeyFile: "missing.pem", ClientAuth: true}
+	server, err := ServerCredentials(opts)
+	if err != nil {
+		t.Fatalf("ServerCredentials: %v", err)
+	}
+	client, err := ClientCredentials(opts)
+	if err != nil {
+		t.Fatalf("ClientCredentials: %v", err)
+	}
+	for name, creds := range map[string]credentials.TransportCredentials{"server": server, "client": client} {
+		if got := creds.Info().SecurityProtocol; got != "insecure" {
+			t.Errorf("%s protocol = %s, want insecure", name, got)
+		}
+	}
+}
+
+func TestCredentialsValidation(t *testing.T) {
+	ca := newTestCA(t, "test")
+	certFile, keyFile := ca.issueFiles(t, "grpc-server", x509.ExtKeyUsageServerAuth)
+	notPEM := filepath.Join(ca.dir, "invalid.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		build   func(Options) (credentials.TransportCredentials, error)
+		opts    Options
+		wantErr error
+	}{
+		{"server without certificate", ServerCredentials, Options{Enabled: true}, ErrMissingCertificate},
+		{"server without key", ServerCredentials, Options{Enabled: true, CertFile: certFile}, ErrMissingCertificate},
+		{"server client auth without ca", ServerCredentials, Options{Enabled: true, CertFile: certFile, KeyFile: keyFile, ClientAuth: true}, ErrMissingClientCA},
+		{"server missing certificate file", ServerCredentials, Options{Enabled: true, CertFile: "missing.pem", KeyFile: keyFile}, nil},
+		{"server invalid client ca", ServerCredentials, Options{Enabled: true, CertFile: certFile, KeyFile: keyFile, ClientAuth: true, CAFile: notPEM}, nil},
+		{"client missing ca file", ClientCredentials, Options{Enabled: true, CAFile: "missing.pem"}, nil},
+		{"client invalid ca", ClientCredentials, Options{Enabled: true, CAFile: notPEM}, nil},
+		{"client certificate without key", ClientCredentials, Options{Enabled: true, CertFile: certFile}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := tt.build(tt.opts)
+			if err == nil {
+				t.Fatalf("credentials = %v, want an error", creds.Info())
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTLSHandshake(t *testing.T) {
+	ca := newTestCA(t, "test")
+	certFile, keyFile := ca.issueFiles(t, "grpc-server", x509.ExtKeyUsageServerAuth)
+	server, err := ServerCredentials(Options{Enabled: true, CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatalf("ServerCredentials: %v", err)
+	}
+	if got := server.Info().SecurityProtocol; got != "tls" {
+		t.Errorf("server protocol = %s, want tls", got)
+	}
+
+	tests := []struct {
+		name   string
+		opts   Options
+		wantOK bool
+	}{
+		{"trusted ca", Options{Enabled: true, CAFile: ca.caFile, ServerName: "grpc-server"}, true},
+		// 证书不包含拨号地址，需配置ServerName
+		{"dial address", Options{Enabled: true, CAFile: ca.caFile}, false},
+		{"server name mismatch", Options{Enabled: true, CAFile: ca.caFile, ServerName: "other-server"}, false},
+		// 自签CA不在系统根证书中
+		{"system roots", Options{Enabled: true, ServerName: "grpc-server"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := ClientCredentials(tt.opts)
+			if err != nil {
+				t.Fatalf("ClientCredentials: %v", err)
+			}
+			_, clientErr := handshake(t, server, client)
+			if (clientErr == nil) != tt.wantOK {
+				t.Errorf("client handshake error = %v, want success %v", clientErr, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMutualTLSHandshake(t *testing.T) {
+	ca := newTestCA(t, "test")
+	serverCert, serverKey := ca.issueFiles(t, "grpc-server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issueFiles(t, "grpc-client", x509.ExtKeyUsageClientAuth)
+	other := newTestCA(t, "other")
+	otherCert, otherKey := other.issueFiles(t, "intruder", x509.ExtKeyUsageClientAuth)
+
+	server, err := ServerCredentials(Options{Enabled: true, CertFile: serverCert, KeyFile: serverKey, ClientAuth: true, CAFile: ca.caFile})
+	if err != nil {
+		t.Fatalf("ServerCredentials: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		opts   Options
+		wantOK bool
+	}{
+		{"client certificate", Options{Enabled: true, CAFile: ca.caFile, ServerName: "grpc-server", CertFile: clientCert, KeyFile: clientKey}, true},
+		{"no client certificate", Options{Enabled: true, CAFile: ca.caFile, ServerName: "grpc-server"}, false},
+		{"untrusted client certificate", Options{Enabled: true, CAFile: ca.caFile, ServerName: "grpc-server", CertFile: otherCert, KeyFile: otherKey}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := ClientCredentials(tt.opts)
+			if err != nil {
+				t.Fatalf("ClientCredentials: %v", err)
+			}
+			// TLS 1.3下客户端可能先完成握手，以服务端校验结果为准
+			serverErr, _ := handshake(t, server, client)
+			if (serverErr == nil) != tt.wantOK {
+				t.Errorf("server handshake error = %v, want success %v", serverErr, tt.wantOK)
+			}
+		})
+	}
+}
@@ -120,3 +120,24 @@ func Sync() error {
 	}
 	return nil
 }
+
+// KeyValue 以"消息, 键, 值..."形式记录日志，供common中只接受键值对参数的公共组件使用
+type KeyValue struct{}
+
+func (KeyValue) Info(msg string, keysAndValues ...interface{}) {
+	if logger != nil {
+		logger.Sugar().Infow(msg, keysAndValues...)
+	}
+}
+
+func (KeyValue) Warn(msg string, keysAndValues ...interface{}) {
+	if logger != nil {
+		logger.Sugar().Warnw(msg, keysAndValues...)
+	}
+}
+
+func (KeyValue) Error(msg string, keysAndValues ...interface{}) {
+	if logger != nil {
+		logger.Sugar().Errorw(msg, keysAndValues...)
+	}
+}
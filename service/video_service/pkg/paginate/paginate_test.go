@@ -0,0 +1,64 @@
+package paginate
+
+import (
+	"encoding/base64"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursors := []Cursor{
+		{Sort: "created_at", Value: 1714566600123456789, ID: 42},
+		{Sort: "id", ID: 1},
+		{Sort: "score", Value: -5, ID: math.MaxUint64},
+	}
+	for _, c := range cursors {
+		token := Encode(c)
+		// 令牌可直接放在URL中
+		if strings.ContainsAny(token, "+/=") {
+			t.Errorf("token %q is not URL safe", token)
+		}
+		got, err := Decode(token, c.Sort)
+		if err != nil {
+			t.Fatalf("Decode(Encode(%+v)): %v", c, err)
+		}
+		if *got != c {
+			t.Errorf("round trip = %+v, want %+v", *got, c)
+		}
+	}
+}
+
+func TestDecodeEmptyTokenStartsFromFirstPage(t *testing.T) {
+	got, err := Decode("", "created_at")
+	if got != nil || err != nil {
+		t.Errorf("Decode(\"\") = (%v, %v), want (nil, nil)", got, err)
+	}
+}
+
+func TestDecodeRejectsTamperedCursor(t *testing.T) {
+	valid := Encode(Cursor{Sort: "created_at", Value: 100, ID: 42})
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "!!not-a-cursor!!"},
+		{"standard base64 padding", base64.StdEncoding.EncodeToString([]byte(`{"s":"created_at","id":42}`)) + "=="},
+		{"truncated", valid[:len(valid)-3]},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("created_at:42"))},
+		{"wrong field type", base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created_at","id":"42"}`))},
+		{"negative id", base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created_at","id":-1}`))},
+		{"missing id", base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created_at","v":100}`))},
+		{"other list", Encode(Cursor{Sort: "score", Value: 100, ID: 42})},
+		{"missing sort", Encode(Cursor{Value: 100, ID: 42})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.token, "created_at")
+			if !errors.Is(err, ErrInvalidCursor) || got != nil {
+				t.Errorf("Decode(%q) = (%v, %v), want ErrInvalidCursor", tt.token, got, err)
+			}
+		})
+	}
+}
@@ -144,15 +144,16 @@ type PublishVideoRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token       string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                              // 用户token
-	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                              // 视频标题
-	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                  // 视频描述
-	CoverUrl    string   `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`        // 视频封面URL
-	VideoUrl    string   `protobuf:"bytes,5,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`        // 视频文件URL
-	Tags        []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                // 视频标签
-	Location    *string  `protobuf:"bytes,7,opt,name=location,proto3,oneof" json:"location,omitempty"`                  // 拍摄地点
-	MusicId     *string  `protobuf:"bytes,8,opt,name=music_id,json=musicId,proto3,oneof" json:"music_id,omitempty"`     // 背景音乐ID
-	IsPublic    *bool    `protobuf:"varint,9,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"` // 是否公开，默认true
+	Token       string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                  // 用户token
+	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                  // 视频标题
+	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                      // 视频描述
+	CoverUrl    string   `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`            // 视频封面URL
+	VideoUrl    string   `protobuf:"bytes,5,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`            // 视频文件URL
+	Tags        []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                    // 视频标签
+	Location    *string  `protobuf:"bytes,7,opt,name=location,proto3,oneof" json:"location,omitempty"`                      // 拍摄地点
+	MusicId     *string  `protobuf:"bytes,8,opt,name=music_id,json=musicId,proto3,oneof" json:"music_id,omitempty"`         // 背景音乐ID
+	IsPublic    *bool    `protobuf:"varint,9,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`     // 是否公开，默认true
+	PublishAt   *int64   `protobuf:"varint,10,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"` // 定时发布时间戳(秒)，为空或不晚于当前时间时立即发布
 }
 
 func (x *PublishVideoRequest) Reset() {
@@ -250,6 +251,13 @@ func (x *PublishVideoRequest) GetIsPublic() bool {
 	return false
 }
 
+func (x *PublishVideoRequest) GetPublishAt() int64 {
+	if x != nil && x.PublishAt != nil {
+		return *x.PublishAt
+	}
+	return 0
+}
+
 type PublishVideoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -258,6 +266,7 @@ type PublishVideoResponse struct {
 	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
 	StatusMsg  string `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
 	VideoId    uint32 `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`          // 发布的视频ID
+	PublishAt  int64  `protobuf:"varint,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`    // 定时发布时间戳(秒)，立即发布时为0
 }
 
 func (x *PublishVideoResponse) Reset() {
@@ -313,6 +322,124 @@ func (x *PublishVideoResponse) GetVideoId() uint32 {
 	return 0
 }
 
+func (x *PublishVideoResponse) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+// 取消定时发布请求
+type CancelScheduledPublishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                     // 用户token
+	VideoId uint32 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // 定时发布的视频ID
+}
+
+func (x *CancelScheduledPublishRequest) Reset() {
+	*x = CancelScheduledPublishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_idl_video_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPublishRequest) ProtoMessage() {}
+
+func (x *CancelScheduledPublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_video_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPublishRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPublishRequest) Descriptor() ([]byte, []int) {
+	return file_idl_video_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelScheduledPublishRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CancelScheduledPublishRequest) GetVideoId() uint32 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+type CancelScheduledPublishResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	StatusMsg  string `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
+}
+
+func (x *CancelScheduledPublishResponse) Reset() {
+	*x = CancelScheduledPublishResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_idl_video_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPublishResponse) ProtoMessage() {}
+
+func (x *CancelScheduledPublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_video_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPublishResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPublishResponse) Descriptor() ([]byte, []int) {
+	return file_idl_video_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelScheduledPublishResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CancelScheduledPublishResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
 // 删除视频请求
 type DeleteVideoRequest struct {
 	state         protoimpl.MessageState
@@ -326,7 +453,7 @@ type DeleteVideoRequest struct {
 func (x *DeleteVideoRequest) Reset() {
 	*x = DeleteVideoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[4]
+		mi := &file_idl_video_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +466,7 @@ func (x *DeleteVideoRequest) String() string {
 func (*DeleteVideoRequest) ProtoMessage() {}
 
 func (x *DeleteVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[4]
+	mi := &file_idl_video_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +479,7 @@ func (x *DeleteVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVideoRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVideoRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{4}
+	return file_idl_video_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteVideoRequest) GetToken() string {
@@ -381,7 +508,7 @@ type DeleteVideoResponse struct {
 func (x *DeleteVideoResponse) Reset() {
 	*x = DeleteVideoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[5]
+		mi := &file_idl_video_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +521,7 @@ func (x *DeleteVideoResponse) String() string {
 func (*DeleteVideoResponse) ProtoMessage() {}
 
 func (x *DeleteVideoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[5]
+	mi := &file_idl_video_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +534,7 @@ func (x *DeleteVideoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVideoResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVideoResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{5}
+	return file_idl_video_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteVideoResponse) GetStatusCode() int32 {
@@ -437,7 +564,7 @@ type GetVideoInfoRequest struct {
 func (x *GetVideoInfoRequest) Reset() {
 	*x = GetVideoInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[6]
+		mi := &file_idl_video_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +577,7 @@ func (x *GetVideoInfoRequest) String() string {
 func (*GetVideoInfoRequest) ProtoMessage() {}
 
 func (x *GetVideoInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[6]
+	mi := &file_idl_video_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +590,7 @@ func (x *GetVideoInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoInfoRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{6}
+	return file_idl_video_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetVideoInfoRequest) GetVideoId() uint32 {
@@ -493,7 +620,7 @@ type GetVideoInfosRequest struct {
 func (x *GetVideoInfosRequest) Reset() {
 	*x = GetVideoInfosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[7]
+		mi := &file_idl_video_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +633,7 @@ func (x *GetVideoInfosRequest) String() string {
 func (*GetVideoInfosRequest) ProtoMessage() {}
 
 func (x *GetVideoInfosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[7]
+	mi := &file_idl_video_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +646,7 @@ func (x *GetVideoInfosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoInfosRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoInfosRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{7}
+	return file_idl_video_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetVideoInfosRequest) GetVideoIds() []uint32 {
@@ -549,7 +676,7 @@ type GetVideoInfosResponse struct {
 func (x *GetVideoInfosResponse) Reset() {
 	*x = GetVideoInfosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[8]
+		mi := &file_idl_video_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -562,7 +689,7 @@ func (x *GetVideoInfosResponse) String() string {
 func (*GetVideoInfosResponse) ProtoMessage() {}
 
 func (x *GetVideoInfosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[8]
+	mi := &file_idl_video_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +702,7 @@ func (x *GetVideoInfosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoInfosResponse.ProtoReflect.Descriptor instead.
 func (*GetVideoInfosResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{8}
+	return file_idl_video_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetVideoInfosResponse) GetStatusCode() int32 {
@@ -609,12 +736,13 @@ type GetUserVideosRequest struct {
 	Token    string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                        // 用户token (可选)
 	Page     uint32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
 	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，默认10，最大50
+	Cursor   string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // 分页游标，传上一页返回的next_cursor，不为空时忽略page
 }
 
 func (x *GetUserVideosRequest) Reset() {
 	*x = GetUserVideosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[9]
+		mi := &file_idl_video_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -627,7 +755,7 @@ func (x *GetUserVideosRequest) String() string {
 func (*GetUserVideosRequest) ProtoMessage() {}
 
 func (x *GetUserVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[9]
+	mi := &file_idl_video_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +768,7 @@ func (x *GetUserVideosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserVideosRequest.ProtoReflect.Descriptor instead.
 func (*GetUserVideosRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{9}
+	return file_idl_video_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserVideosRequest) GetUserId() uint32 {
@@ -671,6 +799,13 @@ func (x *GetUserVideosRequest) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *GetUserVideosRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type GetUserVideosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -681,12 +816,13 @@ type GetUserVideosResponse struct {
 	Videos     []*Video `protobuf:"bytes,3,rep,name=videos,proto3" json:"videos,omitempty"`                            // 视频列表
 	Total      uint32   `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                             // 总视频数量
 	HasMore    bool     `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // 是否有更多
+	NextCursor string   `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`  // 下一页游标，没有更多时为空
 }
 
 func (x *GetUserVideosResponse) Reset() {
 	*x = GetUserVideosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[10]
+		mi := &file_idl_video_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -699,7 +835,7 @@ func (x *GetUserVideosResponse) String() string {
 func (*GetUserVideosResponse) ProtoMessage() {}
 
 func (x *GetUserVideosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[10]
+	mi := &file_idl_video_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +848,7 @@ func (x *GetUserVideosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserVideosResponse.ProtoReflect.Descriptor instead.
 func (*GetUserVideosResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{10}
+	return file_idl_video_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUserVideosResponse) GetStatusCode() int32 {
@@ -750,6 +886,13 @@ func (x *GetUserVideosResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *GetUserVideosResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // 获取推荐视频列表请求
 type GetRecommendVideosRequest struct {
 	state         protoimpl.MessageState
@@ -765,7 +908,7 @@ type GetRecommendVideosRequest struct {
 func (x *GetRecommendVideosRequest) Reset() {
 	*x = GetRecommendVideosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[11]
+		mi := &file_idl_video_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -778,7 +921,7 @@ func (x *GetRecommendVideosRequest) String() string {
 func (*GetRecommendVideosRequest) ProtoMessage() {}
 
 func (x *GetRecommendVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[11]
+	mi := &file_idl_video_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +934,7 @@ func (x *GetRecommendVideosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecommendVideosRequest.ProtoReflect.Descriptor instead.
 func (*GetRecommendVideosRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{11}
+	return file_idl_video_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetRecommendVideosRequest) GetToken() string {
@@ -836,7 +979,7 @@ type GetRecommendVideosResponse struct {
 func (x *GetRecommendVideosResponse) Reset() {
 	*x = GetRecommendVideosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[12]
+		mi := &file_idl_video_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +992,7 @@ func (x *GetRecommendVideosResponse) String() string {
 func (*GetRecommendVideosResponse) ProtoMessage() {}
 
 func (x *GetRecommendVideosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[12]
+	mi := &file_idl_video_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +1005,7 @@ func (x *GetRecommendVideosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecommendVideosResponse.ProtoReflect.Descriptor instead.
 func (*GetRecommendVideosResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{12}
+	return file_idl_video_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetRecommendVideosResponse) GetStatusCode() int32 {
@@ -907,7 +1050,7 @@ type GetFollowVideosRequest struct {
 func (x *GetFollowVideosRequest) Reset() {
 	*x = GetFollowVideosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[13]
+		mi := &file_idl_video_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1063,7 @@ func (x *GetFollowVideosRequest) String() string {
 func (*GetFollowVideosRequest) ProtoMessage() {}
 
 func (x *GetFollowVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[13]
+	mi := &file_idl_video_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1076,7 @@ func (x *GetFollowVideosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowVideosRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowVideosRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{13}
+	return file_idl_video_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetFollowVideosRequest) GetToken() string {
@@ -971,7 +1114,7 @@ type GetFollowVideosResponse struct {
 func (x *GetFollowVideosResponse) Reset() {
 	*x = GetFollowVideosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[14]
+		mi := &file_idl_video_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -984,7 +1127,7 @@ func (x *GetFollowVideosResponse) String() string {
 func (*GetFollowVideosResponse) ProtoMessage() {}
 
 func (x *GetFollowVideosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[14]
+	mi := &file_idl_video_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,7 +1140,7 @@ func (x *GetFollowVideosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowVideosResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowVideosResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{14}
+	return file_idl_video_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFollowVideosResponse) GetStatusCode() int32 {
@@ -1042,7 +1185,7 @@ type LikeVideoRequest struct {
 func (x *LikeVideoRequest) Reset() {
 	*x = LikeVideoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[15]
+		mi := &file_idl_video_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1055,7 +1198,7 @@ func (x *LikeVideoRequest) String() string {
 func (*LikeVideoRequest) ProtoMessage() {}
 
 func (x *LikeVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[15]
+	mi := &file_idl_video_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1211,7 @@ func (x *LikeVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeVideoRequest.ProtoReflect.Descriptor instead.
 func (*LikeVideoRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{15}
+	return file_idl_video_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *LikeVideoRequest) GetToken() string {
@@ -1105,7 +1248,7 @@ type LikeVideoResponse struct {
 func (x *LikeVideoResponse) Reset() {
 	*x = LikeVideoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[16]
+		mi := &file_idl_video_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1261,7 @@ func (x *LikeVideoResponse) String() string {
 func (*LikeVideoResponse) ProtoMessage() {}
 
 func (x *LikeVideoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[16]
+	mi := &file_idl_video_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1274,7 @@ func (x *LikeVideoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeVideoResponse.ProtoReflect.Descriptor instead.
 func (*LikeVideoResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{16}
+	return file_idl_video_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LikeVideoResponse) GetStatusCode() int32 {
@@ -1170,7 +1313,7 @@ type GetUserLikedVideosRequest struct {
 func (x *GetUserLikedVideosRequest) Reset() {
 	*x = GetUserLikedVideosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[17]
+		mi := &file_idl_video_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1183,7 +1326,7 @@ func (x *GetUserLikedVideosRequest) String() string {
 func (*GetUserLikedVideosRequest) ProtoMessage() {}
 
 func (x *GetUserLikedVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[17]
+	mi := &file_idl_video_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,7 +1339,7 @@ func (x *GetUserLikedVideosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLikedVideosRequest.ProtoReflect.Descriptor instead.
 func (*GetUserLikedVideosRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{17}
+	return file_idl_video_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetUserLikedVideosRequest) GetUserId() uint32 {
@@ -1242,7 +1385,7 @@ type GetUserLikedVideosResponse struct {
 func (x *GetUserLikedVideosResponse) Reset() {
 	*x = GetUserLikedVideosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[18]
+		mi := &file_idl_video_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1255,7 +1398,7 @@ func (x *GetUserLikedVideosResponse) String() string {
 func (*GetUserLikedVideosResponse) ProtoMessage() {}
 
 func (x *GetUserLikedVideosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[18]
+	mi := &file_idl_video_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +1411,7 @@ func (x *GetUserLikedVideosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLikedVideosResponse.ProtoReflect.Descriptor instead.
 func (*GetUserLikedVideosResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{18}
+	return file_idl_video_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetUserLikedVideosResponse) GetStatusCode() int32 {
@@ -1314,13 +1457,13 @@ type ShareVideoRequest struct {
 
 	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                          // 用户token
 	VideoId   uint32 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`      // 视频ID
-	ShareType string `protobuf:"bytes,3,opt,name=share_type,json=shareType,proto3" json:"share_type,omitempty"` // 分享类型: wechat, wechat_moments, qq, weibo, copy_link
+	ShareType string `protobuf:"bytes,3,opt,name=share_type,json=shareType,proto3" json:"share_type,omitempty"` // 分享类型: wechat, wechat_moments, qq, weibo, copy_link(link为别名)
 }
 
 func (x *ShareVideoRequest) Reset() {
 	*x = ShareVideoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[19]
+		mi := &file_idl_video_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +1476,7 @@ func (x *ShareVideoRequest) String() string {
 func (*ShareVideoRequest) ProtoMessage() {}
 
 func (x *ShareVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[19]
+	mi := &file_idl_video_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1489,7 @@ func (x *ShareVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShareVideoRequest.ProtoReflect.Descriptor instead.
 func (*ShareVideoRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{19}
+	return file_idl_video_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ShareVideoRequest) GetToken() string {
@@ -1383,7 +1526,7 @@ type ShareVideoResponse struct {
 func (x *ShareVideoResponse) Reset() {
 	*x = ShareVideoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[20]
+		mi := &file_idl_video_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1396,7 +1539,7 @@ func (x *ShareVideoResponse) String() string {
 func (*ShareVideoResponse) ProtoMessage() {}
 
 func (x *ShareVideoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[20]
+	mi := &file_idl_video_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +1552,7 @@ func (x *ShareVideoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShareVideoResponse.ProtoReflect.Descriptor instead.
 func (*ShareVideoResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{20}
+	return file_idl_video_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ShareVideoResponse) GetStatusCode() int32 {
@@ -1433,6 +1576,125 @@ func (x *ShareVideoResponse) GetShareUrl() string {
 	return ""
 }
 
+// 解析分享链接请求，由短链接跳转入口调用
+type ResolveShareLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareCode string `protobuf:"bytes,1,opt,name=share_code,json=shareCode,proto3" json:"share_code,omitempty"` // 分享码
+}
+
+func (x *ResolveShareLinkRequest) Reset() {
+	*x = ResolveShareLinkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_idl_video_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareLinkRequest) ProtoMessage() {}
+
+func (x *ResolveShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_video_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_idl_video_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResolveShareLinkRequest) GetShareCode() string {
+	if x != nil {
+		return x.ShareCode
+	}
+	return ""
+}
+
+type ResolveShareLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	StatusMsg  string `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`     // 返回状态描述
+	VideoId    uint32 `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`          // 视频ID
+	VideoUrl   string `protobuf:"bytes,4,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`        // 跳转的视频播放URL
+}
+
+func (x *ResolveShareLinkResponse) Reset() {
+	*x = ResolveShareLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_idl_video_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareLinkResponse) ProtoMessage() {}
+
+func (x *ResolveShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_video_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_idl_video_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResolveShareLinkResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ResolveShareLinkResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ResolveShareLinkResponse) GetVideoId() uint32 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ResolveShareLinkResponse) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
 // 发表评论请求
 type CommentRequest struct {
 	state         protoimpl.MessageState
@@ -1448,7 +1710,7 @@ type CommentRequest struct {
 func (x *CommentRequest) Reset() {
 	*x = CommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[21]
+		mi := &file_idl_video_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1461,7 +1723,7 @@ func (x *CommentRequest) String() string {
 func (*CommentRequest) ProtoMessage() {}
 
 func (x *CommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[21]
+	mi := &file_idl_video_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +1736,7 @@ func (x *CommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentRequest.ProtoReflect.Descriptor instead.
 func (*CommentRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{21}
+	return file_idl_video_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CommentRequest) GetToken() string {
@@ -1518,7 +1780,7 @@ type CommentResponse struct {
 func (x *CommentResponse) Reset() {
 	*x = CommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[22]
+		mi := &file_idl_video_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1531,7 +1793,7 @@ func (x *CommentResponse) String() string {
 func (*CommentResponse) ProtoMessage() {}
 
 func (x *CommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[22]
+	mi := &file_idl_video_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,7 +1806,7 @@ func (x *CommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
 func (*CommentResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{22}
+	return file_idl_video_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CommentResponse) GetStatusCode() int32 {
@@ -1581,7 +1843,7 @@ type DeleteCommentRequest struct {
 func (x *DeleteCommentRequest) Reset() {
 	*x = DeleteCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[23]
+		mi := &file_idl_video_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1594,7 +1856,7 @@ func (x *DeleteCommentRequest) String() string {
 func (*DeleteCommentRequest) ProtoMessage() {}
 
 func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[23]
+	mi := &file_idl_video_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +1869,7 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{23}
+	return file_idl_video_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteCommentRequest) GetToken() string {
@@ -1636,7 +1898,7 @@ type DeleteCommentResponse struct {
 func (x *DeleteCommentResponse) Reset() {
 	*x = DeleteCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[24]
+		mi := &file_idl_video_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1649,7 +1911,7 @@ func (x *DeleteCommentResponse) String() string {
 func (*DeleteCommentResponse) ProtoMessage() {}
 
 func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[24]
+	mi := &file_idl_video_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,7 +1924,7 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{24}
+	return file_idl_video_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteCommentResponse) GetStatusCode() int32 {
@@ -1695,7 +1957,7 @@ type GetVideoCommentsRequest struct {
 func (x *GetVideoCommentsRequest) Reset() {
 	*x = GetVideoCommentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[25]
+		mi := &file_idl_video_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1708,7 +1970,7 @@ func (x *GetVideoCommentsRequest) String() string {
 func (*GetVideoCommentsRequest) ProtoMessage() {}
 
 func (x *GetVideoCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[25]
+	mi := &file_idl_video_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,7 +1983,7 @@ func (x *GetVideoCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoCommentsRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{25}
+	return file_idl_video_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetVideoCommentsRequest) GetVideoId() uint32 {
@@ -1774,7 +2036,7 @@ type GetVideoCommentsResponse struct {
 func (x *GetVideoCommentsResponse) Reset() {
 	*x = GetVideoCommentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[26]
+		mi := &file_idl_video_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1787,7 +2049,7 @@ func (x *GetVideoCommentsResponse) String() string {
 func (*GetVideoCommentsResponse) ProtoMessage() {}
 
 func (x *GetVideoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[26]
+	mi := &file_idl_video_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +2062,7 @@ func (x *GetVideoCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoCommentsResponse.ProtoReflect.Descriptor instead.
 func (*GetVideoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{26}
+	return file_idl_video_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetVideoCommentsResponse) GetStatusCode() int32 {
@@ -1874,7 +2136,7 @@ type Video struct {
 func (x *Video) Reset() {
 	*x = Video{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[27]
+		mi := &file_idl_video_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1887,7 +2149,7 @@ func (x *Video) String() string {
 func (*Video) ProtoMessage() {}
 
 func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[27]
+	mi := &file_idl_video_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +2162,7 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Video.ProtoReflect.Descriptor instead.
 func (*Video) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{27}
+	return file_idl_video_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Video) GetId() uint32 {
@@ -2105,7 +2367,7 @@ type Comment struct {
 func (x *Comment) Reset() {
 	*x = Comment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_idl_video_proto_msgTypes[28]
+		mi := &file_idl_video_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2118,7 +2380,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_video_proto_msgTypes[28]
+	mi := &file_idl_video_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +2393,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_idl_video_proto_rawDescGZIP(), []int{28}
+	return file_idl_video_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Comment) GetId() uint32 {
@@ -2220,7 +2482,7 @@ var file_idl_video_proto_rawDesc = []byte{
 	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x13,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0xef, 0x02, 0x0a, 0x13,
 	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
@@ -2238,51 +2500,69 @@ var file_idl_video_proto_rawDesc = []byte{
 	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x49, 0x64, 0x88, 0x01, 0x01,
 	0x12, 0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x09, 0x20,
 	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x88,
-	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x71, 0x0a, 0x14, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x22, 0x90, 0x01,
+	0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74,
+	0x22, 0x50, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x22, 0x60, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4d, 0x73, 0x67, 0x22, 0x45, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
 	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73,
 	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
-	0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x45, 0x0a,
-	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x22, 0x46, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81,
-	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x73, 0x22, 0x76, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x15, 0x47,
+	0x73, 0x67, 0x22, 0x46, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12,
+	0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x47,
 	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
@@ -2293,89 +2573,104 @@ var file_idl_video_proto_rawDesc = []byte{
 	0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
 	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22,
-	0x90, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73,
-	0x67, 0x12, 0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68,
-	0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
-	0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x72,
-	0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x4d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
-	0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0xb7, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x28,
-	0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19,
-	0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x63, 0x0a, 0x11, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x90, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x71,
-	0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
+	0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x6b,
+	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x72, 0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
 	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x4d, 0x73, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x55, 0x72,
+	0x73, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
+	0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12,
+	0x28, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x63, 0x0a, 0x11, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x71, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x55,
+	0x72, 0x6c, 0x22, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x92, 0x01, 0x0a,
+	0x18, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x72,
 	0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69,
@@ -2501,8 +2796,8 @@ var file_idl_video_proto_rawDesc = []byte{
 	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
 	0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
 	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65,
-	0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x32, 0xca,
-	0x08, 0x0a, 0x0c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x32, 0x96,
+	0x0a, 0x0a, 0x0c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
 	0x4f, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
 	0x1e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x6c,
 	0x69, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
@@ -2512,67 +2807,80 @@ var file_idl_video_proto_rawDesc = []byte{
 	0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
 	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x28, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x2e,
 	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x1f, 0x2e,
+	0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x1f, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
 	0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
-	0x09, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47,
+	0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09,
+	0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
+	0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x47,
 	0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x72,
-	0x70, 0x63, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67,
-	0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x72, 0x70, 0x63, 0x2f, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2587,76 +2895,84 @@ func file_idl_video_proto_rawDescGZIP() []byte {
 	return file_idl_video_proto_rawDescData
 }
 
-var file_idl_video_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_idl_video_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_idl_video_proto_goTypes = []interface{}{
-	(*VideoRequest)(nil),               // 0: rpc.video.VideoRequest
-	(*VideoResponse)(nil),              // 1: rpc.video.VideoResponse
-	(*PublishVideoRequest)(nil),        // 2: rpc.video.PublishVideoRequest
-	(*PublishVideoResponse)(nil),       // 3: rpc.video.PublishVideoResponse
-	(*DeleteVideoRequest)(nil),         // 4: rpc.video.DeleteVideoRequest
-	(*DeleteVideoResponse)(nil),        // 5: rpc.video.DeleteVideoResponse
-	(*GetVideoInfoRequest)(nil),        // 6: rpc.video.GetVideoInfoRequest
-	(*GetVideoInfosRequest)(nil),       // 7: rpc.video.GetVideoInfosRequest
-	(*GetVideoInfosResponse)(nil),      // 8: rpc.video.GetVideoInfosResponse
-	(*GetUserVideosRequest)(nil),       // 9: rpc.video.GetUserVideosRequest
-	(*GetUserVideosResponse)(nil),      // 10: rpc.video.GetUserVideosResponse
-	(*GetRecommendVideosRequest)(nil),  // 11: rpc.video.GetRecommendVideosRequest
-	(*GetRecommendVideosResponse)(nil), // 12: rpc.video.GetRecommendVideosResponse
-	(*GetFollowVideosRequest)(nil),     // 13: rpc.video.GetFollowVideosRequest
-	(*GetFollowVideosResponse)(nil),    // 14: rpc.video.GetFollowVideosResponse
-	(*LikeVideoRequest)(nil),           // 15: rpc.video.LikeVideoRequest
-	(*LikeVideoResponse)(nil),          // 16: rpc.video.LikeVideoResponse
-	(*GetUserLikedVideosRequest)(nil),  // 17: rpc.video.GetUserLikedVideosRequest
-	(*GetUserLikedVideosResponse)(nil), // 18: rpc.video.GetUserLikedVideosResponse
-	(*ShareVideoRequest)(nil),          // 19: rpc.video.ShareVideoRequest
-	(*ShareVideoResponse)(nil),         // 20: rpc.video.ShareVideoResponse
-	(*CommentRequest)(nil),             // 21: rpc.video.CommentRequest
-	(*CommentResponse)(nil),            // 22: rpc.video.CommentResponse
-	(*DeleteCommentRequest)(nil),       // 23: rpc.video.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),      // 24: rpc.video.DeleteCommentResponse
-	(*GetVideoCommentsRequest)(nil),    // 25: rpc.video.GetVideoCommentsRequest
-	(*GetVideoCommentsResponse)(nil),   // 26: rpc.video.GetVideoCommentsResponse
-	(*Video)(nil),                      // 27: rpc.video.Video
-	(*Comment)(nil),                    // 28: rpc.video.Comment
+	(*VideoRequest)(nil),                   // 0: rpc.video.VideoRequest
+	(*VideoResponse)(nil),                  // 1: rpc.video.VideoResponse
+	(*PublishVideoRequest)(nil),            // 2: rpc.video.PublishVideoRequest
+	(*PublishVideoResponse)(nil),           // 3: rpc.video.PublishVideoResponse
+	(*CancelScheduledPublishRequest)(nil),  // 4: rpc.video.CancelScheduledPublishRequest
+	(*CancelScheduledPublishResponse)(nil), // 5: rpc.video.CancelScheduledPublishResponse
+	(*DeleteVideoRequest)(nil),             // 6: rpc.video.DeleteVideoRequest
+	(*DeleteVideoResponse)(nil),            // 7: rpc.video.DeleteVideoResponse
+	(*GetVideoInfoRequest)(nil),            // 8: rpc.video.GetVideoInfoRequest
+	(*GetVideoInfosRequest)(nil),           // 9: rpc.video.GetVideoInfosRequest
+	(*GetVideoInfosResponse)(nil),          // 10: rpc.video.GetVideoInfosResponse
+	(*GetUserVideosRequest)(nil),           // 11: rpc.video.GetUserVideosRequest
+	(*GetUserVideosResponse)(nil),          // 12: rpc.video.GetUserVideosResponse
+	(*GetRecommendVideosRequest)(nil),      // 13: rpc.video.GetRecommendVideosRequest
+	(*GetRecommendVideosResponse)(nil),     // 14: rpc.video.GetRecommendVideosResponse
+	(*GetFollowVideosRequest)(nil),         // 15: rpc.video.GetFollowVideosRequest
+	(*GetFollowVideosResponse)(nil),        // 16: rpc.video.GetFollowVideosResponse
+	(*LikeVideoRequest)(nil),               // 17: rpc.video.LikeVideoRequest
+	(*LikeVideoResponse)(nil),              // 18: rpc.video.LikeVideoResponse
+	(*GetUserLikedVideosRequest)(nil),      // 19: rpc.video.GetUserLikedVideosRequest
+	(*GetUserLikedVideosResponse)(nil),     // 20: rpc.video.GetUserLikedVideosResponse
+	(*ShareVideoRequest)(nil),              // 21: rpc.video.ShareVideoRequest
+	(*ShareVideoResponse)(nil),             // 22: rpc.video.ShareVideoResponse
+	(*ResolveShareLinkRequest)(nil),        // 23: rpc.video.ResolveShareLinkRequest
+	(*ResolveShareLinkResponse)(nil),       // 24: rpc.video.ResolveShareLinkResponse
+	(*CommentRequest)(nil),                 // 25: rpc.video.CommentRequest
+	(*CommentResponse)(nil),                // 26: rpc.video.CommentResponse
+	(*DeleteCommentRequest)(nil),           // 27: rpc.video.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),          // 28: rpc.video.DeleteCommentResponse
+	(*GetVideoCommentsRequest)(nil),        // 29: rpc.video.GetVideoCommentsRequest
+	(*GetVideoCommentsResponse)(nil),       // 30: rpc.video.GetVideoCommentsResponse
+	(*Video)(nil),                          // 31: rpc.video.Video
+	(*Comment)(nil),                        // 32: rpc.video.Comment
 }
 var file_idl_video_proto_depIdxs = []int32{
-	27, // 0: rpc.video.VideoResponse.video:type_name -> rpc.video.Video
-	27, // 1: rpc.video.GetVideoInfosResponse.videos:type_name -> rpc.video.Video
-	27, // 2: rpc.video.GetUserVideosResponse.videos:type_name -> rpc.video.Video
-	27, // 3: rpc.video.GetRecommendVideosResponse.videos:type_name -> rpc.video.Video
-	27, // 4: rpc.video.GetFollowVideosResponse.videos:type_name -> rpc.video.Video
-	27, // 5: rpc.video.GetUserLikedVideosResponse.videos:type_name -> rpc.video.Video
-	28, // 6: rpc.video.CommentResponse.comment:type_name -> rpc.video.Comment
-	28, // 7: rpc.video.GetVideoCommentsResponse.comments:type_name -> rpc.video.Comment
-	28, // 8: rpc.video.Comment.replies:type_name -> rpc.video.Comment
+	31, // 0: rpc.video.VideoResponse.video:type_name -> rpc.video.Video
+	31, // 1: rpc.video.GetVideoInfosResponse.videos:type_name -> rpc.video.Video
+	31, // 2: rpc.video.GetUserVideosResponse.videos:type_name -> rpc.video.Video
+	31, // 3: rpc.video.GetRecommendVideosResponse.videos:type_name -> rpc.video.Video
+	31, // 4: rpc.video.GetFollowVideosResponse.videos:type_name -> rpc.video.Video
+	31, // 5: rpc.video.GetUserLikedVideosResponse.videos:type_name -> rpc.video.Video
+	32, // 6: rpc.video.CommentResponse.comment:type_name -> rpc.video.Comment
+	32, // 7: rpc.video.GetVideoCommentsResponse.comments:type_name -> rpc.video.Comment
+	32, // 8: rpc.video.Comment.replies:type_name -> rpc.video.Comment
 	2,  // 9: rpc.video.VideoService.PublishVideo:input_type -> rpc.video.PublishVideoRequest
-	4,  // 10: rpc.video.VideoService.DeleteVideo:input_type -> rpc.video.DeleteVideoRequest
-	6,  // 11: rpc.video.VideoService.GetVideoInfo:input_type -> rpc.video.GetVideoInfoRequest
-	7,  // 12: rpc.video.VideoService.GetVideoInfos:input_type -> rpc.video.GetVideoInfosRequest
-	9,  // 13: rpc.video.VideoService.GetUserVideos:input_type -> rpc.video.GetUserVideosRequest
-	11, // 14: rpc.video.VideoService.GetRecommendVideos:input_type -> rpc.video.GetRecommendVideosRequest
-	13, // 15: rpc.video.VideoService.GetFollowVideos:input_type -> rpc.video.GetFollowVideosRequest
-	15, // 16: rpc.video.VideoService.LikeVideo:input_type -> rpc.video.LikeVideoRequest
-	17, // 17: rpc.video.VideoService.GetUserLikedVideos:input_type -> rpc.video.GetUserLikedVideosRequest
-	19, // 18: rpc.video.VideoService.ShareVideo:input_type -> rpc.video.ShareVideoRequest
-	21, // 19: rpc.video.VideoService.CommentVideo:input_type -> rpc.video.CommentRequest
-	23, // 20: rpc.video.VideoService.DeleteComment:input_type -> rpc.video.DeleteCommentRequest
-	25, // 21: rpc.video.VideoService.GetVideoComments:input_type -> rpc.video.GetVideoCommentsRequest
-	3,  // 22: rpc.video.VideoService.PublishVideo:output_type -> rpc.video.PublishVideoResponse
-	5,  // 23: rpc.video.VideoService.DeleteVideo:output_type -> rpc.video.DeleteVideoResponse
-	1,  // 24: rpc.video.VideoService.GetVideoInfo:output_type -> rpc.video.VideoResponse
-	8,  // 25: rpc.video.VideoService.GetVideoInfos:output_type -> rpc.video.GetVideoInfosResponse
-	10, // 26: rpc.video.VideoService.GetUserVideos:output_type -> rpc.video.GetUserVideosResponse
-	12, // 27: rpc.video.VideoService.GetRecommendVideos:output_type -> rpc.video.GetRecommendVideosResponse
-	14, // 28: rpc.video.VideoService.GetFollowVideos:output_type -> rpc.video.GetFollowVideosResponse
-	16, // 29: rpc.video.VideoService.LikeVideo:output_type -> rpc.video.LikeVideoResponse
-	18, // 30: rpc.video.VideoService.GetUserLikedVideos:output_type -> rpc.video.GetUserLikedVideosResponse
-	20, // 31: rpc.video.VideoService.ShareVideo:output_type -> rpc.video.ShareVideoResponse
-	22, // 32: rpc.video.VideoService.CommentVideo:output_type -> rpc.video.CommentResponse
-	24, // 33: rpc.video.VideoService.DeleteComment:output_type -> rpc.video.DeleteCommentResponse
-	26, // 34: rpc.video.VideoService.GetVideoComments:output_type -> rpc.video.GetVideoCommentsResponse
-	22, // [22:35] is the sub-list for method output_type
-	9,  // [9:22] is the sub-list for method input_type
+	6,  // 10: rpc.video.VideoService.DeleteVideo:input_type -> rpc.video.DeleteVideoRequest
+	4,  // 11: rpc.video.VideoService.CancelScheduledPublish:input_type -> rpc.video.CancelScheduledPublishRequest
+	8,  // 12: rpc.video.VideoService.GetVideoInfo:input_type -> rpc.video.GetVideoInfoRequest
+	9,  // 13: rpc.video.VideoService.GetVideoInfos:input_type -> rpc.video.GetVideoInfosRequest
+	11, // 14: rpc.video.VideoService.GetUserVideos:input_type -> rpc.video.GetUserVideosRequest
+	13, // 15: rpc.video.VideoService.GetRecommendVideos:input_type -> rpc.video.GetRecommendVideosRequest
+	15, // 16: rpc.video.VideoService.GetFollowVideos:input_type -> rpc.video.GetFollowVideosRequest
+	17, // 17: rpc.video.VideoService.LikeVideo:input_type -> rpc.video.LikeVideoRequest
+	19, // 18: rpc.video.VideoService.GetUserLikedVideos:input_type -> rpc.video.GetUserLikedVideosRequest
+	21, // 19: rpc.video.VideoService.ShareVideo:input_type -> rpc.video.ShareVideoRequest
+	23, // 20: rpc.video.VideoService.ResolveShareLink:input_type -> rpc.video.ResolveShareLinkRequest
+	25, // 21: rpc.video.VideoService.CommentVideo:input_type -> rpc.video.CommentRequest
+	27, // 22: rpc.video.VideoService.DeleteComment:input_type -> rpc.video.DeleteCommentRequest
+	29, // 23: rpc.video.VideoService.GetVideoComments:input_type -> rpc.video.GetVideoCommentsRequest
+	3,  // 24: rpc.video.VideoService.PublishVideo:output_type -> rpc.video.PublishVideoResponse
+	7,  // 25: rpc.video.VideoService.DeleteVideo:output_type -> rpc.video.DeleteVideoResponse
+	5,  // 26: rpc.video.VideoService.CancelScheduledPublish:output_type -> rpc.video.CancelScheduledPublishResponse
+	1,  // 27: rpc.video.VideoService.GetVideoInfo:output_type -> rpc.video.VideoResponse
+	10, // 28: rpc.video.VideoService.GetVideoInfos:output_type -> rpc.video.GetVideoInfosResponse
+	12, // 29: rpc.video.VideoService.GetUserVideos:output_type -> rpc.video.GetUserVideosResponse
+	14, // 30: rpc.video.VideoService.GetRecommendVideos:output_type -> rpc.video.GetRecommendVideosResponse
+	16, // 31: rpc.video.VideoService.GetFollowVideos:output_type -> rpc.video.GetFollowVideosResponse
+	18, // 32: rpc.video.VideoService.LikeVideo:output_type -> rpc.video.LikeVideoResponse
+	20, // 33: rpc.video.VideoService.GetUserLikedVideos:output_type -> rpc.video.GetUserLikedVideosResponse
+	22, // 34: rpc.video.VideoService.ShareVideo:output_type -> rpc.video.ShareVideoResponse
+	24, // 35: rpc.video.VideoService.ResolveShareLink:output_type -> rpc.video.ResolveShareLinkResponse
+	26, // 36: rpc.video.VideoService.CommentVideo:output_type -> rpc.video.CommentResponse
+	28, // 37: rpc.video.VideoService.DeleteComment:output_type -> rpc.video.DeleteCommentResponse
+	30, // 38: rpc.video.VideoService.GetVideoComments:output_type -> rpc.video.GetVideoCommentsResponse
+	24, // [24:39] is the sub-list for method output_type
+	9,  // [9:24] is the sub-list for method input_type
 	9,  // [9:9] is the sub-list for extension type_name
 	9,  // [9:9] is the sub-list for extension extendee
 	0,  // [0:9] is the sub-list for field type_name
@@ -2717,7 +3033,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVideoRequest); i {
+			switch v := v.(*CancelScheduledPublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2729,7 +3045,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVideoResponse); i {
+			switch v := v.(*CancelScheduledPublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2741,7 +3057,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoInfoRequest); i {
+			switch v := v.(*DeleteVideoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2753,7 +3069,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoInfosRequest); i {
+			switch v := v.(*DeleteVideoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2765,7 +3081,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoInfosResponse); i {
+			switch v := v.(*GetVideoInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2777,7 +3093,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserVideosRequest); i {
+			switch v := v.(*GetVideoInfosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2789,7 +3105,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserVideosResponse); i {
+			switch v := v.(*GetVideoInfosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2801,7 +3117,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRecommendVideosRequest); i {
+			switch v := v.(*GetUserVideosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2813,7 +3129,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRecommendVideosResponse); i {
+			switch v := v.(*GetUserVideosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2825,7 +3141,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowVideosRequest); i {
+			switch v := v.(*GetRecommendVideosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2837,7 +3153,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowVideosResponse); i {
+			switch v := v.(*GetRecommendVideosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2849,7 +3165,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeVideoRequest); i {
+			switch v := v.(*GetFollowVideosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2861,7 +3177,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeVideoResponse); i {
+			switch v := v.(*GetFollowVideosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2873,7 +3189,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserLikedVideosRequest); i {
+			switch v := v.(*LikeVideoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2885,7 +3201,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserLikedVideosResponse); i {
+			switch v := v.(*LikeVideoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2897,7 +3213,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareVideoRequest); i {
+			switch v := v.(*GetUserLikedVideosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2909,7 +3225,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareVideoResponse); i {
+			switch v := v.(*GetUserLikedVideosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2921,7 +3237,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentRequest); i {
+			switch v := v.(*ShareVideoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2933,7 +3249,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentResponse); i {
+			switch v := v.(*ShareVideoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2945,7 +3261,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCommentRequest); i {
+			switch v := v.(*ResolveShareLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2957,7 +3273,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCommentResponse); i {
+			switch v := v.(*ResolveShareLinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2969,7 +3285,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoCommentsRequest); i {
+			switch v := v.(*CommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2981,7 +3297,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoCommentsResponse); i {
+			switch v := v.(*CommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2993,7 +3309,7 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Video); i {
+			switch v := v.(*DeleteCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3005,6 +3321,54 @@ func file_idl_video_proto_init() {
 			}
 		}
 		file_idl_video_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_idl_video_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVideoCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_idl_video_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVideoCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_idl_video_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Video); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_idl_video_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Comment); i {
 			case 0:
 				return &v.state
@@ -3018,17 +3382,17 @@ func file_idl_video_proto_init() {
 		}
 	}
 	file_idl_video_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_idl_video_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_idl_video_proto_msgTypes[21].OneofWrappers = []interface{}{}
-	file_idl_video_proto_msgTypes[27].OneofWrappers = []interface{}{}
-	file_idl_video_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_idl_video_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_idl_video_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_idl_video_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_idl_video_proto_msgTypes[32].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_idl_video_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	VideoService_PublishVideo_FullMethodName       = "/rpc.video.VideoService/PublishVideo"
-	VideoService_DeleteVideo_FullMethodName        = "/rpc.video.VideoService/DeleteVideo"
-	VideoService_GetVideoInfo_FullMethodName       = "/rpc.video.VideoService/GetVideoInfo"
-	VideoService_GetVideoInfos_FullMethodName      = "/rpc.video.VideoService/GetVideoInfos"
-	VideoService_GetUserVideos_FullMethodName      = "/rpc.video.VideoService/GetUserVideos"
-	VideoService_GetRecommendVideos_FullMethodName = "/rpc.video.VideoService/GetRecommendVideos"
-	VideoService_GetFollowVideos_FullMethodName    = "/rpc.video.VideoService/GetFollowVideos"
-	VideoService_LikeVideo_FullMethodName          = "/rpc.video.VideoService/LikeVideo"
-	VideoService_GetUserLikedVideos_FullMethodName = "/rpc.video.VideoService/GetUserLikedVideos"
-	VideoService_ShareVideo_FullMethodName         = "/rpc.video.VideoService/ShareVideo"
-	VideoService_CommentVideo_FullMethodName       = "/rpc.video.VideoService/CommentVideo"
-	VideoService_DeleteComment_FullMethodName      = "/rpc.video.VideoService/DeleteComment"
-	VideoService_GetVideoComments_FullMethodName   = "/rpc.video.VideoService/GetVideoComments"
+	VideoService_PublishVideo_FullMethodName           = "/rpc.video.VideoService/PublishVideo"
+	VideoService_DeleteVideo_FullMethodName            = "/rpc.video.VideoService/DeleteVideo"
+	VideoService_CancelScheduledPublish_FullMethodName = "/rpc.video.VideoService/CancelScheduledPublish"
+	VideoService_GetVideoInfo_FullMethodName           = "/rpc.video.VideoService/GetVideoInfo"
+	VideoService_GetVideoInfos_FullMethodName          = "/rpc.video.VideoService/GetVideoInfos"
+	VideoService_GetUserVideos_FullMethodName          = "/rpc.video.VideoService/GetUserVideos"
+	VideoService_GetRecommendVideos_FullMethodName     = "/rpc.video.VideoService/GetRecommendVideos"
+	VideoService_GetFollowVideos_FullMethodName        = "/rpc.video.VideoService/GetFollowVideos"
+	VideoService_LikeVideo_FullMethodName              = "/rpc.video.VideoService/LikeVideo"
+	VideoService_GetUserLikedVideos_FullMethodName     = "/rpc.video.VideoService/GetUserLikedVideos"
+	VideoService_ShareVideo_FullMethodName             = "/rpc.video.VideoService/ShareVideo"
+	VideoService_ResolveShareLink_FullMethodName       = "/rpc.video.VideoService/ResolveShareLink"
+	VideoService_CommentVideo_FullMethodName           = "/rpc.video.VideoService/CommentVideo"
+	VideoService_DeleteComment_FullMethodName          = "/rpc.video.VideoService/DeleteComment"
+	VideoService_GetVideoComments_FullMethodName       = "/rpc.video.VideoService/GetVideoComments"
 )
 
 // VideoServiceClient is the client API for VideoService service.
@@ -41,6 +43,7 @@ type VideoServiceClient interface {
 	// 视频发布相关
 	PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...grpc.CallOption) (*PublishVideoResponse, error)
 	DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...grpc.CallOption) (*DeleteVideoResponse, error)
+	CancelScheduledPublish(ctx context.Context, in *CancelScheduledPublishRequest, opts ...grpc.CallOption) (*CancelScheduledPublishResponse, error)
 	// 视频信息获取
 	GetVideoInfo(ctx context.Context, in *GetVideoInfoRequest, opts ...grpc.CallOption) (*VideoResponse, error)
 	GetVideoInfos(ctx context.Context, in *GetVideoInfosRequest, opts ...grpc.CallOption) (*GetVideoInfosResponse, error)
@@ -52,6 +55,7 @@ type VideoServiceClient interface {
 	LikeVideo(ctx context.Context, in *LikeVideoRequest, opts ...grpc.CallOption) (*LikeVideoResponse, error)
 	GetUserLikedVideos(ctx context.Context, in *GetUserLikedVideosRequest, opts ...grpc.CallOption) (*GetUserLikedVideosResponse, error)
 	ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoResponse, error)
+	ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*ResolveShareLinkResponse, error)
 	// 视频评论相关
 	CommentVideo(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
@@ -84,6 +88,15 @@ func (c *videoServiceClient) DeleteVideo(ctx context.Context, in *DeleteVideoReq
 	return out, nil
 }
 
+func (c *videoServiceClient) CancelScheduledPublish(ctx context.Context, in *CancelScheduledPublishRequest, opts ...grpc.CallOption) (*CancelScheduledPublishResponse, error) {
+	out := new(CancelScheduledPublishResponse)
+	err := c.cc.Invoke(ctx, VideoService_CancelScheduledPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) GetVideoInfo(ctx context.Context, in *GetVideoInfoRequest, opts ...grpc.CallOption) (*VideoResponse, error) {
 	out := new(VideoResponse)
 	err := c.cc.Invoke(ctx, VideoService_GetVideoInfo_FullMethodName, in, out, opts...)
@@ -156,6 +169,15 @@ func (c *videoServiceClient) ShareVideo(ctx context.Context, in *ShareVideoReque
 	return out, nil
 }
 
+func (c *videoServiceClient) ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*ResolveShareLinkResponse, error) {
+	out := new(ResolveShareLinkResponse)
+	err := c.cc.Invoke(ctx, VideoService_ResolveShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) CommentVideo(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
 	out := new(CommentResponse)
 	err := c.cc.Invoke(ctx, VideoService_CommentVideo_FullMethodName, in, out, opts...)
@@ -190,6 +212,7 @@ type VideoServiceServer interface {
 	// 视频发布相关
 	PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoResponse, error)
 	DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error)
+	CancelScheduledPublish(context.Context, *CancelScheduledPublishRequest) (*CancelScheduledPublishResponse, error)
 	// 视频信息获取
 	GetVideoInfo(context.Context, *GetVideoInfoRequest) (*VideoResponse, error)
 	GetVideoInfos(context.Context, *GetVideoInfosRequest) (*GetVideoInfosResponse, error)
@@ -201,6 +224,7 @@ type VideoServiceServer interface {
 	LikeVideo(context.Context, *LikeVideoRequest) (*LikeVideoResponse, error)
 	GetUserLikedVideos(context.Context, *GetUserLikedVideosRequest) (*GetUserLikedVideosResponse, error)
 	ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error)
+	ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error)
 	// 视频评论相关
 	CommentVideo(context.Context, *CommentRequest) (*CommentResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
@@ -218,6 +242,9 @@ func (UnimplementedVideoServiceServer) PublishVideo(context.Context, *PublishVid
 func (UnimplementedVideoServiceServer) DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVideo not implemented")
 }
+func (UnimplementedVideoServiceServer) CancelScheduledPublish(context.Context, *CancelScheduledPublishRequest) (*CancelScheduledPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPublish not implemented")
+}
 func (UnimplementedVideoServiceServer) GetVideoInfo(context.Context, *GetVideoInfoRequest) (*VideoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoInfo not implemented")
 }
@@ -242,6 +269,9 @@ func (UnimplementedVideoServiceServer) GetUserLikedVideos(context.Context, *GetU
 func (UnimplementedVideoServiceServer) ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareVideo not implemented")
 }
+func (UnimplementedVideoServiceServer) ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShareLink not implemented")
+}
 func (UnimplementedVideoServiceServer) CommentVideo(context.Context, *CommentRequest) (*CommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommentVideo not implemented")
 }
@@ -300,6 +330,24 @@ func _VideoService_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_CancelScheduledPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CancelScheduledPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CancelScheduledPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CancelScheduledPublish(ctx, req.(*CancelScheduledPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_GetVideoInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVideoInfoRequest)
 	if err := dec(in); err != nil {
@@ -444,6 +492,24 @@ func _VideoService_ShareVideo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_ResolveShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ResolveShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ResolveShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ResolveShareLink(ctx, req.(*ResolveShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_CommentVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommentRequest)
 	if err := dec(in); err != nil {
@@ -513,6 +579,10 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVideo",
 			Handler:    _VideoService_DeleteVideo_Handler,
 		},
+		{
+			MethodName: "CancelScheduledPublish",
+			Handler:    _VideoService_CancelScheduledPublish_Handler,
+		},
 		{
 			MethodName: "GetVideoInfo",
 			Handler:    _VideoService_GetVideoInfo_Handler,
@@ -545,6 +615,10 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShareVideo",
 			Handler:    _VideoService_ShareVideo_Handler,
 		},
+		{
+			MethodName: "ResolveShareLink",
+			Handler:    _VideoService_ResolveShareLink_Handler,
+		},
 		{
 			MethodName: "CommentVideo",
 			Handler:    _VideoService_CommentVideo_Handler,